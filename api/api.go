@@ -2,11 +2,13 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/runtime/profile"
 	"github.com/brimdata/super/sbuf"
 	"github.com/segmentio/ksuid"
 )
@@ -36,10 +38,12 @@ type VersionResponse struct {
 }
 
 type PoolPostRequest struct {
-	Name       string   `json:"name"`
-	SortKeys   SortKeys `json:"layout"`
-	SeekStride int      `json:"seek_stride"`
-	Thresh     int64    `json:"thresh"`
+	Name         string   `json:"name"`
+	SortKeys     SortKeys `json:"layout"`
+	SeekStride   int      `json:"seek_stride"`
+	Thresh       int64    `json:"thresh"`
+	BloomFilters bool     `json:"bloom_filters"`
+	Sketches     bool     `json:"sketches"`
 }
 
 type SortKeys struct {
@@ -51,11 +55,45 @@ type PoolPutRequest struct {
 	Name string `json:"name"`
 }
 
+type PoolRetentionRequest struct {
+	Keep    string `json:"keep"`
+	MaxSize int64  `json:"max_size"`
+	MaxAge  string `json:"max_age"`
+}
+
+type PoolContractRequest struct {
+	Type       string `json:"type"`
+	Mode       string `json:"mode"`
+	Quarantine string `json:"quarantine"`
+	Shaper     string `json:"shaper"`
+}
+
 type BranchPostRequest struct {
 	Name   string `json:"name"`
 	Commit string `json:"commit"`
 }
 
+type TagPostRequest struct {
+	Name   string `json:"name"`
+	Commit string `json:"commit"`
+}
+
+type ViewPostRequest struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+}
+
+// View is a materialized view of the branch Branch of the pool Pool.
+// Commit is the commit of the branch that the view's results are computed
+// from.
+type View struct {
+	Name   string      `json:"name" super:"name"`
+	Query  string      `json:"query" super:"query"`
+	Pool   string      `json:"pool" super:"pool"`
+	Branch string      `json:"branch" super:"branch"`
+	Commit ksuid.KSUID `json:"commit" super:"commit"`
+}
+
 type BranchMergeRequest struct {
 	At string `json:"at"`
 }
@@ -96,8 +134,19 @@ type EventBranch struct {
 	Branch string      `super:"branch"`
 }
 
+// QueryRequest is a query and the values of its parameters.  Params holds
+// JSON values, which the service converts to super values as the JSON reader
+// does, and SUPParams holds values in SUP format, as given to the -param
+// option of the super command, so a value of a type JSON lacks, like time or
+// ip, may be bound.  A parameter named "x" is referenced in the query as "$x"
+// and positional parameters, which are written as "?", are named "1", "2",
+// and so on.  If Analyze is true, the query runs in EXPLAIN ANALYZE mode and
+// its profile is sent as a QueryProfile control message when it finishes.
 type QueryRequest struct {
-	Query string `json:"query"`
+	Query     string                     `json:"query"`
+	Params    map[string]json.RawMessage `json:"params,omitempty"`
+	SUPParams map[string]string          `json:"sup_params,omitempty"`
+	Analyze   bool                       `json:"analyze,omitempty"`
 }
 
 type QueryChannelSet struct {
@@ -118,6 +167,21 @@ type QueryStats struct {
 	sbuf.Progress
 }
 
+// QueryProfile is the profile of a query run in EXPLAIN ANALYZE mode.
+type QueryProfile struct {
+	profile.Report
+}
+
+// QueryInfo describes a query running on the service.
+type QueryInfo struct {
+	RequestID string        `json:"request_id" super:"request_id"`
+	TenantID  string        `json:"tenant_id" super:"tenant_id"`
+	UserID    string        `json:"user_id" super:"user_id"`
+	Query     string        `json:"query" super:"query"`
+	StartTime nano.Ts       `json:"start_time" super:"start_time"`
+	Progress  sbuf.Progress `json:"progress" super:"progress"`
+}
+
 type QueryWarning struct {
 	Warning string `json:"warning" super:"warning"`
 }
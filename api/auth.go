@@ -1,8 +1,9 @@
 package api
 
 type AuthIdentityResponse struct {
-	TenantID string `json:"tenant_id" super:"tenant_id"`
-	UserID   string `json:"user_id" super:"user_id"`
+	TenantID string   `json:"tenant_id" super:"tenant_id"`
+	UserID   string   `json:"user_id" super:"user_id"`
+	Roles    []string `json:"roles,omitempty" super:"roles,omitempty"`
 }
 
 type AuthMethod string
@@ -28,3 +29,17 @@ type AuthMethodAuth0Details struct {
 	// for any oauth flows.
 	Domain string `json:"domain"`
 }
+
+// Grant gives the members of Role the permission named by Permission
+// ("read", "load", "delete", or "admin") on the pool named by Pool or, when
+// Pool is "*", on every pool.
+type Grant struct {
+	Role       string `json:"role" super:"role"`
+	Pool       string `json:"pool" super:"pool"`
+	Permission string `json:"permission" super:"permission"`
+}
+
+type GrantRequest struct {
+	Role       string `json:"role"`
+	Permission string `json:"permission"`
+}
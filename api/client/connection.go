@@ -19,6 +19,7 @@ import (
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/db"
 	"github.com/brimdata/super/db/branches"
+	"github.com/brimdata/super/db/tags"
 	"github.com/brimdata/super/runtime/exec"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sup"
@@ -40,6 +41,11 @@ var (
 	ErrBranchNotFound = errors.New("branch not found")
 	// ErrBranchExists is returned when the specified the branch already exists.
 	ErrBranchExists = errors.New("branch exists")
+
+	// ErrTagExists is returned when the specified the tag already exists.
+	ErrTagExists = errors.New("tag exists")
+	// ErrViewExists is returned when the specified view already exists.
+	ErrViewExists = errors.New("view exists")
 )
 
 type Connection struct {
@@ -246,6 +252,26 @@ func (c *Connection) RenamePool(ctx context.Context, id ksuid.KSUID, put api.Poo
 	return nil
 }
 
+func (c *Connection) SetPoolRetention(ctx context.Context, id ksuid.KSUID, put api.PoolRetentionRequest) error {
+	req := c.NewRequest(ctx, http.MethodPut, path.Join("/pool", id.String(), "retention"), put)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+func (c *Connection) SetPoolContract(ctx context.Context, id ksuid.KSUID, put api.PoolContractRequest) error {
+	req := c.NewRequest(ctx, http.MethodPut, path.Join("/pool", id.String(), "contract"), put)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
 func (c *Connection) RemovePool(ctx context.Context, id ksuid.KSUID) error {
 	req := c.NewRequest(ctx, http.MethodDelete, path.Join("/pool", id.String()), nil)
 	res, err := c.Do(req)
@@ -269,6 +295,67 @@ func (c *Connection) CreateBranch(ctx context.Context, poolID ksuid.KSUID, paylo
 	return branch, err
 }
 
+func (c *Connection) Tags(ctx context.Context, poolID ksuid.KSUID) ([]tags.Config, error) {
+	req := c.NewRequest(ctx, http.MethodGet, urlPath("pool", poolID.String(), "tag"), nil)
+	var list []tags.Config
+	err := c.doAndUnmarshal(req, &list)
+	return list, err
+}
+
+func (c *Connection) CreateTag(ctx context.Context, poolID ksuid.KSUID, payload api.TagPostRequest) (tags.Config, error) {
+	req := c.NewRequest(ctx, http.MethodPost, urlPath("pool", poolID.String(), "tag"), payload)
+	var tag tags.Config
+	err := c.doAndUnmarshal(req, &tag)
+	if errIsStatus(err, http.StatusConflict) {
+		err = ErrTagExists
+	}
+	return tag, err
+}
+
+func (c *Connection) Views(ctx context.Context) ([]api.View, error) {
+	req := c.NewRequest(ctx, http.MethodGet, "/view", nil)
+	var list []api.View
+	err := c.doAndUnmarshal(req, &list)
+	return list, err
+}
+
+func (c *Connection) CreateView(ctx context.Context, poolID ksuid.KSUID, branch string, payload api.ViewPostRequest) (api.View, error) {
+	req := c.NewRequest(ctx, http.MethodPost, urlPath("pool", poolID.String(), "branch", branch, "view"), payload)
+	var view api.View
+	err := c.doAndUnmarshal(req, &view)
+	if errIsStatus(err, http.StatusConflict) {
+		err = ErrViewExists
+	}
+	return view, err
+}
+
+func (c *Connection) RefreshView(ctx context.Context, name string) (api.CommitResponse, error) {
+	req := c.NewRequest(ctx, http.MethodPost, urlPath("view", name, "refresh"), nil)
+	var commit api.CommitResponse
+	err := c.doAndUnmarshal(req, &commit)
+	return commit, err
+}
+
+func (c *Connection) RemoveView(ctx context.Context, name string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("view", name), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+func (c *Connection) RemoveTag(ctx context.Context, poolID ksuid.KSUID, name string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("pool", poolID.String(), "tag", name), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
 func (c *Connection) MergeBranch(ctx context.Context, poolID ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (api.CommitResponse, error) {
 	path := urlPath("pool", poolID.String(), "branch", parentBranch, "merge", childBranch)
 	req := c.NewRequest(ctx, http.MethodPost, path, nil)
@@ -291,16 +378,17 @@ func (c *Connection) Revert(ctx context.Context, poolID ksuid.KSUID, branchName
 	return commit, err
 }
 
-// Query assembles a query from src and filenames and runs it.
+// Query assembles a query from src and filenames and runs it with the
+// query parameters params, whose values are in SUP format.
 //
 // As for Connection.Do, if the returned error is nil, the user is expected to
 // call Response.Body.Close.
-func (c *Connection) Query(ctx context.Context, inputs []srcfiles.Input) (*Response, error) {
+func (c *Connection) Query(ctx context.Context, inputs []srcfiles.Input, params map[string]string) (*Response, error) {
 	files, err := srcfiles.Concat(inputs)
 	if err != nil {
 		return nil, err
 	}
-	body := api.QueryRequest{Query: string(files.Text)}
+	body := api.QueryRequest{Query: string(files.Text), SUPParams: params}
 	req := c.NewRequest(ctx, http.MethodPost, "/query?ctrl=T", body)
 	res, err := c.Do(req)
 	if ae := (*api.Error)(nil); errors.As(err, &ae) && len(ae.CompilationErrors) > 0 {
@@ -310,6 +398,26 @@ func (c *Connection) Query(ctx context.Context, inputs []srcfiles.Input) (*Respo
 	return res, err
 }
 
+// RunningQueries returns a description of each query running on the
+// service.
+func (c *Connection) RunningQueries(ctx context.Context) ([]api.QueryInfo, error) {
+	req := c.NewRequest(ctx, http.MethodGet, "/query", nil)
+	var infos []api.QueryInfo
+	err := c.doAndUnmarshal(req, &infos)
+	return infos, err
+}
+
+// KillQuery cancels the running query with the given request ID.
+func (c *Connection) KillQuery(ctx context.Context, requestID string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("query", requestID), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
 func (c *Connection) Compact(ctx context.Context, poolID ksuid.KSUID, branchName string, objects []ksuid.KSUID, writeVectors bool, message api.CommitMessage) (api.CommitResponse, error) {
 	path := urlPath("pool", poolID.String(), "branch", branchName, "compact")
 	if writeVectors {
@@ -327,7 +435,20 @@ func (c *Connection) Compact(ctx context.Context, poolID ksuid.KSUID, branchName
 // Load loads data from r.  contentType is a media type for r or the empty
 // string, in which case the server will attempt to detect r's format.
 func (c *Connection) Load(ctx context.Context, poolID ksuid.KSUID, branchName, contentType string, r io.Reader, message api.CommitMessage) (api.CommitResponse, error) {
+	return c.load(ctx, poolID, branchName, contentType, "", r, message)
+}
+
+// Upsert loads data from r like Load but replaces the values of the branch
+// having the same value of the field key as a loaded value.
+func (c *Connection) Upsert(ctx context.Context, poolID ksuid.KSUID, branchName, contentType, key string, r io.Reader, message api.CommitMessage) (api.CommitResponse, error) {
+	return c.load(ctx, poolID, branchName, contentType, key, r, message)
+}
+
+func (c *Connection) load(ctx context.Context, poolID ksuid.KSUID, branchName, contentType, upsertKey string, r io.Reader, message api.CommitMessage) (api.CommitResponse, error) {
 	path := urlPath("pool", poolID.String(), "branch", branchName)
+	if upsertKey != "" {
+		path += "?upsert_key=" + url.QueryEscape(upsertKey)
+	}
 	req := c.NewRequest(ctx, http.MethodPost, path, r)
 	req.Header.Set("Content-Type", contentType)
 	if err := encodeCommitMessage(req, message); err != nil {
@@ -427,6 +548,38 @@ func (c *Connection) AuthIdentity(ctx context.Context) (api.AuthIdentityResponse
 	return ident, err
 }
 
+// Grants returns the grants on the pools for which the user has admin
+// permission.
+func (c *Connection) Grants(ctx context.Context) ([]api.Grant, error) {
+	req := c.NewRequest(ctx, http.MethodGet, "/auth/grant", nil)
+	var grants []api.Grant
+	err := c.doAndUnmarshal(req, &grants)
+	return grants, err
+}
+
+// Grant gives the members of role perm on pool, which is a pool name or
+// ID or "*" for all pools.
+func (c *Connection) Grant(ctx context.Context, pool, role, perm string) error {
+	req := c.NewRequest(ctx, http.MethodPost, urlPath("auth", "grant", pool), api.GrantRequest{Role: role, Permission: perm})
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
+// Revoke removes the grant to role on pool.
+func (c *Connection) Revoke(ctx context.Context, pool, role string) error {
+	req := c.NewRequest(ctx, http.MethodDelete, urlPath("auth", "grant", pool, role), nil)
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+	return nil
+}
+
 func (c *Connection) refreshAuthToken(ctx context.Context) (string, error) {
 	method, err := c.AuthMethod(ctx)
 	if err != nil {
@@ -64,6 +64,8 @@ again:
 		goto again
 	case *api.QueryError:
 		return nil, errors.New(ctrl.Error)
+	case *api.QueryProfile:
+		goto again
 	default:
 		return nil, fmt.Errorf("unsupported control message: %T", ctrl)
 	}
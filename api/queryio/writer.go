@@ -6,6 +6,7 @@ import (
 
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/runtime/profile"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/anyio"
@@ -74,6 +75,10 @@ func (w *Writer) WriteProgress(stats sbuf.Progress) error {
 	return w.WriteControl(v)
 }
 
+func (w *Writer) WriteProfile(r *profile.Report) error {
+	return w.WriteControl(api.QueryProfile{Report: *r})
+}
+
 func (w *Writer) WriteError(err error) {
 	w.WriteControl(api.QueryError{Error: err.Error()})
 }
@@ -45,6 +45,10 @@ func (f *Flags) setFlags(fs *flag.FlagSet) {
 	fs.BoolVar(&f.BSUP.Compress, "bsup.compress", true, "compress Super Binary frames")
 	fs.IntVar(&f.BSUP.FrameThresh, "bsup.framethresh", bsupio.DefaultFrameThresh,
 		"minimum Super Binary frame size in uncompressed bytes")
+	fs.BoolVar(&f.CSUP.BloomFilters, "csup.bloom", false, "add Bloom filters to CSUP metadata to skip objects in equality lookups")
+	fs.BoolVar(&f.CSUP.Sketches, "csup.sketch", false, "add distinct-value sketches to CSUP metadata for join planning")
+	fs.StringVar(&f.Compression, "compress", "",
+		"compress output with this codec [gzip,zstd,none] (default inferred from -o extension)")
 	fs.BoolVar(&f.color, "color", true, "enable/disable color formatting for -S and db text output")
 	fs.BoolVar(&f.CSV.NoHeader, "noheader", false, "omit header for CSV and TSV output")
 	fs.StringVar(&f.supPersist, "persist", "",
@@ -73,7 +77,7 @@ func (f *Flags) SetFormatFlags(fs *flag.FlagSet) {
 	if f.DefaultFormat == "" {
 		f.DefaultFormat = "bsup"
 	}
-	fs.StringVar(&f.Format, "f", f.DefaultFormat, "format for output data [arrows,bsup,csup,csv,db,json,jsup,line,parquet,sup,table,tsv,zeek]")
+	fs.StringVar(&f.Format, "f", f.DefaultFormat, "format for output data [arrows,avro,bsup,csup,csv,db,json,jsup,line,parquet,sup,table,tsv,zeek]")
 	fs.BoolVar(&f.forceBinary, "B", false, "allow Super Binary to be sent to a terminal output")
 	fs.BoolVar(&f.jsonPretty, "J", false, "use formatted JSON output independent of -f option")
 	fs.BoolVar(&f.jsonShortcut, "j", false, "use line-oriented JSON output independent of -f option")
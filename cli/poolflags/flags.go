@@ -13,7 +13,7 @@ type Flags struct {
 
 func (l *Flags) SetFlags(fs *flag.FlagSet) {
 	defaultHead, _ := readHead()
-	fs.StringVar(&l.defaultHead, "use", defaultHead, "commit to use, i.e., pool, pool@branch, or pool@commit")
+	fs.StringVar(&l.defaultHead, "use", defaultHead, "commit to use, i.e., pool, pool@branch, pool@tag, pool@commit, or pool@time")
 }
 
 func (f *Flags) HEAD() (*dbid.Commitish, error) {
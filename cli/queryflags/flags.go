@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/sbuf"
@@ -12,6 +13,7 @@ import (
 
 type QueryTextFlags struct {
 	Query     []srcfiles.Input
+	Params    ParamsFlag
 	includes  FileInput
 	dashCArgs PlainInput
 }
@@ -26,6 +28,7 @@ func (q *QueryTextFlags) SetFlags(fs *flag.FlagSet) {
 	q.dashCArgs.inputs = &q.Query
 	fs.Var(&q.dashCArgs, "c", "query text (may be used multiple times)")
 	fs.Var(&q.includes, "I", "source file containing query text (may be used multiple times)")
+	fs.Var(&q.Params, "param", "bind query parameter in the form name=value where value is SUP (may be used multiple times)")
 }
 
 func (f *Flags) SetFlags(fs *flag.FlagSet) {
@@ -68,3 +71,22 @@ func (f *FileInput) Set(value string) error {
 func (FileInput) String() string {
 	return ""
 }
+
+// ParamsFlag is a flag.Value for query parameters of the form name=value.
+type ParamsFlag map[string]string
+
+func (p *ParamsFlag) Set(value string) error {
+	name, val, ok := strings.Cut(value, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("query parameter must be in the form name=value: %q", value)
+	}
+	if *p == nil {
+		*p = make(ParamsFlag)
+	}
+	(*p)[strings.TrimPrefix(name, "$")] = val
+	return nil
+}
+
+func (p ParamsFlag) String() string {
+	return ""
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/brimdata/super/runtime/sam/expr/agg"
 	"github.com/brimdata/super/runtime/sam/op/fuse"
 	"github.com/brimdata/super/runtime/sam/op/sort"
+	vamop "github.com/brimdata/super/runtime/vam/op"
+	vamaggregate "github.com/brimdata/super/runtime/vam/op/aggregate"
 	"github.com/pbnjay/memory"
 )
 
@@ -39,9 +41,11 @@ type EngineFlags struct {
 
 type Flags struct {
 	// these memory limits should be based on a shared resource model
-	aggMemMax  auto.Bytes
-	sortMemMax auto.Bytes
-	fuseMemMax auto.Bytes
+	aggMemMax   auto.Bytes
+	sortMemMax  auto.Bytes
+	fuseMemMax  auto.Bytes
+	joinMemMax  auto.Bytes
+	groupMemMax auto.Bytes
 	EngineFlags
 }
 
@@ -58,6 +62,10 @@ func (e *Flags) SetFlags(fs *flag.FlagSet) {
 	fs.Var(&e.sortMemMax, "sortmem", "maximum memory used by sort in MiB, MB, etc")
 	e.fuseMemMax = auto.NewBytes(def)
 	fs.Var(&e.fuseMemMax, "fusemem", "maximum memory used by fuse in MiB, MB, etc")
+	e.joinMemMax = auto.NewBytes(def)
+	fs.Var(&e.joinMemMax, "joinmem", "maximum memory used by hash join tables in MiB, MB, etc")
+	e.groupMemMax = auto.NewBytes(def)
+	fs.Var(&e.groupMemMax, "groupmem", "maximum memory used by vector aggregate group tables in MiB, MB, etc")
 	e.EngineFlags.SetFlags(fs)
 }
 
@@ -80,6 +88,14 @@ func (e *Flags) Init() error {
 		return errors.New("fusemem value must be greater than zero")
 	}
 	fuse.MemMaxBytes = int(e.fuseMemMax.Bytes)
+	if e.joinMemMax.Bytes <= 0 {
+		return errors.New("joinmem value must be greater than zero")
+	}
+	vamop.HashJoinMemMaxBytes = int(e.joinMemMax.Bytes)
+	if e.groupMemMax.Bytes <= 0 {
+		return errors.New("groupmem value must be greater than zero")
+	}
+	vamaggregate.MemMaxBytes = int(e.groupMemMax.Bytes)
 	if e.sam && e.vam {
 		return errors.New("sam and vam flags cannot both be enabled")
 	}
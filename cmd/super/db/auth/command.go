@@ -18,9 +18,12 @@ See https://superdb.org/command/db.html#super-db-auth
 }
 
 func init() {
+	spec.Add(Grant)
+	spec.Add(Grants)
 	spec.Add(Login)
 	spec.Add(Logout)
 	spec.Add(Method)
+	spec.Add(Revoke)
 	spec.Add(Store)
 	spec.Add(Verify)
 	db.Spec.Add(spec)
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var Grant = &charm.Spec{
+	Name:  "grant",
+	Usage: "auth grant role permission pool",
+	Short: "grant a permission on a pool to a role",
+	Long: `
+The auth grant command gives the members of a role a permission on a pool,
+replacing any permission previously granted to the role on the pool.
+The permission is one of "read", "load", "delete", or "admin", each of
+which includes the permissions that precede it, and the pool is a pool
+name or ID or "*" for all pools.
+
+See https://superdb.org/command/db.html#super-db-auth
+`,
+	New: NewGrant,
+}
+
+type GrantCommand struct {
+	*Command
+}
+
+func NewGrant(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &GrantCommand{Command: parent.(*Command)}, nil
+}
+
+func (c *GrantCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 3 {
+		return errors.New("grant command requires a role, a permission, and a pool")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	role, perm, pool := args[0], args[1], args[2]
+	if err := db.Grant(ctx, pool, role, perm); err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		fmt.Printf("granted %s on %s to %s\n", perm, pool, role)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sup"
+)
+
+var Grants = &charm.Spec{
+	Name:  "grants",
+	Usage: "auth grants [options]",
+	Short: "list the permissions on pools granted to roles",
+	Long: `
+The auth grants command lists the permissions granted to roles.  When
+connected to a database service, only the grants on pools for which the
+user has admin permission are listed.
+
+See https://superdb.org/command/db.html#super-db-auth
+`,
+	New: NewGrants,
+}
+
+type GrantsCommand struct {
+	*Command
+	outputFlags outputflags.Flags
+}
+
+func NewGrants(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &GrantsCommand{Command: parent.(*Command)}
+	c.outputFlags.DefaultFormat = "table"
+	c.outputFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *GrantsCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.outputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 0 {
+		return errors.New("grants command takes no arguments")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	grants, err := db.Grants(ctx)
+	if err != nil {
+		return err
+	}
+	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		return err
+	}
+	m := sup.NewBSUPMarshaler()
+	for _, g := range grants {
+		val, err := m.Marshal(g)
+		if err != nil {
+			w.Close()
+			return err
+		}
+		if err := w.Write(val); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	return w.Close()
+}
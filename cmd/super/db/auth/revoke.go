@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var Revoke = &charm.Spec{
+	Name:  "revoke",
+	Usage: "auth revoke role pool",
+	Short: "revoke the permission on a pool granted to a role",
+	Long: `
+The auth revoke command removes the permission granted to a role on a pool,
+which is a pool name or ID or "*" for all pools.
+
+See https://superdb.org/command/db.html#super-db-auth
+`,
+	New: NewRevoke,
+}
+
+type RevokeCommand struct {
+	*Command
+}
+
+func NewRevoke(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &RevokeCommand{Command: parent.(*Command)}, nil
+}
+
+func (c *RevokeCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 2 {
+		return errors.New("revoke command requires a role and a pool")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	role, pool := args[0], args[1]
+	if err := db.Revoke(ctx, pool, role); err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		fmt.Printf("revoked %s on %s\n", role, pool)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package contract
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/db/api"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var spec = &charm.Spec{
+	Name:  "contract",
+	Usage: "contract [-type type] [-mode mode] [-quarantine branch] [-shaper expr] [-off] pool",
+	Short: "show or set the schema contract of a data pool",
+	Long: `
+The contract command sets the schema contract of a pool, which constrains
+the values loaded into the pool to a type, after shaping them with an
+expression if a shaper is given.  With no options, it shows the contract.
+
+See https://superdb.org/command/db.html#super-db-contract
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+	contract pools.Contract
+	off      bool
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	f.StringVar(&c.contract.Type, "type", "", "type to which loaded values must conform")
+	f.StringVar(&c.contract.Mode, "mode", pools.ContractStrict, "enforcement mode (strict, coerce, or quarantine)")
+	f.StringVar(&c.contract.Quarantine, "quarantine", pools.DefaultQuarantineBranch, "branch for values not conforming in quarantine mode")
+	f.StringVar(&c.contract.Shaper, "shaper", "", "expression applied to each loaded value before checking its type")
+	f.BoolVar(&c.off, "off", false, "remove the contract")
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 1 {
+		return errors.New("a pool name must be provided")
+	}
+	if c.off && !c.contract.IsZero() {
+		return errors.New("-off cannot be used with -type or -shaper")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	poolID, err := db.PoolID(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if !c.off && c.contract.IsZero() {
+		pool, err := api.LookupPoolByID(ctx, db, poolID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: %s\n", pool.Name, pool.Contract)
+		return nil
+	}
+	if c.off {
+		c.contract = pools.Contract{}
+	}
+	if err := db.SetPoolContract(ctx, poolID, c.contract); err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		if c.off {
+			fmt.Printf("%s: contract removed\n", args[0])
+		} else {
+			fmt.Printf("%s: contract set\n", args[0])
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/brimdata/super/cli/poolflags"
 	"github.com/brimdata/super/cmd/super/db"
 	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/charm"
 	"github.com/brimdata/super/pkg/units"
@@ -30,6 +31,7 @@ type Command struct {
 	thresh     units.Bytes
 	seekStride units.Bytes
 	use        bool
+	vector     pools.Vector
 }
 
 func init() {
@@ -45,6 +47,8 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c.thresh = data.DefaultThreshold
 	f.Var(&c.thresh, "S", "target size of pool data objects, as '10MB' or '4GiB', etc.")
 	f.BoolVar(&c.use, "use", false, "set created pool as the current pool")
+	f.BoolVar(&c.vector.BloomFilters, "bloom", false, "add Bloom filters to vector objects to skip data objects in equality lookups")
+	f.BoolVar(&c.vector.Sketches, "sketch", false, "add distinct-value sketches to vector objects for join planning")
 	f.StringVar(&c.sortKey, "orderby", "ts:desc", "pool key with optional :asc or :desc suffix to organize data in pool (cannot be changed)")
 	return c, nil
 }
@@ -67,7 +71,7 @@ func (c *Command) Run(args []string) error {
 		return err
 	}
 	poolName := args[0]
-	id, err := db.CreatePool(ctx, poolName, sortKey, int(c.seekStride), int64(c.thresh))
+	id, err := db.CreatePool(ctx, poolName, sortKey, int(c.seekStride), int64(c.thresh), c.vector)
 	if err != nil {
 		return err
 	}
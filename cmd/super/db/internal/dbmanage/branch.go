@@ -36,6 +36,9 @@ func newBranch(c Config, pool *pools.Config, db dbapi.Interface, logger *zap.Log
 }
 
 func (b *branch) run(ctx context.Context) error {
+	if err := b.expire(ctx); err != nil {
+		return err
+	}
 	b.logger.Debug("compaction started")
 	head := dbid.Commitish{Pool: b.pool.Name, Branch: b.config.Branch}
 	it, err := newObjectIterator(ctx, b.db, &head)
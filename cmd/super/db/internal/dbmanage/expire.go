@@ -0,0 +1,229 @@
+package dbmanage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/compiler/srcfiles"
+	dbapi "github.com/brimdata/super/db/api"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/dbid"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/plural"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/sup"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxMessageObjects is the maximum number of objects listed in the
+	// message of an expiration commit.
+	maxMessageObjects = 10
+	// expireAuthor is the author of expiration commits.
+	expireAuthor = "db manage"
+)
+
+// expire deletes the data objects of the branch that fall outside the
+// retention policy of the pool in a single commit whose message records
+// the policy and the key range of each object deleted.
+func (b *branch) expire(ctx context.Context) error {
+	retention := b.pool.Retention
+	if retention.IsZero() {
+		return nil
+	}
+	head := dbid.Commitish{Pool: b.pool.Name, Branch: b.config.Branch}
+	it, err := newObjectIterator(ctx, b.db, &head)
+	if err != nil {
+		return err
+	}
+	var objects []*object
+	for {
+		o, err := it.next()
+		if err != nil {
+			it.close()
+			return err
+		}
+		if o == nil {
+			break
+		}
+		objects = append(objects, o)
+	}
+	if err := it.close(); err != nil {
+		return err
+	}
+	var added map[ksuid.KSUID]nano.Ts
+	if retention.MaxAge > 0 {
+		if added, err = cachedAddedTimes(ctx, b.db, b.pool, b.config.Branch); err != nil {
+			return err
+		}
+	}
+	expired := expiredObjects(objects, added, retention, nano.Now())
+	if len(expired) == 0 {
+		return nil
+	}
+	ids := make([]ksuid.KSUID, 0, len(expired))
+	for _, o := range expired {
+		ids = append(ids, o.ID)
+	}
+	message := api.CommitMessage{
+		Author: expireAuthor,
+		Body:   expireMessage(expired, retention),
+	}
+	commit, err := b.db.Delete(ctx, b.pool.ID, b.config.Branch, ids, message)
+	if err != nil {
+		return err
+	}
+	b.logger.Info("expired",
+		zap.Stringer("commit", commit),
+		zap.Stringer("retention", retention),
+		zap.Int("objects_expired", len(ids)),
+	)
+	return nil
+}
+
+// expiredObjects returns the objects outside of retention at time now,
+// where added holds the time of the commit that added each object.  An
+// object is outside the keep window when its maximum key is a time at or
+// before now minus the window and is too old when it was added at or
+// before now minus the maximum age.  Then, while the remaining objects
+// exceed the maximum size, the objects with the smallest maximum keys are
+// expired.
+func expiredObjects(objects []*object, added map[ksuid.KSUID]nano.Ts, retention pools.Retention, now nano.Ts) []*object {
+	var expired, kept []*object
+	for _, o := range objects {
+		if retention.Keep > 0 && outsideWindow(o.Max, now.Sub(retention.Keep)) {
+			expired = append(expired, o)
+		} else if ts, ok := added[o.ID]; ok && retention.MaxAge > 0 && ts <= now.Sub(retention.MaxAge) {
+			expired = append(expired, o)
+		} else {
+			kept = append(kept, o)
+		}
+	}
+	if retention.MaxSize > 0 {
+		var size int64
+		for _, o := range kept {
+			size += o.Size
+		}
+		cmp := expr.NewValueCompareFn(order.Asc, order.NullsLast)
+		slices.SortStableFunc(kept, func(a, b *object) int {
+			return cmp(a.Max, b.Max)
+		})
+		for len(kept) > 0 && size > retention.MaxSize {
+			expired = append(expired, kept[0])
+			size -= kept[0].Size
+			kept = kept[1:]
+		}
+	}
+	return expired
+}
+
+// addedQuery joins the Add actions of the journal of a branch with their
+// commits to find the time each data object was first added.  The pool
+// name is bound to $pool so it need not be quoted.
+const addedQuery = `
+from f"{$pool}"@%s:rawlog
+| where has(object)
+| values {id:object.id, commit}
+| join (
+    from f"{$pool}"@%s:rawlog
+    | where has(date)
+    | values {commit:id, date}
+  ) using (commit)
+| aggregate date:=min(right.date) by id:=left.id
+`
+
+// addedCache holds the result of addedTimes for each branch along with
+// the commit at the head of the branch when it was computed so that the
+// journal is scanned again only after the branch changes.
+var addedCache = struct {
+	sync.Mutex
+	branches map[string]addedEntry
+}{branches: make(map[string]addedEntry)}
+
+type addedEntry struct {
+	head  ksuid.KSUID
+	added map[ksuid.KSUID]nano.Ts
+}
+
+// cachedAddedTimes returns addedTimes for branch of pool, reusing the
+// result of the last call if the head of the branch has not moved.
+func cachedAddedTimes(ctx context.Context, db dbapi.Interface, pool *pools.Config, branch string) (map[ksuid.KSUID]nano.Ts, error) {
+	head, err := db.CommitObject(ctx, pool.ID, branch)
+	if err != nil {
+		return nil, err
+	}
+	key := pool.ID.String() + "@" + branch
+	addedCache.Lock()
+	entry, ok := addedCache.branches[key]
+	addedCache.Unlock()
+	if ok && entry.head == head {
+		return entry.added, nil
+	}
+	added, err := addedTimes(ctx, db, pool.Name, branch)
+	if err != nil {
+		return nil, err
+	}
+	addedCache.Lock()
+	addedCache.branches[key] = addedEntry{head, added}
+	addedCache.Unlock()
+	return added, nil
+}
+
+// addedTimes returns the time of the earliest commit in the journal of
+// branch that added each data object.  Since objects are rewritten when
+// they are compacted, this is the time of the commit that added the
+// values if they have not been compacted since.
+func addedTimes(ctx context.Context, db dbapi.Interface, pool, branch string) (map[ksuid.KSUID]nano.Ts, error) {
+	query := fmt.Sprintf(addedQuery, sup.QuotedName(branch), sup.QuotedName(branch))
+	params := map[string]string{"pool": sup.QuotedString(pool)}
+	q, err := db.Query(ctx, srcfiles.Plain(query), params)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Pull(true)
+	reader := sbuf.PullerReader(q)
+	unmarshaler := sup.NewBSUPUnmarshaler()
+	added := make(map[ksuid.KSUID]nano.Ts)
+	for {
+		val, err := reader.Read()
+		if val == nil || err != nil {
+			return added, err
+		}
+		var rec struct {
+			ID   ksuid.KSUID `super:"id"`
+			Date nano.Ts     `super:"date"`
+		}
+		if err := unmarshaler.Unmarshal(*val, &rec); err != nil {
+			return nil, err
+		}
+		added[rec.ID] = rec.Date
+	}
+}
+
+func outsideWindow(max super.Value, cutoff nano.Ts) bool {
+	if max.Type() != super.TypeTime || max.IsNull() {
+		return false
+	}
+	return super.DecodeTime(max.Bytes()) <= cutoff
+}
+
+func expireMessage(objects []*object, retention pools.Retention) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "expired %d data object%s by retention policy: %s\n\n", len(objects), plural.Slice(objects, "s"), retention)
+	for k, o := range objects {
+		if k == maxMessageObjects {
+			fmt.Fprintf(&b, "  ... and %d more\n", len(objects)-k)
+			break
+		}
+		fmt.Fprintf(&b, "  %s min %s max %s\n", o.ID, sup.FormatValue(o.Min), sup.FormatValue(o.Max))
+	}
+	return b.String()
+}
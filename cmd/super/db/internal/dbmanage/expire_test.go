@@ -0,0 +1,46 @@
+package dbmanage
+
+import (
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiredObjects(t *testing.T) {
+	now := nano.Ts(100 * nano.Day)
+	newObject := func(key int64, size int64) *object {
+		return &object{Object: data.Object{
+			ID:   ksuid.New(),
+			Min:  super.NewInt64(key),
+			Max:  super.NewInt64(key),
+			Size: size,
+		}}
+	}
+	ids := func(objects []*object) []ksuid.KSUID {
+		var out []ksuid.KSUID
+		for _, o := range objects {
+			out = append(out, o.ID)
+		}
+		return out
+	}
+	a, b, c := newObject(3, 10), newObject(1, 10), newObject(2, 10)
+	objects := []*object{a, b, c}
+	// The pool key is not a time, so only MaxAge and MaxSize apply.
+	added := map[ksuid.KSUID]nano.Ts{
+		a.ID: now.Sub(40 * nano.Day),
+		b.ID: now.Sub(30 * nano.Day),
+		c.ID: now.Sub(nano.Day),
+	}
+	assert.Empty(t, expiredObjects(objects, added, pools.Retention{Keep: nano.Day}, now))
+	assert.Equal(t, ids([]*object{a, b}), ids(expiredObjects(objects, added, pools.Retention{MaxAge: 30 * nano.Day}, now)))
+	assert.Equal(t, ids([]*object{a}), ids(expiredObjects(objects, added, pools.Retention{MaxAge: 31 * nano.Day}, now)))
+	// MaxSize applies to the objects within MaxAge.
+	assert.Equal(t, ids([]*object{a, b}), ids(expiredObjects(objects, added, pools.Retention{MaxAge: 31 * nano.Day, MaxSize: 15}, now)))
+	// Objects whose commit is unknown are kept.
+	assert.Empty(t, expiredObjects(objects, nil, pools.Retention{MaxAge: nano.Day}, now))
+}
@@ -19,11 +19,13 @@ import (
 	"github.com/brimdata/super/cmd/super/db"
 	"github.com/brimdata/super/pkg/charm"
 	"github.com/brimdata/super/pkg/display"
+	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/pkg/units"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/anyio"
 	"github.com/paulbellamy/ratecounter"
+	"github.com/segmentio/ksuid"
 	"golang.org/x/term"
 )
 
@@ -47,6 +49,7 @@ type Command struct {
 	inputFlags   inputflags.Flags
 	poolFlags    poolflags.Flags
 	runtimeFlags runtimeflags.Flags
+	upsertKey    string
 
 	// status output
 	ctx       context.Context
@@ -61,6 +64,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c.inputFlags.SetFlags(f, true)
 	c.poolFlags.SetFlags(f)
 	c.runtimeFlags.SetFlags(f)
+	f.StringVar(&c.upsertKey, "upsert-key", "", "replace values of the branch having the same value of this field as a loaded value")
 	return c, nil
 }
 
@@ -104,7 +108,12 @@ func (c *Command) Run(args []string) error {
 		go d.Run()
 	}
 	message := c.commitFlags.CommitMessage()
-	commitID, err := db.Load(ctx, sctx, poolID, head.Branch, sio.ConcatReader(readers...), message)
+	var commitID ksuid.KSUID
+	if c.upsertKey != "" {
+		commitID, err = db.Upsert(ctx, sctx, poolID, head.Branch, sio.ConcatReader(readers...), field.Dotted(c.upsertKey), message)
+	} else {
+		commitID, err = db.Load(ctx, sctx, poolID, head.Branch, sio.ConcatReader(readers...), message)
+	}
 	if d != nil {
 		d.Close()
 	}
@@ -9,10 +9,10 @@ import (
 	"github.com/brimdata/super/cli/outputflags"
 	"github.com/brimdata/super/cmd/super/db"
 	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/pkg/charm"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/sbuf"
-	"github.com/segmentio/ksuid"
 )
 
 var spec = &charm.Spec{
@@ -39,6 +39,7 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 	c := &Command{Command: parent.(*db.Command)}
 	c.outputFlags.DefaultFormat = "db"
 	c.outputFlags.SetFlags(f)
+	f.StringVar(&c.at, "at", "", "list branches as of this time")
 	return c, nil
 }
 
@@ -63,27 +64,28 @@ func (c *Command) Run(args []string) error {
 	}
 	var query string
 	if poolName == "" {
+		if c.at != "" {
+			return errors.New("-at requires a pool")
+		}
 		query = "from :pools"
 	} else {
 		if strings.IndexByte(poolName, '\'') >= 0 {
 			return errors.New("pool name may not contain quote characters")
 		}
-		query = fmt.Sprintf("from '%s':branches", poolName)
-	}
-	//XXX at should be a date/time
-	var at ksuid.KSUID
-	if c.at != "" {
-		at, err = ksuid.Parse(c.at)
-		if err != nil {
-			return err
+		if c.at == "" {
+			query = fmt.Sprintf("from '%s':branches", poolName)
+		} else {
+			if _, err := dbid.ParseTime(c.at); err != nil {
+				return err
+			}
+			query = fmt.Sprintf("from '%s'@'%s':branches", poolName, c.at)
 		}
-		query = fmt.Sprintf("%s at %s", query, at)
 	}
 	w, err := c.outputFlags.Open(ctx, local)
 	if err != nil {
 		return err
 	}
-	q, err := db.Query(ctx, srcfiles.Plain(query))
+	q, err := db.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		w.Close()
 		return err
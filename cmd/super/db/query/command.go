@@ -0,0 +1,39 @@
+package query
+
+import (
+	"flag"
+
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var spec = &charm.Spec{
+	Name:  "query",
+	Usage: "query [subcommand]",
+	Short: "list and kill queries running on a database service",
+	Long: `
+The query subcommands list the queries running on a database service
+and cancel them.  They require a remote database.
+
+See https://superdb.org/command/db.html#super-db-query
+`,
+	New: New,
+}
+
+func init() {
+	spec.Add(ls)
+	spec.Add(kill)
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &Command{Command: parent.(*db.Command)}, nil
+}
+
+func (c *Command) Run(args []string) error {
+	return charm.NoRun(args)
+}
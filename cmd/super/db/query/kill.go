@@ -0,0 +1,53 @@
+package query
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var kill = &charm.Spec{
+	Name:  "kill",
+	Usage: "query kill request-id [request-id ...]",
+	Short: "cancel queries running on a database service",
+	Long: `
+The query kill command cancels each query running on a database service
+with one of the given request IDs, which are listed by "super db query ls".
+The client of a canceled query receives a "query killed" error.
+`,
+	New: newKill,
+}
+
+type killCommand struct {
+	*Command
+}
+
+func newKill(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &killCommand{Command: parent.(*Command)}, nil
+}
+
+func (c *killCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) == 0 {
+		return errors.New("query kill command requires one or more request IDs")
+	}
+	conn, err := c.DBFlags.Connection()
+	if err != nil {
+		return err
+	}
+	for _, id := range args {
+		if err := conn.KillQuery(ctx, id); err != nil {
+			return fmt.Errorf("%s: %w", id, err)
+		}
+		if !c.DBFlags.Quiet {
+			fmt.Printf("%s killed\n", id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package query
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sup"
+)
+
+var ls = &charm.Spec{
+	Name:  "ls",
+	Usage: "query ls [options]",
+	Short: "list queries running on a database service",
+	Long: `
+The query ls command lists the queries running on a database service
+along with the request ID, user identity, and start time of each
+and the progress each has made.  A listed request ID may be given to
+"super db query kill" to cancel its query.
+`,
+	New: newLs,
+}
+
+type lsCommand struct {
+	*Command
+	outputFlags outputflags.Flags
+}
+
+func newLs(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &lsCommand{Command: parent.(*Command)}
+	c.outputFlags.DefaultFormat = "table"
+	c.outputFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *lsCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.outputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 0 {
+		return errors.New("query ls command takes no arguments")
+	}
+	conn, err := c.DBFlags.Connection()
+	if err != nil {
+		return err
+	}
+	infos, err := conn.RunningQueries(ctx)
+	if err != nil {
+		return err
+	}
+	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		return err
+	}
+	m := sup.NewBSUPMarshaler()
+	for _, info := range infos {
+		val, err := m.Marshal(info)
+		if err != nil {
+			w.Close()
+			return err
+		}
+		if err := w.Write(val); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	return w.Close()
+}
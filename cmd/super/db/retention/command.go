@@ -0,0 +1,114 @@
+package retention
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/db/api"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/units"
+)
+
+var spec = &charm.Spec{
+	Name:  "retention",
+	Usage: "retention [-keep duration] [-maxsize size] [-maxage duration] pool",
+	Short: "show or set the retention policy of a data pool",
+	Long: `
+The retention command sets the retention policy of a pool, which is
+enforced by "super db manage".  With no options, it shows the policy.
+
+See https://superdb.org/command/db.html#super-db-retention
+`,
+	New: New,
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+	set       bool
+	retention pools.Retention
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	f.Func("keep", "keep values whose pool key is within duration of now, as '90d' or '12h', etc. (0 to disable)", func(s string) error {
+		d, err := parseDuration(s)
+		c.retention.Keep = d
+		c.set = true
+		return err
+	})
+	f.Func("maxsize", "maximum size of a branch's data objects, as '10GB' or '4GiB', etc. (0 to disable)", func(s string) error {
+		var size units.Bytes
+		if s != "0" {
+			if err := size.Set(s); err != nil {
+				return err
+			}
+		}
+		c.retention.MaxSize = int64(size)
+		c.set = true
+		return nil
+	})
+	f.Func("maxage", "keep data objects added by commits within duration of now, as '30d' or '12h', etc. (0 to disable)", func(s string) error {
+		d, err := parseDuration(s)
+		c.retention.MaxAge = d
+		c.set = true
+		return err
+	})
+	return c, nil
+}
+
+// parseDuration parses a non-negative duration where "0" disables a rule.
+func parseDuration(s string) (nano.Duration, error) {
+	if s == "0" {
+		return 0, nil
+	}
+	d, err := nano.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, errors.New("duration cannot be negative")
+	}
+	return d, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 1 {
+		return errors.New("a pool name must be provided")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	poolID, err := db.PoolID(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if !c.set {
+		pool, err := api.LookupPoolByID(ctx, db, poolID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s: %s\n", pool.Name, pool.Retention)
+		return nil
+	}
+	if err := db.SetPoolRetention(ctx, poolID, c.retention); err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		fmt.Printf("%s: retention set to %s\n", args[0], c.retention)
+	}
+	return nil
+}
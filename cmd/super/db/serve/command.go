@@ -20,6 +20,8 @@ import (
 	"github.com/brimdata/super/pkg/charm"
 	"github.com/brimdata/super/pkg/fs"
 	"github.com/brimdata/super/pkg/httpd"
+	"github.com/brimdata/super/pkg/units"
+	"github.com/brimdata/super/runtime/vcache"
 	"github.com/brimdata/super/service"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -47,11 +49,14 @@ type Command struct {
 
 	// brimfd is a file descriptor passed through by Zui desktop. If set the
 	// command will exit if the fd is closed.
-	brimfd          int
-	listenAddr      string
-	manage          time.Duration
-	portFile        string
-	rootContentFile string
+	brimfd           int
+	flightListenAddr string
+	listenAddr       string
+	manage           time.Duration
+	pgListenAddr     string
+	portFile         string
+	rootContentFile  string
+	vcacheMaxBytes   units.Bytes
 }
 
 func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
@@ -65,10 +70,15 @@ func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
 		return nil
 	})
 	f.StringVar(&c.conf.DefaultResponseFormat, "defaultfmt", service.DefaultFormat, "default response format")
+	f.StringVar(&c.flightListenAddr, "flightsql.l", "", "[addr]:port to listen on for Arrow Flight SQL clients (disabled if empty)")
 	f.StringVar(&c.listenAddr, "l", ":9867", "[addr]:port to listen on")
 	f.DurationVar(&c.manage, "manage", 0, "when positive, run database maintenance tasks at this interval")
+	f.StringVar(&c.pgListenAddr, "pgwire.l", "", "[addr]:port to listen on for PostgreSQL clients (disabled if empty)")
 	f.StringVar(&c.portFile, "portfile", "", "write listen port to file")
 	f.StringVar(&c.rootContentFile, "rootcontentfile", "", "file to serve for GET /")
+	c.vcacheMaxBytes = vcache.DefaultMaxBytes
+	f.Var(&c.vcacheMaxBytes, "vcache.maxbytes", "memory budget for cached vector data")
+	f.DurationVar(&c.conf.VectorCache.IdleTimeout, "vcache.idletimeout", vcache.DefaultIdleTimeout, "close storage readers of cached objects idle for this long")
 	return c, nil
 }
 
@@ -105,6 +115,7 @@ func (c *Command) Run(args []string) error {
 		}
 	}
 	c.conf.Logger = logger
+	c.conf.VectorCache.MaxBytes = int64(c.vcacheMaxBytes)
 	core, err := service.NewCore(ctx, c.conf)
 	if err != nil {
 		return err
@@ -114,7 +125,28 @@ func (c *Command) Run(args []string) error {
 	if err := srv.Start(ctx); err != nil {
 		return err
 	}
+	var flightListener, pgListener net.Listener
+	if c.flightListenAddr != "" {
+		if flightListener, err = net.Listen("tcp", c.flightListenAddr); err != nil {
+			return err
+		}
+	}
+	if c.pgListenAddr != "" {
+		if pgListener, err = net.Listen("tcp", c.pgListenAddr); err != nil {
+			return err
+		}
+	}
 	group, ctx := errgroup.WithContext(ctx)
+	if flightListener != nil {
+		group.Go(func() error {
+			return core.ServeFlightSQL(ctx, flightListener)
+		})
+	}
+	if pgListener != nil {
+		group.Go(func() error {
+			return core.ServePostgres(ctx, pgListener)
+		})
+	}
 	if c.manage > 0 {
 		conn := client.NewConnectionTo("http://" + srv.Addr())
 		group.Go(func() error {
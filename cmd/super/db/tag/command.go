@@ -0,0 +1,129 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/db/api"
+	"github.com/brimdata/super/dbid"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sup"
+	"github.com/segmentio/ksuid"
+)
+
+var spec = &charm.Spec{
+	Name:  "tag",
+	Usage: "tag [options] [name]",
+	Short: "create, delete, or list tags",
+	Long: `
+The tag command creates a tag, which is an immutable name for a commit,
+for the commit of the HEAD pool and branch or of the commitish given
+with -use.  With no arguments, it lists the tags of the HEAD pool.
+
+See https://superdb.org/command/db.html#super-db-tag
+`,
+	New: New,
+}
+
+type Command struct {
+	*db.Command
+	delete      bool
+	outputFlags outputflags.Flags
+	poolFlags   poolflags.Flags
+}
+
+func init() {
+	db.Spec.Add(spec)
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &Command{Command: parent.(*db.Command)}
+	f.BoolVar(&c.delete, "d", false, "delete the tag instead of creating it")
+	c.outputFlags.DefaultFormat = "table"
+	c.outputFlags.SetFlags(f)
+	c.poolFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *Command) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.outputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 1 {
+		return errors.New("too many arguments")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.poolFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	if head.Pool == "" {
+		return errors.New("a pool name must be included: pool@commitish")
+	}
+	poolID, err := dbid.ParseID(head.Pool)
+	if err != nil {
+		poolID, err = db.PoolID(ctx, head.Pool)
+		if err != nil {
+			return err
+		}
+	}
+	if len(args) == 0 {
+		return c.list(ctx, db, poolID)
+	}
+	tagName := args[0]
+	if c.delete {
+		if err := db.RemoveTag(ctx, poolID, tagName); err != nil {
+			return err
+		}
+		if !c.DBFlags.Quiet {
+			fmt.Printf("tag deleted: %s\n", tagName)
+		}
+		return nil
+	}
+	commit, err := db.CommitObject(ctx, poolID, head.Branch)
+	if err != nil {
+		return err
+	}
+	if err := db.CreateTag(ctx, poolID, tagName, commit); err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		fmt.Printf("%q: tag created for commit %s\n", tagName, commit)
+	}
+	return nil
+}
+
+func (c *Command) list(ctx context.Context, db api.Interface, poolID ksuid.KSUID) error {
+	list, err := db.Tags(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		return err
+	}
+	m := sup.NewBSUPMarshaler()
+	for _, tag := range list {
+		val, err := m.Marshal(tag)
+		if err != nil {
+			w.Close()
+			return err
+		}
+		if err := w.Write(val); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	return w.Close()
+}
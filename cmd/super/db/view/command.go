@@ -0,0 +1,43 @@
+package view
+
+import (
+	"flag"
+
+	"github.com/brimdata/super/cmd/super/db"
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var spec = &charm.Spec{
+	Name:  "view",
+	Usage: "view [subcommand]",
+	Short: "create and manage materialized views",
+	Long: `
+The view subcommands create, list, refresh, and drop materialized views.
+A materialized view is a query over a branch of a source pool whose
+results are stored in a target pool and are maintained incrementally as
+data is committed to the branch.
+
+See https://superdb.org/command/db.html#super-db-view
+`,
+	New: New,
+}
+
+func init() {
+	spec.Add(create)
+	spec.Add(drop)
+	spec.Add(ls)
+	spec.Add(refresh)
+	db.Spec.Add(spec)
+}
+
+type Command struct {
+	*db.Command
+}
+
+func New(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &Command{Command: parent.(*db.Command)}, nil
+}
+
+func (c *Command) Run(args []string) error {
+	return charm.NoRun(args)
+}
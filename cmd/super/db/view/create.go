@@ -0,0 +1,73 @@
+package view
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/cli/poolflags"
+	"github.com/brimdata/super/dbid"
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var create = &charm.Spec{
+	Name:  "create",
+	Usage: "create [options] name query",
+	Short: "create a materialized view",
+	Long: `
+The view create command creates a materialized view named name of the
+query over the HEAD pool and branch or the pool and branch given with -use.
+The query reads the branch's data as its input and must compute its results
+with an aggregate, which may be followed by other operators.  The results
+are stored in a new pool with the name of the view.
+`,
+	New: newCreate,
+}
+
+type createCommand struct {
+	*Command
+	poolFlags poolflags.Flags
+}
+
+func newCreate(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &createCommand{Command: parent.(*Command)}
+	c.poolFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *createCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 2 {
+		return errors.New("a view name and query must be specified")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.poolFlags.HEAD()
+	if err != nil {
+		return err
+	}
+	if head.Pool == "" {
+		return errors.New("a pool name must be included: pool@branch")
+	}
+	poolID, err := dbid.ParseID(head.Pool)
+	if err != nil {
+		poolID, err = db.PoolID(ctx, head.Pool)
+		if err != nil {
+			return err
+		}
+	}
+	name := args[0]
+	if err := db.CreateView(ctx, poolID, head.Branch, name, args[1]); err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		fmt.Printf("view created: %s\n", name)
+	}
+	return nil
+}
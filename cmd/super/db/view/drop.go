@@ -0,0 +1,74 @@
+package view
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/super/pkg/charm"
+)
+
+var drop = &charm.Spec{
+	Name:  "drop",
+	Usage: "drop [options] name",
+	Short: "delete a materialized view and its pool",
+	Long: `
+The view drop command deletes the view named name along with the pool that
+holds its results.
+`,
+	New: newDrop,
+}
+
+type dropCommand struct {
+	*Command
+	force bool
+}
+
+func newDrop(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &dropCommand{Command: parent.(*Command)}
+	f.BoolVar(&c.force, "f", false, "do not prompt for confirmation")
+	return c, nil
+}
+
+func (c *dropCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 1 {
+		return errors.New("a single view name must be specified")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	name := args[0]
+	if err := c.confirm(name); err != nil {
+		return err
+	}
+	if err := db.RemoveView(ctx, name); err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		fmt.Printf("view deleted: %s\n", name)
+	}
+	return nil
+}
+
+func (c *dropCommand) confirm(name string) error {
+	if c.force {
+		return nil
+	}
+	fmt.Printf("Are you sure you want to delete view %q and its pool? There is no going back... [y|n]\n", name)
+	var input string
+	if _, err := fmt.Scanln(&input); err != nil {
+		return err
+	}
+	input = strings.ToLower(input)
+	if input == "y" || input == "yes" {
+		return nil
+	}
+	return errors.New("operation canceled")
+}
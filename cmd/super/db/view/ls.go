@@ -0,0 +1,71 @@
+package view
+
+import (
+	"errors"
+	"flag"
+
+	"github.com/brimdata/super/cli/outputflags"
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sup"
+)
+
+var ls = &charm.Spec{
+	Name:  "ls",
+	Usage: "ls [options]",
+	Short: "list materialized views",
+	Long: `
+The view ls command lists the materialized views of a database along with
+the source pool and branch of each view and the commit of the branch that
+the view's results are computed from.
+`,
+	New: newLs,
+}
+
+type lsCommand struct {
+	*Command
+	outputFlags outputflags.Flags
+}
+
+func newLs(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	c := &lsCommand{Command: parent.(*Command)}
+	c.outputFlags.DefaultFormat = "table"
+	c.outputFlags.SetFlags(f)
+	return c, nil
+}
+
+func (c *lsCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init(&c.outputFlags)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) > 0 {
+		return errors.New("ls command takes no arguments")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	views, err := db.Views(ctx)
+	if err != nil {
+		return err
+	}
+	w, err := c.outputFlags.Open(ctx, storage.NewLocalEngine())
+	if err != nil {
+		return err
+	}
+	m := sup.NewBSUPMarshaler()
+	for _, v := range views {
+		val, err := m.Marshal(v)
+		if err != nil {
+			w.Close()
+			return err
+		}
+		if err := w.Write(val); err != nil {
+			w.Close()
+			return err
+		}
+	}
+	return w.Close()
+}
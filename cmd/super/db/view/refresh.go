@@ -0,0 +1,58 @@
+package view
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/brimdata/super/pkg/charm"
+	"github.com/segmentio/ksuid"
+)
+
+var refresh = &charm.Spec{
+	Name:  "refresh",
+	Usage: "refresh name",
+	Short: "bring a materialized view up to date",
+	Long: `
+The view refresh command brings the results of the view named name up to
+date with its source branch.  Views are refreshed after each commit to
+their source branch, so refresh is needed only when a refresh after a
+commit failed.
+`,
+	New: newRefresh,
+}
+
+type refreshCommand struct {
+	*Command
+}
+
+func newRefresh(parent charm.Command, f *flag.FlagSet) (charm.Command, error) {
+	return &refreshCommand{Command: parent.(*Command)}, nil
+}
+
+func (c *refreshCommand) Run(args []string) error {
+	ctx, cleanup, err := c.Init()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	if len(args) != 1 {
+		return errors.New("a single view name must be specified")
+	}
+	db, err := c.DBFlags.Open(ctx)
+	if err != nil {
+		return err
+	}
+	commit, err := db.RefreshView(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if !c.DBFlags.Quiet {
+		if commit == ksuid.Nil {
+			fmt.Printf("view up to date: %s\n", args[0])
+		} else {
+			fmt.Printf("%s view refreshed\n", commit)
+		}
+	}
+	return nil
+}
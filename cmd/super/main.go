@@ -9,6 +9,7 @@ import (
 	_ "github.com/brimdata/super/cmd/super/db/branch"
 	_ "github.com/brimdata/super/cmd/super/db/compact"
 	_ "github.com/brimdata/super/cmd/super/db/compile"
+	_ "github.com/brimdata/super/cmd/super/db/contract"
 	_ "github.com/brimdata/super/cmd/super/db/create"
 	_ "github.com/brimdata/super/cmd/super/db/delete"
 	_ "github.com/brimdata/super/cmd/super/db/drop"
@@ -18,13 +19,17 @@ import (
 	_ "github.com/brimdata/super/cmd/super/db/ls"
 	_ "github.com/brimdata/super/cmd/super/db/manage"
 	_ "github.com/brimdata/super/cmd/super/db/merge"
+	_ "github.com/brimdata/super/cmd/super/db/query"
 	_ "github.com/brimdata/super/cmd/super/db/rename"
+	_ "github.com/brimdata/super/cmd/super/db/retention"
 	_ "github.com/brimdata/super/cmd/super/db/revert"
 	_ "github.com/brimdata/super/cmd/super/db/serve"
+	_ "github.com/brimdata/super/cmd/super/db/tag"
 	_ "github.com/brimdata/super/cmd/super/db/use"
 	_ "github.com/brimdata/super/cmd/super/db/vacate"
 	_ "github.com/brimdata/super/cmd/super/db/vacuum"
 	_ "github.com/brimdata/super/cmd/super/db/vector"
+	_ "github.com/brimdata/super/cmd/super/db/view"
 	_ "github.com/brimdata/super/cmd/super/dev"
 	_ "github.com/brimdata/super/cmd/super/dev/csup"
 	_ "github.com/brimdata/super/cmd/super/dev/dig/frames"
@@ -19,9 +19,11 @@ import (
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/runtime/profile"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/supio"
+	"github.com/brimdata/super/sup"
 )
 
 var Super = &charm.Spec{
@@ -40,6 +42,7 @@ type Command struct {
 	// common flags
 	cli.Flags
 	// query runtime flags
+	analyze      bool
 	canon        bool
 	stopErr      bool
 	inputFlags   inputflags.Flags
@@ -59,6 +62,7 @@ func (c *Command) SetLeafFlags(f *flag.FlagSet) {
 	c.inputFlags.SetFlags(f, false)
 	c.queryFlags.SetFlags(f)
 	c.runtimeFlags.SetFlags(f)
+	f.BoolVar(&c.analyze, "analyze", false, "display per-operator runtime profile (EXPLAIN ANALYZE) on stderr")
 	f.BoolVar(&c.canon, "C", false, "display parsed AST in a textual format")
 	f.BoolVar(&c.stopErr, "e", true, "stop upon input errors")
 }
@@ -79,6 +83,8 @@ func (c *Command) Run(args []string) error {
 	if err != nil {
 		return err
 	}
+	ast.BindParams(c.queryFlags.Params)
+	ast.SetExplainAnalyze(c.analyze)
 	if c.canon {
 		fmt.Println(sfmt.AST(ast.Parsed()))
 		return nil
@@ -111,5 +117,23 @@ func (c *Command) Run(args []string) error {
 		err = closeErr
 	}
 	c.queryFlags.PrintStats(query.Progress())
+	if err == nil && c.analyze {
+		err = printProfile(query.Profile().Report())
+	}
 	return err
 }
+
+// printProfile displays the annotated DAG of r followed by a record holding
+// the statistics of each operator.
+func printProfile(r *profile.Report) error {
+	rec := struct {
+		Operators []profile.Stats `super:"operators"`
+	}{r.Operators}
+	out, err := sup.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stderr, r.DAG)
+	fmt.Fprintln(os.Stderr, out)
+	return nil
+}
@@ -133,6 +133,15 @@ type (
 		Entries []MapEntry `json:"entries"`
 		Loc     `json:"loc"`
 	}
+	// A ParamExpr is a query parameter written as "$name" or as a
+	// positional "?".  The parser numbers the positional parameters of a
+	// query from 1 in order of appearance so that Name refers to the same
+	// parameter as "$1", "$2", and so on.
+	ParamExpr struct {
+		Kind string `json:"kind" unpack:""`
+		Name string `json:"name"`
+		Loc  `json:"loc"`
+	}
 	RecordExpr struct {
 		Kind  string       `json:"kind" unpack:""`
 		Elems []RecordElem `json:"elems"`
@@ -196,6 +205,19 @@ type (
 		Operand Expr   `json:"operand"`
 		Loc     `json:"loc"`
 	}
+	// A WindowExpr is a call to an aggregate or window function with an
+	// OVER clause, which computes the function over the rows of the
+	// partition that are in the window frame of each row.  Name is set
+	// when the OVER clause refers to a window of the WINDOW clause.
+	WindowExpr struct {
+		Kind        string       `json:"kind" unpack:""`
+		Func        Expr         `json:"func"`
+		Name        *ID          `json:"name"`
+		PartitionBy []Expr       `json:"partition_by"`
+		OrderBy     []SortExpr   `json:"order_by"`
+		Frame       *WindowFrame `json:"frame"`
+		Loc         `json:"loc"`
+	}
 )
 
 // Support structures embedded in Expr nodes
@@ -211,6 +233,19 @@ type MapEntry struct {
 	Loc   `json:"loc"`
 }
 
+type WindowFrame struct {
+	Unit  string      `json:"unit"`
+	Start WindowBound `json:"start"`
+	End   WindowBound `json:"end"`
+	Loc   `json:"loc"`
+}
+
+type WindowBound struct {
+	Type   string `json:"type"`
+	Offset Expr   `json:"offset"`
+	Loc    `json:"loc"`
+}
+
 type When struct {
 	Cond Expr `json:"expr"`
 	Then Expr `json:"else"`
@@ -293,6 +328,7 @@ func (*IndexExpr) exprNode()       {}
 func (*IsNullExpr) exprNode()      {}
 func (*LambdaExpr) exprNode()      {}
 func (*MapExpr) exprNode()         {}
+func (*ParamExpr) exprNode()       {}
 func (*Primitive) exprNode()       {}
 func (*RecordExpr) exprNode()      {}
 func (*RegexpExpr) exprNode()      {}
@@ -306,3 +342,4 @@ func (*TypeValue) exprNode()       {}
 func (*UnaryExpr) exprNode()       {}
 func (*SubqueryExpr) exprNode()    {}
 func (*SubstringExpr) exprNode()   {}
+func (*WindowExpr) exprNode()      {}
@@ -225,6 +225,15 @@ type (
 		Expr Expr   `json:"expr"`
 		Loc  `json:"loc"`
 	}
+	// A WindowOp computes the window functions Funcs for each value of
+	// its input over the sequence of values in the same partition, as
+	// determined by Keys, in input order.
+	WindowOp struct {
+		Kind  string       `json:"kind" unpack:""`
+		Funcs []Assignment `json:"funcs"`
+		Keys  []Expr       `json:"keys"`
+		Loc   `json:"loc"`
+	}
 )
 
 type (
@@ -367,5 +376,6 @@ func (*UniqOp) opNode()       {}
 func (*UnnestOp) opNode()     {}
 func (*ValuesOp) opNode()     {}
 func (*WhereOp) opNode()      {}
+func (*WindowOp) opNode()     {}
 
 func (*DefaultScan) opNode() {}
@@ -24,6 +24,7 @@ type (
 		Where     Expr         `json:"where"`
 		GroupBy   []Expr       `json:"group_by"`
 		Having    Expr         `json:"having"`
+		Windows   []SQLWindow  `json:"windows"`
 		Loc       `json:"loc"`
 	}
 	SQLUnion struct {
@@ -45,6 +46,24 @@ func (*SQLSelect) sqlQueryBodyNode() {}
 func (*SQLUnion) sqlQueryBodyNode()  {}
 func (*SQLValues) sqlQueryBodyNode() {}
 
+// A SQLGroupingSets is a GROUPING SETS, ROLLUP, or CUBE element of a GROUP BY
+// clause.  For GROUPING SETS, Sets holds the grouping sets.  For ROLLUP and
+// CUBE, each element of Sets is an expression or a parenthesized list of
+// expressions that is treated as a unit.
+type SQLGroupingSets struct {
+	Kind string           `json:"kind" unpack:""`
+	Type string           `json:"type"`
+	Sets []SQLGroupingSet `json:"sets"`
+	Loc  `json:"loc"`
+}
+
+type SQLGroupingSet struct {
+	Exprs []Expr `json:"exprs"`
+	Loc   `json:"loc"`
+}
+
+func (*SQLGroupingSets) exprNode() {}
+
 // Structure used by instances of SQLQueryBody
 
 type (
@@ -67,6 +86,13 @@ type (
 		Args []SQLAsExpr `json:"args"`
 		Loc  `json:"loc"`
 	}
+	// A SQLWindow is a window named in the WINDOW clause of a SELECT.
+	// Spec has no Func.
+	SQLWindow struct {
+		Name *ID         `json:"name"`
+		Spec *WindowExpr `json:"spec"`
+		Loc  `json:"loc"`
+	}
 	SQLWith struct {
 		Recursive bool     `json:"recursive"`
 		CTEs      []SQLCTE `json:"ctes"`
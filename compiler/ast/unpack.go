@@ -63,6 +63,7 @@ var unpacker = unpack.New(
 	MergeOp{},
 	OpDecl{},
 	OutputOp{},
+	ParamExpr{},
 	PassOp{},
 	PragmaDecl{},
 	Primitive{},
@@ -103,12 +104,15 @@ var unpacker = unpack.New(
 	UnnestOp{},
 	ValuesOp{},
 	WhereOp{},
+	WindowExpr{},
+	WindowOp{},
 	DBMeta{},
 	// SuperSQL
 	SQLFromItem{},
 	SQLPipe{},
 	SQLSelect{},
 	SQLCrossJoin{},
+	SQLGroupingSets{},
 	SQLJoin{},
 	SQLTimeExpr{},
 	SQLUnion{},
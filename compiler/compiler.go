@@ -32,7 +32,9 @@ func NewCompilerForDB(root *db.Root) runtime.Compiler {
 }
 
 func NewCompilerWithEnv(env *exec.Environment) runtime.Compiler {
-	return &compiler{env}
+	c := &compiler{env}
+	env.Compiler = c
+	return c
 }
 
 func (c *compiler) NewQuery(rctx *runtime.Context, ast *parser.AST, readers []sio.Reader, parallelism int) (runtime.Query, error) {
@@ -49,6 +49,15 @@ type (
 		LHS  Expr   `json:"lhs"`
 		RHS  Expr   `json:"rhs"`
 	}
+	// A BloomFilterExpr is a metadata pruner that is false if the Bloom
+	// filters of the metadata Expr rule out a value equal to Value.  If
+	// Search is true, Value is a search term that may also match strings.
+	BloomFilterExpr struct {
+		Kind   string `json:"kind" unpack:""`
+		Expr   Expr   `json:"expr"`
+		Value  string `json:"value"`
+		Search bool   `json:"search"`
+	}
 	CallExpr struct {
 		Kind string `json:"kind" unpack:""`
 		Tag  string `json:"tag"`
@@ -144,6 +153,7 @@ func (*AggExpr) exprNode()          {}
 func (*ArrayExpr) exprNode()        {}
 func (*BadExpr) exprNode()          {}
 func (*BinaryExpr) exprNode()       {}
+func (*BloomFilterExpr) exprNode()  {}
 func (*CallExpr) exprNode()         {}
 func (*CondExpr) exprNode()         {}
 func (*DotExpr) exprNode()          {}
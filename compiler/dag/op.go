@@ -14,6 +14,7 @@ import (
 
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/segmentio/ksuid"
 )
 
@@ -103,6 +104,9 @@ type (
 		RightAlias string `json:"right_alias"`
 		LeftKey    Expr   `json:"left_key"`
 		RightKey   Expr   `json:"right_key"`
+		// Build is "left" or "right" if the optimizer chose the input
+		// from which to build the hash table.
+		Build string `json:"build"`
 	}
 	HeadOp struct {
 		Kind  string `json:"kind" unpack:""`
@@ -185,6 +189,25 @@ type (
 		Kind  string `json:"kind" unpack:""`
 		Exprs []Expr `json:"exprs"`
 	}
+	// WindowOp computes Funcs over the partitions of its input defined by
+	// PartitionBy where each partition is ordered by OrderBy.
+	WindowOp struct {
+		Kind        string       `json:"kind" unpack:""`
+		PartitionBy []Expr       `json:"partition_by"`
+		OrderBy     []SortExpr   `json:"order_by"`
+		Funcs       []WindowFunc `json:"funcs"`
+	}
+	// StreamWindowOp computes Funcs for each value over the values of its
+	// partition given by Keys in input order.  Unlike WindowOp, it reads
+	// its input in a single pass without sorting it and preserves its
+	// order.  InputSorted is set by the optimizer when the input is
+	// sorted by the first key so that partitions end when it changes.
+	StreamWindowOp struct {
+		Kind        string       `json:"kind" unpack:""`
+		Keys        []Expr       `json:"keys"`
+		Funcs       []WindowFunc `json:"funcs"`
+		InputSorted bool         `json:"input_sorted,omitempty"`
+	}
 )
 
 // Support types for Ops.
@@ -199,37 +222,57 @@ type (
 		Expr Expr `json:"expr"`
 		Path Seq  `json:"seq"`
 	}
+	// WindowFunc assigns to LHS the result of Agg when non-nil or else of
+	// the ranking or value function Name applied to Args.
+	WindowFunc struct {
+		LHS   Expr         `json:"lhs"`
+		Name  string       `json:"name"`
+		Args  []Expr       `json:"args"`
+		Agg   *AggExpr     `json:"agg"`
+		Frame *WindowFrame `json:"frame"`
+	}
+	WindowFrame struct {
+		Unit  string      `json:"unit"`
+		Start WindowBound `json:"start"`
+		End   WindowBound `json:"end"`
+	}
+	WindowBound struct {
+		Type   string `json:"type"`
+		Offset Expr   `json:"offset"`
+	}
 )
 
-func (*AggregateOp) opNode() {}
-func (*CombineOp) opNode()   {}
-func (*CountOp) opNode()     {}
-func (*CutOp) opNode()       {}
-func (*DebugOp) opNode()     {}
-func (*DistinctOp) opNode()  {}
-func (*DropOp) opNode()      {}
-func (*FilterOp) opNode()    {}
-func (*ForkOp) opNode()      {}
-func (*FuseOp) opNode()      {}
-func (*HashJoinOp) opNode()  {}
-func (*HeadOp) opNode()      {}
-func (*JoinOp) opNode()      {}
-func (*LoadOp) opNode()      {}
-func (*MergeOp) opNode()     {}
-func (*OutputOp) opNode()    {}
-func (*PassOp) opNode()      {}
-func (*PutOp) opNode()       {}
-func (*RenameOp) opNode()    {}
-func (*ScatterOp) opNode()   {}
-func (*SkipOp) opNode()      {}
-func (*SlicerOp) opNode()    {}
-func (*SortOp) opNode()      {}
-func (*SwitchOp) opNode()    {}
-func (*TailOp) opNode()      {}
-func (*TopOp) opNode()       {}
-func (*UniqOp) opNode()      {}
-func (*UnnestOp) opNode()    {}
-func (*ValuesOp) opNode()    {}
+func (*AggregateOp) opNode()    {}
+func (*CombineOp) opNode()      {}
+func (*CountOp) opNode()        {}
+func (*CutOp) opNode()          {}
+func (*DebugOp) opNode()        {}
+func (*DistinctOp) opNode()     {}
+func (*DropOp) opNode()         {}
+func (*FilterOp) opNode()       {}
+func (*ForkOp) opNode()         {}
+func (*FuseOp) opNode()         {}
+func (*HashJoinOp) opNode()     {}
+func (*HeadOp) opNode()         {}
+func (*JoinOp) opNode()         {}
+func (*LoadOp) opNode()         {}
+func (*MergeOp) opNode()        {}
+func (*OutputOp) opNode()       {}
+func (*PassOp) opNode()         {}
+func (*PutOp) opNode()          {}
+func (*RenameOp) opNode()       {}
+func (*ScatterOp) opNode()      {}
+func (*SkipOp) opNode()         {}
+func (*SlicerOp) opNode()       {}
+func (*SortOp) opNode()         {}
+func (*StreamWindowOp) opNode() {}
+func (*SwitchOp) opNode()       {}
+func (*TailOp) opNode()         {}
+func (*TopOp) opNode()          {}
+func (*UniqOp) opNode()         {}
+func (*UnnestOp) opNode()       {}
+func (*ValuesOp) opNode()       {}
+func (*WindowOp) opNode()       {}
 
 // Scanner sources also implement Op and all have suffix "Scan".
 type (
@@ -268,10 +311,11 @@ type (
 		Pushdown Pushdown `json:"pushdown"`
 	}
 	ListerScan struct {
-		Kind      string      `json:"kind" unpack:""`
-		Pool      ksuid.KSUID `json:"pool"`
-		Commit    ksuid.KSUID `json:"commit"`
-		KeyPruner Expr        `json:"key_pruner"`
+		Kind       string      `json:"kind" unpack:""`
+		Pool       ksuid.KSUID `json:"pool"`
+		Commit     ksuid.KSUID `json:"commit"`
+		KeyPruner  Expr        `json:"key_pruner"`
+		MetaFilter *ScanFilter `json:"meta_filter"`
 	}
 	HTTPScan struct {
 		Kind    string              `json:"kind" unpack:""`
@@ -296,6 +340,7 @@ type (
 		Kind string      `json:"kind" unpack:""`
 		ID   ksuid.KSUID `json:"id"`
 		Meta string      `json:"meta"`
+		At   nano.Ts     `json:"at"`
 	}
 	NullScan struct {
 		Kind string `json:"kind" unpack:""`
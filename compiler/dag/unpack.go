@@ -13,6 +13,7 @@ var unpacker = unpack.New(
 	Assignment{},
 	BadExpr{},
 	BinaryExpr{},
+	BloomFilterExpr{},
 	CallExpr{},
 	CombineOp{},
 	CommitMetaScan{},
@@ -64,6 +65,7 @@ var unpacker = unpack.New(
 	SlicerOp{},
 	SortOp{},
 	Spread{},
+	StreamWindowOp{},
 	SubqueryExpr{},
 	SwitchOp{},
 	TailOp{},
@@ -74,6 +76,7 @@ var unpacker = unpack.New(
 	UnnestOp{},
 	ValuesOp{},
 	VectorValue{},
+	WindowOp{},
 )
 
 // UnmarshalOp transforms a JSON representation of an operator into an Op.
@@ -113,6 +113,14 @@ func demandForSimpleOp(op dag.Op, downstream demand.Demand) demand.Demand {
 			d = demand.Union(d, demandForExpr(e))
 		}
 		return d
+	case *dag.StreamWindowOp:
+		d := demandForWindowFuncs(op.Funcs, downstream)
+		for _, e := range op.Keys {
+			d = demand.Union(d, demandForExpr(e))
+		}
+		return d
+	case *dag.WindowOp:
+		return demandForWindow(op, downstream)
 
 	case *dag.CommitMetaScan, *dag.DefaultScan, *dag.DeleterScan, *dag.DeleteScan, *dag.DBMetaScan:
 		return demand.None()
@@ -126,7 +134,12 @@ func demandForSimpleOp(op dag.Op, downstream demand.Demand) demand.Demand {
 		}
 		op.Pushdown.Projection = demand.Fields(d)
 		return demand.None()
-	case *dag.HTTPScan, *dag.ListerScan, *dag.NullScan, *dag.PoolMetaScan, *dag.PoolScan:
+	case *dag.ListerScan:
+		if mf := op.MetaFilter; mf != nil {
+			mf.Projection = demand.Fields(demandForExpr(mf.Expr))
+		}
+		return demand.None()
+	case *dag.HTTPScan, *dag.NullScan, *dag.PoolMetaScan, *dag.PoolScan:
 		return demand.None()
 	case *dag.RobotScan:
 		return demandForExpr(op.Expr)
@@ -151,6 +164,8 @@ func demandForExpr(expr dag.Expr) demand.Demand {
 		return demandForArrayOrSetExpr(expr.Elems)
 	case *dag.BinaryExpr:
 		return demand.Union(demandForExpr(expr.LHS), demandForExpr(expr.RHS))
+	case *dag.BloomFilterExpr:
+		return demandForExpr(expr.Expr)
 	case *dag.CallExpr:
 		d := demand.None()
 		for _, a := range expr.Args {
@@ -245,6 +260,34 @@ func demandForAssignments(assignments []dag.Assignment, downstream demand.Demand
 	return d
 }
 
+func demandForWindow(op *dag.WindowOp, downstream demand.Demand) demand.Demand {
+	d := demandForWindowFuncs(op.Funcs, downstream)
+	for _, e := range op.PartitionBy {
+		d = demand.Union(d, demandForExpr(e))
+	}
+	for _, s := range op.OrderBy {
+		d = demand.Union(d, demandForExpr(s.Key))
+	}
+	return d
+}
+
+func demandForWindowFuncs(funcs []dag.WindowFunc, downstream demand.Demand) demand.Demand {
+	d := downstream
+	for _, f := range funcs {
+		// Like an assignment, each function clobbers a static field.
+		d = demand.Delete(d, demandForExpr(f.LHS))
+	}
+	for _, f := range funcs {
+		for _, e := range f.Args {
+			d = demand.Union(d, demandForExpr(e))
+		}
+		if f.Agg != nil {
+			d = demand.Union(d, demandForExpr(f.Agg))
+		}
+	}
+	return d
+}
+
 func demandForSortExprs(sortExprs []dag.SortExpr, downstream demand.Demand) demand.Demand {
 	if len(sortExprs) == 0 {
 		// Need all fields to guess sort key.
@@ -0,0 +1,423 @@
+package optimizer
+
+import (
+	"math"
+	"reflect"
+	"slices"
+
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/pkg/field"
+)
+
+const (
+	// filterSelectivity is the assumed fraction of values that pass a filter.
+	filterSelectivity = 0.5
+	// avgValueSize is the assumed size in bytes of a value in a source whose
+	// row count is unknown.
+	avgValueSize = 64
+)
+
+// joinLeaf is an input to a chain of joins.
+type joinLeaf struct {
+	seq  dag.Seq
+	rows float64 // estimated number of values or -1 if unknown
+	// ndvs maps a key path to its estimated number of distinct values.
+	ndvs map[string]float64
+}
+
+// joinEdge is an equality predicate between keys of two join leaves.
+type joinEdge struct {
+	leaves [2]int
+	keys   [2]dag.Expr
+}
+
+// orderJoins reorders each chain of inner hash joins in seq so that the
+// estimated sizes of the intermediate results are small and sets the build
+// side of each hash join to its smaller input.  It relies on cardinality
+// statistics of pool and file sources and leaves a join unchanged if any of
+// its inputs lack them.  The number of values of a source whose statistics
+// lack a row count is estimated from its size in bytes.  It returns true if
+// it reordered any joins.
+func (o *Optimizer) orderJoins(seq dag.Seq) (dag.Seq, bool) {
+	if o.env == nil {
+		return seq, false
+	}
+	var reordered bool
+	for i := 0; i < len(seq); i++ {
+		var ok bool
+		switch op := seq[i].(type) {
+		case *dag.ForkOp:
+			if i+1 < len(seq) {
+				if _, isjoin := seq[i+1].(*dag.HashJoinOp); isjoin && len(op.Paths) == 2 {
+					var ops dag.Seq
+					ops, ok = o.orderJoinChain(seq[i : i+2])
+					seq = slices.Concat(seq[:i], ops, seq[i+2:])
+					i += len(ops) - 1
+					reordered = reordered || ok
+					continue
+				}
+			}
+			for k := range op.Paths {
+				op.Paths[k], ok = o.orderJoins(op.Paths[k])
+				reordered = reordered || ok
+			}
+		case *dag.ScatterOp:
+			for k := range op.Paths {
+				op.Paths[k], ok = o.orderJoins(op.Paths[k])
+				reordered = reordered || ok
+			}
+		}
+	}
+	return seq, reordered
+}
+
+// orderJoinChain takes a fork and hash join and returns the ops that replace
+// them and true if it reordered any joins.
+func (o *Optimizer) orderJoinChain(seq dag.Seq) (dag.Seq, bool) {
+	leafSeqs, joins := flattenJoinChain(seq)
+	var reordered bool
+	for _, leafSeq := range leafSeqs {
+		var ok bool
+		*leafSeq, ok = o.orderJoins(*leafSeq)
+		reordered = reordered || ok
+	}
+	edges, ok := joinEdges(joins)
+	known := true
+	leaves := make([]*joinLeaf, len(leafSeqs))
+	for k, leafSeq := range leafSeqs {
+		var keys []dag.Expr
+		for _, e := range edges {
+			for j := range 2 {
+				if e.leaves[j] == k {
+					keys = append(keys, e.keys[j])
+				}
+			}
+		}
+		leaves[k] = o.newJoinLeaf(*leafSeq, keys)
+		known = known && leaves[k].rows >= 0
+	}
+	// Swapping the inputs of a single join gains nothing over choosing its
+	// build side.
+	if ok && known && len(leaves) > 2 {
+		if perm, ok := greedyJoinOrder(leaves, edges); ok && !slices.IsSorted(perm) {
+			return buildJoinChain(leaves, edges, joins, perm), true
+		}
+	}
+	// Keep the user's order but choose the build side of each join whose
+	// input sizes are known.
+	rows := leaves[0].rows
+	for k, join := range joins {
+		join.Build = buildSide(rows, leaves[k+1].rows)
+		if !ok || rows < 0 || leaves[k+1].rows < 0 {
+			rows = -1
+			continue
+		}
+		rows = joinRows(leaves, edges, leafRange(k+1), k+1, rows)
+	}
+	return seq, reordered
+}
+
+// flattenJoinChain returns pointers to the leaves and the joins of a
+// left-deep chain of inner hash joins rooted at seq, where joins[k] joins the
+// result of joins[k-1] (or leaves[0] if k is zero) with leaves[k+1].
+func flattenJoinChain(seq dag.Seq) ([]*dag.Seq, []*dag.HashJoinOp) {
+	fork := seq[0].(*dag.ForkOp)
+	join := seq[1].(*dag.HashJoinOp)
+	left := fork.Paths[0]
+	if join.Style == "inner" && len(left) == 2 {
+		if lfork, ok := left[0].(*dag.ForkOp); ok && len(lfork.Paths) == 2 {
+			if ljoin, ok := left[1].(*dag.HashJoinOp); ok && ljoin.Style == "inner" {
+				leaves, joins := flattenJoinChain(left)
+				return append(leaves, &fork.Paths[1]), append(joins, join)
+			}
+		}
+	}
+	return []*dag.Seq{&fork.Paths[0], &fork.Paths[1]}, []*dag.HashJoinOp{join}
+}
+
+// leafRange returns the leaf indexes less than n.
+func leafRange(n int) []int {
+	leaves := make([]int, n)
+	for k := range leaves {
+		leaves[k] = k
+	}
+	return leaves
+}
+
+// joinEdges returns the equality predicates of a chain of inner hash joins
+// with keys relative to the leaves.  It returns false if the chain is a
+// single join that is not an inner join or if a key does not refer to
+// exactly one leaf.
+func joinEdges(joins []*dag.HashJoinOp) ([]joinEdge, bool) {
+	var edges []joinEdge
+	for k, join := range joins {
+		if join.Style != "inner" {
+			return nil, false
+		}
+		leaf, key, ok := resolveJoinKey(joins[:k], join.LeftKey)
+		if !ok {
+			return nil, false
+		}
+		edges = append(edges, joinEdge{
+			leaves: [2]int{leaf, k + 1},
+			keys:   [2]dag.Expr{key, join.RightKey},
+		})
+	}
+	return edges, true
+}
+
+// resolveJoinKey returns the leaf referred to by key, which is relative to
+// the output of joins, and a copy of key relative to that leaf.
+func resolveJoinKey(joins []*dag.HashJoinOp, key dag.Expr) (int, dag.Expr, bool) {
+	leaf, depth := -1, -1
+	ok := true
+	walkT(reflect.ValueOf(key), func(t dag.ThisExpr) dag.ThisExpr {
+		l, d := resolveJoinPath(joins, t.Path)
+		if l < 0 || (leaf >= 0 && (l != leaf || d != depth)) {
+			ok = false
+		}
+		leaf, depth = l, d
+		return t
+	})
+	if !ok || leaf < 0 {
+		return 0, nil, false
+	}
+	key = dag.CopyExpr(key)
+	walkT(reflect.ValueOf(key), func(t dag.ThisExpr) dag.ThisExpr {
+		t.Path = t.Path[depth:]
+		return t
+	})
+	return leaf, key, true
+}
+
+// resolveJoinPath returns the leaf that path refers to in the output of joins
+// and the number of leading path elements that lead to the leaf.
+func resolveJoinPath(joins []*dag.HashJoinOp, path []string) (int, int) {
+	for m := len(joins); m > 0; m-- {
+		depth := len(joins) - m
+		if depth >= len(path) {
+			return -1, 0
+		}
+		switch path[depth] {
+		case joins[m-1].RightAlias:
+			return m, depth + 1
+		case joins[m-1].LeftAlias:
+		default:
+			return -1, 0
+		}
+	}
+	depth := len(joins)
+	if depth > len(path) {
+		return -1, 0
+	}
+	return 0, depth
+}
+
+// newJoinLeaf returns a joinLeaf for seq with the estimated number of
+// distinct values of each of keys that is a field path.
+func (o *Optimizer) newJoinLeaf(seq dag.Seq, keys []dag.Expr) *joinLeaf {
+	leaf := &joinLeaf{seq: seq, rows: -1, ndvs: map[string]float64{}}
+	if len(seq) == 0 {
+		return leaf
+	}
+	var paths []field.Path
+	for _, key := range keys {
+		if this, ok := key.(*dag.ThisExpr); ok {
+			paths = append(paths, this.Path)
+		}
+	}
+	stats, err := o.env.SourceStats(o.ctx, seq[0], paths)
+	if err != nil || stats == nil {
+		return leaf
+	}
+	rows := float64(stats.Rows)
+	if rows == 0 {
+		rows = float64(stats.Bytes) / avgValueSize
+	}
+	reshaped := false
+	for _, op := range seq[1:] {
+		switch op := op.(type) {
+		case *dag.FilterOp:
+			rows *= math.Pow(filterSelectivity, float64(len(splitPredicate(op.Expr))))
+		case *dag.HeadOp:
+			rows = min(rows, float64(op.Count))
+		case *dag.CutOp, *dag.DropOp, *dag.PutOp, *dag.RenameOp, *dag.ValuesOp:
+			// The key paths may no longer refer to source fields.
+			reshaped = true
+		default:
+			return leaf
+		}
+	}
+	leaf.rows = rows
+	if reshaped {
+		return leaf
+	}
+	for k, path := range paths {
+		if ndv := stats.NDVs[k]; ndv > 0 {
+			leaf.ndvs[path.String()] = float64(ndv)
+		}
+	}
+	return leaf
+}
+
+// ndv returns the estimated number of distinct values of key in l.
+func (l *joinLeaf) ndv(key dag.Expr) float64 {
+	ndv := l.rows
+	if this, ok := key.(*dag.ThisExpr); ok {
+		if n, ok := l.ndvs[field.Path(this.Path).String()]; ok {
+			ndv = min(ndv, n)
+		}
+	}
+	return max(ndv, 1)
+}
+
+// greedyJoinOrder returns a left-deep order of leaves that starts with the
+// smallest leaf and at each step adds the connected leaf that yields the
+// smallest intermediate result.  It returns false if the leaves cannot be
+// joined without a cross product.
+func greedyJoinOrder(leaves []*joinLeaf, edges []joinEdge) ([]int, bool) {
+	first := 0
+	for k, leaf := range leaves {
+		if leaf.rows < leaves[first].rows {
+			first = k
+		}
+	}
+	perm := []int{first}
+	rows := leaves[first].rows
+	for len(perm) < len(leaves) {
+		next, nextRows := -1, 0.0
+		for k := range leaves {
+			if slices.Contains(perm, k) || !joinsTo(edges, perm, k) {
+				continue
+			}
+			if r := joinRows(leaves, edges, perm, k, rows); next < 0 || r < nextRows {
+				next, nextRows = k, r
+			}
+		}
+		if next < 0 {
+			return nil, false
+		}
+		perm = append(perm, next)
+		rows = nextRows
+	}
+	return perm, true
+}
+
+func joinsTo(edges []joinEdge, set []int, leaf int) bool {
+	for _, e := range edges {
+		if _, ok := edgeTo(e, set, leaf); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// edgeTo returns the index in e.leaves of the leaf in set if e connects a
+// leaf in set to leaf.
+func edgeTo(e joinEdge, set []int, leaf int) (int, bool) {
+	switch {
+	case e.leaves[1] == leaf && slices.Contains(set, e.leaves[0]):
+		return 0, true
+	case e.leaves[0] == leaf && slices.Contains(set, e.leaves[1]):
+		return 1, true
+	}
+	return 0, false
+}
+
+// joinRows estimates the size of the join of the leaves in set, whose
+// estimated size is rows, with leaf under the assumption that the keys of
+// each predicate are uniformly distributed and independent.
+func joinRows(leaves []*joinLeaf, edges []joinEdge, set []int, leaf int, rows float64) float64 {
+	out := rows * leaves[leaf].rows
+	for _, e := range edges {
+		if j, ok := edgeTo(e, set, leaf); ok {
+			in := e.leaves[j]
+			ndv := max(min(leaves[in].ndv(e.keys[j]), rows), leaves[leaf].ndv(e.keys[1-j]))
+			out /= ndv
+		}
+	}
+	return out
+}
+
+// buildSide returns the input of a hash join from which to build the table
+// given the estimated sizes of the inputs.
+func buildSide(left, right float64) string {
+	switch {
+	case left < 0 || right < 0 || left == right:
+		return ""
+	case left < right:
+		return "left"
+	default:
+		return "right"
+	}
+}
+
+// buildJoinChain returns the ops for a chain of inner hash joins of leaves in
+// the order perm followed by a values op that restores the shape of the
+// output of the original joins.
+func buildJoinChain(leaves []*joinLeaf, edges []joinEdge, joins []*dag.HashJoinOp, perm []int) dag.Seq {
+	// paths[k] is the path of leaves[k] in the output of the new chain.
+	paths := make([][]string, len(leaves))
+	seq := leaves[perm[0]].seq
+	rows := leaves[perm[0]].rows
+	for k := 1; k < len(perm); k++ {
+		leaf := perm[k]
+		var lefts, rights []dag.Expr
+		for _, e := range edges {
+			if j, ok := edgeTo(e, perm[:k], leaf); ok {
+				lefts = append(lefts, prefixThisPaths(e.keys[j], paths[e.leaves[j]]))
+				rights = append(rights, e.keys[1-j])
+			}
+		}
+		var left, right dag.Expr
+		if len(lefts) == 1 {
+			left, right = lefts[0], rights[0]
+		} else {
+			left, right = buildTuple(lefts), buildTuple(rights)
+		}
+		join := &dag.HashJoinOp{
+			Kind:       "HashJoinOp",
+			Style:      "inner",
+			LeftAlias:  "left",
+			RightAlias: "right",
+			LeftKey:    left,
+			RightKey:   right,
+			Build:      buildSide(rows, leaves[leaf].rows),
+		}
+		fork := &dag.ForkOp{Kind: "ForkOp", Paths: []dag.Seq{seq, leaves[leaf].seq}}
+		seq = dag.Seq{fork, join}
+		rows = joinRows(leaves, edges, perm[:k], leaf, rows)
+		for _, in := range perm[:k] {
+			paths[in] = append([]string{"left"}, paths[in]...)
+		}
+		paths[leaf] = []string{"right"}
+	}
+	return append(seq, dag.NewValuesOp(joinShape(joins, paths)))
+}
+
+// joinShape returns an expression for the output of joins in terms of the
+// paths of their leaves.
+func joinShape(joins []*dag.HashJoinOp, paths [][]string) dag.Expr {
+	if len(joins) == 0 {
+		return dag.NewThis(paths[0])
+	}
+	join := joins[len(joins)-1]
+	return &dag.RecordExpr{
+		Kind: "RecordExpr",
+		Elems: []dag.RecordElem{
+			&dag.Field{Kind: "Field", Name: join.LeftAlias, Value: joinShape(joins[:len(joins)-1], paths)},
+			&dag.Field{Kind: "Field", Name: join.RightAlias, Value: dag.NewThis(paths[len(joins)])},
+		},
+	}
+}
+
+// prefixThisPaths returns a copy of e with prefix prepended to every
+// dag.This.Path.
+func prefixThisPaths(e dag.Expr, prefix []string) dag.Expr {
+	e = dag.CopyExpr(e)
+	walkT(reflect.ValueOf(e), func(t dag.ThisExpr) dag.ThisExpr {
+		t.Path = slices.Concat(prefix, t.Path)
+		return t
+	})
+	return e
+}
@@ -72,6 +72,13 @@ func (o *Optimizer) analyzeSortKeys(op dag.Op, in order.SortKeys) (order.SortKey
 			}
 		}
 		return in, nil
+	case *dag.StreamWindowOp:
+		for _, f := range op.Funcs {
+			if fieldOf(f.LHS).Equal(key.Key) {
+				return nil, nil
+			}
+		}
+		return in, nil
 	default:
 		return nil, nil
 	}
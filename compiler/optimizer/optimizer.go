@@ -129,6 +129,11 @@ func (o *Optimizer) Optimize(main *dag.Main) error {
 	replaceJoinWithHashJoin(seq)
 	seq = joinFilterPullup(seq)
 	seq = removePassOps(seq)
+	var reordered bool
+	if seq, reordered = o.orderJoins(seq); reordered {
+		// Merge the values ops that restore the shape of reordered joins.
+		seq = mergeValuesOps(seq)
+	}
 	seq = replaceSortAndHeadOrTailWithTop(seq)
 	o.optimizeParallels(seq)
 	seq = mergeFilters(seq)
@@ -231,6 +236,17 @@ func (o *Optimizer) optimizeSourcePaths(seq dag.Seq) (dag.Seq, error) {
 				return nil, err
 			}
 			lister.KeyPruner = maybeNewRangePruner(filter, sortKeys)
+			// The Bloom filters in the metadata of vector objects can
+			// prune data objects for equality and "in" filters on any
+			// field.  Without them, reading the metadata of every object
+			// costs more than the range comparisons typically save.
+			bloomFilters, err := o.bloomFiltersOfPool(op.ID)
+			if err != nil {
+				return nil, err
+			}
+			if mf := newMetaFilter(filter); bloomFilters && mf != nil && hasBloomFilter(mf.Expr) {
+				lister.MetaFilter = mf
+			}
 			seq = dag.Seq{lister}
 			_, _, orderRequired, err := o.concurrentPath(chain, sortKeys)
 			if err != nil {
@@ -386,6 +402,12 @@ func (o *Optimizer) propagateSortKeyOp(op dag.Op, parents []order.SortKeys) ([]o
 			sortKeys = nil
 		}
 		return []order.SortKeys{sortKeys}, nil
+	case *dag.StreamWindowOp:
+		if len(op.Keys) > 0 && !parent.IsNil() && fieldOf(op.Keys[0]).Equal(parent.Primary().Key) {
+			op.InputSorted = true
+		}
+		out, err := o.analyzeSortKeys(op, parent)
+		return []order.SortKeys{out}, err
 	case *dag.PoolScan, *dag.ListerScan, *dag.SeqScan, *dag.DefaultScan:
 		out, err := o.sortKeysOfSource(op)
 		return []order.SortKeys{out}, err
@@ -430,6 +452,16 @@ func (o *Optimizer) sortKey(id ksuid.KSUID) (order.SortKeys, error) {
 	return pool.SortKeys, nil
 }
 
+// bloomFiltersOfPool returns true if the vector objects of the pool with
+// ID id are written with Bloom filters.
+func (o *Optimizer) bloomFiltersOfPool(id ksuid.KSUID) (bool, error) {
+	pool, err := o.lookupPool(id)
+	if err != nil {
+		return false, err
+	}
+	return pool.Vector.BloomFilters, nil
+}
+
 func (o *Optimizer) lookupPool(id ksuid.KSUID) (*db.Pool, error) {
 	if o.db == nil {
 		return nil, errors.New("internal error: database operation requires database operating context")
@@ -865,7 +897,7 @@ func setPushdownUnordered(seq dag.Seq, unordered bool) bool {
 	for i := len(seq) - 1; i >= 0; i-- {
 		switch op := seq[i].(type) {
 		case *dag.AggregateOp, *dag.CombineOp, *dag.DistinctOp, *dag.HashJoinOp, *dag.JoinOp, *dag.SortOp, *dag.TopOp,
-			*dag.DefaultScan, *dag.HTTPScan, *dag.PoolScan,
+			*dag.WindowOp, *dag.DefaultScan, *dag.HTTPScan, *dag.PoolScan,
 			*dag.CommitMetaScan, *dag.DBMetaScan, *dag.PoolMetaScan:
 			unordered = true
 		case *dag.FileScan:
@@ -294,6 +294,9 @@ func (o *Optimizer) concurrentPath(seq dag.Seq, sortKeys order.SortKeys) (length
 				return 0, nil, false, nil
 			}
 			return k, op.Exprs, false, nil
+		case *dag.StreamWindowOp, *dag.WindowOp:
+			// Each partition must be seen by a single window operator.
+			return k, nil, false, nil
 		case *dag.LoadOp:
 			// XXX At some point Load should have an optimization where if the
 			// upstream sort is the same as the Load destination sort we
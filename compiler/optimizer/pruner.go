@@ -5,8 +5,10 @@ import (
 	"slices"
 	"unicode/utf8"
 
+	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/bloom"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/sup"
 )
@@ -159,6 +161,18 @@ func newMetaFilter(pred dag.Expr) *dag.ScanFilter {
 	return &dag.ScanFilter{Expr: e}
 }
 
+// hasBloomFilter returns true if the metadata pruner e tests a Bloom filter.
+func hasBloomFilter(e dag.Expr) bool {
+	switch e := e.(type) {
+	case *dag.BinaryExpr:
+		return hasBloomFilter(e.LHS) || hasBloomFilter(e.RHS)
+	case *dag.BloomFilterExpr:
+		return true
+	default:
+		return false
+	}
+}
+
 func newMetadataPruner(pred dag.Expr) dag.Expr {
 	switch e := pred.(type) {
 	case *dag.BinaryExpr:
@@ -178,6 +192,8 @@ func newMetadataPruner(pred dag.Expr) dag.Expr {
 		return dag.NewBinaryExpr("and",
 			compare("<=", min, dag.NewThis(append(slices.Clone(this.Path), "max"))),
 			compare(">", max, dag.NewThis(append(slices.Clone(this.Path), "min"))))
+	case *dag.SearchExpr:
+		return searchPruner(e)
 	default:
 		return nil
 	}
@@ -319,8 +335,49 @@ func metadataPrunerPred(op string, this *dag.ThisExpr, literal *dag.PrimitiveExp
 		return compare(">=", max, literal)
 	case "==":
 		return dag.NewBinaryExpr("and",
-			compare(">=", literal, min),
-			compare("<=", literal, max))
+			dag.NewBinaryExpr("and",
+				compare(">=", literal, min),
+				compare("<=", literal, max)),
+			&dag.BloomFilterExpr{
+				Kind:  "BloomFilterExpr",
+				Expr:  dag.NewThis(append(slices.Clone(this.Path), "bloom")),
+				Value: literal.Value,
+			})
 	}
 	panic("metadataPrunerPred unknown op " + op)
 }
+
+// searchPruner returns a metadata pruner for a search term that is not a
+// string or network, which match substrings and addresses, respectively.
+// Since the term also matches any string containing its text, the Bloom
+// filters can rule out only objects whose searched values are not strings.
+func searchPruner(e *dag.SearchExpr) dag.Expr {
+	this, ok := e.Expr.(*dag.ThisExpr)
+	if !ok {
+		return nil
+	}
+	val, err := sup.ParseValue(super.NewContext(), e.Value)
+	if err != nil {
+		return nil
+	}
+	if !canBloomSearch(val.Type()) {
+		return nil
+	}
+	return &dag.BloomFilterExpr{
+		Kind:   "BloomFilterExpr",
+		Expr:   dag.NewThis(slices.Clone(this.Path)),
+		Value:  e.Value,
+		Search: true,
+	}
+}
+
+func canBloomSearch(typ super.Type) bool {
+	switch id := super.TypeUnder(typ).ID(); {
+	case super.IsSigned(id), super.IsUnsigned(id), super.IsFloat(id):
+		return true
+	case id == super.IDString, id == super.IDNet:
+		return false
+	default:
+		return bloom.Hashable(super.TypeUnder(typ))
+	}
+}
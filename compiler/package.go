@@ -15,6 +15,7 @@ import (
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/runtime/profile"
 	"github.com/brimdata/super/runtime/sam/op"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio"
@@ -66,17 +67,31 @@ func CompileWithAST(rctx *runtime.Context, ast *parser.AST, env *exec.Environmen
 	if err != nil {
 		return nil, err
 	}
+	if p := ast.Partials(); p != parser.PartialsNone {
+		if err := splitPartials(main, p); err != nil {
+			return nil, err
+		}
+	}
 	if optimize {
 		err = Optimize(rctx, main, env, parallel)
 		if err != nil {
 			return nil, err
 		}
 	}
-	outputs, debugs, meter, err := Build(rctx, main, env, readers)
+	b := rungen.NewBuilder(rctx, env)
+	var prof *profile.Profile
+	if ast.ExplainAnalyze() {
+		prof = b.EnableProfile(main)
+	}
+	outputs, debugs, err := b.Build(main, readers...)
 	if err != nil {
 		return nil, err
 	}
-	return exec.NewQuery(rctx, bundleOutputs(rctx, outputs, debugs), meter), nil
+	puller := bundleOutputs(rctx, outputs, debugs)
+	if ast.Explain() {
+		puller = prof.Explain(puller)
+	}
+	return exec.NewProfiledQuery(rctx, puller, b.Meter(), prof), nil
 }
 
 func Compile(rctx *runtime.Context, env *exec.Environment, optimize bool, parallel int, readers []sio.Reader, inputs []srcfiles.Input) (*exec.Query, error) {
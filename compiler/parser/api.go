@@ -8,10 +8,33 @@ import (
 )
 
 type AST struct {
-	seq   ast.Seq
-	files *srcfiles.List
+	seq      ast.Seq
+	files    *srcfiles.List
+	params   map[string]string
+	analyze  bool
+	explain  bool
+	partials Partials
 }
 
+// Partials selects the stage of a query ending in an aggregate that is
+// compiled when the aggregate is computed incrementally by merging partial
+// results.
+type Partials int
+
+const (
+	// PartialsNone compiles the entire query.
+	PartialsNone Partials = iota
+	// PartialsOut compiles the operators up to and including the last
+	// aggregate, which emits partial results.
+	PartialsOut
+	// PartialsMerge compiles the last aggregate alone, which merges partial
+	// results into partial results.
+	PartialsMerge
+	// PartialsIn compiles the last aggregate, which merges partial results
+	// into final results, and the operators after it.
+	PartialsIn
+)
+
 func (a *AST) Parsed() ast.Seq {
 	return a.seq
 }
@@ -24,6 +47,44 @@ func (a *AST) Files() *srcfiles.List {
 	return a.files
 }
 
+// BindParams binds the query parameters to values in SUP format.  A value
+// named "x" is referenced in the query as "$x" and the positional
+// parameters are named "1", "2", and so on.
+func (a *AST) BindParams(params map[string]string) {
+	a.params = params
+}
+
+func (a *AST) Params() map[string]string {
+	return a.params
+}
+
+// SetExplainAnalyze sets whether the query is run in EXPLAIN ANALYZE mode, where
+// the runtime statistics of each of its operators are collected.
+func (a *AST) SetExplainAnalyze(on bool) {
+	a.analyze = on
+}
+
+func (a *AST) ExplainAnalyze() bool {
+	return a.analyze || a.explain
+}
+
+// Explain returns true if the query text begins with EXPLAIN ANALYZE, in
+// which case the query's results are discarded and its output is its
+// annotated DAG.
+func (a *AST) Explain() bool {
+	return a.explain
+}
+
+// SetPartials sets the stage of the query that is compiled.  Stages other
+// than PartialsNone read their input from the readers given to the compiler.
+func (a *AST) SetPartials(p Partials) {
+	a.partials = p
+}
+
+func (a *AST) Partials() Partials {
+	return a.partials
+}
+
 func (a *AST) ConvertToDeleteWhere(pool, branch string) error {
 	if len(a.seq) == 0 {
 		return errors.New("internal error: AST seq cannot be empty")
@@ -36,6 +97,12 @@ func (a *AST) ConvertToDeleteWhere(pool, branch string) error {
 	return nil
 }
 
+// AppendHead appends a head operator to the query so that it stops after
+// its first value.
+func (a *AST) AppendHead() {
+	a.seq = append(a.seq, &ast.HeadOp{Kind: "HeadOp"})
+}
+
 func (a *AST) PrependFileScan(paths []string) {
 	a.seq.Prepend(&ast.FileScan{
 		Kind:  "FileScan",
@@ -58,14 +125,17 @@ func ParseFiles(inputs []srcfiles.Input) (*AST, error) {
 	if files.Text == "" {
 		return &AST{files: files}, nil
 	}
-	p, err := Parse("", []byte(files.Text), Recover(false))
+	ps := make(params)
+	ex := &explain{}
+	p, err := Parse("", []byte(files.Text), Recover(false), GlobalStore("params", ps), GlobalStore("explain", ex))
 	if err != nil {
 		if err := convertParseErrs(err, files); err != nil {
 			return nil, err
 		}
 		return nil, files.Error()
 	}
-	return &AST{sliceOf[ast.Op](p), files}, nil
+	ps.number()
+	return &AST{seq: sliceOf[ast.Op](p), files: files, explain: ex.on}, nil
 }
 
 func convertParseErrs(err error, files *srcfiles.List) error {
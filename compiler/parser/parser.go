@@ -36,14 +36,42 @@ var g = &grammar{
 						},
 						&labeledExpr{
 							pos:   position{line: 10, col: 8, offset: 106},
+							label: "explain",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 10, col: 16, offset: 114},
+								expr: &seqExpr{
+									pos: position{line: 10, col: 17, offset: 115},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 10, col: 17, offset: 115},
+											name: "EXPLAIN",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 10, col: 25, offset: 123},
+											name: "_",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 10, col: 27, offset: 125},
+											name: "ANALYZE",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 10, col: 35, offset: 133},
+											name: "_",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 10, col: 39, offset: 137},
 							label: "q",
 							expr: &ruleRefExpr{
-								pos:  position{line: 10, col: 10, offset: 108},
+								pos:  position{line: 10, col: 41, offset: 139},
 								name: "Query",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 10, col: 16, offset: 114},
+							pos:  position{line: 10, col: 47, offset: 145},
 							name: "EndQuery",
 						},
 					},
@@ -54,29 +82,29 @@ var g = &grammar{
 		},
 		{
 			name: "EndQuery",
-			pos:  position{line: 12, col: 1, offset: 142},
+			pos:  position{line: 17, col: 1, offset: 239},
 			expr: &seqExpr{
-				pos: position{line: 12, col: 12, offset: 153},
+				pos: position{line: 17, col: 12, offset: 250},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 12, col: 12, offset: 153},
+						pos:  position{line: 17, col: 12, offset: 250},
 						name: "__",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 12, col: 15, offset: 156},
+						pos: position{line: 17, col: 15, offset: 253},
 						expr: &litMatcher{
-							pos:        position{line: 12, col: 15, offset: 156},
+							pos:        position{line: 17, col: 15, offset: 253},
 							val:        ";",
 							ignoreCase: false,
 							want:       "\";\"",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 12, col: 20, offset: 161},
+						pos:  position{line: 17, col: 20, offset: 258},
 						name: "__",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 12, col: 23, offset: 164},
+						pos:  position{line: 17, col: 23, offset: 261},
 						name: "EOF",
 					},
 				},
@@ -86,24 +114,24 @@ var g = &grammar{
 		},
 		{
 			name: "Query",
-			pos:  position{line: 14, col: 1, offset: 169},
+			pos:  position{line: 19, col: 1, offset: 266},
 			expr: &choiceExpr{
-				pos: position{line: 15, col: 5, offset: 179},
+				pos: position{line: 20, col: 5, offset: 276},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 15, col: 5, offset: 179},
+						pos: position{line: 20, col: 5, offset: 276},
 						run: (*parser).callonQuery2,
 						expr: &labeledExpr{
-							pos:   position{line: 15, col: 5, offset: 179},
+							pos:   position{line: 20, col: 5, offset: 276},
 							label: "scope",
 							expr: &ruleRefExpr{
-								pos:  position{line: 15, col: 11, offset: 185},
+								pos:  position{line: 20, col: 11, offset: 282},
 								name: "Scope",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 16, col: 5, offset: 224},
+						pos:  position{line: 21, col: 5, offset: 321},
 						name: "Seq",
 					},
 				},
@@ -113,29 +141,29 @@ var g = &grammar{
 		},
 		{
 			name: "Scope",
-			pos:  position{line: 18, col: 1, offset: 229},
+			pos:  position{line: 23, col: 1, offset: 326},
 			expr: &actionExpr{
-				pos: position{line: 19, col: 5, offset: 239},
+				pos: position{line: 24, col: 5, offset: 336},
 				run: (*parser).callonScope1,
 				expr: &seqExpr{
-					pos: position{line: 19, col: 5, offset: 239},
+					pos: position{line: 24, col: 5, offset: 336},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 19, col: 5, offset: 239},
+							pos:   position{line: 24, col: 5, offset: 336},
 							label: "decls",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 19, col: 11, offset: 245},
+								pos: position{line: 24, col: 11, offset: 342},
 								expr: &ruleRefExpr{
-									pos:  position{line: 19, col: 11, offset: 245},
+									pos:  position{line: 24, col: 11, offset: 342},
 									name: "Decl",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 19, col: 17, offset: 251},
+							pos:   position{line: 24, col: 17, offset: 348},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 19, col: 22, offset: 256},
+								pos:  position{line: 24, col: 22, offset: 353},
 								name: "Seq",
 							},
 						},
@@ -147,28 +175,28 @@ var g = &grammar{
 		},
 		{
 			name: "Seq",
-			pos:  position{line: 28, col: 1, offset: 433},
+			pos:  position{line: 33, col: 1, offset: 530},
 			expr: &actionExpr{
-				pos: position{line: 29, col: 5, offset: 441},
+				pos: position{line: 34, col: 5, offset: 538},
 				run: (*parser).callonSeq1,
 				expr: &seqExpr{
-					pos: position{line: 29, col: 5, offset: 441},
+					pos: position{line: 34, col: 5, offset: 538},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 29, col: 5, offset: 441},
+							pos:   position{line: 34, col: 5, offset: 538},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 29, col: 11, offset: 447},
+								pos:  position{line: 34, col: 11, offset: 544},
 								name: "PipeOp",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 29, col: 18, offset: 454},
+							pos:   position{line: 34, col: 18, offset: 551},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 29, col: 23, offset: 459},
+								pos: position{line: 34, col: 23, offset: 556},
 								expr: &ruleRefExpr{
-									pos:  position{line: 29, col: 23, offset: 459},
+									pos:  position{line: 34, col: 23, offset: 556},
 									name: "SeqTail",
 								},
 							},
@@ -181,30 +209,30 @@ var g = &grammar{
 		},
 		{
 			name: "SeqTail",
-			pos:  position{line: 33, col: 1, offset: 516},
+			pos:  position{line: 38, col: 1, offset: 613},
 			expr: &actionExpr{
-				pos: position{line: 33, col: 11, offset: 526},
+				pos: position{line: 38, col: 11, offset: 623},
 				run: (*parser).callonSeqTail1,
 				expr: &seqExpr{
-					pos: position{line: 33, col: 11, offset: 526},
+					pos: position{line: 38, col: 11, offset: 623},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 33, col: 11, offset: 526},
+							pos:  position{line: 38, col: 11, offset: 623},
 							name: "__",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 33, col: 14, offset: 529},
+							pos:  position{line: 38, col: 14, offset: 626},
 							name: "Pipe",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 33, col: 19, offset: 534},
+							pos:  position{line: 38, col: 19, offset: 631},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 33, col: 22, offset: 537},
+							pos:   position{line: 38, col: 22, offset: 634},
 							label: "o",
 							expr: &ruleRefExpr{
-								pos:  position{line: 33, col: 24, offset: 539},
+								pos:  position{line: 38, col: 24, offset: 636},
 								name: "PipeOp",
 							},
 						},
@@ -216,48 +244,48 @@ var g = &grammar{
 		},
 		{
 			name: "Decl",
-			pos:  position{line: 35, col: 1, offset: 565},
+			pos:  position{line: 40, col: 1, offset: 662},
 			expr: &actionExpr{
-				pos: position{line: 36, col: 5, offset: 574},
+				pos: position{line: 41, col: 5, offset: 671},
 				run: (*parser).callonDecl1,
 				expr: &seqExpr{
-					pos: position{line: 36, col: 5, offset: 574},
+					pos: position{line: 41, col: 5, offset: 671},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 36, col: 5, offset: 574},
+							pos:   position{line: 41, col: 5, offset: 671},
 							label: "v",
 							expr: &choiceExpr{
-								pos: position{line: 36, col: 8, offset: 577},
+								pos: position{line: 41, col: 8, offset: 674},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 36, col: 8, offset: 577},
+										pos:  position{line: 41, col: 8, offset: 674},
 										name: "ConstDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 36, col: 20, offset: 589},
+										pos:  position{line: 41, col: 20, offset: 686},
 										name: "FuncDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 36, col: 31, offset: 600},
+										pos:  position{line: 41, col: 31, offset: 697},
 										name: "OpDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 36, col: 40, offset: 609},
+										pos:  position{line: 41, col: 40, offset: 706},
 										name: "PragmaDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 36, col: 53, offset: 622},
+										pos:  position{line: 41, col: 53, offset: 719},
 										name: "QueryDecl",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 36, col: 65, offset: 634},
+										pos:  position{line: 41, col: 65, offset: 731},
 										name: "TypeDecl",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 36, col: 76, offset: 645},
+							pos:  position{line: 41, col: 76, offset: 742},
 							name: "_",
 						},
 					},
@@ -268,48 +296,48 @@ var g = &grammar{
 		},
 		{
 			name: "ConstDecl",
-			pos:  position{line: 38, col: 1, offset: 666},
+			pos:  position{line: 43, col: 1, offset: 763},
 			expr: &actionExpr{
-				pos: position{line: 39, col: 5, offset: 680},
+				pos: position{line: 44, col: 5, offset: 777},
 				run: (*parser).callonConstDecl1,
 				expr: &seqExpr{
-					pos: position{line: 39, col: 5, offset: 680},
+					pos: position{line: 44, col: 5, offset: 777},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 5, offset: 680},
+							pos:  position{line: 44, col: 5, offset: 777},
 							name: "CONST",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 11, offset: 686},
+							pos:  position{line: 44, col: 11, offset: 783},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 13, offset: 688},
+							pos:   position{line: 44, col: 13, offset: 785},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 18, offset: 693},
+								pos:  position{line: 44, col: 18, offset: 790},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 29, offset: 704},
+							pos:  position{line: 44, col: 29, offset: 801},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 39, col: 32, offset: 707},
+							pos:        position{line: 44, col: 32, offset: 804},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 39, col: 36, offset: 711},
+							pos:  position{line: 44, col: 36, offset: 808},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 39, offset: 714},
+							pos:   position{line: 44, col: 39, offset: 811},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 44, offset: 719},
+								pos:  position{line: 44, col: 44, offset: 816},
 								name: "Expr",
 							},
 						},
@@ -321,83 +349,83 @@ var g = &grammar{
 		},
 		{
 			name: "FuncDecl",
-			pos:  position{line: 48, col: 1, offset: 892},
+			pos:  position{line: 53, col: 1, offset: 989},
 			expr: &actionExpr{
-				pos: position{line: 49, col: 5, offset: 905},
+				pos: position{line: 54, col: 5, offset: 1002},
 				run: (*parser).callonFuncDecl1,
 				expr: &seqExpr{
-					pos: position{line: 49, col: 5, offset: 905},
+					pos: position{line: 54, col: 5, offset: 1002},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 5, offset: 905},
+							pos:  position{line: 54, col: 5, offset: 1002},
 							name: "FN",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 8, offset: 908},
+							pos:  position{line: 54, col: 8, offset: 1005},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 49, col: 10, offset: 910},
+							pos:   position{line: 54, col: 10, offset: 1007},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 49, col: 15, offset: 915},
+								pos:  position{line: 54, col: 15, offset: 1012},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 26, offset: 926},
+							pos:  position{line: 54, col: 26, offset: 1023},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 49, col: 29, offset: 929},
+							pos:        position{line: 54, col: 29, offset: 1026},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 33, offset: 933},
+							pos:  position{line: 54, col: 33, offset: 1030},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 49, col: 36, offset: 936},
+							pos:   position{line: 54, col: 36, offset: 1033},
 							label: "params",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 49, col: 43, offset: 943},
+								pos: position{line: 54, col: 43, offset: 1040},
 								expr: &ruleRefExpr{
-									pos:  position{line: 49, col: 43, offset: 943},
+									pos:  position{line: 54, col: 43, offset: 1040},
 									name: "Identifiers",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 56, offset: 956},
+							pos:  position{line: 54, col: 56, offset: 1053},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 49, col: 59, offset: 959},
+							pos:        position{line: 54, col: 59, offset: 1056},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 63, offset: 963},
+							pos:  position{line: 54, col: 63, offset: 1060},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 49, col: 66, offset: 966},
+							pos:        position{line: 54, col: 66, offset: 1063},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 49, col: 70, offset: 970},
+							pos:  position{line: 54, col: 70, offset: 1067},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 49, col: 73, offset: 973},
+							pos:   position{line: 54, col: 73, offset: 1070},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 49, col: 78, offset: 978},
+								pos:  position{line: 54, col: 78, offset: 1075},
 								name: "Expr",
 							},
 						},
@@ -409,67 +437,67 @@ var g = &grammar{
 		},
 		{
 			name: "QueryDecl",
-			pos:  position{line: 63, col: 1, offset: 1284},
+			pos:  position{line: 68, col: 1, offset: 1381},
 			expr: &actionExpr{
-				pos: position{line: 64, col: 5, offset: 1298},
+				pos: position{line: 69, col: 5, offset: 1395},
 				run: (*parser).callonQueryDecl1,
 				expr: &seqExpr{
-					pos: position{line: 64, col: 5, offset: 1298},
+					pos: position{line: 69, col: 5, offset: 1395},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 64, col: 5, offset: 1298},
+							pos:  position{line: 69, col: 5, offset: 1395},
 							name: "LET",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 64, col: 9, offset: 1302},
+							pos:  position{line: 69, col: 9, offset: 1399},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 64, col: 11, offset: 1304},
+							pos:   position{line: 69, col: 11, offset: 1401},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 64, col: 16, offset: 1309},
+								pos:  position{line: 69, col: 16, offset: 1406},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 64, col: 27, offset: 1320},
+							pos:  position{line: 69, col: 27, offset: 1417},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 64, col: 30, offset: 1323},
+							pos:        position{line: 69, col: 30, offset: 1420},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 64, col: 34, offset: 1327},
+							pos:  position{line: 69, col: 34, offset: 1424},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 64, col: 37, offset: 1330},
+							pos:        position{line: 69, col: 37, offset: 1427},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 64, col: 41, offset: 1334},
+							pos:  position{line: 69, col: 41, offset: 1431},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 64, col: 44, offset: 1337},
+							pos:   position{line: 69, col: 44, offset: 1434},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 64, col: 49, offset: 1342},
+								pos:  position{line: 69, col: 49, offset: 1439},
 								name: "Query",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 64, col: 55, offset: 1348},
+							pos:  position{line: 69, col: 55, offset: 1445},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 64, col: 58, offset: 1351},
+							pos:        position{line: 69, col: 58, offset: 1448},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -482,40 +510,40 @@ var g = &grammar{
 		},
 		{
 			name: "LambdaExpr",
-			pos:  position{line: 73, col: 1, offset: 1521},
+			pos:  position{line: 78, col: 1, offset: 1618},
 			expr: &choiceExpr{
-				pos: position{line: 74, col: 5, offset: 1536},
+				pos: position{line: 79, col: 5, offset: 1633},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 74, col: 5, offset: 1536},
+						pos: position{line: 79, col: 5, offset: 1633},
 						run: (*parser).callonLambdaExpr2,
 						expr: &seqExpr{
-							pos: position{line: 74, col: 5, offset: 1536},
+							pos: position{line: 79, col: 5, offset: 1633},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 74, col: 5, offset: 1536},
+									pos:  position{line: 79, col: 5, offset: 1633},
 									name: "LAMBDA",
 								},
 								&labeledExpr{
-									pos:   position{line: 74, col: 12, offset: 1543},
+									pos:   position{line: 79, col: 12, offset: 1640},
 									label: "params",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 74, col: 19, offset: 1550},
+										pos: position{line: 79, col: 19, offset: 1647},
 										expr: &actionExpr{
-											pos: position{line: 74, col: 20, offset: 1551},
+											pos: position{line: 79, col: 20, offset: 1648},
 											run: (*parser).callonLambdaExpr7,
 											expr: &seqExpr{
-												pos: position{line: 74, col: 20, offset: 1551},
+												pos: position{line: 79, col: 20, offset: 1648},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 74, col: 20, offset: 1551},
+														pos:  position{line: 79, col: 20, offset: 1648},
 														name: "_",
 													},
 													&labeledExpr{
-														pos:   position{line: 74, col: 22, offset: 1553},
+														pos:   position{line: 79, col: 22, offset: 1650},
 														label: "ids",
 														expr: &ruleRefExpr{
-															pos:  position{line: 74, col: 26, offset: 1557},
+															pos:  position{line: 79, col: 26, offset: 1654},
 															name: "Identifiers",
 														},
 													},
@@ -525,24 +553,24 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 74, col: 60, offset: 1591},
+									pos:  position{line: 79, col: 60, offset: 1688},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 74, col: 63, offset: 1594},
+									pos:        position{line: 79, col: 63, offset: 1691},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 74, col: 67, offset: 1598},
+									pos:  position{line: 79, col: 67, offset: 1695},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 74, col: 70, offset: 1601},
+									pos:   position{line: 79, col: 70, offset: 1698},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 75, offset: 1606},
+										pos:  position{line: 79, col: 75, offset: 1703},
 										name: "Expr",
 									},
 								},
@@ -550,35 +578,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 82, col: 7, offset: 1790},
+						pos: position{line: 87, col: 7, offset: 1887},
 						run: (*parser).callonLambdaExpr17,
 						expr: &seqExpr{
-							pos: position{line: 82, col: 7, offset: 1790},
+							pos: position{line: 87, col: 7, offset: 1887},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 82, col: 7, offset: 1790},
+									pos:        position{line: 87, col: 7, offset: 1887},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 82, col: 11, offset: 1794},
+									pos:  position{line: 87, col: 11, offset: 1891},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 82, col: 14, offset: 1797},
+									pos:   position{line: 87, col: 14, offset: 1894},
 									label: "lambda",
 									expr: &ruleRefExpr{
-										pos:  position{line: 82, col: 21, offset: 1804},
+										pos:  position{line: 87, col: 21, offset: 1901},
 										name: "LambdaExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 82, col: 32, offset: 1815},
+									pos:  position{line: 87, col: 32, offset: 1912},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 82, col: 35, offset: 1818},
+									pos:        position{line: 87, col: 35, offset: 1915},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -593,51 +621,51 @@ var g = &grammar{
 		},
 		{
 			name: "FuncOrExprs",
-			pos:  position{line: 84, col: 1, offset: 1846},
+			pos:  position{line: 89, col: 1, offset: 1943},
 			expr: &actionExpr{
-				pos: position{line: 85, col: 5, offset: 1862},
+				pos: position{line: 90, col: 5, offset: 1959},
 				run: (*parser).callonFuncOrExprs1,
 				expr: &seqExpr{
-					pos: position{line: 85, col: 5, offset: 1862},
+					pos: position{line: 90, col: 5, offset: 1959},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 85, col: 5, offset: 1862},
+							pos:   position{line: 90, col: 5, offset: 1959},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 85, col: 11, offset: 1868},
+								pos:  position{line: 90, col: 11, offset: 1965},
 								name: "FuncOrExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 85, col: 22, offset: 1879},
+							pos:   position{line: 90, col: 22, offset: 1976},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 85, col: 27, offset: 1884},
+								pos: position{line: 90, col: 27, offset: 1981},
 								expr: &actionExpr{
-									pos: position{line: 85, col: 28, offset: 1885},
+									pos: position{line: 90, col: 28, offset: 1982},
 									run: (*parser).callonFuncOrExprs7,
 									expr: &seqExpr{
-										pos: position{line: 85, col: 28, offset: 1885},
+										pos: position{line: 90, col: 28, offset: 1982},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 85, col: 28, offset: 1885},
+												pos:  position{line: 90, col: 28, offset: 1982},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 85, col: 31, offset: 1888},
+												pos:        position{line: 90, col: 31, offset: 1985},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 85, col: 35, offset: 1892},
+												pos:  position{line: 90, col: 35, offset: 1989},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 85, col: 38, offset: 1895},
+												pos:   position{line: 90, col: 38, offset: 1992},
 												label: "f",
 												expr: &ruleRefExpr{
-													pos:  position{line: 85, col: 40, offset: 1897},
+													pos:  position{line: 90, col: 40, offset: 1994},
 													name: "FuncOrExpr",
 												},
 											},
@@ -654,16 +682,16 @@ var g = &grammar{
 		},
 		{
 			name: "FuncOrExpr",
-			pos:  position{line: 89, col: 1, offset: 1976},
+			pos:  position{line: 94, col: 1, offset: 2073},
 			expr: &choiceExpr{
-				pos: position{line: 89, col: 14, offset: 1989},
+				pos: position{line: 94, col: 14, offset: 2086},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 89, col: 14, offset: 1989},
+						pos:  position{line: 94, col: 14, offset: 2086},
 						name: "FuncValue",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 89, col: 26, offset: 2001},
+						pos:  position{line: 94, col: 26, offset: 2098},
 						name: "Expr",
 					},
 				},
@@ -673,49 +701,49 @@ var g = &grammar{
 		},
 		{
 			name: "OpDecl",
-			pos:  position{line: 91, col: 1, offset: 2007},
+			pos:  position{line: 96, col: 1, offset: 2104},
 			expr: &actionExpr{
-				pos: position{line: 92, col: 5, offset: 2018},
+				pos: position{line: 97, col: 5, offset: 2115},
 				run: (*parser).callonOpDecl1,
 				expr: &seqExpr{
-					pos: position{line: 92, col: 5, offset: 2018},
+					pos: position{line: 97, col: 5, offset: 2115},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 92, col: 5, offset: 2018},
+							pos:  position{line: 97, col: 5, offset: 2115},
 							name: "OP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 92, col: 8, offset: 2021},
+							pos:  position{line: 97, col: 8, offset: 2118},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 92, col: 10, offset: 2023},
+							pos:   position{line: 97, col: 10, offset: 2120},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 92, col: 15, offset: 2028},
+								pos:  position{line: 97, col: 15, offset: 2125},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 92, col: 26, offset: 2039},
+							pos:   position{line: 97, col: 26, offset: 2136},
 							label: "params",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 92, col: 33, offset: 2046},
+								pos: position{line: 97, col: 33, offset: 2143},
 								expr: &actionExpr{
-									pos: position{line: 92, col: 34, offset: 2047},
+									pos: position{line: 97, col: 34, offset: 2144},
 									run: (*parser).callonOpDecl9,
 									expr: &seqExpr{
-										pos: position{line: 92, col: 34, offset: 2047},
+										pos: position{line: 97, col: 34, offset: 2144},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 92, col: 34, offset: 2047},
+												pos:  position{line: 97, col: 34, offset: 2144},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 92, col: 36, offset: 2049},
+												pos:   position{line: 97, col: 36, offset: 2146},
 												label: "ids",
 												expr: &ruleRefExpr{
-													pos:  position{line: 92, col: 40, offset: 2053},
+													pos:  position{line: 97, col: 40, offset: 2150},
 													name: "Identifiers",
 												},
 											},
@@ -725,24 +753,24 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 92, col: 74, offset: 2087},
+							pos:  position{line: 97, col: 74, offset: 2184},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 92, col: 77, offset: 2090},
+							pos:        position{line: 97, col: 77, offset: 2187},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 92, col: 81, offset: 2094},
+							pos:  position{line: 97, col: 81, offset: 2191},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 92, col: 84, offset: 2097},
+							pos:   position{line: 97, col: 84, offset: 2194},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 92, col: 89, offset: 2102},
+								pos:  position{line: 97, col: 89, offset: 2199},
 								name: "ScopeBody",
 							},
 						},
@@ -754,40 +782,40 @@ var g = &grammar{
 		},
 		{
 			name: "ScopeBody",
-			pos:  position{line: 102, col: 1, offset: 2314},
+			pos:  position{line: 107, col: 1, offset: 2411},
 			expr: &choiceExpr{
-				pos: position{line: 103, col: 5, offset: 2328},
+				pos: position{line: 108, col: 5, offset: 2425},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 103, col: 5, offset: 2328},
+						pos: position{line: 108, col: 5, offset: 2425},
 						run: (*parser).callonScopeBody2,
 						expr: &seqExpr{
-							pos: position{line: 103, col: 5, offset: 2328},
+							pos: position{line: 108, col: 5, offset: 2425},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 103, col: 5, offset: 2328},
+									pos:        position{line: 108, col: 5, offset: 2425},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 103, col: 9, offset: 2332},
+									pos:  position{line: 108, col: 9, offset: 2429},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 103, col: 12, offset: 2335},
+									pos:   position{line: 108, col: 12, offset: 2432},
 									label: "scope",
 									expr: &ruleRefExpr{
-										pos:  position{line: 103, col: 18, offset: 2341},
+										pos:  position{line: 108, col: 18, offset: 2438},
 										name: "Scope",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 103, col: 24, offset: 2347},
+									pos:  position{line: 108, col: 24, offset: 2444},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 103, col: 27, offset: 2350},
+									pos:        position{line: 108, col: 27, offset: 2447},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -796,35 +824,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 104, col: 5, offset: 2389},
+						pos: position{line: 109, col: 5, offset: 2486},
 						run: (*parser).callonScopeBody10,
 						expr: &seqExpr{
-							pos: position{line: 104, col: 5, offset: 2389},
+							pos: position{line: 109, col: 5, offset: 2486},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 104, col: 5, offset: 2389},
+									pos:        position{line: 109, col: 5, offset: 2486},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 104, col: 9, offset: 2393},
+									pos:  position{line: 109, col: 9, offset: 2490},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 104, col: 12, offset: 2396},
+									pos:   position{line: 109, col: 12, offset: 2493},
 									label: "seq",
 									expr: &ruleRefExpr{
-										pos:  position{line: 104, col: 16, offset: 2400},
+										pos:  position{line: 109, col: 16, offset: 2497},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 104, col: 20, offset: 2404},
+									pos:  position{line: 109, col: 20, offset: 2501},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 104, col: 23, offset: 2407},
+									pos:        position{line: 109, col: 23, offset: 2504},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -839,59 +867,59 @@ var g = &grammar{
 		},
 		{
 			name: "PragmaDecl",
-			pos:  position{line: 106, col: 1, offset: 2438},
+			pos:  position{line: 111, col: 1, offset: 2535},
 			expr: &actionExpr{
-				pos: position{line: 107, col: 5, offset: 2453},
+				pos: position{line: 112, col: 5, offset: 2550},
 				run: (*parser).callonPragmaDecl1,
 				expr: &seqExpr{
-					pos: position{line: 107, col: 5, offset: 2453},
+					pos: position{line: 112, col: 5, offset: 2550},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 107, col: 5, offset: 2453},
+							pos:  position{line: 112, col: 5, offset: 2550},
 							name: "PRAGMA",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 107, col: 12, offset: 2460},
+							pos:  position{line: 112, col: 12, offset: 2557},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 107, col: 14, offset: 2462},
+							pos:   position{line: 112, col: 14, offset: 2559},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 107, col: 19, offset: 2467},
+								pos:  position{line: 112, col: 19, offset: 2564},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 107, col: 30, offset: 2478},
+							pos:   position{line: 112, col: 30, offset: 2575},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 107, col: 35, offset: 2483},
+								pos: position{line: 112, col: 35, offset: 2580},
 								expr: &actionExpr{
-									pos: position{line: 107, col: 36, offset: 2484},
+									pos: position{line: 112, col: 36, offset: 2581},
 									run: (*parser).callonPragmaDecl9,
 									expr: &seqExpr{
-										pos: position{line: 107, col: 36, offset: 2484},
+										pos: position{line: 112, col: 36, offset: 2581},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 107, col: 36, offset: 2484},
+												pos:  position{line: 112, col: 36, offset: 2581},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 107, col: 39, offset: 2487},
+												pos:        position{line: 112, col: 39, offset: 2584},
 												val:        "=",
 												ignoreCase: false,
 												want:       "\"=\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 107, col: 43, offset: 2491},
+												pos:  position{line: 112, col: 43, offset: 2588},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 107, col: 46, offset: 2494},
+												pos:   position{line: 112, col: 46, offset: 2591},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 107, col: 48, offset: 2496},
+													pos:  position{line: 112, col: 48, offset: 2593},
 													name: "Expr",
 												},
 											},
@@ -908,48 +936,48 @@ var g = &grammar{
 		},
 		{
 			name: "TypeDecl",
-			pos:  position{line: 119, col: 1, offset: 2728},
+			pos:  position{line: 124, col: 1, offset: 2825},
 			expr: &actionExpr{
-				pos: position{line: 120, col: 5, offset: 2741},
+				pos: position{line: 125, col: 5, offset: 2838},
 				run: (*parser).callonTypeDecl1,
 				expr: &seqExpr{
-					pos: position{line: 120, col: 5, offset: 2741},
+					pos: position{line: 125, col: 5, offset: 2838},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 5, offset: 2741},
+							pos:  position{line: 125, col: 5, offset: 2838},
 							name: "TYPE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 10, offset: 2746},
+							pos:  position{line: 125, col: 10, offset: 2843},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 120, col: 12, offset: 2748},
+							pos:   position{line: 125, col: 12, offset: 2845},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 120, col: 17, offset: 2753},
+								pos:  position{line: 125, col: 17, offset: 2850},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 28, offset: 2764},
+							pos:  position{line: 125, col: 28, offset: 2861},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 120, col: 31, offset: 2767},
+							pos:        position{line: 125, col: 31, offset: 2864},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 120, col: 35, offset: 2771},
+							pos:  position{line: 125, col: 35, offset: 2868},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 120, col: 38, offset: 2774},
+							pos:   position{line: 125, col: 38, offset: 2871},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 120, col: 42, offset: 2778},
+								pos:  position{line: 125, col: 42, offset: 2875},
 								name: "Type",
 							},
 						},
@@ -961,44 +989,44 @@ var g = &grammar{
 		},
 		{
 			name: "PipeOp",
-			pos:  position{line: 133, col: 1, offset: 3221},
+			pos:  position{line: 138, col: 1, offset: 3318},
 			expr: &choiceExpr{
-				pos: position{line: 134, col: 5, offset: 3232},
+				pos: position{line: 139, col: 5, offset: 3329},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 134, col: 5, offset: 3232},
+						pos:  position{line: 139, col: 5, offset: 3329},
 						name: "Operator",
 					},
 					&actionExpr{
-						pos: position{line: 135, col: 5, offset: 3245},
+						pos: position{line: 140, col: 5, offset: 3342},
 						run: (*parser).callonPipeOp3,
 						expr: &seqExpr{
-							pos: position{line: 135, col: 5, offset: 3245},
+							pos: position{line: 140, col: 5, offset: 3342},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 135, col: 5, offset: 3245},
+									pos:        position{line: 140, col: 5, offset: 3342},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 135, col: 9, offset: 3249},
+									pos:  position{line: 140, col: 9, offset: 3346},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 135, col: 12, offset: 3252},
+									pos:   position{line: 140, col: 12, offset: 3349},
 									label: "scope",
 									expr: &ruleRefExpr{
-										pos:  position{line: 135, col: 18, offset: 3258},
+										pos:  position{line: 140, col: 18, offset: 3355},
 										name: "Scope",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 135, col: 24, offset: 3264},
+									pos:  position{line: 140, col: 24, offset: 3361},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 135, col: 27, offset: 3267},
+									pos:        position{line: 140, col: 27, offset: 3364},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -1007,23 +1035,23 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 136, col: 5, offset: 3297},
+						pos: position{line: 141, col: 5, offset: 3394},
 						run: (*parser).callonPipeOp11,
 						expr: &seqExpr{
-							pos: position{line: 136, col: 5, offset: 3297},
+							pos: position{line: 141, col: 5, offset: 3394},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 136, col: 5, offset: 3297},
+									pos:   position{line: 141, col: 5, offset: 3394},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 136, col: 7, offset: 3299},
+										pos:  position{line: 141, col: 7, offset: 3396},
 										name: "AssignmentOp",
 									},
 								},
 								&andExpr{
-									pos: position{line: 136, col: 20, offset: 3312},
+									pos: position{line: 141, col: 20, offset: 3409},
 									expr: &ruleRefExpr{
-										pos:  position{line: 136, col: 21, offset: 3313},
+										pos:  position{line: 141, col: 21, offset: 3410},
 										name: "EndOfOp",
 									},
 								},
@@ -1031,39 +1059,39 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 137, col: 5, offset: 3343},
+						pos: position{line: 142, col: 5, offset: 3440},
 						run: (*parser).callonPipeOp17,
 						expr: &seqExpr{
-							pos: position{line: 137, col: 5, offset: 3343},
+							pos: position{line: 142, col: 5, offset: 3440},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 137, col: 5, offset: 3343},
+									pos: position{line: 142, col: 5, offset: 3440},
 									expr: &seqExpr{
-										pos: position{line: 137, col: 7, offset: 3345},
+										pos: position{line: 142, col: 7, offset: 3442},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 137, col: 7, offset: 3345},
+												pos:  position{line: 142, col: 7, offset: 3442},
 												name: "Function",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 137, col: 16, offset: 3354},
+												pos:  position{line: 142, col: 16, offset: 3451},
 												name: "EndOfOp",
 											},
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 137, col: 25, offset: 3363},
+									pos:   position{line: 142, col: 25, offset: 3460},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 137, col: 27, offset: 3365},
+										pos:  position{line: 142, col: 27, offset: 3462},
 										name: "Aggregation",
 									},
 								},
 								&andExpr{
-									pos: position{line: 137, col: 39, offset: 3377},
+									pos: position{line: 142, col: 39, offset: 3474},
 									expr: &ruleRefExpr{
-										pos:  position{line: 137, col: 40, offset: 3378},
+										pos:  position{line: 142, col: 40, offset: 3475},
 										name: "EndOfOp",
 									},
 								},
@@ -1071,42 +1099,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 138, col: 5, offset: 3408},
+						pos: position{line: 143, col: 5, offset: 3505},
 						run: (*parser).callonPipeOp27,
 						expr: &seqExpr{
-							pos: position{line: 138, col: 5, offset: 3408},
+							pos: position{line: 143, col: 5, offset: 3505},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 138, col: 5, offset: 3408},
+									pos: position{line: 143, col: 5, offset: 3505},
 									expr: &ruleRefExpr{
-										pos:  position{line: 138, col: 6, offset: 3409},
+										pos:  position{line: 143, col: 6, offset: 3506},
 										name: "CallIDGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 138, col: 18, offset: 3421},
+									pos:   position{line: 143, col: 18, offset: 3518},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 138, col: 23, offset: 3426},
+										pos:  position{line: 143, col: 23, offset: 3523},
 										name: "Identifier",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 138, col: 34, offset: 3437},
+									pos:  position{line: 143, col: 34, offset: 3534},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 138, col: 36, offset: 3439},
+									pos: position{line: 143, col: 36, offset: 3536},
 									expr: &ruleRefExpr{
-										pos:  position{line: 138, col: 37, offset: 3440},
+										pos:  position{line: 143, col: 37, offset: 3537},
 										name: "CallExprGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 138, col: 51, offset: 3454},
+									pos:   position{line: 143, col: 51, offset: 3551},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 138, col: 56, offset: 3459},
+										pos:  position{line: 143, col: 56, offset: 3556},
 										name: "FuncOrExprs",
 									},
 								},
@@ -1114,30 +1142,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 141, col: 5, offset: 3592},
+						pos: position{line: 146, col: 5, offset: 3689},
 						run: (*parser).callonPipeOp38,
 						expr: &seqExpr{
-							pos: position{line: 141, col: 5, offset: 3592},
+							pos: position{line: 146, col: 5, offset: 3689},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 141, col: 5, offset: 3592},
+									pos: position{line: 146, col: 5, offset: 3689},
 									expr: &ruleRefExpr{
-										pos:  position{line: 141, col: 6, offset: 3593},
+										pos:  position{line: 146, col: 6, offset: 3690},
 										name: "CallIDGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 141, col: 18, offset: 3605},
+									pos:   position{line: 146, col: 18, offset: 3702},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 141, col: 23, offset: 3610},
+										pos:  position{line: 146, col: 23, offset: 3707},
 										name: "Identifier",
 									},
 								},
 								&andExpr{
-									pos: position{line: 141, col: 34, offset: 3621},
+									pos: position{line: 146, col: 34, offset: 3718},
 									expr: &ruleRefExpr{
-										pos:  position{line: 141, col: 35, offset: 3622},
+										pos:  position{line: 146, col: 35, offset: 3719},
 										name: "EndOfOp",
 									},
 								},
@@ -1145,42 +1173,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 144, col: 5, offset: 3721},
+						pos: position{line: 149, col: 5, offset: 3818},
 						run: (*parser).callonPipeOp46,
 						expr: &seqExpr{
-							pos: position{line: 144, col: 5, offset: 3721},
+							pos: position{line: 149, col: 5, offset: 3818},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 144, col: 5, offset: 3721},
+									pos: position{line: 149, col: 5, offset: 3818},
 									expr: &seqExpr{
-										pos: position{line: 144, col: 7, offset: 3723},
+										pos: position{line: 149, col: 7, offset: 3820},
 										exprs: []any{
 											&choiceExpr{
-												pos: position{line: 144, col: 8, offset: 3724},
+												pos: position{line: 149, col: 8, offset: 3821},
 												alternatives: []any{
 													&ruleRefExpr{
-														pos:  position{line: 144, col: 8, offset: 3724},
+														pos:  position{line: 149, col: 8, offset: 3821},
 														name: "Identifier",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 144, col: 21, offset: 3737},
+														pos:  position{line: 149, col: 21, offset: 3834},
 														name: "Literal",
 													},
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 144, col: 30, offset: 3746},
+												pos:  position{line: 149, col: 30, offset: 3843},
 												name: "__",
 											},
 											&choiceExpr{
-												pos: position{line: 144, col: 34, offset: 3750},
+												pos: position{line: 149, col: 34, offset: 3847},
 												alternatives: []any{
 													&ruleRefExpr{
-														pos:  position{line: 144, col: 34, offset: 3750},
+														pos:  position{line: 149, col: 34, offset: 3847},
 														name: "Pipe",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 144, col: 39, offset: 3755},
+														pos:  position{line: 149, col: 39, offset: 3852},
 														name: "EOF",
 													},
 												},
@@ -1189,10 +1217,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 144, col: 45, offset: 3761},
+									pos:   position{line: 149, col: 45, offset: 3858},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 144, col: 47, offset: 3763},
+										pos:  position{line: 149, col: 47, offset: 3860},
 										name: "Expr",
 									},
 								},
@@ -1206,41 +1234,41 @@ var g = &grammar{
 		},
 		{
 			name: "EndOfOp",
-			pos:  position{line: 148, col: 1, offset: 3851},
+			pos:  position{line: 153, col: 1, offset: 3948},
 			expr: &seqExpr{
-				pos: position{line: 148, col: 11, offset: 3861},
+				pos: position{line: 153, col: 11, offset: 3958},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 148, col: 11, offset: 3861},
+						pos:  position{line: 153, col: 11, offset: 3958},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 148, col: 15, offset: 3865},
+						pos: position{line: 153, col: 15, offset: 3962},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 148, col: 15, offset: 3865},
+								pos:  position{line: 153, col: 15, offset: 3962},
 								name: "Pipe",
 							},
 							&litMatcher{
-								pos:        position{line: 148, col: 22, offset: 3872},
+								pos:        position{line: 153, col: 22, offset: 3969},
 								val:        ")",
 								ignoreCase: false,
 								want:       "\")\"",
 							},
 							&litMatcher{
-								pos:        position{line: 148, col: 28, offset: 3878},
+								pos:        position{line: 153, col: 28, offset: 3975},
 								val:        "]",
 								ignoreCase: false,
 								want:       "\"]\"",
 							},
 							&litMatcher{
-								pos:        position{line: 148, col: 34, offset: 3884},
+								pos:        position{line: 153, col: 34, offset: 3981},
 								val:        ";",
 								ignoreCase: false,
 								want:       "\";\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 148, col: 40, offset: 3890},
+								pos:  position{line: 153, col: 40, offset: 3987},
 								name: "EOF",
 							},
 						},
@@ -1252,18 +1280,18 @@ var g = &grammar{
 		},
 		{
 			name: "Pipe",
-			pos:  position{line: 149, col: 1, offset: 3895},
+			pos:  position{line: 154, col: 1, offset: 3992},
 			expr: &choiceExpr{
-				pos: position{line: 149, col: 8, offset: 3902},
+				pos: position{line: 154, col: 8, offset: 3999},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 149, col: 8, offset: 3902},
+						pos:        position{line: 154, col: 8, offset: 3999},
 						val:        "|>",
 						ignoreCase: false,
 						want:       "\"|>\"",
 					},
 					&litMatcher{
-						pos:        position{line: 149, col: 15, offset: 3909},
+						pos:        position{line: 154, col: 15, offset: 4006},
 						val:        "|",
 						ignoreCase: false,
 						want:       "\"|\"",
@@ -1275,28 +1303,28 @@ var g = &grammar{
 		},
 		{
 			name: "CallExprGuard",
-			pos:  position{line: 151, col: 1, offset: 3914},
+			pos:  position{line: 156, col: 1, offset: 4011},
 			expr: &choiceExpr{
-				pos: position{line: 151, col: 17, offset: 3930},
+				pos: position{line: 156, col: 17, offset: 4027},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 151, col: 17, offset: 3930},
+						pos:  position{line: 156, col: 17, offset: 4027},
 						name: "IN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 151, col: 22, offset: 3935},
+						pos:  position{line: 156, col: 22, offset: 4032},
 						name: "LIKE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 151, col: 29, offset: 3942},
+						pos:  position{line: 156, col: 29, offset: 4039},
 						name: "IS",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 151, col: 34, offset: 3947},
+						pos:  position{line: 156, col: 34, offset: 4044},
 						name: "OR",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 151, col: 39, offset: 3952},
+						pos:  position{line: 156, col: 39, offset: 4049},
 						name: "AND",
 					},
 				},
@@ -1306,20 +1334,20 @@ var g = &grammar{
 		},
 		{
 			name: "CallIDGuard",
-			pos:  position{line: 152, col: 1, offset: 3956},
+			pos:  position{line: 157, col: 1, offset: 4053},
 			expr: &choiceExpr{
-				pos: position{line: 152, col: 15, offset: 3970},
+				pos: position{line: 157, col: 15, offset: 4067},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 152, col: 15, offset: 3970},
+						pos:  position{line: 157, col: 15, offset: 4067},
 						name: "NOT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 152, col: 21, offset: 3976},
+						pos:  position{line: 157, col: 21, offset: 4073},
 						name: "SQLGuard",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 152, col: 32, offset: 3987},
+						pos:  position{line: 157, col: 32, offset: 4084},
 						name: "PRAGMA",
 					},
 				},
@@ -1329,49 +1357,49 @@ var g = &grammar{
 		},
 		{
 			name: "ExprGuard",
-			pos:  position{line: 154, col: 1, offset: 3995},
+			pos:  position{line: 159, col: 1, offset: 4092},
 			expr: &seqExpr{
-				pos: position{line: 154, col: 13, offset: 4007},
+				pos: position{line: 159, col: 13, offset: 4104},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 154, col: 13, offset: 4007},
+						pos:  position{line: 159, col: 13, offset: 4104},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 154, col: 17, offset: 4011},
+						pos: position{line: 159, col: 17, offset: 4108},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 154, col: 17, offset: 4011},
+								pos:  position{line: 159, col: 17, offset: 4108},
 								name: "Comparator",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 154, col: 30, offset: 4024},
+								pos:  position{line: 159, col: 30, offset: 4121},
 								name: "AdditiveOperator",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 154, col: 49, offset: 4043},
+								pos:  position{line: 159, col: 49, offset: 4140},
 								name: "MultiplicativeOperator",
 							},
 							&litMatcher{
-								pos:        position{line: 154, col: 74, offset: 4068},
+								pos:        position{line: 159, col: 74, offset: 4165},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 							&litMatcher{
-								pos:        position{line: 154, col: 80, offset: 4074},
+								pos:        position{line: 159, col: 80, offset: 4171},
 								val:        "(",
 								ignoreCase: false,
 								want:       "\"(\"",
 							},
 							&litMatcher{
-								pos:        position{line: 154, col: 86, offset: 4080},
+								pos:        position{line: 159, col: 86, offset: 4177},
 								val:        "[",
 								ignoreCase: false,
 								want:       "\"[\"",
 							},
 							&litMatcher{
-								pos:        position{line: 154, col: 92, offset: 4086},
+								pos:        position{line: 159, col: 92, offset: 4183},
 								val:        "~",
 								ignoreCase: false,
 								want:       "\"~\"",
@@ -1385,68 +1413,68 @@ var g = &grammar{
 		},
 		{
 			name: "Comparator",
-			pos:  position{line: 156, col: 1, offset: 4092},
+			pos:  position{line: 161, col: 1, offset: 4189},
 			expr: &choiceExpr{
-				pos: position{line: 157, col: 5, offset: 4107},
+				pos: position{line: 162, col: 5, offset: 4204},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 157, col: 5, offset: 4107},
+						pos: position{line: 162, col: 5, offset: 4204},
 						run: (*parser).callonComparator2,
 						expr: &choiceExpr{
-							pos: position{line: 157, col: 6, offset: 4108},
+							pos: position{line: 162, col: 6, offset: 4205},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 157, col: 6, offset: 4108},
+									pos:        position{line: 162, col: 6, offset: 4205},
 									val:        "==",
 									ignoreCase: false,
 									want:       "\"==\"",
 								},
 								&litMatcher{
-									pos:        position{line: 157, col: 13, offset: 4115},
+									pos:        position{line: 162, col: 13, offset: 4212},
 									val:        "=",
 									ignoreCase: false,
 									want:       "\"=\"",
 								},
 								&litMatcher{
-									pos:        position{line: 157, col: 19, offset: 4121},
+									pos:        position{line: 162, col: 19, offset: 4218},
 									val:        "!=",
 									ignoreCase: false,
 									want:       "\"!=\"",
 								},
 								&litMatcher{
-									pos:        position{line: 157, col: 26, offset: 4128},
+									pos:        position{line: 162, col: 26, offset: 4225},
 									val:        "<>",
 									ignoreCase: false,
 									want:       "\"<>\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 157, col: 33, offset: 4135},
+									pos:  position{line: 162, col: 33, offset: 4232},
 									name: "IN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 157, col: 38, offset: 4140},
+									pos:  position{line: 162, col: 38, offset: 4237},
 									name: "LIKE",
 								},
 								&litMatcher{
-									pos:        position{line: 157, col: 45, offset: 4147},
+									pos:        position{line: 162, col: 45, offset: 4244},
 									val:        "<=",
 									ignoreCase: false,
 									want:       "\"<=\"",
 								},
 								&litMatcher{
-									pos:        position{line: 157, col: 52, offset: 4154},
+									pos:        position{line: 162, col: 52, offset: 4251},
 									val:        "<",
 									ignoreCase: false,
 									want:       "\"<\"",
 								},
 								&litMatcher{
-									pos:        position{line: 157, col: 58, offset: 4160},
+									pos:        position{line: 162, col: 58, offset: 4257},
 									val:        ">=",
 									ignoreCase: false,
 									want:       "\">=\"",
 								},
 								&litMatcher{
-									pos:        position{line: 157, col: 65, offset: 4167},
+									pos:        position{line: 162, col: 65, offset: 4264},
 									val:        ">",
 									ignoreCase: false,
 									want:       "\">\"",
@@ -1455,42 +1483,42 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 158, col: 5, offset: 4207},
+						pos: position{line: 163, col: 5, offset: 4304},
 						run: (*parser).callonComparator14,
 						expr: &seqExpr{
-							pos: position{line: 158, col: 5, offset: 4207},
+							pos: position{line: 163, col: 5, offset: 4304},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 158, col: 5, offset: 4207},
+									pos:  position{line: 163, col: 5, offset: 4304},
 									name: "NOT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 158, col: 9, offset: 4211},
+									pos:  position{line: 163, col: 9, offset: 4308},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 158, col: 11, offset: 4213},
+									pos:  position{line: 163, col: 11, offset: 4310},
 									name: "LIKE",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 159, col: 5, offset: 4249},
+						pos: position{line: 164, col: 5, offset: 4346},
 						run: (*parser).callonComparator19,
 						expr: &seqExpr{
-							pos: position{line: 159, col: 5, offset: 4249},
+							pos: position{line: 164, col: 5, offset: 4346},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 159, col: 5, offset: 4249},
+									pos:  position{line: 164, col: 5, offset: 4346},
 									name: "NOT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 159, col: 9, offset: 4253},
+									pos:  position{line: 164, col: 9, offset: 4350},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 159, col: 11, offset: 4255},
+									pos:  position{line: 164, col: 11, offset: 4352},
 									name: "IN",
 								},
 							},
@@ -1503,28 +1531,28 @@ var g = &grammar{
 		},
 		{
 			name: "SearchBoolean",
-			pos:  position{line: 161, col: 1, offset: 4284},
+			pos:  position{line: 166, col: 1, offset: 4381},
 			expr: &actionExpr{
-				pos: position{line: 162, col: 5, offset: 4302},
+				pos: position{line: 167, col: 5, offset: 4399},
 				run: (*parser).callonSearchBoolean1,
 				expr: &seqExpr{
-					pos: position{line: 162, col: 5, offset: 4302},
+					pos: position{line: 167, col: 5, offset: 4399},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 162, col: 5, offset: 4302},
+							pos:   position{line: 167, col: 5, offset: 4399},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 162, col: 11, offset: 4308},
+								pos:  position{line: 167, col: 11, offset: 4405},
 								name: "SearchAnd",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 162, col: 21, offset: 4318},
+							pos:   position{line: 167, col: 21, offset: 4415},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 162, col: 26, offset: 4323},
+								pos: position{line: 167, col: 26, offset: 4420},
 								expr: &ruleRefExpr{
-									pos:  position{line: 162, col: 26, offset: 4323},
+									pos:  position{line: 167, col: 26, offset: 4420},
 									name: "SearchOrTerm",
 								},
 							},
@@ -1537,30 +1565,30 @@ var g = &grammar{
 		},
 		{
 			name: "SearchOrTerm",
-			pos:  position{line: 166, col: 1, offset: 4400},
+			pos:  position{line: 171, col: 1, offset: 4497},
 			expr: &actionExpr{
-				pos: position{line: 166, col: 16, offset: 4415},
+				pos: position{line: 171, col: 16, offset: 4512},
 				run: (*parser).callonSearchOrTerm1,
 				expr: &seqExpr{
-					pos: position{line: 166, col: 16, offset: 4415},
+					pos: position{line: 171, col: 16, offset: 4512},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 166, col: 16, offset: 4415},
+							pos:  position{line: 171, col: 16, offset: 4512},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 166, col: 18, offset: 4417},
+							pos:  position{line: 171, col: 18, offset: 4514},
 							name: "OR",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 166, col: 21, offset: 4420},
+							pos:  position{line: 171, col: 21, offset: 4517},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 166, col: 23, offset: 4422},
+							pos:   position{line: 171, col: 23, offset: 4519},
 							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 166, col: 25, offset: 4424},
+								pos:  position{line: 171, col: 25, offset: 4521},
 								name: "SearchAnd",
 							},
 						},
@@ -1572,64 +1600,64 @@ var g = &grammar{
 		},
 		{
 			name: "SearchAnd",
-			pos:  position{line: 168, col: 1, offset: 4466},
+			pos:  position{line: 173, col: 1, offset: 4563},
 			expr: &actionExpr{
-				pos: position{line: 169, col: 5, offset: 4480},
+				pos: position{line: 174, col: 5, offset: 4577},
 				run: (*parser).callonSearchAnd1,
 				expr: &seqExpr{
-					pos: position{line: 169, col: 5, offset: 4480},
+					pos: position{line: 174, col: 5, offset: 4577},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 169, col: 5, offset: 4480},
+							pos:   position{line: 174, col: 5, offset: 4577},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 11, offset: 4486},
+								pos:  position{line: 174, col: 11, offset: 4583},
 								name: "SearchFactor",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 170, col: 5, offset: 4503},
+							pos:   position{line: 175, col: 5, offset: 4600},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 170, col: 10, offset: 4508},
+								pos: position{line: 175, col: 10, offset: 4605},
 								expr: &actionExpr{
-									pos: position{line: 170, col: 11, offset: 4509},
+									pos: position{line: 175, col: 11, offset: 4606},
 									run: (*parser).callonSearchAnd7,
 									expr: &seqExpr{
-										pos: position{line: 170, col: 11, offset: 4509},
+										pos: position{line: 175, col: 11, offset: 4606},
 										exprs: []any{
 											&zeroOrOneExpr{
-												pos: position{line: 170, col: 11, offset: 4509},
+												pos: position{line: 175, col: 11, offset: 4606},
 												expr: &seqExpr{
-													pos: position{line: 170, col: 12, offset: 4510},
+													pos: position{line: 175, col: 12, offset: 4607},
 													exprs: []any{
 														&ruleRefExpr{
-															pos:  position{line: 170, col: 12, offset: 4510},
+															pos:  position{line: 175, col: 12, offset: 4607},
 															name: "_",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 170, col: 14, offset: 4512},
+															pos:  position{line: 175, col: 14, offset: 4609},
 															name: "AND",
 														},
 													},
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 170, col: 20, offset: 4518},
+												pos:  position{line: 175, col: 20, offset: 4615},
 												name: "_",
 											},
 											&notExpr{
-												pos: position{line: 170, col: 22, offset: 4520},
+												pos: position{line: 175, col: 22, offset: 4617},
 												expr: &ruleRefExpr{
-													pos:  position{line: 170, col: 23, offset: 4521},
+													pos:  position{line: 175, col: 23, offset: 4618},
 													name: "OR",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 170, col: 26, offset: 4524},
+												pos:   position{line: 175, col: 26, offset: 4621},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 170, col: 31, offset: 4529},
+													pos:  position{line: 175, col: 31, offset: 4626},
 													name: "SearchFactor",
 												},
 											},
@@ -1646,43 +1674,43 @@ var g = &grammar{
 		},
 		{
 			name: "SearchFactor",
-			pos:  position{line: 174, col: 1, offset: 4642},
+			pos:  position{line: 179, col: 1, offset: 4739},
 			expr: &choiceExpr{
-				pos: position{line: 175, col: 5, offset: 4659},
+				pos: position{line: 180, col: 5, offset: 4756},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 175, col: 5, offset: 4659},
+						pos: position{line: 180, col: 5, offset: 4756},
 						run: (*parser).callonSearchFactor2,
 						expr: &seqExpr{
-							pos: position{line: 175, col: 5, offset: 4659},
+							pos: position{line: 180, col: 5, offset: 4756},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 175, col: 6, offset: 4660},
+									pos: position{line: 180, col: 6, offset: 4757},
 									alternatives: []any{
 										&seqExpr{
-											pos: position{line: 175, col: 6, offset: 4660},
+											pos: position{line: 180, col: 6, offset: 4757},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 175, col: 6, offset: 4660},
+													pos:  position{line: 180, col: 6, offset: 4757},
 													name: "NOT",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 175, col: 10, offset: 4664},
+													pos:  position{line: 180, col: 10, offset: 4761},
 													name: "_",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 175, col: 14, offset: 4668},
+											pos: position{line: 180, col: 14, offset: 4765},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 175, col: 14, offset: 4668},
+													pos:        position{line: 180, col: 14, offset: 4765},
 													val:        "!",
 													ignoreCase: false,
 													want:       "\"!\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 175, col: 18, offset: 4672},
+													pos:  position{line: 180, col: 18, offset: 4769},
 													name: "__",
 												},
 											},
@@ -1690,10 +1718,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 175, col: 22, offset: 4676},
+									pos:   position{line: 180, col: 22, offset: 4773},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 175, col: 24, offset: 4678},
+										pos:  position{line: 180, col: 24, offset: 4775},
 										name: "SearchFactor",
 									},
 								},
@@ -1701,35 +1729,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 183, col: 5, offset: 4849},
+						pos: position{line: 188, col: 5, offset: 4946},
 						run: (*parser).callonSearchFactor13,
 						expr: &seqExpr{
-							pos: position{line: 183, col: 5, offset: 4849},
+							pos: position{line: 188, col: 5, offset: 4946},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 183, col: 5, offset: 4849},
+									pos:        position{line: 188, col: 5, offset: 4946},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 183, col: 9, offset: 4853},
+									pos:  position{line: 188, col: 9, offset: 4950},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 183, col: 12, offset: 4856},
+									pos:   position{line: 188, col: 12, offset: 4953},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 183, col: 17, offset: 4861},
+										pos:  position{line: 188, col: 17, offset: 4958},
 										name: "SearchBoolean",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 183, col: 31, offset: 4875},
+									pos:  position{line: 188, col: 31, offset: 4972},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 183, col: 34, offset: 4878},
+									pos:        position{line: 188, col: 34, offset: 4975},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -1738,7 +1766,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 184, col: 5, offset: 4907},
+						pos:  position{line: 189, col: 5, offset: 5004},
 						name: "SearchExpr",
 					},
 				},
@@ -1748,32 +1776,32 @@ var g = &grammar{
 		},
 		{
 			name: "SearchExpr",
-			pos:  position{line: 186, col: 1, offset: 4919},
+			pos:  position{line: 191, col: 1, offset: 5016},
 			expr: &choiceExpr{
-				pos: position{line: 187, col: 5, offset: 4934},
+				pos: position{line: 192, col: 5, offset: 5031},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 187, col: 5, offset: 4934},
+						pos:  position{line: 192, col: 5, offset: 5031},
 						name: "Regexp",
 					},
 					&actionExpr{
-						pos: position{line: 188, col: 5, offset: 4945},
+						pos: position{line: 193, col: 5, offset: 5042},
 						run: (*parser).callonSearchExpr3,
 						expr: &seqExpr{
-							pos: position{line: 188, col: 5, offset: 4945},
+							pos: position{line: 193, col: 5, offset: 5042},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 188, col: 5, offset: 4945},
+									pos:   position{line: 193, col: 5, offset: 5042},
 									label: "g",
 									expr: &ruleRefExpr{
-										pos:  position{line: 188, col: 7, offset: 4947},
+										pos:  position{line: 193, col: 7, offset: 5044},
 										name: "Glob",
 									},
 								},
 								&notExpr{
-									pos: position{line: 188, col: 12, offset: 4952},
+									pos: position{line: 193, col: 12, offset: 5049},
 									expr: &ruleRefExpr{
-										pos:  position{line: 188, col: 13, offset: 4953},
+										pos:  position{line: 193, col: 13, offset: 5050},
 										name: "ExprGuard",
 									},
 								},
@@ -1781,40 +1809,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 189, col: 5, offset: 4985},
+						pos: position{line: 194, col: 5, offset: 5082},
 						run: (*parser).callonSearchExpr9,
 						expr: &seqExpr{
-							pos: position{line: 189, col: 5, offset: 4985},
+							pos: position{line: 194, col: 5, offset: 5082},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 189, col: 5, offset: 4985},
+									pos:   position{line: 194, col: 5, offset: 5082},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 189, col: 7, offset: 4987},
+										pos:  position{line: 194, col: 7, offset: 5084},
 										name: "SearchValue",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 189, col: 20, offset: 5000},
+									pos: position{line: 194, col: 20, offset: 5097},
 									alternatives: []any{
 										&notExpr{
-											pos: position{line: 189, col: 20, offset: 5000},
+											pos: position{line: 194, col: 20, offset: 5097},
 											expr: &ruleRefExpr{
-												pos:  position{line: 189, col: 21, offset: 5001},
+												pos:  position{line: 194, col: 21, offset: 5098},
 												name: "ExprGuard",
 											},
 										},
 										&andExpr{
-											pos: position{line: 189, col: 33, offset: 5013},
+											pos: position{line: 194, col: 33, offset: 5110},
 											expr: &seqExpr{
-												pos: position{line: 189, col: 35, offset: 5015},
+												pos: position{line: 194, col: 35, offset: 5112},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 189, col: 35, offset: 5015},
+														pos:  position{line: 194, col: 35, offset: 5112},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 189, col: 37, offset: 5017},
+														pos:  position{line: 194, col: 37, offset: 5114},
 														name: "Glob",
 													},
 												},
@@ -1826,7 +1854,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 197, col: 5, offset: 5194},
+						pos:  position{line: 202, col: 5, offset: 5291},
 						name: "SearchPredicate",
 					},
 				},
@@ -1836,45 +1864,45 @@ var g = &grammar{
 		},
 		{
 			name: "SearchPredicate",
-			pos:  position{line: 199, col: 1, offset: 5211},
+			pos:  position{line: 204, col: 1, offset: 5308},
 			expr: &choiceExpr{
-				pos: position{line: 200, col: 5, offset: 5231},
+				pos: position{line: 205, col: 5, offset: 5328},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 200, col: 5, offset: 5231},
+						pos: position{line: 205, col: 5, offset: 5328},
 						run: (*parser).callonSearchPredicate2,
 						expr: &seqExpr{
-							pos: position{line: 200, col: 5, offset: 5231},
+							pos: position{line: 205, col: 5, offset: 5328},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 200, col: 5, offset: 5231},
+									pos:   position{line: 205, col: 5, offset: 5328},
 									label: "lhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 200, col: 9, offset: 5235},
+										pos:  position{line: 205, col: 9, offset: 5332},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 200, col: 22, offset: 5248},
+									pos:  position{line: 205, col: 22, offset: 5345},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 200, col: 25, offset: 5251},
+									pos:   position{line: 205, col: 25, offset: 5348},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 200, col: 28, offset: 5254},
+										pos:  position{line: 205, col: 28, offset: 5351},
 										name: "Comparator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 200, col: 39, offset: 5265},
+									pos:  position{line: 205, col: 39, offset: 5362},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 200, col: 42, offset: 5268},
+									pos:   position{line: 205, col: 42, offset: 5365},
 									label: "rhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 200, col: 46, offset: 5272},
+										pos:  position{line: 205, col: 46, offset: 5369},
 										name: "AdditiveExpr",
 									},
 								},
@@ -1882,13 +1910,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 209, col: 5, offset: 5472},
+						pos: position{line: 214, col: 5, offset: 5569},
 						run: (*parser).callonSearchPredicate12,
 						expr: &labeledExpr{
-							pos:   position{line: 209, col: 5, offset: 5472},
+							pos:   position{line: 214, col: 5, offset: 5569},
 							label: "f",
 							expr: &ruleRefExpr{
-								pos:  position{line: 209, col: 7, offset: 5474},
+								pos:  position{line: 214, col: 7, offset: 5571},
 								name: "Function",
 							},
 						},
@@ -1900,32 +1928,32 @@ var g = &grammar{
 		},
 		{
 			name: "SearchValue",
-			pos:  position{line: 211, col: 1, offset: 5502},
+			pos:  position{line: 216, col: 1, offset: 5599},
 			expr: &choiceExpr{
-				pos: position{line: 212, col: 5, offset: 5518},
+				pos: position{line: 217, col: 5, offset: 5615},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 212, col: 5, offset: 5518},
+						pos:  position{line: 217, col: 5, offset: 5615},
 						name: "Literal",
 					},
 					&actionExpr{
-						pos: position{line: 213, col: 5, offset: 5530},
+						pos: position{line: 218, col: 5, offset: 5627},
 						run: (*parser).callonSearchValue3,
 						expr: &seqExpr{
-							pos: position{line: 213, col: 5, offset: 5530},
+							pos: position{line: 218, col: 5, offset: 5627},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 213, col: 5, offset: 5530},
+									pos: position{line: 218, col: 5, offset: 5627},
 									expr: &ruleRefExpr{
-										pos:  position{line: 213, col: 6, offset: 5531},
+										pos:  position{line: 218, col: 6, offset: 5628},
 										name: "Regexp",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 213, col: 13, offset: 5538},
+									pos:   position{line: 218, col: 13, offset: 5635},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 213, col: 15, offset: 5540},
+										pos:  position{line: 218, col: 15, offset: 5637},
 										name: "KeyWord",
 									},
 								},
@@ -1939,15 +1967,15 @@ var g = &grammar{
 		},
 		{
 			name: "Glob",
-			pos:  position{line: 217, col: 1, offset: 5613},
+			pos:  position{line: 222, col: 1, offset: 5710},
 			expr: &actionExpr{
-				pos: position{line: 218, col: 5, offset: 5622},
+				pos: position{line: 223, col: 5, offset: 5719},
 				run: (*parser).callonGlob1,
 				expr: &labeledExpr{
-					pos:   position{line: 218, col: 5, offset: 5622},
+					pos:   position{line: 223, col: 5, offset: 5719},
 					label: "pattern",
 					expr: &ruleRefExpr{
-						pos:  position{line: 218, col: 13, offset: 5630},
+						pos:  position{line: 223, col: 13, offset: 5727},
 						name: "GlobPattern",
 					},
 				},
@@ -1957,37 +1985,37 @@ var g = &grammar{
 		},
 		{
 			name: "Regexp",
-			pos:  position{line: 222, col: 1, offset: 5741},
+			pos:  position{line: 227, col: 1, offset: 5838},
 			expr: &actionExpr{
-				pos: position{line: 223, col: 5, offset: 5752},
+				pos: position{line: 228, col: 5, offset: 5849},
 				run: (*parser).callonRegexp1,
 				expr: &seqExpr{
-					pos: position{line: 223, col: 5, offset: 5752},
+					pos: position{line: 228, col: 5, offset: 5849},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 223, col: 5, offset: 5752},
+							pos:        position{line: 228, col: 5, offset: 5849},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 223, col: 9, offset: 5756},
+							pos:   position{line: 228, col: 9, offset: 5853},
 							label: "pattern",
 							expr: &ruleRefExpr{
-								pos:  position{line: 223, col: 17, offset: 5764},
+								pos:  position{line: 228, col: 17, offset: 5861},
 								name: "RegexpBody",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 223, col: 28, offset: 5775},
+							pos:        position{line: 228, col: 28, offset: 5872},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&notExpr{
-							pos: position{line: 223, col: 32, offset: 5779},
+							pos: position{line: 228, col: 32, offset: 5876},
 							expr: &ruleRefExpr{
-								pos:  position{line: 223, col: 33, offset: 5780},
+								pos:  position{line: 228, col: 33, offset: 5877},
 								name: "KeyWordStart",
 							},
 						},
@@ -1999,33 +2027,33 @@ var g = &grammar{
 		},
 		{
 			name: "RegexpBody",
-			pos:  position{line: 227, col: 1, offset: 5894},
+			pos:  position{line: 232, col: 1, offset: 5991},
 			expr: &actionExpr{
-				pos: position{line: 228, col: 5, offset: 5909},
+				pos: position{line: 233, col: 5, offset: 6006},
 				run: (*parser).callonRegexpBody1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 228, col: 5, offset: 5909},
+					pos: position{line: 233, col: 5, offset: 6006},
 					expr: &choiceExpr{
-						pos: position{line: 228, col: 6, offset: 5910},
+						pos: position{line: 233, col: 6, offset: 6007},
 						alternatives: []any{
 							&charClassMatcher{
-								pos:        position{line: 228, col: 6, offset: 5910},
+								pos:        position{line: 233, col: 6, offset: 6007},
 								val:        "[^/\\\\]",
 								chars:      []rune{'/', '\\'},
 								ignoreCase: false,
 								inverted:   true,
 							},
 							&seqExpr{
-								pos: position{line: 228, col: 15, offset: 5919},
+								pos: position{line: 233, col: 15, offset: 6016},
 								exprs: []any{
 									&litMatcher{
-										pos:        position{line: 228, col: 15, offset: 5919},
+										pos:        position{line: 233, col: 15, offset: 6016},
 										val:        "\\",
 										ignoreCase: false,
 										want:       "\"\\\\\"",
 									},
 									&anyMatcher{
-										line: 228, col: 20, offset: 5924,
+										line: 233, col: 20, offset: 6021,
 									},
 								},
 							},
@@ -2038,36 +2066,36 @@ var g = &grammar{
 		},
 		{
 			name: "Aggregation",
-			pos:  position{line: 232, col: 1, offset: 5986},
+			pos:  position{line: 237, col: 1, offset: 6083},
 			expr: &choiceExpr{
-				pos: position{line: 233, col: 5, offset: 6002},
+				pos: position{line: 238, col: 5, offset: 6099},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 233, col: 5, offset: 6002},
+						pos: position{line: 238, col: 5, offset: 6099},
 						run: (*parser).callonAggregation2,
 						expr: &seqExpr{
-							pos: position{line: 233, col: 5, offset: 6002},
+							pos: position{line: 238, col: 5, offset: 6099},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 233, col: 5, offset: 6002},
+									pos: position{line: 238, col: 5, offset: 6099},
 									expr: &ruleRefExpr{
-										pos:  position{line: 233, col: 5, offset: 6002},
+										pos:  position{line: 238, col: 5, offset: 6099},
 										name: "Aggregate",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 233, col: 16, offset: 6013},
+									pos:   position{line: 238, col: 16, offset: 6110},
 									label: "keys",
 									expr: &ruleRefExpr{
-										pos:  position{line: 233, col: 21, offset: 6018},
+										pos:  position{line: 238, col: 21, offset: 6115},
 										name: "AggregateKeys",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 233, col: 35, offset: 6032},
+									pos:   position{line: 238, col: 35, offset: 6129},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 233, col: 41, offset: 6038},
+										pos:  position{line: 238, col: 41, offset: 6135},
 										name: "LimitArg",
 									},
 								},
@@ -2075,40 +2103,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 241, col: 5, offset: 6226},
+						pos: position{line: 246, col: 5, offset: 6323},
 						run: (*parser).callonAggregation10,
 						expr: &seqExpr{
-							pos: position{line: 241, col: 5, offset: 6226},
+							pos: position{line: 246, col: 5, offset: 6323},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 241, col: 5, offset: 6226},
+									pos: position{line: 246, col: 5, offset: 6323},
 									expr: &ruleRefExpr{
-										pos:  position{line: 241, col: 5, offset: 6226},
+										pos:  position{line: 246, col: 5, offset: 6323},
 										name: "Aggregate",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 241, col: 16, offset: 6237},
+									pos:   position{line: 246, col: 16, offset: 6334},
 									label: "aggs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 241, col: 21, offset: 6242},
+										pos:  position{line: 246, col: 21, offset: 6339},
 										name: "AggAssignments",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 241, col: 36, offset: 6257},
+									pos:   position{line: 246, col: 36, offset: 6354},
 									label: "keys",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 241, col: 41, offset: 6262},
+										pos: position{line: 246, col: 41, offset: 6359},
 										expr: &seqExpr{
-											pos: position{line: 241, col: 42, offset: 6263},
+											pos: position{line: 246, col: 42, offset: 6360},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 241, col: 42, offset: 6263},
+													pos:  position{line: 246, col: 42, offset: 6360},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 241, col: 44, offset: 6265},
+													pos:  position{line: 246, col: 44, offset: 6362},
 													name: "AggregateKeys",
 												},
 											},
@@ -2116,10 +2144,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 241, col: 60, offset: 6281},
+									pos:   position{line: 246, col: 60, offset: 6378},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 241, col: 66, offset: 6287},
+										pos:  position{line: 246, col: 66, offset: 6384},
 										name: "LimitArg",
 									},
 								},
@@ -2133,25 +2161,25 @@ var g = &grammar{
 		},
 		{
 			name: "Aggregate",
-			pos:  position{line: 254, col: 1, offset: 6575},
+			pos:  position{line: 259, col: 1, offset: 6672},
 			expr: &seqExpr{
-				pos: position{line: 254, col: 13, offset: 6587},
+				pos: position{line: 259, col: 13, offset: 6684},
 				exprs: []any{
 					&choiceExpr{
-						pos: position{line: 254, col: 14, offset: 6588},
+						pos: position{line: 259, col: 14, offset: 6685},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 254, col: 14, offset: 6588},
+								pos:  position{line: 259, col: 14, offset: 6685},
 								name: "AGGREGATE",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 254, col: 26, offset: 6600},
+								pos:  position{line: 259, col: 26, offset: 6697},
 								name: "SUMMARIZE",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 254, col: 37, offset: 6611},
+						pos:  position{line: 259, col: 37, offset: 6708},
 						name: "_",
 					},
 				},
@@ -2161,42 +2189,42 @@ var g = &grammar{
 		},
 		{
 			name: "AggregateKeys",
-			pos:  position{line: 256, col: 1, offset: 6614},
+			pos:  position{line: 261, col: 1, offset: 6711},
 			expr: &actionExpr{
-				pos: position{line: 257, col: 5, offset: 6632},
+				pos: position{line: 262, col: 5, offset: 6729},
 				run: (*parser).callonAggregateKeys1,
 				expr: &seqExpr{
-					pos: position{line: 257, col: 5, offset: 6632},
+					pos: position{line: 262, col: 5, offset: 6729},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 257, col: 5, offset: 6632},
+							pos: position{line: 262, col: 5, offset: 6729},
 							expr: &seqExpr{
-								pos: position{line: 257, col: 6, offset: 6633},
+								pos: position{line: 262, col: 6, offset: 6730},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 257, col: 6, offset: 6633},
+										pos:  position{line: 262, col: 6, offset: 6730},
 										name: "GROUP",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 257, col: 12, offset: 6639},
+										pos:  position{line: 262, col: 12, offset: 6736},
 										name: "_",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 257, col: 16, offset: 6643},
+							pos:  position{line: 262, col: 16, offset: 6740},
 							name: "BY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 257, col: 19, offset: 6646},
+							pos:  position{line: 262, col: 19, offset: 6743},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 257, col: 21, offset: 6648},
+							pos:   position{line: 262, col: 21, offset: 6745},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 257, col: 29, offset: 6656},
+								pos:  position{line: 262, col: 29, offset: 6753},
 								name: "Assignments",
 							},
 						},
@@ -2208,43 +2236,43 @@ var g = &grammar{
 		},
 		{
 			name: "LimitArg",
-			pos:  position{line: 259, col: 1, offset: 6693},
+			pos:  position{line: 264, col: 1, offset: 6790},
 			expr: &choiceExpr{
-				pos: position{line: 260, col: 5, offset: 6706},
+				pos: position{line: 265, col: 5, offset: 6803},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 260, col: 5, offset: 6706},
+						pos: position{line: 265, col: 5, offset: 6803},
 						run: (*parser).callonLimitArg2,
 						expr: &seqExpr{
-							pos: position{line: 260, col: 5, offset: 6706},
+							pos: position{line: 265, col: 5, offset: 6803},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 260, col: 5, offset: 6706},
+									pos:  position{line: 265, col: 5, offset: 6803},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 260, col: 7, offset: 6708},
+									pos:  position{line: 265, col: 7, offset: 6805},
 									name: "WITH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 260, col: 12, offset: 6713},
+									pos:  position{line: 265, col: 12, offset: 6810},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 260, col: 14, offset: 6715},
+									pos:        position{line: 265, col: 14, offset: 6812},
 									val:        "-limit",
 									ignoreCase: false,
 									want:       "\"-limit\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 260, col: 23, offset: 6724},
+									pos:  position{line: 265, col: 23, offset: 6821},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 260, col: 25, offset: 6726},
+									pos:   position{line: 265, col: 25, offset: 6823},
 									label: "limit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 260, col: 31, offset: 6732},
+										pos:  position{line: 265, col: 31, offset: 6829},
 										name: "UInt",
 									},
 								},
@@ -2252,10 +2280,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 261, col: 5, offset: 6763},
+						pos: position{line: 266, col: 5, offset: 6860},
 						run: (*parser).callonLimitArg11,
 						expr: &litMatcher{
-							pos:        position{line: 261, col: 5, offset: 6763},
+							pos:        position{line: 266, col: 5, offset: 6860},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -2268,43 +2296,43 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignment",
-			pos:  position{line: 263, col: 1, offset: 6785},
+			pos:  position{line: 268, col: 1, offset: 6882},
 			expr: &choiceExpr{
-				pos: position{line: 264, col: 5, offset: 6803},
+				pos: position{line: 269, col: 5, offset: 6900},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 264, col: 5, offset: 6803},
+						pos: position{line: 269, col: 5, offset: 6900},
 						run: (*parser).callonAggAssignment2,
 						expr: &seqExpr{
-							pos: position{line: 264, col: 5, offset: 6803},
+							pos: position{line: 269, col: 5, offset: 6900},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 264, col: 5, offset: 6803},
+									pos:   position{line: 269, col: 5, offset: 6900},
 									label: "lval",
 									expr: &ruleRefExpr{
-										pos:  position{line: 264, col: 10, offset: 6808},
+										pos:  position{line: 269, col: 10, offset: 6905},
 										name: "Lval",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 264, col: 15, offset: 6813},
+									pos:  position{line: 269, col: 15, offset: 6910},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 264, col: 18, offset: 6816},
+									pos:        position{line: 269, col: 18, offset: 6913},
 									val:        ":=",
 									ignoreCase: false,
 									want:       "\":=\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 264, col: 23, offset: 6821},
+									pos:  position{line: 269, col: 23, offset: 6918},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 264, col: 26, offset: 6824},
+									pos:   position{line: 269, col: 26, offset: 6921},
 									label: "agg",
 									expr: &ruleRefExpr{
-										pos:  position{line: 264, col: 30, offset: 6828},
+										pos:  position{line: 269, col: 30, offset: 6925},
 										name: "AggFunc",
 									},
 								},
@@ -2312,13 +2340,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 267, col: 5, offset: 6932},
+						pos: position{line: 272, col: 5, offset: 7029},
 						run: (*parser).callonAggAssignment11,
 						expr: &labeledExpr{
-							pos:   position{line: 267, col: 5, offset: 6932},
+							pos:   position{line: 272, col: 5, offset: 7029},
 							label: "agg",
 							expr: &ruleRefExpr{
-								pos:  position{line: 267, col: 9, offset: 6936},
+								pos:  position{line: 272, col: 9, offset: 7033},
 								name: "AggFunc",
 							},
 						},
@@ -2330,84 +2358,84 @@ var g = &grammar{
 		},
 		{
 			name: "AggFunc",
-			pos:  position{line: 271, col: 1, offset: 7017},
+			pos:  position{line: 276, col: 1, offset: 7114},
 			expr: &choiceExpr{
-				pos: position{line: 272, col: 5, offset: 7029},
+				pos: position{line: 277, col: 5, offset: 7126},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 272, col: 5, offset: 7029},
+						pos: position{line: 277, col: 5, offset: 7126},
 						run: (*parser).callonAggFunc2,
 						expr: &seqExpr{
-							pos: position{line: 272, col: 5, offset: 7029},
+							pos: position{line: 277, col: 5, offset: 7126},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 272, col: 5, offset: 7029},
+									pos:   position{line: 277, col: 5, offset: 7126},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 272, col: 10, offset: 7034},
+										pos:  position{line: 277, col: 10, offset: 7131},
 										name: "AggName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 272, col: 18, offset: 7042},
+									pos:  position{line: 277, col: 18, offset: 7139},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 272, col: 21, offset: 7045},
+									pos:        position{line: 277, col: 21, offset: 7142},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 272, col: 25, offset: 7049},
+									pos:  position{line: 277, col: 25, offset: 7146},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 272, col: 28, offset: 7052},
+									pos:   position{line: 277, col: 28, offset: 7149},
 									label: "q",
 									expr: &choiceExpr{
-										pos: position{line: 272, col: 31, offset: 7055},
+										pos: position{line: 277, col: 31, offset: 7152},
 										alternatives: []any{
 											&ruleRefExpr{
-												pos:  position{line: 272, col: 31, offset: 7055},
+												pos:  position{line: 277, col: 31, offset: 7152},
 												name: "ALL",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 272, col: 37, offset: 7061},
+												pos:  position{line: 277, col: 37, offset: 7158},
 												name: "DISTINCT",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 272, col: 47, offset: 7071},
+									pos:  position{line: 277, col: 47, offset: 7168},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 272, col: 49, offset: 7073},
+									pos:   position{line: 277, col: 49, offset: 7170},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 272, col: 54, offset: 7078},
+										pos:  position{line: 277, col: 54, offset: 7175},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 272, col: 59, offset: 7083},
+									pos:  position{line: 277, col: 59, offset: 7180},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 272, col: 62, offset: 7086},
+									pos:        position{line: 277, col: 62, offset: 7183},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 272, col: 66, offset: 7090},
+									pos:   position{line: 277, col: 66, offset: 7187},
 									label: "filter",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 272, col: 73, offset: 7097},
+										pos: position{line: 277, col: 73, offset: 7194},
 										expr: &ruleRefExpr{
-											pos:  position{line: 272, col: 73, offset: 7097},
+											pos:  position{line: 277, col: 73, offset: 7194},
 											name: "FilterClause",
 										},
 									},
@@ -2416,61 +2444,61 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 286, col: 5, offset: 7485},
+						pos: position{line: 291, col: 5, offset: 7582},
 						run: (*parser).callonAggFunc21,
 						expr: &seqExpr{
-							pos: position{line: 286, col: 5, offset: 7485},
+							pos: position{line: 291, col: 5, offset: 7582},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 286, col: 5, offset: 7485},
+									pos:   position{line: 291, col: 5, offset: 7582},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 286, col: 10, offset: 7490},
+										pos:  position{line: 291, col: 10, offset: 7587},
 										name: "AggName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 286, col: 18, offset: 7498},
+									pos:  position{line: 291, col: 18, offset: 7595},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 286, col: 21, offset: 7501},
+									pos:        position{line: 291, col: 21, offset: 7598},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 286, col: 25, offset: 7505},
+									pos:  position{line: 291, col: 25, offset: 7602},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 286, col: 28, offset: 7508},
+									pos:   position{line: 291, col: 28, offset: 7605},
 									label: "expr",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 286, col: 33, offset: 7513},
+										pos: position{line: 291, col: 33, offset: 7610},
 										expr: &ruleRefExpr{
-											pos:  position{line: 286, col: 33, offset: 7513},
+											pos:  position{line: 291, col: 33, offset: 7610},
 											name: "Expr",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 286, col: 39, offset: 7519},
+									pos:  position{line: 291, col: 39, offset: 7616},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 286, col: 42, offset: 7522},
+									pos:        position{line: 291, col: 42, offset: 7619},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 286, col: 46, offset: 7526},
+									pos:   position{line: 291, col: 46, offset: 7623},
 									label: "filter",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 286, col: 53, offset: 7533},
+										pos: position{line: 291, col: 53, offset: 7630},
 										expr: &ruleRefExpr{
-											pos:  position{line: 286, col: 53, offset: 7533},
+											pos:  position{line: 291, col: 53, offset: 7630},
 											name: "FilterClause",
 										},
 									},
@@ -2479,26 +2507,26 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 300, col: 5, offset: 7843},
+						pos: position{line: 305, col: 5, offset: 7940},
 						run: (*parser).callonAggFunc36,
 						expr: &seqExpr{
-							pos: position{line: 300, col: 5, offset: 7843},
+							pos: position{line: 305, col: 5, offset: 7940},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 300, col: 5, offset: 7843},
+									pos:   position{line: 305, col: 5, offset: 7940},
 									label: "cs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 300, col: 8, offset: 7846},
+										pos:  position{line: 305, col: 8, offset: 7943},
 										name: "CountStar",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 300, col: 18, offset: 7856},
+									pos:   position{line: 305, col: 18, offset: 7953},
 									label: "filter",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 300, col: 25, offset: 7863},
+										pos: position{line: 305, col: 25, offset: 7960},
 										expr: &ruleRefExpr{
-											pos:  position{line: 300, col: 25, offset: 7863},
+											pos:  position{line: 305, col: 25, offset: 7960},
 											name: "FilterClause",
 										},
 									},
@@ -2513,20 +2541,20 @@ var g = &grammar{
 		},
 		{
 			name: "AggName",
-			pos:  position{line: 312, col: 1, offset: 8098},
+			pos:  position{line: 317, col: 1, offset: 8195},
 			expr: &choiceExpr{
-				pos: position{line: 313, col: 5, offset: 8110},
+				pos: position{line: 318, col: 5, offset: 8207},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 313, col: 5, offset: 8110},
+						pos:  position{line: 318, col: 5, offset: 8207},
 						name: "IdentifierName",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 314, col: 5, offset: 8129},
+						pos:  position{line: 319, col: 5, offset: 8226},
 						name: "AND",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 315, col: 5, offset: 8137},
+						pos:  position{line: 320, col: 5, offset: 8234},
 						name: "OR",
 					},
 				},
@@ -2536,65 +2564,65 @@ var g = &grammar{
 		},
 		{
 			name: "FilterClause",
-			pos:  position{line: 317, col: 1, offset: 8141},
+			pos:  position{line: 322, col: 1, offset: 8238},
 			expr: &actionExpr{
-				pos: position{line: 317, col: 16, offset: 8156},
+				pos: position{line: 322, col: 16, offset: 8253},
 				run: (*parser).callonFilterClause1,
 				expr: &seqExpr{
-					pos: position{line: 317, col: 16, offset: 8156},
+					pos: position{line: 322, col: 16, offset: 8253},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 16, offset: 8156},
+							pos:  position{line: 322, col: 16, offset: 8253},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 18, offset: 8158},
+							pos:  position{line: 322, col: 18, offset: 8255},
 							name: "FILTER",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 25, offset: 8165},
+							pos:  position{line: 322, col: 25, offset: 8262},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 317, col: 28, offset: 8168},
+							pos:        position{line: 322, col: 28, offset: 8265},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 32, offset: 8172},
+							pos:  position{line: 322, col: 32, offset: 8269},
 							name: "__",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 317, col: 35, offset: 8175},
+							pos: position{line: 322, col: 35, offset: 8272},
 							expr: &seqExpr{
-								pos: position{line: 317, col: 36, offset: 8176},
+								pos: position{line: 322, col: 36, offset: 8273},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 317, col: 36, offset: 8176},
+										pos:  position{line: 322, col: 36, offset: 8273},
 										name: "WHERE",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 317, col: 42, offset: 8182},
+										pos:  position{line: 322, col: 42, offset: 8279},
 										name: "_",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 317, col: 46, offset: 8186},
+							pos:   position{line: 322, col: 46, offset: 8283},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 317, col: 51, offset: 8191},
+								pos:  position{line: 322, col: 51, offset: 8288},
 								name: "LogicalOrExpr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 65, offset: 8205},
+							pos:  position{line: 322, col: 65, offset: 8302},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 317, col: 68, offset: 8208},
+							pos:        position{line: 322, col: 68, offset: 8305},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2607,45 +2635,45 @@ var g = &grammar{
 		},
 		{
 			name: "AggAssignments",
-			pos:  position{line: 319, col: 1, offset: 8234},
+			pos:  position{line: 324, col: 1, offset: 8331},
 			expr: &actionExpr{
-				pos: position{line: 320, col: 5, offset: 8253},
+				pos: position{line: 325, col: 5, offset: 8350},
 				run: (*parser).callonAggAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 320, col: 5, offset: 8253},
+					pos: position{line: 325, col: 5, offset: 8350},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 320, col: 5, offset: 8253},
+							pos:   position{line: 325, col: 5, offset: 8350},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 320, col: 11, offset: 8259},
+								pos:  position{line: 325, col: 11, offset: 8356},
 								name: "AggAssignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 320, col: 25, offset: 8273},
+							pos:   position{line: 325, col: 25, offset: 8370},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 320, col: 30, offset: 8278},
+								pos: position{line: 325, col: 30, offset: 8375},
 								expr: &seqExpr{
-									pos: position{line: 320, col: 31, offset: 8279},
+									pos: position{line: 325, col: 31, offset: 8376},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 320, col: 31, offset: 8279},
+											pos:  position{line: 325, col: 31, offset: 8376},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 320, col: 34, offset: 8282},
+											pos:        position{line: 325, col: 34, offset: 8379},
 											val:        ",",
 											ignoreCase: false,
 											want:       "\",\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 320, col: 38, offset: 8286},
+											pos:  position{line: 325, col: 38, offset: 8383},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 320, col: 41, offset: 8289},
+											pos:  position{line: 325, col: 41, offset: 8386},
 											name: "AggAssignment",
 										},
 									},
@@ -2660,43 +2688,43 @@ var g = &grammar{
 		},
 		{
 			name: "CountStar",
-			pos:  position{line: 328, col: 1, offset: 8463},
+			pos:  position{line: 333, col: 1, offset: 8560},
 			expr: &actionExpr{
-				pos: position{line: 328, col: 13, offset: 8475},
+				pos: position{line: 333, col: 13, offset: 8572},
 				run: (*parser).callonCountStar1,
 				expr: &seqExpr{
-					pos: position{line: 328, col: 13, offset: 8475},
+					pos: position{line: 333, col: 13, offset: 8572},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 13, offset: 8475},
+							pos:  position{line: 333, col: 13, offset: 8572},
 							name: "COUNT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 19, offset: 8481},
+							pos:  position{line: 333, col: 19, offset: 8578},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 22, offset: 8484},
+							pos:        position{line: 333, col: 22, offset: 8581},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 26, offset: 8488},
+							pos:  position{line: 333, col: 26, offset: 8585},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 29, offset: 8491},
+							pos:        position{line: 333, col: 29, offset: 8588},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 328, col: 33, offset: 8495},
+							pos:  position{line: 333, col: 33, offset: 8592},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 36, offset: 8498},
+							pos:        position{line: 333, col: 36, offset: 8595},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -2709,28 +2737,28 @@ var g = &grammar{
 		},
 		{
 			name: "Operator",
-			pos:  position{line: 343, col: 1, offset: 8738},
+			pos:  position{line: 348, col: 1, offset: 8835},
 			expr: &choiceExpr{
-				pos: position{line: 344, col: 5, offset: 8751},
+				pos: position{line: 349, col: 5, offset: 8848},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 344, col: 5, offset: 8751},
+						pos: position{line: 349, col: 5, offset: 8848},
 						run: (*parser).callonOperator2,
 						expr: &seqExpr{
-							pos: position{line: 344, col: 5, offset: 8751},
+							pos: position{line: 349, col: 5, offset: 8848},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 344, col: 5, offset: 8751},
+									pos:   position{line: 349, col: 5, offset: 8848},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 344, col: 8, offset: 8754},
+										pos:  position{line: 349, col: 8, offset: 8851},
 										name: "SQLOp",
 									},
 								},
 								&andExpr{
-									pos: position{line: 344, col: 14, offset: 8760},
+									pos: position{line: 349, col: 14, offset: 8857},
 									expr: &ruleRefExpr{
-										pos:  position{line: 344, col: 15, offset: 8761},
+										pos:  position{line: 349, col: 15, offset: 8858},
 										name: "EndOfOp",
 									},
 								},
@@ -2738,119 +2766,123 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 345, col: 5, offset: 8792},
+						pos:  position{line: 350, col: 5, offset: 8889},
 						name: "ForkOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 346, col: 5, offset: 8803},
+						pos:  position{line: 351, col: 5, offset: 8900},
 						name: "SwitchOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 347, col: 5, offset: 8816},
+						pos:  position{line: 352, col: 5, offset: 8913},
 						name: "SearchOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 348, col: 5, offset: 8829},
+						pos:  position{line: 353, col: 5, offset: 8926},
 						name: "AssertOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 349, col: 5, offset: 8842},
+						pos:  position{line: 354, col: 5, offset: 8939},
 						name: "SortOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 350, col: 5, offset: 8853},
+						pos:  position{line: 355, col: 5, offset: 8950},
 						name: "TopOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 351, col: 5, offset: 8863},
+						pos:  position{line: 356, col: 5, offset: 8960},
 						name: "CallOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 352, col: 5, offset: 8874},
+						pos:  position{line: 357, col: 5, offset: 8971},
 						name: "CountOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 353, col: 5, offset: 8886},
+						pos:  position{line: 358, col: 5, offset: 8983},
 						name: "CutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 354, col: 5, offset: 8896},
+						pos:  position{line: 359, col: 5, offset: 8993},
 						name: "DistinctOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 355, col: 5, offset: 8911},
+						pos:  position{line: 360, col: 5, offset: 9008},
 						name: "DropOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 356, col: 5, offset: 8922},
+						pos:  position{line: 361, col: 5, offset: 9019},
 						name: "HeadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 357, col: 5, offset: 8933},
+						pos:  position{line: 362, col: 5, offset: 9030},
 						name: "TailOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 358, col: 5, offset: 8944},
+						pos:  position{line: 363, col: 5, offset: 9041},
 						name: "SkipOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 359, col: 5, offset: 8955},
+						pos:  position{line: 364, col: 5, offset: 9052},
 						name: "WhereOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 360, col: 5, offset: 8967},
+						pos:  position{line: 365, col: 5, offset: 9064},
 						name: "UniqOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 361, col: 5, offset: 8978},
+						pos:  position{line: 366, col: 5, offset: 9075},
+						name: "WindowOp",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 367, col: 5, offset: 9088},
 						name: "PutOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 362, col: 5, offset: 8988},
+						pos:  position{line: 368, col: 5, offset: 9098},
 						name: "RenameOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 363, col: 5, offset: 9001},
+						pos:  position{line: 369, col: 5, offset: 9111},
 						name: "FuseOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 364, col: 5, offset: 9012},
+						pos:  position{line: 370, col: 5, offset: 9122},
 						name: "JoinOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 365, col: 5, offset: 9023},
+						pos:  position{line: 371, col: 5, offset: 9133},
 						name: "ShapesOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 366, col: 5, offset: 9036},
+						pos:  position{line: 372, col: 5, offset: 9146},
 						name: "FromOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 367, col: 5, offset: 9047},
+						pos:  position{line: 373, col: 5, offset: 9157},
 						name: "PassOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 368, col: 5, offset: 9058},
+						pos:  position{line: 374, col: 5, offset: 9168},
 						name: "MergeOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 369, col: 5, offset: 9070},
+						pos:  position{line: 375, col: 5, offset: 9180},
 						name: "UnnestOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 370, col: 5, offset: 9083},
+						pos:  position{line: 376, col: 5, offset: 9193},
 						name: "ValuesOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 371, col: 5, offset: 9096},
+						pos:  position{line: 377, col: 5, offset: 9206},
 						name: "LoadOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 372, col: 5, offset: 9107},
+						pos:  position{line: 378, col: 5, offset: 9217},
 						name: "OutputOp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 373, col: 5, offset: 9120},
+						pos:  position{line: 379, col: 5, offset: 9230},
 						name: "DebugOp",
 					},
 				},
@@ -2860,37 +2892,37 @@ var g = &grammar{
 		},
 		{
 			name: "ForkOp",
-			pos:  position{line: 375, col: 2, offset: 9130},
+			pos:  position{line: 381, col: 2, offset: 9240},
 			expr: &actionExpr{
-				pos: position{line: 376, col: 4, offset: 9142},
+				pos: position{line: 382, col: 4, offset: 9252},
 				run: (*parser).callonForkOp1,
 				expr: &seqExpr{
-					pos: position{line: 376, col: 4, offset: 9142},
+					pos: position{line: 382, col: 4, offset: 9252},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 376, col: 4, offset: 9142},
+							pos:  position{line: 382, col: 4, offset: 9252},
 							name: "FORK",
 						},
 						&labeledExpr{
-							pos:   position{line: 376, col: 9, offset: 9147},
+							pos:   position{line: 382, col: 9, offset: 9257},
 							label: "paths",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 376, col: 15, offset: 9153},
+								pos: position{line: 382, col: 15, offset: 9263},
 								expr: &actionExpr{
-									pos: position{line: 376, col: 17, offset: 9155},
+									pos: position{line: 382, col: 17, offset: 9265},
 									run: (*parser).callonForkOp6,
 									expr: &seqExpr{
-										pos: position{line: 376, col: 17, offset: 9155},
+										pos: position{line: 382, col: 17, offset: 9265},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 376, col: 17, offset: 9155},
+												pos:  position{line: 382, col: 17, offset: 9265},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 376, col: 20, offset: 9158},
+												pos:   position{line: 382, col: 20, offset: 9268},
 												label: "path",
 												expr: &ruleRefExpr{
-													pos:  position{line: 376, col: 25, offset: 9163},
+													pos:  position{line: 382, col: 25, offset: 9273},
 													name: "ScopeBody",
 												},
 											},
@@ -2907,31 +2939,31 @@ var g = &grammar{
 		},
 		{
 			name: "SwitchOp",
-			pos:  position{line: 388, col: 1, offset: 9437},
+			pos:  position{line: 394, col: 1, offset: 9547},
 			expr: &choiceExpr{
-				pos: position{line: 389, col: 5, offset: 9450},
+				pos: position{line: 395, col: 5, offset: 9560},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 389, col: 5, offset: 9450},
+						pos: position{line: 395, col: 5, offset: 9560},
 						run: (*parser).callonSwitchOp2,
 						expr: &seqExpr{
-							pos: position{line: 389, col: 5, offset: 9450},
+							pos: position{line: 395, col: 5, offset: 9560},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 389, col: 5, offset: 9450},
+									pos:  position{line: 395, col: 5, offset: 9560},
 									name: "SWITCH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 389, col: 12, offset: 9457},
+									pos:  position{line: 395, col: 12, offset: 9567},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 389, col: 14, offset: 9459},
+									pos:   position{line: 395, col: 14, offset: 9569},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 389, col: 20, offset: 9465},
+										pos: position{line: 395, col: 20, offset: 9575},
 										expr: &ruleRefExpr{
-											pos:  position{line: 389, col: 20, offset: 9465},
+											pos:  position{line: 395, col: 20, offset: 9575},
 											name: "SwitchPath",
 										},
 									},
@@ -2940,38 +2972,38 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 396, col: 5, offset: 9624},
+						pos: position{line: 402, col: 5, offset: 9734},
 						run: (*parser).callonSwitchOp9,
 						expr: &seqExpr{
-							pos: position{line: 396, col: 5, offset: 9624},
+							pos: position{line: 402, col: 5, offset: 9734},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 396, col: 5, offset: 9624},
+									pos:  position{line: 402, col: 5, offset: 9734},
 									name: "SWITCH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 396, col: 12, offset: 9631},
+									pos:  position{line: 402, col: 12, offset: 9741},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 396, col: 14, offset: 9633},
+									pos:   position{line: 402, col: 14, offset: 9743},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 396, col: 19, offset: 9638},
+										pos:  position{line: 402, col: 19, offset: 9748},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 396, col: 24, offset: 9643},
+									pos:  position{line: 402, col: 24, offset: 9753},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 396, col: 26, offset: 9645},
+									pos:   position{line: 402, col: 26, offset: 9755},
 									label: "cases",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 396, col: 32, offset: 9651},
+										pos: position{line: 402, col: 32, offset: 9761},
 										expr: &ruleRefExpr{
-											pos:  position{line: 396, col: 32, offset: 9651},
+											pos:  position{line: 402, col: 32, offset: 9761},
 											name: "SwitchPath",
 										},
 									},
@@ -2986,34 +3018,34 @@ var g = &grammar{
 		},
 		{
 			name: "SwitchPath",
-			pos:  position{line: 405, col: 1, offset: 9840},
+			pos:  position{line: 411, col: 1, offset: 9950},
 			expr: &actionExpr{
-				pos: position{line: 406, col: 5, offset: 9855},
+				pos: position{line: 412, col: 5, offset: 9965},
 				run: (*parser).callonSwitchPath1,
 				expr: &seqExpr{
-					pos: position{line: 406, col: 5, offset: 9855},
+					pos: position{line: 412, col: 5, offset: 9965},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 406, col: 5, offset: 9855},
+							pos:  position{line: 412, col: 5, offset: 9965},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 406, col: 8, offset: 9858},
+							pos:   position{line: 412, col: 8, offset: 9968},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 406, col: 13, offset: 9863},
+								pos:  position{line: 412, col: 13, offset: 9973},
 								name: "Case",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 406, col: 18, offset: 9868},
+							pos:  position{line: 412, col: 18, offset: 9978},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 406, col: 21, offset: 9871},
+							pos:   position{line: 412, col: 21, offset: 9981},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 406, col: 26, offset: 9876},
+								pos:  position{line: 412, col: 26, offset: 9986},
 								name: "ScopeBody",
 							},
 						},
@@ -3025,29 +3057,29 @@ var g = &grammar{
 		},
 		{
 			name: "Case",
-			pos:  position{line: 414, col: 1, offset: 10028},
+			pos:  position{line: 420, col: 1, offset: 10138},
 			expr: &choiceExpr{
-				pos: position{line: 415, col: 5, offset: 10037},
+				pos: position{line: 421, col: 5, offset: 10147},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 415, col: 5, offset: 10037},
+						pos: position{line: 421, col: 5, offset: 10147},
 						run: (*parser).callonCase2,
 						expr: &seqExpr{
-							pos: position{line: 415, col: 5, offset: 10037},
+							pos: position{line: 421, col: 5, offset: 10147},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 415, col: 5, offset: 10037},
+									pos:  position{line: 421, col: 5, offset: 10147},
 									name: "CASE",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 415, col: 10, offset: 10042},
+									pos:  position{line: 421, col: 10, offset: 10152},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 415, col: 12, offset: 10044},
+									pos:   position{line: 421, col: 12, offset: 10154},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 415, col: 17, offset: 10049},
+										pos:  position{line: 421, col: 17, offset: 10159},
 										name: "Expr",
 									},
 								},
@@ -3055,10 +3087,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 416, col: 5, offset: 10079},
+						pos: position{line: 422, col: 5, offset: 10189},
 						run: (*parser).callonCase8,
 						expr: &ruleRefExpr{
-							pos:  position{line: 416, col: 5, offset: 10079},
+							pos:  position{line: 422, col: 5, offset: 10189},
 							name: "DEFAULT",
 						},
 					},
@@ -3069,40 +3101,40 @@ var g = &grammar{
 		},
 		{
 			name: "SearchOp",
-			pos:  position{line: 418, col: 1, offset: 10108},
+			pos:  position{line: 424, col: 1, offset: 10218},
 			expr: &actionExpr{
-				pos: position{line: 419, col: 5, offset: 10121},
+				pos: position{line: 425, col: 5, offset: 10231},
 				run: (*parser).callonSearchOp1,
 				expr: &seqExpr{
-					pos: position{line: 419, col: 5, offset: 10121},
+					pos: position{line: 425, col: 5, offset: 10231},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 419, col: 6, offset: 10122},
+							pos: position{line: 425, col: 6, offset: 10232},
 							alternatives: []any{
 								&seqExpr{
-									pos: position{line: 419, col: 6, offset: 10122},
+									pos: position{line: 425, col: 6, offset: 10232},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 419, col: 6, offset: 10122},
+											pos:  position{line: 425, col: 6, offset: 10232},
 											name: "SEARCH",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 419, col: 13, offset: 10129},
+											pos:  position{line: 425, col: 13, offset: 10239},
 											name: "_",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 419, col: 17, offset: 10133},
+									pos: position{line: 425, col: 17, offset: 10243},
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 419, col: 17, offset: 10133},
+											pos:        position{line: 425, col: 17, offset: 10243},
 											val:        "?",
 											ignoreCase: false,
 											want:       "\"?\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 419, col: 21, offset: 10137},
+											pos:  position{line: 425, col: 21, offset: 10247},
 											name: "__",
 										},
 									},
@@ -3110,10 +3142,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 419, col: 25, offset: 10141},
+							pos:   position{line: 425, col: 25, offset: 10251},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 419, col: 30, offset: 10146},
+								pos:  position{line: 425, col: 30, offset: 10256},
 								name: "SearchBoolean",
 							},
 						},
@@ -3125,32 +3157,32 @@ var g = &grammar{
 		},
 		{
 			name: "AssertOp",
-			pos:  position{line: 423, col: 1, offset: 10250},
+			pos:  position{line: 429, col: 1, offset: 10360},
 			expr: &actionExpr{
-				pos: position{line: 424, col: 5, offset: 10263},
+				pos: position{line: 430, col: 5, offset: 10373},
 				run: (*parser).callonAssertOp1,
 				expr: &seqExpr{
-					pos: position{line: 424, col: 5, offset: 10263},
+					pos: position{line: 430, col: 5, offset: 10373},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 424, col: 5, offset: 10263},
+							pos:  position{line: 430, col: 5, offset: 10373},
 							name: "ASSERT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 424, col: 12, offset: 10270},
+							pos:  position{line: 430, col: 12, offset: 10380},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 424, col: 14, offset: 10272},
+							pos:   position{line: 430, col: 14, offset: 10382},
 							label: "expr",
 							expr: &actionExpr{
-								pos: position{line: 424, col: 20, offset: 10278},
+								pos: position{line: 430, col: 20, offset: 10388},
 								run: (*parser).callonAssertOp6,
 								expr: &labeledExpr{
-									pos:   position{line: 424, col: 20, offset: 10278},
+									pos:   position{line: 430, col: 20, offset: 10388},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 424, col: 22, offset: 10280},
+										pos:  position{line: 430, col: 22, offset: 10390},
 										name: "Expr",
 									},
 								},
@@ -3164,33 +3196,33 @@ var g = &grammar{
 		},
 		{
 			name: "SortOp",
-			pos:  position{line: 433, col: 1, offset: 10514},
+			pos:  position{line: 439, col: 1, offset: 10624},
 			expr: &actionExpr{
-				pos: position{line: 434, col: 5, offset: 10525},
+				pos: position{line: 440, col: 5, offset: 10635},
 				run: (*parser).callonSortOp1,
 				expr: &seqExpr{
-					pos: position{line: 434, col: 5, offset: 10525},
+					pos: position{line: 440, col: 5, offset: 10635},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 434, col: 6, offset: 10526},
+							pos: position{line: 440, col: 6, offset: 10636},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 434, col: 6, offset: 10526},
+									pos:  position{line: 440, col: 6, offset: 10636},
 									name: "SORT",
 								},
 								&seqExpr{
-									pos: position{line: 434, col: 13, offset: 10533},
+									pos: position{line: 440, col: 13, offset: 10643},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 434, col: 13, offset: 10533},
+											pos:  position{line: 440, col: 13, offset: 10643},
 											name: "ORDER",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 434, col: 19, offset: 10539},
+											pos:  position{line: 440, col: 19, offset: 10649},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 434, col: 21, offset: 10541},
+											pos:  position{line: 440, col: 21, offset: 10651},
 											name: "BY",
 										},
 									},
@@ -3198,33 +3230,33 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 434, col: 25, offset: 10545},
+							pos:   position{line: 440, col: 25, offset: 10655},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 434, col: 30, offset: 10550},
+								pos:  position{line: 440, col: 30, offset: 10660},
 								name: "SortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 434, col: 39, offset: 10559},
+							pos:   position{line: 440, col: 39, offset: 10669},
 							label: "exprs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 434, col: 45, offset: 10565},
+								pos: position{line: 440, col: 45, offset: 10675},
 								expr: &actionExpr{
-									pos: position{line: 434, col: 46, offset: 10566},
+									pos: position{line: 440, col: 46, offset: 10676},
 									run: (*parser).callonSortOp13,
 									expr: &seqExpr{
-										pos: position{line: 434, col: 46, offset: 10566},
+										pos: position{line: 440, col: 46, offset: 10676},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 434, col: 46, offset: 10566},
+												pos:  position{line: 440, col: 46, offset: 10676},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 434, col: 49, offset: 10569},
+												pos:   position{line: 440, col: 49, offset: 10679},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 434, col: 51, offset: 10571},
+													pos:  position{line: 440, col: 51, offset: 10681},
 													name: "OrderByList",
 												},
 											},
@@ -3241,30 +3273,30 @@ var g = &grammar{
 		},
 		{
 			name: "SortArgs",
-			pos:  position{line: 449, col: 1, offset: 10885},
+			pos:  position{line: 455, col: 1, offset: 10995},
 			expr: &actionExpr{
-				pos: position{line: 449, col: 12, offset: 10896},
+				pos: position{line: 455, col: 12, offset: 11006},
 				run: (*parser).callonSortArgs1,
 				expr: &labeledExpr{
-					pos:   position{line: 449, col: 12, offset: 10896},
+					pos:   position{line: 455, col: 12, offset: 11006},
 					label: "args",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 449, col: 17, offset: 10901},
+						pos: position{line: 455, col: 17, offset: 11011},
 						expr: &actionExpr{
-							pos: position{line: 449, col: 18, offset: 10902},
+							pos: position{line: 455, col: 18, offset: 11012},
 							run: (*parser).callonSortArgs4,
 							expr: &seqExpr{
-								pos: position{line: 449, col: 18, offset: 10902},
+								pos: position{line: 455, col: 18, offset: 11012},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 449, col: 18, offset: 10902},
+										pos:  position{line: 455, col: 18, offset: 11012},
 										name: "_",
 									},
 									&labeledExpr{
-										pos:   position{line: 449, col: 20, offset: 10904},
+										pos:   position{line: 455, col: 20, offset: 11014},
 										label: "a",
 										expr: &ruleRefExpr{
-											pos:  position{line: 449, col: 22, offset: 10906},
+											pos:  position{line: 455, col: 22, offset: 11016},
 											name: "SortArg",
 										},
 									},
@@ -3279,12 +3311,12 @@ var g = &grammar{
 		},
 		{
 			name: "SortArg",
-			pos:  position{line: 451, col: 1, offset: 10963},
+			pos:  position{line: 457, col: 1, offset: 11073},
 			expr: &actionExpr{
-				pos: position{line: 452, col: 5, offset: 10975},
+				pos: position{line: 458, col: 5, offset: 11085},
 				run: (*parser).callonSortArg1,
 				expr: &litMatcher{
-					pos:        position{line: 452, col: 5, offset: 10975},
+					pos:        position{line: 458, col: 5, offset: 11085},
 					val:        "-r",
 					ignoreCase: false,
 					want:       "\"-r\"",
@@ -3295,45 +3327,45 @@ var g = &grammar{
 		},
 		{
 			name: "TopOp",
-			pos:  position{line: 454, col: 1, offset: 11039},
+			pos:  position{line: 460, col: 1, offset: 11149},
 			expr: &actionExpr{
-				pos: position{line: 455, col: 5, offset: 11049},
+				pos: position{line: 461, col: 5, offset: 11159},
 				run: (*parser).callonTopOp1,
 				expr: &seqExpr{
-					pos: position{line: 455, col: 5, offset: 11049},
+					pos: position{line: 461, col: 5, offset: 11159},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 455, col: 5, offset: 11049},
+							pos:  position{line: 461, col: 5, offset: 11159},
 							name: "TOP",
 						},
 						&labeledExpr{
-							pos:   position{line: 455, col: 9, offset: 11053},
+							pos:   position{line: 461, col: 9, offset: 11163},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 455, col: 14, offset: 11058},
+								pos:  position{line: 461, col: 14, offset: 11168},
 								name: "SortArgs",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 455, col: 23, offset: 11067},
+							pos:   position{line: 461, col: 23, offset: 11177},
 							label: "limit",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 455, col: 29, offset: 11073},
+								pos: position{line: 461, col: 29, offset: 11183},
 								expr: &actionExpr{
-									pos: position{line: 455, col: 30, offset: 11074},
+									pos: position{line: 461, col: 30, offset: 11184},
 									run: (*parser).callonTopOp8,
 									expr: &seqExpr{
-										pos: position{line: 455, col: 30, offset: 11074},
+										pos: position{line: 461, col: 30, offset: 11184},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 455, col: 30, offset: 11074},
+												pos:  position{line: 461, col: 30, offset: 11184},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 455, col: 32, offset: 11076},
+												pos:   position{line: 461, col: 32, offset: 11186},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 455, col: 34, offset: 11078},
+													pos:  position{line: 461, col: 34, offset: 11188},
 													name: "Expr",
 												},
 											},
@@ -3343,25 +3375,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 455, col: 59, offset: 11103},
+							pos:   position{line: 461, col: 59, offset: 11213},
 							label: "exprs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 455, col: 65, offset: 11109},
+								pos: position{line: 461, col: 65, offset: 11219},
 								expr: &actionExpr{
-									pos: position{line: 455, col: 66, offset: 11110},
+									pos: position{line: 461, col: 66, offset: 11220},
 									run: (*parser).callonTopOp15,
 									expr: &seqExpr{
-										pos: position{line: 455, col: 66, offset: 11110},
+										pos: position{line: 461, col: 66, offset: 11220},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 455, col: 66, offset: 11110},
+												pos:  position{line: 461, col: 66, offset: 11220},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 455, col: 68, offset: 11112},
+												pos:   position{line: 461, col: 68, offset: 11222},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 455, col: 70, offset: 11114},
+													pos:  position{line: 461, col: 70, offset: 11224},
 													name: "OrderByList",
 												},
 											},
@@ -3378,49 +3410,49 @@ var g = &grammar{
 		},
 		{
 			name: "CallOp",
-			pos:  position{line: 473, col: 1, offset: 11498},
+			pos:  position{line: 479, col: 1, offset: 11608},
 			expr: &actionExpr{
-				pos: position{line: 474, col: 5, offset: 11509},
+				pos: position{line: 480, col: 5, offset: 11619},
 				run: (*parser).callonCallOp1,
 				expr: &seqExpr{
-					pos: position{line: 474, col: 5, offset: 11509},
+					pos: position{line: 480, col: 5, offset: 11619},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 474, col: 5, offset: 11509},
+							pos:  position{line: 480, col: 5, offset: 11619},
 							name: "CALL",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 474, col: 10, offset: 11514},
+							pos:  position{line: 480, col: 10, offset: 11624},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 474, col: 12, offset: 11516},
+							pos:   position{line: 480, col: 12, offset: 11626},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 474, col: 17, offset: 11521},
+								pos:  position{line: 480, col: 17, offset: 11631},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 474, col: 28, offset: 11532},
+							pos:   position{line: 480, col: 28, offset: 11642},
 							label: "args",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 474, col: 33, offset: 11537},
+								pos: position{line: 480, col: 33, offset: 11647},
 								expr: &actionExpr{
-									pos: position{line: 474, col: 34, offset: 11538},
+									pos: position{line: 480, col: 34, offset: 11648},
 									run: (*parser).callonCallOp9,
 									expr: &seqExpr{
-										pos: position{line: 474, col: 35, offset: 11539},
+										pos: position{line: 480, col: 35, offset: 11649},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 474, col: 35, offset: 11539},
+												pos:  position{line: 480, col: 35, offset: 11649},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 474, col: 37, offset: 11541},
+												pos:   position{line: 480, col: 37, offset: 11651},
 												label: "args",
 												expr: &ruleRefExpr{
-													pos:  position{line: 474, col: 42, offset: 11546},
+													pos:  position{line: 480, col: 42, offset: 11656},
 													name: "FuncOrExprs",
 												},
 											},
@@ -3437,29 +3469,29 @@ var g = &grammar{
 		},
 		{
 			name: "CountOp",
-			pos:  position{line: 483, col: 1, offset: 11744},
+			pos:  position{line: 489, col: 1, offset: 11854},
 			expr: &choiceExpr{
-				pos: position{line: 484, col: 5, offset: 11756},
+				pos: position{line: 490, col: 5, offset: 11866},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 484, col: 5, offset: 11756},
+						pos: position{line: 490, col: 5, offset: 11866},
 						run: (*parser).callonCountOp2,
 						expr: &seqExpr{
-							pos: position{line: 484, col: 5, offset: 11756},
+							pos: position{line: 490, col: 5, offset: 11866},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 484, col: 5, offset: 11756},
+									pos:  position{line: 490, col: 5, offset: 11866},
 									name: "COUNT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 484, col: 11, offset: 11762},
+									pos:  position{line: 490, col: 11, offset: 11872},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 484, col: 13, offset: 11764},
+									pos:   position{line: 490, col: 13, offset: 11874},
 									label: "rec",
 									expr: &ruleRefExpr{
-										pos:  position{line: 484, col: 17, offset: 11768},
+										pos:  position{line: 490, col: 17, offset: 11878},
 										name: "Record",
 									},
 								},
@@ -3467,19 +3499,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 491, col: 5, offset: 11910},
+						pos: position{line: 497, col: 5, offset: 12020},
 						run: (*parser).callonCountOp8,
 						expr: &seqExpr{
-							pos: position{line: 491, col: 5, offset: 11910},
+							pos: position{line: 497, col: 5, offset: 12020},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 491, col: 5, offset: 11910},
+									pos:  position{line: 497, col: 5, offset: 12020},
 									name: "COUNT",
 								},
 								&andExpr{
-									pos: position{line: 491, col: 11, offset: 11916},
+									pos: position{line: 497, col: 11, offset: 12026},
 									expr: &ruleRefExpr{
-										pos:  position{line: 491, col: 12, offset: 11917},
+										pos:  position{line: 497, col: 12, offset: 12027},
 										name: "EndOfOp",
 									},
 								},
@@ -3493,26 +3525,26 @@ var g = &grammar{
 		},
 		{
 			name: "CutOp",
-			pos:  position{line: 498, col: 1, offset: 12020},
+			pos:  position{line: 504, col: 1, offset: 12130},
 			expr: &actionExpr{
-				pos: position{line: 499, col: 5, offset: 12030},
+				pos: position{line: 505, col: 5, offset: 12140},
 				run: (*parser).callonCutOp1,
 				expr: &seqExpr{
-					pos: position{line: 499, col: 5, offset: 12030},
+					pos: position{line: 505, col: 5, offset: 12140},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 499, col: 5, offset: 12030},
+							pos:  position{line: 505, col: 5, offset: 12140},
 							name: "CUT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 499, col: 9, offset: 12034},
+							pos:  position{line: 505, col: 9, offset: 12144},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 499, col: 11, offset: 12036},
+							pos:   position{line: 505, col: 11, offset: 12146},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 499, col: 16, offset: 12041},
+								pos:  position{line: 505, col: 16, offset: 12151},
 								name: "Assignments",
 							},
 						},
@@ -3524,26 +3556,26 @@ var g = &grammar{
 		},
 		{
 			name: "DistinctOp",
-			pos:  position{line: 507, col: 1, offset: 12189},
+			pos:  position{line: 513, col: 1, offset: 12299},
 			expr: &actionExpr{
-				pos: position{line: 508, col: 5, offset: 12204},
+				pos: position{line: 514, col: 5, offset: 12314},
 				run: (*parser).callonDistinctOp1,
 				expr: &seqExpr{
-					pos: position{line: 508, col: 5, offset: 12204},
+					pos: position{line: 514, col: 5, offset: 12314},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 508, col: 5, offset: 12204},
+							pos:  position{line: 514, col: 5, offset: 12314},
 							name: "DISTINCT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 508, col: 14, offset: 12213},
+							pos:  position{line: 514, col: 14, offset: 12323},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 508, col: 16, offset: 12215},
+							pos:   position{line: 514, col: 16, offset: 12325},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 508, col: 18, offset: 12217},
+								pos:  position{line: 514, col: 18, offset: 12327},
 								name: "Expr",
 							},
 						},
@@ -3555,26 +3587,26 @@ var g = &grammar{
 		},
 		{
 			name: "DropOp",
-			pos:  position{line: 516, col: 1, offset: 12357},
+			pos:  position{line: 522, col: 1, offset: 12467},
 			expr: &actionExpr{
-				pos: position{line: 517, col: 5, offset: 12368},
+				pos: position{line: 523, col: 5, offset: 12478},
 				run: (*parser).callonDropOp1,
 				expr: &seqExpr{
-					pos: position{line: 517, col: 5, offset: 12368},
+					pos: position{line: 523, col: 5, offset: 12478},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 517, col: 5, offset: 12368},
+							pos:  position{line: 523, col: 5, offset: 12478},
 							name: "DROP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 517, col: 10, offset: 12373},
+							pos:  position{line: 523, col: 10, offset: 12483},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 517, col: 12, offset: 12375},
+							pos:   position{line: 523, col: 12, offset: 12485},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 517, col: 17, offset: 12380},
+								pos:  position{line: 523, col: 17, offset: 12490},
 								name: "Lvals",
 							},
 						},
@@ -3586,38 +3618,38 @@ var g = &grammar{
 		},
 		{
 			name: "HeadOp",
-			pos:  position{line: 525, col: 1, offset: 12524},
+			pos:  position{line: 531, col: 1, offset: 12634},
 			expr: &choiceExpr{
-				pos: position{line: 526, col: 5, offset: 12535},
+				pos: position{line: 532, col: 5, offset: 12645},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 526, col: 5, offset: 12535},
+						pos: position{line: 532, col: 5, offset: 12645},
 						run: (*parser).callonHeadOp2,
 						expr: &seqExpr{
-							pos: position{line: 526, col: 5, offset: 12535},
+							pos: position{line: 532, col: 5, offset: 12645},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 526, col: 6, offset: 12536},
+									pos: position{line: 532, col: 6, offset: 12646},
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 526, col: 6, offset: 12536},
+											pos:  position{line: 532, col: 6, offset: 12646},
 											name: "HEAD",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 526, col: 13, offset: 12543},
+											pos:  position{line: 532, col: 13, offset: 12653},
 											name: "LIMIT",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 526, col: 20, offset: 12550},
+									pos:  position{line: 532, col: 20, offset: 12660},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 526, col: 22, offset: 12552},
+									pos:   position{line: 532, col: 22, offset: 12662},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 526, col: 28, offset: 12558},
+										pos:  position{line: 532, col: 28, offset: 12668},
 										name: "Expr",
 									},
 								},
@@ -3625,19 +3657,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 533, col: 5, offset: 12692},
+						pos: position{line: 539, col: 5, offset: 12802},
 						run: (*parser).callonHeadOp10,
 						expr: &seqExpr{
-							pos: position{line: 533, col: 5, offset: 12692},
+							pos: position{line: 539, col: 5, offset: 12802},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 533, col: 5, offset: 12692},
+									pos:  position{line: 539, col: 5, offset: 12802},
 									name: "HEAD",
 								},
 								&andExpr{
-									pos: position{line: 533, col: 10, offset: 12697},
+									pos: position{line: 539, col: 10, offset: 12807},
 									expr: &ruleRefExpr{
-										pos:  position{line: 533, col: 11, offset: 12698},
+										pos:  position{line: 539, col: 11, offset: 12808},
 										name: "EndOfOp",
 									},
 								},
@@ -3651,29 +3683,29 @@ var g = &grammar{
 		},
 		{
 			name: "TailOp",
-			pos:  position{line: 540, col: 1, offset: 12799},
+			pos:  position{line: 546, col: 1, offset: 12909},
 			expr: &choiceExpr{
-				pos: position{line: 541, col: 5, offset: 12810},
+				pos: position{line: 547, col: 5, offset: 12920},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 541, col: 5, offset: 12810},
+						pos: position{line: 547, col: 5, offset: 12920},
 						run: (*parser).callonTailOp2,
 						expr: &seqExpr{
-							pos: position{line: 541, col: 5, offset: 12810},
+							pos: position{line: 547, col: 5, offset: 12920},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 541, col: 5, offset: 12810},
+									pos:  position{line: 547, col: 5, offset: 12920},
 									name: "TAIL",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 541, col: 10, offset: 12815},
+									pos:  position{line: 547, col: 10, offset: 12925},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 541, col: 12, offset: 12817},
+									pos:   position{line: 547, col: 12, offset: 12927},
 									label: "count",
 									expr: &ruleRefExpr{
-										pos:  position{line: 541, col: 18, offset: 12823},
+										pos:  position{line: 547, col: 18, offset: 12933},
 										name: "Expr",
 									},
 								},
@@ -3681,19 +3713,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 548, col: 5, offset: 12957},
+						pos: position{line: 554, col: 5, offset: 13067},
 						run: (*parser).callonTailOp8,
 						expr: &seqExpr{
-							pos: position{line: 548, col: 5, offset: 12957},
+							pos: position{line: 554, col: 5, offset: 13067},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 548, col: 5, offset: 12957},
+									pos:  position{line: 554, col: 5, offset: 13067},
 									name: "TAIL",
 								},
 								&andExpr{
-									pos: position{line: 548, col: 10, offset: 12962},
+									pos: position{line: 554, col: 10, offset: 13072},
 									expr: &ruleRefExpr{
-										pos:  position{line: 548, col: 11, offset: 12963},
+										pos:  position{line: 554, col: 11, offset: 13073},
 										name: "EndOfOp",
 									},
 								},
@@ -3707,26 +3739,26 @@ var g = &grammar{
 		},
 		{
 			name: "SkipOp",
-			pos:  position{line: 555, col: 1, offset: 13064},
+			pos:  position{line: 561, col: 1, offset: 13174},
 			expr: &actionExpr{
-				pos: position{line: 556, col: 5, offset: 13075},
+				pos: position{line: 562, col: 5, offset: 13185},
 				run: (*parser).callonSkipOp1,
 				expr: &seqExpr{
-					pos: position{line: 556, col: 5, offset: 13075},
+					pos: position{line: 562, col: 5, offset: 13185},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 556, col: 5, offset: 13075},
+							pos:  position{line: 562, col: 5, offset: 13185},
 							name: "SKIP",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 556, col: 10, offset: 13080},
+							pos:  position{line: 562, col: 10, offset: 13190},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 556, col: 12, offset: 13082},
+							pos:   position{line: 562, col: 12, offset: 13192},
 							label: "count",
 							expr: &ruleRefExpr{
-								pos:  position{line: 556, col: 18, offset: 13088},
+								pos:  position{line: 562, col: 18, offset: 13198},
 								name: "Expr",
 							},
 						},
@@ -3738,26 +3770,26 @@ var g = &grammar{
 		},
 		{
 			name: "WhereOp",
-			pos:  position{line: 564, col: 1, offset: 13219},
+			pos:  position{line: 570, col: 1, offset: 13329},
 			expr: &actionExpr{
-				pos: position{line: 565, col: 5, offset: 13231},
+				pos: position{line: 571, col: 5, offset: 13341},
 				run: (*parser).callonWhereOp1,
 				expr: &seqExpr{
-					pos: position{line: 565, col: 5, offset: 13231},
+					pos: position{line: 571, col: 5, offset: 13341},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 565, col: 5, offset: 13231},
+							pos:  position{line: 571, col: 5, offset: 13341},
 							name: "WHERE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 565, col: 11, offset: 13237},
+							pos:  position{line: 571, col: 11, offset: 13347},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 565, col: 13, offset: 13239},
+							pos:   position{line: 571, col: 13, offset: 13349},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 565, col: 18, offset: 13244},
+								pos:  position{line: 571, col: 18, offset: 13354},
 								name: "Expr",
 							},
 						},
@@ -3769,26 +3801,26 @@ var g = &grammar{
 		},
 		{
 			name: "UniqOp",
-			pos:  position{line: 573, col: 1, offset: 13375},
+			pos:  position{line: 579, col: 1, offset: 13485},
 			expr: &choiceExpr{
-				pos: position{line: 574, col: 5, offset: 13386},
+				pos: position{line: 580, col: 5, offset: 13496},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 574, col: 5, offset: 13386},
+						pos: position{line: 580, col: 5, offset: 13496},
 						run: (*parser).callonUniqOp2,
 						expr: &seqExpr{
-							pos: position{line: 574, col: 5, offset: 13386},
+							pos: position{line: 580, col: 5, offset: 13496},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 574, col: 5, offset: 13386},
+									pos:  position{line: 580, col: 5, offset: 13496},
 									name: "UNIQ",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 574, col: 10, offset: 13391},
+									pos:  position{line: 580, col: 10, offset: 13501},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 574, col: 12, offset: 13393},
+									pos:        position{line: 580, col: 12, offset: 13503},
 									val:        "-c",
 									ignoreCase: false,
 									want:       "\"-c\"",
@@ -3797,19 +3829,19 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 577, col: 5, offset: 13482},
+						pos: position{line: 583, col: 5, offset: 13592},
 						run: (*parser).callonUniqOp7,
 						expr: &seqExpr{
-							pos: position{line: 577, col: 5, offset: 13482},
+							pos: position{line: 583, col: 5, offset: 13592},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 577, col: 5, offset: 13482},
+									pos:  position{line: 583, col: 5, offset: 13592},
 									name: "UNIQ",
 								},
 								&andExpr{
-									pos: position{line: 577, col: 10, offset: 13487},
+									pos: position{line: 583, col: 10, offset: 13597},
 									expr: &ruleRefExpr{
-										pos:  position{line: 577, col: 11, offset: 13488},
+										pos:  position{line: 583, col: 11, offset: 13598},
 										name: "EndOfOp",
 									},
 								},
@@ -3821,28 +3853,312 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "WindowOp",
+			pos:  position{line: 587, col: 1, offset: 13674},
+			expr: &actionExpr{
+				pos: position{line: 588, col: 5, offset: 13687},
+				run: (*parser).callonWindowOp1,
+				expr: &seqExpr{
+					pos: position{line: 588, col: 5, offset: 13687},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 588, col: 5, offset: 13687},
+							name: "WINDOW",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 588, col: 12, offset: 13694},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 588, col: 14, offset: 13696},
+							label: "funcs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 588, col: 20, offset: 13702},
+								name: "WindowAssignments",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 588, col: 38, offset: 13720},
+							label: "keys",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 588, col: 43, offset: 13725},
+								expr: &actionExpr{
+									pos: position{line: 588, col: 44, offset: 13726},
+									run: (*parser).callonWindowOp9,
+									expr: &seqExpr{
+										pos: position{line: 588, col: 44, offset: 13726},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 588, col: 44, offset: 13726},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 588, col: 46, offset: 13728},
+												name: "BY",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 588, col: 49, offset: 13731},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 588, col: 51, offset: 13733},
+												label: "e",
+												expr: &ruleRefExpr{
+													pos:  position{line: 588, col: 53, offset: 13735},
+													name: "Exprs",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowAssignments",
+			pos:  position{line: 600, col: 1, offset: 13993},
+			expr: &actionExpr{
+				pos: position{line: 601, col: 5, offset: 14015},
+				run: (*parser).callonWindowAssignments1,
+				expr: &seqExpr{
+					pos: position{line: 601, col: 5, offset: 14015},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 601, col: 5, offset: 14015},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 601, col: 11, offset: 14021},
+								name: "WindowAssignment",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 601, col: 28, offset: 14038},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 601, col: 33, offset: 14043},
+								expr: &actionExpr{
+									pos: position{line: 601, col: 34, offset: 14044},
+									run: (*parser).callonWindowAssignments7,
+									expr: &seqExpr{
+										pos: position{line: 601, col: 34, offset: 14044},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 601, col: 34, offset: 14044},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 601, col: 37, offset: 14047},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 601, col: 41, offset: 14051},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 601, col: 44, offset: 14054},
+												label: "a",
+												expr: &ruleRefExpr{
+													pos:  position{line: 601, col: 46, offset: 14056},
+													name: "WindowAssignment",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowAssignment",
+			pos:  position{line: 605, col: 1, offset: 14141},
+			expr: &choiceExpr{
+				pos: position{line: 606, col: 5, offset: 14162},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 606, col: 5, offset: 14162},
+						run: (*parser).callonWindowAssignment2,
+						expr: &seqExpr{
+							pos: position{line: 606, col: 5, offset: 14162},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 606, col: 5, offset: 14162},
+									label: "lval",
+									expr: &ruleRefExpr{
+										pos:  position{line: 606, col: 10, offset: 14167},
+										name: "Lval",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 606, col: 15, offset: 14172},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 606, col: 18, offset: 14175},
+									val:        ":=",
+									ignoreCase: false,
+									want:       "\":=\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 606, col: 23, offset: 14180},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 606, col: 26, offset: 14183},
+									label: "f",
+									expr: &ruleRefExpr{
+										pos:  position{line: 606, col: 28, offset: 14185},
+										name: "WindowCall",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 609, col: 5, offset: 14295},
+						run: (*parser).callonWindowAssignment11,
+						expr: &seqExpr{
+							pos: position{line: 609, col: 5, offset: 14295},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 609, col: 5, offset: 14295},
+									label: "f",
+									expr: &ruleRefExpr{
+										pos:  position{line: 609, col: 7, offset: 14297},
+										name: "WindowCall",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 609, col: 18, offset: 14308},
+									label: "lhs",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 609, col: 22, offset: 14312},
+										expr: &ruleRefExpr{
+											pos:  position{line: 609, col: 22, offset: 14312},
+											name: "AsArg",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowCall",
+			pos:  position{line: 617, col: 1, offset: 14467},
+			expr: &choiceExpr{
+				pos: position{line: 618, col: 5, offset: 14482},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 618, col: 5, offset: 14482},
+						run: (*parser).callonWindowCall2,
+						expr: &seqExpr{
+							pos: position{line: 618, col: 5, offset: 14482},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 618, col: 5, offset: 14482},
+									label: "f",
+									expr: &ruleRefExpr{
+										pos:  position{line: 618, col: 7, offset: 14484},
+										name: "Callable",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 618, col: 16, offset: 14493},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 618, col: 19, offset: 14496},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&notExpr{
+									pos: position{line: 618, col: 23, offset: 14500},
+									expr: &ruleRefExpr{
+										pos:  position{line: 618, col: 24, offset: 14501},
+										name: "AggArgGuard",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 618, col: 36, offset: 14513},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 618, col: 39, offset: 14516},
+									label: "args",
+									expr: &ruleRefExpr{
+										pos:  position{line: 618, col: 44, offset: 14521},
+										name: "FunctionArgs",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 618, col: 57, offset: 14534},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 618, col: 60, offset: 14537},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+								&notExpr{
+									pos: position{line: 618, col: 64, offset: 14541},
+									expr: &ruleRefExpr{
+										pos:  position{line: 618, col: 65, offset: 14542},
+										name: "FilterClause",
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 621, col: 5, offset: 14605},
+						name: "AggFunc",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "PutOp",
-			pos:  position{line: 581, col: 1, offset: 13564},
+			pos:  position{line: 623, col: 1, offset: 14614},
 			expr: &actionExpr{
-				pos: position{line: 582, col: 5, offset: 13574},
+				pos: position{line: 624, col: 5, offset: 14624},
 				run: (*parser).callonPutOp1,
 				expr: &seqExpr{
-					pos: position{line: 582, col: 5, offset: 13574},
+					pos: position{line: 624, col: 5, offset: 14624},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 582, col: 5, offset: 13574},
+							pos:  position{line: 624, col: 5, offset: 14624},
 							name: "PUT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 582, col: 9, offset: 13578},
+							pos:  position{line: 624, col: 9, offset: 14628},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 582, col: 11, offset: 13580},
+							pos:   position{line: 624, col: 11, offset: 14630},
 							label: "args",
 							expr: &ruleRefExpr{
-								pos:  position{line: 582, col: 16, offset: 13585},
+								pos:  position{line: 624, col: 16, offset: 14635},
 								name: "Assignments",
 							},
 						},
@@ -3854,59 +4170,59 @@ var g = &grammar{
 		},
 		{
 			name: "RenameOp",
-			pos:  position{line: 590, col: 1, offset: 13739},
+			pos:  position{line: 632, col: 1, offset: 14789},
 			expr: &actionExpr{
-				pos: position{line: 591, col: 5, offset: 13752},
+				pos: position{line: 633, col: 5, offset: 14802},
 				run: (*parser).callonRenameOp1,
 				expr: &seqExpr{
-					pos: position{line: 591, col: 5, offset: 13752},
+					pos: position{line: 633, col: 5, offset: 14802},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 591, col: 5, offset: 13752},
+							pos:  position{line: 633, col: 5, offset: 14802},
 							name: "RENAME",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 591, col: 12, offset: 13759},
+							pos:  position{line: 633, col: 12, offset: 14809},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 591, col: 14, offset: 13761},
+							pos:   position{line: 633, col: 14, offset: 14811},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 591, col: 20, offset: 13767},
+								pos:  position{line: 633, col: 20, offset: 14817},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 591, col: 31, offset: 13778},
+							pos:   position{line: 633, col: 31, offset: 14828},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 591, col: 36, offset: 13783},
+								pos: position{line: 633, col: 36, offset: 14833},
 								expr: &actionExpr{
-									pos: position{line: 591, col: 37, offset: 13784},
+									pos: position{line: 633, col: 37, offset: 14834},
 									run: (*parser).callonRenameOp9,
 									expr: &seqExpr{
-										pos: position{line: 591, col: 37, offset: 13784},
+										pos: position{line: 633, col: 37, offset: 14834},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 591, col: 37, offset: 13784},
+												pos:  position{line: 633, col: 37, offset: 14834},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 591, col: 40, offset: 13787},
+												pos:        position{line: 633, col: 40, offset: 14837},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 591, col: 44, offset: 13791},
+												pos:  position{line: 633, col: 44, offset: 14841},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 591, col: 47, offset: 13794},
+												pos:   position{line: 633, col: 47, offset: 14844},
 												label: "cl",
 												expr: &ruleRefExpr{
-													pos:  position{line: 591, col: 50, offset: 13797},
+													pos:  position{line: 633, col: 50, offset: 14847},
 													name: "Assignment",
 												},
 											},
@@ -3923,21 +4239,21 @@ var g = &grammar{
 		},
 		{
 			name: "FuseOp",
-			pos:  position{line: 600, col: 1, offset: 14023},
+			pos:  position{line: 642, col: 1, offset: 15073},
 			expr: &actionExpr{
-				pos: position{line: 601, col: 5, offset: 14034},
+				pos: position{line: 643, col: 5, offset: 15084},
 				run: (*parser).callonFuseOp1,
 				expr: &seqExpr{
-					pos: position{line: 601, col: 5, offset: 14034},
+					pos: position{line: 643, col: 5, offset: 15084},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 601, col: 5, offset: 14034},
+							pos:  position{line: 643, col: 5, offset: 15084},
 							name: "FUSE",
 						},
 						&andExpr{
-							pos: position{line: 601, col: 10, offset: 14039},
+							pos: position{line: 643, col: 10, offset: 15089},
 							expr: &ruleRefExpr{
-								pos:  position{line: 601, col: 11, offset: 14040},
+								pos:  position{line: 643, col: 11, offset: 15090},
 								name: "EndOfOp",
 							},
 						},
@@ -3949,41 +4265,41 @@ var g = &grammar{
 		},
 		{
 			name: "JoinOp",
-			pos:  position{line: 605, col: 1, offset: 14116},
+			pos:  position{line: 647, col: 1, offset: 15166},
 			expr: &choiceExpr{
-				pos: position{line: 606, col: 5, offset: 14127},
+				pos: position{line: 648, col: 5, offset: 15177},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 606, col: 5, offset: 14127},
+						pos: position{line: 648, col: 5, offset: 15177},
 						run: (*parser).callonJoinOp2,
 						expr: &seqExpr{
-							pos: position{line: 606, col: 5, offset: 14127},
+							pos: position{line: 648, col: 5, offset: 15177},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 606, col: 5, offset: 14127},
+									pos:  position{line: 648, col: 5, offset: 15177},
 									name: "CROSS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 606, col: 11, offset: 14133},
+									pos:  position{line: 648, col: 11, offset: 15183},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 606, col: 13, offset: 14135},
+									pos:  position{line: 648, col: 13, offset: 15185},
 									name: "JOIN",
 								},
 								&labeledExpr{
-									pos:   position{line: 606, col: 18, offset: 14140},
+									pos:   position{line: 648, col: 18, offset: 15190},
 									label: "rightInput",
 									expr: &ruleRefExpr{
-										pos:  position{line: 606, col: 29, offset: 14151},
+										pos:  position{line: 648, col: 29, offset: 15201},
 										name: "JoinRightInput",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 606, col: 44, offset: 14166},
+									pos:   position{line: 648, col: 44, offset: 15216},
 									label: "alias",
 									expr: &ruleRefExpr{
-										pos:  position{line: 606, col: 50, offset: 14172},
+										pos:  position{line: 648, col: 50, offset: 15222},
 										name: "OptJoinAlias",
 									},
 								},
@@ -3991,48 +4307,48 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 620, col: 5, offset: 14479},
+						pos: position{line: 662, col: 5, offset: 15529},
 						run: (*parser).callonJoinOp11,
 						expr: &seqExpr{
-							pos: position{line: 620, col: 5, offset: 14479},
+							pos: position{line: 662, col: 5, offset: 15529},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 620, col: 5, offset: 14479},
+									pos:   position{line: 662, col: 5, offset: 15529},
 									label: "style",
 									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 11, offset: 14485},
+										pos:  position{line: 662, col: 11, offset: 15535},
 										name: "JoinStyle",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 620, col: 21, offset: 14495},
+									pos:  position{line: 662, col: 21, offset: 15545},
 									name: "JOIN",
 								},
 								&labeledExpr{
-									pos:   position{line: 620, col: 26, offset: 14500},
+									pos:   position{line: 662, col: 26, offset: 15550},
 									label: "rightInput",
 									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 37, offset: 14511},
+										pos:  position{line: 662, col: 37, offset: 15561},
 										name: "JoinRightInput",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 620, col: 52, offset: 14526},
+									pos:   position{line: 662, col: 52, offset: 15576},
 									label: "alias",
 									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 58, offset: 14532},
+										pos:  position{line: 662, col: 58, offset: 15582},
 										name: "OptJoinAlias",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 620, col: 71, offset: 14545},
+									pos:  position{line: 662, col: 71, offset: 15595},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 620, col: 73, offset: 14547},
+									pos:   position{line: 662, col: 73, offset: 15597},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 620, col: 75, offset: 14549},
+										pos:  position{line: 662, col: 75, offset: 15599},
 										name: "JoinCond",
 									},
 								},
@@ -4046,83 +4362,83 @@ var g = &grammar{
 		},
 		{
 			name: "JoinStyle",
-			pos:  position{line: 636, col: 1, offset: 14888},
+			pos:  position{line: 678, col: 1, offset: 15938},
 			expr: &choiceExpr{
-				pos: position{line: 637, col: 5, offset: 14902},
+				pos: position{line: 679, col: 5, offset: 15952},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 637, col: 5, offset: 14902},
+						pos: position{line: 679, col: 5, offset: 15952},
 						run: (*parser).callonJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 637, col: 5, offset: 14902},
+							pos: position{line: 679, col: 5, offset: 15952},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 637, col: 5, offset: 14902},
+									pos:  position{line: 679, col: 5, offset: 15952},
 									name: "ANTI",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 637, col: 10, offset: 14907},
+									pos:  position{line: 679, col: 10, offset: 15957},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 638, col: 5, offset: 14937},
+						pos: position{line: 680, col: 5, offset: 15987},
 						run: (*parser).callonJoinStyle6,
 						expr: &seqExpr{
-							pos: position{line: 638, col: 5, offset: 14937},
+							pos: position{line: 680, col: 5, offset: 15987},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 638, col: 5, offset: 14937},
+									pos:  position{line: 680, col: 5, offset: 15987},
 									name: "INNER",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 638, col: 11, offset: 14943},
+									pos:  position{line: 680, col: 11, offset: 15993},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 639, col: 5, offset: 14973},
+						pos: position{line: 681, col: 5, offset: 16023},
 						run: (*parser).callonJoinStyle10,
 						expr: &seqExpr{
-							pos: position{line: 639, col: 5, offset: 14973},
+							pos: position{line: 681, col: 5, offset: 16023},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 639, col: 5, offset: 14973},
+									pos:  position{line: 681, col: 5, offset: 16023},
 									name: "LEFT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 639, col: 11, offset: 14979},
+									pos:  position{line: 681, col: 11, offset: 16029},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 640, col: 5, offset: 15008},
+						pos: position{line: 682, col: 5, offset: 16058},
 						run: (*parser).callonJoinStyle14,
 						expr: &seqExpr{
-							pos: position{line: 640, col: 5, offset: 15008},
+							pos: position{line: 682, col: 5, offset: 16058},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 640, col: 5, offset: 15008},
+									pos:  position{line: 682, col: 5, offset: 16058},
 									name: "RIGHT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 640, col: 11, offset: 15014},
+									pos:  position{line: 682, col: 11, offset: 16064},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 641, col: 5, offset: 15044},
+						pos: position{line: 683, col: 5, offset: 16094},
 						run: (*parser).callonJoinStyle18,
 						expr: &litMatcher{
-							pos:        position{line: 641, col: 5, offset: 15044},
+							pos:        position{line: 683, col: 5, offset: 16094},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -4135,33 +4451,33 @@ var g = &grammar{
 		},
 		{
 			name: "OptJoinAlias",
-			pos:  position{line: 643, col: 1, offset: 15072},
+			pos:  position{line: 685, col: 1, offset: 16122},
 			expr: &choiceExpr{
-				pos: position{line: 644, col: 5, offset: 15089},
+				pos: position{line: 686, col: 5, offset: 16139},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 644, col: 5, offset: 15089},
+						pos: position{line: 686, col: 5, offset: 16139},
 						run: (*parser).callonOptJoinAlias2,
 						expr: &seqExpr{
-							pos: position{line: 644, col: 5, offset: 15089},
+							pos: position{line: 686, col: 5, offset: 16139},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 644, col: 5, offset: 15089},
+									pos:  position{line: 686, col: 5, offset: 16139},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 644, col: 7, offset: 15091},
+									pos:  position{line: 686, col: 7, offset: 16141},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 644, col: 10, offset: 15094},
+									pos:  position{line: 686, col: 10, offset: 16144},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 644, col: 12, offset: 15096},
+									pos:   position{line: 686, col: 12, offset: 16146},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 644, col: 14, offset: 15098},
+										pos:  position{line: 686, col: 14, offset: 16148},
 										name: "JoinAlias",
 									},
 								},
@@ -4169,10 +4485,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 645, col: 5, offset: 15130},
+						pos: position{line: 687, col: 5, offset: 16180},
 						run: (*parser).callonOptJoinAlias9,
 						expr: &litMatcher{
-							pos:        position{line: 645, col: 5, offset: 15130},
+							pos:        position{line: 687, col: 5, offset: 16180},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -4185,59 +4501,59 @@ var g = &grammar{
 		},
 		{
 			name: "JoinAlias",
-			pos:  position{line: 647, col: 1, offset: 15154},
+			pos:  position{line: 689, col: 1, offset: 16204},
 			expr: &actionExpr{
-				pos: position{line: 648, col: 5, offset: 15168},
+				pos: position{line: 690, col: 5, offset: 16218},
 				run: (*parser).callonJoinAlias1,
 				expr: &seqExpr{
-					pos: position{line: 648, col: 5, offset: 15168},
+					pos: position{line: 690, col: 5, offset: 16218},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 648, col: 5, offset: 15168},
+							pos:        position{line: 690, col: 5, offset: 16218},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 648, col: 9, offset: 15172},
+							pos:  position{line: 690, col: 9, offset: 16222},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 648, col: 12, offset: 15175},
+							pos:   position{line: 690, col: 12, offset: 16225},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 648, col: 17, offset: 15180},
+								pos:  position{line: 690, col: 17, offset: 16230},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 648, col: 28, offset: 15191},
+							pos:  position{line: 690, col: 28, offset: 16241},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 648, col: 31, offset: 15194},
+							pos:        position{line: 690, col: 31, offset: 16244},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 648, col: 35, offset: 15198},
+							pos:  position{line: 690, col: 35, offset: 16248},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 648, col: 38, offset: 15201},
+							pos:   position{line: 690, col: 38, offset: 16251},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 648, col: 44, offset: 15207},
+								pos:  position{line: 690, col: 44, offset: 16257},
 								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 648, col: 55, offset: 15218},
+							pos:  position{line: 690, col: 55, offset: 16268},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 648, col: 58, offset: 15221},
+							pos:        position{line: 690, col: 58, offset: 16271},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -4250,44 +4566,44 @@ var g = &grammar{
 		},
 		{
 			name: "JoinRightInput",
-			pos:  position{line: 656, col: 1, offset: 15359},
+			pos:  position{line: 698, col: 1, offset: 16409},
 			expr: &choiceExpr{
-				pos: position{line: 657, col: 5, offset: 15378},
+				pos: position{line: 699, col: 5, offset: 16428},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 657, col: 5, offset: 15378},
+						pos: position{line: 699, col: 5, offset: 16428},
 						run: (*parser).callonJoinRightInput2,
 						expr: &seqExpr{
-							pos: position{line: 657, col: 5, offset: 15378},
+							pos: position{line: 699, col: 5, offset: 16428},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 657, col: 5, offset: 15378},
+									pos:  position{line: 699, col: 5, offset: 16428},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 657, col: 8, offset: 15381},
+									pos:        position{line: 699, col: 8, offset: 16431},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 657, col: 12, offset: 15385},
+									pos:  position{line: 699, col: 12, offset: 16435},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 657, col: 15, offset: 15388},
+									pos:   position{line: 699, col: 15, offset: 16438},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 657, col: 17, offset: 15390},
+										pos:  position{line: 699, col: 17, offset: 16440},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 657, col: 21, offset: 15394},
+									pos:  position{line: 699, col: 21, offset: 16444},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 657, col: 24, offset: 15397},
+									pos:        position{line: 699, col: 24, offset: 16447},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -4296,10 +4612,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 658, col: 5, offset: 15423},
+						pos: position{line: 700, col: 5, offset: 16473},
 						run: (*parser).callonJoinRightInput11,
 						expr: &litMatcher{
-							pos:        position{line: 658, col: 5, offset: 15423},
+							pos:        position{line: 700, col: 5, offset: 16473},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -4312,37 +4628,37 @@ var g = &grammar{
 		},
 		{
 			name: "ShapesOp",
-			pos:  position{line: 660, col: 1, offset: 15447},
+			pos:  position{line: 702, col: 1, offset: 16497},
 			expr: &actionExpr{
-				pos: position{line: 661, col: 5, offset: 15460},
+				pos: position{line: 703, col: 5, offset: 16510},
 				run: (*parser).callonShapesOp1,
 				expr: &seqExpr{
-					pos: position{line: 661, col: 5, offset: 15460},
+					pos: position{line: 703, col: 5, offset: 16510},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 661, col: 5, offset: 15460},
+							pos:  position{line: 703, col: 5, offset: 16510},
 							name: "SHAPES",
 						},
 						&labeledExpr{
-							pos:   position{line: 661, col: 12, offset: 15467},
+							pos:   position{line: 703, col: 12, offset: 16517},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 661, col: 17, offset: 15472},
+								pos: position{line: 703, col: 17, offset: 16522},
 								expr: &actionExpr{
-									pos: position{line: 661, col: 18, offset: 15473},
+									pos: position{line: 703, col: 18, offset: 16523},
 									run: (*parser).callonShapesOp6,
 									expr: &seqExpr{
-										pos: position{line: 661, col: 18, offset: 15473},
+										pos: position{line: 703, col: 18, offset: 16523},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 661, col: 18, offset: 15473},
+												pos:  position{line: 703, col: 18, offset: 16523},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 661, col: 20, offset: 15475},
+												pos:   position{line: 703, col: 20, offset: 16525},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 661, col: 22, offset: 15477},
+													pos:  position{line: 703, col: 22, offset: 16527},
 													name: "Lval",
 												},
 											},
@@ -4359,28 +4675,28 @@ var g = &grammar{
 		},
 		{
 			name: "AssignmentOp",
-			pos:  position{line: 674, col: 1, offset: 15920},
+			pos:  position{line: 716, col: 1, offset: 16970},
 			expr: &actionExpr{
-				pos: position{line: 675, col: 5, offset: 15937},
+				pos: position{line: 717, col: 5, offset: 16987},
 				run: (*parser).callonAssignmentOp1,
 				expr: &seqExpr{
-					pos: position{line: 675, col: 5, offset: 15937},
+					pos: position{line: 717, col: 5, offset: 16987},
 					exprs: []any{
 						&andExpr{
-							pos: position{line: 675, col: 5, offset: 15937},
+							pos: position{line: 717, col: 5, offset: 16987},
 							expr: &seqExpr{
-								pos: position{line: 675, col: 7, offset: 15939},
+								pos: position{line: 717, col: 7, offset: 16989},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 675, col: 7, offset: 15939},
+										pos:  position{line: 717, col: 7, offset: 16989},
 										name: "Lval",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 675, col: 12, offset: 15944},
+										pos:  position{line: 717, col: 12, offset: 16994},
 										name: "__",
 									},
 									&litMatcher{
-										pos:        position{line: 675, col: 15, offset: 15947},
+										pos:        position{line: 717, col: 15, offset: 16997},
 										val:        ":=",
 										ignoreCase: false,
 										want:       "\":=\"",
@@ -4389,10 +4705,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 675, col: 21, offset: 15953},
+							pos:   position{line: 717, col: 21, offset: 17003},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 675, col: 23, offset: 15955},
+								pos:  position{line: 717, col: 23, offset: 17005},
 								name: "Assignments",
 							},
 						},
@@ -4404,36 +4720,36 @@ var g = &grammar{
 		},
 		{
 			name: "LoadOp",
-			pos:  position{line: 683, col: 1, offset: 16127},
+			pos:  position{line: 725, col: 1, offset: 17177},
 			expr: &actionExpr{
-				pos: position{line: 684, col: 5, offset: 16138},
+				pos: position{line: 726, col: 5, offset: 17188},
 				run: (*parser).callonLoadOp1,
 				expr: &seqExpr{
-					pos: position{line: 684, col: 5, offset: 16138},
+					pos: position{line: 726, col: 5, offset: 17188},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 684, col: 5, offset: 16138},
+							pos:  position{line: 726, col: 5, offset: 17188},
 							name: "LOAD",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 684, col: 10, offset: 16143},
+							pos:  position{line: 726, col: 10, offset: 17193},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 684, col: 12, offset: 16145},
+							pos:   position{line: 726, col: 12, offset: 17195},
 							label: "pool",
 							expr: &ruleRefExpr{
-								pos:  position{line: 684, col: 17, offset: 16150},
+								pos:  position{line: 726, col: 17, offset: 17200},
 								name: "Text",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 684, col: 22, offset: 16155},
+							pos:   position{line: 726, col: 22, offset: 17205},
 							label: "args",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 684, col: 27, offset: 16160},
+								pos: position{line: 726, col: 27, offset: 17210},
 								expr: &ruleRefExpr{
-									pos:  position{line: 684, col: 27, offset: 16160},
+									pos:  position{line: 726, col: 27, offset: 17210},
 									name: "CommitishOpArgs",
 								},
 							},
@@ -4446,26 +4762,26 @@ var g = &grammar{
 		},
 		{
 			name: "OutputOp",
-			pos:  position{line: 693, col: 1, offset: 16342},
+			pos:  position{line: 735, col: 1, offset: 17392},
 			expr: &actionExpr{
-				pos: position{line: 694, col: 5, offset: 16355},
+				pos: position{line: 736, col: 5, offset: 17405},
 				run: (*parser).callonOutputOp1,
 				expr: &seqExpr{
-					pos: position{line: 694, col: 5, offset: 16355},
+					pos: position{line: 736, col: 5, offset: 17405},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 694, col: 5, offset: 16355},
+							pos:  position{line: 736, col: 5, offset: 17405},
 							name: "OUTPUT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 694, col: 12, offset: 16362},
+							pos:  position{line: 736, col: 12, offset: 17412},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 694, col: 14, offset: 16364},
+							pos:   position{line: 736, col: 14, offset: 17414},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 694, col: 19, offset: 16369},
+								pos:  position{line: 736, col: 19, offset: 17419},
 								name: "Identifier",
 							},
 						},
@@ -4477,44 +4793,44 @@ var g = &grammar{
 		},
 		{
 			name: "DebugOp",
-			pos:  position{line: 702, col: 1, offset: 16507},
+			pos:  position{line: 744, col: 1, offset: 17557},
 			expr: &actionExpr{
-				pos: position{line: 703, col: 5, offset: 16519},
+				pos: position{line: 745, col: 5, offset: 17569},
 				run: (*parser).callonDebugOp1,
 				expr: &seqExpr{
-					pos: position{line: 703, col: 5, offset: 16519},
+					pos: position{line: 745, col: 5, offset: 17569},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 703, col: 5, offset: 16519},
+							pos:  position{line: 745, col: 5, offset: 17569},
 							name: "DEBUG",
 						},
 						&labeledExpr{
-							pos:   position{line: 703, col: 11, offset: 16525},
+							pos:   position{line: 745, col: 11, offset: 17575},
 							label: "expr",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 703, col: 16, offset: 16530},
+								pos: position{line: 745, col: 16, offset: 17580},
 								expr: &actionExpr{
-									pos: position{line: 703, col: 17, offset: 16531},
+									pos: position{line: 745, col: 17, offset: 17581},
 									run: (*parser).callonDebugOp6,
 									expr: &seqExpr{
-										pos: position{line: 703, col: 17, offset: 16531},
+										pos: position{line: 745, col: 17, offset: 17581},
 										exprs: []any{
 											&notExpr{
-												pos: position{line: 703, col: 17, offset: 16531},
+												pos: position{line: 745, col: 17, offset: 17581},
 												expr: &ruleRefExpr{
-													pos:  position{line: 703, col: 18, offset: 16532},
+													pos:  position{line: 745, col: 18, offset: 17582},
 													name: "FilterClause",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 703, col: 31, offset: 16545},
+												pos:  position{line: 745, col: 31, offset: 17595},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 703, col: 33, offset: 16547},
+												pos:   position{line: 745, col: 33, offset: 17597},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 703, col: 35, offset: 16549},
+													pos:  position{line: 745, col: 35, offset: 17599},
 													name: "Expr",
 												},
 											},
@@ -4524,12 +4840,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 703, col: 60, offset: 16574},
+							pos:   position{line: 745, col: 60, offset: 17624},
 							label: "filter",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 703, col: 67, offset: 16581},
+								pos: position{line: 745, col: 67, offset: 17631},
 								expr: &ruleRefExpr{
-									pos:  position{line: 703, col: 67, offset: 16581},
+									pos:  position{line: 745, col: 67, offset: 17631},
 									name: "FilterClause",
 								},
 							},
@@ -4542,26 +4858,26 @@ var g = &grammar{
 		},
 		{
 			name: "FromOp",
-			pos:  position{line: 717, col: 1, offset: 16837},
+			pos:  position{line: 759, col: 1, offset: 17887},
 			expr: &actionExpr{
-				pos: position{line: 718, col: 5, offset: 16848},
+				pos: position{line: 760, col: 5, offset: 17898},
 				run: (*parser).callonFromOp1,
 				expr: &seqExpr{
-					pos: position{line: 718, col: 5, offset: 16848},
+					pos: position{line: 760, col: 5, offset: 17898},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 718, col: 5, offset: 16848},
+							pos:  position{line: 760, col: 5, offset: 17898},
 							name: "FROM",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 718, col: 10, offset: 16853},
+							pos:  position{line: 760, col: 10, offset: 17903},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 718, col: 12, offset: 16855},
+							pos:   position{line: 760, col: 12, offset: 17905},
 							label: "item",
 							expr: &ruleRefExpr{
-								pos:  position{line: 718, col: 17, offset: 16860},
+								pos:  position{line: 760, col: 17, offset: 17910},
 								name: "FromItem",
 							},
 						},
@@ -4573,28 +4889,28 @@ var g = &grammar{
 		},
 		{
 			name: "JoinedTable",
-			pos:  position{line: 726, col: 1, offset: 16996},
+			pos:  position{line: 768, col: 1, offset: 18046},
 			expr: &actionExpr{
-				pos: position{line: 727, col: 5, offset: 17012},
+				pos: position{line: 769, col: 5, offset: 18062},
 				run: (*parser).callonJoinedTable1,
 				expr: &seqExpr{
-					pos: position{line: 727, col: 5, offset: 17012},
+					pos: position{line: 769, col: 5, offset: 18062},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 727, col: 5, offset: 17012},
+							pos:   position{line: 769, col: 5, offset: 18062},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 727, col: 11, offset: 17018},
+								pos:  position{line: 769, col: 11, offset: 18068},
 								name: "SQLTableExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 727, col: 24, offset: 17031},
+							pos:   position{line: 769, col: 24, offset: 18081},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 727, col: 29, offset: 17036},
+								pos: position{line: 769, col: 29, offset: 18086},
 								expr: &ruleRefExpr{
-									pos:  position{line: 727, col: 30, offset: 17037},
+									pos:  position{line: 769, col: 30, offset: 18087},
 									name: "JoinOperation",
 								},
 							},
@@ -4607,40 +4923,40 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTableExpr",
-			pos:  position{line: 745, col: 1, offset: 17481},
+			pos:  position{line: 787, col: 1, offset: 18531},
 			expr: &choiceExpr{
-				pos: position{line: 746, col: 5, offset: 17498},
+				pos: position{line: 788, col: 5, offset: 18548},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 746, col: 5, offset: 17498},
+						pos: position{line: 788, col: 5, offset: 18548},
 						run: (*parser).callonSQLTableExpr2,
 						expr: &seqExpr{
-							pos: position{line: 746, col: 5, offset: 17498},
+							pos: position{line: 788, col: 5, offset: 18548},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 746, col: 5, offset: 17498},
+									pos:        position{line: 788, col: 5, offset: 18548},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 746, col: 9, offset: 17502},
+									pos:  position{line: 788, col: 9, offset: 18552},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 746, col: 12, offset: 17505},
+									pos:   position{line: 788, col: 12, offset: 18555},
 									label: "table",
 									expr: &ruleRefExpr{
-										pos:  position{line: 746, col: 18, offset: 17511},
+										pos:  position{line: 788, col: 18, offset: 18561},
 										name: "JoinedTable",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 746, col: 30, offset: 17523},
+									pos:  position{line: 788, col: 30, offset: 18573},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 746, col: 33, offset: 17526},
+									pos:        position{line: 788, col: 33, offset: 18576},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -4649,52 +4965,52 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 747, col: 5, offset: 17556},
+						pos: position{line: 789, col: 5, offset: 18606},
 						run: (*parser).callonSQLTableExpr10,
 						expr: &seqExpr{
-							pos: position{line: 747, col: 5, offset: 17556},
+							pos: position{line: 789, col: 5, offset: 18606},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 747, col: 5, offset: 17556},
+									pos:        position{line: 789, col: 5, offset: 18606},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 747, col: 9, offset: 17560},
+									pos:  position{line: 789, col: 9, offset: 18610},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 747, col: 12, offset: 17563},
+									pos:   position{line: 789, col: 12, offset: 18613},
 									label: "pipe",
 									expr: &ruleRefExpr{
-										pos:  position{line: 747, col: 17, offset: 17568},
+										pos:  position{line: 789, col: 17, offset: 18618},
 										name: "SQLPipe",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 747, col: 25, offset: 17576},
+									pos:  position{line: 789, col: 25, offset: 18626},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 747, col: 28, offset: 17579},
+									pos:        position{line: 789, col: 28, offset: 18629},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 747, col: 32, offset: 17583},
+									pos:   position{line: 789, col: 32, offset: 18633},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 747, col: 34, offset: 17585},
+										pos:  position{line: 789, col: 34, offset: 18635},
 										name: "OptOrdinality",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 747, col: 48, offset: 17599},
+									pos:   position{line: 789, col: 48, offset: 18649},
 									label: "alias",
 									expr: &ruleRefExpr{
-										pos:  position{line: 747, col: 54, offset: 17605},
+										pos:  position{line: 789, col: 54, offset: 18655},
 										name: "OptAlias",
 									},
 								},
@@ -4702,32 +5018,32 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 761, col: 5, offset: 17926},
+						pos: position{line: 803, col: 5, offset: 18976},
 						run: (*parser).callonSQLTableExpr22,
 						expr: &seqExpr{
-							pos: position{line: 761, col: 5, offset: 17926},
+							pos: position{line: 803, col: 5, offset: 18976},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 761, col: 5, offset: 17926},
+									pos:   position{line: 803, col: 5, offset: 18976},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 761, col: 7, offset: 17928},
+										pos:  position{line: 803, col: 7, offset: 18978},
 										name: "FromItem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 761, col: 16, offset: 17937},
+									pos:   position{line: 803, col: 16, offset: 18987},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 761, col: 18, offset: 17939},
+										pos:  position{line: 803, col: 18, offset: 18989},
 										name: "OptOrdinality",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 761, col: 32, offset: 17953},
+									pos:   position{line: 803, col: 32, offset: 19003},
 									label: "alias",
 									expr: &ruleRefExpr{
-										pos:  position{line: 761, col: 38, offset: 17959},
+										pos:  position{line: 803, col: 38, offset: 19009},
 										name: "OptAlias",
 									},
 								},
@@ -4741,28 +5057,28 @@ var g = &grammar{
 		},
 		{
 			name: "FromItem",
-			pos:  position{line: 776, col: 1, offset: 18275},
+			pos:  position{line: 818, col: 1, offset: 19325},
 			expr: &actionExpr{
-				pos: position{line: 777, col: 5, offset: 18288},
+				pos: position{line: 819, col: 5, offset: 19338},
 				run: (*parser).callonFromItem1,
 				expr: &seqExpr{
-					pos: position{line: 777, col: 5, offset: 18288},
+					pos: position{line: 819, col: 5, offset: 19338},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 777, col: 5, offset: 18288},
+							pos:   position{line: 819, col: 5, offset: 19338},
 							label: "source",
 							expr: &ruleRefExpr{
-								pos:  position{line: 777, col: 12, offset: 18295},
+								pos:  position{line: 819, col: 12, offset: 19345},
 								name: "FromSource",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 777, col: 23, offset: 18306},
+							pos:   position{line: 819, col: 23, offset: 19356},
 							label: "args",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 777, col: 28, offset: 18311},
+								pos: position{line: 819, col: 28, offset: 19361},
 								expr: &ruleRefExpr{
-									pos:  position{line: 777, col: 28, offset: 18311},
+									pos:  position{line: 819, col: 28, offset: 19361},
 									name: "CommitishOpArgs",
 								},
 							},
@@ -4775,34 +5091,34 @@ var g = &grammar{
 		},
 		{
 			name: "FromSource",
-			pos:  position{line: 785, col: 1, offset: 18480},
+			pos:  position{line: 827, col: 1, offset: 19530},
 			expr: &choiceExpr{
-				pos: position{line: 786, col: 5, offset: 18495},
+				pos: position{line: 828, col: 5, offset: 19545},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 786, col: 5, offset: 18495},
+						pos:  position{line: 828, col: 5, offset: 19545},
 						name: "Regexp",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 787, col: 5, offset: 18506},
+						pos:  position{line: 829, col: 5, offset: 19556},
 						name: "Glob",
 					},
 					&actionExpr{
-						pos: position{line: 788, col: 5, offset: 18515},
+						pos: position{line: 830, col: 5, offset: 19565},
 						run: (*parser).callonFromSource4,
 						expr: &seqExpr{
-							pos: position{line: 788, col: 5, offset: 18515},
+							pos: position{line: 830, col: 5, offset: 19565},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 788, col: 5, offset: 18515},
+									pos:        position{line: 830, col: 5, offset: 19565},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&notExpr{
-									pos: position{line: 788, col: 9, offset: 18519},
+									pos: position{line: 830, col: 9, offset: 19569},
 									expr: &ruleRefExpr{
-										pos:  position{line: 788, col: 10, offset: 18520},
+										pos:  position{line: 830, col: 10, offset: 19570},
 										name: "ExprGuard",
 									},
 								},
@@ -4810,31 +5126,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 789, col: 5, offset: 18609},
+						pos: position{line: 831, col: 5, offset: 19659},
 						run: (*parser).callonFromSource9,
 						expr: &labeledExpr{
-							pos:   position{line: 789, col: 5, offset: 18609},
+							pos:   position{line: 831, col: 5, offset: 19659},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 789, col: 7, offset: 18611},
+								pos:  position{line: 831, col: 7, offset: 19661},
 								name: "FString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 796, col: 5, offset: 18755},
+						pos: position{line: 838, col: 5, offset: 19805},
 						run: (*parser).callonFromSource12,
 						expr: &labeledExpr{
-							pos:   position{line: 796, col: 5, offset: 18755},
+							pos:   position{line: 838, col: 5, offset: 19805},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 796, col: 10, offset: 18760},
+								pos:  position{line: 838, col: 10, offset: 19810},
 								name: "ColonName",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 803, col: 5, offset: 18898},
+						pos:  position{line: 845, col: 5, offset: 19948},
 						name: "Text",
 					},
 				},
@@ -4844,34 +5160,34 @@ var g = &grammar{
 		},
 		{
 			name: "Text",
-			pos:  position{line: 805, col: 1, offset: 18904},
+			pos:  position{line: 847, col: 1, offset: 19954},
 			expr: &actionExpr{
-				pos: position{line: 806, col: 4, offset: 18912},
+				pos: position{line: 848, col: 4, offset: 19962},
 				run: (*parser).callonText1,
 				expr: &labeledExpr{
-					pos:   position{line: 806, col: 4, offset: 18912},
+					pos:   position{line: 848, col: 4, offset: 19962},
 					label: "s",
 					expr: &choiceExpr{
-						pos: position{line: 806, col: 7, offset: 18915},
+						pos: position{line: 848, col: 7, offset: 19965},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 806, col: 7, offset: 18915},
+								pos:  position{line: 848, col: 7, offset: 19965},
 								name: "SimpleURL",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 806, col: 19, offset: 18927},
+								pos:  position{line: 848, col: 19, offset: 19977},
 								name: "TextChars",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 806, col: 31, offset: 18939},
+								pos:  position{line: 848, col: 31, offset: 19989},
 								name: "DoubleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 806, col: 52, offset: 18960},
+								pos:  position{line: 848, col: 52, offset: 20010},
 								name: "SingleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 806, col: 73, offset: 18981},
+								pos:  position{line: 848, col: 73, offset: 20031},
 								name: "RString",
 							},
 						},
@@ -4883,29 +5199,29 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleURL",
-			pos:  position{line: 810, col: 1, offset: 19070},
+			pos:  position{line: 852, col: 1, offset: 20120},
 			expr: &actionExpr{
-				pos: position{line: 811, col: 3, offset: 19084},
+				pos: position{line: 853, col: 3, offset: 20134},
 				run: (*parser).callonSimpleURL1,
 				expr: &seqExpr{
-					pos: position{line: 811, col: 3, offset: 19084},
+					pos: position{line: 853, col: 3, offset: 20134},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 811, col: 4, offset: 19085},
+							pos: position{line: 853, col: 4, offset: 20135},
 							alternatives: []any{
 								&seqExpr{
-									pos: position{line: 811, col: 4, offset: 19085},
+									pos: position{line: 853, col: 4, offset: 20135},
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 811, col: 4, offset: 19085},
+											pos:        position{line: 853, col: 4, offset: 20135},
 											val:        "http",
 											ignoreCase: false,
 											want:       "\"http\"",
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 811, col: 11, offset: 19092},
+											pos: position{line: 853, col: 11, offset: 20142},
 											expr: &litMatcher{
-												pos:        position{line: 811, col: 11, offset: 19092},
+												pos:        position{line: 853, col: 11, offset: 20142},
 												val:        "s",
 												ignoreCase: false,
 												want:       "\"s\"",
@@ -4914,7 +5230,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 811, col: 18, offset: 19099},
+									pos:        position{line: 853, col: 18, offset: 20149},
 									val:        "s3",
 									ignoreCase: false,
 									want:       "\"s3\"",
@@ -4922,15 +5238,15 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 811, col: 24, offset: 19105},
+							pos:        position{line: 853, col: 24, offset: 20155},
 							val:        "://",
 							ignoreCase: false,
 							want:       "\"://\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 812, col: 4, offset: 19114},
+							pos: position{line: 854, col: 4, offset: 20164},
 							expr: &charClassMatcher{
-								pos:        position{line: 812, col: 4, offset: 19114},
+								pos:        position{line: 854, col: 4, offset: 20164},
 								val:        "[a-zA-Z0-9_-]",
 								chars:      []rune{'_', '-'},
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -4939,20 +5255,20 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 812, col: 20, offset: 19130},
+							pos: position{line: 854, col: 20, offset: 20180},
 							expr: &seqExpr{
-								pos: position{line: 812, col: 22, offset: 19132},
+								pos: position{line: 854, col: 22, offset: 20182},
 								exprs: []any{
 									&litMatcher{
-										pos:        position{line: 812, col: 22, offset: 19132},
+										pos:        position{line: 854, col: 22, offset: 20182},
 										val:        ".",
 										ignoreCase: false,
 										want:       "\".\"",
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 812, col: 26, offset: 19136},
+										pos: position{line: 854, col: 26, offset: 20186},
 										expr: &charClassMatcher{
-											pos:        position{line: 812, col: 26, offset: 19136},
+											pos:        position{line: 854, col: 26, offset: 20186},
 											val:        "[a-zA-Z0-9_-]",
 											chars:      []rune{'_', '-'},
 											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -4964,20 +5280,20 @@ var g = &grammar{
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 813, col: 3, offset: 19155},
+							pos: position{line: 855, col: 3, offset: 20205},
 							expr: &seqExpr{
-								pos: position{line: 813, col: 4, offset: 19156},
+								pos: position{line: 855, col: 4, offset: 20206},
 								exprs: []any{
 									&litMatcher{
-										pos:        position{line: 813, col: 4, offset: 19156},
+										pos:        position{line: 855, col: 4, offset: 20206},
 										val:        "/",
 										ignoreCase: false,
 										want:       "\"/\"",
 									},
 									&zeroOrOneExpr{
-										pos: position{line: 813, col: 8, offset: 19160},
+										pos: position{line: 855, col: 8, offset: 20210},
 										expr: &ruleRefExpr{
-											pos:  position{line: 813, col: 8, offset: 19160},
+											pos:  position{line: 855, col: 8, offset: 20210},
 											name: "TextChars",
 										},
 									},
@@ -4992,27 +5308,27 @@ var g = &grammar{
 		},
 		{
 			name: "TextChars",
-			pos:  position{line: 815, col: 1, offset: 19205},
+			pos:  position{line: 857, col: 1, offset: 20255},
 			expr: &actionExpr{
-				pos: position{line: 816, col: 5, offset: 19219},
+				pos: position{line: 858, col: 5, offset: 20269},
 				run: (*parser).callonTextChars1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 816, col: 5, offset: 19219},
+					pos: position{line: 858, col: 5, offset: 20269},
 					expr: &choiceExpr{
-						pos: position{line: 816, col: 6, offset: 19220},
+						pos: position{line: 858, col: 6, offset: 20270},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 816, col: 6, offset: 19220},
+								pos:  position{line: 858, col: 6, offset: 20270},
 								name: "IdentifierRest",
 							},
 							&litMatcher{
-								pos:        position{line: 816, col: 23, offset: 19237},
+								pos:        position{line: 858, col: 23, offset: 20287},
 								val:        ".",
 								ignoreCase: false,
 								want:       "\".\"",
 							},
 							&litMatcher{
-								pos:        position{line: 816, col: 29, offset: 19243},
+								pos:        position{line: 858, col: 29, offset: 20293},
 								val:        "/",
 								ignoreCase: false,
 								want:       "\"/\"",
@@ -5026,40 +5342,40 @@ var g = &grammar{
 		},
 		{
 			name: "CommitishOpArgs",
-			pos:  position{line: 818, col: 1, offset: 19281},
+			pos:  position{line: 860, col: 1, offset: 20331},
 			expr: &choiceExpr{
-				pos: position{line: 819, col: 5, offset: 19301},
+				pos: position{line: 861, col: 5, offset: 20351},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 819, col: 5, offset: 19301},
+						pos: position{line: 861, col: 5, offset: 20351},
 						run: (*parser).callonCommitishOpArgs2,
 						expr: &seqExpr{
-							pos: position{line: 819, col: 5, offset: 19301},
+							pos: position{line: 861, col: 5, offset: 20351},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 819, col: 5, offset: 19301},
+									pos:  position{line: 861, col: 5, offset: 20351},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 819, col: 8, offset: 19304},
+									pos:   position{line: 861, col: 8, offset: 20354},
 									label: "commit",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 819, col: 15, offset: 19311},
+										pos: position{line: 861, col: 15, offset: 20361},
 										expr: &ruleRefExpr{
-											pos:  position{line: 819, col: 15, offset: 19311},
+											pos:  position{line: 861, col: 15, offset: 20361},
 											name: "MetaCommitish",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 819, col: 30, offset: 19326},
+									pos:  position{line: 861, col: 30, offset: 20376},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 819, col: 33, offset: 19329},
+									pos:   position{line: 861, col: 33, offset: 20379},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 819, col: 38, offset: 19334},
+										pos:  position{line: 861, col: 38, offset: 20384},
 										name: "OpArgs",
 									},
 								},
@@ -5067,20 +5383,20 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 825, col: 5, offset: 19464},
+						pos: position{line: 867, col: 5, offset: 20514},
 						run: (*parser).callonCommitishOpArgs11,
 						expr: &seqExpr{
-							pos: position{line: 825, col: 5, offset: 19464},
+							pos: position{line: 867, col: 5, offset: 20514},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 825, col: 5, offset: 19464},
+									pos:  position{line: 867, col: 5, offset: 20514},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 825, col: 8, offset: 19467},
+									pos:   position{line: 867, col: 8, offset: 20517},
 									label: "commit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 825, col: 15, offset: 19474},
+										pos:  position{line: 867, col: 15, offset: 20524},
 										name: "MetaCommitish",
 									},
 								},
@@ -5094,31 +5410,31 @@ var g = &grammar{
 		},
 		{
 			name: "MetaCommitish",
-			pos:  position{line: 827, col: 1, offset: 19512},
+			pos:  position{line: 869, col: 1, offset: 20562},
 			expr: &choiceExpr{
-				pos: position{line: 828, col: 5, offset: 19530},
+				pos: position{line: 870, col: 5, offset: 20580},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 828, col: 5, offset: 19530},
+						pos: position{line: 870, col: 5, offset: 20580},
 						run: (*parser).callonMetaCommitish2,
 						expr: &seqExpr{
-							pos: position{line: 828, col: 5, offset: 19530},
+							pos: position{line: 870, col: 5, offset: 20580},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 828, col: 5, offset: 19530},
+									pos:   position{line: 870, col: 5, offset: 20580},
 									label: "commit",
 									expr: &ruleRefExpr{
-										pos:  position{line: 828, col: 12, offset: 19537},
+										pos:  position{line: 870, col: 12, offset: 20587},
 										name: "Commitish",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 828, col: 22, offset: 19547},
+									pos:   position{line: 870, col: 22, offset: 20597},
 									label: "meta",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 828, col: 27, offset: 19552},
+										pos: position{line: 870, col: 27, offset: 20602},
 										expr: &ruleRefExpr{
-											pos:  position{line: 828, col: 27, offset: 19552},
+											pos:  position{line: 870, col: 27, offset: 20602},
 											name: "ColonName",
 										},
 									},
@@ -5127,13 +5443,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 835, col: 5, offset: 19776},
+						pos: position{line: 877, col: 5, offset: 20826},
 						run: (*parser).callonMetaCommitish9,
 						expr: &labeledExpr{
-							pos:   position{line: 835, col: 5, offset: 19776},
+							pos:   position{line: 877, col: 5, offset: 20826},
 							label: "meta",
 							expr: &ruleRefExpr{
-								pos:  position{line: 835, col: 10, offset: 19781},
+								pos:  position{line: 877, col: 10, offset: 20831},
 								name: "ColonName",
 							},
 						},
@@ -5145,24 +5461,24 @@ var g = &grammar{
 		},
 		{
 			name: "Commitish",
-			pos:  position{line: 839, col: 1, offset: 19905},
+			pos:  position{line: 881, col: 1, offset: 20955},
 			expr: &actionExpr{
-				pos: position{line: 840, col: 5, offset: 19919},
+				pos: position{line: 882, col: 5, offset: 20969},
 				run: (*parser).callonCommitish1,
 				expr: &seqExpr{
-					pos: position{line: 840, col: 5, offset: 19919},
+					pos: position{line: 882, col: 5, offset: 20969},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 840, col: 5, offset: 19919},
+							pos:        position{line: 882, col: 5, offset: 20969},
 							val:        "@",
 							ignoreCase: false,
 							want:       "\"@\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 840, col: 9, offset: 19923},
+							pos:   position{line: 882, col: 9, offset: 20973},
 							label: "text",
 							expr: &ruleRefExpr{
-								pos:  position{line: 840, col: 14, offset: 19928},
+								pos:  position{line: 882, col: 14, offset: 20978},
 								name: "CommitText",
 							},
 						},
@@ -5174,19 +5490,19 @@ var g = &grammar{
 		},
 		{
 			name: "CommitText",
-			pos:  position{line: 844, col: 1, offset: 20063},
+			pos:  position{line: 886, col: 1, offset: 21113},
 			expr: &choiceExpr{
-				pos: position{line: 845, col: 5, offset: 20078},
+				pos: position{line: 887, col: 5, offset: 21128},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 845, col: 5, offset: 20078},
+						pos:  position{line: 887, col: 5, offset: 21128},
 						name: "Name",
 					},
 					&actionExpr{
-						pos: position{line: 846, col: 5, offset: 20087},
+						pos: position{line: 888, col: 5, offset: 21137},
 						run: (*parser).callonCommitText3,
 						expr: &ruleRefExpr{
-							pos:  position{line: 846, col: 5, offset: 20087},
+							pos:  position{line: 888, col: 5, offset: 21137},
 							name: "KSUID",
 						},
 					},
@@ -5197,11 +5513,11 @@ var g = &grammar{
 		},
 		{
 			name: "KSUID",
-			pos:  position{line: 848, col: 1, offset: 20165},
+			pos:  position{line: 890, col: 1, offset: 21215},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 848, col: 9, offset: 20173},
+				pos: position{line: 890, col: 9, offset: 21223},
 				expr: &charClassMatcher{
-					pos:        position{line: 848, col: 9, offset: 20173},
+					pos:        position{line: 890, col: 9, offset: 21223},
 					val:        "[0-9a-zA-Z]",
 					ranges:     []rune{'0', '9', 'a', 'z', 'A', 'Z'},
 					ignoreCase: false,
@@ -5213,40 +5529,40 @@ var g = &grammar{
 		},
 		{
 			name: "OpArg",
-			pos:  position{line: 850, col: 1, offset: 20187},
+			pos:  position{line: 892, col: 1, offset: 21237},
 			expr: &choiceExpr{
-				pos: position{line: 851, col: 5, offset: 20197},
+				pos: position{line: 893, col: 5, offset: 21247},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 851, col: 5, offset: 20197},
+						pos: position{line: 893, col: 5, offset: 21247},
 						run: (*parser).callonOpArg2,
 						expr: &seqExpr{
-							pos: position{line: 851, col: 5, offset: 20197},
+							pos: position{line: 893, col: 5, offset: 21247},
 							exprs: []any{
 								&andExpr{
-									pos: position{line: 851, col: 5, offset: 20197},
+									pos: position{line: 893, col: 5, offset: 21247},
 									expr: &ruleRefExpr{
-										pos:  position{line: 851, col: 6, offset: 20198},
+										pos:  position{line: 893, col: 6, offset: 21248},
 										name: "ArgNameExpr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 851, col: 18, offset: 20210},
+									pos:   position{line: 893, col: 18, offset: 21260},
 									label: "key",
 									expr: &ruleRefExpr{
-										pos:  position{line: 851, col: 22, offset: 20214},
+										pos:  position{line: 893, col: 22, offset: 21264},
 										name: "ArgName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 851, col: 30, offset: 20222},
+									pos:  position{line: 893, col: 30, offset: 21272},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 851, col: 32, offset: 20224},
+									pos:   position{line: 893, col: 32, offset: 21274},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 851, col: 34, offset: 20226},
+										pos:  position{line: 893, col: 34, offset: 21276},
 										name: "Expr",
 									},
 								},
@@ -5254,28 +5570,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 852, col: 5, offset: 20333},
+						pos: position{line: 894, col: 5, offset: 21383},
 						run: (*parser).callonOpArg11,
 						expr: &seqExpr{
-							pos: position{line: 852, col: 5, offset: 20333},
+							pos: position{line: 894, col: 5, offset: 21383},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 852, col: 5, offset: 20333},
+									pos:   position{line: 894, col: 5, offset: 21383},
 									label: "key",
 									expr: &ruleRefExpr{
-										pos:  position{line: 852, col: 9, offset: 20337},
+										pos:  position{line: 894, col: 9, offset: 21387},
 										name: "ArgName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 852, col: 17, offset: 20345},
+									pos:  position{line: 894, col: 17, offset: 21395},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 852, col: 19, offset: 20347},
+									pos:   position{line: 894, col: 19, offset: 21397},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 852, col: 21, offset: 20349},
+										pos:  position{line: 894, col: 21, offset: 21399},
 										name: "Text",
 									},
 								},
@@ -5289,51 +5605,51 @@ var g = &grammar{
 		},
 		{
 			name: "OpArgs",
-			pos:  position{line: 854, col: 1, offset: 20454},
+			pos:  position{line: 896, col: 1, offset: 21504},
 			expr: &actionExpr{
-				pos: position{line: 855, col: 5, offset: 20465},
+				pos: position{line: 897, col: 5, offset: 21515},
 				run: (*parser).callonOpArgs1,
 				expr: &seqExpr{
-					pos: position{line: 855, col: 5, offset: 20465},
+					pos: position{line: 897, col: 5, offset: 21515},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 855, col: 5, offset: 20465},
+							pos:        position{line: 897, col: 5, offset: 21515},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 855, col: 9, offset: 20469},
+							pos:  position{line: 897, col: 9, offset: 21519},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 855, col: 12, offset: 20472},
+							pos:   position{line: 897, col: 12, offset: 21522},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 855, col: 18, offset: 20478},
+								pos:  position{line: 897, col: 18, offset: 21528},
 								name: "OpArg",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 855, col: 24, offset: 20484},
+							pos:   position{line: 897, col: 24, offset: 21534},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 855, col: 29, offset: 20489},
+								pos: position{line: 897, col: 29, offset: 21539},
 								expr: &actionExpr{
-									pos: position{line: 855, col: 30, offset: 20490},
+									pos: position{line: 897, col: 30, offset: 21540},
 									run: (*parser).callonOpArgs9,
 									expr: &seqExpr{
-										pos: position{line: 855, col: 30, offset: 20490},
+										pos: position{line: 897, col: 30, offset: 21540},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 855, col: 30, offset: 20490},
+												pos:  position{line: 897, col: 30, offset: 21540},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 855, col: 32, offset: 20492},
+												pos:   position{line: 897, col: 32, offset: 21542},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 855, col: 34, offset: 20494},
+													pos:  position{line: 897, col: 34, offset: 21544},
 													name: "OpArg",
 												},
 											},
@@ -5343,11 +5659,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 855, col: 60, offset: 20520},
+							pos:  position{line: 897, col: 60, offset: 21570},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 855, col: 63, offset: 20523},
+							pos:        position{line: 897, col: 63, offset: 21573},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5360,14 +5676,14 @@ var g = &grammar{
 		},
 		{
 			name: "ArgName",
-			pos:  position{line: 859, col: 1, offset: 20575},
+			pos:  position{line: 901, col: 1, offset: 21625},
 			expr: &actionExpr{
-				pos: position{line: 859, col: 11, offset: 20585},
+				pos: position{line: 901, col: 11, offset: 21635},
 				run: (*parser).callonArgName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 859, col: 11, offset: 20585},
+					pos: position{line: 901, col: 11, offset: 21635},
 					expr: &ruleRefExpr{
-						pos:  position{line: 859, col: 11, offset: 20585},
+						pos:  position{line: 901, col: 11, offset: 21635},
 						name: "UnicodeLetter",
 					},
 				},
@@ -5377,20 +5693,20 @@ var g = &grammar{
 		},
 		{
 			name: "ArgNameExpr",
-			pos:  position{line: 861, col: 1, offset: 20632},
+			pos:  position{line: 903, col: 1, offset: 21682},
 			expr: &seqExpr{
-				pos: position{line: 862, col: 5, offset: 20648},
+				pos: position{line: 904, col: 5, offset: 21698},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 862, col: 5, offset: 20648},
+						pos:        position{line: 904, col: 5, offset: 21698},
 						val:        "headers",
 						ignoreCase: true,
 						want:       "\"headers\"i",
 					},
 					&notExpr{
-						pos: position{line: 862, col: 16, offset: 20659},
+						pos: position{line: 904, col: 16, offset: 21709},
 						expr: &ruleRefExpr{
-							pos:  position{line: 862, col: 17, offset: 20660},
+							pos:  position{line: 904, col: 17, offset: 21710},
 							name: "UnicodeLetter",
 						},
 					},
@@ -5401,24 +5717,24 @@ var g = &grammar{
 		},
 		{
 			name: "ColonName",
-			pos:  position{line: 864, col: 1, offset: 20675},
+			pos:  position{line: 906, col: 1, offset: 21725},
 			expr: &actionExpr{
-				pos: position{line: 865, col: 5, offset: 20689},
+				pos: position{line: 907, col: 5, offset: 21739},
 				run: (*parser).callonColonName1,
 				expr: &seqExpr{
-					pos: position{line: 865, col: 5, offset: 20689},
+					pos: position{line: 907, col: 5, offset: 21739},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 865, col: 5, offset: 20689},
+							pos:        position{line: 907, col: 5, offset: 21739},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 865, col: 9, offset: 20693},
+							pos:   position{line: 907, col: 9, offset: 21743},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 865, col: 11, offset: 20695},
+								pos:  position{line: 907, col: 11, offset: 21745},
 								name: "Name",
 							},
 						},
@@ -5430,21 +5746,21 @@ var g = &grammar{
 		},
 		{
 			name: "PassOp",
-			pos:  position{line: 867, col: 1, offset: 20719},
+			pos:  position{line: 909, col: 1, offset: 21769},
 			expr: &actionExpr{
-				pos: position{line: 868, col: 5, offset: 20730},
+				pos: position{line: 910, col: 5, offset: 21780},
 				run: (*parser).callonPassOp1,
 				expr: &seqExpr{
-					pos: position{line: 868, col: 5, offset: 20730},
+					pos: position{line: 910, col: 5, offset: 21780},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 868, col: 5, offset: 20730},
+							pos:  position{line: 910, col: 5, offset: 21780},
 							name: "PASS",
 						},
 						&andExpr{
-							pos: position{line: 868, col: 10, offset: 20735},
+							pos: position{line: 910, col: 10, offset: 21785},
 							expr: &ruleRefExpr{
-								pos:  position{line: 868, col: 11, offset: 20736},
+								pos:  position{line: 910, col: 11, offset: 21786},
 								name: "EndOfOp",
 							},
 						},
@@ -5456,26 +5772,26 @@ var g = &grammar{
 		},
 		{
 			name: "MergeOp",
-			pos:  position{line: 872, col: 1, offset: 20812},
+			pos:  position{line: 914, col: 1, offset: 21862},
 			expr: &actionExpr{
-				pos: position{line: 873, col: 5, offset: 20824},
+				pos: position{line: 915, col: 5, offset: 21874},
 				run: (*parser).callonMergeOp1,
 				expr: &seqExpr{
-					pos: position{line: 873, col: 5, offset: 20824},
+					pos: position{line: 915, col: 5, offset: 21874},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 873, col: 5, offset: 20824},
+							pos:  position{line: 915, col: 5, offset: 21874},
 							name: "MERGE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 873, col: 11, offset: 20830},
+							pos:  position{line: 915, col: 11, offset: 21880},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 873, col: 13, offset: 20832},
+							pos:   position{line: 915, col: 13, offset: 21882},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 873, col: 19, offset: 20838},
+								pos:  position{line: 915, col: 19, offset: 21888},
 								name: "OrderByList",
 							},
 						},
@@ -5487,59 +5803,59 @@ var g = &grammar{
 		},
 		{
 			name: "UnnestOp",
-			pos:  position{line: 881, col: 1, offset: 20984},
+			pos:  position{line: 923, col: 1, offset: 22034},
 			expr: &actionExpr{
-				pos: position{line: 882, col: 6, offset: 20998},
+				pos: position{line: 924, col: 6, offset: 22048},
 				run: (*parser).callonUnnestOp1,
 				expr: &seqExpr{
-					pos: position{line: 882, col: 6, offset: 20998},
+					pos: position{line: 924, col: 6, offset: 22048},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 882, col: 6, offset: 20998},
+							pos:  position{line: 924, col: 6, offset: 22048},
 							name: "UNNEST",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 882, col: 13, offset: 21005},
+							pos:  position{line: 924, col: 13, offset: 22055},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 882, col: 15, offset: 21007},
+							pos:   position{line: 924, col: 15, offset: 22057},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 882, col: 17, offset: 21009},
+								pos:  position{line: 924, col: 17, offset: 22059},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 882, col: 22, offset: 21014},
+							pos:   position{line: 924, col: 22, offset: 22064},
 							label: "body",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 882, col: 27, offset: 21019},
+								pos: position{line: 924, col: 27, offset: 22069},
 								expr: &actionExpr{
-									pos: position{line: 882, col: 28, offset: 21020},
+									pos: position{line: 924, col: 28, offset: 22070},
 									run: (*parser).callonUnnestOp9,
 									expr: &seqExpr{
-										pos: position{line: 882, col: 28, offset: 21020},
+										pos: position{line: 924, col: 28, offset: 22070},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 882, col: 28, offset: 21020},
+												pos:  position{line: 924, col: 28, offset: 22070},
 												name: "_",
 											},
 											&litMatcher{
-												pos:        position{line: 882, col: 30, offset: 21022},
+												pos:        position{line: 924, col: 30, offset: 22072},
 												val:        "into",
 												ignoreCase: true,
 												want:       "\"into\"i",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 882, col: 38, offset: 21030},
+												pos:  position{line: 924, col: 38, offset: 22080},
 												name: "_",
 											},
 											&labeledExpr{
-												pos:   position{line: 882, col: 40, offset: 21032},
+												pos:   position{line: 924, col: 40, offset: 22082},
 												label: "body",
 												expr: &ruleRefExpr{
-													pos:  position{line: 882, col: 45, offset: 21037},
+													pos:  position{line: 924, col: 45, offset: 22087},
 													name: "ScopeBody",
 												},
 											},
@@ -5556,30 +5872,30 @@ var g = &grammar{
 		},
 		{
 			name: "AsArg",
-			pos:  position{line: 894, col: 1, offset: 21278},
+			pos:  position{line: 936, col: 1, offset: 22328},
 			expr: &actionExpr{
-				pos: position{line: 895, col: 5, offset: 21288},
+				pos: position{line: 937, col: 5, offset: 22338},
 				run: (*parser).callonAsArg1,
 				expr: &seqExpr{
-					pos: position{line: 895, col: 5, offset: 21288},
+					pos: position{line: 937, col: 5, offset: 22338},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 895, col: 5, offset: 21288},
+							pos:  position{line: 937, col: 5, offset: 22338},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 895, col: 7, offset: 21290},
+							pos:  position{line: 937, col: 7, offset: 22340},
 							name: "AS",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 895, col: 10, offset: 21293},
+							pos:  position{line: 937, col: 10, offset: 22343},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 895, col: 12, offset: 21295},
+							pos:   position{line: 937, col: 12, offset: 22345},
 							label: "lhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 895, col: 16, offset: 21299},
+								pos:  position{line: 937, col: 16, offset: 22349},
 								name: "Lval",
 							},
 						},
@@ -5591,9 +5907,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lval",
-			pos:  position{line: 899, col: 1, offset: 21350},
+			pos:  position{line: 941, col: 1, offset: 22400},
 			expr: &ruleRefExpr{
-				pos:  position{line: 899, col: 8, offset: 21357},
+				pos:  position{line: 941, col: 8, offset: 22407},
 				name: "DerefExpr",
 			},
 			leader:        false,
@@ -5601,51 +5917,51 @@ var g = &grammar{
 		},
 		{
 			name: "Lvals",
-			pos:  position{line: 901, col: 1, offset: 21368},
+			pos:  position{line: 943, col: 1, offset: 22418},
 			expr: &actionExpr{
-				pos: position{line: 902, col: 5, offset: 21378},
+				pos: position{line: 944, col: 5, offset: 22428},
 				run: (*parser).callonLvals1,
 				expr: &seqExpr{
-					pos: position{line: 902, col: 5, offset: 21378},
+					pos: position{line: 944, col: 5, offset: 22428},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 902, col: 5, offset: 21378},
+							pos:   position{line: 944, col: 5, offset: 22428},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 902, col: 11, offset: 21384},
+								pos:  position{line: 944, col: 11, offset: 22434},
 								name: "Lval",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 902, col: 16, offset: 21389},
+							pos:   position{line: 944, col: 16, offset: 22439},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 902, col: 21, offset: 21394},
+								pos: position{line: 944, col: 21, offset: 22444},
 								expr: &actionExpr{
-									pos: position{line: 902, col: 22, offset: 21395},
+									pos: position{line: 944, col: 22, offset: 22445},
 									run: (*parser).callonLvals7,
 									expr: &seqExpr{
-										pos: position{line: 902, col: 22, offset: 21395},
+										pos: position{line: 944, col: 22, offset: 22445},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 902, col: 22, offset: 21395},
+												pos:  position{line: 944, col: 22, offset: 22445},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 902, col: 25, offset: 21398},
+												pos:        position{line: 944, col: 25, offset: 22448},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 902, col: 29, offset: 21402},
+												pos:  position{line: 944, col: 29, offset: 22452},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 902, col: 32, offset: 21405},
+												pos:   position{line: 944, col: 32, offset: 22455},
 												label: "lval",
 												expr: &ruleRefExpr{
-													pos:  position{line: 902, col: 37, offset: 21410},
+													pos:  position{line: 944, col: 37, offset: 22460},
 													name: "Lval",
 												},
 											},
@@ -5662,51 +5978,51 @@ var g = &grammar{
 		},
 		{
 			name: "Assignments",
-			pos:  position{line: 906, col: 1, offset: 21486},
+			pos:  position{line: 948, col: 1, offset: 22536},
 			expr: &actionExpr{
-				pos: position{line: 907, col: 5, offset: 21502},
+				pos: position{line: 949, col: 5, offset: 22552},
 				run: (*parser).callonAssignments1,
 				expr: &seqExpr{
-					pos: position{line: 907, col: 5, offset: 21502},
+					pos: position{line: 949, col: 5, offset: 22552},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 907, col: 5, offset: 21502},
+							pos:   position{line: 949, col: 5, offset: 22552},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 907, col: 11, offset: 21508},
+								pos:  position{line: 949, col: 11, offset: 22558},
 								name: "Assignment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 907, col: 22, offset: 21519},
+							pos:   position{line: 949, col: 22, offset: 22569},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 907, col: 27, offset: 21524},
+								pos: position{line: 949, col: 27, offset: 22574},
 								expr: &actionExpr{
-									pos: position{line: 907, col: 28, offset: 21525},
+									pos: position{line: 949, col: 28, offset: 22575},
 									run: (*parser).callonAssignments7,
 									expr: &seqExpr{
-										pos: position{line: 907, col: 28, offset: 21525},
+										pos: position{line: 949, col: 28, offset: 22575},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 907, col: 28, offset: 21525},
+												pos:  position{line: 949, col: 28, offset: 22575},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 907, col: 31, offset: 21528},
+												pos:        position{line: 949, col: 31, offset: 22578},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 907, col: 35, offset: 21532},
+												pos:  position{line: 949, col: 35, offset: 22582},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 907, col: 38, offset: 21535},
+												pos:   position{line: 949, col: 38, offset: 22585},
 												label: "a",
 												expr: &ruleRefExpr{
-													pos:  position{line: 907, col: 40, offset: 21537},
+													pos:  position{line: 949, col: 40, offset: 22587},
 													name: "Assignment",
 												},
 											},
@@ -5723,38 +6039,38 @@ var g = &grammar{
 		},
 		{
 			name: "Assignment",
-			pos:  position{line: 911, col: 1, offset: 21612},
+			pos:  position{line: 953, col: 1, offset: 22662},
 			expr: &actionExpr{
-				pos: position{line: 912, col: 5, offset: 21627},
+				pos: position{line: 954, col: 5, offset: 22677},
 				run: (*parser).callonAssignment1,
 				expr: &seqExpr{
-					pos: position{line: 912, col: 5, offset: 21627},
+					pos: position{line: 954, col: 5, offset: 22677},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 912, col: 5, offset: 21627},
+							pos:   position{line: 954, col: 5, offset: 22677},
 							label: "lhs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 912, col: 9, offset: 21631},
+								pos: position{line: 954, col: 9, offset: 22681},
 								expr: &actionExpr{
-									pos: position{line: 912, col: 10, offset: 21632},
+									pos: position{line: 954, col: 10, offset: 22682},
 									run: (*parser).callonAssignment5,
 									expr: &seqExpr{
-										pos: position{line: 912, col: 10, offset: 21632},
+										pos: position{line: 954, col: 10, offset: 22682},
 										exprs: []any{
 											&labeledExpr{
-												pos:   position{line: 912, col: 10, offset: 21632},
+												pos:   position{line: 954, col: 10, offset: 22682},
 												label: "lval",
 												expr: &ruleRefExpr{
-													pos:  position{line: 912, col: 15, offset: 21637},
+													pos:  position{line: 954, col: 15, offset: 22687},
 													name: "Lval",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 912, col: 20, offset: 21642},
+												pos:  position{line: 954, col: 20, offset: 22692},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 912, col: 23, offset: 21645},
+												pos:        position{line: 954, col: 23, offset: 22695},
 												val:        ":=",
 												ignoreCase: false,
 												want:       "\":=\"",
@@ -5765,14 +6081,14 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 912, col: 51, offset: 21673},
+							pos:  position{line: 954, col: 51, offset: 22723},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 912, col: 54, offset: 21676},
+							pos:   position{line: 954, col: 54, offset: 22726},
 							label: "rhs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 912, col: 58, offset: 21680},
+								pos:  position{line: 954, col: 58, offset: 22730},
 								name: "Expr",
 							},
 						},
@@ -5784,9 +6100,9 @@ var g = &grammar{
 		},
 		{
 			name: "Expr",
-			pos:  position{line: 923, col: 1, offset: 21864},
+			pos:  position{line: 965, col: 1, offset: 22914},
 			expr: &ruleRefExpr{
-				pos:  position{line: 923, col: 8, offset: 21871},
+				pos:  position{line: 965, col: 8, offset: 22921},
 				name: "CondExpr",
 			},
 			leader:        false,
@@ -5794,63 +6110,63 @@ var g = &grammar{
 		},
 		{
 			name: "CondExpr",
-			pos:  position{line: 925, col: 1, offset: 21881},
+			pos:  position{line: 967, col: 1, offset: 22931},
 			expr: &actionExpr{
-				pos: position{line: 926, col: 5, offset: 21894},
+				pos: position{line: 968, col: 5, offset: 22944},
 				run: (*parser).callonCondExpr1,
 				expr: &seqExpr{
-					pos: position{line: 926, col: 5, offset: 21894},
+					pos: position{line: 968, col: 5, offset: 22944},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 926, col: 5, offset: 21894},
+							pos:   position{line: 968, col: 5, offset: 22944},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 926, col: 10, offset: 21899},
+								pos:  position{line: 968, col: 10, offset: 22949},
 								name: "LogicalOrExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 926, col: 24, offset: 21913},
+							pos:   position{line: 968, col: 24, offset: 22963},
 							label: "opt",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 926, col: 28, offset: 21917},
+								pos: position{line: 968, col: 28, offset: 22967},
 								expr: &seqExpr{
-									pos: position{line: 926, col: 29, offset: 21918},
+									pos: position{line: 968, col: 29, offset: 22968},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 926, col: 29, offset: 21918},
+											pos:  position{line: 968, col: 29, offset: 22968},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 926, col: 32, offset: 21921},
+											pos:        position{line: 968, col: 32, offset: 22971},
 											val:        "?",
 											ignoreCase: false,
 											want:       "\"?\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 926, col: 36, offset: 21925},
+											pos:  position{line: 968, col: 36, offset: 22975},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 926, col: 39, offset: 21928},
+											pos:  position{line: 968, col: 39, offset: 22978},
 											name: "Expr",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 926, col: 44, offset: 21933},
+											pos:  position{line: 968, col: 44, offset: 22983},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 926, col: 47, offset: 21936},
+											pos:        position{line: 968, col: 47, offset: 22986},
 											val:        ":",
 											ignoreCase: false,
 											want:       "\":\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 926, col: 51, offset: 21940},
+											pos:  position{line: 968, col: 51, offset: 22990},
 											name: "__",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 926, col: 54, offset: 21943},
+											pos:  position{line: 968, col: 54, offset: 22993},
 											name: "Expr",
 										},
 									},
@@ -5865,53 +6181,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalOrExpr",
-			pos:  position{line: 940, col: 1, offset: 22258},
+			pos:  position{line: 982, col: 1, offset: 23308},
 			expr: &actionExpr{
-				pos: position{line: 941, col: 5, offset: 22276},
+				pos: position{line: 983, col: 5, offset: 23326},
 				run: (*parser).callonLogicalOrExpr1,
 				expr: &seqExpr{
-					pos: position{line: 941, col: 5, offset: 22276},
+					pos: position{line: 983, col: 5, offset: 23326},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 941, col: 5, offset: 22276},
+							pos:   position{line: 983, col: 5, offset: 23326},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 941, col: 11, offset: 22282},
+								pos:  position{line: 983, col: 11, offset: 23332},
 								name: "LogicalAndExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 942, col: 5, offset: 22301},
+							pos:   position{line: 984, col: 5, offset: 23351},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 942, col: 10, offset: 22306},
+								pos: position{line: 984, col: 10, offset: 23356},
 								expr: &actionExpr{
-									pos: position{line: 942, col: 11, offset: 22307},
+									pos: position{line: 984, col: 11, offset: 23357},
 									run: (*parser).callonLogicalOrExpr7,
 									expr: &seqExpr{
-										pos: position{line: 942, col: 11, offset: 22307},
+										pos: position{line: 984, col: 11, offset: 23357},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 942, col: 11, offset: 22307},
+												pos:  position{line: 984, col: 11, offset: 23357},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 942, col: 14, offset: 22310},
+												pos:   position{line: 984, col: 14, offset: 23360},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 942, col: 17, offset: 22313},
+													pos:  position{line: 984, col: 17, offset: 23363},
 													name: "OR",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 942, col: 20, offset: 22316},
+												pos:  position{line: 984, col: 20, offset: 23366},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 942, col: 23, offset: 22319},
+												pos:   position{line: 984, col: 23, offset: 23369},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 942, col: 28, offset: 22324},
+													pos:  position{line: 984, col: 28, offset: 23374},
 													name: "LogicalAndExpr",
 												},
 											},
@@ -5928,53 +6244,53 @@ var g = &grammar{
 		},
 		{
 			name: "LogicalAndExpr",
-			pos:  position{line: 946, col: 1, offset: 22438},
+			pos:  position{line: 988, col: 1, offset: 23488},
 			expr: &actionExpr{
-				pos: position{line: 947, col: 5, offset: 22457},
+				pos: position{line: 989, col: 5, offset: 23507},
 				run: (*parser).callonLogicalAndExpr1,
 				expr: &seqExpr{
-					pos: position{line: 947, col: 5, offset: 22457},
+					pos: position{line: 989, col: 5, offset: 23507},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 947, col: 5, offset: 22457},
+							pos:   position{line: 989, col: 5, offset: 23507},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 947, col: 11, offset: 22463},
+								pos:  position{line: 989, col: 11, offset: 23513},
 								name: "NotExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 948, col: 5, offset: 22475},
+							pos:   position{line: 990, col: 5, offset: 23525},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 948, col: 10, offset: 22480},
+								pos: position{line: 990, col: 10, offset: 23530},
 								expr: &actionExpr{
-									pos: position{line: 948, col: 11, offset: 22481},
+									pos: position{line: 990, col: 11, offset: 23531},
 									run: (*parser).callonLogicalAndExpr7,
 									expr: &seqExpr{
-										pos: position{line: 948, col: 11, offset: 22481},
+										pos: position{line: 990, col: 11, offset: 23531},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 948, col: 11, offset: 22481},
+												pos:  position{line: 990, col: 11, offset: 23531},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 948, col: 14, offset: 22484},
+												pos:   position{line: 990, col: 14, offset: 23534},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 948, col: 17, offset: 22487},
+													pos:  position{line: 990, col: 17, offset: 23537},
 													name: "AND",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 948, col: 21, offset: 22491},
+												pos:  position{line: 990, col: 21, offset: 23541},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 948, col: 24, offset: 22494},
+												pos:   position{line: 990, col: 24, offset: 23544},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 948, col: 29, offset: 22499},
+													pos:  position{line: 990, col: 29, offset: 23549},
 													name: "NotExpr",
 												},
 											},
@@ -5991,43 +6307,43 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpr",
-			pos:  position{line: 952, col: 1, offset: 22606},
+			pos:  position{line: 994, col: 1, offset: 23656},
 			expr: &choiceExpr{
-				pos: position{line: 953, col: 5, offset: 22618},
+				pos: position{line: 995, col: 5, offset: 23668},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 953, col: 5, offset: 22618},
+						pos: position{line: 995, col: 5, offset: 23668},
 						run: (*parser).callonNotExpr2,
 						expr: &seqExpr{
-							pos: position{line: 953, col: 5, offset: 22618},
+							pos: position{line: 995, col: 5, offset: 23668},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 953, col: 6, offset: 22619},
+									pos: position{line: 995, col: 6, offset: 23669},
 									alternatives: []any{
 										&seqExpr{
-											pos: position{line: 953, col: 6, offset: 22619},
+											pos: position{line: 995, col: 6, offset: 23669},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 953, col: 6, offset: 22619},
+													pos:  position{line: 995, col: 6, offset: 23669},
 													name: "NOT",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 953, col: 10, offset: 22623},
+													pos:  position{line: 995, col: 10, offset: 23673},
 													name: "__",
 												},
 											},
 										},
 										&seqExpr{
-											pos: position{line: 953, col: 15, offset: 22628},
+											pos: position{line: 995, col: 15, offset: 23678},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 953, col: 15, offset: 22628},
+													pos:        position{line: 995, col: 15, offset: 23678},
 													val:        "!",
 													ignoreCase: false,
 													want:       "\"!\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 953, col: 19, offset: 22632},
+													pos:  position{line: 995, col: 19, offset: 23682},
 													name: "__",
 												},
 											},
@@ -6035,10 +6351,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 953, col: 23, offset: 22636},
+									pos:   position{line: 995, col: 23, offset: 23686},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 953, col: 25, offset: 22638},
+										pos:  position{line: 995, col: 25, offset: 23688},
 										name: "NotExpr",
 									},
 								},
@@ -6046,7 +6362,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 961, col: 5, offset: 22804},
+						pos:  position{line: 1003, col: 5, offset: 23854},
 						name: "BetweenExpr",
 					},
 				},
@@ -6056,42 +6372,42 @@ var g = &grammar{
 		},
 		{
 			name: "BetweenExpr",
-			pos:  position{line: 963, col: 1, offset: 22817},
+			pos:  position{line: 1005, col: 1, offset: 23867},
 			expr: &choiceExpr{
-				pos: position{line: 964, col: 5, offset: 22833},
+				pos: position{line: 1006, col: 5, offset: 23883},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 964, col: 5, offset: 22833},
+						pos: position{line: 1006, col: 5, offset: 23883},
 						run: (*parser).callonBetweenExpr2,
 						expr: &seqExpr{
-							pos: position{line: 964, col: 5, offset: 22833},
+							pos: position{line: 1006, col: 5, offset: 23883},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 964, col: 5, offset: 22833},
+									pos:   position{line: 1006, col: 5, offset: 23883},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 964, col: 10, offset: 22838},
+										pos:  position{line: 1006, col: 10, offset: 23888},
 										name: "ComparisonExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 964, col: 25, offset: 22853},
+									pos:  position{line: 1006, col: 25, offset: 23903},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 964, col: 27, offset: 22855},
+									pos:   position{line: 1006, col: 27, offset: 23905},
 									label: "not",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 964, col: 31, offset: 22859},
+										pos: position{line: 1006, col: 31, offset: 23909},
 										expr: &seqExpr{
-											pos: position{line: 964, col: 32, offset: 22860},
+											pos: position{line: 1006, col: 32, offset: 23910},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 964, col: 32, offset: 22860},
+													pos:  position{line: 1006, col: 32, offset: 23910},
 													name: "NOT",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 964, col: 36, offset: 22864},
+													pos:  position{line: 1006, col: 36, offset: 23914},
 													name: "_",
 												},
 											},
@@ -6099,38 +6415,38 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 964, col: 40, offset: 22868},
+									pos:  position{line: 1006, col: 40, offset: 23918},
 									name: "BETWEEN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 964, col: 48, offset: 22876},
+									pos:  position{line: 1006, col: 48, offset: 23926},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 964, col: 50, offset: 22878},
+									pos:   position{line: 1006, col: 50, offset: 23928},
 									label: "lower",
 									expr: &ruleRefExpr{
-										pos:  position{line: 964, col: 56, offset: 22884},
+										pos:  position{line: 1006, col: 56, offset: 23934},
 										name: "BetweenExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 964, col: 68, offset: 22896},
+									pos:  position{line: 1006, col: 68, offset: 23946},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 964, col: 70, offset: 22898},
+									pos:  position{line: 1006, col: 70, offset: 23948},
 									name: "AND",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 964, col: 74, offset: 22902},
+									pos:  position{line: 1006, col: 74, offset: 23952},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 964, col: 76, offset: 22904},
+									pos:   position{line: 1006, col: 76, offset: 23954},
 									label: "upper",
 									expr: &ruleRefExpr{
-										pos:  position{line: 964, col: 82, offset: 22910},
+										pos:  position{line: 1006, col: 82, offset: 23960},
 										name: "BetweenExpr",
 									},
 								},
@@ -6138,7 +6454,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 974, col: 5, offset: 23150},
+						pos:  position{line: 1016, col: 5, offset: 24200},
 						name: "ComparisonExpr",
 					},
 				},
@@ -6148,46 +6464,46 @@ var g = &grammar{
 		},
 		{
 			name: "ComparisonExpr",
-			pos:  position{line: 976, col: 1, offset: 23166},
+			pos:  position{line: 1018, col: 1, offset: 24216},
 			expr: &choiceExpr{
-				pos: position{line: 977, col: 5, offset: 23185},
+				pos: position{line: 1019, col: 5, offset: 24235},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 977, col: 5, offset: 23185},
+						pos: position{line: 1019, col: 5, offset: 24235},
 						run: (*parser).callonComparisonExpr2,
 						expr: &seqExpr{
-							pos: position{line: 977, col: 5, offset: 23185},
+							pos: position{line: 1019, col: 5, offset: 24235},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 977, col: 5, offset: 23185},
+									pos:   position{line: 1019, col: 5, offset: 24235},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 977, col: 10, offset: 23190},
+										pos:  position{line: 1019, col: 10, offset: 24240},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 977, col: 23, offset: 23203},
+									pos:  position{line: 1019, col: 23, offset: 24253},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 977, col: 25, offset: 23205},
+									pos:  position{line: 1019, col: 25, offset: 24255},
 									name: "IS",
 								},
 								&labeledExpr{
-									pos:   position{line: 977, col: 28, offset: 23208},
+									pos:   position{line: 1019, col: 28, offset: 24258},
 									label: "not",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 977, col: 32, offset: 23212},
+										pos: position{line: 1019, col: 32, offset: 24262},
 										expr: &seqExpr{
-											pos: position{line: 977, col: 33, offset: 23213},
+											pos: position{line: 1019, col: 33, offset: 24263},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 977, col: 33, offset: 23213},
+													pos:  position{line: 1019, col: 33, offset: 24263},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 977, col: 35, offset: 23215},
+													pos:  position{line: 1019, col: 35, offset: 24265},
 													name: "NOT",
 												},
 											},
@@ -6195,82 +6511,82 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 977, col: 41, offset: 23221},
+									pos:  position{line: 1019, col: 41, offset: 24271},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 977, col: 43, offset: 23223},
+									pos:  position{line: 1019, col: 43, offset: 24273},
 									name: "NULL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 985, col: 5, offset: 23388},
+						pos: position{line: 1027, col: 5, offset: 24438},
 						run: (*parser).callonComparisonExpr15,
 						expr: &seqExpr{
-							pos: position{line: 985, col: 5, offset: 23388},
+							pos: position{line: 1027, col: 5, offset: 24438},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 985, col: 5, offset: 23388},
+									pos:   position{line: 1027, col: 5, offset: 24438},
 									label: "lhs",
 									expr: &ruleRefExpr{
-										pos:  position{line: 985, col: 9, offset: 23392},
+										pos:  position{line: 1027, col: 9, offset: 24442},
 										name: "AdditiveExpr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 985, col: 22, offset: 23405},
+									pos:   position{line: 1027, col: 22, offset: 24455},
 									label: "opAndRHS",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 985, col: 31, offset: 23414},
+										pos: position{line: 1027, col: 31, offset: 24464},
 										expr: &choiceExpr{
-											pos: position{line: 985, col: 32, offset: 23415},
+											pos: position{line: 1027, col: 32, offset: 24465},
 											alternatives: []any{
 												&seqExpr{
-													pos: position{line: 985, col: 32, offset: 23415},
+													pos: position{line: 1027, col: 32, offset: 24465},
 													exprs: []any{
 														&ruleRefExpr{
-															pos:  position{line: 985, col: 32, offset: 23415},
+															pos:  position{line: 1027, col: 32, offset: 24465},
 															name: "__",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 985, col: 35, offset: 23418},
+															pos:  position{line: 1027, col: 35, offset: 24468},
 															name: "Comparator",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 985, col: 46, offset: 23429},
+															pos:  position{line: 1027, col: 46, offset: 24479},
 															name: "__",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 985, col: 49, offset: 23432},
+															pos:  position{line: 1027, col: 49, offset: 24482},
 															name: "AdditiveExpr",
 														},
 													},
 												},
 												&seqExpr{
-													pos: position{line: 985, col: 64, offset: 23447},
+													pos: position{line: 1027, col: 64, offset: 24497},
 													exprs: []any{
 														&ruleRefExpr{
-															pos:  position{line: 985, col: 64, offset: 23447},
+															pos:  position{line: 1027, col: 64, offset: 24497},
 															name: "__",
 														},
 														&actionExpr{
-															pos: position{line: 985, col: 68, offset: 23451},
+															pos: position{line: 1027, col: 68, offset: 24501},
 															run: (*parser).callonComparisonExpr29,
 															expr: &litMatcher{
-																pos:        position{line: 985, col: 68, offset: 23451},
+																pos:        position{line: 1027, col: 68, offset: 24501},
 																val:        "~",
 																ignoreCase: false,
 																want:       "\"~\"",
 															},
 														},
 														&ruleRefExpr{
-															pos:  position{line: 985, col: 104, offset: 23487},
+															pos:  position{line: 1027, col: 104, offset: 24537},
 															name: "__",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 985, col: 107, offset: 23490},
+															pos:  position{line: 1027, col: 107, offset: 24540},
 															name: "AdditiveExpr",
 														},
 													},
@@ -6289,53 +6605,53 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveExpr",
-			pos:  position{line: 998, col: 1, offset: 23781},
+			pos:  position{line: 1040, col: 1, offset: 24831},
 			expr: &actionExpr{
-				pos: position{line: 999, col: 5, offset: 23798},
+				pos: position{line: 1041, col: 5, offset: 24848},
 				run: (*parser).callonAdditiveExpr1,
 				expr: &seqExpr{
-					pos: position{line: 999, col: 5, offset: 23798},
+					pos: position{line: 1041, col: 5, offset: 24848},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 999, col: 5, offset: 23798},
+							pos:   position{line: 1041, col: 5, offset: 24848},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 999, col: 11, offset: 23804},
+								pos:  position{line: 1041, col: 11, offset: 24854},
 								name: "MultiplicativeExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1000, col: 5, offset: 23827},
+							pos:   position{line: 1042, col: 5, offset: 24877},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1000, col: 10, offset: 23832},
+								pos: position{line: 1042, col: 10, offset: 24882},
 								expr: &actionExpr{
-									pos: position{line: 1000, col: 11, offset: 23833},
+									pos: position{line: 1042, col: 11, offset: 24883},
 									run: (*parser).callonAdditiveExpr7,
 									expr: &seqExpr{
-										pos: position{line: 1000, col: 11, offset: 23833},
+										pos: position{line: 1042, col: 11, offset: 24883},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1000, col: 11, offset: 23833},
+												pos:  position{line: 1042, col: 11, offset: 24883},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1000, col: 14, offset: 23836},
+												pos:   position{line: 1042, col: 14, offset: 24886},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1000, col: 17, offset: 23839},
+													pos:  position{line: 1042, col: 17, offset: 24889},
 													name: "AdditiveOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1000, col: 34, offset: 23856},
+												pos:  position{line: 1042, col: 34, offset: 24906},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1000, col: 37, offset: 23859},
+												pos:   position{line: 1042, col: 37, offset: 24909},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1000, col: 42, offset: 23864},
+													pos:  position{line: 1042, col: 42, offset: 24914},
 													name: "MultiplicativeExpr",
 												},
 											},
@@ -6352,21 +6668,21 @@ var g = &grammar{
 		},
 		{
 			name: "AdditiveOperator",
-			pos:  position{line: 1004, col: 1, offset: 23982},
+			pos:  position{line: 1046, col: 1, offset: 25032},
 			expr: &actionExpr{
-				pos: position{line: 1004, col: 20, offset: 24001},
+				pos: position{line: 1046, col: 20, offset: 25051},
 				run: (*parser).callonAdditiveOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 1004, col: 21, offset: 24002},
+					pos: position{line: 1046, col: 21, offset: 25052},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1004, col: 21, offset: 24002},
+							pos:        position{line: 1046, col: 21, offset: 25052},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1004, col: 27, offset: 24008},
+							pos:        position{line: 1046, col: 27, offset: 25058},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
@@ -6379,53 +6695,53 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeExpr",
-			pos:  position{line: 1006, col: 1, offset: 24045},
+			pos:  position{line: 1048, col: 1, offset: 25095},
 			expr: &actionExpr{
-				pos: position{line: 1007, col: 5, offset: 24068},
+				pos: position{line: 1049, col: 5, offset: 25118},
 				run: (*parser).callonMultiplicativeExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1007, col: 5, offset: 24068},
+					pos: position{line: 1049, col: 5, offset: 25118},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1007, col: 5, offset: 24068},
+							pos:   position{line: 1049, col: 5, offset: 25118},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1007, col: 11, offset: 24074},
+								pos:  position{line: 1049, col: 11, offset: 25124},
 								name: "ConcatExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1008, col: 5, offset: 24089},
+							pos:   position{line: 1050, col: 5, offset: 25139},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1008, col: 10, offset: 24094},
+								pos: position{line: 1050, col: 10, offset: 25144},
 								expr: &actionExpr{
-									pos: position{line: 1008, col: 11, offset: 24095},
+									pos: position{line: 1050, col: 11, offset: 25145},
 									run: (*parser).callonMultiplicativeExpr7,
 									expr: &seqExpr{
-										pos: position{line: 1008, col: 11, offset: 24095},
+										pos: position{line: 1050, col: 11, offset: 25145},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1008, col: 11, offset: 24095},
+												pos:  position{line: 1050, col: 11, offset: 25145},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1008, col: 14, offset: 24098},
+												pos:   position{line: 1050, col: 14, offset: 25148},
 												label: "op",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1008, col: 17, offset: 24101},
+													pos:  position{line: 1050, col: 17, offset: 25151},
 													name: "MultiplicativeOperator",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1008, col: 40, offset: 24124},
+												pos:  position{line: 1050, col: 40, offset: 25174},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1008, col: 43, offset: 24127},
+												pos:   position{line: 1050, col: 43, offset: 25177},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1008, col: 48, offset: 24132},
+													pos:  position{line: 1050, col: 48, offset: 25182},
 													name: "ConcatExpr",
 												},
 											},
@@ -6442,27 +6758,27 @@ var g = &grammar{
 		},
 		{
 			name: "MultiplicativeOperator",
-			pos:  position{line: 1012, col: 1, offset: 24242},
+			pos:  position{line: 1054, col: 1, offset: 25292},
 			expr: &actionExpr{
-				pos: position{line: 1012, col: 26, offset: 24267},
+				pos: position{line: 1054, col: 26, offset: 25317},
 				run: (*parser).callonMultiplicativeOperator1,
 				expr: &choiceExpr{
-					pos: position{line: 1012, col: 27, offset: 24268},
+					pos: position{line: 1054, col: 27, offset: 25318},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1012, col: 27, offset: 24268},
+							pos:        position{line: 1054, col: 27, offset: 25318},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1012, col: 33, offset: 24274},
+							pos:        position{line: 1054, col: 33, offset: 25324},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1012, col: 39, offset: 24280},
+							pos:        position{line: 1054, col: 39, offset: 25330},
 							val:        "%",
 							ignoreCase: false,
 							want:       "\"%\"",
@@ -6475,51 +6791,51 @@ var g = &grammar{
 		},
 		{
 			name: "ConcatExpr",
-			pos:  position{line: 1014, col: 1, offset: 24317},
+			pos:  position{line: 1056, col: 1, offset: 25367},
 			expr: &actionExpr{
-				pos: position{line: 1015, col: 5, offset: 24332},
+				pos: position{line: 1057, col: 5, offset: 25382},
 				run: (*parser).callonConcatExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1015, col: 5, offset: 24332},
+					pos: position{line: 1057, col: 5, offset: 25382},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1015, col: 5, offset: 24332},
+							pos:   position{line: 1057, col: 5, offset: 25382},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1015, col: 11, offset: 24338},
+								pos:  position{line: 1057, col: 11, offset: 25388},
 								name: "UnaryPlusOrMinus",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1016, col: 5, offset: 24359},
+							pos:   position{line: 1058, col: 5, offset: 25409},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1016, col: 10, offset: 24364},
+								pos: position{line: 1058, col: 10, offset: 25414},
 								expr: &actionExpr{
-									pos: position{line: 1016, col: 11, offset: 24365},
+									pos: position{line: 1058, col: 11, offset: 25415},
 									run: (*parser).callonConcatExpr7,
 									expr: &seqExpr{
-										pos: position{line: 1016, col: 11, offset: 24365},
+										pos: position{line: 1058, col: 11, offset: 25415},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1016, col: 11, offset: 24365},
+												pos:  position{line: 1058, col: 11, offset: 25415},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1016, col: 14, offset: 24368},
+												pos:        position{line: 1058, col: 14, offset: 25418},
 												val:        "||",
 												ignoreCase: false,
 												want:       "\"||\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1016, col: 19, offset: 24373},
+												pos:  position{line: 1058, col: 19, offset: 25423},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1016, col: 22, offset: 24376},
+												pos:   position{line: 1058, col: 22, offset: 25426},
 												label: "expr",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1016, col: 27, offset: 24381},
+													pos:  position{line: 1058, col: 27, offset: 25431},
 													name: "UnaryPlusOrMinus",
 												},
 											},
@@ -6536,40 +6852,40 @@ var g = &grammar{
 		},
 		{
 			name: "UnaryPlusOrMinus",
-			pos:  position{line: 1020, col: 1, offset: 24499},
+			pos:  position{line: 1062, col: 1, offset: 25549},
 			expr: &choiceExpr{
-				pos: position{line: 1021, col: 5, offset: 24520},
+				pos: position{line: 1063, col: 5, offset: 25570},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1021, col: 5, offset: 24520},
+						pos: position{line: 1063, col: 5, offset: 25570},
 						run: (*parser).callonUnaryPlusOrMinus2,
 						expr: &seqExpr{
-							pos: position{line: 1021, col: 5, offset: 24520},
+							pos: position{line: 1063, col: 5, offset: 25570},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1021, col: 5, offset: 24520},
+									pos: position{line: 1063, col: 5, offset: 25570},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1021, col: 6, offset: 24521},
+										pos:  position{line: 1063, col: 6, offset: 25571},
 										name: "Literal",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1021, col: 14, offset: 24529},
+									pos:   position{line: 1063, col: 14, offset: 25579},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1021, col: 17, offset: 24532},
+										pos:  position{line: 1063, col: 17, offset: 25582},
 										name: "PlusOrMinusOp",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1021, col: 31, offset: 24546},
+									pos:  position{line: 1063, col: 31, offset: 25596},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1021, col: 34, offset: 24549},
+									pos:   position{line: 1063, col: 34, offset: 25599},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1021, col: 36, offset: 24551},
+										pos:  position{line: 1063, col: 36, offset: 25601},
 										name: "UnaryPlusOrMinus",
 									},
 								},
@@ -6577,7 +6893,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1030, col: 5, offset: 24735},
+						pos:  position{line: 1072, col: 5, offset: 25785},
 						name: "ColonCast",
 					},
 				},
@@ -6587,21 +6903,21 @@ var g = &grammar{
 		},
 		{
 			name: "PlusOrMinusOp",
-			pos:  position{line: 1032, col: 1, offset: 24746},
+			pos:  position{line: 1074, col: 1, offset: 25796},
 			expr: &actionExpr{
-				pos: position{line: 1032, col: 17, offset: 24762},
+				pos: position{line: 1074, col: 17, offset: 25812},
 				run: (*parser).callonPlusOrMinusOp1,
 				expr: &choiceExpr{
-					pos: position{line: 1032, col: 18, offset: 24763},
+					pos: position{line: 1074, col: 18, offset: 25813},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 1032, col: 18, offset: 24763},
+							pos:        position{line: 1074, col: 18, offset: 25813},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&litMatcher{
-							pos:        position{line: 1032, col: 24, offset: 24769},
+							pos:        position{line: 1074, col: 24, offset: 25819},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
@@ -6614,58 +6930,58 @@ var g = &grammar{
 		},
 		{
 			name: "ColonCast",
-			pos:  position{line: 1034, col: 1, offset: 24806},
+			pos:  position{line: 1076, col: 1, offset: 25856},
 			expr: &actionExpr{
-				pos: position{line: 1035, col: 5, offset: 24820},
+				pos: position{line: 1077, col: 5, offset: 25870},
 				run: (*parser).callonColonCast1,
 				expr: &seqExpr{
-					pos: position{line: 1035, col: 5, offset: 24820},
+					pos: position{line: 1077, col: 5, offset: 25870},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1035, col: 5, offset: 24820},
+							pos:   position{line: 1077, col: 5, offset: 25870},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1035, col: 11, offset: 24826},
+								pos:  position{line: 1077, col: 11, offset: 25876},
 								name: "DerefExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1036, col: 5, offset: 24840},
+							pos:   position{line: 1078, col: 5, offset: 25890},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1036, col: 10, offset: 24845},
+								pos: position{line: 1078, col: 10, offset: 25895},
 								expr: &actionExpr{
-									pos: position{line: 1036, col: 11, offset: 24846},
+									pos: position{line: 1078, col: 11, offset: 25896},
 									run: (*parser).callonColonCast7,
 									expr: &seqExpr{
-										pos: position{line: 1036, col: 11, offset: 24846},
+										pos: position{line: 1078, col: 11, offset: 25896},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1036, col: 11, offset: 24846},
+												pos:  position{line: 1078, col: 11, offset: 25896},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1036, col: 14, offset: 24849},
+												pos:        position{line: 1078, col: 14, offset: 25899},
 												val:        "::",
 												ignoreCase: false,
 												want:       "\"::\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1036, col: 19, offset: 24854},
+												pos:  position{line: 1078, col: 19, offset: 25904},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1036, col: 22, offset: 24857},
+												pos:   position{line: 1078, col: 22, offset: 25907},
 												label: "expr",
 												expr: &choiceExpr{
-													pos: position{line: 1036, col: 28, offset: 24863},
+													pos: position{line: 1078, col: 28, offset: 25913},
 													alternatives: []any{
 														&ruleRefExpr{
-															pos:  position{line: 1036, col: 28, offset: 24863},
+															pos:  position{line: 1078, col: 28, offset: 25913},
 															name: "TypeAsValue",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1036, col: 42, offset: 24877},
+															pos:  position{line: 1078, col: 42, offset: 25927},
 															name: "IDExpr",
 														},
 													},
@@ -6684,15 +7000,15 @@ var g = &grammar{
 		},
 		{
 			name: "IDExpr",
-			pos:  position{line: 1040, col: 1, offset: 24984},
+			pos:  position{line: 1082, col: 1, offset: 26034},
 			expr: &actionExpr{
-				pos: position{line: 1040, col: 10, offset: 24993},
+				pos: position{line: 1082, col: 10, offset: 26043},
 				run: (*parser).callonIDExpr1,
 				expr: &labeledExpr{
-					pos:   position{line: 1040, col: 10, offset: 24993},
+					pos:   position{line: 1082, col: 10, offset: 26043},
 					label: "id",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1040, col: 13, offset: 24996},
+						pos:  position{line: 1082, col: 13, offset: 26046},
 						name: "Identifier",
 					},
 				},
@@ -6702,73 +7018,73 @@ var g = &grammar{
 		},
 		{
 			name: "DerefExpr",
-			pos:  position{line: 1042, col: 1, offset: 25073},
+			pos:  position{line: 1084, col: 1, offset: 26123},
 			expr: &choiceExpr{
-				pos: position{line: 1043, col: 5, offset: 25087},
+				pos: position{line: 1085, col: 5, offset: 26137},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1043, col: 5, offset: 25087},
+						pos: position{line: 1085, col: 5, offset: 26137},
 						run: (*parser).callonDerefExpr2,
 						expr: &seqExpr{
-							pos: position{line: 1043, col: 5, offset: 25087},
+							pos: position{line: 1085, col: 5, offset: 26137},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1043, col: 5, offset: 25087},
+									pos:   position{line: 1085, col: 5, offset: 26137},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1043, col: 10, offset: 25092},
+										pos:  position{line: 1085, col: 10, offset: 26142},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1043, col: 20, offset: 25102},
+									pos:        position{line: 1085, col: 20, offset: 26152},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1043, col: 24, offset: 25106},
+									pos:  position{line: 1085, col: 24, offset: 26156},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1043, col: 27, offset: 25109},
+									pos:   position{line: 1085, col: 27, offset: 26159},
 									label: "from",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1043, col: 32, offset: 25114},
+										pos:  position{line: 1085, col: 32, offset: 26164},
 										name: "AdditiveExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1043, col: 45, offset: 25127},
+									pos:  position{line: 1085, col: 45, offset: 26177},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1043, col: 48, offset: 25130},
+									pos:        position{line: 1085, col: 48, offset: 26180},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1043, col: 52, offset: 25134},
+									pos:  position{line: 1085, col: 52, offset: 26184},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1043, col: 55, offset: 25137},
+									pos:   position{line: 1085, col: 55, offset: 26187},
 									label: "to",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1043, col: 58, offset: 25140},
+										pos: position{line: 1085, col: 58, offset: 26190},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1043, col: 58, offset: 25140},
+											pos:  position{line: 1085, col: 58, offset: 26190},
 											name: "AdditiveExpr",
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1043, col: 72, offset: 25154},
+									pos:  position{line: 1085, col: 72, offset: 26204},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1043, col: 75, offset: 25157},
+									pos:        position{line: 1085, col: 75, offset: 26207},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -6777,49 +7093,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1055, col: 5, offset: 25396},
+						pos: position{line: 1097, col: 5, offset: 26446},
 						run: (*parser).callonDerefExpr18,
 						expr: &seqExpr{
-							pos: position{line: 1055, col: 5, offset: 25396},
+							pos: position{line: 1097, col: 5, offset: 26446},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1055, col: 5, offset: 25396},
+									pos:   position{line: 1097, col: 5, offset: 26446},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1055, col: 10, offset: 25401},
+										pos:  position{line: 1097, col: 10, offset: 26451},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1055, col: 20, offset: 25411},
+									pos:        position{line: 1097, col: 20, offset: 26461},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1055, col: 24, offset: 25415},
+									pos:  position{line: 1097, col: 24, offset: 26465},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1055, col: 27, offset: 25418},
+									pos:        position{line: 1097, col: 27, offset: 26468},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1055, col: 31, offset: 25422},
+									pos:  position{line: 1097, col: 31, offset: 26472},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1055, col: 34, offset: 25425},
+									pos:   position{line: 1097, col: 34, offset: 26475},
 									label: "to",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1055, col: 37, offset: 25428},
+										pos:  position{line: 1097, col: 37, offset: 26478},
 										name: "AdditiveExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1055, col: 50, offset: 25441},
+									pos:        position{line: 1097, col: 50, offset: 26491},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -6828,35 +7144,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1063, col: 5, offset: 25605},
+						pos: position{line: 1105, col: 5, offset: 26655},
 						run: (*parser).callonDerefExpr29,
 						expr: &seqExpr{
-							pos: position{line: 1063, col: 5, offset: 25605},
+							pos: position{line: 1105, col: 5, offset: 26655},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1063, col: 5, offset: 25605},
+									pos:   position{line: 1105, col: 5, offset: 26655},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1063, col: 10, offset: 25610},
+										pos:  position{line: 1105, col: 10, offset: 26660},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1063, col: 20, offset: 25620},
+									pos:        position{line: 1105, col: 20, offset: 26670},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1063, col: 24, offset: 25624},
+									pos:   position{line: 1105, col: 24, offset: 26674},
 									label: "index",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1063, col: 30, offset: 25630},
+										pos:  position{line: 1105, col: 30, offset: 26680},
 										name: "Expr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1063, col: 35, offset: 25635},
+									pos:        position{line: 1105, col: 35, offset: 26685},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -6865,30 +7181,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1071, col: 5, offset: 25805},
+						pos: position{line: 1113, col: 5, offset: 26855},
 						run: (*parser).callonDerefExpr37,
 						expr: &seqExpr{
-							pos: position{line: 1071, col: 5, offset: 25805},
+							pos: position{line: 1113, col: 5, offset: 26855},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1071, col: 5, offset: 25805},
+									pos:   position{line: 1113, col: 5, offset: 26855},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1071, col: 10, offset: 25810},
+										pos:  position{line: 1113, col: 10, offset: 26860},
 										name: "DerefExpr",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1071, col: 20, offset: 25820},
+									pos:        position{line: 1113, col: 20, offset: 26870},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1071, col: 24, offset: 25824},
+									pos:   position{line: 1113, col: 24, offset: 26874},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1071, col: 27, offset: 25827},
+										pos:  position{line: 1113, col: 27, offset: 26877},
 										name: "DerefKey",
 									},
 								},
@@ -6896,15 +7212,15 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1080, col: 5, offset: 26015},
+						pos:  position{line: 1122, col: 5, offset: 27065},
 						name: "CaseExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1081, col: 5, offset: 26028},
+						pos:  position{line: 1123, col: 5, offset: 27078},
 						name: "Function",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1082, col: 5, offset: 26041},
+						pos:  position{line: 1124, col: 5, offset: 27091},
 						name: "Primary",
 					},
 				},
@@ -6914,42 +7230,42 @@ var g = &grammar{
 		},
 		{
 			name: "DerefKey",
-			pos:  position{line: 1084, col: 1, offset: 26050},
+			pos:  position{line: 1126, col: 1, offset: 27100},
 			expr: &choiceExpr{
-				pos: position{line: 1085, col: 5, offset: 26063},
+				pos: position{line: 1127, col: 5, offset: 27113},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1085, col: 5, offset: 26063},
+						pos: position{line: 1127, col: 5, offset: 27113},
 						run: (*parser).callonDerefKey2,
 						expr: &labeledExpr{
-							pos:   position{line: 1085, col: 5, offset: 26063},
+							pos:   position{line: 1127, col: 5, offset: 27113},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1085, col: 8, offset: 26066},
+								pos:  position{line: 1127, col: 8, offset: 27116},
 								name: "Identifier",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1086, col: 5, offset: 26157},
+						pos: position{line: 1128, col: 5, offset: 27207},
 						run: (*parser).callonDerefKey5,
 						expr: &labeledExpr{
-							pos:   position{line: 1086, col: 5, offset: 26157},
+							pos:   position{line: 1128, col: 5, offset: 27207},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1086, col: 7, offset: 26159},
+								pos:  position{line: 1128, col: 7, offset: 27209},
 								name: "DoubleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1087, col: 5, offset: 26271},
+						pos: position{line: 1129, col: 5, offset: 27321},
 						run: (*parser).callonDerefKey8,
 						expr: &labeledExpr{
-							pos:   position{line: 1087, col: 5, offset: 26271},
+							pos:   position{line: 1129, col: 5, offset: 27321},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1087, col: 7, offset: 26273},
+								pos:  position{line: 1129, col: 7, offset: 27323},
 								name: "BacktickString",
 							},
 						},
@@ -6961,68 +7277,68 @@ var g = &grammar{
 		},
 		{
 			name: "Function",
-			pos:  position{line: 1089, col: 1, offset: 26382},
+			pos:  position{line: 1131, col: 1, offset: 27432},
 			expr: &choiceExpr{
-				pos: position{line: 1090, col: 5, offset: 26395},
+				pos: position{line: 1132, col: 5, offset: 27445},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1090, col: 5, offset: 26395},
+						pos: position{line: 1132, col: 5, offset: 27445},
 						run: (*parser).callonFunction2,
 						expr: &seqExpr{
-							pos: position{line: 1090, col: 5, offset: 26395},
+							pos: position{line: 1132, col: 5, offset: 27445},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1090, col: 5, offset: 26395},
+									pos:  position{line: 1132, col: 5, offset: 27445},
 									name: "EXTRACT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1090, col: 13, offset: 26403},
+									pos:  position{line: 1132, col: 13, offset: 27453},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1090, col: 16, offset: 26406},
+									pos:        position{line: 1132, col: 16, offset: 27456},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1090, col: 20, offset: 26410},
+									pos:  position{line: 1132, col: 20, offset: 27460},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1090, col: 23, offset: 26413},
+									pos:   position{line: 1132, col: 23, offset: 27463},
 									label: "part",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1090, col: 28, offset: 26418},
+										pos:  position{line: 1132, col: 28, offset: 27468},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1090, col: 33, offset: 26423},
+									pos:  position{line: 1132, col: 33, offset: 27473},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1090, col: 35, offset: 26425},
+									pos:  position{line: 1132, col: 35, offset: 27475},
 									name: "FROM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1090, col: 40, offset: 26430},
+									pos:  position{line: 1132, col: 40, offset: 27480},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 1090, col: 42, offset: 26432},
+									pos:   position{line: 1132, col: 42, offset: 27482},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1090, col: 44, offset: 26434},
+										pos:  position{line: 1132, col: 44, offset: 27484},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1090, col: 49, offset: 26439},
+									pos:  position{line: 1132, col: 49, offset: 27489},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1090, col: 52, offset: 26442},
+									pos:        position{line: 1132, col: 52, offset: 27492},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7031,43 +7347,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1098, col: 5, offset: 26611},
+						pos: position{line: 1140, col: 5, offset: 27661},
 						run: (*parser).callonFunction17,
 						expr: &seqExpr{
-							pos: position{line: 1098, col: 5, offset: 26611},
+							pos: position{line: 1140, col: 5, offset: 27661},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1098, col: 5, offset: 26611},
+									pos:  position{line: 1140, col: 5, offset: 27661},
 									name: "EXISTS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1098, col: 12, offset: 26618},
+									pos:  position{line: 1140, col: 12, offset: 27668},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1098, col: 15, offset: 26621},
+									pos:        position{line: 1140, col: 15, offset: 27671},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1098, col: 19, offset: 26625},
+									pos:  position{line: 1140, col: 19, offset: 27675},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1098, col: 22, offset: 26628},
+									pos:   position{line: 1140, col: 22, offset: 27678},
 									label: "body",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1098, col: 27, offset: 26633},
+										pos:  position{line: 1140, col: 27, offset: 27683},
 										name: "Seq",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1098, col: 31, offset: 26637},
+									pos:  position{line: 1140, col: 31, offset: 27687},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1098, col: 34, offset: 26640},
+									pos:        position{line: 1140, col: 34, offset: 27690},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7076,72 +7392,72 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1105, col: 5, offset: 26785},
+						pos: position{line: 1147, col: 5, offset: 27835},
 						run: (*parser).callonFunction27,
 						expr: &seqExpr{
-							pos: position{line: 1105, col: 5, offset: 26785},
+							pos: position{line: 1147, col: 5, offset: 27835},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1105, col: 5, offset: 26785},
+									pos:  position{line: 1147, col: 5, offset: 27835},
 									name: "CAST",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1105, col: 10, offset: 26790},
+									pos:  position{line: 1147, col: 10, offset: 27840},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1105, col: 13, offset: 26793},
+									pos:        position{line: 1147, col: 13, offset: 27843},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1105, col: 17, offset: 26797},
+									pos:  position{line: 1147, col: 17, offset: 27847},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1105, col: 20, offset: 26800},
+									pos:   position{line: 1147, col: 20, offset: 27850},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1105, col: 22, offset: 26802},
+										pos:  position{line: 1147, col: 22, offset: 27852},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1105, col: 27, offset: 26807},
+									pos:  position{line: 1147, col: 27, offset: 27857},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1105, col: 29, offset: 26809},
+									pos:  position{line: 1147, col: 29, offset: 27859},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1105, col: 32, offset: 26812},
+									pos:  position{line: 1147, col: 32, offset: 27862},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 1105, col: 34, offset: 26814},
+									pos:   position{line: 1147, col: 34, offset: 27864},
 									label: "typ",
 									expr: &choiceExpr{
-										pos: position{line: 1105, col: 39, offset: 26819},
+										pos: position{line: 1147, col: 39, offset: 27869},
 										alternatives: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1105, col: 39, offset: 26819},
+												pos:  position{line: 1147, col: 39, offset: 27869},
 												name: "DateTypeHack",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1105, col: 54, offset: 26834},
+												pos:  position{line: 1147, col: 54, offset: 27884},
 												name: "Type",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1105, col: 60, offset: 26840},
+									pos:  position{line: 1147, col: 60, offset: 27890},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1105, col: 63, offset: 26843},
+									pos:        position{line: 1147, col: 63, offset: 27893},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7150,65 +7466,65 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1113, col: 5, offset: 27005},
+						pos: position{line: 1155, col: 5, offset: 28055},
 						run: (*parser).callonFunction44,
 						expr: &seqExpr{
-							pos: position{line: 1113, col: 5, offset: 27005},
+							pos: position{line: 1155, col: 5, offset: 28055},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1113, col: 5, offset: 27005},
+									pos:  position{line: 1155, col: 5, offset: 28055},
 									name: "SUBSTRING",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1113, col: 15, offset: 27015},
+									pos:  position{line: 1155, col: 15, offset: 28065},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1113, col: 18, offset: 27018},
+									pos:        position{line: 1155, col: 18, offset: 28068},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1113, col: 22, offset: 27022},
+									pos:  position{line: 1155, col: 22, offset: 28072},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1113, col: 25, offset: 27025},
+									pos:   position{line: 1155, col: 25, offset: 28075},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1113, col: 30, offset: 27030},
+										pos:  position{line: 1155, col: 30, offset: 28080},
 										name: "Expr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1113, col: 35, offset: 27035},
+									pos:   position{line: 1155, col: 35, offset: 28085},
 									label: "from",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1113, col: 40, offset: 27040},
+										pos: position{line: 1155, col: 40, offset: 28090},
 										expr: &actionExpr{
-											pos: position{line: 1113, col: 41, offset: 27041},
+											pos: position{line: 1155, col: 41, offset: 28091},
 											run: (*parser).callonFunction54,
 											expr: &seqExpr{
-												pos: position{line: 1113, col: 41, offset: 27041},
+												pos: position{line: 1155, col: 41, offset: 28091},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1113, col: 41, offset: 27041},
+														pos:  position{line: 1155, col: 41, offset: 28091},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1113, col: 43, offset: 27043},
+														pos:  position{line: 1155, col: 43, offset: 28093},
 														name: "FROM",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1113, col: 48, offset: 27048},
+														pos:  position{line: 1155, col: 48, offset: 28098},
 														name: "_",
 													},
 													&labeledExpr{
-														pos:   position{line: 1113, col: 50, offset: 27050},
+														pos:   position{line: 1155, col: 50, offset: 28100},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1113, col: 52, offset: 27052},
+															pos:  position{line: 1155, col: 52, offset: 28102},
 															name: "Expr",
 														},
 													},
@@ -7218,33 +7534,33 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1113, col: 77, offset: 27077},
+									pos:   position{line: 1155, col: 77, offset: 28127},
 									label: "for_",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1113, col: 82, offset: 27082},
+										pos: position{line: 1155, col: 82, offset: 28132},
 										expr: &actionExpr{
-											pos: position{line: 1113, col: 83, offset: 27083},
+											pos: position{line: 1155, col: 83, offset: 28133},
 											run: (*parser).callonFunction63,
 											expr: &seqExpr{
-												pos: position{line: 1113, col: 83, offset: 27083},
+												pos: position{line: 1155, col: 83, offset: 28133},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1113, col: 83, offset: 27083},
+														pos:  position{line: 1155, col: 83, offset: 28133},
 														name: "_",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1113, col: 85, offset: 27085},
+														pos:  position{line: 1155, col: 85, offset: 28135},
 														name: "FOR",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1113, col: 89, offset: 27089},
+														pos:  position{line: 1155, col: 89, offset: 28139},
 														name: "_",
 													},
 													&labeledExpr{
-														pos:   position{line: 1113, col: 91, offset: 27091},
+														pos:   position{line: 1155, col: 91, offset: 28141},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1113, col: 93, offset: 27093},
+															pos:  position{line: 1155, col: 93, offset: 28143},
 															name: "Expr",
 														},
 													},
@@ -7254,7 +7570,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1113, col: 118, offset: 27118},
+									pos:        position{line: 1155, col: 118, offset: 28168},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7263,71 +7579,100 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1127, col: 5, offset: 27403},
+						pos: position{line: 1169, col: 5, offset: 28453},
 						run: (*parser).callonFunction71,
 						expr: &seqExpr{
-							pos: position{line: 1127, col: 5, offset: 27403},
+							pos: position{line: 1169, col: 5, offset: 28453},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1127, col: 5, offset: 27403},
+									pos:   position{line: 1169, col: 5, offset: 28453},
 									label: "f",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1127, col: 7, offset: 27405},
+										pos:  position{line: 1169, col: 7, offset: 28455},
 										name: "Callable",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1127, col: 16, offset: 27414},
+									pos:  position{line: 1169, col: 16, offset: 28464},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1127, col: 19, offset: 27417},
+									pos:        position{line: 1169, col: 19, offset: 28467},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&notExpr{
-									pos: position{line: 1127, col: 23, offset: 27421},
+									pos: position{line: 1169, col: 23, offset: 28471},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1127, col: 24, offset: 27422},
+										pos:  position{line: 1169, col: 24, offset: 28472},
 										name: "AggArgGuard",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1127, col: 36, offset: 27434},
+									pos:  position{line: 1169, col: 36, offset: 28484},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1127, col: 39, offset: 27437},
+									pos:   position{line: 1169, col: 39, offset: 28487},
 									label: "args",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1127, col: 44, offset: 27442},
+										pos:  position{line: 1169, col: 44, offset: 28492},
 										name: "FunctionArgs",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1127, col: 57, offset: 27455},
+									pos:  position{line: 1169, col: 57, offset: 28505},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1127, col: 60, offset: 27458},
+									pos:        position{line: 1169, col: 60, offset: 28508},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&notExpr{
-									pos: position{line: 1127, col: 64, offset: 27462},
+									pos: position{line: 1169, col: 64, offset: 28512},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1127, col: 65, offset: 27463},
+										pos:  position{line: 1169, col: 65, offset: 28513},
 										name: "FilterClause",
 									},
 								},
+								&labeledExpr{
+									pos:   position{line: 1169, col: 78, offset: 28526},
+									label: "over",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1169, col: 83, offset: 28531},
+										name: "OptOverClause",
+									},
+								},
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1130, col: 5, offset: 27526},
-						name: "AggFunc",
+					&actionExpr{
+						pos: position{line: 1176, col: 5, offset: 28694},
+						run: (*parser).callonFunction88,
+						expr: &seqExpr{
+							pos: position{line: 1176, col: 5, offset: 28694},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 1176, col: 5, offset: 28694},
+									label: "agg",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1176, col: 9, offset: 28698},
+										name: "AggFunc",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 1176, col: 17, offset: 28706},
+									label: "over",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1176, col: 22, offset: 28711},
+										name: "OptOverClause",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -7336,33 +7681,33 @@ var g = &grammar{
 		},
 		{
 			name: "AggArgGuard",
-			pos:  position{line: 1132, col: 1, offset: 27535},
+			pos:  position{line: 1183, col: 1, offset: 28835},
 			expr: &seqExpr{
-				pos: position{line: 1132, col: 15, offset: 27549},
+				pos: position{line: 1183, col: 15, offset: 28849},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1132, col: 15, offset: 27549},
+						pos:  position{line: 1183, col: 15, offset: 28849},
 						name: "__",
 					},
 					&choiceExpr{
-						pos: position{line: 1132, col: 19, offset: 27553},
+						pos: position{line: 1183, col: 19, offset: 28853},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 1132, col: 19, offset: 27553},
+								pos:  position{line: 1183, col: 19, offset: 28853},
 								name: "ALL",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1132, col: 25, offset: 27559},
+								pos:  position{line: 1183, col: 25, offset: 28859},
 								name: "DISTINCT",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1132, col: 35, offset: 27569},
+						pos:  position{line: 1183, col: 35, offset: 28869},
 						name: "_",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1132, col: 37, offset: 27571},
+						pos:  position{line: 1183, col: 37, offset: 28871},
 						name: "Expr",
 					},
 				},
@@ -7371,55 +7716,591 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "Callable",
-			pos:  position{line: 1134, col: 1, offset: 27577},
+			name: "OptOverClause",
+			pos:  position{line: 1185, col: 1, offset: 28877},
 			expr: &choiceExpr{
-				pos: position{line: 1135, col: 5, offset: 27590},
+				pos: position{line: 1186, col: 5, offset: 28895},
 				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 1135, col: 5, offset: 27590},
-						name: "LambdaExpr",
-					},
 					&actionExpr{
-						pos: position{line: 1136, col: 5, offset: 27605},
-						run: (*parser).callonCallable3,
-						expr: &labeledExpr{
-							pos:   position{line: 1136, col: 5, offset: 27605},
-							label: "id",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1136, col: 8, offset: 27608},
-								name: "IdentifierName",
-							},
-						},
-					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
+						pos: position{line: 1186, col: 5, offset: 28895},
+						run: (*parser).callonOptOverClause2,
+						expr: &seqExpr{
+							pos: position{line: 1186, col: 5, offset: 28895},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 1186, col: 5, offset: 28895},
+									name: "__",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1186, col: 8, offset: 28898},
+									name: "OVER",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1186, col: 13, offset: 28903},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 1186, col: 16, offset: 28906},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1186, col: 20, offset: 28910},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 1186, col: 23, offset: 28913},
+									label: "spec",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1186, col: 28, offset: 28918},
+										name: "WindowSpec",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1186, col: 39, offset: 28929},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1187, col: 5, offset: 28958},
+						run: (*parser).callonOptOverClause12,
+						expr: &seqExpr{
+							pos: position{line: 1187, col: 5, offset: 28958},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 1187, col: 5, offset: 28958},
+									name: "__",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1187, col: 8, offset: 28961},
+									name: "OVER",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1187, col: 13, offset: 28966},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 1187, col: 15, offset: 28968},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1187, col: 20, offset: 28973},
+										name: "Identifier",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1190, col: 5, offset: 29072},
+						run: (*parser).callonOptOverClause19,
+						expr: &litMatcher{
+							pos:        position{line: 1190, col: 5, offset: 29072},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowSpec",
+			pos:  position{line: 1192, col: 1, offset: 29096},
+			expr: &actionExpr{
+				pos: position{line: 1193, col: 5, offset: 29111},
+				run: (*parser).callonWindowSpec1,
+				expr: &seqExpr{
+					pos: position{line: 1193, col: 5, offset: 29111},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 1193, col: 5, offset: 29111},
+							label: "name",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1193, col: 10, offset: 29116},
+								expr: &actionExpr{
+									pos: position{line: 1193, col: 11, offset: 29117},
+									run: (*parser).callonWindowSpec5,
+									expr: &seqExpr{
+										pos: position{line: 1193, col: 11, offset: 29117},
+										exprs: []any{
+											&notExpr{
+												pos: position{line: 1193, col: 11, offset: 29117},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1193, col: 12, offset: 29118},
+													name: "WindowSpecKeyword",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 1193, col: 30, offset: 29136},
+												label: "id",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1193, col: 33, offset: 29139},
+													name: "Identifier",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1193, col: 44, offset: 29150},
+												name: "__",
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1194, col: 5, offset: 29178},
+							label: "partition",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1194, col: 15, offset: 29188},
+								expr: &actionExpr{
+									pos: position{line: 1194, col: 16, offset: 29189},
+									run: (*parser).callonWindowSpec14,
+									expr: &seqExpr{
+										pos: position{line: 1194, col: 16, offset: 29189},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 1194, col: 16, offset: 29189},
+												name: "PARTITION",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1194, col: 26, offset: 29199},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1194, col: 28, offset: 29201},
+												name: "BY",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1194, col: 31, offset: 29204},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 1194, col: 33, offset: 29206},
+												label: "e",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1194, col: 35, offset: 29208},
+													name: "Exprs",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1194, col: 41, offset: 29214},
+												name: "__",
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1195, col: 5, offset: 29241},
+							label: "orderby",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1195, col: 13, offset: 29249},
+								expr: &actionExpr{
+									pos: position{line: 1195, col: 14, offset: 29250},
+									run: (*parser).callonWindowSpec25,
+									expr: &seqExpr{
+										pos: position{line: 1195, col: 14, offset: 29250},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 1195, col: 14, offset: 29250},
+												name: "ORDER",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1195, col: 20, offset: 29256},
+												name: "_",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1195, col: 22, offset: 29258},
+												name: "BY",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1195, col: 25, offset: 29261},
+												name: "_",
+											},
+											&labeledExpr{
+												pos:   position{line: 1195, col: 27, offset: 29263},
+												label: "list",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1195, col: 32, offset: 29268},
+													name: "OrderByList",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1195, col: 44, offset: 29280},
+												name: "__",
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1196, col: 5, offset: 29310},
+							label: "frame",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1196, col: 11, offset: 29316},
+								expr: &actionExpr{
+									pos: position{line: 1196, col: 12, offset: 29317},
+									run: (*parser).callonWindowSpec36,
+									expr: &seqExpr{
+										pos: position{line: 1196, col: 12, offset: 29317},
+										exprs: []any{
+											&labeledExpr{
+												pos:   position{line: 1196, col: 12, offset: 29317},
+												label: "f",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1196, col: 14, offset: 29319},
+													name: "WindowFrame",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1196, col: 26, offset: 29331},
+												name: "__",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowFrame",
+			pos:  position{line: 1213, col: 1, offset: 29736},
+			expr: &choiceExpr{
+				pos: position{line: 1214, col: 5, offset: 29752},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 1214, col: 5, offset: 29752},
+						run: (*parser).callonWindowFrame2,
+						expr: &seqExpr{
+							pos: position{line: 1214, col: 5, offset: 29752},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 1214, col: 5, offset: 29752},
+									label: "unit",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1214, col: 10, offset: 29757},
+										name: "WindowFrameUnit",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1214, col: 26, offset: 29773},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1214, col: 28, offset: 29775},
+									name: "BETWEEN",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1214, col: 36, offset: 29783},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 1214, col: 38, offset: 29785},
+									label: "start",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1214, col: 44, offset: 29791},
+										name: "WindowFrameBound",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1214, col: 61, offset: 29808},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1214, col: 63, offset: 29810},
+									name: "AND",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1214, col: 67, offset: 29814},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 1214, col: 69, offset: 29816},
+									label: "end",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1214, col: 73, offset: 29820},
+										name: "WindowFrameBound",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1222, col: 5, offset: 30019},
+						run: (*parser).callonWindowFrame16,
+						expr: &seqExpr{
+							pos: position{line: 1222, col: 5, offset: 30019},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 1222, col: 5, offset: 30019},
+									label: "unit",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1222, col: 10, offset: 30024},
+										name: "WindowFrameUnit",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1222, col: 26, offset: 30040},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 1222, col: 28, offset: 30042},
+									label: "start",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1222, col: 34, offset: 30048},
+										name: "WindowFrameBound",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowSpecKeyword",
+			pos:  position{line: 1231, col: 1, offset: 30272},
+			expr: &choiceExpr{
+				pos: position{line: 1231, col: 21, offset: 30292},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 1231, col: 21, offset: 30292},
+						name: "PARTITION",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1231, col: 33, offset: 30304},
+						name: "ORDER",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1231, col: 41, offset: 30312},
+						name: "ROWS",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1231, col: 48, offset: 30319},
+						name: "RANGE",
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowFrameUnit",
+			pos:  position{line: 1233, col: 1, offset: 30326},
+			expr: &choiceExpr{
+				pos: position{line: 1234, col: 5, offset: 30346},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 1234, col: 5, offset: 30346},
+						run: (*parser).callonWindowFrameUnit2,
+						expr: &ruleRefExpr{
+							pos:  position{line: 1234, col: 5, offset: 30346},
+							name: "ROWS",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1235, col: 5, offset: 30378},
+						run: (*parser).callonWindowFrameUnit4,
+						expr: &ruleRefExpr{
+							pos:  position{line: 1235, col: 5, offset: 30378},
+							name: "RANGE",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WindowFrameBound",
+			pos:  position{line: 1237, col: 1, offset: 30409},
+			expr: &choiceExpr{
+				pos: position{line: 1238, col: 5, offset: 30430},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 1238, col: 5, offset: 30430},
+						run: (*parser).callonWindowFrameBound2,
+						expr: &seqExpr{
+							pos: position{line: 1238, col: 5, offset: 30430},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 1238, col: 5, offset: 30430},
+									name: "UNBOUNDED",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1238, col: 15, offset: 30440},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1238, col: 17, offset: 30442},
+									name: "PRECEDING",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1239, col: 5, offset: 30530},
+						run: (*parser).callonWindowFrameBound7,
+						expr: &seqExpr{
+							pos: position{line: 1239, col: 5, offset: 30530},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 1239, col: 5, offset: 30530},
+									name: "UNBOUNDED",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1239, col: 15, offset: 30540},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1239, col: 17, offset: 30542},
+									name: "FOLLOWING",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1240, col: 5, offset: 30630},
+						run: (*parser).callonWindowFrameBound12,
+						expr: &seqExpr{
+							pos: position{line: 1240, col: 5, offset: 30630},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 1240, col: 5, offset: 30630},
+									name: "CURRENT",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1240, col: 13, offset: 30638},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1240, col: 15, offset: 30640},
+									name: "ROW",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1241, col: 5, offset: 30714},
+						run: (*parser).callonWindowFrameBound17,
+						expr: &seqExpr{
+							pos: position{line: 1241, col: 5, offset: 30714},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 1241, col: 5, offset: 30714},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1241, col: 7, offset: 30716},
+										name: "AdditiveExpr",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1241, col: 20, offset: 30729},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1241, col: 22, offset: 30731},
+									name: "PRECEDING",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1242, col: 5, offset: 30831},
+						run: (*parser).callonWindowFrameBound23,
+						expr: &seqExpr{
+							pos: position{line: 1242, col: 5, offset: 30831},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 1242, col: 5, offset: 30831},
+									label: "e",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1242, col: 7, offset: 30833},
+										name: "AdditiveExpr",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1242, col: 20, offset: 30846},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1242, col: 22, offset: 30848},
+									name: "FOLLOWING",
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "Callable",
+			pos:  position{line: 1244, col: 1, offset: 30945},
+			expr: &choiceExpr{
+				pos: position{line: 1245, col: 5, offset: 30958},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 1245, col: 5, offset: 30958},
+						name: "LambdaExpr",
+					},
+					&actionExpr{
+						pos: position{line: 1246, col: 5, offset: 30973},
+						run: (*parser).callonCallable3,
+						expr: &labeledExpr{
+							pos:   position{line: 1246, col: 5, offset: 30973},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1246, col: 8, offset: 30976},
+								name: "IdentifierName",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "FuncValue",
-			pos:  position{line: 1144, col: 1, offset: 27755},
+			pos:  position{line: 1254, col: 1, offset: 31123},
 			expr: &choiceExpr{
-				pos: position{line: 1145, col: 5, offset: 27769},
+				pos: position{line: 1255, col: 5, offset: 31137},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1145, col: 5, offset: 27769},
+						pos: position{line: 1255, col: 5, offset: 31137},
 						run: (*parser).callonFuncValue2,
 						expr: &seqExpr{
-							pos: position{line: 1145, col: 5, offset: 27769},
+							pos: position{line: 1255, col: 5, offset: 31137},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1145, col: 5, offset: 27769},
+									pos:        position{line: 1255, col: 5, offset: 31137},
 									val:        "&",
 									ignoreCase: false,
 									want:       "\"&\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1145, col: 9, offset: 27773},
+									pos:   position{line: 1255, col: 9, offset: 31141},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1145, col: 12, offset: 27776},
+										pos:  position{line: 1255, col: 12, offset: 31144},
 										name: "IdentifierName",
 									},
 								},
@@ -7427,7 +8308,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1152, col: 5, offset: 27926},
+						pos:  position{line: 1262, col: 5, offset: 31294},
 						name: "LambdaExpr",
 					},
 				},
@@ -7437,12 +8318,12 @@ var g = &grammar{
 		},
 		{
 			name: "DateTypeHack",
-			pos:  position{line: 1154, col: 1, offset: 27938},
+			pos:  position{line: 1264, col: 1, offset: 31306},
 			expr: &actionExpr{
-				pos: position{line: 1155, col: 5, offset: 27955},
+				pos: position{line: 1265, col: 5, offset: 31323},
 				run: (*parser).callonDateTypeHack1,
 				expr: &litMatcher{
-					pos:        position{line: 1155, col: 5, offset: 27955},
+					pos:        position{line: 1265, col: 5, offset: 31323},
 					val:        "date",
 					ignoreCase: true,
 					want:       "\"date\"i",
@@ -7453,19 +8334,19 @@ var g = &grammar{
 		},
 		{
 			name: "FunctionArgs",
-			pos:  position{line: 1162, col: 1, offset: 28067},
+			pos:  position{line: 1272, col: 1, offset: 31435},
 			expr: &choiceExpr{
-				pos: position{line: 1163, col: 5, offset: 28084},
+				pos: position{line: 1273, col: 5, offset: 31452},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1163, col: 5, offset: 28084},
+						pos:  position{line: 1273, col: 5, offset: 31452},
 						name: "FuncOrExprs",
 					},
 					&actionExpr{
-						pos: position{line: 1164, col: 5, offset: 28100},
+						pos: position{line: 1274, col: 5, offset: 31468},
 						run: (*parser).callonFunctionArgs3,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1164, col: 5, offset: 28100},
+							pos:  position{line: 1274, col: 5, offset: 31468},
 							name: "__",
 						},
 					},
@@ -7476,51 +8357,51 @@ var g = &grammar{
 		},
 		{
 			name: "Exprs",
-			pos:  position{line: 1166, col: 1, offset: 28128},
+			pos:  position{line: 1276, col: 1, offset: 31496},
 			expr: &actionExpr{
-				pos: position{line: 1167, col: 5, offset: 28138},
+				pos: position{line: 1277, col: 5, offset: 31506},
 				run: (*parser).callonExprs1,
 				expr: &seqExpr{
-					pos: position{line: 1167, col: 5, offset: 28138},
+					pos: position{line: 1277, col: 5, offset: 31506},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1167, col: 5, offset: 28138},
+							pos:   position{line: 1277, col: 5, offset: 31506},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1167, col: 11, offset: 28144},
+								pos:  position{line: 1277, col: 11, offset: 31512},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1167, col: 16, offset: 28149},
+							pos:   position{line: 1277, col: 16, offset: 31517},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1167, col: 21, offset: 28154},
+								pos: position{line: 1277, col: 21, offset: 31522},
 								expr: &actionExpr{
-									pos: position{line: 1167, col: 22, offset: 28155},
+									pos: position{line: 1277, col: 22, offset: 31523},
 									run: (*parser).callonExprs7,
 									expr: &seqExpr{
-										pos: position{line: 1167, col: 22, offset: 28155},
+										pos: position{line: 1277, col: 22, offset: 31523},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1167, col: 22, offset: 28155},
+												pos:  position{line: 1277, col: 22, offset: 31523},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1167, col: 25, offset: 28158},
+												pos:        position{line: 1277, col: 25, offset: 31526},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1167, col: 29, offset: 28162},
+												pos:  position{line: 1277, col: 29, offset: 31530},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1167, col: 32, offset: 28165},
+												pos:   position{line: 1277, col: 32, offset: 31533},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1167, col: 34, offset: 28167},
+													pos:  position{line: 1277, col: 34, offset: 31535},
 													name: "Expr",
 												},
 											},
@@ -7537,80 +8418,94 @@ var g = &grammar{
 		},
 		{
 			name: "Primary",
-			pos:  position{line: 1171, col: 1, offset: 28240},
+			pos:  position{line: 1281, col: 1, offset: 31608},
 			expr: &choiceExpr{
-				pos: position{line: 1172, col: 5, offset: 28252},
+				pos: position{line: 1282, col: 5, offset: 31620},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1172, col: 5, offset: 28252},
+						pos:  position{line: 1282, col: 5, offset: 31620},
 						name: "Record",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1173, col: 5, offset: 28263},
+						pos:  position{line: 1283, col: 5, offset: 31631},
 						name: "Array",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1174, col: 5, offset: 28273},
+						pos:  position{line: 1284, col: 5, offset: 31641},
 						name: "Set",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1175, col: 5, offset: 28281},
+						pos:  position{line: 1285, col: 5, offset: 31649},
 						name: "Map",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1176, col: 5, offset: 28289},
+						pos:  position{line: 1286, col: 5, offset: 31657},
 						name: "SQLTimeExpr",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1177, col: 5, offset: 28305},
+						pos:  position{line: 1287, col: 5, offset: 31673},
 						name: "Literal",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 1288, col: 5, offset: 31685},
+						name: "Param",
+					},
 					&actionExpr{
-						pos: position{line: 1178, col: 5, offset: 28317},
-						run: (*parser).callonPrimary8,
+						pos: position{line: 1289, col: 5, offset: 31695},
+						run: (*parser).callonPrimary9,
 						expr: &labeledExpr{
-							pos:   position{line: 1178, col: 5, offset: 28317},
+							pos:   position{line: 1289, col: 5, offset: 31695},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1178, col: 8, offset: 28320},
+								pos:  position{line: 1289, col: 8, offset: 31698},
 								name: "Identifier",
 							},
 						},
 					},
+					&actionExpr{
+						pos: position{line: 1290, col: 5, offset: 31791},
+						run: (*parser).callonPrimary12,
+						expr: &litMatcher{
+							pos:        position{line: 1290, col: 5, offset: 31791},
+							val:        "?",
+							ignoreCase: false,
+							want:       "\"?\"",
+						},
+					},
 					&ruleRefExpr{
-						pos:  position{line: 1179, col: 5, offset: 28413},
+						pos:  position{line: 1291, col: 5, offset: 31827},
 						name: "Tuple",
 					},
 					&actionExpr{
-						pos: position{line: 1180, col: 5, offset: 28423},
-						run: (*parser).callonPrimary12,
+						pos: position{line: 1292, col: 5, offset: 31837},
+						run: (*parser).callonPrimary15,
 						expr: &seqExpr{
-							pos: position{line: 1180, col: 5, offset: 28423},
+							pos: position{line: 1292, col: 5, offset: 31837},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1180, col: 5, offset: 28423},
+									pos:        position{line: 1292, col: 5, offset: 31837},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1180, col: 9, offset: 28427},
+									pos:  position{line: 1292, col: 9, offset: 31841},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1180, col: 12, offset: 28430},
+									pos:   position{line: 1292, col: 12, offset: 31844},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1180, col: 17, offset: 28435},
+										pos:  position{line: 1292, col: 17, offset: 31849},
 										name: "Expr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1180, col: 22, offset: 28440},
+									pos:  position{line: 1292, col: 22, offset: 31854},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1180, col: 25, offset: 28443},
+									pos:        position{line: 1292, col: 25, offset: 31857},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7619,35 +8514,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1181, col: 5, offset: 28472},
-						run: (*parser).callonPrimary20,
+						pos: position{line: 1293, col: 5, offset: 31886},
+						run: (*parser).callonPrimary23,
 						expr: &seqExpr{
-							pos: position{line: 1181, col: 5, offset: 28472},
+							pos: position{line: 1293, col: 5, offset: 31886},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1181, col: 5, offset: 28472},
+									pos:        position{line: 1293, col: 5, offset: 31886},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1181, col: 9, offset: 28476},
+									pos:  position{line: 1293, col: 9, offset: 31890},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1181, col: 12, offset: 28479},
+									pos:   position{line: 1293, col: 12, offset: 31893},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1181, col: 17, offset: 28484},
+										pos:  position{line: 1293, col: 17, offset: 31898},
 										name: "SubqueryExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1181, col: 30, offset: 28497},
+									pos:  position{line: 1293, col: 30, offset: 31911},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1181, col: 33, offset: 28500},
+									pos:        position{line: 1293, col: 33, offset: 31914},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7656,35 +8551,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1182, col: 5, offset: 28529},
-						run: (*parser).callonPrimary28,
+						pos: position{line: 1294, col: 5, offset: 31943},
+						run: (*parser).callonPrimary31,
 						expr: &seqExpr{
-							pos: position{line: 1182, col: 5, offset: 28529},
+							pos: position{line: 1294, col: 5, offset: 31943},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1182, col: 5, offset: 28529},
+									pos:        position{line: 1294, col: 5, offset: 31943},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1182, col: 9, offset: 28533},
+									pos:  position{line: 1294, col: 9, offset: 31947},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1182, col: 12, offset: 28536},
+									pos:   position{line: 1294, col: 12, offset: 31950},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1182, col: 17, offset: 28541},
+										pos:  position{line: 1294, col: 17, offset: 31955},
 										name: "SubqueryExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1182, col: 30, offset: 28554},
+									pos:  position{line: 1294, col: 30, offset: 31968},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1182, col: 33, offset: 28557},
+									pos:        position{line: 1294, col: 33, offset: 31971},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -7699,53 +8594,53 @@ var g = &grammar{
 		},
 		{
 			name: "CaseExpr",
-			pos:  position{line: 1187, col: 1, offset: 28639},
+			pos:  position{line: 1299, col: 1, offset: 32053},
 			expr: &choiceExpr{
-				pos: position{line: 1188, col: 5, offset: 28652},
+				pos: position{line: 1300, col: 5, offset: 32066},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1188, col: 5, offset: 28652},
+						pos: position{line: 1300, col: 5, offset: 32066},
 						run: (*parser).callonCaseExpr2,
 						expr: &seqExpr{
-							pos: position{line: 1188, col: 5, offset: 28652},
+							pos: position{line: 1300, col: 5, offset: 32066},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1188, col: 5, offset: 28652},
+									pos:  position{line: 1300, col: 5, offset: 32066},
 									name: "CASE",
 								},
 								&labeledExpr{
-									pos:   position{line: 1188, col: 10, offset: 28657},
+									pos:   position{line: 1300, col: 10, offset: 32071},
 									label: "whens",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1188, col: 16, offset: 28663},
+										pos: position{line: 1300, col: 16, offset: 32077},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1188, col: 16, offset: 28663},
+											pos:  position{line: 1300, col: 16, offset: 32077},
 											name: "When",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1188, col: 22, offset: 28669},
+									pos:   position{line: 1300, col: 22, offset: 32083},
 									label: "else_",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1188, col: 28, offset: 28675},
+										pos: position{line: 1300, col: 28, offset: 32089},
 										expr: &seqExpr{
-											pos: position{line: 1188, col: 29, offset: 28676},
+											pos: position{line: 1300, col: 29, offset: 32090},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1188, col: 29, offset: 28676},
+													pos:  position{line: 1300, col: 29, offset: 32090},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1188, col: 31, offset: 28678},
+													pos:  position{line: 1300, col: 31, offset: 32092},
 													name: "ELSE",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1188, col: 36, offset: 28683},
+													pos:  position{line: 1300, col: 36, offset: 32097},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1188, col: 38, offset: 28685},
+													pos:  position{line: 1300, col: 38, offset: 32099},
 													name: "Expr",
 												},
 											},
@@ -7753,24 +8648,24 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1188, col: 45, offset: 28692},
+									pos:  position{line: 1300, col: 45, offset: 32106},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1188, col: 47, offset: 28694},
+									pos:  position{line: 1300, col: 47, offset: 32108},
 									name: "END",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1188, col: 51, offset: 28698},
+									pos: position{line: 1300, col: 51, offset: 32112},
 									expr: &seqExpr{
-										pos: position{line: 1188, col: 52, offset: 28699},
+										pos: position{line: 1300, col: 52, offset: 32113},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1188, col: 52, offset: 28699},
+												pos:  position{line: 1300, col: 52, offset: 32113},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1188, col: 54, offset: 28701},
+												pos:  position{line: 1300, col: 54, offset: 32115},
 												name: "CASE",
 											},
 										},
@@ -7780,60 +8675,60 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1199, col: 5, offset: 28974},
+						pos: position{line: 1311, col: 5, offset: 32388},
 						run: (*parser).callonCaseExpr21,
 						expr: &seqExpr{
-							pos: position{line: 1199, col: 5, offset: 28974},
+							pos: position{line: 1311, col: 5, offset: 32388},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1199, col: 5, offset: 28974},
+									pos:  position{line: 1311, col: 5, offset: 32388},
 									name: "CASE",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1199, col: 10, offset: 28979},
+									pos:  position{line: 1311, col: 10, offset: 32393},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 1199, col: 12, offset: 28981},
+									pos:   position{line: 1311, col: 12, offset: 32395},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1199, col: 17, offset: 28986},
+										pos:  position{line: 1311, col: 17, offset: 32400},
 										name: "Expr",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1199, col: 22, offset: 28991},
+									pos:   position{line: 1311, col: 22, offset: 32405},
 									label: "whens",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1199, col: 28, offset: 28997},
+										pos: position{line: 1311, col: 28, offset: 32411},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1199, col: 28, offset: 28997},
+											pos:  position{line: 1311, col: 28, offset: 32411},
 											name: "When",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1199, col: 34, offset: 29003},
+									pos:   position{line: 1311, col: 34, offset: 32417},
 									label: "else_",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1199, col: 40, offset: 29009},
+										pos: position{line: 1311, col: 40, offset: 32423},
 										expr: &seqExpr{
-											pos: position{line: 1199, col: 41, offset: 29010},
+											pos: position{line: 1311, col: 41, offset: 32424},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1199, col: 41, offset: 29010},
+													pos:  position{line: 1311, col: 41, offset: 32424},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1199, col: 43, offset: 29012},
+													pos:  position{line: 1311, col: 43, offset: 32426},
 													name: "ELSE",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1199, col: 48, offset: 29017},
+													pos:  position{line: 1311, col: 48, offset: 32431},
 													name: "_",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1199, col: 50, offset: 29019},
+													pos:  position{line: 1311, col: 50, offset: 32433},
 													name: "Expr",
 												},
 											},
@@ -7841,24 +8736,24 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1199, col: 57, offset: 29026},
+									pos:  position{line: 1311, col: 57, offset: 32440},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1199, col: 59, offset: 29028},
+									pos:  position{line: 1311, col: 59, offset: 32442},
 									name: "END",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1199, col: 63, offset: 29032},
+									pos: position{line: 1311, col: 63, offset: 32446},
 									expr: &seqExpr{
-										pos: position{line: 1199, col: 64, offset: 29033},
+										pos: position{line: 1311, col: 64, offset: 32447},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1199, col: 64, offset: 29033},
+												pos:  position{line: 1311, col: 64, offset: 32447},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1199, col: 66, offset: 29035},
+												pos:  position{line: 1311, col: 66, offset: 32449},
 												name: "CASE",
 											},
 										},
@@ -7874,50 +8769,50 @@ var g = &grammar{
 		},
 		{
 			name: "When",
-			pos:  position{line: 1212, col: 1, offset: 29341},
+			pos:  position{line: 1324, col: 1, offset: 32755},
 			expr: &actionExpr{
-				pos: position{line: 1213, col: 5, offset: 29350},
+				pos: position{line: 1325, col: 5, offset: 32764},
 				run: (*parser).callonWhen1,
 				expr: &seqExpr{
-					pos: position{line: 1213, col: 5, offset: 29350},
+					pos: position{line: 1325, col: 5, offset: 32764},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1213, col: 5, offset: 29350},
+							pos:  position{line: 1325, col: 5, offset: 32764},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1213, col: 7, offset: 29352},
+							pos:  position{line: 1325, col: 7, offset: 32766},
 							name: "WHEN",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1213, col: 12, offset: 29357},
+							pos:  position{line: 1325, col: 12, offset: 32771},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1213, col: 14, offset: 29359},
+							pos:   position{line: 1325, col: 14, offset: 32773},
 							label: "cond",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1213, col: 19, offset: 29364},
+								pos:  position{line: 1325, col: 19, offset: 32778},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1213, col: 24, offset: 29369},
+							pos:  position{line: 1325, col: 24, offset: 32783},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1213, col: 26, offset: 29371},
+							pos:  position{line: 1325, col: 26, offset: 32785},
 							name: "THEN",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1213, col: 31, offset: 29376},
+							pos:  position{line: 1325, col: 31, offset: 32790},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1213, col: 33, offset: 29378},
+							pos:   position{line: 1325, col: 33, offset: 32792},
 							label: "then",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1213, col: 38, offset: 29383},
+								pos:  position{line: 1325, col: 38, offset: 32797},
 								name: "Expr",
 							},
 						},
@@ -7929,15 +8824,15 @@ var g = &grammar{
 		},
 		{
 			name: "SubqueryExpr",
-			pos:  position{line: 1221, col: 1, offset: 29516},
+			pos:  position{line: 1333, col: 1, offset: 32930},
 			expr: &actionExpr{
-				pos: position{line: 1222, col: 5, offset: 29533},
+				pos: position{line: 1334, col: 5, offset: 32947},
 				run: (*parser).callonSubqueryExpr1,
 				expr: &labeledExpr{
-					pos:   position{line: 1222, col: 5, offset: 29533},
+					pos:   position{line: 1334, col: 5, offset: 32947},
 					label: "body",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1222, col: 10, offset: 29538},
+						pos:  position{line: 1334, col: 10, offset: 32952},
 						name: "Query",
 					},
 				},
@@ -7947,37 +8842,37 @@ var g = &grammar{
 		},
 		{
 			name: "Record",
-			pos:  position{line: 1230, col: 1, offset: 29684},
+			pos:  position{line: 1342, col: 1, offset: 33098},
 			expr: &actionExpr{
-				pos: position{line: 1231, col: 5, offset: 29695},
+				pos: position{line: 1343, col: 5, offset: 33109},
 				run: (*parser).callonRecord1,
 				expr: &seqExpr{
-					pos: position{line: 1231, col: 5, offset: 29695},
+					pos: position{line: 1343, col: 5, offset: 33109},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1231, col: 5, offset: 29695},
+							pos:        position{line: 1343, col: 5, offset: 33109},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1231, col: 9, offset: 29699},
+							pos:  position{line: 1343, col: 9, offset: 33113},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1231, col: 12, offset: 29702},
+							pos:   position{line: 1343, col: 12, offset: 33116},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1231, col: 18, offset: 29708},
+								pos:  position{line: 1343, col: 18, offset: 33122},
 								name: "RecordElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1231, col: 30, offset: 29720},
+							pos:  position{line: 1343, col: 30, offset: 33134},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1231, col: 33, offset: 29723},
+							pos:        position{line: 1343, col: 33, offset: 33137},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -7990,31 +8885,31 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElems",
-			pos:  position{line: 1239, col: 1, offset: 29881},
+			pos:  position{line: 1351, col: 1, offset: 33295},
 			expr: &choiceExpr{
-				pos: position{line: 1240, col: 5, offset: 29897},
+				pos: position{line: 1352, col: 5, offset: 33311},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1240, col: 5, offset: 29897},
+						pos: position{line: 1352, col: 5, offset: 33311},
 						run: (*parser).callonRecordElems2,
 						expr: &seqExpr{
-							pos: position{line: 1240, col: 5, offset: 29897},
+							pos: position{line: 1352, col: 5, offset: 33311},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1240, col: 5, offset: 29897},
+									pos:   position{line: 1352, col: 5, offset: 33311},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1240, col: 11, offset: 29903},
+										pos:  position{line: 1352, col: 11, offset: 33317},
 										name: "RecordElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1240, col: 22, offset: 29914},
+									pos:   position{line: 1352, col: 22, offset: 33328},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1240, col: 27, offset: 29919},
+										pos: position{line: 1352, col: 27, offset: 33333},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1240, col: 27, offset: 29919},
+											pos:  position{line: 1352, col: 27, offset: 33333},
 											name: "RecordElemTail",
 										},
 									},
@@ -8023,10 +8918,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1243, col: 5, offset: 29982},
+						pos: position{line: 1355, col: 5, offset: 33396},
 						run: (*parser).callonRecordElems9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1243, col: 5, offset: 29982},
+							pos:  position{line: 1355, col: 5, offset: 33396},
 							name: "__",
 						},
 					},
@@ -8037,32 +8932,32 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElemTail",
-			pos:  position{line: 1245, col: 1, offset: 30006},
+			pos:  position{line: 1357, col: 1, offset: 33420},
 			expr: &actionExpr{
-				pos: position{line: 1245, col: 18, offset: 30023},
+				pos: position{line: 1357, col: 18, offset: 33437},
 				run: (*parser).callonRecordElemTail1,
 				expr: &seqExpr{
-					pos: position{line: 1245, col: 18, offset: 30023},
+					pos: position{line: 1357, col: 18, offset: 33437},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1245, col: 18, offset: 30023},
+							pos:  position{line: 1357, col: 18, offset: 33437},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1245, col: 21, offset: 30026},
+							pos:        position{line: 1357, col: 21, offset: 33440},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1245, col: 25, offset: 30030},
+							pos:  position{line: 1357, col: 25, offset: 33444},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1245, col: 28, offset: 30033},
+							pos:   position{line: 1357, col: 28, offset: 33447},
 							label: "elem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1245, col: 33, offset: 30038},
+								pos:  position{line: 1357, col: 33, offset: 33452},
 								name: "RecordElem",
 							},
 						},
@@ -8074,20 +8969,20 @@ var g = &grammar{
 		},
 		{
 			name: "RecordElem",
-			pos:  position{line: 1247, col: 1, offset: 30071},
+			pos:  position{line: 1359, col: 1, offset: 33485},
 			expr: &choiceExpr{
-				pos: position{line: 1247, col: 14, offset: 30084},
+				pos: position{line: 1359, col: 14, offset: 33498},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1247, col: 14, offset: 30084},
+						pos:  position{line: 1359, col: 14, offset: 33498},
 						name: "SpreadElem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1247, col: 27, offset: 30097},
+						pos:  position{line: 1359, col: 27, offset: 33511},
 						name: "FieldElem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1247, col: 39, offset: 30109},
+						pos:  position{line: 1359, col: 39, offset: 33523},
 						name: "ExprElem",
 					},
 				},
@@ -8097,28 +8992,28 @@ var g = &grammar{
 		},
 		{
 			name: "SpreadElem",
-			pos:  position{line: 1249, col: 1, offset: 30119},
+			pos:  position{line: 1361, col: 1, offset: 33533},
 			expr: &actionExpr{
-				pos: position{line: 1250, col: 5, offset: 30134},
+				pos: position{line: 1362, col: 5, offset: 33548},
 				run: (*parser).callonSpreadElem1,
 				expr: &seqExpr{
-					pos: position{line: 1250, col: 5, offset: 30134},
+					pos: position{line: 1362, col: 5, offset: 33548},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1250, col: 5, offset: 30134},
+							pos:        position{line: 1362, col: 5, offset: 33548},
 							val:        "...",
 							ignoreCase: false,
 							want:       "\"...\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1250, col: 11, offset: 30140},
+							pos:  position{line: 1362, col: 11, offset: 33554},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1250, col: 14, offset: 30143},
+							pos:   position{line: 1362, col: 14, offset: 33557},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1250, col: 19, offset: 30148},
+								pos:  position{line: 1362, col: 19, offset: 33562},
 								name: "Expr",
 							},
 						},
@@ -8130,40 +9025,40 @@ var g = &grammar{
 		},
 		{
 			name: "FieldElem",
-			pos:  position{line: 1254, col: 1, offset: 30252},
+			pos:  position{line: 1366, col: 1, offset: 33666},
 			expr: &actionExpr{
-				pos: position{line: 1255, col: 5, offset: 30266},
+				pos: position{line: 1367, col: 5, offset: 33680},
 				run: (*parser).callonFieldElem1,
 				expr: &seqExpr{
-					pos: position{line: 1255, col: 5, offset: 30266},
+					pos: position{line: 1367, col: 5, offset: 33680},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1255, col: 5, offset: 30266},
+							pos:   position{line: 1367, col: 5, offset: 33680},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1255, col: 10, offset: 30271},
+								pos:  position{line: 1367, col: 10, offset: 33685},
 								name: "Name",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1255, col: 15, offset: 30276},
+							pos:  position{line: 1367, col: 15, offset: 33690},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1255, col: 18, offset: 30279},
+							pos:        position{line: 1367, col: 18, offset: 33693},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1255, col: 22, offset: 30283},
+							pos:  position{line: 1367, col: 22, offset: 33697},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1255, col: 25, offset: 30286},
+							pos:   position{line: 1367, col: 25, offset: 33700},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1255, col: 31, offset: 30292},
+								pos:  position{line: 1367, col: 31, offset: 33706},
 								name: "Expr",
 							},
 						},
@@ -8175,15 +9070,15 @@ var g = &grammar{
 		},
 		{
 			name: "ExprElem",
-			pos:  position{line: 1264, col: 1, offset: 30461},
+			pos:  position{line: 1376, col: 1, offset: 33875},
 			expr: &actionExpr{
-				pos: position{line: 1265, col: 5, offset: 30474},
+				pos: position{line: 1377, col: 5, offset: 33888},
 				run: (*parser).callonExprElem1,
 				expr: &labeledExpr{
-					pos:   position{line: 1265, col: 5, offset: 30474},
+					pos:   position{line: 1377, col: 5, offset: 33888},
 					label: "expr",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1265, col: 10, offset: 30479},
+						pos:  position{line: 1377, col: 10, offset: 33893},
 						name: "Expr",
 					},
 				},
@@ -8193,37 +9088,37 @@ var g = &grammar{
 		},
 		{
 			name: "Array",
-			pos:  position{line: 1269, col: 1, offset: 30579},
+			pos:  position{line: 1381, col: 1, offset: 33993},
 			expr: &actionExpr{
-				pos: position{line: 1270, col: 5, offset: 30589},
+				pos: position{line: 1382, col: 5, offset: 34003},
 				run: (*parser).callonArray1,
 				expr: &seqExpr{
-					pos: position{line: 1270, col: 5, offset: 30589},
+					pos: position{line: 1382, col: 5, offset: 34003},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1270, col: 5, offset: 30589},
+							pos:        position{line: 1382, col: 5, offset: 34003},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1270, col: 9, offset: 30593},
+							pos:  position{line: 1382, col: 9, offset: 34007},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1270, col: 12, offset: 30596},
+							pos:   position{line: 1382, col: 12, offset: 34010},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1270, col: 18, offset: 30602},
+								pos:  position{line: 1382, col: 18, offset: 34016},
 								name: "ArrayElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1270, col: 29, offset: 30613},
+							pos:  position{line: 1382, col: 29, offset: 34027},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1270, col: 32, offset: 30616},
+							pos:        position{line: 1382, col: 32, offset: 34030},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -8236,37 +9131,37 @@ var g = &grammar{
 		},
 		{
 			name: "Set",
-			pos:  position{line: 1278, col: 1, offset: 30771},
+			pos:  position{line: 1390, col: 1, offset: 34185},
 			expr: &actionExpr{
-				pos: position{line: 1279, col: 5, offset: 30779},
+				pos: position{line: 1391, col: 5, offset: 34193},
 				run: (*parser).callonSet1,
 				expr: &seqExpr{
-					pos: position{line: 1279, col: 5, offset: 30779},
+					pos: position{line: 1391, col: 5, offset: 34193},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1279, col: 5, offset: 30779},
+							pos:        position{line: 1391, col: 5, offset: 34193},
 							val:        "|[",
 							ignoreCase: false,
 							want:       "\"|[\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1279, col: 10, offset: 30784},
+							pos:  position{line: 1391, col: 10, offset: 34198},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1279, col: 13, offset: 30787},
+							pos:   position{line: 1391, col: 13, offset: 34201},
 							label: "elems",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1279, col: 19, offset: 30793},
+								pos:  position{line: 1391, col: 19, offset: 34207},
 								name: "ArrayElems",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1279, col: 30, offset: 30804},
+							pos:  position{line: 1391, col: 30, offset: 34218},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1279, col: 33, offset: 30807},
+							pos:        position{line: 1391, col: 33, offset: 34221},
 							val:        "]|",
 							ignoreCase: false,
 							want:       "\"]|\"",
@@ -8279,54 +9174,54 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayElems",
-			pos:  position{line: 1287, col: 1, offset: 30959},
+			pos:  position{line: 1399, col: 1, offset: 34373},
 			expr: &choiceExpr{
-				pos: position{line: 1288, col: 5, offset: 30974},
+				pos: position{line: 1400, col: 5, offset: 34388},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1288, col: 5, offset: 30974},
+						pos: position{line: 1400, col: 5, offset: 34388},
 						run: (*parser).callonArrayElems2,
 						expr: &seqExpr{
-							pos: position{line: 1288, col: 5, offset: 30974},
+							pos: position{line: 1400, col: 5, offset: 34388},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1288, col: 5, offset: 30974},
+									pos:   position{line: 1400, col: 5, offset: 34388},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1288, col: 11, offset: 30980},
+										pos:  position{line: 1400, col: 11, offset: 34394},
 										name: "ArrayElem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1288, col: 21, offset: 30990},
+									pos:   position{line: 1400, col: 21, offset: 34404},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1288, col: 26, offset: 30995},
+										pos: position{line: 1400, col: 26, offset: 34409},
 										expr: &actionExpr{
-											pos: position{line: 1288, col: 27, offset: 30996},
+											pos: position{line: 1400, col: 27, offset: 34410},
 											run: (*parser).callonArrayElems8,
 											expr: &seqExpr{
-												pos: position{line: 1288, col: 27, offset: 30996},
+												pos: position{line: 1400, col: 27, offset: 34410},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 1288, col: 27, offset: 30996},
+														pos:  position{line: 1400, col: 27, offset: 34410},
 														name: "__",
 													},
 													&litMatcher{
-														pos:        position{line: 1288, col: 30, offset: 30999},
+														pos:        position{line: 1400, col: 30, offset: 34413},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 1288, col: 34, offset: 31003},
+														pos:  position{line: 1400, col: 34, offset: 34417},
 														name: "__",
 													},
 													&labeledExpr{
-														pos:   position{line: 1288, col: 37, offset: 31006},
+														pos:   position{line: 1400, col: 37, offset: 34420},
 														label: "e",
 														expr: &ruleRefExpr{
-															pos:  position{line: 1288, col: 39, offset: 31008},
+															pos:  position{line: 1400, col: 39, offset: 34422},
 															name: "ArrayElem",
 														},
 													},
@@ -8339,10 +9234,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1291, col: 5, offset: 31089},
+						pos: position{line: 1403, col: 5, offset: 34503},
 						run: (*parser).callonArrayElems15,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1291, col: 5, offset: 31089},
+							pos:  position{line: 1403, col: 5, offset: 34503},
 							name: "__",
 						},
 					},
@@ -8353,16 +9248,16 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayElem",
-			pos:  position{line: 1293, col: 1, offset: 31113},
+			pos:  position{line: 1405, col: 1, offset: 34527},
 			expr: &choiceExpr{
-				pos: position{line: 1293, col: 13, offset: 31125},
+				pos: position{line: 1405, col: 13, offset: 34539},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1293, col: 13, offset: 31125},
+						pos:  position{line: 1405, col: 13, offset: 34539},
 						name: "SpreadElem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1293, col: 26, offset: 31138},
+						pos:  position{line: 1405, col: 26, offset: 34552},
 						name: "ExprElem",
 					},
 				},
@@ -8372,37 +9267,37 @@ var g = &grammar{
 		},
 		{
 			name: "Map",
-			pos:  position{line: 1295, col: 1, offset: 31148},
+			pos:  position{line: 1407, col: 1, offset: 34562},
 			expr: &actionExpr{
-				pos: position{line: 1296, col: 5, offset: 31156},
+				pos: position{line: 1408, col: 5, offset: 34570},
 				run: (*parser).callonMap1,
 				expr: &seqExpr{
-					pos: position{line: 1296, col: 5, offset: 31156},
+					pos: position{line: 1408, col: 5, offset: 34570},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1296, col: 5, offset: 31156},
+							pos:        position{line: 1408, col: 5, offset: 34570},
 							val:        "|{",
 							ignoreCase: false,
 							want:       "\"|{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1296, col: 10, offset: 31161},
+							pos:  position{line: 1408, col: 10, offset: 34575},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1296, col: 13, offset: 31164},
+							pos:   position{line: 1408, col: 13, offset: 34578},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1296, col: 19, offset: 31170},
+								pos:  position{line: 1408, col: 19, offset: 34584},
 								name: "Entries",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1296, col: 27, offset: 31178},
+							pos:  position{line: 1408, col: 27, offset: 34592},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1296, col: 30, offset: 31181},
+							pos:        position{line: 1408, col: 30, offset: 34595},
 							val:        "}|",
 							ignoreCase: false,
 							want:       "\"}|\"",
@@ -8415,31 +9310,31 @@ var g = &grammar{
 		},
 		{
 			name: "Entries",
-			pos:  position{line: 1304, col: 1, offset: 31334},
+			pos:  position{line: 1416, col: 1, offset: 34748},
 			expr: &choiceExpr{
-				pos: position{line: 1305, col: 5, offset: 31346},
+				pos: position{line: 1417, col: 5, offset: 34760},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1305, col: 5, offset: 31346},
+						pos: position{line: 1417, col: 5, offset: 34760},
 						run: (*parser).callonEntries2,
 						expr: &seqExpr{
-							pos: position{line: 1305, col: 5, offset: 31346},
+							pos: position{line: 1417, col: 5, offset: 34760},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1305, col: 5, offset: 31346},
+									pos:   position{line: 1417, col: 5, offset: 34760},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1305, col: 11, offset: 31352},
+										pos:  position{line: 1417, col: 11, offset: 34766},
 										name: "Entry",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1305, col: 17, offset: 31358},
+									pos:   position{line: 1417, col: 17, offset: 34772},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1305, col: 22, offset: 31363},
+										pos: position{line: 1417, col: 22, offset: 34777},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1305, col: 22, offset: 31363},
+											pos:  position{line: 1417, col: 22, offset: 34777},
 											name: "EntryTail",
 										},
 									},
@@ -8448,10 +9343,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1308, col: 5, offset: 31421},
+						pos: position{line: 1420, col: 5, offset: 34835},
 						run: (*parser).callonEntries9,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1308, col: 5, offset: 31421},
+							pos:  position{line: 1420, col: 5, offset: 34835},
 							name: "__",
 						},
 					},
@@ -8462,32 +9357,32 @@ var g = &grammar{
 		},
 		{
 			name: "EntryTail",
-			pos:  position{line: 1311, col: 1, offset: 31446},
+			pos:  position{line: 1423, col: 1, offset: 34860},
 			expr: &actionExpr{
-				pos: position{line: 1311, col: 13, offset: 31458},
+				pos: position{line: 1423, col: 13, offset: 34872},
 				run: (*parser).callonEntryTail1,
 				expr: &seqExpr{
-					pos: position{line: 1311, col: 13, offset: 31458},
+					pos: position{line: 1423, col: 13, offset: 34872},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1311, col: 13, offset: 31458},
+							pos:  position{line: 1423, col: 13, offset: 34872},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1311, col: 16, offset: 31461},
+							pos:        position{line: 1423, col: 16, offset: 34875},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1311, col: 20, offset: 31465},
+							pos:  position{line: 1423, col: 20, offset: 34879},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1311, col: 23, offset: 31468},
+							pos:   position{line: 1423, col: 23, offset: 34882},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1311, col: 25, offset: 31470},
+								pos:  position{line: 1423, col: 25, offset: 34884},
 								name: "Entry",
 							},
 						},
@@ -8499,40 +9394,40 @@ var g = &grammar{
 		},
 		{
 			name: "Entry",
-			pos:  position{line: 1313, col: 1, offset: 31495},
+			pos:  position{line: 1425, col: 1, offset: 34909},
 			expr: &actionExpr{
-				pos: position{line: 1314, col: 5, offset: 31505},
+				pos: position{line: 1426, col: 5, offset: 34919},
 				run: (*parser).callonEntry1,
 				expr: &seqExpr{
-					pos: position{line: 1314, col: 5, offset: 31505},
+					pos: position{line: 1426, col: 5, offset: 34919},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1314, col: 5, offset: 31505},
+							pos:   position{line: 1426, col: 5, offset: 34919},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1314, col: 9, offset: 31509},
+								pos:  position{line: 1426, col: 9, offset: 34923},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1314, col: 14, offset: 31514},
+							pos:  position{line: 1426, col: 14, offset: 34928},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1314, col: 17, offset: 31517},
+							pos:        position{line: 1426, col: 17, offset: 34931},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1314, col: 21, offset: 31521},
+							pos:  position{line: 1426, col: 21, offset: 34935},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1314, col: 24, offset: 31524},
+							pos:   position{line: 1426, col: 24, offset: 34938},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1314, col: 30, offset: 31530},
+								pos:  position{line: 1426, col: 30, offset: 34944},
 								name: "Expr",
 							},
 						},
@@ -8544,61 +9439,61 @@ var g = &grammar{
 		},
 		{
 			name: "Tuple",
-			pos:  position{line: 1318, col: 1, offset: 31632},
+			pos:  position{line: 1430, col: 1, offset: 35046},
 			expr: &actionExpr{
-				pos: position{line: 1319, col: 5, offset: 31642},
+				pos: position{line: 1431, col: 5, offset: 35056},
 				run: (*parser).callonTuple1,
 				expr: &seqExpr{
-					pos: position{line: 1319, col: 5, offset: 31642},
+					pos: position{line: 1431, col: 5, offset: 35056},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1319, col: 5, offset: 31642},
+							pos:        position{line: 1431, col: 5, offset: 35056},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1319, col: 9, offset: 31646},
+							pos:  position{line: 1431, col: 9, offset: 35060},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1319, col: 12, offset: 31649},
+							pos:   position{line: 1431, col: 12, offset: 35063},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1319, col: 18, offset: 31655},
+								pos:  position{line: 1431, col: 18, offset: 35069},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1319, col: 23, offset: 31660},
+							pos:   position{line: 1431, col: 23, offset: 35074},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1319, col: 28, offset: 31665},
+								pos: position{line: 1431, col: 28, offset: 35079},
 								expr: &actionExpr{
-									pos: position{line: 1319, col: 29, offset: 31666},
+									pos: position{line: 1431, col: 29, offset: 35080},
 									run: (*parser).callonTuple9,
 									expr: &seqExpr{
-										pos: position{line: 1319, col: 29, offset: 31666},
+										pos: position{line: 1431, col: 29, offset: 35080},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1319, col: 29, offset: 31666},
+												pos:  position{line: 1431, col: 29, offset: 35080},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1319, col: 32, offset: 31669},
+												pos:        position{line: 1431, col: 32, offset: 35083},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1319, col: 36, offset: 31673},
+												pos:  position{line: 1431, col: 36, offset: 35087},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1319, col: 39, offset: 31676},
+												pos:   position{line: 1431, col: 39, offset: 35090},
 												label: "e",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1319, col: 41, offset: 31678},
+													pos:  position{line: 1431, col: 41, offset: 35092},
 													name: "Expr",
 												},
 											},
@@ -8608,11 +9503,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1319, col: 66, offset: 31703},
+							pos:  position{line: 1431, col: 66, offset: 35117},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1319, col: 69, offset: 31706},
+							pos:        position{line: 1431, col: 69, offset: 35120},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -8625,39 +9520,39 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTimeExpr",
-			pos:  position{line: 1327, col: 1, offset: 31865},
+			pos:  position{line: 1439, col: 1, offset: 35279},
 			expr: &actionExpr{
-				pos: position{line: 1328, col: 5, offset: 31881},
+				pos: position{line: 1440, col: 5, offset: 35295},
 				run: (*parser).callonSQLTimeExpr1,
 				expr: &seqExpr{
-					pos: position{line: 1328, col: 5, offset: 31881},
+					pos: position{line: 1440, col: 5, offset: 35295},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1328, col: 5, offset: 31881},
+							pos:   position{line: 1440, col: 5, offset: 35295},
 							label: "typ",
 							expr: &choiceExpr{
-								pos: position{line: 1328, col: 10, offset: 31886},
+								pos: position{line: 1440, col: 10, offset: 35300},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1328, col: 10, offset: 31886},
+										pos:  position{line: 1440, col: 10, offset: 35300},
 										name: "DATE",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1328, col: 17, offset: 31893},
+										pos:  position{line: 1440, col: 17, offset: 35307},
 										name: "TIMESTAMP",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1328, col: 28, offset: 31904},
+							pos:  position{line: 1440, col: 28, offset: 35318},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 1328, col: 30, offset: 31906},
+							pos:   position{line: 1440, col: 30, offset: 35320},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1328, col: 32, offset: 31908},
+								pos:  position{line: 1440, col: 32, offset: 35322},
 								name: "StringLiteral",
 							},
 						},
@@ -8669,56 +9564,56 @@ var g = &grammar{
 		},
 		{
 			name: "Literal",
-			pos:  position{line: 1339, col: 1, offset: 32123},
+			pos:  position{line: 1451, col: 1, offset: 35537},
 			expr: &choiceExpr{
-				pos: position{line: 1340, col: 5, offset: 32135},
+				pos: position{line: 1452, col: 5, offset: 35549},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1340, col: 5, offset: 32135},
+						pos:  position{line: 1452, col: 5, offset: 35549},
 						name: "TypeLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1341, col: 5, offset: 32151},
+						pos:  position{line: 1453, col: 5, offset: 35565},
 						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1342, col: 5, offset: 32169},
+						pos:  position{line: 1454, col: 5, offset: 35583},
 						name: "FString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1343, col: 5, offset: 32181},
+						pos:  position{line: 1455, col: 5, offset: 35595},
 						name: "SubnetLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1344, col: 5, offset: 32199},
+						pos:  position{line: 1456, col: 5, offset: 35613},
 						name: "AddressLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1345, col: 5, offset: 32218},
+						pos:  position{line: 1457, col: 5, offset: 35632},
 						name: "BytesLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1346, col: 5, offset: 32235},
+						pos:  position{line: 1458, col: 5, offset: 35649},
 						name: "Duration",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1347, col: 5, offset: 32248},
+						pos:  position{line: 1459, col: 5, offset: 35662},
 						name: "Time",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1348, col: 5, offset: 32257},
+						pos:  position{line: 1460, col: 5, offset: 35671},
 						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1349, col: 5, offset: 32274},
+						pos:  position{line: 1461, col: 5, offset: 35688},
 						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1350, col: 5, offset: 32293},
+						pos:  position{line: 1462, col: 5, offset: 35707},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1351, col: 5, offset: 32312},
+						pos:  position{line: 1463, col: 5, offset: 35726},
 						name: "NullLiteral",
 					},
 				},
@@ -8728,28 +9623,28 @@ var g = &grammar{
 		},
 		{
 			name: "SubnetLiteral",
-			pos:  position{line: 1353, col: 1, offset: 32325},
+			pos:  position{line: 1465, col: 1, offset: 35739},
 			expr: &choiceExpr{
-				pos: position{line: 1354, col: 5, offset: 32343},
+				pos: position{line: 1466, col: 5, offset: 35757},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1354, col: 5, offset: 32343},
+						pos: position{line: 1466, col: 5, offset: 35757},
 						run: (*parser).callonSubnetLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1354, col: 5, offset: 32343},
+							pos: position{line: 1466, col: 5, offset: 35757},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1354, col: 5, offset: 32343},
+									pos:   position{line: 1466, col: 5, offset: 35757},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1354, col: 7, offset: 32345},
+										pos:  position{line: 1466, col: 7, offset: 35759},
 										name: "IP6Net",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1354, col: 14, offset: 32352},
+									pos: position{line: 1466, col: 14, offset: 35766},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1354, col: 15, offset: 32353},
+										pos:  position{line: 1466, col: 15, offset: 35767},
 										name: "IdentifierRest",
 									},
 								},
@@ -8757,13 +9652,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1357, col: 5, offset: 32433},
+						pos: position{line: 1469, col: 5, offset: 35847},
 						run: (*parser).callonSubnetLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1357, col: 5, offset: 32433},
+							pos:   position{line: 1469, col: 5, offset: 35847},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1357, col: 7, offset: 32435},
+								pos:  position{line: 1469, col: 7, offset: 35849},
 								name: "IP4Net",
 							},
 						},
@@ -8775,35 +9670,35 @@ var g = &grammar{
 		},
 		{
 			name: "AddressLiteral",
-			pos:  position{line: 1361, col: 1, offset: 32504},
+			pos:  position{line: 1473, col: 1, offset: 35918},
 			expr: &choiceExpr{
-				pos: position{line: 1362, col: 5, offset: 32523},
+				pos: position{line: 1474, col: 5, offset: 35937},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1362, col: 5, offset: 32523},
+						pos: position{line: 1474, col: 5, offset: 35937},
 						run: (*parser).callonAddressLiteral2,
 						expr: &seqExpr{
-							pos: position{line: 1362, col: 5, offset: 32523},
+							pos: position{line: 1474, col: 5, offset: 35937},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1362, col: 5, offset: 32523},
+									pos:   position{line: 1474, col: 5, offset: 35937},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1362, col: 7, offset: 32525},
+										pos:  position{line: 1474, col: 7, offset: 35939},
 										name: "IP6",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1362, col: 11, offset: 32529},
+									pos: position{line: 1474, col: 11, offset: 35943},
 									expr: &choiceExpr{
-										pos: position{line: 1362, col: 13, offset: 32531},
+										pos: position{line: 1474, col: 13, offset: 35945},
 										alternatives: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1362, col: 13, offset: 32531},
+												pos:  position{line: 1474, col: 13, offset: 35945},
 												name: "IdentifierRest",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1362, col: 30, offset: 32548},
+												pos:  position{line: 1474, col: 30, offset: 35962},
 												name: "TypeLiteral",
 											},
 										},
@@ -8813,13 +9708,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1365, col: 5, offset: 32625},
+						pos: position{line: 1477, col: 5, offset: 36039},
 						run: (*parser).callonAddressLiteral10,
 						expr: &labeledExpr{
-							pos:   position{line: 1365, col: 5, offset: 32625},
+							pos:   position{line: 1477, col: 5, offset: 36039},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1365, col: 7, offset: 32627},
+								pos:  position{line: 1477, col: 7, offset: 36041},
 								name: "IP",
 							},
 						},
@@ -8831,15 +9726,15 @@ var g = &grammar{
 		},
 		{
 			name: "FloatLiteral",
-			pos:  position{line: 1369, col: 1, offset: 32691},
+			pos:  position{line: 1481, col: 1, offset: 36105},
 			expr: &actionExpr{
-				pos: position{line: 1370, col: 5, offset: 32708},
+				pos: position{line: 1482, col: 5, offset: 36122},
 				run: (*parser).callonFloatLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1370, col: 5, offset: 32708},
+					pos:   position{line: 1482, col: 5, offset: 36122},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1370, col: 7, offset: 32710},
+						pos:  position{line: 1482, col: 7, offset: 36124},
 						name: "FloatString",
 					},
 				},
@@ -8849,15 +9744,15 @@ var g = &grammar{
 		},
 		{
 			name: "IntegerLiteral",
-			pos:  position{line: 1374, col: 1, offset: 32788},
+			pos:  position{line: 1486, col: 1, offset: 36202},
 			expr: &actionExpr{
-				pos: position{line: 1375, col: 5, offset: 32807},
+				pos: position{line: 1487, col: 5, offset: 36221},
 				run: (*parser).callonIntegerLiteral1,
 				expr: &labeledExpr{
-					pos:   position{line: 1375, col: 5, offset: 32807},
+					pos:   position{line: 1487, col: 5, offset: 36221},
 					label: "v",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1375, col: 7, offset: 32809},
+						pos:  position{line: 1487, col: 7, offset: 36223},
 						name: "IntString",
 					},
 				},
@@ -8867,23 +9762,23 @@ var g = &grammar{
 		},
 		{
 			name: "BooleanLiteral",
-			pos:  position{line: 1379, col: 1, offset: 32883},
+			pos:  position{line: 1491, col: 1, offset: 36297},
 			expr: &choiceExpr{
-				pos: position{line: 1380, col: 5, offset: 32902},
+				pos: position{line: 1492, col: 5, offset: 36316},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1380, col: 5, offset: 32902},
+						pos: position{line: 1492, col: 5, offset: 36316},
 						run: (*parser).callonBooleanLiteral2,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1380, col: 5, offset: 32902},
+							pos:  position{line: 1492, col: 5, offset: 36316},
 							name: "TRUE",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1381, col: 5, offset: 32960},
+						pos: position{line: 1493, col: 5, offset: 36374},
 						run: (*parser).callonBooleanLiteral4,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1381, col: 5, offset: 32960},
+							pos:  position{line: 1493, col: 5, offset: 36374},
 							name: "FALSE",
 						},
 					},
@@ -8894,12 +9789,12 @@ var g = &grammar{
 		},
 		{
 			name: "NullLiteral",
-			pos:  position{line: 1383, col: 1, offset: 33016},
+			pos:  position{line: 1495, col: 1, offset: 36430},
 			expr: &actionExpr{
-				pos: position{line: 1384, col: 5, offset: 33032},
+				pos: position{line: 1496, col: 5, offset: 36446},
 				run: (*parser).callonNullLiteral1,
 				expr: &ruleRefExpr{
-					pos:  position{line: 1384, col: 5, offset: 33032},
+					pos:  position{line: 1496, col: 5, offset: 36446},
 					name: "NULL",
 				},
 			},
@@ -8908,23 +9803,23 @@ var g = &grammar{
 		},
 		{
 			name: "BytesLiteral",
-			pos:  position{line: 1386, col: 1, offset: 33082},
+			pos:  position{line: 1498, col: 1, offset: 36496},
 			expr: &actionExpr{
-				pos: position{line: 1387, col: 5, offset: 33099},
+				pos: position{line: 1499, col: 5, offset: 36513},
 				run: (*parser).callonBytesLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1387, col: 5, offset: 33099},
+					pos: position{line: 1499, col: 5, offset: 36513},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1387, col: 5, offset: 33099},
+							pos:        position{line: 1499, col: 5, offset: 36513},
 							val:        "0x",
 							ignoreCase: false,
 							want:       "\"0x\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1387, col: 10, offset: 33104},
+							pos: position{line: 1499, col: 10, offset: 36518},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1387, col: 10, offset: 33104},
+								pos:  position{line: 1499, col: 10, offset: 36518},
 								name: "HexDigit",
 							},
 						},
@@ -8936,29 +9831,29 @@ var g = &grammar{
 		},
 		{
 			name: "TypeLiteral",
-			pos:  position{line: 1391, col: 1, offset: 33178},
+			pos:  position{line: 1503, col: 1, offset: 36592},
 			expr: &actionExpr{
-				pos: position{line: 1392, col: 5, offset: 33194},
+				pos: position{line: 1504, col: 5, offset: 36608},
 				run: (*parser).callonTypeLiteral1,
 				expr: &seqExpr{
-					pos: position{line: 1392, col: 5, offset: 33194},
+					pos: position{line: 1504, col: 5, offset: 36608},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1392, col: 5, offset: 33194},
+							pos:        position{line: 1504, col: 5, offset: 36608},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1392, col: 9, offset: 33198},
+							pos:   position{line: 1504, col: 9, offset: 36612},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1392, col: 13, offset: 33202},
+								pos:  position{line: 1504, col: 13, offset: 36616},
 								name: "Type",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1392, col: 18, offset: 33207},
+							pos:        position{line: 1504, col: 18, offset: 36621},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
@@ -8971,27 +9866,27 @@ var g = &grammar{
 		},
 		{
 			name: "TypeAsValue",
-			pos:  position{line: 1400, col: 1, offset: 33340},
+			pos:  position{line: 1512, col: 1, offset: 36754},
 			expr: &choiceExpr{
-				pos: position{line: 1401, col: 5, offset: 33356},
+				pos: position{line: 1513, col: 5, offset: 36770},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1401, col: 5, offset: 33356},
+						pos: position{line: 1513, col: 5, offset: 36770},
 						run: (*parser).callonTypeAsValue2,
 						expr: &seqExpr{
-							pos: position{line: 1401, col: 5, offset: 33356},
+							pos: position{line: 1513, col: 5, offset: 36770},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1401, col: 5, offset: 33356},
+									pos:        position{line: 1513, col: 5, offset: 36770},
 									val:        "=",
 									ignoreCase: false,
 									want:       "\"=\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1401, col: 9, offset: 33360},
+									pos:   position{line: 1513, col: 9, offset: 36774},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1401, col: 14, offset: 33365},
+										pos:  position{line: 1513, col: 14, offset: 36779},
 										name: "Name",
 									},
 								},
@@ -8999,13 +9894,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1402, col: 5, offset: 33439},
+						pos: position{line: 1514, col: 5, offset: 36853},
 						run: (*parser).callonTypeAsValue7,
 						expr: &labeledExpr{
-							pos:   position{line: 1402, col: 5, offset: 33439},
+							pos:   position{line: 1514, col: 5, offset: 36853},
 							label: "t",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1402, col: 7, offset: 33441},
+								pos:  position{line: 1514, col: 7, offset: 36855},
 								name: "EasyType",
 							},
 						},
@@ -9017,16 +9912,16 @@ var g = &grammar{
 		},
 		{
 			name: "Type",
-			pos:  position{line: 1410, col: 1, offset: 33577},
+			pos:  position{line: 1522, col: 1, offset: 36991},
 			expr: &choiceExpr{
-				pos: position{line: 1411, col: 5, offset: 33586},
+				pos: position{line: 1523, col: 5, offset: 37000},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1411, col: 5, offset: 33586},
+						pos:  position{line: 1523, col: 5, offset: 37000},
 						name: "TypeUnion",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1412, col: 5, offset: 33600},
+						pos:  position{line: 1524, col: 5, offset: 37014},
 						name: "ComponentType",
 					},
 				},
@@ -9036,52 +9931,52 @@ var g = &grammar{
 		},
 		{
 			name: "ComponentType",
-			pos:  position{line: 1414, col: 1, offset: 33615},
+			pos:  position{line: 1526, col: 1, offset: 37029},
 			expr: &choiceExpr{
-				pos: position{line: 1415, col: 5, offset: 33633},
+				pos: position{line: 1527, col: 5, offset: 37047},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1415, col: 5, offset: 33633},
+						pos:  position{line: 1527, col: 5, offset: 37047},
 						name: "EasyType",
 					},
 					&actionExpr{
-						pos: position{line: 1416, col: 5, offset: 33646},
+						pos: position{line: 1528, col: 5, offset: 37060},
 						run: (*parser).callonComponentType3,
 						expr: &seqExpr{
-							pos: position{line: 1416, col: 5, offset: 33646},
+							pos: position{line: 1528, col: 5, offset: 37060},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1416, col: 5, offset: 33646},
+									pos:   position{line: 1528, col: 5, offset: 37060},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1416, col: 10, offset: 33651},
+										pos:  position{line: 1528, col: 10, offset: 37065},
 										name: "Name",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1416, col: 15, offset: 33656},
+									pos:   position{line: 1528, col: 15, offset: 37070},
 									label: "opt",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1416, col: 19, offset: 33660},
+										pos: position{line: 1528, col: 19, offset: 37074},
 										expr: &seqExpr{
-											pos: position{line: 1416, col: 20, offset: 33661},
+											pos: position{line: 1528, col: 20, offset: 37075},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1416, col: 20, offset: 33661},
+													pos:  position{line: 1528, col: 20, offset: 37075},
 													name: "__",
 												},
 												&litMatcher{
-													pos:        position{line: 1416, col: 23, offset: 33664},
+													pos:        position{line: 1528, col: 23, offset: 37078},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1416, col: 27, offset: 33668},
+													pos:  position{line: 1528, col: 27, offset: 37082},
 													name: "__",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1416, col: 30, offset: 33671},
+													pos:  position{line: 1528, col: 30, offset: 37085},
 													name: "Type",
 												},
 											},
@@ -9098,40 +9993,40 @@ var g = &grammar{
 		},
 		{
 			name: "EasyType",
-			pos:  position{line: 1428, col: 1, offset: 33993},
+			pos:  position{line: 1540, col: 1, offset: 37407},
 			expr: &choiceExpr{
-				pos: position{line: 1429, col: 5, offset: 34006},
+				pos: position{line: 1541, col: 5, offset: 37420},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1429, col: 5, offset: 34006},
+						pos: position{line: 1541, col: 5, offset: 37420},
 						run: (*parser).callonEasyType2,
 						expr: &seqExpr{
-							pos: position{line: 1429, col: 5, offset: 34006},
+							pos: position{line: 1541, col: 5, offset: 37420},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1429, col: 5, offset: 34006},
+									pos:        position{line: 1541, col: 5, offset: 37420},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1429, col: 9, offset: 34010},
+									pos:  position{line: 1541, col: 9, offset: 37424},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1429, col: 12, offset: 34013},
+									pos:   position{line: 1541, col: 12, offset: 37427},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1429, col: 16, offset: 34017},
+										pos:  position{line: 1541, col: 16, offset: 37431},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1429, col: 21, offset: 34022},
+									pos:  position{line: 1541, col: 21, offset: 37436},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1429, col: 24, offset: 34025},
+									pos:        position{line: 1541, col: 24, offset: 37439},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -9140,23 +10035,23 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1430, col: 5, offset: 34052},
+						pos: position{line: 1542, col: 5, offset: 37466},
 						run: (*parser).callonEasyType10,
 						expr: &seqExpr{
-							pos: position{line: 1430, col: 5, offset: 34052},
+							pos: position{line: 1542, col: 5, offset: 37466},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1430, col: 5, offset: 34052},
+									pos:   position{line: 1542, col: 5, offset: 37466},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1430, col: 10, offset: 34057},
+										pos:  position{line: 1542, col: 10, offset: 37471},
 										name: "PrimitiveType",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1430, col: 24, offset: 34071},
+									pos: position{line: 1542, col: 24, offset: 37485},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1430, col: 25, offset: 34072},
+										pos:  position{line: 1542, col: 25, offset: 37486},
 										name: "IdentifierRest",
 									},
 								},
@@ -9164,43 +10059,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1431, col: 5, offset: 34112},
+						pos: position{line: 1543, col: 5, offset: 37526},
 						run: (*parser).callonEasyType16,
 						expr: &seqExpr{
-							pos: position{line: 1431, col: 5, offset: 34112},
+							pos: position{line: 1543, col: 5, offset: 37526},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1431, col: 5, offset: 34112},
+									pos:  position{line: 1543, col: 5, offset: 37526},
 									name: "ERROR",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1431, col: 11, offset: 34118},
+									pos:  position{line: 1543, col: 11, offset: 37532},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1431, col: 14, offset: 34121},
+									pos:        position{line: 1543, col: 14, offset: 37535},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1431, col: 18, offset: 34125},
+									pos:  position{line: 1543, col: 18, offset: 37539},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1431, col: 21, offset: 34128},
+									pos:   position{line: 1543, col: 21, offset: 37542},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1431, col: 23, offset: 34130},
+										pos:  position{line: 1543, col: 23, offset: 37544},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1431, col: 28, offset: 34135},
+									pos:  position{line: 1543, col: 28, offset: 37549},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1431, col: 31, offset: 34138},
+									pos:        position{line: 1543, col: 31, offset: 37552},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -9209,43 +10104,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1438, col: 5, offset: 34278},
+						pos: position{line: 1550, col: 5, offset: 37692},
 						run: (*parser).callonEasyType26,
 						expr: &seqExpr{
-							pos: position{line: 1438, col: 5, offset: 34278},
+							pos: position{line: 1550, col: 5, offset: 37692},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1438, col: 5, offset: 34278},
+									pos:  position{line: 1550, col: 5, offset: 37692},
 									name: "ENUM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1438, col: 10, offset: 34283},
+									pos:  position{line: 1550, col: 10, offset: 37697},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1438, col: 13, offset: 34286},
+									pos:        position{line: 1550, col: 13, offset: 37700},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1438, col: 17, offset: 34290},
+									pos:  position{line: 1550, col: 17, offset: 37704},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1438, col: 20, offset: 34293},
+									pos:   position{line: 1550, col: 20, offset: 37707},
 									label: "names",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1438, col: 26, offset: 34299},
+										pos:  position{line: 1550, col: 26, offset: 37713},
 										name: "Names",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1438, col: 32, offset: 34305},
+									pos:  position{line: 1550, col: 32, offset: 37719},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1438, col: 35, offset: 34308},
+									pos:        position{line: 1550, col: 35, offset: 37722},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -9254,35 +10149,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1445, col: 5, offset: 34462},
+						pos: position{line: 1557, col: 5, offset: 37876},
 						run: (*parser).callonEasyType36,
 						expr: &seqExpr{
-							pos: position{line: 1445, col: 5, offset: 34462},
+							pos: position{line: 1557, col: 5, offset: 37876},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1445, col: 5, offset: 34462},
+									pos:        position{line: 1557, col: 5, offset: 37876},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1445, col: 9, offset: 34466},
+									pos:  position{line: 1557, col: 9, offset: 37880},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1445, col: 12, offset: 34469},
+									pos:   position{line: 1557, col: 12, offset: 37883},
 									label: "fields",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1445, col: 19, offset: 34476},
+										pos:  position{line: 1557, col: 19, offset: 37890},
 										name: "TypeFieldList",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1445, col: 33, offset: 34490},
+									pos:  position{line: 1557, col: 33, offset: 37904},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1445, col: 36, offset: 34493},
+									pos:        position{line: 1557, col: 36, offset: 37907},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -9291,35 +10186,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1452, col: 5, offset: 34655},
+						pos: position{line: 1564, col: 5, offset: 38069},
 						run: (*parser).callonEasyType44,
 						expr: &seqExpr{
-							pos: position{line: 1452, col: 5, offset: 34655},
+							pos: position{line: 1564, col: 5, offset: 38069},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1452, col: 5, offset: 34655},
+									pos:        position{line: 1564, col: 5, offset: 38069},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1452, col: 9, offset: 34659},
+									pos:  position{line: 1564, col: 9, offset: 38073},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1452, col: 12, offset: 34662},
+									pos:   position{line: 1564, col: 12, offset: 38076},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1452, col: 16, offset: 34666},
+										pos:  position{line: 1564, col: 16, offset: 38080},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1452, col: 21, offset: 34671},
+									pos:  position{line: 1564, col: 21, offset: 38085},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1452, col: 24, offset: 34674},
+									pos:        position{line: 1564, col: 24, offset: 38088},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -9328,35 +10223,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1459, col: 5, offset: 34816},
+						pos: position{line: 1571, col: 5, offset: 38230},
 						run: (*parser).callonEasyType52,
 						expr: &seqExpr{
-							pos: position{line: 1459, col: 5, offset: 34816},
+							pos: position{line: 1571, col: 5, offset: 38230},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1459, col: 5, offset: 34816},
+									pos:        position{line: 1571, col: 5, offset: 38230},
 									val:        "|[",
 									ignoreCase: false,
 									want:       "\"|[\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1459, col: 10, offset: 34821},
+									pos:  position{line: 1571, col: 10, offset: 38235},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1459, col: 13, offset: 34824},
+									pos:   position{line: 1571, col: 13, offset: 38238},
 									label: "typ",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1459, col: 17, offset: 34828},
+										pos:  position{line: 1571, col: 17, offset: 38242},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1459, col: 22, offset: 34833},
+									pos:  position{line: 1571, col: 22, offset: 38247},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1459, col: 25, offset: 34836},
+									pos:        position{line: 1571, col: 25, offset: 38250},
 									val:        "]|",
 									ignoreCase: false,
 									want:       "\"]|\"",
@@ -9365,57 +10260,57 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1466, col: 5, offset: 34975},
+						pos: position{line: 1578, col: 5, offset: 38389},
 						run: (*parser).callonEasyType60,
 						expr: &seqExpr{
-							pos: position{line: 1466, col: 5, offset: 34975},
+							pos: position{line: 1578, col: 5, offset: 38389},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1466, col: 5, offset: 34975},
+									pos:        position{line: 1578, col: 5, offset: 38389},
 									val:        "|{",
 									ignoreCase: false,
 									want:       "\"|{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1466, col: 10, offset: 34980},
+									pos:  position{line: 1578, col: 10, offset: 38394},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1466, col: 13, offset: 34983},
+									pos:   position{line: 1578, col: 13, offset: 38397},
 									label: "keyType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1466, col: 21, offset: 34991},
+										pos:  position{line: 1578, col: 21, offset: 38405},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1466, col: 26, offset: 34996},
+									pos:  position{line: 1578, col: 26, offset: 38410},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1466, col: 29, offset: 34999},
+									pos:        position{line: 1578, col: 29, offset: 38413},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1466, col: 33, offset: 35003},
+									pos:  position{line: 1578, col: 33, offset: 38417},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 1466, col: 36, offset: 35006},
+									pos:   position{line: 1578, col: 36, offset: 38420},
 									label: "valType",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1466, col: 44, offset: 35014},
+										pos:  position{line: 1578, col: 44, offset: 38428},
 										name: "Type",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1466, col: 49, offset: 35019},
+									pos:  position{line: 1578, col: 49, offset: 38433},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 1466, col: 52, offset: 35022},
+									pos:        position{line: 1578, col: 52, offset: 38436},
 									val:        "}|",
 									ignoreCase: false,
 									want:       "\"}|\"",
@@ -9430,15 +10325,15 @@ var g = &grammar{
 		},
 		{
 			name: "TypeUnion",
-			pos:  position{line: 1475, col: 1, offset: 35196},
+			pos:  position{line: 1587, col: 1, offset: 38610},
 			expr: &actionExpr{
-				pos: position{line: 1476, col: 5, offset: 35210},
+				pos: position{line: 1588, col: 5, offset: 38624},
 				run: (*parser).callonTypeUnion1,
 				expr: &labeledExpr{
-					pos:   position{line: 1476, col: 5, offset: 35210},
+					pos:   position{line: 1588, col: 5, offset: 38624},
 					label: "types",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1476, col: 11, offset: 35216},
+						pos:  position{line: 1588, col: 11, offset: 38630},
 						name: "TypeList",
 					},
 				},
@@ -9448,28 +10343,28 @@ var g = &grammar{
 		},
 		{
 			name: "TypeList",
-			pos:  position{line: 1484, col: 1, offset: 35353},
+			pos:  position{line: 1596, col: 1, offset: 38767},
 			expr: &actionExpr{
-				pos: position{line: 1485, col: 5, offset: 35366},
+				pos: position{line: 1597, col: 5, offset: 38780},
 				run: (*parser).callonTypeList1,
 				expr: &seqExpr{
-					pos: position{line: 1485, col: 5, offset: 35366},
+					pos: position{line: 1597, col: 5, offset: 38780},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1485, col: 5, offset: 35366},
+							pos:   position{line: 1597, col: 5, offset: 38780},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1485, col: 11, offset: 35372},
+								pos:  position{line: 1597, col: 11, offset: 38786},
 								name: "ComponentType",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1485, col: 25, offset: 35386},
+							pos:   position{line: 1597, col: 25, offset: 38800},
 							label: "rest",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1485, col: 30, offset: 35391},
+								pos: position{line: 1597, col: 30, offset: 38805},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1485, col: 30, offset: 35391},
+									pos:  position{line: 1597, col: 30, offset: 38805},
 									name: "TypeListTail",
 								},
 							},
@@ -9482,32 +10377,32 @@ var g = &grammar{
 		},
 		{
 			name: "TypeListTail",
-			pos:  position{line: 1489, col: 1, offset: 35449},
+			pos:  position{line: 1601, col: 1, offset: 38863},
 			expr: &actionExpr{
-				pos: position{line: 1489, col: 16, offset: 35464},
+				pos: position{line: 1601, col: 16, offset: 38878},
 				run: (*parser).callonTypeListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1489, col: 16, offset: 35464},
+					pos: position{line: 1601, col: 16, offset: 38878},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1489, col: 16, offset: 35464},
+							pos:  position{line: 1601, col: 16, offset: 38878},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1489, col: 19, offset: 35467},
+							pos:        position{line: 1601, col: 19, offset: 38881},
 							val:        "|",
 							ignoreCase: false,
 							want:       "\"|\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1489, col: 23, offset: 35471},
+							pos:  position{line: 1601, col: 23, offset: 38885},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1489, col: 26, offset: 35474},
+							pos:   position{line: 1601, col: 26, offset: 38888},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1489, col: 30, offset: 35478},
+								pos:  position{line: 1601, col: 30, offset: 38892},
 								name: "ComponentType",
 							},
 						},
@@ -9519,42 +10414,42 @@ var g = &grammar{
 		},
 		{
 			name: "StringLiteral",
-			pos:  position{line: 1491, col: 1, offset: 35513},
+			pos:  position{line: 1603, col: 1, offset: 38927},
 			expr: &choiceExpr{
-				pos: position{line: 1492, col: 5, offset: 35531},
+				pos: position{line: 1604, col: 5, offset: 38945},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1492, col: 5, offset: 35531},
+						pos: position{line: 1604, col: 5, offset: 38945},
 						run: (*parser).callonStringLiteral2,
 						expr: &labeledExpr{
-							pos:   position{line: 1492, col: 5, offset: 35531},
+							pos:   position{line: 1604, col: 5, offset: 38945},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1492, col: 7, offset: 35533},
+								pos:  position{line: 1604, col: 7, offset: 38947},
 								name: "DoubleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1493, col: 5, offset: 35648},
+						pos: position{line: 1605, col: 5, offset: 39062},
 						run: (*parser).callonStringLiteral5,
 						expr: &labeledExpr{
-							pos:   position{line: 1493, col: 5, offset: 35648},
+							pos:   position{line: 1605, col: 5, offset: 39062},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1493, col: 7, offset: 35650},
+								pos:  position{line: 1605, col: 7, offset: 39064},
 								name: "SingleQuotedString",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1494, col: 5, offset: 35727},
+						pos: position{line: 1606, col: 5, offset: 39141},
 						run: (*parser).callonStringLiteral8,
 						expr: &labeledExpr{
-							pos:   position{line: 1494, col: 5, offset: 35727},
+							pos:   position{line: 1606, col: 5, offset: 39141},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1494, col: 7, offset: 35729},
+								pos:  position{line: 1606, col: 7, offset: 39143},
 								name: "RString",
 							},
 						},
@@ -9566,35 +10461,35 @@ var g = &grammar{
 		},
 		{
 			name: "FString",
-			pos:  position{line: 1496, col: 1, offset: 35792},
+			pos:  position{line: 1608, col: 1, offset: 39206},
 			expr: &choiceExpr{
-				pos: position{line: 1497, col: 5, offset: 35804},
+				pos: position{line: 1609, col: 5, offset: 39218},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1497, col: 5, offset: 35804},
+						pos: position{line: 1609, col: 5, offset: 39218},
 						run: (*parser).callonFString2,
 						expr: &seqExpr{
-							pos: position{line: 1497, col: 5, offset: 35804},
+							pos: position{line: 1609, col: 5, offset: 39218},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1497, col: 5, offset: 35804},
+									pos:        position{line: 1609, col: 5, offset: 39218},
 									val:        "f\"",
 									ignoreCase: false,
 									want:       "\"f\\\"\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1497, col: 11, offset: 35810},
+									pos:   position{line: 1609, col: 11, offset: 39224},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1497, col: 13, offset: 35812},
+										pos: position{line: 1609, col: 13, offset: 39226},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1497, col: 13, offset: 35812},
+											pos:  position{line: 1609, col: 13, offset: 39226},
 											name: "FStringDoubleQuotedElem",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1497, col: 38, offset: 35837},
+									pos:        position{line: 1609, col: 38, offset: 39251},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
@@ -9603,30 +10498,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1504, col: 5, offset: 35991},
+						pos: position{line: 1616, col: 5, offset: 39405},
 						run: (*parser).callonFString9,
 						expr: &seqExpr{
-							pos: position{line: 1504, col: 5, offset: 35991},
+							pos: position{line: 1616, col: 5, offset: 39405},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1504, col: 5, offset: 35991},
+									pos:        position{line: 1616, col: 5, offset: 39405},
 									val:        "f'",
 									ignoreCase: false,
 									want:       "\"f'\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1504, col: 10, offset: 35996},
+									pos:   position{line: 1616, col: 10, offset: 39410},
 									label: "v",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1504, col: 12, offset: 35998},
+										pos: position{line: 1616, col: 12, offset: 39412},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1504, col: 12, offset: 35998},
+											pos:  position{line: 1616, col: 12, offset: 39412},
 											name: "FStringSingleQuotedElem",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1504, col: 37, offset: 36023},
+									pos:        position{line: 1616, col: 37, offset: 39437},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
@@ -9641,24 +10536,24 @@ var g = &grammar{
 		},
 		{
 			name: "FStringDoubleQuotedElem",
-			pos:  position{line: 1512, col: 1, offset: 36174},
+			pos:  position{line: 1624, col: 1, offset: 39588},
 			expr: &choiceExpr{
-				pos: position{line: 1513, col: 5, offset: 36202},
+				pos: position{line: 1625, col: 5, offset: 39616},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1513, col: 5, offset: 36202},
+						pos:  position{line: 1625, col: 5, offset: 39616},
 						name: "FStringExprElem",
 					},
 					&actionExpr{
-						pos: position{line: 1514, col: 5, offset: 36222},
+						pos: position{line: 1626, col: 5, offset: 39636},
 						run: (*parser).callonFStringDoubleQuotedElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1514, col: 5, offset: 36222},
+							pos:   position{line: 1626, col: 5, offset: 39636},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1514, col: 7, offset: 36224},
+								pos: position{line: 1626, col: 7, offset: 39638},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1514, col: 7, offset: 36224},
+									pos:  position{line: 1626, col: 7, offset: 39638},
 									name: "FStringDoubleQuotedChar",
 								},
 							},
@@ -9671,27 +10566,27 @@ var g = &grammar{
 		},
 		{
 			name: "FStringDoubleQuotedChar",
-			pos:  position{line: 1518, col: 1, offset: 36355},
+			pos:  position{line: 1630, col: 1, offset: 39769},
 			expr: &choiceExpr{
-				pos: position{line: 1519, col: 5, offset: 36383},
+				pos: position{line: 1631, col: 5, offset: 39797},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1519, col: 5, offset: 36383},
+						pos: position{line: 1631, col: 5, offset: 39797},
 						run: (*parser).callonFStringDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1519, col: 5, offset: 36383},
+							pos: position{line: 1631, col: 5, offset: 39797},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1519, col: 5, offset: 36383},
+									pos:        position{line: 1631, col: 5, offset: 39797},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1519, col: 10, offset: 36388},
+									pos:   position{line: 1631, col: 10, offset: 39802},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1519, col: 12, offset: 36390},
+										pos:        position{line: 1631, col: 12, offset: 39804},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
@@ -9701,25 +10596,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1520, col: 5, offset: 36416},
+						pos: position{line: 1632, col: 5, offset: 39830},
 						run: (*parser).callonFStringDoubleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1520, col: 5, offset: 36416},
+							pos: position{line: 1632, col: 5, offset: 39830},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1520, col: 5, offset: 36416},
+									pos: position{line: 1632, col: 5, offset: 39830},
 									expr: &litMatcher{
-										pos:        position{line: 1520, col: 7, offset: 36418},
+										pos:        position{line: 1632, col: 7, offset: 39832},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1520, col: 12, offset: 36423},
+									pos:   position{line: 1632, col: 12, offset: 39837},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1520, col: 14, offset: 36425},
+										pos:  position{line: 1632, col: 14, offset: 39839},
 										name: "DoubleQuotedChar",
 									},
 								},
@@ -9733,24 +10628,24 @@ var g = &grammar{
 		},
 		{
 			name: "FStringSingleQuotedElem",
-			pos:  position{line: 1522, col: 1, offset: 36461},
+			pos:  position{line: 1634, col: 1, offset: 39875},
 			expr: &choiceExpr{
-				pos: position{line: 1523, col: 5, offset: 36489},
+				pos: position{line: 1635, col: 5, offset: 39903},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1523, col: 5, offset: 36489},
+						pos:  position{line: 1635, col: 5, offset: 39903},
 						name: "FStringExprElem",
 					},
 					&actionExpr{
-						pos: position{line: 1524, col: 5, offset: 36509},
+						pos: position{line: 1636, col: 5, offset: 39923},
 						run: (*parser).callonFStringSingleQuotedElem3,
 						expr: &labeledExpr{
-							pos:   position{line: 1524, col: 5, offset: 36509},
+							pos:   position{line: 1636, col: 5, offset: 39923},
 							label: "v",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 1524, col: 7, offset: 36511},
+								pos: position{line: 1636, col: 7, offset: 39925},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1524, col: 7, offset: 36511},
+									pos:  position{line: 1636, col: 7, offset: 39925},
 									name: "FStringSingleQuotedChar",
 								},
 							},
@@ -9763,27 +10658,27 @@ var g = &grammar{
 		},
 		{
 			name: "FStringSingleQuotedChar",
-			pos:  position{line: 1528, col: 1, offset: 36642},
+			pos:  position{line: 1640, col: 1, offset: 40056},
 			expr: &choiceExpr{
-				pos: position{line: 1529, col: 5, offset: 36670},
+				pos: position{line: 1641, col: 5, offset: 40084},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1529, col: 5, offset: 36670},
+						pos: position{line: 1641, col: 5, offset: 40084},
 						run: (*parser).callonFStringSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1529, col: 5, offset: 36670},
+							pos: position{line: 1641, col: 5, offset: 40084},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1529, col: 5, offset: 36670},
+									pos:        position{line: 1641, col: 5, offset: 40084},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1529, col: 10, offset: 36675},
+									pos:   position{line: 1641, col: 10, offset: 40089},
 									label: "v",
 									expr: &litMatcher{
-										pos:        position{line: 1529, col: 12, offset: 36677},
+										pos:        position{line: 1641, col: 12, offset: 40091},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
@@ -9793,25 +10688,25 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1530, col: 5, offset: 36703},
+						pos: position{line: 1642, col: 5, offset: 40117},
 						run: (*parser).callonFStringSingleQuotedChar7,
 						expr: &seqExpr{
-							pos: position{line: 1530, col: 5, offset: 36703},
+							pos: position{line: 1642, col: 5, offset: 40117},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1530, col: 5, offset: 36703},
+									pos: position{line: 1642, col: 5, offset: 40117},
 									expr: &litMatcher{
-										pos:        position{line: 1530, col: 7, offset: 36705},
+										pos:        position{line: 1642, col: 7, offset: 40119},
 										val:        "{",
 										ignoreCase: false,
 										want:       "\"{\"",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1530, col: 12, offset: 36710},
+									pos:   position{line: 1642, col: 12, offset: 40124},
 									label: "v",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1530, col: 14, offset: 36712},
+										pos:  position{line: 1642, col: 14, offset: 40126},
 										name: "SingleQuotedChar",
 									},
 								},
@@ -9825,37 +10720,37 @@ var g = &grammar{
 		},
 		{
 			name: "FStringExprElem",
-			pos:  position{line: 1532, col: 1, offset: 36748},
+			pos:  position{line: 1644, col: 1, offset: 40162},
 			expr: &actionExpr{
-				pos: position{line: 1533, col: 5, offset: 36768},
+				pos: position{line: 1645, col: 5, offset: 40182},
 				run: (*parser).callonFStringExprElem1,
 				expr: &seqExpr{
-					pos: position{line: 1533, col: 5, offset: 36768},
+					pos: position{line: 1645, col: 5, offset: 40182},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1533, col: 5, offset: 36768},
+							pos:        position{line: 1645, col: 5, offset: 40182},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1533, col: 9, offset: 36772},
+							pos:  position{line: 1645, col: 9, offset: 40186},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1533, col: 12, offset: 36775},
+							pos:   position{line: 1645, col: 12, offset: 40189},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1533, col: 14, offset: 36777},
+								pos:  position{line: 1645, col: 14, offset: 40191},
 								name: "Expr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1533, col: 19, offset: 36782},
+							pos:  position{line: 1645, col: 19, offset: 40196},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1533, col: 22, offset: 36785},
+							pos:        position{line: 1645, col: 22, offset: 40199},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -9868,144 +10763,144 @@ var g = &grammar{
 		},
 		{
 			name: "PrimitiveType",
-			pos:  position{line: 1541, col: 1, offset: 36928},
+			pos:  position{line: 1653, col: 1, offset: 40342},
 			expr: &choiceExpr{
-				pos: position{line: 1542, col: 5, offset: 36946},
+				pos: position{line: 1654, col: 5, offset: 40360},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1542, col: 5, offset: 36946},
+						pos: position{line: 1654, col: 5, offset: 40360},
 						run: (*parser).callonPrimitiveType2,
 						expr: &labeledExpr{
-							pos:   position{line: 1542, col: 5, offset: 36946},
+							pos:   position{line: 1654, col: 5, offset: 40360},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1542, col: 10, offset: 36951},
+								pos:  position{line: 1654, col: 10, offset: 40365},
 								name: "PostgreSQLPrimitiveType",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1549, col: 5, offset: 37126},
+						pos: position{line: 1661, col: 5, offset: 40540},
 						run: (*parser).callonPrimitiveType5,
 						expr: &choiceExpr{
-							pos: position{line: 1549, col: 9, offset: 37130},
+							pos: position{line: 1661, col: 9, offset: 40544},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1549, col: 9, offset: 37130},
+									pos:        position{line: 1661, col: 9, offset: 40544},
 									val:        "uint8",
 									ignoreCase: false,
 									want:       "\"uint8\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1549, col: 19, offset: 37140},
+									pos:        position{line: 1661, col: 19, offset: 40554},
 									val:        "uint16",
 									ignoreCase: false,
 									want:       "\"uint16\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1549, col: 30, offset: 37151},
+									pos:        position{line: 1661, col: 30, offset: 40565},
 									val:        "uint32",
 									ignoreCase: false,
 									want:       "\"uint32\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1549, col: 41, offset: 37162},
+									pos:        position{line: 1661, col: 41, offset: 40576},
 									val:        "uint64",
 									ignoreCase: false,
 									want:       "\"uint64\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1550, col: 9, offset: 37179},
+									pos:        position{line: 1662, col: 9, offset: 40593},
 									val:        "int8",
 									ignoreCase: false,
 									want:       "\"int8\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1550, col: 18, offset: 37188},
+									pos:        position{line: 1662, col: 18, offset: 40602},
 									val:        "int16",
 									ignoreCase: false,
 									want:       "\"int16\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1550, col: 28, offset: 37198},
+									pos:        position{line: 1662, col: 28, offset: 40612},
 									val:        "int32",
 									ignoreCase: false,
 									want:       "\"int32\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1550, col: 38, offset: 37208},
+									pos:        position{line: 1662, col: 38, offset: 40622},
 									val:        "int64",
 									ignoreCase: false,
 									want:       "\"int64\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1551, col: 9, offset: 37224},
+									pos:        position{line: 1663, col: 9, offset: 40638},
 									val:        "float16",
 									ignoreCase: false,
 									want:       "\"float16\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1551, col: 21, offset: 37236},
+									pos:        position{line: 1663, col: 21, offset: 40650},
 									val:        "float32",
 									ignoreCase: false,
 									want:       "\"float32\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1551, col: 33, offset: 37248},
+									pos:        position{line: 1663, col: 33, offset: 40662},
 									val:        "float64",
 									ignoreCase: false,
 									want:       "\"float64\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1552, col: 9, offset: 37266},
+									pos:        position{line: 1664, col: 9, offset: 40680},
 									val:        "bool",
 									ignoreCase: false,
 									want:       "\"bool\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1552, col: 18, offset: 37275},
+									pos:        position{line: 1664, col: 18, offset: 40689},
 									val:        "string",
 									ignoreCase: false,
 									want:       "\"string\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1553, col: 9, offset: 37292},
+									pos:        position{line: 1665, col: 9, offset: 40706},
 									val:        "duration",
 									ignoreCase: false,
 									want:       "\"duration\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1553, col: 22, offset: 37305},
+									pos:        position{line: 1665, col: 22, offset: 40719},
 									val:        "time",
 									ignoreCase: false,
 									want:       "\"time\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1554, col: 9, offset: 37320},
+									pos:        position{line: 1666, col: 9, offset: 40734},
 									val:        "bytes",
 									ignoreCase: false,
 									want:       "\"bytes\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1555, col: 9, offset: 37336},
+									pos:        position{line: 1667, col: 9, offset: 40750},
 									val:        "ip",
 									ignoreCase: false,
 									want:       "\"ip\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1555, col: 16, offset: 37343},
+									pos:        position{line: 1667, col: 16, offset: 40757},
 									val:        "net",
 									ignoreCase: false,
 									want:       "\"net\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1556, col: 9, offset: 37357},
+									pos:        position{line: 1668, col: 9, offset: 40771},
 									val:        "type",
 									ignoreCase: false,
 									want:       "\"type\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1556, col: 18, offset: 37366},
+									pos:        position{line: 1668, col: 18, offset: 40780},
 									val:        "null",
 									ignoreCase: false,
 									want:       "\"null\"",
@@ -10020,56 +10915,56 @@ var g = &grammar{
 		},
 		{
 			name: "PostgreSQLPrimitiveType",
-			pos:  position{line: 1565, col: 1, offset: 37623},
+			pos:  position{line: 1677, col: 1, offset: 41037},
 			expr: &choiceExpr{
-				pos: position{line: 1566, col: 5, offset: 37651},
+				pos: position{line: 1678, col: 5, offset: 41065},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1566, col: 5, offset: 37651},
+						pos: position{line: 1678, col: 5, offset: 41065},
 						run: (*parser).callonPostgreSQLPrimitiveType2,
 						expr: &litMatcher{
-							pos:        position{line: 1566, col: 5, offset: 37651},
+							pos:        position{line: 1678, col: 5, offset: 41065},
 							val:        "bigint",
 							ignoreCase: true,
 							want:       "\"bigint\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1567, col: 5, offset: 37700},
+						pos: position{line: 1679, col: 5, offset: 41114},
 						run: (*parser).callonPostgreSQLPrimitiveType4,
 						expr: &litMatcher{
-							pos:        position{line: 1567, col: 5, offset: 37700},
+							pos:        position{line: 1679, col: 5, offset: 41114},
 							val:        "boolean",
 							ignoreCase: true,
 							want:       "\"boolean\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1568, col: 5, offset: 37748},
+						pos: position{line: 1680, col: 5, offset: 41162},
 						run: (*parser).callonPostgreSQLPrimitiveType6,
 						expr: &litMatcher{
-							pos:        position{line: 1568, col: 5, offset: 37748},
+							pos:        position{line: 1680, col: 5, offset: 41162},
 							val:        "bytea",
 							ignoreCase: true,
 							want:       "\"bytea\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1569, col: 5, offset: 37797},
+						pos: position{line: 1681, col: 5, offset: 41211},
 						run: (*parser).callonPostgreSQLPrimitiveType8,
 						expr: &seqExpr{
-							pos: position{line: 1569, col: 5, offset: 37797},
+							pos: position{line: 1681, col: 5, offset: 41211},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1569, col: 5, offset: 37797},
+									pos:        position{line: 1681, col: 5, offset: 41211},
 									val:        "char",
 									ignoreCase: true,
 									want:       "\"char\"i",
 								},
 								&notExpr{
-									pos: position{line: 1569, col: 13, offset: 37805},
+									pos: position{line: 1681, col: 13, offset: 41219},
 									expr: &litMatcher{
-										pos:        position{line: 1569, col: 14, offset: 37806},
+										pos:        position{line: 1681, col: 14, offset: 41220},
 										val:        "a",
 										ignoreCase: true,
 										want:       "\"a\"i",
@@ -10079,61 +10974,61 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1570, col: 5, offset: 37847},
+						pos: position{line: 1682, col: 5, offset: 41261},
 						run: (*parser).callonPostgreSQLPrimitiveType13,
 						expr: &litMatcher{
-							pos:        position{line: 1570, col: 5, offset: 37847},
+							pos:        position{line: 1682, col: 5, offset: 41261},
 							val:        "character varying",
 							ignoreCase: true,
 							want:       "\"character varying\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1571, col: 5, offset: 37897},
+						pos: position{line: 1683, col: 5, offset: 41311},
 						run: (*parser).callonPostgreSQLPrimitiveType15,
 						expr: &litMatcher{
-							pos:        position{line: 1571, col: 5, offset: 37897},
+							pos:        position{line: 1683, col: 5, offset: 41311},
 							val:        "character",
 							ignoreCase: true,
 							want:       "\"character\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1572, col: 5, offset: 37947},
+						pos: position{line: 1684, col: 5, offset: 41361},
 						run: (*parser).callonPostgreSQLPrimitiveType17,
 						expr: &litMatcher{
-							pos:        position{line: 1572, col: 5, offset: 37947},
+							pos:        position{line: 1684, col: 5, offset: 41361},
 							val:        "cidr",
 							ignoreCase: true,
 							want:       "\"cidr\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1573, col: 5, offset: 37994},
+						pos: position{line: 1685, col: 5, offset: 41408},
 						run: (*parser).callonPostgreSQLPrimitiveType19,
 						expr: &litMatcher{
-							pos:        position{line: 1573, col: 5, offset: 37994},
+							pos:        position{line: 1685, col: 5, offset: 41408},
 							val:        "double precision",
 							ignoreCase: true,
 							want:       "\"double precision\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1574, col: 5, offset: 38045},
+						pos: position{line: 1686, col: 5, offset: 41459},
 						run: (*parser).callonPostgreSQLPrimitiveType21,
 						expr: &seqExpr{
-							pos: position{line: 1574, col: 5, offset: 38045},
+							pos: position{line: 1686, col: 5, offset: 41459},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1574, col: 5, offset: 38045},
+									pos:        position{line: 1686, col: 5, offset: 41459},
 									val:        "float",
 									ignoreCase: true,
 									want:       "\"float\"i",
 								},
 								&notExpr{
-									pos: position{line: 1574, col: 14, offset: 38054},
+									pos: position{line: 1686, col: 14, offset: 41468},
 									expr: &charClassMatcher{
-										pos:        position{line: 1574, col: 15, offset: 38055},
+										pos:        position{line: 1686, col: 15, offset: 41469},
 										val:        "[136]",
 										chars:      []rune{'1', '3', '6'},
 										ignoreCase: false,
@@ -10144,31 +11039,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1575, col: 5, offset: 38096},
+						pos: position{line: 1687, col: 5, offset: 41510},
 						run: (*parser).callonPostgreSQLPrimitiveType26,
 						expr: &litMatcher{
-							pos:        position{line: 1575, col: 5, offset: 38096},
+							pos:        position{line: 1687, col: 5, offset: 41510},
 							val:        "inet",
 							ignoreCase: true,
 							want:       "\"inet\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1576, col: 5, offset: 38142},
+						pos: position{line: 1688, col: 5, offset: 41556},
 						run: (*parser).callonPostgreSQLPrimitiveType28,
 						expr: &seqExpr{
-							pos: position{line: 1576, col: 5, offset: 38142},
+							pos: position{line: 1688, col: 5, offset: 41556},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1576, col: 5, offset: 38142},
+									pos:        position{line: 1688, col: 5, offset: 41556},
 									val:        "int",
 									ignoreCase: true,
 									want:       "\"int\"i",
 								},
 								&notExpr{
-									pos: position{line: 1576, col: 12, offset: 38149},
+									pos: position{line: 1688, col: 12, offset: 41563},
 									expr: &charClassMatcher{
-										pos:        position{line: 1576, col: 13, offset: 38150},
+										pos:        position{line: 1688, col: 13, offset: 41564},
 										val:        "[1368e]i",
 										chars:      []rune{'1', '3', '6', '8', 'e'},
 										ignoreCase: true,
@@ -10179,60 +11074,60 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1577, col: 5, offset: 38191},
+						pos: position{line: 1689, col: 5, offset: 41605},
 						run: (*parser).callonPostgreSQLPrimitiveType33,
 						expr: &litMatcher{
-							pos:        position{line: 1577, col: 5, offset: 38191},
+							pos:        position{line: 1689, col: 5, offset: 41605},
 							val:        "integer",
 							ignoreCase: true,
 							want:       "\"integer\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1578, col: 5, offset: 38240},
+						pos: position{line: 1690, col: 5, offset: 41654},
 						run: (*parser).callonPostgreSQLPrimitiveType35,
 						expr: &litMatcher{
-							pos:        position{line: 1578, col: 5, offset: 38240},
+							pos:        position{line: 1690, col: 5, offset: 41654},
 							val:        "interval",
 							ignoreCase: true,
 							want:       "\"interval\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1579, col: 5, offset: 38292},
+						pos: position{line: 1691, col: 5, offset: 41706},
 						run: (*parser).callonPostgreSQLPrimitiveType37,
 						expr: &litMatcher{
-							pos:        position{line: 1579, col: 5, offset: 38292},
+							pos:        position{line: 1691, col: 5, offset: 41706},
 							val:        "real",
 							ignoreCase: true,
 							want:       "\"real\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1580, col: 5, offset: 38343},
+						pos: position{line: 1692, col: 5, offset: 41757},
 						run: (*parser).callonPostgreSQLPrimitiveType39,
 						expr: &litMatcher{
-							pos:        position{line: 1580, col: 5, offset: 38343},
+							pos:        position{line: 1692, col: 5, offset: 41757},
 							val:        "smallint",
 							ignoreCase: true,
 							want:       "\"smallint\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1581, col: 5, offset: 38392},
+						pos: position{line: 1693, col: 5, offset: 41806},
 						run: (*parser).callonPostgreSQLPrimitiveType41,
 						expr: &litMatcher{
-							pos:        position{line: 1581, col: 5, offset: 38392},
+							pos:        position{line: 1693, col: 5, offset: 41806},
 							val:        "text",
 							ignoreCase: true,
 							want:       "\"text\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1582, col: 5, offset: 38442},
+						pos: position{line: 1694, col: 5, offset: 41856},
 						run: (*parser).callonPostgreSQLPrimitiveType43,
 						expr: &litMatcher{
-							pos:        position{line: 1582, col: 5, offset: 38442},
+							pos:        position{line: 1694, col: 5, offset: 41856},
 							val:        "varchar",
 							ignoreCase: true,
 							want:       "\"varchar\"i",
@@ -10245,31 +11140,31 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldList",
-			pos:  position{line: 1584, col: 1, offset: 38489},
+			pos:  position{line: 1696, col: 1, offset: 41903},
 			expr: &choiceExpr{
-				pos: position{line: 1585, col: 5, offset: 38507},
+				pos: position{line: 1697, col: 5, offset: 41921},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1585, col: 5, offset: 38507},
+						pos: position{line: 1697, col: 5, offset: 41921},
 						run: (*parser).callonTypeFieldList2,
 						expr: &seqExpr{
-							pos: position{line: 1585, col: 5, offset: 38507},
+							pos: position{line: 1697, col: 5, offset: 41921},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1585, col: 5, offset: 38507},
+									pos:   position{line: 1697, col: 5, offset: 41921},
 									label: "first",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1585, col: 11, offset: 38513},
+										pos:  position{line: 1697, col: 11, offset: 41927},
 										name: "TypeField",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1585, col: 21, offset: 38523},
+									pos:   position{line: 1697, col: 21, offset: 41937},
 									label: "rest",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1585, col: 26, offset: 38528},
+										pos: position{line: 1697, col: 26, offset: 41942},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1585, col: 26, offset: 38528},
+											pos:  position{line: 1697, col: 26, offset: 41942},
 											name: "TypeFieldListTail",
 										},
 									},
@@ -10278,10 +11173,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1588, col: 5, offset: 38594},
+						pos: position{line: 1700, col: 5, offset: 42008},
 						run: (*parser).callonTypeFieldList9,
 						expr: &litMatcher{
-							pos:        position{line: 1588, col: 5, offset: 38594},
+							pos:        position{line: 1700, col: 5, offset: 42008},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -10294,32 +11189,32 @@ var g = &grammar{
 		},
 		{
 			name: "TypeFieldListTail",
-			pos:  position{line: 1590, col: 1, offset: 38618},
+			pos:  position{line: 1702, col: 1, offset: 42032},
 			expr: &actionExpr{
-				pos: position{line: 1590, col: 21, offset: 38638},
+				pos: position{line: 1702, col: 21, offset: 42052},
 				run: (*parser).callonTypeFieldListTail1,
 				expr: &seqExpr{
-					pos: position{line: 1590, col: 21, offset: 38638},
+					pos: position{line: 1702, col: 21, offset: 42052},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1590, col: 21, offset: 38638},
+							pos:  position{line: 1702, col: 21, offset: 42052},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1590, col: 24, offset: 38641},
+							pos:        position{line: 1702, col: 24, offset: 42055},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1590, col: 28, offset: 38645},
+							pos:  position{line: 1702, col: 28, offset: 42059},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1590, col: 31, offset: 38648},
+							pos:   position{line: 1702, col: 31, offset: 42062},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1590, col: 35, offset: 38652},
+								pos:  position{line: 1702, col: 35, offset: 42066},
 								name: "TypeField",
 							},
 						},
@@ -10331,48 +11226,48 @@ var g = &grammar{
 		},
 		{
 			name: "TypeField",
-			pos:  position{line: 1592, col: 1, offset: 38683},
+			pos:  position{line: 1704, col: 1, offset: 42097},
 			expr: &actionExpr{
-				pos: position{line: 1593, col: 5, offset: 38697},
+				pos: position{line: 1705, col: 5, offset: 42111},
 				run: (*parser).callonTypeField1,
 				expr: &seqExpr{
-					pos: position{line: 1593, col: 5, offset: 38697},
+					pos: position{line: 1705, col: 5, offset: 42111},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1593, col: 5, offset: 38697},
+							pos:   position{line: 1705, col: 5, offset: 42111},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1593, col: 10, offset: 38702},
+								pos:  position{line: 1705, col: 10, offset: 42116},
 								name: "Name",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1593, col: 15, offset: 38707},
+							pos:   position{line: 1705, col: 15, offset: 42121},
 							label: "opt",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1593, col: 19, offset: 38711},
+								pos:  position{line: 1705, col: 19, offset: 42125},
 								name: "OptToken",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1593, col: 28, offset: 38720},
+							pos:  position{line: 1705, col: 28, offset: 42134},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 1593, col: 31, offset: 38723},
+							pos:        position{line: 1705, col: 31, offset: 42137},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1593, col: 35, offset: 38727},
+							pos:  position{line: 1705, col: 35, offset: 42141},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 1593, col: 38, offset: 38730},
+							pos:   position{line: 1705, col: 38, offset: 42144},
 							label: "typ",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1593, col: 42, offset: 38734},
+								pos:  position{line: 1705, col: 42, offset: 42148},
 								name: "Type",
 							},
 						},
@@ -10384,25 +11279,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptToken",
-			pos:  position{line: 1602, col: 1, offset: 38910},
+			pos:  position{line: 1714, col: 1, offset: 42324},
 			expr: &choiceExpr{
-				pos: position{line: 1603, col: 5, offset: 38923},
+				pos: position{line: 1715, col: 5, offset: 42337},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1603, col: 5, offset: 38923},
+						pos: position{line: 1715, col: 5, offset: 42337},
 						run: (*parser).callonOptToken2,
 						expr: &litMatcher{
-							pos:        position{line: 1603, col: 5, offset: 38923},
+							pos:        position{line: 1715, col: 5, offset: 42337},
 							val:        "?",
 							ignoreCase: false,
 							want:       "\"?\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1604, col: 5, offset: 38952},
+						pos: position{line: 1716, col: 5, offset: 42366},
 						run: (*parser).callonOptToken4,
 						expr: &litMatcher{
-							pos:        position{line: 1604, col: 5, offset: 38952},
+							pos:        position{line: 1716, col: 5, offset: 42366},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -10415,26 +11310,26 @@ var g = &grammar{
 		},
 		{
 			name: "Name",
-			pos:  position{line: 1606, col: 1, offset: 38978},
+			pos:  position{line: 1718, col: 1, offset: 42392},
 			expr: &actionExpr{
-				pos: position{line: 1607, col: 4, offset: 38986},
+				pos: position{line: 1719, col: 4, offset: 42400},
 				run: (*parser).callonName1,
 				expr: &labeledExpr{
-					pos:   position{line: 1607, col: 4, offset: 38986},
+					pos:   position{line: 1719, col: 4, offset: 42400},
 					label: "s",
 					expr: &choiceExpr{
-						pos: position{line: 1607, col: 7, offset: 38989},
+						pos: position{line: 1719, col: 7, offset: 42403},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 1607, col: 7, offset: 38989},
+								pos:  position{line: 1719, col: 7, offset: 42403},
 								name: "IdentifierName",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1607, col: 24, offset: 39006},
+								pos:  position{line: 1719, col: 24, offset: 42420},
 								name: "DoubleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1607, col: 45, offset: 39027},
+								pos:  position{line: 1719, col: 45, offset: 42441},
 								name: "SingleQuotedString",
 							},
 						},
@@ -10446,51 +11341,51 @@ var g = &grammar{
 		},
 		{
 			name: "Names",
-			pos:  position{line: 1611, col: 1, offset: 39127},
+			pos:  position{line: 1723, col: 1, offset: 42541},
 			expr: &actionExpr{
-				pos: position{line: 1612, col: 5, offset: 39137},
+				pos: position{line: 1724, col: 5, offset: 42551},
 				run: (*parser).callonNames1,
 				expr: &seqExpr{
-					pos: position{line: 1612, col: 5, offset: 39137},
+					pos: position{line: 1724, col: 5, offset: 42551},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1612, col: 5, offset: 39137},
+							pos:   position{line: 1724, col: 5, offset: 42551},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1612, col: 11, offset: 39143},
+								pos:  position{line: 1724, col: 11, offset: 42557},
 								name: "Name",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1612, col: 16, offset: 39148},
+							pos:   position{line: 1724, col: 16, offset: 42562},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1612, col: 21, offset: 39153},
+								pos: position{line: 1724, col: 21, offset: 42567},
 								expr: &actionExpr{
-									pos: position{line: 1612, col: 22, offset: 39154},
+									pos: position{line: 1724, col: 22, offset: 42568},
 									run: (*parser).callonNames7,
 									expr: &seqExpr{
-										pos: position{line: 1612, col: 22, offset: 39154},
+										pos: position{line: 1724, col: 22, offset: 42568},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1612, col: 22, offset: 39154},
+												pos:  position{line: 1724, col: 22, offset: 42568},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1612, col: 25, offset: 39157},
+												pos:        position{line: 1724, col: 25, offset: 42571},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1612, col: 29, offset: 39161},
+												pos:  position{line: 1724, col: 29, offset: 42575},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1612, col: 32, offset: 39164},
+												pos:   position{line: 1724, col: 32, offset: 42578},
 												label: "name",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1612, col: 37, offset: 39169},
+													pos:  position{line: 1724, col: 37, offset: 42583},
 													name: "Name",
 												},
 											},
@@ -10507,15 +11402,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 1616, col: 1, offset: 39241},
+			pos:  position{line: 1728, col: 1, offset: 42655},
 			expr: &actionExpr{
-				pos: position{line: 1617, col: 5, offset: 39256},
+				pos: position{line: 1729, col: 5, offset: 42670},
 				run: (*parser).callonIdentifier1,
 				expr: &labeledExpr{
-					pos:   position{line: 1617, col: 5, offset: 39256},
+					pos:   position{line: 1729, col: 5, offset: 42670},
 					label: "id",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1617, col: 8, offset: 39259},
+						pos:  position{line: 1729, col: 8, offset: 42673},
 						name: "IdentifierName",
 					},
 				},
@@ -10525,51 +11420,51 @@ var g = &grammar{
 		},
 		{
 			name: "Identifiers",
-			pos:  position{line: 1624, col: 1, offset: 39370},
+			pos:  position{line: 1736, col: 1, offset: 42784},
 			expr: &actionExpr{
-				pos: position{line: 1625, col: 5, offset: 39386},
+				pos: position{line: 1737, col: 5, offset: 42800},
 				run: (*parser).callonIdentifiers1,
 				expr: &seqExpr{
-					pos: position{line: 1625, col: 5, offset: 39386},
+					pos: position{line: 1737, col: 5, offset: 42800},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1625, col: 5, offset: 39386},
+							pos:   position{line: 1737, col: 5, offset: 42800},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1625, col: 11, offset: 39392},
+								pos:  position{line: 1737, col: 11, offset: 42806},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1625, col: 22, offset: 39403},
+							pos:   position{line: 1737, col: 22, offset: 42817},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1625, col: 27, offset: 39408},
+								pos: position{line: 1737, col: 27, offset: 42822},
 								expr: &actionExpr{
-									pos: position{line: 1625, col: 28, offset: 39409},
+									pos: position{line: 1737, col: 28, offset: 42823},
 									run: (*parser).callonIdentifiers7,
 									expr: &seqExpr{
-										pos: position{line: 1625, col: 28, offset: 39409},
+										pos: position{line: 1737, col: 28, offset: 42823},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1625, col: 28, offset: 39409},
+												pos:  position{line: 1737, col: 28, offset: 42823},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 1625, col: 31, offset: 39412},
+												pos:        position{line: 1737, col: 31, offset: 42826},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1625, col: 35, offset: 39416},
+												pos:  position{line: 1737, col: 35, offset: 42830},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 1625, col: 38, offset: 39419},
+												pos:   position{line: 1737, col: 38, offset: 42833},
 												label: "name",
 												expr: &ruleRefExpr{
-													pos:  position{line: 1625, col: 43, offset: 39424},
+													pos:  position{line: 1737, col: 43, offset: 42838},
 													name: "Identifier",
 												},
 											},
@@ -10586,22 +11481,22 @@ var g = &grammar{
 		},
 		{
 			name: "SQLIdentifier",
-			pos:  position{line: 1629, col: 1, offset: 39502},
+			pos:  position{line: 1741, col: 1, offset: 42916},
 			expr: &choiceExpr{
-				pos: position{line: 1630, col: 5, offset: 39520},
+				pos: position{line: 1742, col: 5, offset: 42934},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1630, col: 5, offset: 39520},
+						pos:  position{line: 1742, col: 5, offset: 42934},
 						name: "Identifier",
 					},
 					&actionExpr{
-						pos: position{line: 1631, col: 5, offset: 39535},
+						pos: position{line: 1743, col: 5, offset: 42949},
 						run: (*parser).callonSQLIdentifier3,
 						expr: &labeledExpr{
-							pos:   position{line: 1631, col: 5, offset: 39535},
+							pos:   position{line: 1743, col: 5, offset: 42949},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1631, col: 7, offset: 39537},
+								pos:  position{line: 1743, col: 7, offset: 42951},
 								name: "DoubleQuotedString",
 							},
 						},
@@ -10611,31 +11506,67 @@ var g = &grammar{
 			leader:        false,
 			leftRecursive: false,
 		},
+		{
+			name: "Param",
+			pos:  position{line: 1745, col: 1, offset: 43025},
+			expr: &actionExpr{
+				pos: position{line: 1746, col: 5, offset: 43035},
+				run: (*parser).callonParam1,
+				expr: &seqExpr{
+					pos: position{line: 1746, col: 5, offset: 43035},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1746, col: 5, offset: 43035},
+							val:        "$",
+							ignoreCase: false,
+							want:       "\"$\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 1746, col: 9, offset: 43039},
+							label: "name",
+							expr: &actionExpr{
+								pos: position{line: 1746, col: 15, offset: 43045},
+								run: (*parser).callonParam5,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 1746, col: 15, offset: 43045},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1746, col: 15, offset: 43045},
+										name: "IdentifierRest",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
 		{
 			name: "IdentifierName",
-			pos:  position{line: 1633, col: 1, offset: 39611},
+			pos:  position{line: 1750, col: 1, offset: 43188},
 			expr: &choiceExpr{
-				pos: position{line: 1634, col: 5, offset: 39630},
+				pos: position{line: 1751, col: 5, offset: 43207},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1634, col: 5, offset: 39630},
+						pos: position{line: 1751, col: 5, offset: 43207},
 						run: (*parser).callonIdentifierName2,
 						expr: &seqExpr{
-							pos: position{line: 1634, col: 5, offset: 39630},
+							pos: position{line: 1751, col: 5, offset: 43207},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1634, col: 5, offset: 39630},
+									pos: position{line: 1751, col: 5, offset: 43207},
 									expr: &seqExpr{
-										pos: position{line: 1634, col: 7, offset: 39632},
+										pos: position{line: 1751, col: 7, offset: 43209},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1634, col: 7, offset: 39632},
+												pos:  position{line: 1751, col: 7, offset: 43209},
 												name: "IDGuard",
 											},
 											&notExpr{
-												pos: position{line: 1634, col: 15, offset: 39640},
+												pos: position{line: 1751, col: 15, offset: 43217},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1634, col: 16, offset: 39641},
+													pos:  position{line: 1751, col: 16, offset: 43218},
 													name: "IdentifierRest",
 												},
 											},
@@ -10643,13 +11574,13 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1634, col: 32, offset: 39657},
+									pos:  position{line: 1751, col: 32, offset: 43234},
 									name: "IdentifierStart",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1634, col: 48, offset: 39673},
+									pos: position{line: 1751, col: 48, offset: 43250},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1634, col: 48, offset: 39673},
+										pos:  position{line: 1751, col: 48, offset: 43250},
 										name: "IdentifierRest",
 									},
 								},
@@ -10657,7 +11588,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1635, col: 5, offset: 39724},
+						pos:  position{line: 1752, col: 5, offset: 43301},
 						name: "BacktickString",
 					},
 				},
@@ -10667,22 +11598,22 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierStart",
-			pos:  position{line: 1637, col: 1, offset: 39740},
+			pos:  position{line: 1754, col: 1, offset: 43317},
 			expr: &choiceExpr{
-				pos: position{line: 1638, col: 5, offset: 39760},
+				pos: position{line: 1755, col: 5, offset: 43337},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1638, col: 5, offset: 39760},
+						pos:  position{line: 1755, col: 5, offset: 43337},
 						name: "UnicodeLetter",
 					},
 					&litMatcher{
-						pos:        position{line: 1639, col: 5, offset: 39778},
+						pos:        position{line: 1756, col: 5, offset: 43355},
 						val:        "$",
 						ignoreCase: false,
 						want:       "\"$\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1640, col: 5, offset: 39786},
+						pos:        position{line: 1757, col: 5, offset: 43363},
 						val:        "_",
 						ignoreCase: false,
 						want:       "\"_\"",
@@ -10694,24 +11625,24 @@ var g = &grammar{
 		},
 		{
 			name: "IdentifierRest",
-			pos:  position{line: 1642, col: 1, offset: 39791},
+			pos:  position{line: 1759, col: 1, offset: 43368},
 			expr: &choiceExpr{
-				pos: position{line: 1643, col: 5, offset: 39810},
+				pos: position{line: 1760, col: 5, offset: 43387},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1643, col: 5, offset: 39810},
+						pos:  position{line: 1760, col: 5, offset: 43387},
 						name: "IdentifierStart",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1644, col: 5, offset: 39830},
+						pos:  position{line: 1761, col: 5, offset: 43407},
 						name: "UnicodeCombiningMark",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1645, col: 5, offset: 39855},
+						pos:  position{line: 1762, col: 5, offset: 43432},
 						name: "UnicodeDigit",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1646, col: 5, offset: 39872},
+						pos:  position{line: 1763, col: 5, offset: 43449},
 						name: "UnicodeConnectorPunctuation",
 					},
 				},
@@ -10721,24 +11652,24 @@ var g = &grammar{
 		},
 		{
 			name: "IDGuard",
-			pos:  position{line: 1648, col: 1, offset: 39901},
+			pos:  position{line: 1765, col: 1, offset: 43478},
 			expr: &choiceExpr{
-				pos: position{line: 1649, col: 5, offset: 39913},
+				pos: position{line: 1766, col: 5, offset: 43490},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1649, col: 5, offset: 39913},
+						pos:  position{line: 1766, col: 5, offset: 43490},
 						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1650, col: 5, offset: 39932},
+						pos:  position{line: 1767, col: 5, offset: 43509},
 						name: "NullLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1651, col: 5, offset: 39948},
+						pos:  position{line: 1768, col: 5, offset: 43525},
 						name: "NaN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1652, col: 5, offset: 39956},
+						pos:  position{line: 1769, col: 5, offset: 43533},
 						name: "Infinity",
 					},
 				},
@@ -10748,25 +11679,25 @@ var g = &grammar{
 		},
 		{
 			name: "Time",
-			pos:  position{line: 1654, col: 1, offset: 39966},
+			pos:  position{line: 1771, col: 1, offset: 43543},
 			expr: &actionExpr{
-				pos: position{line: 1655, col: 5, offset: 39975},
+				pos: position{line: 1772, col: 5, offset: 43552},
 				run: (*parser).callonTime1,
 				expr: &seqExpr{
-					pos: position{line: 1655, col: 5, offset: 39975},
+					pos: position{line: 1772, col: 5, offset: 43552},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1655, col: 5, offset: 39975},
+							pos:  position{line: 1772, col: 5, offset: 43552},
 							name: "FullDate",
 						},
 						&litMatcher{
-							pos:        position{line: 1655, col: 14, offset: 39984},
+							pos:        position{line: 1772, col: 14, offset: 43561},
 							val:        "T",
 							ignoreCase: false,
 							want:       "\"T\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1655, col: 18, offset: 39988},
+							pos:  position{line: 1772, col: 18, offset: 43565},
 							name: "FullTime",
 						},
 					},
@@ -10777,32 +11708,32 @@ var g = &grammar{
 		},
 		{
 			name: "FullDate",
-			pos:  position{line: 1659, col: 1, offset: 40064},
+			pos:  position{line: 1776, col: 1, offset: 43641},
 			expr: &seqExpr{
-				pos: position{line: 1659, col: 12, offset: 40075},
+				pos: position{line: 1776, col: 12, offset: 43652},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1659, col: 12, offset: 40075},
+						pos:  position{line: 1776, col: 12, offset: 43652},
 						name: "D4",
 					},
 					&litMatcher{
-						pos:        position{line: 1659, col: 15, offset: 40078},
+						pos:        position{line: 1776, col: 15, offset: 43655},
 						val:        "-",
 						ignoreCase: false,
 						want:       "\"-\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1659, col: 19, offset: 40082},
+						pos:  position{line: 1776, col: 19, offset: 43659},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1659, col: 22, offset: 40085},
+						pos:        position{line: 1776, col: 22, offset: 43662},
 						val:        "-",
 						ignoreCase: false,
 						want:       "\"-\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1659, col: 26, offset: 40089},
+						pos:  position{line: 1776, col: 26, offset: 43666},
 						name: "D2",
 					},
 				},
@@ -10812,33 +11743,33 @@ var g = &grammar{
 		},
 		{
 			name: "D4",
-			pos:  position{line: 1661, col: 1, offset: 40093},
+			pos:  position{line: 1778, col: 1, offset: 43670},
 			expr: &seqExpr{
-				pos: position{line: 1661, col: 6, offset: 40098},
+				pos: position{line: 1778, col: 6, offset: 43675},
 				exprs: []any{
 					&charClassMatcher{
-						pos:        position{line: 1661, col: 6, offset: 40098},
+						pos:        position{line: 1778, col: 6, offset: 43675},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1661, col: 11, offset: 40103},
+						pos:        position{line: 1778, col: 11, offset: 43680},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1661, col: 16, offset: 40108},
+						pos:        position{line: 1778, col: 16, offset: 43685},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1661, col: 21, offset: 40113},
+						pos:        position{line: 1778, col: 21, offset: 43690},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10851,19 +11782,19 @@ var g = &grammar{
 		},
 		{
 			name: "D2",
-			pos:  position{line: 1662, col: 1, offset: 40119},
+			pos:  position{line: 1779, col: 1, offset: 43696},
 			expr: &seqExpr{
-				pos: position{line: 1662, col: 6, offset: 40124},
+				pos: position{line: 1779, col: 6, offset: 43701},
 				exprs: []any{
 					&charClassMatcher{
-						pos:        position{line: 1662, col: 6, offset: 40124},
+						pos:        position{line: 1779, col: 6, offset: 43701},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
 						inverted:   false,
 					},
 					&charClassMatcher{
-						pos:        position{line: 1662, col: 11, offset: 40129},
+						pos:        position{line: 1779, col: 11, offset: 43706},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -10876,16 +11807,16 @@ var g = &grammar{
 		},
 		{
 			name: "FullTime",
-			pos:  position{line: 1664, col: 1, offset: 40136},
+			pos:  position{line: 1781, col: 1, offset: 43713},
 			expr: &seqExpr{
-				pos: position{line: 1664, col: 12, offset: 40147},
+				pos: position{line: 1781, col: 12, offset: 43724},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1664, col: 12, offset: 40147},
+						pos:  position{line: 1781, col: 12, offset: 43724},
 						name: "PartialTime",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1664, col: 24, offset: 40159},
+						pos:  position{line: 1781, col: 24, offset: 43736},
 						name: "TimeOffset",
 					},
 				},
@@ -10895,49 +11826,49 @@ var g = &grammar{
 		},
 		{
 			name: "PartialTime",
-			pos:  position{line: 1666, col: 1, offset: 40171},
+			pos:  position{line: 1783, col: 1, offset: 43748},
 			expr: &seqExpr{
-				pos: position{line: 1666, col: 15, offset: 40185},
+				pos: position{line: 1783, col: 15, offset: 43762},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1666, col: 15, offset: 40185},
+						pos:  position{line: 1783, col: 15, offset: 43762},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1666, col: 18, offset: 40188},
+						pos:        position{line: 1783, col: 18, offset: 43765},
 						val:        ":",
 						ignoreCase: false,
 						want:       "\":\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1666, col: 22, offset: 40192},
+						pos:  position{line: 1783, col: 22, offset: 43769},
 						name: "D2",
 					},
 					&litMatcher{
-						pos:        position{line: 1666, col: 25, offset: 40195},
+						pos:        position{line: 1783, col: 25, offset: 43772},
 						val:        ":",
 						ignoreCase: false,
 						want:       "\":\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1666, col: 29, offset: 40199},
+						pos:  position{line: 1783, col: 29, offset: 43776},
 						name: "D2",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1666, col: 32, offset: 40202},
+						pos: position{line: 1783, col: 32, offset: 43779},
 						expr: &seqExpr{
-							pos: position{line: 1666, col: 33, offset: 40203},
+							pos: position{line: 1783, col: 33, offset: 43780},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1666, col: 33, offset: 40203},
+									pos:        position{line: 1783, col: 33, offset: 43780},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1666, col: 37, offset: 40207},
+									pos: position{line: 1783, col: 37, offset: 43784},
 									expr: &charClassMatcher{
-										pos:        position{line: 1666, col: 37, offset: 40207},
+										pos:        position{line: 1783, col: 37, offset: 43784},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -10954,30 +11885,30 @@ var g = &grammar{
 		},
 		{
 			name: "TimeOffset",
-			pos:  position{line: 1668, col: 1, offset: 40217},
+			pos:  position{line: 1785, col: 1, offset: 43794},
 			expr: &choiceExpr{
-				pos: position{line: 1669, col: 5, offset: 40232},
+				pos: position{line: 1786, col: 5, offset: 43809},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1669, col: 5, offset: 40232},
+						pos:        position{line: 1786, col: 5, offset: 43809},
 						val:        "Z",
 						ignoreCase: false,
 						want:       "\"Z\"",
 					},
 					&seqExpr{
-						pos: position{line: 1670, col: 5, offset: 40240},
+						pos: position{line: 1787, col: 5, offset: 43817},
 						exprs: []any{
 							&choiceExpr{
-								pos: position{line: 1670, col: 6, offset: 40241},
+								pos: position{line: 1787, col: 6, offset: 43818},
 								alternatives: []any{
 									&litMatcher{
-										pos:        position{line: 1670, col: 6, offset: 40241},
+										pos:        position{line: 1787, col: 6, offset: 43818},
 										val:        "+",
 										ignoreCase: false,
 										want:       "\"+\"",
 									},
 									&litMatcher{
-										pos:        position{line: 1670, col: 12, offset: 40247},
+										pos:        position{line: 1787, col: 12, offset: 43824},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
@@ -10985,34 +11916,34 @@ var g = &grammar{
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1670, col: 17, offset: 40252},
+								pos:  position{line: 1787, col: 17, offset: 43829},
 								name: "D2",
 							},
 							&litMatcher{
-								pos:        position{line: 1670, col: 20, offset: 40255},
+								pos:        position{line: 1787, col: 20, offset: 43832},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1670, col: 24, offset: 40259},
+								pos:  position{line: 1787, col: 24, offset: 43836},
 								name: "D2",
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1670, col: 27, offset: 40262},
+								pos: position{line: 1787, col: 27, offset: 43839},
 								expr: &seqExpr{
-									pos: position{line: 1670, col: 28, offset: 40263},
+									pos: position{line: 1787, col: 28, offset: 43840},
 									exprs: []any{
 										&litMatcher{
-											pos:        position{line: 1670, col: 28, offset: 40263},
+											pos:        position{line: 1787, col: 28, offset: 43840},
 											val:        ".",
 											ignoreCase: false,
 											want:       "\".\"",
 										},
 										&oneOrMoreExpr{
-											pos: position{line: 1670, col: 32, offset: 40267},
+											pos: position{line: 1787, col: 32, offset: 43844},
 											expr: &charClassMatcher{
-												pos:        position{line: 1670, col: 32, offset: 40267},
+												pos:        position{line: 1787, col: 32, offset: 43844},
 												val:        "[0-9]",
 												ranges:     []rune{'0', '9'},
 												ignoreCase: false,
@@ -11031,33 +11962,33 @@ var g = &grammar{
 		},
 		{
 			name: "Duration",
-			pos:  position{line: 1672, col: 1, offset: 40277},
+			pos:  position{line: 1789, col: 1, offset: 43854},
 			expr: &actionExpr{
-				pos: position{line: 1673, col: 5, offset: 40290},
+				pos: position{line: 1790, col: 5, offset: 43867},
 				run: (*parser).callonDuration1,
 				expr: &seqExpr{
-					pos: position{line: 1673, col: 5, offset: 40290},
+					pos: position{line: 1790, col: 5, offset: 43867},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 1673, col: 5, offset: 40290},
+							pos: position{line: 1790, col: 5, offset: 43867},
 							expr: &litMatcher{
-								pos:        position{line: 1673, col: 5, offset: 40290},
+								pos:        position{line: 1790, col: 5, offset: 43867},
 								val:        "-",
 								ignoreCase: false,
 								want:       "\"-\"",
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 1673, col: 10, offset: 40295},
+							pos: position{line: 1790, col: 10, offset: 43872},
 							expr: &seqExpr{
-								pos: position{line: 1673, col: 11, offset: 40296},
+								pos: position{line: 1790, col: 11, offset: 43873},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1673, col: 11, offset: 40296},
+										pos:  position{line: 1790, col: 11, offset: 43873},
 										name: "Decimal",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1673, col: 19, offset: 40304},
+										pos:  position{line: 1790, col: 19, offset: 43881},
 										name: "TimeUnit",
 									},
 								},
@@ -11071,27 +12002,27 @@ var g = &grammar{
 		},
 		{
 			name: "Decimal",
-			pos:  position{line: 1677, col: 1, offset: 40386},
+			pos:  position{line: 1794, col: 1, offset: 43963},
 			expr: &seqExpr{
-				pos: position{line: 1677, col: 11, offset: 40396},
+				pos: position{line: 1794, col: 11, offset: 43973},
 				exprs: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1677, col: 11, offset: 40396},
+						pos:  position{line: 1794, col: 11, offset: 43973},
 						name: "UInt",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1677, col: 16, offset: 40401},
+						pos: position{line: 1794, col: 16, offset: 43978},
 						expr: &seqExpr{
-							pos: position{line: 1677, col: 17, offset: 40402},
+							pos: position{line: 1794, col: 17, offset: 43979},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1677, col: 17, offset: 40402},
+									pos:        position{line: 1794, col: 17, offset: 43979},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1677, col: 21, offset: 40406},
+									pos:  position{line: 1794, col: 21, offset: 43983},
 									name: "UInt",
 								},
 							},
@@ -11104,60 +12035,60 @@ var g = &grammar{
 		},
 		{
 			name: "TimeUnit",
-			pos:  position{line: 1679, col: 1, offset: 40414},
+			pos:  position{line: 1796, col: 1, offset: 43991},
 			expr: &choiceExpr{
-				pos: position{line: 1680, col: 5, offset: 40427},
+				pos: position{line: 1797, col: 5, offset: 44004},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1680, col: 5, offset: 40427},
+						pos:        position{line: 1797, col: 5, offset: 44004},
 						val:        "ns",
 						ignoreCase: false,
 						want:       "\"ns\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1681, col: 5, offset: 40436},
+						pos:        position{line: 1798, col: 5, offset: 44013},
 						val:        "us",
 						ignoreCase: false,
 						want:       "\"us\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1682, col: 5, offset: 40445},
+						pos:        position{line: 1799, col: 5, offset: 44022},
 						val:        "ms",
 						ignoreCase: false,
 						want:       "\"ms\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1683, col: 5, offset: 40454},
+						pos:        position{line: 1800, col: 5, offset: 44031},
 						val:        "s",
 						ignoreCase: false,
 						want:       "\"s\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1684, col: 5, offset: 40462},
+						pos:        position{line: 1801, col: 5, offset: 44039},
 						val:        "m",
 						ignoreCase: false,
 						want:       "\"m\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1685, col: 5, offset: 40470},
+						pos:        position{line: 1802, col: 5, offset: 44047},
 						val:        "h",
 						ignoreCase: false,
 						want:       "\"h\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1686, col: 5, offset: 40478},
+						pos:        position{line: 1803, col: 5, offset: 44055},
 						val:        "d",
 						ignoreCase: false,
 						want:       "\"d\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1687, col: 5, offset: 40486},
+						pos:        position{line: 1804, col: 5, offset: 44063},
 						val:        "w",
 						ignoreCase: false,
 						want:       "\"w\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1688, col: 5, offset: 40494},
+						pos:        position{line: 1805, col: 5, offset: 44071},
 						val:        "y",
 						ignoreCase: false,
 						want:       "\"y\"",
@@ -11169,45 +12100,45 @@ var g = &grammar{
 		},
 		{
 			name: "IP",
-			pos:  position{line: 1690, col: 1, offset: 40499},
+			pos:  position{line: 1807, col: 1, offset: 44076},
 			expr: &actionExpr{
-				pos: position{line: 1691, col: 5, offset: 40506},
+				pos: position{line: 1808, col: 5, offset: 44083},
 				run: (*parser).callonIP1,
 				expr: &seqExpr{
-					pos: position{line: 1691, col: 5, offset: 40506},
+					pos: position{line: 1808, col: 5, offset: 44083},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1691, col: 5, offset: 40506},
+							pos:  position{line: 1808, col: 5, offset: 44083},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1691, col: 10, offset: 40511},
+							pos:        position{line: 1808, col: 10, offset: 44088},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1691, col: 14, offset: 40515},
+							pos:  position{line: 1808, col: 14, offset: 44092},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1691, col: 19, offset: 40520},
+							pos:        position{line: 1808, col: 19, offset: 44097},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1691, col: 23, offset: 40524},
+							pos:  position{line: 1808, col: 23, offset: 44101},
 							name: "UInt",
 						},
 						&litMatcher{
-							pos:        position{line: 1691, col: 28, offset: 40529},
+							pos:        position{line: 1808, col: 28, offset: 44106},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1691, col: 32, offset: 40533},
+							pos:  position{line: 1808, col: 32, offset: 44110},
 							name: "UInt",
 						},
 					},
@@ -11218,43 +12149,43 @@ var g = &grammar{
 		},
 		{
 			name: "IP6",
-			pos:  position{line: 1693, col: 1, offset: 40570},
+			pos:  position{line: 1810, col: 1, offset: 44147},
 			expr: &actionExpr{
-				pos: position{line: 1694, col: 5, offset: 40578},
+				pos: position{line: 1811, col: 5, offset: 44155},
 				run: (*parser).callonIP61,
 				expr: &seqExpr{
-					pos: position{line: 1694, col: 5, offset: 40578},
+					pos: position{line: 1811, col: 5, offset: 44155},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 1694, col: 5, offset: 40578},
+							pos: position{line: 1811, col: 5, offset: 44155},
 							expr: &seqExpr{
-								pos: position{line: 1694, col: 7, offset: 40580},
+								pos: position{line: 1811, col: 7, offset: 44157},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1694, col: 7, offset: 40580},
+										pos:  position{line: 1811, col: 7, offset: 44157},
 										name: "Hex",
 									},
 									&litMatcher{
-										pos:        position{line: 1694, col: 11, offset: 40584},
+										pos:        position{line: 1811, col: 11, offset: 44161},
 										val:        ":",
 										ignoreCase: false,
 										want:       "\":\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1694, col: 15, offset: 40588},
+										pos:  position{line: 1811, col: 15, offset: 44165},
 										name: "Hex",
 									},
 									&notExpr{
-										pos: position{line: 1694, col: 19, offset: 40592},
+										pos: position{line: 1811, col: 19, offset: 44169},
 										expr: &choiceExpr{
-											pos: position{line: 1694, col: 21, offset: 40594},
+											pos: position{line: 1811, col: 21, offset: 44171},
 											alternatives: []any{
 												&ruleRefExpr{
-													pos:  position{line: 1694, col: 21, offset: 40594},
+													pos:  position{line: 1811, col: 21, offset: 44171},
 													name: "HexDigit",
 												},
 												&litMatcher{
-													pos:        position{line: 1694, col: 32, offset: 40605},
+													pos:        position{line: 1811, col: 32, offset: 44182},
 													val:        ":",
 													ignoreCase: false,
 													want:       "\":\"",
@@ -11266,10 +12197,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1694, col: 38, offset: 40611},
+							pos:   position{line: 1811, col: 38, offset: 44188},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1694, col: 40, offset: 40613},
+								pos:  position{line: 1811, col: 40, offset: 44190},
 								name: "IP6Variations",
 							},
 						},
@@ -11281,32 +12212,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Variations",
-			pos:  position{line: 1698, col: 1, offset: 40777},
+			pos:  position{line: 1815, col: 1, offset: 44354},
 			expr: &choiceExpr{
-				pos: position{line: 1699, col: 5, offset: 40795},
+				pos: position{line: 1816, col: 5, offset: 44372},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1699, col: 5, offset: 40795},
+						pos: position{line: 1816, col: 5, offset: 44372},
 						run: (*parser).callonIP6Variations2,
 						expr: &seqExpr{
-							pos: position{line: 1699, col: 5, offset: 40795},
+							pos: position{line: 1816, col: 5, offset: 44372},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1699, col: 5, offset: 40795},
+									pos:   position{line: 1816, col: 5, offset: 44372},
 									label: "a",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 1699, col: 7, offset: 40797},
+										pos: position{line: 1816, col: 7, offset: 44374},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1699, col: 7, offset: 40797},
+											pos:  position{line: 1816, col: 7, offset: 44374},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1699, col: 17, offset: 40807},
+									pos:   position{line: 1816, col: 17, offset: 44384},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1699, col: 19, offset: 40809},
+										pos:  position{line: 1816, col: 19, offset: 44386},
 										name: "IP6Tail",
 									},
 								},
@@ -11314,52 +12245,52 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1702, col: 5, offset: 40873},
+						pos: position{line: 1819, col: 5, offset: 44450},
 						run: (*parser).callonIP6Variations9,
 						expr: &seqExpr{
-							pos: position{line: 1702, col: 5, offset: 40873},
+							pos: position{line: 1819, col: 5, offset: 44450},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1702, col: 5, offset: 40873},
+									pos:   position{line: 1819, col: 5, offset: 44450},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1702, col: 7, offset: 40875},
+										pos:  position{line: 1819, col: 7, offset: 44452},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1702, col: 11, offset: 40879},
+									pos:   position{line: 1819, col: 11, offset: 44456},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1702, col: 13, offset: 40881},
+										pos: position{line: 1819, col: 13, offset: 44458},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1702, col: 13, offset: 40881},
+											pos:  position{line: 1819, col: 13, offset: 44458},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1702, col: 23, offset: 40891},
+									pos:        position{line: 1819, col: 23, offset: 44468},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1702, col: 28, offset: 40896},
+									pos:   position{line: 1819, col: 28, offset: 44473},
 									label: "d",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1702, col: 30, offset: 40898},
+										pos: position{line: 1819, col: 30, offset: 44475},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1702, col: 30, offset: 40898},
+											pos:  position{line: 1819, col: 30, offset: 44475},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1702, col: 40, offset: 40908},
+									pos:   position{line: 1819, col: 40, offset: 44485},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1702, col: 42, offset: 40910},
+										pos:  position{line: 1819, col: 42, offset: 44487},
 										name: "IP6Tail",
 									},
 								},
@@ -11367,33 +12298,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1705, col: 5, offset: 41009},
+						pos: position{line: 1822, col: 5, offset: 44586},
 						run: (*parser).callonIP6Variations22,
 						expr: &seqExpr{
-							pos: position{line: 1705, col: 5, offset: 41009},
+							pos: position{line: 1822, col: 5, offset: 44586},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1705, col: 5, offset: 41009},
+									pos:        position{line: 1822, col: 5, offset: 44586},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1705, col: 10, offset: 41014},
+									pos:   position{line: 1822, col: 10, offset: 44591},
 									label: "a",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1705, col: 12, offset: 41016},
+										pos: position{line: 1822, col: 12, offset: 44593},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1705, col: 12, offset: 41016},
+											pos:  position{line: 1822, col: 12, offset: 44593},
 											name: "HexColon",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1705, col: 22, offset: 41026},
+									pos:   position{line: 1822, col: 22, offset: 44603},
 									label: "b",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1705, col: 24, offset: 41028},
+										pos:  position{line: 1822, col: 24, offset: 44605},
 										name: "IP6Tail",
 									},
 								},
@@ -11401,40 +12332,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1708, col: 5, offset: 41099},
+						pos: position{line: 1825, col: 5, offset: 44676},
 						run: (*parser).callonIP6Variations30,
 						expr: &seqExpr{
-							pos: position{line: 1708, col: 5, offset: 41099},
+							pos: position{line: 1825, col: 5, offset: 44676},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 1708, col: 5, offset: 41099},
+									pos:   position{line: 1825, col: 5, offset: 44676},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1708, col: 7, offset: 41101},
+										pos:  position{line: 1825, col: 7, offset: 44678},
 										name: "Hex",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1708, col: 11, offset: 41105},
+									pos:   position{line: 1825, col: 11, offset: 44682},
 									label: "b",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1708, col: 13, offset: 41107},
+										pos: position{line: 1825, col: 13, offset: 44684},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1708, col: 13, offset: 41107},
+											pos:  position{line: 1825, col: 13, offset: 44684},
 											name: "ColonHex",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1708, col: 23, offset: 41117},
+									pos:        position{line: 1825, col: 23, offset: 44694},
 									val:        "::",
 									ignoreCase: false,
 									want:       "\"::\"",
 								},
 								&notExpr{
-									pos: position{line: 1708, col: 28, offset: 41122},
+									pos: position{line: 1825, col: 28, offset: 44699},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1708, col: 29, offset: 41123},
+										pos:  position{line: 1825, col: 29, offset: 44700},
 										name: "TypeAsValue",
 									},
 								},
@@ -11442,10 +12373,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1711, col: 5, offset: 41198},
+						pos: position{line: 1828, col: 5, offset: 44775},
 						run: (*parser).callonIP6Variations40,
 						expr: &litMatcher{
-							pos:        position{line: 1711, col: 5, offset: 41198},
+							pos:        position{line: 1828, col: 5, offset: 44775},
 							val:        "::",
 							ignoreCase: false,
 							want:       "\"::\"",
@@ -11458,16 +12389,16 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Tail",
-			pos:  position{line: 1715, col: 1, offset: 41235},
+			pos:  position{line: 1832, col: 1, offset: 44812},
 			expr: &choiceExpr{
-				pos: position{line: 1716, col: 5, offset: 41247},
+				pos: position{line: 1833, col: 5, offset: 44824},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1716, col: 5, offset: 41247},
+						pos:  position{line: 1833, col: 5, offset: 44824},
 						name: "IP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1717, col: 5, offset: 41254},
+						pos:  position{line: 1834, col: 5, offset: 44831},
 						name: "Hex",
 					},
 				},
@@ -11477,24 +12408,24 @@ var g = &grammar{
 		},
 		{
 			name: "ColonHex",
-			pos:  position{line: 1719, col: 1, offset: 41259},
+			pos:  position{line: 1836, col: 1, offset: 44836},
 			expr: &actionExpr{
-				pos: position{line: 1719, col: 12, offset: 41270},
+				pos: position{line: 1836, col: 12, offset: 44847},
 				run: (*parser).callonColonHex1,
 				expr: &seqExpr{
-					pos: position{line: 1719, col: 12, offset: 41270},
+					pos: position{line: 1836, col: 12, offset: 44847},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1719, col: 12, offset: 41270},
+							pos:        position{line: 1836, col: 12, offset: 44847},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1719, col: 16, offset: 41274},
+							pos:   position{line: 1836, col: 16, offset: 44851},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1719, col: 18, offset: 41276},
+								pos:  position{line: 1836, col: 18, offset: 44853},
 								name: "Hex",
 							},
 						},
@@ -11506,23 +12437,23 @@ var g = &grammar{
 		},
 		{
 			name: "HexColon",
-			pos:  position{line: 1721, col: 1, offset: 41314},
+			pos:  position{line: 1838, col: 1, offset: 44891},
 			expr: &actionExpr{
-				pos: position{line: 1721, col: 12, offset: 41325},
+				pos: position{line: 1838, col: 12, offset: 44902},
 				run: (*parser).callonHexColon1,
 				expr: &seqExpr{
-					pos: position{line: 1721, col: 12, offset: 41325},
+					pos: position{line: 1838, col: 12, offset: 44902},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1721, col: 12, offset: 41325},
+							pos:   position{line: 1838, col: 12, offset: 44902},
 							label: "v",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1721, col: 14, offset: 41327},
+								pos:  position{line: 1838, col: 14, offset: 44904},
 								name: "Hex",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1721, col: 18, offset: 41331},
+							pos:        position{line: 1838, col: 18, offset: 44908},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
@@ -11535,32 +12466,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP4Net",
-			pos:  position{line: 1723, col: 1, offset: 41369},
+			pos:  position{line: 1840, col: 1, offset: 44946},
 			expr: &actionExpr{
-				pos: position{line: 1724, col: 5, offset: 41380},
+				pos: position{line: 1841, col: 5, offset: 44957},
 				run: (*parser).callonIP4Net1,
 				expr: &seqExpr{
-					pos: position{line: 1724, col: 5, offset: 41380},
+					pos: position{line: 1841, col: 5, offset: 44957},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1724, col: 5, offset: 41380},
+							pos:   position{line: 1841, col: 5, offset: 44957},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1724, col: 7, offset: 41382},
+								pos:  position{line: 1841, col: 7, offset: 44959},
 								name: "IP",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1724, col: 10, offset: 41385},
+							pos:        position{line: 1841, col: 10, offset: 44962},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1724, col: 14, offset: 41389},
+							pos:   position{line: 1841, col: 14, offset: 44966},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1724, col: 16, offset: 41391},
+								pos:  position{line: 1841, col: 16, offset: 44968},
 								name: "UIntString",
 							},
 						},
@@ -11572,32 +12503,32 @@ var g = &grammar{
 		},
 		{
 			name: "IP6Net",
-			pos:  position{line: 1728, col: 1, offset: 41459},
+			pos:  position{line: 1845, col: 1, offset: 45036},
 			expr: &actionExpr{
-				pos: position{line: 1729, col: 5, offset: 41470},
+				pos: position{line: 1846, col: 5, offset: 45047},
 				run: (*parser).callonIP6Net1,
 				expr: &seqExpr{
-					pos: position{line: 1729, col: 5, offset: 41470},
+					pos: position{line: 1846, col: 5, offset: 45047},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1729, col: 5, offset: 41470},
+							pos:   position{line: 1846, col: 5, offset: 45047},
 							label: "a",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1729, col: 7, offset: 41472},
+								pos:  position{line: 1846, col: 7, offset: 45049},
 								name: "IP6",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1729, col: 11, offset: 41476},
+							pos:        position{line: 1846, col: 11, offset: 45053},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1729, col: 15, offset: 41480},
+							pos:   position{line: 1846, col: 15, offset: 45057},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1729, col: 17, offset: 41482},
+								pos:  position{line: 1846, col: 17, offset: 45059},
 								name: "UIntString",
 							},
 						},
@@ -11609,15 +12540,15 @@ var g = &grammar{
 		},
 		{
 			name: "UInt",
-			pos:  position{line: 1733, col: 1, offset: 41550},
+			pos:  position{line: 1850, col: 1, offset: 45127},
 			expr: &actionExpr{
-				pos: position{line: 1734, col: 4, offset: 41558},
+				pos: position{line: 1851, col: 4, offset: 45135},
 				run: (*parser).callonUInt1,
 				expr: &labeledExpr{
-					pos:   position{line: 1734, col: 4, offset: 41558},
+					pos:   position{line: 1851, col: 4, offset: 45135},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1734, col: 6, offset: 41560},
+						pos:  position{line: 1851, col: 6, offset: 45137},
 						name: "UIntString",
 					},
 				},
@@ -11627,16 +12558,16 @@ var g = &grammar{
 		},
 		{
 			name: "IntString",
-			pos:  position{line: 1736, col: 1, offset: 41600},
+			pos:  position{line: 1853, col: 1, offset: 45177},
 			expr: &choiceExpr{
-				pos: position{line: 1737, col: 5, offset: 41614},
+				pos: position{line: 1854, col: 5, offset: 45191},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1737, col: 5, offset: 41614},
+						pos:  position{line: 1854, col: 5, offset: 45191},
 						name: "UIntString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1738, col: 5, offset: 41629},
+						pos:  position{line: 1855, col: 5, offset: 45206},
 						name: "MinusIntString",
 					},
 				},
@@ -11646,14 +12577,14 @@ var g = &grammar{
 		},
 		{
 			name: "UIntString",
-			pos:  position{line: 1740, col: 1, offset: 41645},
+			pos:  position{line: 1857, col: 1, offset: 45222},
 			expr: &actionExpr{
-				pos: position{line: 1740, col: 14, offset: 41658},
+				pos: position{line: 1857, col: 14, offset: 45235},
 				run: (*parser).callonUIntString1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1740, col: 14, offset: 41658},
+					pos: position{line: 1857, col: 14, offset: 45235},
 					expr: &charClassMatcher{
-						pos:        position{line: 1740, col: 14, offset: 41658},
+						pos:        position{line: 1857, col: 14, offset: 45235},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -11666,21 +12597,21 @@ var g = &grammar{
 		},
 		{
 			name: "MinusIntString",
-			pos:  position{line: 1742, col: 1, offset: 41697},
+			pos:  position{line: 1859, col: 1, offset: 45274},
 			expr: &actionExpr{
-				pos: position{line: 1743, col: 5, offset: 41716},
+				pos: position{line: 1860, col: 5, offset: 45293},
 				run: (*parser).callonMinusIntString1,
 				expr: &seqExpr{
-					pos: position{line: 1743, col: 5, offset: 41716},
+					pos: position{line: 1860, col: 5, offset: 45293},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1743, col: 5, offset: 41716},
+							pos:        position{line: 1860, col: 5, offset: 45293},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1743, col: 9, offset: 41720},
+							pos:  position{line: 1860, col: 9, offset: 45297},
 							name: "UIntString",
 						},
 					},
@@ -11691,29 +12622,29 @@ var g = &grammar{
 		},
 		{
 			name: "FloatString",
-			pos:  position{line: 1745, col: 1, offset: 41763},
+			pos:  position{line: 1862, col: 1, offset: 45340},
 			expr: &choiceExpr{
-				pos: position{line: 1746, col: 5, offset: 41779},
+				pos: position{line: 1863, col: 5, offset: 45356},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1746, col: 5, offset: 41779},
+						pos: position{line: 1863, col: 5, offset: 45356},
 						run: (*parser).callonFloatString2,
 						expr: &seqExpr{
-							pos: position{line: 1746, col: 5, offset: 41779},
+							pos: position{line: 1863, col: 5, offset: 45356},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 1746, col: 5, offset: 41779},
+									pos: position{line: 1863, col: 5, offset: 45356},
 									expr: &litMatcher{
-										pos:        position{line: 1746, col: 5, offset: 41779},
+										pos:        position{line: 1863, col: 5, offset: 45356},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1746, col: 10, offset: 41784},
+									pos: position{line: 1863, col: 10, offset: 45361},
 									expr: &charClassMatcher{
-										pos:        position{line: 1746, col: 10, offset: 41784},
+										pos:        position{line: 1863, col: 10, offset: 45361},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11721,15 +12652,15 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1746, col: 17, offset: 41791},
+									pos:        position{line: 1863, col: 17, offset: 45368},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1746, col: 21, offset: 41795},
+									pos: position{line: 1863, col: 21, offset: 45372},
 									expr: &charClassMatcher{
-										pos:        position{line: 1746, col: 21, offset: 41795},
+										pos:        position{line: 1863, col: 21, offset: 45372},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11737,9 +12668,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1746, col: 28, offset: 41802},
+									pos: position{line: 1863, col: 28, offset: 45379},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1746, col: 28, offset: 41802},
+										pos:  position{line: 1863, col: 28, offset: 45379},
 										name: "ExponentPart",
 									},
 								},
@@ -11747,30 +12678,30 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1747, col: 5, offset: 41851},
+						pos: position{line: 1864, col: 5, offset: 45428},
 						run: (*parser).callonFloatString13,
 						expr: &seqExpr{
-							pos: position{line: 1747, col: 5, offset: 41851},
+							pos: position{line: 1864, col: 5, offset: 45428},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 1747, col: 5, offset: 41851},
+									pos: position{line: 1864, col: 5, offset: 45428},
 									expr: &litMatcher{
-										pos:        position{line: 1747, col: 5, offset: 41851},
+										pos:        position{line: 1864, col: 5, offset: 45428},
 										val:        "-",
 										ignoreCase: false,
 										want:       "\"-\"",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1747, col: 10, offset: 41856},
+									pos:        position{line: 1864, col: 10, offset: 45433},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1747, col: 14, offset: 41860},
+									pos: position{line: 1864, col: 14, offset: 45437},
 									expr: &charClassMatcher{
-										pos:        position{line: 1747, col: 14, offset: 41860},
+										pos:        position{line: 1864, col: 14, offset: 45437},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -11778,9 +12709,9 @@ var g = &grammar{
 									},
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 1747, col: 21, offset: 41867},
+									pos: position{line: 1864, col: 21, offset: 45444},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1747, col: 21, offset: 41867},
+										pos:  position{line: 1864, col: 21, offset: 45444},
 										name: "ExponentPart",
 									},
 								},
@@ -11788,17 +12719,17 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1748, col: 5, offset: 41916},
+						pos: position{line: 1865, col: 5, offset: 45493},
 						run: (*parser).callonFloatString22,
 						expr: &choiceExpr{
-							pos: position{line: 1748, col: 6, offset: 41917},
+							pos: position{line: 1865, col: 6, offset: 45494},
 							alternatives: []any{
 								&ruleRefExpr{
-									pos:  position{line: 1748, col: 6, offset: 41917},
+									pos:  position{line: 1865, col: 6, offset: 45494},
 									name: "NaN",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1748, col: 12, offset: 41923},
+									pos:  position{line: 1865, col: 12, offset: 45500},
 									name: "Infinity",
 								},
 							},
@@ -11811,20 +12742,20 @@ var g = &grammar{
 		},
 		{
 			name: "ExponentPart",
-			pos:  position{line: 1751, col: 1, offset: 41966},
+			pos:  position{line: 1868, col: 1, offset: 45543},
 			expr: &seqExpr{
-				pos: position{line: 1751, col: 16, offset: 41981},
+				pos: position{line: 1868, col: 16, offset: 45558},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1751, col: 16, offset: 41981},
+						pos:        position{line: 1868, col: 16, offset: 45558},
 						val:        "e",
 						ignoreCase: true,
 						want:       "\"e\"i",
 					},
 					&zeroOrOneExpr{
-						pos: position{line: 1751, col: 21, offset: 41986},
+						pos: position{line: 1868, col: 21, offset: 45563},
 						expr: &charClassMatcher{
-							pos:        position{line: 1751, col: 21, offset: 41986},
+							pos:        position{line: 1868, col: 21, offset: 45563},
 							val:        "[+-]",
 							chars:      []rune{'+', '-'},
 							ignoreCase: false,
@@ -11832,7 +12763,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1751, col: 27, offset: 41992},
+						pos:  position{line: 1868, col: 27, offset: 45569},
 						name: "UIntString",
 					},
 				},
@@ -11842,9 +12773,9 @@ var g = &grammar{
 		},
 		{
 			name: "NaN",
-			pos:  position{line: 1753, col: 1, offset: 42004},
+			pos:  position{line: 1870, col: 1, offset: 45581},
 			expr: &litMatcher{
-				pos:        position{line: 1753, col: 7, offset: 42010},
+				pos:        position{line: 1870, col: 7, offset: 45587},
 				val:        "NaN",
 				ignoreCase: false,
 				want:       "\"NaN\"",
@@ -11854,23 +12785,23 @@ var g = &grammar{
 		},
 		{
 			name: "Infinity",
-			pos:  position{line: 1755, col: 1, offset: 42017},
+			pos:  position{line: 1872, col: 1, offset: 45594},
 			expr: &seqExpr{
-				pos: position{line: 1755, col: 12, offset: 42028},
+				pos: position{line: 1872, col: 12, offset: 45605},
 				exprs: []any{
 					&zeroOrOneExpr{
-						pos: position{line: 1755, col: 12, offset: 42028},
+						pos: position{line: 1872, col: 12, offset: 45605},
 						expr: &choiceExpr{
-							pos: position{line: 1755, col: 13, offset: 42029},
+							pos: position{line: 1872, col: 13, offset: 45606},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 1755, col: 13, offset: 42029},
+									pos:        position{line: 1872, col: 13, offset: 45606},
 									val:        "-",
 									ignoreCase: false,
 									want:       "\"-\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1755, col: 19, offset: 42035},
+									pos:        position{line: 1872, col: 19, offset: 45612},
 									val:        "+",
 									ignoreCase: false,
 									want:       "\"+\"",
@@ -11879,7 +12810,7 @@ var g = &grammar{
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1755, col: 25, offset: 42041},
+						pos:        position{line: 1872, col: 25, offset: 45618},
 						val:        "Inf",
 						ignoreCase: false,
 						want:       "\"Inf\"",
@@ -11891,14 +12822,14 @@ var g = &grammar{
 		},
 		{
 			name: "Hex",
-			pos:  position{line: 1757, col: 1, offset: 42048},
+			pos:  position{line: 1874, col: 1, offset: 45625},
 			expr: &actionExpr{
-				pos: position{line: 1757, col: 7, offset: 42054},
+				pos: position{line: 1874, col: 7, offset: 45631},
 				run: (*parser).callonHex1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1757, col: 7, offset: 42054},
+					pos: position{line: 1874, col: 7, offset: 45631},
 					expr: &ruleRefExpr{
-						pos:  position{line: 1757, col: 7, offset: 42054},
+						pos:  position{line: 1874, col: 7, offset: 45631},
 						name: "HexDigit",
 					},
 				},
@@ -11908,9 +12839,9 @@ var g = &grammar{
 		},
 		{
 			name: "HexDigit",
-			pos:  position{line: 1759, col: 1, offset: 42096},
+			pos:  position{line: 1876, col: 1, offset: 45673},
 			expr: &charClassMatcher{
-				pos:        position{line: 1759, col: 12, offset: 42107},
+				pos:        position{line: 1876, col: 12, offset: 45684},
 				val:        "[0-9a-fA-F]",
 				ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
 				ignoreCase: false,
@@ -11921,32 +12852,32 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedString",
-			pos:  position{line: 1761, col: 1, offset: 42120},
+			pos:  position{line: 1878, col: 1, offset: 45697},
 			expr: &actionExpr{
-				pos: position{line: 1762, col: 5, offset: 42143},
+				pos: position{line: 1879, col: 5, offset: 45720},
 				run: (*parser).callonSingleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 1762, col: 5, offset: 42143},
+					pos: position{line: 1879, col: 5, offset: 45720},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1762, col: 5, offset: 42143},
+							pos:        position{line: 1879, col: 5, offset: 45720},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1762, col: 9, offset: 42147},
+							pos:   position{line: 1879, col: 9, offset: 45724},
 							label: "v",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1762, col: 11, offset: 42149},
+								pos: position{line: 1879, col: 11, offset: 45726},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1762, col: 11, offset: 42149},
+									pos:  position{line: 1879, col: 11, offset: 45726},
 									name: "SingleQuotedChar",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1762, col: 29, offset: 42167},
+							pos:        position{line: 1879, col: 29, offset: 45744},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
@@ -11959,32 +12890,32 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedString",
-			pos:  position{line: 1764, col: 1, offset: 42201},
+			pos:  position{line: 1881, col: 1, offset: 45778},
 			expr: &actionExpr{
-				pos: position{line: 1765, col: 5, offset: 42224},
+				pos: position{line: 1882, col: 5, offset: 45801},
 				run: (*parser).callonDoubleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 1765, col: 5, offset: 42224},
+					pos: position{line: 1882, col: 5, offset: 45801},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1765, col: 5, offset: 42224},
+							pos:        position{line: 1882, col: 5, offset: 45801},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1765, col: 9, offset: 42228},
+							pos:   position{line: 1882, col: 9, offset: 45805},
 							label: "v",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1765, col: 11, offset: 42230},
+								pos: position{line: 1882, col: 11, offset: 45807},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1765, col: 11, offset: 42230},
+									pos:  position{line: 1882, col: 11, offset: 45807},
 									name: "DoubleQuotedChar",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1765, col: 29, offset: 42248},
+							pos:        position{line: 1882, col: 29, offset: 45825},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -11997,57 +12928,57 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedChar",
-			pos:  position{line: 1767, col: 1, offset: 42282},
+			pos:  position{line: 1884, col: 1, offset: 45859},
 			expr: &choiceExpr{
-				pos: position{line: 1768, col: 5, offset: 42303},
+				pos: position{line: 1885, col: 5, offset: 45880},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1768, col: 5, offset: 42303},
+						pos: position{line: 1885, col: 5, offset: 45880},
 						run: (*parser).callonDoubleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1768, col: 5, offset: 42303},
+							pos: position{line: 1885, col: 5, offset: 45880},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1768, col: 5, offset: 42303},
+									pos: position{line: 1885, col: 5, offset: 45880},
 									expr: &choiceExpr{
-										pos: position{line: 1768, col: 7, offset: 42305},
+										pos: position{line: 1885, col: 7, offset: 45882},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1768, col: 7, offset: 42305},
+												pos:        position{line: 1885, col: 7, offset: 45882},
 												val:        "\"",
 												ignoreCase: false,
 												want:       "\"\\\"\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1768, col: 13, offset: 42311},
+												pos:  position{line: 1885, col: 13, offset: 45888},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1768, col: 26, offset: 42324,
+									line: 1885, col: 26, offset: 45901,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1769, col: 5, offset: 42361},
+						pos: position{line: 1886, col: 5, offset: 45938},
 						run: (*parser).callonDoubleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1769, col: 5, offset: 42361},
+							pos: position{line: 1886, col: 5, offset: 45938},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1769, col: 5, offset: 42361},
+									pos:        position{line: 1886, col: 5, offset: 45938},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1769, col: 10, offset: 42366},
+									pos:   position{line: 1886, col: 10, offset: 45943},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1769, col: 12, offset: 42368},
+										pos:  position{line: 1886, col: 12, offset: 45945},
 										name: "EscapeSequence",
 									},
 								},
@@ -12061,32 +12992,32 @@ var g = &grammar{
 		},
 		{
 			name: "RString",
-			pos:  position{line: 1771, col: 1, offset: 42402},
+			pos:  position{line: 1888, col: 1, offset: 45979},
 			expr: &choiceExpr{
-				pos: position{line: 1772, col: 5, offset: 42414},
+				pos: position{line: 1889, col: 5, offset: 45991},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1772, col: 5, offset: 42414},
+						pos: position{line: 1889, col: 5, offset: 45991},
 						run: (*parser).callonRString2,
 						expr: &seqExpr{
-							pos: position{line: 1772, col: 5, offset: 42414},
+							pos: position{line: 1889, col: 5, offset: 45991},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1772, col: 5, offset: 42414},
+									pos:        position{line: 1889, col: 5, offset: 45991},
 									val:        "r'",
 									ignoreCase: false,
 									want:       "\"r'\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1772, col: 10, offset: 42419},
+									pos:   position{line: 1889, col: 10, offset: 45996},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1772, col: 12, offset: 42421},
+										pos:  position{line: 1889, col: 12, offset: 45998},
 										name: "NoSingleQuotes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1772, col: 27, offset: 42436},
+									pos:        position{line: 1889, col: 27, offset: 46013},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
@@ -12095,33 +13026,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1773, col: 5, offset: 42471},
+						pos: position{line: 1890, col: 5, offset: 46048},
 						run: (*parser).callonRString8,
 						expr: &seqExpr{
-							pos: position{line: 1773, col: 5, offset: 42471},
+							pos: position{line: 1890, col: 5, offset: 46048},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1773, col: 5, offset: 42471},
+									pos:        position{line: 1890, col: 5, offset: 46048},
 									val:        "r",
 									ignoreCase: false,
 									want:       "\"r\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1773, col: 9, offset: 42475},
+									pos:        position{line: 1890, col: 9, offset: 46052},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1773, col: 13, offset: 42479},
+									pos:   position{line: 1890, col: 13, offset: 46056},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1773, col: 15, offset: 42481},
+										pos:  position{line: 1890, col: 15, offset: 46058},
 										name: "NoDoubleQuotes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1773, col: 30, offset: 42496},
+									pos:        position{line: 1890, col: 30, offset: 46073},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
@@ -12136,26 +13067,26 @@ var g = &grammar{
 		},
 		{
 			name: "NoSingleQuotes",
-			pos:  position{line: 1775, col: 1, offset: 42528},
+			pos:  position{line: 1892, col: 1, offset: 46105},
 			expr: &actionExpr{
-				pos: position{line: 1776, col: 5, offset: 42547},
+				pos: position{line: 1893, col: 5, offset: 46124},
 				run: (*parser).callonNoSingleQuotes1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1776, col: 5, offset: 42547},
+					pos: position{line: 1893, col: 5, offset: 46124},
 					expr: &seqExpr{
-						pos: position{line: 1776, col: 6, offset: 42548},
+						pos: position{line: 1893, col: 6, offset: 46125},
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 1776, col: 6, offset: 42548},
+								pos: position{line: 1893, col: 6, offset: 46125},
 								expr: &litMatcher{
-									pos:        position{line: 1776, col: 7, offset: 42549},
+									pos:        position{line: 1893, col: 7, offset: 46126},
 									val:        "'",
 									ignoreCase: false,
 									want:       "\"'\"",
 								},
 							},
 							&anyMatcher{
-								line: 1776, col: 11, offset: 42553,
+								line: 1893, col: 11, offset: 46130,
 							},
 						},
 					},
@@ -12166,26 +13097,26 @@ var g = &grammar{
 		},
 		{
 			name: "NoDoubleQuotes",
-			pos:  position{line: 1778, col: 1, offset: 42589},
+			pos:  position{line: 1895, col: 1, offset: 46166},
 			expr: &actionExpr{
-				pos: position{line: 1779, col: 5, offset: 42608},
+				pos: position{line: 1896, col: 5, offset: 46185},
 				run: (*parser).callonNoDoubleQuotes1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 1779, col: 5, offset: 42608},
+					pos: position{line: 1896, col: 5, offset: 46185},
 					expr: &seqExpr{
-						pos: position{line: 1779, col: 6, offset: 42609},
+						pos: position{line: 1896, col: 6, offset: 46186},
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 1779, col: 6, offset: 42609},
+								pos: position{line: 1896, col: 6, offset: 46186},
 								expr: &litMatcher{
-									pos:        position{line: 1779, col: 7, offset: 42610},
+									pos:        position{line: 1896, col: 7, offset: 46187},
 									val:        "\"",
 									ignoreCase: false,
 									want:       "\"\\\"\"",
 								},
 							},
 							&anyMatcher{
-								line: 1779, col: 11, offset: 42614,
+								line: 1896, col: 11, offset: 46191,
 							},
 						},
 					},
@@ -12196,32 +13127,32 @@ var g = &grammar{
 		},
 		{
 			name: "BacktickString",
-			pos:  position{line: 1781, col: 1, offset: 42650},
+			pos:  position{line: 1898, col: 1, offset: 46227},
 			expr: &actionExpr{
-				pos: position{line: 1782, col: 5, offset: 42669},
+				pos: position{line: 1899, col: 5, offset: 46246},
 				run: (*parser).callonBacktickString1,
 				expr: &seqExpr{
-					pos: position{line: 1782, col: 5, offset: 42669},
+					pos: position{line: 1899, col: 5, offset: 46246},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1782, col: 5, offset: 42669},
+							pos:        position{line: 1899, col: 5, offset: 46246},
 							val:        "`",
 							ignoreCase: false,
 							want:       "\"`\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1782, col: 9, offset: 42673},
+							pos:   position{line: 1899, col: 9, offset: 46250},
 							label: "v",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1782, col: 11, offset: 42675},
+								pos: position{line: 1899, col: 11, offset: 46252},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1782, col: 11, offset: 42675},
+									pos:  position{line: 1899, col: 11, offset: 46252},
 									name: "BacktickChar",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1782, col: 25, offset: 42689},
+							pos:        position{line: 1899, col: 25, offset: 46266},
 							val:        "`",
 							ignoreCase: false,
 							want:       "\"`\"",
@@ -12234,57 +13165,57 @@ var g = &grammar{
 		},
 		{
 			name: "BacktickChar",
-			pos:  position{line: 1784, col: 1, offset: 42723},
+			pos:  position{line: 1901, col: 1, offset: 46300},
 			expr: &choiceExpr{
-				pos: position{line: 1785, col: 5, offset: 42740},
+				pos: position{line: 1902, col: 5, offset: 46317},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1785, col: 5, offset: 42740},
+						pos: position{line: 1902, col: 5, offset: 46317},
 						run: (*parser).callonBacktickChar2,
 						expr: &seqExpr{
-							pos: position{line: 1785, col: 5, offset: 42740},
+							pos: position{line: 1902, col: 5, offset: 46317},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1785, col: 5, offset: 42740},
+									pos: position{line: 1902, col: 5, offset: 46317},
 									expr: &choiceExpr{
-										pos: position{line: 1785, col: 7, offset: 42742},
+										pos: position{line: 1902, col: 7, offset: 46319},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1785, col: 7, offset: 42742},
+												pos:        position{line: 1902, col: 7, offset: 46319},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1785, col: 13, offset: 42748},
+												pos:  position{line: 1902, col: 13, offset: 46325},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1785, col: 26, offset: 42761,
+									line: 1902, col: 26, offset: 46338,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1786, col: 5, offset: 42798},
+						pos: position{line: 1903, col: 5, offset: 46375},
 						run: (*parser).callonBacktickChar9,
 						expr: &seqExpr{
-							pos: position{line: 1786, col: 5, offset: 42798},
+							pos: position{line: 1903, col: 5, offset: 46375},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1786, col: 5, offset: 42798},
+									pos:        position{line: 1903, col: 5, offset: 46375},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1786, col: 10, offset: 42803},
+									pos:   position{line: 1903, col: 10, offset: 46380},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1786, col: 12, offset: 42805},
+										pos:  position{line: 1903, col: 12, offset: 46382},
 										name: "EscapeSequence",
 									},
 								},
@@ -12298,28 +13229,28 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWord",
-			pos:  position{line: 1788, col: 1, offset: 42839},
+			pos:  position{line: 1905, col: 1, offset: 46416},
 			expr: &actionExpr{
-				pos: position{line: 1789, col: 5, offset: 42851},
+				pos: position{line: 1906, col: 5, offset: 46428},
 				run: (*parser).callonKeyWord1,
 				expr: &seqExpr{
-					pos: position{line: 1789, col: 5, offset: 42851},
+					pos: position{line: 1906, col: 5, offset: 46428},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1789, col: 5, offset: 42851},
+							pos:   position{line: 1906, col: 5, offset: 46428},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1789, col: 10, offset: 42856},
+								pos:  position{line: 1906, col: 10, offset: 46433},
 								name: "KeyWordStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1789, col: 23, offset: 42869},
+							pos:   position{line: 1906, col: 23, offset: 46446},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1789, col: 28, offset: 42874},
+								pos: position{line: 1906, col: 28, offset: 46451},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1789, col: 28, offset: 42874},
+									pos:  position{line: 1906, col: 28, offset: 46451},
 									name: "KeyWordRest",
 								},
 							},
@@ -12332,16 +13263,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordStart",
-			pos:  position{line: 1791, col: 1, offset: 42936},
+			pos:  position{line: 1908, col: 1, offset: 46513},
 			expr: &choiceExpr{
-				pos: position{line: 1792, col: 5, offset: 42953},
+				pos: position{line: 1909, col: 5, offset: 46530},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1792, col: 5, offset: 42953},
+						pos:  position{line: 1909, col: 5, offset: 46530},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1793, col: 5, offset: 42970},
+						pos:  position{line: 1910, col: 5, offset: 46547},
 						name: "KeyWordEsc",
 					},
 				},
@@ -12351,16 +13282,16 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordRest",
-			pos:  position{line: 1795, col: 1, offset: 42982},
+			pos:  position{line: 1912, col: 1, offset: 46559},
 			expr: &choiceExpr{
-				pos: position{line: 1796, col: 5, offset: 42998},
+				pos: position{line: 1913, col: 5, offset: 46575},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1796, col: 5, offset: 42998},
+						pos:  position{line: 1913, col: 5, offset: 46575},
 						name: "KeyWordStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1797, col: 5, offset: 43015},
+						pos:        position{line: 1914, col: 5, offset: 46592},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -12373,19 +13304,19 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordChars",
-			pos:  position{line: 1799, col: 1, offset: 43022},
+			pos:  position{line: 1916, col: 1, offset: 46599},
 			expr: &actionExpr{
-				pos: position{line: 1799, col: 16, offset: 43037},
+				pos: position{line: 1916, col: 16, offset: 46614},
 				run: (*parser).callonKeyWordChars1,
 				expr: &choiceExpr{
-					pos: position{line: 1799, col: 17, offset: 43038},
+					pos: position{line: 1916, col: 17, offset: 46615},
 					alternatives: []any{
 						&ruleRefExpr{
-							pos:  position{line: 1799, col: 17, offset: 43038},
+							pos:  position{line: 1916, col: 17, offset: 46615},
 							name: "UnicodeLetter",
 						},
 						&charClassMatcher{
-							pos:        position{line: 1799, col: 33, offset: 43054},
+							pos:        position{line: 1916, col: 33, offset: 46631},
 							val:        "[_.:/%#@~]",
 							chars:      []rune{'_', '.', ':', '/', '%', '#', '@', '~'},
 							ignoreCase: false,
@@ -12399,31 +13330,31 @@ var g = &grammar{
 		},
 		{
 			name: "KeyWordEsc",
-			pos:  position{line: 1801, col: 1, offset: 43098},
+			pos:  position{line: 1918, col: 1, offset: 46675},
 			expr: &actionExpr{
-				pos: position{line: 1801, col: 14, offset: 43111},
+				pos: position{line: 1918, col: 14, offset: 46688},
 				run: (*parser).callonKeyWordEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1801, col: 14, offset: 43111},
+					pos: position{line: 1918, col: 14, offset: 46688},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1801, col: 14, offset: 43111},
+							pos:        position{line: 1918, col: 14, offset: 46688},
 							val:        "\\",
 							ignoreCase: false,
 							want:       "\"\\\\\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1801, col: 19, offset: 43116},
+							pos:   position{line: 1918, col: 19, offset: 46693},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1801, col: 22, offset: 43119},
+								pos: position{line: 1918, col: 22, offset: 46696},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1801, col: 22, offset: 43119},
+										pos:  position{line: 1918, col: 22, offset: 46696},
 										name: "KeywordEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1801, col: 38, offset: 43135},
+										pos:  position{line: 1918, col: 38, offset: 46712},
 										name: "EscapeSequence",
 									},
 								},
@@ -12437,42 +13368,42 @@ var g = &grammar{
 		},
 		{
 			name: "GlobPattern",
-			pos:  position{line: 1803, col: 1, offset: 43170},
+			pos:  position{line: 1920, col: 1, offset: 46747},
 			expr: &actionExpr{
-				pos: position{line: 1804, col: 5, offset: 43186},
+				pos: position{line: 1921, col: 5, offset: 46763},
 				run: (*parser).callonGlobPattern1,
 				expr: &seqExpr{
-					pos: position{line: 1804, col: 5, offset: 43186},
+					pos: position{line: 1921, col: 5, offset: 46763},
 					exprs: []any{
 						&andExpr{
-							pos: position{line: 1804, col: 5, offset: 43186},
+							pos: position{line: 1921, col: 5, offset: 46763},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1804, col: 6, offset: 43187},
+								pos:  position{line: 1921, col: 6, offset: 46764},
 								name: "GlobProperStart",
 							},
 						},
 						&andExpr{
-							pos: position{line: 1804, col: 22, offset: 43203},
+							pos: position{line: 1921, col: 22, offset: 46780},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1804, col: 23, offset: 43204},
+								pos:  position{line: 1921, col: 23, offset: 46781},
 								name: "GlobHasStar",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1804, col: 35, offset: 43216},
+							pos:   position{line: 1921, col: 35, offset: 46793},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1804, col: 40, offset: 43221},
+								pos:  position{line: 1921, col: 40, offset: 46798},
 								name: "GlobStart",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1804, col: 50, offset: 43231},
+							pos:   position{line: 1921, col: 50, offset: 46808},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1804, col: 55, offset: 43236},
+								pos: position{line: 1921, col: 55, offset: 46813},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1804, col: 55, offset: 43236},
+									pos:  position{line: 1921, col: 55, offset: 46813},
 									name: "GlobRest",
 								},
 							},
@@ -12485,28 +13416,28 @@ var g = &grammar{
 		},
 		{
 			name: "GlobProperStart",
-			pos:  position{line: 1808, col: 1, offset: 43305},
+			pos:  position{line: 1925, col: 1, offset: 46882},
 			expr: &choiceExpr{
-				pos: position{line: 1808, col: 19, offset: 43323},
+				pos: position{line: 1925, col: 19, offset: 46900},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1808, col: 19, offset: 43323},
+						pos:  position{line: 1925, col: 19, offset: 46900},
 						name: "KeyWordStart",
 					},
 					&seqExpr{
-						pos: position{line: 1808, col: 34, offset: 43338},
+						pos: position{line: 1925, col: 34, offset: 46915},
 						exprs: []any{
 							&oneOrMoreExpr{
-								pos: position{line: 1808, col: 34, offset: 43338},
+								pos: position{line: 1925, col: 34, offset: 46915},
 								expr: &litMatcher{
-									pos:        position{line: 1808, col: 34, offset: 43338},
+									pos:        position{line: 1925, col: 34, offset: 46915},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1808, col: 39, offset: 43343},
+								pos:  position{line: 1925, col: 39, offset: 46920},
 								name: "KeyWordRest",
 							},
 						},
@@ -12518,19 +13449,19 @@ var g = &grammar{
 		},
 		{
 			name: "GlobHasStar",
-			pos:  position{line: 1809, col: 1, offset: 43355},
+			pos:  position{line: 1926, col: 1, offset: 46932},
 			expr: &seqExpr{
-				pos: position{line: 1809, col: 15, offset: 43369},
+				pos: position{line: 1926, col: 15, offset: 46946},
 				exprs: []any{
 					&zeroOrMoreExpr{
-						pos: position{line: 1809, col: 15, offset: 43369},
+						pos: position{line: 1926, col: 15, offset: 46946},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1809, col: 15, offset: 43369},
+							pos:  position{line: 1926, col: 15, offset: 46946},
 							name: "KeyWordRest",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1809, col: 28, offset: 43382},
+						pos:        position{line: 1926, col: 28, offset: 46959},
 						val:        "*",
 						ignoreCase: false,
 						want:       "\"*\"",
@@ -12542,23 +13473,23 @@ var g = &grammar{
 		},
 		{
 			name: "GlobStart",
-			pos:  position{line: 1811, col: 1, offset: 43387},
+			pos:  position{line: 1928, col: 1, offset: 46964},
 			expr: &choiceExpr{
-				pos: position{line: 1812, col: 5, offset: 43401},
+				pos: position{line: 1929, col: 5, offset: 46978},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1812, col: 5, offset: 43401},
+						pos:  position{line: 1929, col: 5, offset: 46978},
 						name: "KeyWordChars",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1813, col: 5, offset: 43418},
+						pos:  position{line: 1930, col: 5, offset: 46995},
 						name: "GlobEsc",
 					},
 					&actionExpr{
-						pos: position{line: 1814, col: 5, offset: 43430},
+						pos: position{line: 1931, col: 5, offset: 47007},
 						run: (*parser).callonGlobStart4,
 						expr: &litMatcher{
-							pos:        position{line: 1814, col: 5, offset: 43430},
+							pos:        position{line: 1931, col: 5, offset: 47007},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
@@ -12571,16 +13502,16 @@ var g = &grammar{
 		},
 		{
 			name: "GlobRest",
-			pos:  position{line: 1816, col: 1, offset: 43455},
+			pos:  position{line: 1933, col: 1, offset: 47032},
 			expr: &choiceExpr{
-				pos: position{line: 1817, col: 5, offset: 43468},
+				pos: position{line: 1934, col: 5, offset: 47045},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1817, col: 5, offset: 43468},
+						pos:  position{line: 1934, col: 5, offset: 47045},
 						name: "GlobStart",
 					},
 					&charClassMatcher{
-						pos:        position{line: 1818, col: 5, offset: 43482},
+						pos:        position{line: 1935, col: 5, offset: 47059},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -12593,31 +13524,31 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEsc",
-			pos:  position{line: 1820, col: 1, offset: 43489},
+			pos:  position{line: 1937, col: 1, offset: 47066},
 			expr: &actionExpr{
-				pos: position{line: 1820, col: 11, offset: 43499},
+				pos: position{line: 1937, col: 11, offset: 47076},
 				run: (*parser).callonGlobEsc1,
 				expr: &seqExpr{
-					pos: position{line: 1820, col: 11, offset: 43499},
+					pos: position{line: 1937, col: 11, offset: 47076},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 1820, col: 11, offset: 43499},
+							pos:        position{line: 1937, col: 11, offset: 47076},
 							val:        "\\",
 							ignoreCase: false,
 							want:       "\"\\\\\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1820, col: 16, offset: 43504},
+							pos:   position{line: 1937, col: 16, offset: 47081},
 							label: "s",
 							expr: &choiceExpr{
-								pos: position{line: 1820, col: 19, offset: 43507},
+								pos: position{line: 1937, col: 19, offset: 47084},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 1820, col: 19, offset: 43507},
+										pos:  position{line: 1937, col: 19, offset: 47084},
 										name: "GlobEscape",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1820, col: 32, offset: 43520},
+										pos:  position{line: 1937, col: 32, offset: 47097},
 										name: "EscapeSequence",
 									},
 								},
@@ -12631,32 +13562,32 @@ var g = &grammar{
 		},
 		{
 			name: "GlobEscape",
-			pos:  position{line: 1822, col: 1, offset: 43555},
+			pos:  position{line: 1939, col: 1, offset: 47132},
 			expr: &choiceExpr{
-				pos: position{line: 1823, col: 5, offset: 43570},
+				pos: position{line: 1940, col: 5, offset: 47147},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1823, col: 5, offset: 43570},
+						pos: position{line: 1940, col: 5, offset: 47147},
 						run: (*parser).callonGlobEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1823, col: 5, offset: 43570},
+							pos:        position{line: 1940, col: 5, offset: 47147},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1824, col: 5, offset: 43598},
+						pos: position{line: 1941, col: 5, offset: 47175},
 						run: (*parser).callonGlobEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1824, col: 5, offset: 43598},
+							pos:        position{line: 1941, col: 5, offset: 47175},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1825, col: 5, offset: 43628},
+						pos:        position{line: 1942, col: 5, offset: 47205},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -12669,57 +13600,57 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedChar",
-			pos:  position{line: 1827, col: 1, offset: 43634},
+			pos:  position{line: 1944, col: 1, offset: 47211},
 			expr: &choiceExpr{
-				pos: position{line: 1828, col: 5, offset: 43655},
+				pos: position{line: 1945, col: 5, offset: 47232},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1828, col: 5, offset: 43655},
+						pos: position{line: 1945, col: 5, offset: 47232},
 						run: (*parser).callonSingleQuotedChar2,
 						expr: &seqExpr{
-							pos: position{line: 1828, col: 5, offset: 43655},
+							pos: position{line: 1945, col: 5, offset: 47232},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1828, col: 5, offset: 43655},
+									pos: position{line: 1945, col: 5, offset: 47232},
 									expr: &choiceExpr{
-										pos: position{line: 1828, col: 7, offset: 43657},
+										pos: position{line: 1945, col: 7, offset: 47234},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 1828, col: 7, offset: 43657},
+												pos:        position{line: 1945, col: 7, offset: 47234},
 												val:        "'",
 												ignoreCase: false,
 												want:       "\"'\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1828, col: 13, offset: 43663},
+												pos:  position{line: 1945, col: 13, offset: 47240},
 												name: "EscapedChar",
 											},
 										},
 									},
 								},
 								&anyMatcher{
-									line: 1828, col: 26, offset: 43676,
+									line: 1945, col: 26, offset: 47253,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1829, col: 5, offset: 43713},
+						pos: position{line: 1946, col: 5, offset: 47290},
 						run: (*parser).callonSingleQuotedChar9,
 						expr: &seqExpr{
-							pos: position{line: 1829, col: 5, offset: 43713},
+							pos: position{line: 1946, col: 5, offset: 47290},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1829, col: 5, offset: 43713},
+									pos:        position{line: 1946, col: 5, offset: 47290},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1829, col: 10, offset: 43718},
+									pos:   position{line: 1946, col: 10, offset: 47295},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1829, col: 12, offset: 43720},
+										pos:  position{line: 1946, col: 12, offset: 47297},
 										name: "EscapeSequence",
 									},
 								},
@@ -12733,16 +13664,16 @@ var g = &grammar{
 		},
 		{
 			name: "EscapeSequence",
-			pos:  position{line: 1831, col: 1, offset: 43754},
+			pos:  position{line: 1948, col: 1, offset: 47331},
 			expr: &choiceExpr{
-				pos: position{line: 1832, col: 5, offset: 43773},
+				pos: position{line: 1949, col: 5, offset: 47350},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1832, col: 5, offset: 43773},
+						pos:  position{line: 1949, col: 5, offset: 47350},
 						name: "SingleCharEscape",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1833, col: 5, offset: 43794},
+						pos:  position{line: 1950, col: 5, offset: 47371},
 						name: "UnicodeEscape",
 					},
 				},
@@ -12752,87 +13683,87 @@ var g = &grammar{
 		},
 		{
 			name: "SingleCharEscape",
-			pos:  position{line: 1835, col: 1, offset: 43809},
+			pos:  position{line: 1952, col: 1, offset: 47386},
 			expr: &choiceExpr{
-				pos: position{line: 1836, col: 5, offset: 43830},
+				pos: position{line: 1953, col: 5, offset: 47407},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1836, col: 5, offset: 43830},
+						pos:        position{line: 1953, col: 5, offset: 47407},
 						val:        "'",
 						ignoreCase: false,
 						want:       "\"'\"",
 					},
 					&actionExpr{
-						pos: position{line: 1837, col: 5, offset: 43838},
+						pos: position{line: 1954, col: 5, offset: 47415},
 						run: (*parser).callonSingleCharEscape3,
 						expr: &litMatcher{
-							pos:        position{line: 1837, col: 5, offset: 43838},
+							pos:        position{line: 1954, col: 5, offset: 47415},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1838, col: 5, offset: 43878},
+						pos:        position{line: 1955, col: 5, offset: 47455},
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
 					},
 					&actionExpr{
-						pos: position{line: 1839, col: 5, offset: 43887},
+						pos: position{line: 1956, col: 5, offset: 47464},
 						run: (*parser).callonSingleCharEscape6,
 						expr: &litMatcher{
-							pos:        position{line: 1839, col: 5, offset: 43887},
+							pos:        position{line: 1956, col: 5, offset: 47464},
 							val:        "b",
 							ignoreCase: false,
 							want:       "\"b\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1840, col: 5, offset: 43916},
+						pos: position{line: 1957, col: 5, offset: 47493},
 						run: (*parser).callonSingleCharEscape8,
 						expr: &litMatcher{
-							pos:        position{line: 1840, col: 5, offset: 43916},
+							pos:        position{line: 1957, col: 5, offset: 47493},
 							val:        "f",
 							ignoreCase: false,
 							want:       "\"f\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1841, col: 5, offset: 43945},
+						pos: position{line: 1958, col: 5, offset: 47522},
 						run: (*parser).callonSingleCharEscape10,
 						expr: &litMatcher{
-							pos:        position{line: 1841, col: 5, offset: 43945},
+							pos:        position{line: 1958, col: 5, offset: 47522},
 							val:        "n",
 							ignoreCase: false,
 							want:       "\"n\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1842, col: 5, offset: 43974},
+						pos: position{line: 1959, col: 5, offset: 47551},
 						run: (*parser).callonSingleCharEscape12,
 						expr: &litMatcher{
-							pos:        position{line: 1842, col: 5, offset: 43974},
+							pos:        position{line: 1959, col: 5, offset: 47551},
 							val:        "r",
 							ignoreCase: false,
 							want:       "\"r\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1843, col: 5, offset: 44003},
+						pos: position{line: 1960, col: 5, offset: 47580},
 						run: (*parser).callonSingleCharEscape14,
 						expr: &litMatcher{
-							pos:        position{line: 1843, col: 5, offset: 44003},
+							pos:        position{line: 1960, col: 5, offset: 47580},
 							val:        "t",
 							ignoreCase: false,
 							want:       "\"t\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1844, col: 5, offset: 44032},
+						pos: position{line: 1961, col: 5, offset: 47609},
 						run: (*parser).callonSingleCharEscape16,
 						expr: &litMatcher{
-							pos:        position{line: 1844, col: 5, offset: 44032},
+							pos:        position{line: 1961, col: 5, offset: 47609},
 							val:        "v",
 							ignoreCase: false,
 							want:       "\"v\"",
@@ -12845,32 +13776,32 @@ var g = &grammar{
 		},
 		{
 			name: "KeywordEscape",
-			pos:  position{line: 1846, col: 1, offset: 44058},
+			pos:  position{line: 1963, col: 1, offset: 47635},
 			expr: &choiceExpr{
-				pos: position{line: 1847, col: 5, offset: 44076},
+				pos: position{line: 1964, col: 5, offset: 47653},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1847, col: 5, offset: 44076},
+						pos: position{line: 1964, col: 5, offset: 47653},
 						run: (*parser).callonKeywordEscape2,
 						expr: &litMatcher{
-							pos:        position{line: 1847, col: 5, offset: 44076},
+							pos:        position{line: 1964, col: 5, offset: 47653},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1848, col: 5, offset: 44104},
+						pos: position{line: 1965, col: 5, offset: 47681},
 						run: (*parser).callonKeywordEscape4,
 						expr: &litMatcher{
-							pos:        position{line: 1848, col: 5, offset: 44104},
+							pos:        position{line: 1965, col: 5, offset: 47681},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&charClassMatcher{
-						pos:        position{line: 1849, col: 5, offset: 44132},
+						pos:        position{line: 1966, col: 5, offset: 47709},
 						val:        "[+-]",
 						chars:      []rune{'+', '-'},
 						ignoreCase: false,
@@ -12883,42 +13814,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeEscape",
-			pos:  position{line: 1851, col: 1, offset: 44138},
+			pos:  position{line: 1968, col: 1, offset: 47715},
 			expr: &choiceExpr{
-				pos: position{line: 1852, col: 5, offset: 44156},
+				pos: position{line: 1969, col: 5, offset: 47733},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 1852, col: 5, offset: 44156},
+						pos: position{line: 1969, col: 5, offset: 47733},
 						run: (*parser).callonUnicodeEscape2,
 						expr: &seqExpr{
-							pos: position{line: 1852, col: 5, offset: 44156},
+							pos: position{line: 1969, col: 5, offset: 47733},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1852, col: 5, offset: 44156},
+									pos:        position{line: 1969, col: 5, offset: 47733},
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1852, col: 9, offset: 44160},
+									pos:   position{line: 1969, col: 9, offset: 47737},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1852, col: 16, offset: 44167},
+										pos: position{line: 1969, col: 16, offset: 47744},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1852, col: 16, offset: 44167},
+												pos:  position{line: 1969, col: 16, offset: 47744},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1852, col: 25, offset: 44176},
+												pos:  position{line: 1969, col: 25, offset: 47753},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1852, col: 34, offset: 44185},
+												pos:  position{line: 1969, col: 34, offset: 47762},
 												name: "HexDigit",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1852, col: 43, offset: 44194},
+												pos:  position{line: 1969, col: 43, offset: 47771},
 												name: "HexDigit",
 											},
 										},
@@ -12928,65 +13859,65 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1855, col: 5, offset: 44257},
+						pos: position{line: 1972, col: 5, offset: 47834},
 						run: (*parser).callonUnicodeEscape11,
 						expr: &seqExpr{
-							pos: position{line: 1855, col: 5, offset: 44257},
+							pos: position{line: 1972, col: 5, offset: 47834},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 1855, col: 5, offset: 44257},
+									pos:        position{line: 1972, col: 5, offset: 47834},
 									val:        "u",
 									ignoreCase: false,
 									want:       "\"u\"",
 								},
 								&litMatcher{
-									pos:        position{line: 1855, col: 9, offset: 44261},
+									pos:        position{line: 1972, col: 9, offset: 47838},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1855, col: 13, offset: 44265},
+									pos:   position{line: 1972, col: 13, offset: 47842},
 									label: "chars",
 									expr: &seqExpr{
-										pos: position{line: 1855, col: 20, offset: 44272},
+										pos: position{line: 1972, col: 20, offset: 47849},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 1855, col: 20, offset: 44272},
+												pos:  position{line: 1972, col: 20, offset: 47849},
 												name: "HexDigit",
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1855, col: 29, offset: 44281},
+												pos: position{line: 1972, col: 29, offset: 47858},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1855, col: 29, offset: 44281},
+													pos:  position{line: 1972, col: 29, offset: 47858},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1855, col: 39, offset: 44291},
+												pos: position{line: 1972, col: 39, offset: 47868},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1855, col: 39, offset: 44291},
+													pos:  position{line: 1972, col: 39, offset: 47868},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1855, col: 49, offset: 44301},
+												pos: position{line: 1972, col: 49, offset: 47878},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1855, col: 49, offset: 44301},
+													pos:  position{line: 1972, col: 49, offset: 47878},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1855, col: 59, offset: 44311},
+												pos: position{line: 1972, col: 59, offset: 47888},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1855, col: 59, offset: 44311},
+													pos:  position{line: 1972, col: 59, offset: 47888},
 													name: "HexDigit",
 												},
 											},
 											&zeroOrOneExpr{
-												pos: position{line: 1855, col: 69, offset: 44321},
+												pos: position{line: 1972, col: 69, offset: 47898},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1855, col: 69, offset: 44321},
+													pos:  position{line: 1972, col: 69, offset: 47898},
 													name: "HexDigit",
 												},
 											},
@@ -12994,7 +13925,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1855, col: 80, offset: 44332},
+									pos:        position{line: 1972, col: 80, offset: 47909},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -13009,9 +13940,9 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedChar",
-			pos:  position{line: 1860, col: 1, offset: 44387},
+			pos:  position{line: 1977, col: 1, offset: 47964},
 			expr: &charClassMatcher{
-				pos:        position{line: 1861, col: 5, offset: 44403},
+				pos:        position{line: 1978, col: 5, offset: 47980},
 				val:        "[\\x00-\\x1f\\\\]",
 				chars:      []rune{'\\'},
 				ranges:     []rune{'\x00', '\x1f'},
@@ -13023,11 +13954,11 @@ var g = &grammar{
 		},
 		{
 			name: "_",
-			pos:  position{line: 1863, col: 1, offset: 44418},
+			pos:  position{line: 1980, col: 1, offset: 47995},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 1863, col: 5, offset: 44422},
+				pos: position{line: 1980, col: 5, offset: 47999},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1863, col: 5, offset: 44422},
+					pos:  position{line: 1980, col: 5, offset: 47999},
 					name: "AnySpace",
 				},
 			},
@@ -13036,11 +13967,11 @@ var g = &grammar{
 		},
 		{
 			name: "__",
-			pos:  position{line: 1865, col: 1, offset: 44433},
+			pos:  position{line: 1982, col: 1, offset: 48010},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1865, col: 6, offset: 44438},
+				pos: position{line: 1982, col: 6, offset: 48015},
 				expr: &ruleRefExpr{
-					pos:  position{line: 1865, col: 6, offset: 44438},
+					pos:  position{line: 1982, col: 6, offset: 48015},
 					name: "AnySpace",
 				},
 			},
@@ -13049,20 +13980,20 @@ var g = &grammar{
 		},
 		{
 			name: "AnySpace",
-			pos:  position{line: 1867, col: 1, offset: 44449},
+			pos:  position{line: 1984, col: 1, offset: 48026},
 			expr: &choiceExpr{
-				pos: position{line: 1868, col: 5, offset: 44462},
+				pos: position{line: 1985, col: 5, offset: 48039},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1868, col: 5, offset: 44462},
+						pos:  position{line: 1985, col: 5, offset: 48039},
 						name: "WhiteSpace",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1869, col: 5, offset: 44477},
+						pos:  position{line: 1986, col: 5, offset: 48054},
 						name: "LineTerminator",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1870, col: 5, offset: 44496},
+						pos:  position{line: 1987, col: 5, offset: 48073},
 						name: "Comment",
 					},
 				},
@@ -13072,32 +14003,32 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeLetter",
-			pos:  position{line: 1872, col: 1, offset: 44505},
+			pos:  position{line: 1989, col: 1, offset: 48082},
 			expr: &choiceExpr{
-				pos: position{line: 1873, col: 5, offset: 44523},
+				pos: position{line: 1990, col: 5, offset: 48100},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1873, col: 5, offset: 44523},
+						pos:  position{line: 1990, col: 5, offset: 48100},
 						name: "Lu",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1874, col: 5, offset: 44530},
+						pos:  position{line: 1991, col: 5, offset: 48107},
 						name: "Ll",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1875, col: 5, offset: 44537},
+						pos:  position{line: 1992, col: 5, offset: 48114},
 						name: "Lt",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1876, col: 5, offset: 44544},
+						pos:  position{line: 1993, col: 5, offset: 48121},
 						name: "Lm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1877, col: 5, offset: 44551},
+						pos:  position{line: 1994, col: 5, offset: 48128},
 						name: "Lo",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1878, col: 5, offset: 44558},
+						pos:  position{line: 1995, col: 5, offset: 48135},
 						name: "Nl",
 					},
 				},
@@ -13107,16 +14038,16 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeCombiningMark",
-			pos:  position{line: 1880, col: 1, offset: 44562},
+			pos:  position{line: 1997, col: 1, offset: 48139},
 			expr: &choiceExpr{
-				pos: position{line: 1881, col: 5, offset: 44587},
+				pos: position{line: 1998, col: 5, offset: 48164},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1881, col: 5, offset: 44587},
+						pos:  position{line: 1998, col: 5, offset: 48164},
 						name: "Mn",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1882, col: 5, offset: 44594},
+						pos:  position{line: 1999, col: 5, offset: 48171},
 						name: "Mc",
 					},
 				},
@@ -13126,9 +14057,9 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeDigit",
-			pos:  position{line: 1884, col: 1, offset: 44598},
+			pos:  position{line: 2001, col: 1, offset: 48175},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1885, col: 5, offset: 44615},
+				pos:  position{line: 2002, col: 5, offset: 48192},
 				name: "Nd",
 			},
 			leader:        false,
@@ -13136,9 +14067,9 @@ var g = &grammar{
 		},
 		{
 			name: "UnicodeConnectorPunctuation",
-			pos:  position{line: 1887, col: 1, offset: 44619},
+			pos:  position{line: 2004, col: 1, offset: 48196},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1888, col: 5, offset: 44651},
+				pos:  position{line: 2005, col: 5, offset: 48228},
 				name: "Pc",
 			},
 			leader:        false,
@@ -13146,9 +14077,9 @@ var g = &grammar{
 		},
 		{
 			name: "Ll",
-			pos:  position{line: 1894, col: 1, offset: 44832},
+			pos:  position{line: 2011, col: 1, offset: 48409},
 			expr: &charClassMatcher{
-				pos:        position{line: 1894, col: 6, offset: 44837},
+				pos:        position{line: 2011, col: 6, offset: 48414},
 				val:        "[\\u0061-\\u007A\\u00B5\\u00DF-\\u00F6\\u00F8-\\u00FF\\u0101\\u0103\\u0105\\u0107\\u0109\\u010B\\u010D\\u010F\\u0111\\u0113\\u0115\\u0117\\u0119\\u011B\\u011D\\u011F\\u0121\\u0123\\u0125\\u0127\\u0129\\u012B\\u012D\\u012F\\u0131\\u0133\\u0135\\u0137-\\u0138\\u013A\\u013C\\u013E\\u0140\\u0142\\u0144\\u0146\\u0148-\\u0149\\u014B\\u014D\\u014F\\u0151\\u0153\\u0155\\u0157\\u0159\\u015B\\u015D\\u015F\\u0161\\u0163\\u0165\\u0167\\u0169\\u016B\\u016D\\u016F\\u0171\\u0173\\u0175\\u0177\\u017A\\u017C\\u017E-\\u0180\\u0183\\u0185\\u0188\\u018C-\\u018D\\u0192\\u0195\\u0199-\\u019B\\u019E\\u01A1\\u01A3\\u01A5\\u01A8\\u01AA-\\u01AB\\u01AD\\u01B0\\u01B4\\u01B6\\u01B9-\\u01BA\\u01BD-\\u01BF\\u01C6\\u01C9\\u01CC\\u01CE\\u01D0\\u01D2\\u01D4\\u01D6\\u01D8\\u01DA\\u01DC-\\u01DD\\u01DF\\u01E1\\u01E3\\u01E5\\u01E7\\u01E9\\u01EB\\u01ED\\u01EF-\\u01F0\\u01F3\\u01F5\\u01F9\\u01FB\\u01FD\\u01FF\\u0201\\u0203\\u0205\\u0207\\u0209\\u020B\\u020D\\u020F\\u0211\\u0213\\u0215\\u0217\\u0219\\u021B\\u021D\\u021F\\u0221\\u0223\\u0225\\u0227\\u0229\\u022B\\u022D\\u022F\\u0231\\u0233-\\u0239\\u023C\\u023F-\\u0240\\u0242\\u0247\\u0249\\u024B\\u024D\\u024F-\\u0293\\u0295-\\u02AF\\u0371\\u0373\\u0377\\u037B-\\u037D\\u0390\\u03AC-\\u03CE\\u03D0-\\u03D1\\u03D5-\\u03D7\\u03D9\\u03DB\\u03DD\\u03DF\\u03E1\\u03E3\\u03E5\\u03E7\\u03E9\\u03EB\\u03ED\\u03EF-\\u03F3\\u03F5\\u03F8\\u03FB-\\u03FC\\u0430-\\u045F\\u0461\\u0463\\u0465\\u0467\\u0469\\u046B\\u046D\\u046F\\u0471\\u0473\\u0475\\u0477\\u0479\\u047B\\u047D\\u047F\\u0481\\u048B\\u048D\\u048F\\u0491\\u0493\\u0495\\u0497\\u0499\\u049B\\u049D\\u049F\\u04A1\\u04A3\\u04A5\\u04A7\\u04A9\\u04AB\\u04AD\\u04AF\\u04B1\\u04B3\\u04B5\\u04B7\\u04B9\\u04BB\\u04BD\\u04BF\\u04C2\\u04C4\\u04C6\\u04C8\\u04CA\\u04CC\\u04CE-\\u04CF\\u04D1\\u04D3\\u04D5\\u04D7\\u04D9\\u04DB\\u04DD\\u04DF\\u04E1\\u04E3\\u04E5\\u04E7\\u04E9\\u04EB\\u04ED\\u04EF\\u04F1\\u04F3\\u04F5\\u04F7\\u04F9\\u04FB\\u04FD\\u04FF\\u0501\\u0503\\u0505\\u0507\\u0509\\u050B\\u050D\\u050F\\u0511\\u0513\\u0515\\u0517\\u0519\\u051B\\u051D\\u051F\\u0521\\u0523\\u0525\\u0527\\u0529\\u052B\\u052D\\u052F\\u0560-\\u0588\\u10D0-\\u10FA\\u10FD-\\u10FF\\u13F8-\\u13FD\\u1C80-\\u1C88\\u1D00-\\u1D2B\\u1D6B-\\u1D77\\u1D79-\\u1D9A\\u1E01\\u1E03\\u1E05\\u1E07\\u1E09\\u1E0B\\u1E0D\\u1E0F\\u1E11\\u1E13\\u1E15\\u1E17\\u1E19\\u1E1B\\u1E1D\\u1E1F\\u1E21\\u1E23\\u1E25\\u1E27\\u1E29\\u1E2B\\u1E2D\\u1E2F\\u1E31\\u1E33\\u1E35\\u1E37\\u1E39\\u1E3B\\u1E3D\\u1E3F\\u1E41\\u1E43\\u1E45\\u1E47\\u1E49\\u1E4B\\u1E4D\\u1E4F\\u1E51\\u1E53\\u1E55\\u1E57\\u1E59\\u1E5B\\u1E5D\\u1E5F\\u1E61\\u1E63\\u1E65\\u1E67\\u1E69\\u1E6B\\u1E6D\\u1E6F\\u1E71\\u1E73\\u1E75\\u1E77\\u1E79\\u1E7B\\u1E7D\\u1E7F\\u1E81\\u1E83\\u1E85\\u1E87\\u1E89\\u1E8B\\u1E8D\\u1E8F\\u1E91\\u1E93\\u1E95-\\u1E9D\\u1E9F\\u1EA1\\u1EA3\\u1EA5\\u1EA7\\u1EA9\\u1EAB\\u1EAD\\u1EAF\\u1EB1\\u1EB3\\u1EB5\\u1EB7\\u1EB9\\u1EBB\\u1EBD\\u1EBF\\u1EC1\\u1EC3\\u1EC5\\u1EC7\\u1EC9\\u1ECB\\u1ECD\\u1ECF\\u1ED1\\u1ED3\\u1ED5\\u1ED7\\u1ED9\\u1EDB\\u1EDD\\u1EDF\\u1EE1\\u1EE3\\u1EE5\\u1EE7\\u1EE9\\u1EEB\\u1EED\\u1EEF\\u1EF1\\u1EF3\\u1EF5\\u1EF7\\u1EF9\\u1EFB\\u1EFD\\u1EFF-\\u1F07\\u1F10-\\u1F15\\u1F20-\\u1F27\\u1F30-\\u1F37\\u1F40-\\u1F45\\u1F50-\\u1F57\\u1F60-\\u1F67\\u1F70-\\u1F7D\\u1F80-\\u1F87\\u1F90-\\u1F97\\u1FA0-\\u1FA7\\u1FB0-\\u1FB4\\u1FB6-\\u1FB7\\u1FBE\\u1FC2-\\u1FC4\\u1FC6-\\u1FC7\\u1FD0-\\u1FD3\\u1FD6-\\u1FD7\\u1FE0-\\u1FE7\\u1FF2-\\u1FF4\\u1FF6-\\u1FF7\\u210A\\u210E-\\u210F\\u2113\\u212F\\u2134\\u2139\\u213C-\\u213D\\u2146-\\u2149\\u214E\\u2184\\u2C30-\\u2C5E\\u2C61\\u2C65-\\u2C66\\u2C68\\u2C6A\\u2C6C\\u2C71\\u2C73-\\u2C74\\u2C76-\\u2C7B\\u2C81\\u2C83\\u2C85\\u2C87\\u2C89\\u2C8B\\u2C8D\\u2C8F\\u2C91\\u2C93\\u2C95\\u2C97\\u2C99\\u2C9B\\u2C9D\\u2C9F\\u2CA1\\u2CA3\\u2CA5\\u2CA7\\u2CA9\\u2CAB\\u2CAD\\u2CAF\\u2CB1\\u2CB3\\u2CB5\\u2CB7\\u2CB9\\u2CBB\\u2CBD\\u2CBF\\u2CC1\\u2CC3\\u2CC5\\u2CC7\\u2CC9\\u2CCB\\u2CCD\\u2CCF\\u2CD1\\u2CD3\\u2CD5\\u2CD7\\u2CD9\\u2CDB\\u2CDD\\u2CDF\\u2CE1\\u2CE3-\\u2CE4\\u2CEC\\u2CEE\\u2CF3\\u2D00-\\u2D25\\u2D27\\u2D2D\\uA641\\uA643\\uA645\\uA647\\uA649\\uA64B\\uA64D\\uA64F\\uA651\\uA653\\uA655\\uA657\\uA659\\uA65B\\uA65D\\uA65F\\uA661\\uA663\\uA665\\uA667\\uA669\\uA66B\\uA66D\\uA681\\uA683\\uA685\\uA687\\uA689\\uA68B\\uA68D\\uA68F\\uA691\\uA693\\uA695\\uA697\\uA699\\uA69B\\uA723\\uA725\\uA727\\uA729\\uA72B\\uA72D\\uA72F-\\uA731\\uA733\\uA735\\uA737\\uA739\\uA73B\\uA73D\\uA73F\\uA741\\uA743\\uA745\\uA747\\uA749\\uA74B\\uA74D\\uA74F\\uA751\\uA753\\uA755\\uA757\\uA759\\uA75B\\uA75D\\uA75F\\uA761\\uA763\\uA765\\uA767\\uA769\\uA76B\\uA76D\\uA76F\\uA771-\\uA778\\uA77A\\uA77C\\uA77F\\uA781\\uA783\\uA785\\uA787\\uA78C\\uA78E\\uA791\\uA793-\\uA795\\uA797\\uA799\\uA79B\\uA79D\\uA79F\\uA7A1\\uA7A3\\uA7A5\\uA7A7\\uA7A9\\uA7AF\\uA7B5\\uA7B7\\uA7B9\\uA7FA\\uAB30-\\uAB5A\\uAB60-\\uAB65\\uAB70-\\uABBF\\uFB00-\\uFB06\\uFB13-\\uFB17\\uFF41-\\uFF5A]",
 				chars:      []rune{'µ', 'ā', 'ă', 'ą', 'ć', 'ĉ', 'ċ', 'č', 'ď', 'đ', 'ē', 'ĕ', 'ė', 'ę', 'ě', 'ĝ', 'ğ', 'ġ', 'ģ', 'ĥ', 'ħ', 'ĩ', 'ī', 'ĭ', 'į', 'ı', 'ĳ', 'ĵ', 'ĺ', 'ļ', 'ľ', 'ŀ', 'ł', 'ń', 'ņ', 'ŋ', 'ō', 'ŏ', 'ő', 'œ', 'ŕ', 'ŗ', 'ř', 'ś', 'ŝ', 'ş', 'š', 'ţ', 'ť', 'ŧ', 'ũ', 'ū', 'ŭ', 'ů', 'ű', 'ų', 'ŵ', 'ŷ', 'ź', 'ż', 'ƃ', 'ƅ', 'ƈ', 'ƒ', 'ƕ', 'ƞ', 'ơ', 'ƣ', 'ƥ', 'ƨ', 'ƭ', 'ư', 'ƴ', 'ƶ', 'ǆ', 'ǉ', 'ǌ', 'ǎ', 'ǐ', 'ǒ', 'ǔ', 'ǖ', 'ǘ', 'ǚ', 'ǟ', 'ǡ', 'ǣ', 'ǥ', 'ǧ', 'ǩ', 'ǫ', 'ǭ', 'ǳ', 'ǵ', 'ǹ', 'ǻ', 'ǽ', 'ǿ', 'ȁ', 'ȃ', 'ȅ', 'ȇ', 'ȉ', 'ȋ', 'ȍ', 'ȏ', 'ȑ', 'ȓ', 'ȕ', 'ȗ', 'ș', 'ț', 'ȝ', 'ȟ', 'ȡ', 'ȣ', 'ȥ', 'ȧ', 'ȩ', 'ȫ', 'ȭ', 'ȯ', 'ȱ', 'ȼ', 'ɂ', 'ɇ', 'ɉ', 'ɋ', 'ɍ', 'ͱ', 'ͳ', 'ͷ', 'ΐ', 'ϙ', 'ϛ', 'ϝ', 'ϟ', 'ϡ', 'ϣ', 'ϥ', 'ϧ', 'ϩ', 'ϫ', 'ϭ', 'ϵ', 'ϸ', 'ѡ', 'ѣ', 'ѥ', 'ѧ', 'ѩ', 'ѫ', 'ѭ', 'ѯ', 'ѱ', 'ѳ', 'ѵ', 'ѷ', 'ѹ', 'ѻ', 'ѽ', 'ѿ', 'ҁ', 'ҋ', 'ҍ', 'ҏ', 'ґ', 'ғ', 'ҕ', 'җ', 'ҙ', 'қ', 'ҝ', 'ҟ', 'ҡ', 'ң', 'ҥ', 'ҧ', 'ҩ', 'ҫ', 'ҭ', 'ү', 'ұ', 'ҳ', 'ҵ', 'ҷ', 'ҹ', 'һ', 'ҽ', 'ҿ', 'ӂ', 'ӄ', 'ӆ', 'ӈ', 'ӊ', 'ӌ', 'ӑ', 'ӓ', 'ӕ', 'ӗ', 'ә', 'ӛ', 'ӝ', 'ӟ', 'ӡ', 'ӣ', 'ӥ', 'ӧ', 'ө', 'ӫ', 'ӭ', 'ӯ', 'ӱ', 'ӳ', 'ӵ', 'ӷ', 'ӹ', 'ӻ', 'ӽ', 'ӿ', 'ԁ', 'ԃ', 'ԅ', 'ԇ', 'ԉ', 'ԋ', 'ԍ', 'ԏ', 'ԑ', 'ԓ', 'ԕ', 'ԗ', 'ԙ', 'ԛ', 'ԝ', 'ԟ', 'ԡ', 'ԣ', 'ԥ', 'ԧ', 'ԩ', 'ԫ', 'ԭ', 'ԯ', 'ḁ', 'ḃ', 'ḅ', 'ḇ', 'ḉ', 'ḋ', 'ḍ', 'ḏ', 'ḑ', 'ḓ', 'ḕ', 'ḗ', 'ḙ', 'ḛ', 'ḝ', 'ḟ', 'ḡ', 'ḣ', 'ḥ', 'ḧ', 'ḩ', 'ḫ', 'ḭ', 'ḯ', 'ḱ', 'ḳ', 'ḵ', 'ḷ', 'ḹ', 'ḻ', 'ḽ', 'ḿ', 'ṁ', 'ṃ', 'ṅ', 'ṇ', 'ṉ', 'ṋ', 'ṍ', 'ṏ', 'ṑ', 'ṓ', 'ṕ', 'ṗ', 'ṙ', 'ṛ', 'ṝ', 'ṟ', 'ṡ', 'ṣ', 'ṥ', 'ṧ', 'ṩ', 'ṫ', 'ṭ', 'ṯ', 'ṱ', 'ṳ', 'ṵ', 'ṷ', 'ṹ', 'ṻ', 'ṽ', 'ṿ', 'ẁ', 'ẃ', 'ẅ', 'ẇ', 'ẉ', 'ẋ', 'ẍ', 'ẏ', 'ẑ', 'ẓ', 'ẟ', 'ạ', 'ả', 'ấ', 'ầ', 'ẩ', 'ẫ', 'ậ', 'ắ', 'ằ', 'ẳ', 'ẵ', 'ặ', 'ẹ', 'ẻ', 'ẽ', 'ế', 'ề', 'ể', 'ễ', 'ệ', 'ỉ', 'ị', 'ọ', 'ỏ', 'ố', 'ồ', 'ổ', 'ỗ', 'ộ', 'ớ', 'ờ', 'ở', 'ỡ', 'ợ', 'ụ', 'ủ', 'ứ', 'ừ', 'ử', 'ữ', 'ự', 'ỳ', 'ỵ', 'ỷ', 'ỹ', 'ỻ', 'ỽ', 'ι', 'ℊ', 'ℓ', 'ℯ', 'ℴ', 'ℹ', 'ⅎ', 'ↄ', 'ⱡ', 'ⱨ', 'ⱪ', 'ⱬ', 'ⱱ', 'ⲁ', 'ⲃ', 'ⲅ', 'ⲇ', 'ⲉ', 'ⲋ', 'ⲍ', 'ⲏ', 'ⲑ', 'ⲓ', 'ⲕ', 'ⲗ', 'ⲙ', 'ⲛ', 'ⲝ', 'ⲟ', 'ⲡ', 'ⲣ', 'ⲥ', 'ⲧ', 'ⲩ', 'ⲫ', 'ⲭ', 'ⲯ', 'ⲱ', 'ⲳ', 'ⲵ', 'ⲷ', 'ⲹ', 'ⲻ', 'ⲽ', 'ⲿ', 'ⳁ', 'ⳃ', 'ⳅ', 'ⳇ', 'ⳉ', 'ⳋ', 'ⳍ', 'ⳏ', 'ⳑ', 'ⳓ', 'ⳕ', 'ⳗ', 'ⳙ', 'ⳛ', 'ⳝ', 'ⳟ', 'ⳡ', 'ⳬ', 'ⳮ', 'ⳳ', 'ⴧ', 'ⴭ', 'ꙁ', 'ꙃ', 'ꙅ', 'ꙇ', 'ꙉ', 'ꙋ', 'ꙍ', 'ꙏ', 'ꙑ', 'ꙓ', 'ꙕ', 'ꙗ', 'ꙙ', 'ꙛ', 'ꙝ', 'ꙟ', 'ꙡ', 'ꙣ', 'ꙥ', 'ꙧ', 'ꙩ', 'ꙫ', 'ꙭ', 'ꚁ', 'ꚃ', 'ꚅ', 'ꚇ', 'ꚉ', 'ꚋ', 'ꚍ', 'ꚏ', 'ꚑ', 'ꚓ', 'ꚕ', 'ꚗ', 'ꚙ', 'ꚛ', 'ꜣ', 'ꜥ', 'ꜧ', 'ꜩ', 'ꜫ', 'ꜭ', 'ꜳ', 'ꜵ', 'ꜷ', 'ꜹ', 'ꜻ', 'ꜽ', 'ꜿ', 'ꝁ', 'ꝃ', 'ꝅ', 'ꝇ', 'ꝉ', 'ꝋ', 'ꝍ', 'ꝏ', 'ꝑ', 'ꝓ', 'ꝕ', 'ꝗ', 'ꝙ', 'ꝛ', 'ꝝ', 'ꝟ', 'ꝡ', 'ꝣ', 'ꝥ', 'ꝧ', 'ꝩ', 'ꝫ', 'ꝭ', 'ꝯ', 'ꝺ', 'ꝼ', 'ꝿ', 'ꞁ', 'ꞃ', 'ꞅ', 'ꞇ', 'ꞌ', 'ꞎ', 'ꞑ', 'ꞗ', 'ꞙ', 'ꞛ', 'ꞝ', 'ꞟ', 'ꞡ', 'ꞣ', 'ꞥ', 'ꞧ', 'ꞩ', 'ꞯ', 'ꞵ', 'ꞷ', 'ꞹ', 'ꟺ'},
 				ranges:     []rune{'a', 'z', 'ß', 'ö', 'ø', 'ÿ', 'ķ', 'ĸ', 'ň', 'ŉ', 'ž', 'ƀ', 'ƌ', 'ƍ', 'ƙ', 'ƛ', 'ƪ', 'ƫ', 'ƹ', 'ƺ', 'ƽ', 'ƿ', 'ǜ', 'ǝ', 'ǯ', 'ǰ', 'ȳ', 'ȹ', 'ȿ', 'ɀ', 'ɏ', 'ʓ', 'ʕ', 'ʯ', 'ͻ', 'ͽ', 'ά', 'ώ', 'ϐ', 'ϑ', 'ϕ', 'ϗ', 'ϯ', 'ϳ', 'ϻ', 'ϼ', 'а', 'џ', 'ӎ', 'ӏ', 'ՠ', 'ֈ', 'ა', 'ჺ', 'ჽ', 'ჿ', 'ᏸ', 'ᏽ', 'ᲀ', 'ᲈ', 'ᴀ', 'ᴫ', 'ᵫ', 'ᵷ', 'ᵹ', 'ᶚ', 'ẕ', 'ẝ', 'ỿ', 'ἇ', 'ἐ', 'ἕ', 'ἠ', 'ἧ', 'ἰ', 'ἷ', 'ὀ', 'ὅ', 'ὐ', 'ὗ', 'ὠ', 'ὧ', 'ὰ', 'ώ', 'ᾀ', 'ᾇ', 'ᾐ', 'ᾗ', 'ᾠ', 'ᾧ', 'ᾰ', 'ᾴ', 'ᾶ', 'ᾷ', 'ῂ', 'ῄ', 'ῆ', 'ῇ', 'ῐ', 'ΐ', 'ῖ', 'ῗ', 'ῠ', 'ῧ', 'ῲ', 'ῴ', 'ῶ', 'ῷ', 'ℎ', 'ℏ', 'ℼ', 'ℽ', 'ⅆ', 'ⅉ', 'ⰰ', 'ⱞ', 'ⱥ', 'ⱦ', 'ⱳ', 'ⱴ', 'ⱶ', 'ⱻ', 'ⳣ', 'ⳤ', 'ⴀ', 'ⴥ', 'ꜯ', 'ꜱ', 'ꝱ', 'ꝸ', 'ꞓ', 'ꞕ', 'ꬰ', 'ꭚ', 'ꭠ', 'ꭥ', 'ꭰ', 'ꮿ', 'ﬀ', 'ﬆ', 'ﬓ', 'ﬗ', 'ａ', 'ｚ'},
@@ -13160,9 +14091,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lm",
-			pos:  position{line: 1897, col: 1, offset: 48989},
+			pos:  position{line: 2014, col: 1, offset: 52566},
 			expr: &charClassMatcher{
-				pos:        position{line: 1897, col: 6, offset: 48994},
+				pos:        position{line: 2014, col: 6, offset: 52571},
 				val:        "[\\u02B0-\\u02C1\\u02C6-\\u02D1\\u02E0-\\u02E4\\u02EC\\u02EE\\u0374\\u037A\\u0559\\u0640\\u06E5-\\u06E6\\u07F4-\\u07F5\\u07FA\\u081A\\u0824\\u0828\\u0971\\u0E46\\u0EC6\\u10FC\\u17D7\\u1843\\u1AA7\\u1C78-\\u1C7D\\u1D2C-\\u1D6A\\u1D78\\u1D9B-\\u1DBF\\u2071\\u207F\\u2090-\\u209C\\u2C7C-\\u2C7D\\u2D6F\\u2E2F\\u3005\\u3031-\\u3035\\u303B\\u309D-\\u309E\\u30FC-\\u30FE\\uA015\\uA4F8-\\uA4FD\\uA60C\\uA67F\\uA69C-\\uA69D\\uA717-\\uA71F\\uA770\\uA788\\uA7F8-\\uA7F9\\uA9CF\\uA9E6\\uAA70\\uAADD\\uAAF3-\\uAAF4\\uAB5C-\\uAB5F\\uFF70\\uFF9E-\\uFF9F]",
 				chars:      []rune{'ˬ', 'ˮ', 'ʹ', 'ͺ', 'ՙ', 'ـ', 'ߺ', 'ࠚ', 'ࠤ', 'ࠨ', 'ॱ', 'ๆ', 'ໆ', 'ჼ', 'ៗ', 'ᡃ', 'ᪧ', 'ᵸ', 'ⁱ', 'ⁿ', 'ⵯ', 'ⸯ', '々', '〻', 'ꀕ', 'ꘌ', 'ꙿ', 'ꝰ', 'ꞈ', 'ꧏ', 'ꧦ', 'ꩰ', 'ꫝ', 'ｰ'},
 				ranges:     []rune{'ʰ', 'ˁ', 'ˆ', 'ˑ', 'ˠ', 'ˤ', 'ۥ', 'ۦ', 'ߴ', 'ߵ', 'ᱸ', 'ᱽ', 'ᴬ', 'ᵪ', 'ᶛ', 'ᶿ', 'ₐ', 'ₜ', 'ⱼ', 'ⱽ', '〱', '〵', 'ゝ', 'ゞ', 'ー', 'ヾ', 'ꓸ', 'ꓽ', 'ꚜ', 'ꚝ', 'ꜗ', 'ꜟ', 'ꟸ', 'ꟹ', 'ꫳ', 'ꫴ', 'ꭜ', 'ꭟ', 'ﾞ', 'ﾟ'},
@@ -13174,9 +14105,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lo",
-			pos:  position{line: 1900, col: 1, offset: 49479},
+			pos:  position{line: 2017, col: 1, offset: 53056},
 			expr: &charClassMatcher{
-				pos:        position{line: 1900, col: 6, offset: 49484},
+				pos:        position{line: 2017, col: 6, offset: 53061},
 				val:        "[\\u00AA\\u00BA\\u01BB\\u01C0-\\u01C3\\u0294\\u05D0-\\u05EA\\u05EF-\\u05F2\\u0620-\\u063F\\u0641-\\u064A\\u066E-\\u066F\\u0671-\\u06D3\\u06D5\\u06EE-\\u06EF\\u06FA-\\u06FC\\u06FF\\u0710\\u0712-\\u072F\\u074D-\\u07A5\\u07B1\\u07CA-\\u07EA\\u0800-\\u0815\\u0840-\\u0858\\u0860-\\u086A\\u08A0-\\u08B4\\u08B6-\\u08BD\\u0904-\\u0939\\u093D\\u0950\\u0958-\\u0961\\u0972-\\u0980\\u0985-\\u098C\\u098F-\\u0990\\u0993-\\u09A8\\u09AA-\\u09B0\\u09B2\\u09B6-\\u09B9\\u09BD\\u09CE\\u09DC-\\u09DD\\u09DF-\\u09E1\\u09F0-\\u09F1\\u09FC\\u0A05-\\u0A0A\\u0A0F-\\u0A10\\u0A13-\\u0A28\\u0A2A-\\u0A30\\u0A32-\\u0A33\\u0A35-\\u0A36\\u0A38-\\u0A39\\u0A59-\\u0A5C\\u0A5E\\u0A72-\\u0A74\\u0A85-\\u0A8D\\u0A8F-\\u0A91\\u0A93-\\u0AA8\\u0AAA-\\u0AB0\\u0AB2-\\u0AB3\\u0AB5-\\u0AB9\\u0ABD\\u0AD0\\u0AE0-\\u0AE1\\u0AF9\\u0B05-\\u0B0C\\u0B0F-\\u0B10\\u0B13-\\u0B28\\u0B2A-\\u0B30\\u0B32-\\u0B33\\u0B35-\\u0B39\\u0B3D\\u0B5C-\\u0B5D\\u0B5F-\\u0B61\\u0B71\\u0B83\\u0B85-\\u0B8A\\u0B8E-\\u0B90\\u0B92-\\u0B95\\u0B99-\\u0B9A\\u0B9C\\u0B9E-\\u0B9F\\u0BA3-\\u0BA4\\u0BA8-\\u0BAA\\u0BAE-\\u0BB9\\u0BD0\\u0C05-\\u0C0C\\u0C0E-\\u0C10\\u0C12-\\u0C28\\u0C2A-\\u0C39\\u0C3D\\u0C58-\\u0C5A\\u0C60-\\u0C61\\u0C80\\u0C85-\\u0C8C\\u0C8E-\\u0C90\\u0C92-\\u0CA8\\u0CAA-\\u0CB3\\u0CB5-\\u0CB9\\u0CBD\\u0CDE\\u0CE0-\\u0CE1\\u0CF1-\\u0CF2\\u0D05-\\u0D0C\\u0D0E-\\u0D10\\u0D12-\\u0D3A\\u0D3D\\u0D4E\\u0D54-\\u0D56\\u0D5F-\\u0D61\\u0D7A-\\u0D7F\\u0D85-\\u0D96\\u0D9A-\\u0DB1\\u0DB3-\\u0DBB\\u0DBD\\u0DC0-\\u0DC6\\u0E01-\\u0E30\\u0E32-\\u0E33\\u0E40-\\u0E45\\u0E81-\\u0E82\\u0E84\\u0E87-\\u0E88\\u0E8A\\u0E8D\\u0E94-\\u0E97\\u0E99-\\u0E9F\\u0EA1-\\u0EA3\\u0EA5\\u0EA7\\u0EAA-\\u0EAB\\u0EAD-\\u0EB0\\u0EB2-\\u0EB3\\u0EBD\\u0EC0-\\u0EC4\\u0EDC-\\u0EDF\\u0F00\\u0F40-\\u0F47\\u0F49-\\u0F6C\\u0F88-\\u0F8C\\u1000-\\u102A\\u103F\\u1050-\\u1055\\u105A-\\u105D\\u1061\\u1065-\\u1066\\u106E-\\u1070\\u1075-\\u1081\\u108E\\u1100-\\u1248\\u124A-\\u124D\\u1250-\\u1256\\u1258\\u125A-\\u125D\\u1260-\\u1288\\u128A-\\u128D\\u1290-\\u12B0\\u12B2-\\u12B5\\u12B8-\\u12BE\\u12C0\\u12C2-\\u12C5\\u12C8-\\u12D6\\u12D8-\\u1310\\u1312-\\u1315\\u1318-\\u135A\\u1380-\\u138F\\u1401-\\u166C\\u166F-\\u167F\\u1681-\\u169A\\u16A0-\\u16EA\\u16F1-\\u16F8\\u1700-\\u170C\\u170E-\\u1711\\u1720-\\u1731\\u1740-\\u1751\\u1760-\\u176C\\u176E-\\u1770\\u1780-\\u17B3\\u17DC\\u1820-\\u1842\\u1844-\\u1878\\u1880-\\u1884\\u1887-\\u18A8\\u18AA\\u18B0-\\u18F5\\u1900-\\u191E\\u1950-\\u196D\\u1970-\\u1974\\u1980-\\u19AB\\u19B0-\\u19C9\\u1A00-\\u1A16\\u1A20-\\u1A54\\u1B05-\\u1B33\\u1B45-\\u1B4B\\u1B83-\\u1BA0\\u1BAE-\\u1BAF\\u1BBA-\\u1BE5\\u1C00-\\u1C23\\u1C4D-\\u1C4F\\u1C5A-\\u1C77\\u1CE9-\\u1CEC\\u1CEE-\\u1CF1\\u1CF5-\\u1CF6\\u2135-\\u2138\\u2D30-\\u2D67\\u2D80-\\u2D96\\u2DA0-\\u2DA6\\u2DA8-\\u2DAE\\u2DB0-\\u2DB6\\u2DB8-\\u2DBE\\u2DC0-\\u2DC6\\u2DC8-\\u2DCE\\u2DD0-\\u2DD6\\u2DD8-\\u2DDE\\u3006\\u303C\\u3041-\\u3096\\u309F\\u30A1-\\u30FA\\u30FF\\u3105-\\u312F\\u3131-\\u318E\\u31A0-\\u31BA\\u31F0-\\u31FF\\u3400-\\u4DB5\\u4E00-\\u9FEF\\uA000-\\uA014\\uA016-\\uA48C\\uA4D0-\\uA4F7\\uA500-\\uA60B\\uA610-\\uA61F\\uA62A-\\uA62B\\uA66E\\uA6A0-\\uA6E5\\uA78F\\uA7F7\\uA7FB-\\uA801\\uA803-\\uA805\\uA807-\\uA80A\\uA80C-\\uA822\\uA840-\\uA873\\uA882-\\uA8B3\\uA8F2-\\uA8F7\\uA8FB\\uA8FD-\\uA8FE\\uA90A-\\uA925\\uA930-\\uA946\\uA960-\\uA97C\\uA984-\\uA9B2\\uA9E0-\\uA9E4\\uA9E7-\\uA9EF\\uA9FA-\\uA9FE\\uAA00-\\uAA28\\uAA40-\\uAA42\\uAA44-\\uAA4B\\uAA60-\\uAA6F\\uAA71-\\uAA76\\uAA7A\\uAA7E-\\uAAAF\\uAAB1\\uAAB5-\\uAAB6\\uAAB9-\\uAABD\\uAAC0\\uAAC2\\uAADB-\\uAADC\\uAAE0-\\uAAEA\\uAAF2\\uAB01-\\uAB06\\uAB09-\\uAB0E\\uAB11-\\uAB16\\uAB20-\\uAB26\\uAB28-\\uAB2E\\uABC0-\\uABE2\\uAC00-\\uD7A3\\uD7B0-\\uD7C6\\uD7CB-\\uD7FB\\uF900-\\uFA6D\\uFA70-\\uFAD9\\uFB1D\\uFB1F-\\uFB28\\uFB2A-\\uFB36\\uFB38-\\uFB3C\\uFB3E\\uFB40-\\uFB41\\uFB43-\\uFB44\\uFB46-\\uFBB1\\uFBD3-\\uFD3D\\uFD50-\\uFD8F\\uFD92-\\uFDC7\\uFDF0-\\uFDFB\\uFE70-\\uFE74\\uFE76-\\uFEFC\\uFF66-\\uFF6F\\uFF71-\\uFF9D\\uFFA0-\\uFFBE\\uFFC2-\\uFFC7\\uFFCA-\\uFFCF\\uFFD2-\\uFFD7\\uFFDA-\\uFFDC]",
 				chars:      []rune{'ª', 'º', 'ƻ', 'ʔ', 'ە', 'ۿ', 'ܐ', 'ޱ', 'ऽ', 'ॐ', 'ল', 'ঽ', 'ৎ', 'ৼ', 'ਫ਼', 'ઽ', 'ૐ', 'ૹ', 'ଽ', 'ୱ', 'ஃ', 'ஜ', 'ௐ', 'ఽ', 'ಀ', 'ಽ', 'ೞ', 'ഽ', 'ൎ', 'ල', 'ຄ', 'ຊ', 'ຍ', 'ລ', 'ວ', 'ຽ', 'ༀ', 'ဿ', 'ၡ', 'ႎ', 'ቘ', 'ዀ', 'ៜ', 'ᢪ', '〆', '〼', 'ゟ', 'ヿ', 'ꙮ', 'ꞏ', 'ꟷ', 'ꣻ', 'ꩺ', 'ꪱ', 'ꫀ', 'ꫂ', 'ꫲ', 'יִ', 'מּ'},
 				ranges:     []rune{'ǀ', 'ǃ', 'א', 'ת', 'ׯ', 'ײ', 'ؠ', 'ؿ', 'ف', 'ي', 'ٮ', 'ٯ', 'ٱ', 'ۓ', 'ۮ', 'ۯ', 'ۺ', 'ۼ', 'ܒ', 'ܯ', 'ݍ', 'ޥ', 'ߊ', 'ߪ', 'ࠀ', 'ࠕ', 'ࡀ', 'ࡘ', 'ࡠ', 'ࡪ', 'ࢠ', 'ࢴ', 'ࢶ', 'ࢽ', 'ऄ', 'ह', 'क़', 'ॡ', 'ॲ', 'ঀ', 'অ', 'ঌ', 'এ', 'ঐ', 'ও', 'ন', 'প', 'র', 'শ', 'হ', 'ড়', 'ঢ়', 'য়', 'ৡ', 'ৰ', 'ৱ', 'ਅ', 'ਊ', 'ਏ', 'ਐ', 'ਓ', 'ਨ', 'ਪ', 'ਰ', 'ਲ', 'ਲ਼', 'ਵ', 'ਸ਼', 'ਸ', 'ਹ', 'ਖ਼', 'ੜ', 'ੲ', 'ੴ', 'અ', 'ઍ', 'એ', 'ઑ', 'ઓ', 'ન', 'પ', 'ર', 'લ', 'ળ', 'વ', 'હ', 'ૠ', 'ૡ', 'ଅ', 'ଌ', 'ଏ', 'ଐ', 'ଓ', 'ନ', 'ପ', 'ର', 'ଲ', 'ଳ', 'ଵ', 'ହ', 'ଡ଼', 'ଢ଼', 'ୟ', 'ୡ', 'அ', 'ஊ', 'எ', 'ஐ', 'ஒ', 'க', 'ங', 'ச', 'ஞ', 'ட', 'ண', 'த', 'ந', 'ப', 'ம', 'ஹ', 'అ', 'ఌ', 'ఎ', 'ఐ', 'ఒ', 'న', 'ప', 'హ', 'ౘ', 'ౚ', 'ౠ', 'ౡ', 'ಅ', 'ಌ', 'ಎ', 'ಐ', 'ಒ', 'ನ', 'ಪ', 'ಳ', 'ವ', 'ಹ', 'ೠ', 'ೡ', 'ೱ', 'ೲ', 'അ', 'ഌ', 'എ', 'ഐ', 'ഒ', 'ഺ', 'ൔ', 'ൖ', 'ൟ', 'ൡ', 'ൺ', 'ൿ', 'අ', 'ඖ', 'ක', 'න', 'ඳ', 'ර', 'ව', 'ෆ', 'ก', 'ะ', 'า', 'ำ', 'เ', 'ๅ', 'ກ', 'ຂ', 'ງ', 'ຈ', 'ດ', 'ທ', 'ນ', 'ຟ', 'ມ', 'ຣ', 'ສ', 'ຫ', 'ອ', 'ະ', 'າ', 'ຳ', 'ເ', 'ໄ', 'ໜ', 'ໟ', 'ཀ', 'ཇ', 'ཉ', 'ཬ', 'ྈ', 'ྌ', 'က', 'ဪ', 'ၐ', 'ၕ', 'ၚ', 'ၝ', 'ၥ', 'ၦ', 'ၮ', 'ၰ', 'ၵ', 'ႁ', 'ᄀ', 'ቈ', 'ቊ', 'ቍ', 'ቐ', 'ቖ', 'ቚ', 'ቝ', 'በ', 'ኈ', 'ኊ', 'ኍ', 'ነ', 'ኰ', 'ኲ', 'ኵ', 'ኸ', 'ኾ', 'ዂ', 'ዅ', 'ወ', 'ዖ', 'ዘ', 'ጐ', 'ጒ', 'ጕ', 'ጘ', 'ፚ', 'ᎀ', 'ᎏ', 'ᐁ', 'ᙬ', 'ᙯ', 'ᙿ', 'ᚁ', 'ᚚ', 'ᚠ', 'ᛪ', 'ᛱ', 'ᛸ', 'ᜀ', 'ᜌ', 'ᜎ', 'ᜑ', 'ᜠ', 'ᜱ', 'ᝀ', 'ᝑ', 'ᝠ', 'ᝬ', 'ᝮ', 'ᝰ', 'ក', 'ឳ', 'ᠠ', 'ᡂ', 'ᡄ', 'ᡸ', 'ᢀ', 'ᢄ', 'ᢇ', 'ᢨ', 'ᢰ', 'ᣵ', 'ᤀ', 'ᤞ', 'ᥐ', 'ᥭ', 'ᥰ', 'ᥴ', 'ᦀ', 'ᦫ', 'ᦰ', 'ᧉ', 'ᨀ', 'ᨖ', 'ᨠ', 'ᩔ', 'ᬅ', 'ᬳ', 'ᭅ', 'ᭋ', 'ᮃ', 'ᮠ', 'ᮮ', 'ᮯ', 'ᮺ', 'ᯥ', 'ᰀ', 'ᰣ', 'ᱍ', 'ᱏ', 'ᱚ', 'ᱷ', 'ᳩ', 'ᳬ', 'ᳮ', 'ᳱ', 'ᳵ', 'ᳶ', 'ℵ', 'ℸ', 'ⴰ', 'ⵧ', 'ⶀ', 'ⶖ', 'ⶠ', 'ⶦ', 'ⶨ', 'ⶮ', 'ⶰ', 'ⶶ', 'ⶸ', 'ⶾ', 'ⷀ', 'ⷆ', 'ⷈ', 'ⷎ', 'ⷐ', 'ⷖ', 'ⷘ', 'ⷞ', 'ぁ', 'ゖ', 'ァ', 'ヺ', 'ㄅ', 'ㄯ', 'ㄱ', 'ㆎ', 'ㆠ', 'ㆺ', 'ㇰ', 'ㇿ', '㐀', '䶵', '一', '鿯', 'ꀀ', 'ꀔ', 'ꀖ', 'ꒌ', 'ꓐ', 'ꓷ', 'ꔀ', 'ꘋ', 'ꘐ', 'ꘟ', 'ꘪ', 'ꘫ', 'ꚠ', 'ꛥ', 'ꟻ', 'ꠁ', 'ꠃ', 'ꠅ', 'ꠇ', 'ꠊ', 'ꠌ', 'ꠢ', 'ꡀ', 'ꡳ', 'ꢂ', 'ꢳ', 'ꣲ', 'ꣷ', 'ꣽ', 'ꣾ', 'ꤊ', 'ꤥ', 'ꤰ', 'ꥆ', 'ꥠ', 'ꥼ', 'ꦄ', 'ꦲ', 'ꧠ', 'ꧤ', 'ꧧ', 'ꧯ', 'ꧺ', 'ꧾ', 'ꨀ', 'ꨨ', 'ꩀ', 'ꩂ', 'ꩄ', 'ꩋ', 'ꩠ', 'ꩯ', 'ꩱ', 'ꩶ', 'ꩾ', 'ꪯ', 'ꪵ', 'ꪶ', 'ꪹ', 'ꪽ', 'ꫛ', 'ꫜ', 'ꫠ', 'ꫪ', 'ꬁ', 'ꬆ', 'ꬉ', 'ꬎ', 'ꬑ', 'ꬖ', 'ꬠ', 'ꬦ', 'ꬨ', 'ꬮ', 'ꯀ', 'ꯢ', '가', '힣', 'ힰ', 'ퟆ', 'ퟋ', 'ퟻ', '豈', '舘', '並', '龎', 'ײַ', 'ﬨ', 'שׁ', 'זּ', 'טּ', 'לּ', 'נּ', 'סּ', 'ףּ', 'פּ', 'צּ', 'ﮱ', 'ﯓ', 'ﴽ', 'ﵐ', 'ﶏ', 'ﶒ', 'ﷇ', 'ﷰ', 'ﷻ', 'ﹰ', 'ﹴ', 'ﹶ', 'ﻼ', 'ｦ', 'ｯ', 'ｱ', 'ﾝ', 'ﾠ', 'ﾾ', 'ￂ', 'ￇ', 'ￊ', 'ￏ', 'ￒ', 'ￗ', 'ￚ', 'ￜ'},
@@ -13188,9 +14119,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lt",
-			pos:  position{line: 1903, col: 1, offset: 52931},
+			pos:  position{line: 2020, col: 1, offset: 56508},
 			expr: &charClassMatcher{
-				pos:        position{line: 1903, col: 6, offset: 52936},
+				pos:        position{line: 2020, col: 6, offset: 56513},
 				val:        "[\\u01C5\\u01C8\\u01CB\\u01F2\\u1F88-\\u1F8F\\u1F98-\\u1F9F\\u1FA8-\\u1FAF\\u1FBC\\u1FCC\\u1FFC]",
 				chars:      []rune{'ǅ', 'ǈ', 'ǋ', 'ǲ', 'ᾼ', 'ῌ', 'ῼ'},
 				ranges:     []rune{'ᾈ', 'ᾏ', 'ᾘ', 'ᾟ', 'ᾨ', 'ᾯ'},
@@ -13202,9 +14133,9 @@ var g = &grammar{
 		},
 		{
 			name: "Lu",
-			pos:  position{line: 1906, col: 1, offset: 53042},
+			pos:  position{line: 2023, col: 1, offset: 56619},
 			expr: &charClassMatcher{
-				pos:        position{line: 1906, col: 6, offset: 53047},
+				pos:        position{line: 2023, col: 6, offset: 56624},
 				val:        "[\\u0041-\\u005A\\u00C0-\\u00D6\\u00D8-\\u00DE\\u0100\\u0102\\u0104\\u0106\\u0108\\u010A\\u010C\\u010E\\u0110\\u0112\\u0114\\u0116\\u0118\\u011A\\u011C\\u011E\\u0120\\u0122\\u0124\\u0126\\u0128\\u012A\\u012C\\u012E\\u0130\\u0132\\u0134\\u0136\\u0139\\u013B\\u013D\\u013F\\u0141\\u0143\\u0145\\u0147\\u014A\\u014C\\u014E\\u0150\\u0152\\u0154\\u0156\\u0158\\u015A\\u015C\\u015E\\u0160\\u0162\\u0164\\u0166\\u0168\\u016A\\u016C\\u016E\\u0170\\u0172\\u0174\\u0176\\u0178-\\u0179\\u017B\\u017D\\u0181-\\u0182\\u0184\\u0186-\\u0187\\u0189-\\u018B\\u018E-\\u0191\\u0193-\\u0194\\u0196-\\u0198\\u019C-\\u019D\\u019F-\\u01A0\\u01A2\\u01A4\\u01A6-\\u01A7\\u01A9\\u01AC\\u01AE-\\u01AF\\u01B1-\\u01B3\\u01B5\\u01B7-\\u01B8\\u01BC\\u01C4\\u01C7\\u01CA\\u01CD\\u01CF\\u01D1\\u01D3\\u01D5\\u01D7\\u01D9\\u01DB\\u01DE\\u01E0\\u01E2\\u01E4\\u01E6\\u01E8\\u01EA\\u01EC\\u01EE\\u01F1\\u01F4\\u01F6-\\u01F8\\u01FA\\u01FC\\u01FE\\u0200\\u0202\\u0204\\u0206\\u0208\\u020A\\u020C\\u020E\\u0210\\u0212\\u0214\\u0216\\u0218\\u021A\\u021C\\u021E\\u0220\\u0222\\u0224\\u0226\\u0228\\u022A\\u022C\\u022E\\u0230\\u0232\\u023A-\\u023B\\u023D-\\u023E\\u0241\\u0243-\\u0246\\u0248\\u024A\\u024C\\u024E\\u0370\\u0372\\u0376\\u037F\\u0386\\u0388-\\u038A\\u038C\\u038E-\\u038F\\u0391-\\u03A1\\u03A3-\\u03AB\\u03CF\\u03D2-\\u03D4\\u03D8\\u03DA\\u03DC\\u03DE\\u03E0\\u03E2\\u03E4\\u03E6\\u03E8\\u03EA\\u03EC\\u03EE\\u03F4\\u03F7\\u03F9-\\u03FA\\u03FD-\\u042F\\u0460\\u0462\\u0464\\u0466\\u0468\\u046A\\u046C\\u046E\\u0470\\u0472\\u0474\\u0476\\u0478\\u047A\\u047C\\u047E\\u0480\\u048A\\u048C\\u048E\\u0490\\u0492\\u0494\\u0496\\u0498\\u049A\\u049C\\u049E\\u04A0\\u04A2\\u04A4\\u04A6\\u04A8\\u04AA\\u04AC\\u04AE\\u04B0\\u04B2\\u04B4\\u04B6\\u04B8\\u04BA\\u04BC\\u04BE\\u04C0-\\u04C1\\u04C3\\u04C5\\u04C7\\u04C9\\u04CB\\u04CD\\u04D0\\u04D2\\u04D4\\u04D6\\u04D8\\u04DA\\u04DC\\u04DE\\u04E0\\u04E2\\u04E4\\u04E6\\u04E8\\u04EA\\u04EC\\u04EE\\u04F0\\u04F2\\u04F4\\u04F6\\u04F8\\u04FA\\u04FC\\u04FE\\u0500\\u0502\\u0504\\u0506\\u0508\\u050A\\u050C\\u050E\\u0510\\u0512\\u0514\\u0516\\u0518\\u051A\\u051C\\u051E\\u0520\\u0522\\u0524\\u0526\\u0528\\u052A\\u052C\\u052E\\u0531-\\u0556\\u10A0-\\u10C5\\u10C7\\u10CD\\u13A0-\\u13F5\\u1C90-\\u1CBA\\u1CBD-\\u1CBF\\u1E00\\u1E02\\u1E04\\u1E06\\u1E08\\u1E0A\\u1E0C\\u1E0E\\u1E10\\u1E12\\u1E14\\u1E16\\u1E18\\u1E1A\\u1E1C\\u1E1E\\u1E20\\u1E22\\u1E24\\u1E26\\u1E28\\u1E2A\\u1E2C\\u1E2E\\u1E30\\u1E32\\u1E34\\u1E36\\u1E38\\u1E3A\\u1E3C\\u1E3E\\u1E40\\u1E42\\u1E44\\u1E46\\u1E48\\u1E4A\\u1E4C\\u1E4E\\u1E50\\u1E52\\u1E54\\u1E56\\u1E58\\u1E5A\\u1E5C\\u1E5E\\u1E60\\u1E62\\u1E64\\u1E66\\u1E68\\u1E6A\\u1E6C\\u1E6E\\u1E70\\u1E72\\u1E74\\u1E76\\u1E78\\u1E7A\\u1E7C\\u1E7E\\u1E80\\u1E82\\u1E84\\u1E86\\u1E88\\u1E8A\\u1E8C\\u1E8E\\u1E90\\u1E92\\u1E94\\u1E9E\\u1EA0\\u1EA2\\u1EA4\\u1EA6\\u1EA8\\u1EAA\\u1EAC\\u1EAE\\u1EB0\\u1EB2\\u1EB4\\u1EB6\\u1EB8\\u1EBA\\u1EBC\\u1EBE\\u1EC0\\u1EC2\\u1EC4\\u1EC6\\u1EC8\\u1ECA\\u1ECC\\u1ECE\\u1ED0\\u1ED2\\u1ED4\\u1ED6\\u1ED8\\u1EDA\\u1EDC\\u1EDE\\u1EE0\\u1EE2\\u1EE4\\u1EE6\\u1EE8\\u1EEA\\u1EEC\\u1EEE\\u1EF0\\u1EF2\\u1EF4\\u1EF6\\u1EF8\\u1EFA\\u1EFC\\u1EFE\\u1F08-\\u1F0F\\u1F18-\\u1F1D\\u1F28-\\u1F2F\\u1F38-\\u1F3F\\u1F48-\\u1F4D\\u1F59\\u1F5B\\u1F5D\\u1F5F\\u1F68-\\u1F6F\\u1FB8-\\u1FBB\\u1FC8-\\u1FCB\\u1FD8-\\u1FDB\\u1FE8-\\u1FEC\\u1FF8-\\u1FFB\\u2102\\u2107\\u210B-\\u210D\\u2110-\\u2112\\u2115\\u2119-\\u211D\\u2124\\u2126\\u2128\\u212A-\\u212D\\u2130-\\u2133\\u213E-\\u213F\\u2145\\u2183\\u2C00-\\u2C2E\\u2C60\\u2C62-\\u2C64\\u2C67\\u2C69\\u2C6B\\u2C6D-\\u2C70\\u2C72\\u2C75\\u2C7E-\\u2C80\\u2C82\\u2C84\\u2C86\\u2C88\\u2C8A\\u2C8C\\u2C8E\\u2C90\\u2C92\\u2C94\\u2C96\\u2C98\\u2C9A\\u2C9C\\u2C9E\\u2CA0\\u2CA2\\u2CA4\\u2CA6\\u2CA8\\u2CAA\\u2CAC\\u2CAE\\u2CB0\\u2CB2\\u2CB4\\u2CB6\\u2CB8\\u2CBA\\u2CBC\\u2CBE\\u2CC0\\u2CC2\\u2CC4\\u2CC6\\u2CC8\\u2CCA\\u2CCC\\u2CCE\\u2CD0\\u2CD2\\u2CD4\\u2CD6\\u2CD8\\u2CDA\\u2CDC\\u2CDE\\u2CE0\\u2CE2\\u2CEB\\u2CED\\u2CF2\\uA640\\uA642\\uA644\\uA646\\uA648\\uA64A\\uA64C\\uA64E\\uA650\\uA652\\uA654\\uA656\\uA658\\uA65A\\uA65C\\uA65E\\uA660\\uA662\\uA664\\uA666\\uA668\\uA66A\\uA66C\\uA680\\uA682\\uA684\\uA686\\uA688\\uA68A\\uA68C\\uA68E\\uA690\\uA692\\uA694\\uA696\\uA698\\uA69A\\uA722\\uA724\\uA726\\uA728\\uA72A\\uA72C\\uA72E\\uA732\\uA734\\uA736\\uA738\\uA73A\\uA73C\\uA73E\\uA740\\uA742\\uA744\\uA746\\uA748\\uA74A\\uA74C\\uA74E\\uA750\\uA752\\uA754\\uA756\\uA758\\uA75A\\uA75C\\uA75E\\uA760\\uA762\\uA764\\uA766\\uA768\\uA76A\\uA76C\\uA76E\\uA779\\uA77B\\uA77D-\\uA77E\\uA780\\uA782\\uA784\\uA786\\uA78B\\uA78D\\uA790\\uA792\\uA796\\uA798\\uA79A\\uA79C\\uA79E\\uA7A0\\uA7A2\\uA7A4\\uA7A6\\uA7A8\\uA7AA-\\uA7AE\\uA7B0-\\uA7B4\\uA7B6\\uA7B8\\uFF21-\\uFF3A]",
 				chars:      []rune{'Ā', 'Ă', 'Ą', 'Ć', 'Ĉ', 'Ċ', 'Č', 'Ď', 'Đ', 'Ē', 'Ĕ', 'Ė', 'Ę', 'Ě', 'Ĝ', 'Ğ', 'Ġ', 'Ģ', 'Ĥ', 'Ħ', 'Ĩ', 'Ī', 'Ĭ', 'Į', 'İ', 'Ĳ', 'Ĵ', 'Ķ', 'Ĺ', 'Ļ', 'Ľ', 'Ŀ', 'Ł', 'Ń', 'Ņ', 'Ň', 'Ŋ', 'Ō', 'Ŏ', 'Ő', 'Œ', 'Ŕ', 'Ŗ', 'Ř', 'Ś', 'Ŝ', 'Ş', 'Š', 'Ţ', 'Ť', 'Ŧ', 'Ũ', 'Ū', 'Ŭ', 'Ů', 'Ű', 'Ų', 'Ŵ', 'Ŷ', 'Ż', 'Ž', 'Ƅ', 'Ƣ', 'Ƥ', 'Ʃ', 'Ƭ', 'Ƶ', 'Ƽ', 'Ǆ', 'Ǉ', 'Ǌ', 'Ǎ', 'Ǐ', 'Ǒ', 'Ǔ', 'Ǖ', 'Ǘ', 'Ǚ', 'Ǜ', 'Ǟ', 'Ǡ', 'Ǣ', 'Ǥ', 'Ǧ', 'Ǩ', 'Ǫ', 'Ǭ', 'Ǯ', 'Ǳ', 'Ǵ', 'Ǻ', 'Ǽ', 'Ǿ', 'Ȁ', 'Ȃ', 'Ȅ', 'Ȇ', 'Ȉ', 'Ȋ', 'Ȍ', 'Ȏ', 'Ȑ', 'Ȓ', 'Ȕ', 'Ȗ', 'Ș', 'Ț', 'Ȝ', 'Ȟ', 'Ƞ', 'Ȣ', 'Ȥ', 'Ȧ', 'Ȩ', 'Ȫ', 'Ȭ', 'Ȯ', 'Ȱ', 'Ȳ', 'Ɂ', 'Ɉ', 'Ɋ', 'Ɍ', 'Ɏ', 'Ͱ', 'Ͳ', 'Ͷ', 'Ϳ', 'Ά', 'Ό', 'Ϗ', 'Ϙ', 'Ϛ', 'Ϝ', 'Ϟ', 'Ϡ', 'Ϣ', 'Ϥ', 'Ϧ', 'Ϩ', 'Ϫ', 'Ϭ', 'Ϯ', 'ϴ', 'Ϸ', 'Ѡ', 'Ѣ', 'Ѥ', 'Ѧ', 'Ѩ', 'Ѫ', 'Ѭ', 'Ѯ', 'Ѱ', 'Ѳ', 'Ѵ', 'Ѷ', 'Ѹ', 'Ѻ', 'Ѽ', 'Ѿ', 'Ҁ', 'Ҋ', 'Ҍ', 'Ҏ', 'Ґ', 'Ғ', 'Ҕ', 'Җ', 'Ҙ', 'Қ', 'Ҝ', 'Ҟ', 'Ҡ', 'Ң', 'Ҥ', 'Ҧ', 'Ҩ', 'Ҫ', 'Ҭ', 'Ү', 'Ұ', 'Ҳ', 'Ҵ', 'Ҷ', 'Ҹ', 'Һ', 'Ҽ', 'Ҿ', 'Ӄ', 'Ӆ', 'Ӈ', 'Ӊ', 'Ӌ', 'Ӎ', 'Ӑ', 'Ӓ', 'Ӕ', 'Ӗ', 'Ә', 'Ӛ', 'Ӝ', 'Ӟ', 'Ӡ', 'Ӣ', 'Ӥ', 'Ӧ', 'Ө', 'Ӫ', 'Ӭ', 'Ӯ', 'Ӱ', 'Ӳ', 'Ӵ', 'Ӷ', 'Ӹ', 'Ӻ', 'Ӽ', 'Ӿ', 'Ԁ', 'Ԃ', 'Ԅ', 'Ԇ', 'Ԉ', 'Ԋ', 'Ԍ', 'Ԏ', 'Ԑ', 'Ԓ', 'Ԕ', 'Ԗ', 'Ԙ', 'Ԛ', 'Ԝ', 'Ԟ', 'Ԡ', 'Ԣ', 'Ԥ', 'Ԧ', 'Ԩ', 'Ԫ', 'Ԭ', 'Ԯ', 'Ⴧ', 'Ⴭ', 'Ḁ', 'Ḃ', 'Ḅ', 'Ḇ', 'Ḉ', 'Ḋ', 'Ḍ', 'Ḏ', 'Ḑ', 'Ḓ', 'Ḕ', 'Ḗ', 'Ḙ', 'Ḛ', 'Ḝ', 'Ḟ', 'Ḡ', 'Ḣ', 'Ḥ', 'Ḧ', 'Ḩ', 'Ḫ', 'Ḭ', 'Ḯ', 'Ḱ', 'Ḳ', 'Ḵ', 'Ḷ', 'Ḹ', 'Ḻ', 'Ḽ', 'Ḿ', 'Ṁ', 'Ṃ', 'Ṅ', 'Ṇ', 'Ṉ', 'Ṋ', 'Ṍ', 'Ṏ', 'Ṑ', 'Ṓ', 'Ṕ', 'Ṗ', 'Ṙ', 'Ṛ', 'Ṝ', 'Ṟ', 'Ṡ', 'Ṣ', 'Ṥ', 'Ṧ', 'Ṩ', 'Ṫ', 'Ṭ', 'Ṯ', 'Ṱ', 'Ṳ', 'Ṵ', 'Ṷ', 'Ṹ', 'Ṻ', 'Ṽ', 'Ṿ', 'Ẁ', 'Ẃ', 'Ẅ', 'Ẇ', 'Ẉ', 'Ẋ', 'Ẍ', 'Ẏ', 'Ẑ', 'Ẓ', 'Ẕ', 'ẞ', 'Ạ', 'Ả', 'Ấ', 'Ầ', 'Ẩ', 'Ẫ', 'Ậ', 'Ắ', 'Ằ', 'Ẳ', 'Ẵ', 'Ặ', 'Ẹ', 'Ẻ', 'Ẽ', 'Ế', 'Ề', 'Ể', 'Ễ', 'Ệ', 'Ỉ', 'Ị', 'Ọ', 'Ỏ', 'Ố', 'Ồ', 'Ổ', 'Ỗ', 'Ộ', 'Ớ', 'Ờ', 'Ở', 'Ỡ', 'Ợ', 'Ụ', 'Ủ', 'Ứ', 'Ừ', 'Ử', 'Ữ', 'Ự', 'Ỳ', 'Ỵ', 'Ỷ', 'Ỹ', 'Ỻ', 'Ỽ', 'Ỿ', 'Ὑ', 'Ὓ', 'Ὕ', 'Ὗ', 'ℂ', 'ℇ', 'ℕ', 'ℤ', 'Ω', 'ℨ', 'ⅅ', 'Ↄ', 'Ⱡ', 'Ⱨ', 'Ⱪ', 'Ⱬ', 'Ⱳ', 'Ⱶ', 'Ⲃ', 'Ⲅ', 'Ⲇ', 'Ⲉ', 'Ⲋ', 'Ⲍ', 'Ⲏ', 'Ⲑ', 'Ⲓ', 'Ⲕ', 'Ⲗ', 'Ⲙ', 'Ⲛ', 'Ⲝ', 'Ⲟ', 'Ⲡ', 'Ⲣ', 'Ⲥ', 'Ⲧ', 'Ⲩ', 'Ⲫ', 'Ⲭ', 'Ⲯ', 'Ⲱ', 'Ⲳ', 'Ⲵ', 'Ⲷ', 'Ⲹ', 'Ⲻ', 'Ⲽ', 'Ⲿ', 'Ⳁ', 'Ⳃ', 'Ⳅ', 'Ⳇ', 'Ⳉ', 'Ⳋ', 'Ⳍ', 'Ⳏ', 'Ⳑ', 'Ⳓ', 'Ⳕ', 'Ⳗ', 'Ⳙ', 'Ⳛ', 'Ⳝ', 'Ⳟ', 'Ⳡ', 'Ⳣ', 'Ⳬ', 'Ⳮ', 'Ⳳ', 'Ꙁ', 'Ꙃ', 'Ꙅ', 'Ꙇ', 'Ꙉ', 'Ꙋ', 'Ꙍ', 'Ꙏ', 'Ꙑ', 'Ꙓ', 'Ꙕ', 'Ꙗ', 'Ꙙ', 'Ꙛ', 'Ꙝ', 'Ꙟ', 'Ꙡ', 'Ꙣ', 'Ꙥ', 'Ꙧ', 'Ꙩ', 'Ꙫ', 'Ꙭ', 'Ꚁ', 'Ꚃ', 'Ꚅ', 'Ꚇ', 'Ꚉ', 'Ꚋ', 'Ꚍ', 'Ꚏ', 'Ꚑ', 'Ꚓ', 'Ꚕ', 'Ꚗ', 'Ꚙ', 'Ꚛ', 'Ꜣ', 'Ꜥ', 'Ꜧ', 'Ꜩ', 'Ꜫ', 'Ꜭ', 'Ꜯ', 'Ꜳ', 'Ꜵ', 'Ꜷ', 'Ꜹ', 'Ꜻ', 'Ꜽ', 'Ꜿ', 'Ꝁ', 'Ꝃ', 'Ꝅ', 'Ꝇ', 'Ꝉ', 'Ꝋ', 'Ꝍ', 'Ꝏ', 'Ꝑ', 'Ꝓ', 'Ꝕ', 'Ꝗ', 'Ꝙ', 'Ꝛ', 'Ꝝ', 'Ꝟ', 'Ꝡ', 'Ꝣ', 'Ꝥ', 'Ꝧ', 'Ꝩ', 'Ꝫ', 'Ꝭ', 'Ꝯ', 'Ꝺ', 'Ꝼ', 'Ꞁ', 'Ꞃ', 'Ꞅ', 'Ꞇ', 'Ꞌ', 'Ɥ', 'Ꞑ', 'Ꞓ', 'Ꞗ', 'Ꞙ', 'Ꞛ', 'Ꞝ', 'Ꞟ', 'Ꞡ', 'Ꞣ', 'Ꞥ', 'Ꞧ', 'Ꞩ', 'Ꞷ', 'Ꞹ'},
 				ranges:     []rune{'A', 'Z', 'À', 'Ö', 'Ø', 'Þ', 'Ÿ', 'Ź', 'Ɓ', 'Ƃ', 'Ɔ', 'Ƈ', 'Ɖ', 'Ƌ', 'Ǝ', 'Ƒ', 'Ɠ', 'Ɣ', 'Ɩ', 'Ƙ', 'Ɯ', 'Ɲ', 'Ɵ', 'Ơ', 'Ʀ', 'Ƨ', 'Ʈ', 'Ư', 'Ʊ', 'Ƴ', 'Ʒ', 'Ƹ', 'Ƕ', 'Ǹ', 'Ⱥ', 'Ȼ', 'Ƚ', 'Ⱦ', 'Ƀ', 'Ɇ', 'Έ', 'Ί', 'Ύ', 'Ώ', 'Α', 'Ρ', 'Σ', 'Ϋ', 'ϒ', 'ϔ', 'Ϲ', 'Ϻ', 'Ͻ', 'Я', 'Ӏ', 'Ӂ', 'Ա', 'Ֆ', 'Ⴀ', 'Ⴥ', 'Ꭰ', 'Ᏽ', 'Ა', 'Ჺ', 'Ჽ', 'Ჿ', 'Ἀ', 'Ἇ', 'Ἐ', 'Ἕ', 'Ἠ', 'Ἧ', 'Ἰ', 'Ἷ', 'Ὀ', 'Ὅ', 'Ὠ', 'Ὧ', 'Ᾰ', 'Ά', 'Ὲ', 'Ή', 'Ῐ', 'Ί', 'Ῠ', 'Ῥ', 'Ὸ', 'Ώ', 'ℋ', 'ℍ', 'ℐ', 'ℒ', 'ℙ', 'ℝ', 'K', 'ℭ', 'ℰ', 'ℳ', 'ℾ', 'ℿ', 'Ⰰ', 'Ⱞ', 'Ɫ', 'Ɽ', 'Ɑ', 'Ɒ', 'Ȿ', 'Ⲁ', 'Ᵹ', 'Ꝿ', 'Ɦ', 'Ɪ', 'Ʞ', 'Ꞵ', 'Ａ', 'Ｚ'},
@@ -13216,9 +14147,9 @@ var g = &grammar{
 		},
 		{
 			name: "Mc",
-			pos:  position{line: 1909, col: 1, offset: 57048},
+			pos:  position{line: 2026, col: 1, offset: 60625},
 			expr: &charClassMatcher{
-				pos:        position{line: 1909, col: 6, offset: 57053},
+				pos:        position{line: 2026, col: 6, offset: 60630},
 				val:        "[\\u0903\\u093B\\u093E-\\u0940\\u0949-\\u094C\\u094E-\\u094F\\u0982-\\u0983\\u09BE-\\u09C0\\u09C7-\\u09C8\\u09CB-\\u09CC\\u09D7\\u0A03\\u0A3E-\\u0A40\\u0A83\\u0ABE-\\u0AC0\\u0AC9\\u0ACB-\\u0ACC\\u0B02-\\u0B03\\u0B3E\\u0B40\\u0B47-\\u0B48\\u0B4B-\\u0B4C\\u0B57\\u0BBE-\\u0BBF\\u0BC1-\\u0BC2\\u0BC6-\\u0BC8\\u0BCA-\\u0BCC\\u0BD7\\u0C01-\\u0C03\\u0C41-\\u0C44\\u0C82-\\u0C83\\u0CBE\\u0CC0-\\u0CC4\\u0CC7-\\u0CC8\\u0CCA-\\u0CCB\\u0CD5-\\u0CD6\\u0D02-\\u0D03\\u0D3E-\\u0D40\\u0D46-\\u0D48\\u0D4A-\\u0D4C\\u0D57\\u0D82-\\u0D83\\u0DCF-\\u0DD1\\u0DD8-\\u0DDF\\u0DF2-\\u0DF3\\u0F3E-\\u0F3F\\u0F7F\\u102B-\\u102C\\u1031\\u1038\\u103B-\\u103C\\u1056-\\u1057\\u1062-\\u1064\\u1067-\\u106D\\u1083-\\u1084\\u1087-\\u108C\\u108F\\u109A-\\u109C\\u17B6\\u17BE-\\u17C5\\u17C7-\\u17C8\\u1923-\\u1926\\u1929-\\u192B\\u1930-\\u1931\\u1933-\\u1938\\u1A19-\\u1A1A\\u1A55\\u1A57\\u1A61\\u1A63-\\u1A64\\u1A6D-\\u1A72\\u1B04\\u1B35\\u1B3B\\u1B3D-\\u1B41\\u1B43-\\u1B44\\u1B82\\u1BA1\\u1BA6-\\u1BA7\\u1BAA\\u1BE7\\u1BEA-\\u1BEC\\u1BEE\\u1BF2-\\u1BF3\\u1C24-\\u1C2B\\u1C34-\\u1C35\\u1CE1\\u1CF2-\\u1CF3\\u1CF7\\u302E-\\u302F\\uA823-\\uA824\\uA827\\uA880-\\uA881\\uA8B4-\\uA8C3\\uA952-\\uA953\\uA983\\uA9B4-\\uA9B5\\uA9BA-\\uA9BB\\uA9BD-\\uA9C0\\uAA2F-\\uAA30\\uAA33-\\uAA34\\uAA4D\\uAA7B\\uAA7D\\uAAEB\\uAAEE-\\uAAEF\\uAAF5\\uABE3-\\uABE4\\uABE6-\\uABE7\\uABE9-\\uABEA\\uABEC]",
 				chars:      []rune{'ः', 'ऻ', 'ৗ', 'ਃ', 'ઃ', 'ૉ', 'ା', 'ୀ', 'ୗ', 'ௗ', 'ಾ', 'ൗ', 'ཿ', 'ေ', 'း', 'ႏ', 'ា', 'ᩕ', 'ᩗ', 'ᩡ', 'ᬄ', 'ᬵ', 'ᬻ', 'ᮂ', 'ᮡ', '᮪', 'ᯧ', 'ᯮ', '᳡', '᳷', 'ꠧ', 'ꦃ', 'ꩍ', 'ꩻ', 'ꩽ', 'ꫫ', 'ꫵ', '꯬'},
 				ranges:     []rune{'ा', 'ी', 'ॉ', 'ौ', 'ॎ', 'ॏ', 'ং', 'ঃ', 'া', 'ী', 'ে', 'ৈ', 'ো', 'ৌ', 'ਾ', 'ੀ', 'ા', 'ી', 'ો', 'ૌ', 'ଂ', 'ଃ', 'େ', 'ୈ', 'ୋ', 'ୌ', 'ா', 'ி', 'ு', 'ூ', 'ெ', 'ை', 'ொ', 'ௌ', 'ఁ', 'ః', 'ు', 'ౄ', 'ಂ', 'ಃ', 'ೀ', 'ೄ', 'ೇ', 'ೈ', 'ೊ', 'ೋ', 'ೕ', 'ೖ', 'ം', 'ഃ', 'ാ', 'ീ', 'െ', 'ൈ', 'ൊ', 'ൌ', 'ං', 'ඃ', 'ා', 'ෑ', 'ෘ', 'ෟ', 'ෲ', 'ෳ', '༾', '༿', 'ါ', 'ာ', 'ျ', 'ြ', 'ၖ', 'ၗ', 'ၢ', 'ၤ', 'ၧ', 'ၭ', 'ႃ', 'ႄ', 'ႇ', 'ႌ', 'ႚ', 'ႜ', 'ើ', 'ៅ', 'ះ', 'ៈ', 'ᤣ', 'ᤦ', 'ᤩ', 'ᤫ', 'ᤰ', 'ᤱ', 'ᤳ', 'ᤸ', 'ᨙ', 'ᨚ', 'ᩣ', 'ᩤ', 'ᩭ', 'ᩲ', 'ᬽ', 'ᭁ', 'ᭃ', '᭄', 'ᮦ', 'ᮧ', 'ᯪ', 'ᯬ', '᯲', '᯳', 'ᰤ', 'ᰫ', 'ᰴ', 'ᰵ', 'ᳲ', 'ᳳ', '〮', '〯', 'ꠣ', 'ꠤ', 'ꢀ', 'ꢁ', 'ꢴ', 'ꣃ', 'ꥒ', '꥓', 'ꦴ', 'ꦵ', 'ꦺ', 'ꦻ', 'ꦽ', '꧀', 'ꨯ', 'ꨰ', 'ꨳ', 'ꨴ', 'ꫮ', 'ꫯ', 'ꯣ', 'ꯤ', 'ꯦ', 'ꯧ', 'ꯩ', 'ꯪ'},
@@ -13230,9 +14161,9 @@ var g = &grammar{
 		},
 		{
 			name: "Mn",
-			pos:  position{line: 1912, col: 1, offset: 58241},
+			pos:  position{line: 2029, col: 1, offset: 61818},
 			expr: &charClassMatcher{
-				pos:        position{line: 1912, col: 6, offset: 58246},
+				pos:        position{line: 2029, col: 6, offset: 61823},
 				val:        "[\\u0300-\\u036F\\u0483-\\u0487\\u0591-\\u05BD\\u05BF\\u05C1-\\u05C2\\u05C4-\\u05C5\\u05C7\\u0610-\\u061A\\u064B-\\u065F\\u0670\\u06D6-\\u06DC\\u06DF-\\u06E4\\u06E7-\\u06E8\\u06EA-\\u06ED\\u0711\\u0730-\\u074A\\u07A6-\\u07B0\\u07EB-\\u07F3\\u07FD\\u0816-\\u0819\\u081B-\\u0823\\u0825-\\u0827\\u0829-\\u082D\\u0859-\\u085B\\u08D3-\\u08E1\\u08E3-\\u0902\\u093A\\u093C\\u0941-\\u0948\\u094D\\u0951-\\u0957\\u0962-\\u0963\\u0981\\u09BC\\u09C1-\\u09C4\\u09CD\\u09E2-\\u09E3\\u09FE\\u0A01-\\u0A02\\u0A3C\\u0A41-\\u0A42\\u0A47-\\u0A48\\u0A4B-\\u0A4D\\u0A51\\u0A70-\\u0A71\\u0A75\\u0A81-\\u0A82\\u0ABC\\u0AC1-\\u0AC5\\u0AC7-\\u0AC8\\u0ACD\\u0AE2-\\u0AE3\\u0AFA-\\u0AFF\\u0B01\\u0B3C\\u0B3F\\u0B41-\\u0B44\\u0B4D\\u0B56\\u0B62-\\u0B63\\u0B82\\u0BC0\\u0BCD\\u0C00\\u0C04\\u0C3E-\\u0C40\\u0C46-\\u0C48\\u0C4A-\\u0C4D\\u0C55-\\u0C56\\u0C62-\\u0C63\\u0C81\\u0CBC\\u0CBF\\u0CC6\\u0CCC-\\u0CCD\\u0CE2-\\u0CE3\\u0D00-\\u0D01\\u0D3B-\\u0D3C\\u0D41-\\u0D44\\u0D4D\\u0D62-\\u0D63\\u0DCA\\u0DD2-\\u0DD4\\u0DD6\\u0E31\\u0E34-\\u0E3A\\u0E47-\\u0E4E\\u0EB1\\u0EB4-\\u0EB9\\u0EBB-\\u0EBC\\u0EC8-\\u0ECD\\u0F18-\\u0F19\\u0F35\\u0F37\\u0F39\\u0F71-\\u0F7E\\u0F80-\\u0F84\\u0F86-\\u0F87\\u0F8D-\\u0F97\\u0F99-\\u0FBC\\u0FC6\\u102D-\\u1030\\u1032-\\u1037\\u1039-\\u103A\\u103D-\\u103E\\u1058-\\u1059\\u105E-\\u1060\\u1071-\\u1074\\u1082\\u1085-\\u1086\\u108D\\u109D\\u135D-\\u135F\\u1712-\\u1714\\u1732-\\u1734\\u1752-\\u1753\\u1772-\\u1773\\u17B4-\\u17B5\\u17B7-\\u17BD\\u17C6\\u17C9-\\u17D3\\u17DD\\u180B-\\u180D\\u1885-\\u1886\\u18A9\\u1920-\\u1922\\u1927-\\u1928\\u1932\\u1939-\\u193B\\u1A17-\\u1A18\\u1A1B\\u1A56\\u1A58-\\u1A5E\\u1A60\\u1A62\\u1A65-\\u1A6C\\u1A73-\\u1A7C\\u1A7F\\u1AB0-\\u1ABD\\u1B00-\\u1B03\\u1B34\\u1B36-\\u1B3A\\u1B3C\\u1B42\\u1B6B-\\u1B73\\u1B80-\\u1B81\\u1BA2-\\u1BA5\\u1BA8-\\u1BA9\\u1BAB-\\u1BAD\\u1BE6\\u1BE8-\\u1BE9\\u1BED\\u1BEF-\\u1BF1\\u1C2C-\\u1C33\\u1C36-\\u1C37\\u1CD0-\\u1CD2\\u1CD4-\\u1CE0\\u1CE2-\\u1CE8\\u1CED\\u1CF4\\u1CF8-\\u1CF9\\u1DC0-\\u1DF9\\u1DFB-\\u1DFF\\u20D0-\\u20DC\\u20E1\\u20E5-\\u20F0\\u2CEF-\\u2CF1\\u2D7F\\u2DE0-\\u2DFF\\u302A-\\u302D\\u3099-\\u309A\\uA66F\\uA674-\\uA67D\\uA69E-\\uA69F\\uA6F0-\\uA6F1\\uA802\\uA806\\uA80B\\uA825-\\uA826\\uA8C4-\\uA8C5\\uA8E0-\\uA8F1\\uA8FF\\uA926-\\uA92D\\uA947-\\uA951\\uA980-\\uA982\\uA9B3\\uA9B6-\\uA9B9\\uA9BC\\uA9E5\\uAA29-\\uAA2E\\uAA31-\\uAA32\\uAA35-\\uAA36\\uAA43\\uAA4C\\uAA7C\\uAAB0\\uAAB2-\\uAAB4\\uAAB7-\\uAAB8\\uAABE-\\uAABF\\uAAC1\\uAAEC-\\uAAED\\uAAF6\\uABE5\\uABE8\\uABED\\uFB1E\\uFE00-\\uFE0F\\uFE20-\\uFE2F]",
 				chars:      []rune{'ֿ', 'ׇ', 'ٰ', 'ܑ', '߽', 'ऺ', '़', '्', 'ঁ', '়', '্', '৾', '਼', 'ੑ', 'ੵ', '઼', '્', 'ଁ', '଼', 'ି', '୍', 'ୖ', 'ஂ', 'ீ', '்', 'ఀ', 'ఄ', 'ಁ', '಼', 'ಿ', 'ೆ', '്', '්', 'ූ', 'ั', 'ັ', '༵', '༷', '༹', '࿆', 'ႂ', 'ႍ', 'ႝ', 'ំ', '៝', 'ᢩ', 'ᤲ', 'ᨛ', 'ᩖ', '᩠', 'ᩢ', '᩿', '᬴', 'ᬼ', 'ᭂ', '᯦', 'ᯭ', '᳭', '᳴', '⃡', '⵿', '꙯', 'ꠂ', '꠆', 'ꠋ', 'ꣿ', '꦳', 'ꦼ', 'ꧥ', 'ꩃ', 'ꩌ', 'ꩼ', 'ꪰ', '꫁', '꫶', 'ꯥ', 'ꯨ', '꯭', 'ﬞ'},
 				ranges:     []rune{'̀', 'ͯ', '҃', '҇', '֑', 'ֽ', 'ׁ', 'ׂ', 'ׄ', 'ׅ', 'ؐ', 'ؚ', 'ً', 'ٟ', 'ۖ', 'ۜ', '۟', 'ۤ', 'ۧ', 'ۨ', '۪', 'ۭ', 'ܰ', '݊', 'ަ', 'ް', '߫', '߳', 'ࠖ', '࠙', 'ࠛ', 'ࠣ', 'ࠥ', 'ࠧ', 'ࠩ', '࠭', '࡙', '࡛', '࣓', '࣡', 'ࣣ', 'ं', 'ु', 'ै', '॑', 'ॗ', 'ॢ', 'ॣ', 'ু', 'ৄ', 'ৢ', 'ৣ', 'ਁ', 'ਂ', 'ੁ', 'ੂ', 'ੇ', 'ੈ', 'ੋ', '੍', 'ੰ', 'ੱ', 'ઁ', 'ં', 'ુ', 'ૅ', 'ે', 'ૈ', 'ૢ', 'ૣ', 'ૺ', '૿', 'ୁ', 'ୄ', 'ୢ', 'ୣ', 'ా', 'ీ', 'ె', 'ై', 'ొ', '్', 'ౕ', 'ౖ', 'ౢ', 'ౣ', 'ೌ', '್', 'ೢ', 'ೣ', 'ഀ', 'ഁ', '഻', '഼', 'ു', 'ൄ', 'ൢ', 'ൣ', 'ි', 'ු', 'ิ', 'ฺ', '็', '๎', 'ິ', 'ູ', 'ົ', 'ຼ', '່', 'ໍ', '༘', '༙', 'ཱ', 'ཾ', 'ྀ', '྄', '྆', '྇', 'ྍ', 'ྗ', 'ྙ', 'ྼ', 'ိ', 'ူ', 'ဲ', '့', '္', '်', 'ွ', 'ှ', 'ၘ', 'ၙ', 'ၞ', 'ၠ', 'ၱ', 'ၴ', 'ႅ', 'ႆ', '፝', '፟', 'ᜒ', '᜔', 'ᜲ', '᜴', 'ᝒ', 'ᝓ', 'ᝲ', 'ᝳ', '឴', '឵', 'ិ', 'ួ', '៉', '៓', '᠋', '᠍', 'ᢅ', 'ᢆ', 'ᤠ', 'ᤢ', 'ᤧ', 'ᤨ', '᤹', '᤻', 'ᨗ', 'ᨘ', 'ᩘ', 'ᩞ', 'ᩥ', 'ᩬ', 'ᩳ', '᩼', '᪰', '᪽', 'ᬀ', 'ᬃ', 'ᬶ', 'ᬺ', '᭫', '᭳', 'ᮀ', 'ᮁ', 'ᮢ', 'ᮥ', 'ᮨ', 'ᮩ', '᮫', 'ᮭ', 'ᯨ', 'ᯩ', 'ᯯ', 'ᯱ', 'ᰬ', 'ᰳ', 'ᰶ', '᰷', '᳐', '᳒', '᳔', '᳠', '᳢', '᳨', '᳸', '᳹', '᷀', '᷹', '᷻', '᷿', '⃐', '⃜', '⃥', '⃰', '⳯', '⳱', 'ⷠ', 'ⷿ', '〪', '〭', '゙', '゚', 'ꙴ', '꙽', 'ꚞ', 'ꚟ', '꛰', '꛱', 'ꠥ', 'ꠦ', '꣄', 'ꣅ', '꣠', '꣱', 'ꤦ', '꤭', 'ꥇ', 'ꥑ', 'ꦀ', 'ꦂ', 'ꦶ', 'ꦹ', 'ꨩ', 'ꨮ', 'ꨱ', 'ꨲ', 'ꨵ', 'ꨶ', 'ꪲ', 'ꪴ', 'ꪷ', 'ꪸ', 'ꪾ', '꪿', 'ꫬ', 'ꫭ', '︀', '️', '︠', '︯'},
@@ -13244,9 +14175,9 @@ var g = &grammar{
 		},
 		{
 			name: "Nd",
-			pos:  position{line: 1915, col: 1, offset: 60426},
+			pos:  position{line: 2032, col: 1, offset: 64003},
 			expr: &charClassMatcher{
-				pos:        position{line: 1915, col: 6, offset: 60431},
+				pos:        position{line: 2032, col: 6, offset: 64008},
 				val:        "[\\u0030-\\u0039\\u0660-\\u0669\\u06F0-\\u06F9\\u07C0-\\u07C9\\u0966-\\u096F\\u09E6-\\u09EF\\u0A66-\\u0A6F\\u0AE6-\\u0AEF\\u0B66-\\u0B6F\\u0BE6-\\u0BEF\\u0C66-\\u0C6F\\u0CE6-\\u0CEF\\u0D66-\\u0D6F\\u0DE6-\\u0DEF\\u0E50-\\u0E59\\u0ED0-\\u0ED9\\u0F20-\\u0F29\\u1040-\\u1049\\u1090-\\u1099\\u17E0-\\u17E9\\u1810-\\u1819\\u1946-\\u194F\\u19D0-\\u19D9\\u1A80-\\u1A89\\u1A90-\\u1A99\\u1B50-\\u1B59\\u1BB0-\\u1BB9\\u1C40-\\u1C49\\u1C50-\\u1C59\\uA620-\\uA629\\uA8D0-\\uA8D9\\uA900-\\uA909\\uA9D0-\\uA9D9\\uA9F0-\\uA9F9\\uAA50-\\uAA59\\uABF0-\\uABF9\\uFF10-\\uFF19]",
 				ranges:     []rune{'0', '9', '٠', '٩', '۰', '۹', '߀', '߉', '०', '९', '০', '৯', '੦', '੯', '૦', '૯', '୦', '୯', '௦', '௯', '౦', '౯', '೦', '೯', '൦', '൯', '෦', '෯', '๐', '๙', '໐', '໙', '༠', '༩', '၀', '၉', '႐', '႙', '០', '៩', '᠐', '᠙', '᥆', '᥏', '᧐', '᧙', '᪀', '᪉', '᪐', '᪙', '᭐', '᭙', '᮰', '᮹', '᱀', '᱉', '᱐', '᱙', '꘠', '꘩', '꣐', '꣙', '꤀', '꤉', '꧐', '꧙', '꧰', '꧹', '꩐', '꩙', '꯰', '꯹', '０', '９'},
 				ignoreCase: false,
@@ -13257,9 +14188,9 @@ var g = &grammar{
 		},
 		{
 			name: "Nl",
-			pos:  position{line: 1918, col: 1, offset: 60934},
+			pos:  position{line: 2035, col: 1, offset: 64511},
 			expr: &charClassMatcher{
-				pos:        position{line: 1918, col: 6, offset: 60939},
+				pos:        position{line: 2035, col: 6, offset: 64516},
 				val:        "[\\u16EE-\\u16F0\\u2160-\\u2182\\u2185-\\u2188\\u3007\\u3021-\\u3029\\u3038-\\u303A\\uA6E6-\\uA6EF]",
 				chars:      []rune{'〇'},
 				ranges:     []rune{'ᛮ', 'ᛰ', 'Ⅰ', 'ↂ', 'ↅ', 'ↈ', '〡', '〩', '〸', '〺', 'ꛦ', 'ꛯ'},
@@ -13271,9 +14202,9 @@ var g = &grammar{
 		},
 		{
 			name: "Pc",
-			pos:  position{line: 1921, col: 1, offset: 61053},
+			pos:  position{line: 2038, col: 1, offset: 64630},
 			expr: &charClassMatcher{
-				pos:        position{line: 1921, col: 6, offset: 61058},
+				pos:        position{line: 2038, col: 6, offset: 64635},
 				val:        "[\\u005F\\u203F-\\u2040\\u2054\\uFE33-\\uFE34\\uFE4D-\\uFE4F\\uFF3F]",
 				chars:      []rune{'_', '⁔', '＿'},
 				ranges:     []rune{'‿', '⁀', '︳', '︴', '﹍', '﹏'},
@@ -13285,9 +14216,9 @@ var g = &grammar{
 		},
 		{
 			name: "Zs",
-			pos:  position{line: 1924, col: 1, offset: 61139},
+			pos:  position{line: 2041, col: 1, offset: 64716},
 			expr: &charClassMatcher{
-				pos:        position{line: 1924, col: 6, offset: 61144},
+				pos:        position{line: 2041, col: 6, offset: 64721},
 				val:        "[\\u0020\\u00A0\\u1680\\u2000-\\u200A\\u202F\\u205F\\u3000]",
 				chars:      []rune{' ', '\u00a0', '\u1680', '\u202f', '\u205f', '\u3000'},
 				ranges:     []rune{'\u2000', '\u200a'},
@@ -13299,9 +14230,9 @@ var g = &grammar{
 		},
 		{
 			name: "SourceCharacter",
-			pos:  position{line: 1926, col: 1, offset: 61197},
+			pos:  position{line: 2043, col: 1, offset: 64774},
 			expr: &anyMatcher{
-				line: 1927, col: 5, offset: 61217,
+				line: 2044, col: 5, offset: 64794,
 			},
 			leader:        false,
 			leftRecursive: false,
@@ -13309,48 +14240,48 @@ var g = &grammar{
 		{
 			name:        "WhiteSpace",
 			displayName: "\"whitespace\"",
-			pos:         position{line: 1929, col: 1, offset: 61220},
+			pos:         position{line: 2046, col: 1, offset: 64797},
 			expr: &choiceExpr{
-				pos: position{line: 1930, col: 5, offset: 61248},
+				pos: position{line: 2047, col: 5, offset: 64825},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 1930, col: 5, offset: 61248},
+						pos:        position{line: 2047, col: 5, offset: 64825},
 						val:        "\t",
 						ignoreCase: false,
 						want:       "\"\\t\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1931, col: 5, offset: 61257},
+						pos:        position{line: 2048, col: 5, offset: 64834},
 						val:        "\v",
 						ignoreCase: false,
 						want:       "\"\\v\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1932, col: 5, offset: 61266},
+						pos:        position{line: 2049, col: 5, offset: 64843},
 						val:        "\f",
 						ignoreCase: false,
 						want:       "\"\\f\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1933, col: 5, offset: 61275},
+						pos:        position{line: 2050, col: 5, offset: 64852},
 						val:        " ",
 						ignoreCase: false,
 						want:       "\" \"",
 					},
 					&litMatcher{
-						pos:        position{line: 1934, col: 5, offset: 61283},
+						pos:        position{line: 2051, col: 5, offset: 64860},
 						val:        "\u00a0",
 						ignoreCase: false,
 						want:       "\"\\u00a0\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1935, col: 5, offset: 61296},
+						pos:        position{line: 2052, col: 5, offset: 64873},
 						val:        "\ufeff",
 						ignoreCase: false,
 						want:       "\"\\ufeff\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1936, col: 5, offset: 61309},
+						pos:  position{line: 2053, col: 5, offset: 64886},
 						name: "Zs",
 					},
 				},
@@ -13360,9 +14291,9 @@ var g = &grammar{
 		},
 		{
 			name: "LineTerminator",
-			pos:  position{line: 1938, col: 1, offset: 61313},
+			pos:  position{line: 2055, col: 1, offset: 64890},
 			expr: &charClassMatcher{
-				pos:        position{line: 1939, col: 5, offset: 61332},
+				pos:        position{line: 2056, col: 5, offset: 64909},
 				val:        "[\\n\\r\\u2028\\u2029]",
 				chars:      []rune{'\n', '\r', '\u2028', '\u2029'},
 				ignoreCase: false,
@@ -13374,16 +14305,16 @@ var g = &grammar{
 		{
 			name:        "Comment",
 			displayName: "\"comment\"",
-			pos:         position{line: 1941, col: 1, offset: 61352},
+			pos:         position{line: 2058, col: 1, offset: 64929},
 			expr: &choiceExpr{
-				pos: position{line: 1942, col: 5, offset: 61374},
+				pos: position{line: 2059, col: 5, offset: 64951},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1942, col: 5, offset: 61374},
+						pos:  position{line: 2059, col: 5, offset: 64951},
 						name: "MultiLineComment",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1943, col: 5, offset: 61395},
+						pos:  position{line: 2060, col: 5, offset: 64972},
 						name: "SingleLineComment",
 					},
 				},
@@ -13393,39 +14324,39 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineComment",
-			pos:  position{line: 1945, col: 1, offset: 61414},
+			pos:  position{line: 2062, col: 1, offset: 64991},
 			expr: &seqExpr{
-				pos: position{line: 1946, col: 5, offset: 61435},
+				pos: position{line: 2063, col: 5, offset: 65012},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1946, col: 5, offset: 61435},
+						pos:        position{line: 2063, col: 5, offset: 65012},
 						val:        "/*",
 						ignoreCase: false,
 						want:       "\"/*\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1946, col: 10, offset: 61440},
+						pos: position{line: 2063, col: 10, offset: 65017},
 						expr: &seqExpr{
-							pos: position{line: 1946, col: 11, offset: 61441},
+							pos: position{line: 2063, col: 11, offset: 65018},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1946, col: 11, offset: 61441},
+									pos: position{line: 2063, col: 11, offset: 65018},
 									expr: &litMatcher{
-										pos:        position{line: 1946, col: 12, offset: 61442},
+										pos:        position{line: 2063, col: 12, offset: 65019},
 										val:        "*/",
 										ignoreCase: false,
 										want:       "\"*/\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1946, col: 17, offset: 61447},
+									pos:  position{line: 2063, col: 17, offset: 65024},
 									name: "SourceCharacter",
 								},
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 1946, col: 35, offset: 61465},
+						pos:        position{line: 2063, col: 35, offset: 65042},
 						val:        "*/",
 						ignoreCase: false,
 						want:       "\"*/\"",
@@ -13437,30 +14368,30 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineComment",
-			pos:  position{line: 1948, col: 1, offset: 61471},
+			pos:  position{line: 2065, col: 1, offset: 65048},
 			expr: &seqExpr{
-				pos: position{line: 1949, col: 5, offset: 61493},
+				pos: position{line: 2066, col: 5, offset: 65070},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 1949, col: 5, offset: 61493},
+						pos:        position{line: 2066, col: 5, offset: 65070},
 						val:        "--",
 						ignoreCase: false,
 						want:       "\"--\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1949, col: 10, offset: 61498},
+						pos: position{line: 2066, col: 10, offset: 65075},
 						expr: &seqExpr{
-							pos: position{line: 1949, col: 11, offset: 61499},
+							pos: position{line: 2066, col: 11, offset: 65076},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 1949, col: 11, offset: 61499},
+									pos: position{line: 2066, col: 11, offset: 65076},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1949, col: 12, offset: 61500},
+										pos:  position{line: 2066, col: 12, offset: 65077},
 										name: "LineTerminator",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1949, col: 27, offset: 61515},
+									pos:  position{line: 2066, col: 27, offset: 65092},
 									name: "SourceCharacter",
 								},
 							},
@@ -13473,19 +14404,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 1951, col: 1, offset: 61534},
+			pos:  position{line: 2068, col: 1, offset: 65111},
 			expr: &seqExpr{
-				pos: position{line: 1951, col: 7, offset: 61540},
+				pos: position{line: 2068, col: 7, offset: 65117},
 				exprs: []any{
 					&zeroOrMoreExpr{
-						pos: position{line: 1951, col: 7, offset: 61540},
+						pos: position{line: 2068, col: 7, offset: 65117},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1951, col: 7, offset: 61540},
+							pos:  position{line: 2068, col: 7, offset: 65117},
 							name: "WhiteSpace",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1951, col: 19, offset: 61552},
+						pos:  position{line: 2068, col: 19, offset: 65129},
 						name: "LineTerminator",
 					},
 				},
@@ -13495,16 +14426,16 @@ var g = &grammar{
 		},
 		{
 			name: "EOT",
-			pos:  position{line: 1953, col: 1, offset: 61568},
+			pos:  position{line: 2070, col: 1, offset: 65145},
 			expr: &choiceExpr{
-				pos: position{line: 1953, col: 7, offset: 61574},
+				pos: position{line: 2070, col: 7, offset: 65151},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 1953, col: 7, offset: 61574},
+						pos:  position{line: 2070, col: 7, offset: 65151},
 						name: "_",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1953, col: 11, offset: 61578},
+						pos:  position{line: 2070, col: 11, offset: 65155},
 						name: "EOF",
 					},
 				},
@@ -13514,11 +14445,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 1955, col: 1, offset: 61583},
+			pos:  position{line: 2072, col: 1, offset: 65160},
 			expr: &notExpr{
-				pos: position{line: 1955, col: 7, offset: 61589},
+				pos: position{line: 2072, col: 7, offset: 65166},
 				expr: &anyMatcher{
-					line: 1955, col: 8, offset: 61590,
+					line: 2072, col: 8, offset: 65167,
 				},
 			},
 			leader:        false,
@@ -13526,15 +14457,15 @@ var g = &grammar{
 		},
 		{
 			name: "SQLPipe",
-			pos:  position{line: 1959, col: 1, offset: 61615},
+			pos:  position{line: 2076, col: 1, offset: 65192},
 			expr: &actionExpr{
-				pos: position{line: 1960, col: 5, offset: 61627},
+				pos: position{line: 2077, col: 5, offset: 65204},
 				run: (*parser).callonSQLPipe1,
 				expr: &labeledExpr{
-					pos:   position{line: 1960, col: 5, offset: 61627},
+					pos:   position{line: 2077, col: 5, offset: 65204},
 					label: "s",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1960, col: 7, offset: 61629},
+						pos:  position{line: 2077, col: 7, offset: 65206},
 						name: "Seq",
 					},
 				},
@@ -13544,15 +14475,15 @@ var g = &grammar{
 		},
 		{
 			name: "SQLOp",
-			pos:  position{line: 1968, col: 1, offset: 61776},
+			pos:  position{line: 2085, col: 1, offset: 65353},
 			expr: &actionExpr{
-				pos: position{line: 1969, col: 5, offset: 61786},
+				pos: position{line: 2086, col: 5, offset: 65363},
 				run: (*parser).callonSQLOp1,
 				expr: &labeledExpr{
-					pos:   position{line: 1969, col: 5, offset: 61786},
+					pos:   position{line: 2086, col: 5, offset: 65363},
 					label: "query",
 					expr: &ruleRefExpr{
-						pos:  position{line: 1969, col: 11, offset: 61792},
+						pos:  position{line: 2086, col: 11, offset: 65369},
 						name: "SQLQuery",
 					},
 				},
@@ -13562,42 +14493,42 @@ var g = &grammar{
 		},
 		{
 			name: "SQLQuery",
-			pos:  position{line: 1977, col: 1, offset: 61929},
+			pos:  position{line: 2094, col: 1, offset: 65506},
 			expr: &actionExpr{
-				pos: position{line: 1978, col: 5, offset: 61942},
+				pos: position{line: 2095, col: 5, offset: 65519},
 				run: (*parser).callonSQLQuery1,
 				expr: &seqExpr{
-					pos: position{line: 1978, col: 5, offset: 61942},
+					pos: position{line: 2095, col: 5, offset: 65519},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 1978, col: 5, offset: 61942},
+							pos:   position{line: 2095, col: 5, offset: 65519},
 							label: "with",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1978, col: 10, offset: 61947},
+								pos:  position{line: 2095, col: 10, offset: 65524},
 								name: "OptWithClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1979, col: 5, offset: 61965},
+							pos:   position{line: 2096, col: 5, offset: 65542},
 							label: "body",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1979, col: 10, offset: 61970},
+								pos:  position{line: 2096, col: 10, offset: 65547},
 								name: "SQLBodySetOp",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1980, col: 5, offset: 61987},
+							pos:   position{line: 2097, col: 5, offset: 65564},
 							label: "orderby",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1980, col: 13, offset: 61995},
+								pos:  position{line: 2097, col: 13, offset: 65572},
 								name: "OptOrderByClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1981, col: 5, offset: 62016},
+							pos:   position{line: 2098, col: 5, offset: 65593},
 							label: "loff",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1981, col: 10, offset: 62021},
+								pos:  position{line: 2098, col: 10, offset: 65598},
 								name: "OptSQLLimitOffset",
 							},
 						},
@@ -13609,39 +14540,39 @@ var g = &grammar{
 		},
 		{
 			name: "SQLBodySetOp",
-			pos:  position{line: 1999, col: 1, offset: 62438},
+			pos:  position{line: 2116, col: 1, offset: 66015},
 			expr: &actionExpr{
-				pos: position{line: 2000, col: 5, offset: 62455},
+				pos: position{line: 2117, col: 5, offset: 66032},
 				run: (*parser).callonSQLBodySetOp1,
 				expr: &seqExpr{
-					pos: position{line: 2000, col: 5, offset: 62455},
+					pos: position{line: 2117, col: 5, offset: 66032},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2000, col: 5, offset: 62455},
+							pos:   position{line: 2117, col: 5, offset: 66032},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2000, col: 11, offset: 62461},
+								pos:  position{line: 2117, col: 11, offset: 66038},
 								name: "SQLQueryBody",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2000, col: 24, offset: 62474},
+							pos:   position{line: 2117, col: 24, offset: 66051},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2000, col: 29, offset: 62479},
+								pos: position{line: 2117, col: 29, offset: 66056},
 								expr: &seqExpr{
-									pos: position{line: 2000, col: 30, offset: 62480},
+									pos: position{line: 2117, col: 30, offset: 66057},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 2000, col: 30, offset: 62480},
+											pos:  position{line: 2117, col: 30, offset: 66057},
 											name: "SetOp",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2000, col: 36, offset: 62486},
+											pos:  position{line: 2117, col: 36, offset: 66063},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2000, col: 38, offset: 62488},
+											pos:  position{line: 2117, col: 38, offset: 66065},
 											name: "SQLQueryBody",
 										},
 									},
@@ -13656,52 +14587,52 @@ var g = &grammar{
 		},
 		{
 			name: "SQLQueryBody",
-			pos:  position{line: 2016, col: 1, offset: 62886},
+			pos:  position{line: 2133, col: 1, offset: 66463},
 			expr: &choiceExpr{
-				pos: position{line: 2017, col: 5, offset: 62903},
+				pos: position{line: 2134, col: 5, offset: 66480},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2017, col: 5, offset: 62903},
+						pos:  position{line: 2134, col: 5, offset: 66480},
 						name: "Select",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2018, col: 5, offset: 62914},
+						pos:  position{line: 2135, col: 5, offset: 66491},
 						name: "FromSelect",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2019, col: 5, offset: 62929},
+						pos:  position{line: 2136, col: 5, offset: 66506},
 						name: "SQLValues",
 					},
 					&actionExpr{
-						pos: position{line: 2020, col: 5, offset: 62943},
+						pos: position{line: 2137, col: 5, offset: 66520},
 						run: (*parser).callonSQLQueryBody5,
 						expr: &seqExpr{
-							pos: position{line: 2020, col: 5, offset: 62943},
+							pos: position{line: 2137, col: 5, offset: 66520},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 2020, col: 5, offset: 62943},
+									pos:        position{line: 2137, col: 5, offset: 66520},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2020, col: 9, offset: 62947},
+									pos:  position{line: 2137, col: 9, offset: 66524},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 2020, col: 12, offset: 62950},
+									pos:   position{line: 2137, col: 12, offset: 66527},
 									label: "s",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2020, col: 14, offset: 62952},
+										pos:  position{line: 2137, col: 14, offset: 66529},
 										name: "SQLQueryOrSetExpr",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2020, col: 32, offset: 62970},
+									pos:  position{line: 2137, col: 32, offset: 66547},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2020, col: 34, offset: 62972},
+									pos:        position{line: 2137, col: 34, offset: 66549},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -13716,16 +14647,16 @@ var g = &grammar{
 		},
 		{
 			name: "SQLQueryOrSetExpr",
-			pos:  position{line: 2022, col: 1, offset: 62995},
+			pos:  position{line: 2139, col: 1, offset: 66572},
 			expr: &choiceExpr{
-				pos: position{line: 2022, col: 21, offset: 63015},
+				pos: position{line: 2139, col: 21, offset: 66592},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2022, col: 21, offset: 63015},
+						pos:  position{line: 2139, col: 21, offset: 66592},
 						name: "SQLQuery",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2022, col: 32, offset: 63026},
+						pos:  position{line: 2139, col: 32, offset: 66603},
 						name: "SQLBodySetOp",
 					},
 				},
@@ -13735,71 +14666,261 @@ var g = &grammar{
 		},
 		{
 			name: "Select",
-			pos:  position{line: 2024, col: 1, offset: 63040},
+			pos:  position{line: 2141, col: 1, offset: 66617},
 			expr: &actionExpr{
-				pos: position{line: 2025, col: 5, offset: 63051},
+				pos: position{line: 2142, col: 5, offset: 66628},
 				run: (*parser).callonSelect1,
 				expr: &seqExpr{
-					pos: position{line: 2025, col: 5, offset: 63051},
+					pos: position{line: 2142, col: 5, offset: 66628},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2025, col: 5, offset: 63051},
+							pos:  position{line: 2142, col: 5, offset: 66628},
 							name: "SELECT",
 						},
 						&labeledExpr{
-							pos:   position{line: 2026, col: 5, offset: 63062},
+							pos:   position{line: 2143, col: 5, offset: 66639},
 							label: "distinct",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2026, col: 14, offset: 63071},
+								pos:  position{line: 2143, col: 14, offset: 66648},
 								name: "OptDistinct",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2029, col: 5, offset: 63207},
+							pos:  position{line: 2146, col: 5, offset: 66784},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2029, col: 7, offset: 63209},
+							pos:   position{line: 2146, col: 7, offset: 66786},
 							label: "selection",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2029, col: 17, offset: 63219},
+								pos:  position{line: 2146, col: 17, offset: 66796},
 								name: "Selection",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2030, col: 5, offset: 63233},
+							pos:   position{line: 2147, col: 5, offset: 66810},
 							label: "from",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2030, col: 10, offset: 63238},
+								pos:  position{line: 2147, col: 10, offset: 66815},
 								name: "OptFromClause",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2031, col: 5, offset: 63256},
+							pos:   position{line: 2148, col: 5, offset: 66833},
+							label: "where",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2148, col: 11, offset: 66839},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2148, col: 11, offset: 66839},
+									name: "WhereClause",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2149, col: 5, offset: 66856},
+							label: "group",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2149, col: 11, offset: 66862},
+								name: "OptGroupClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2150, col: 5, offset: 66881},
+							label: "having",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2150, col: 12, offset: 66888},
+								name: "OptHavingClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2152, col: 5, offset: 66943},
+							label: "windows",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2152, col: 13, offset: 66951},
+								name: "OptWindowClause",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "FromSelect",
+			pos:  position{line: 2178, col: 1, offset: 67561},
+			expr: &actionExpr{
+				pos: position{line: 2179, col: 5, offset: 67576},
+				run: (*parser).callonFromSelect1,
+				expr: &seqExpr{
+					pos: position{line: 2179, col: 5, offset: 67576},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2179, col: 5, offset: 67576},
+							name: "FROM",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2179, col: 10, offset: 67581},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 2179, col: 12, offset: 67583},
+							label: "from",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2179, col: 17, offset: 67588},
+								name: "JoinedTable",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2179, col: 29, offset: 67600},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2179, col: 31, offset: 67602},
+							name: "SELECT",
+						},
+						&labeledExpr{
+							pos:   position{line: 2180, col: 5, offset: 67613},
+							label: "distinct",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2180, col: 14, offset: 67622},
+								name: "OptDistinct",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2183, col: 5, offset: 67758},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 2183, col: 7, offset: 67760},
+							label: "selection",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2183, col: 17, offset: 67770},
+								name: "Selection",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2184, col: 5, offset: 67784},
 							label: "where",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 2031, col: 11, offset: 63262},
+								pos: position{line: 2184, col: 11, offset: 67790},
 								expr: &ruleRefExpr{
-									pos:  position{line: 2031, col: 11, offset: 63262},
+									pos:  position{line: 2184, col: 11, offset: 67790},
 									name: "WhereClause",
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 2032, col: 5, offset: 63279},
-							label: "group",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2032, col: 11, offset: 63285},
-								name: "OptGroupClause",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 2033, col: 5, offset: 63304},
-							label: "having",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2033, col: 12, offset: 63311},
-								name: "OptHavingClause",
-							},
+						&labeledExpr{
+							pos:   position{line: 2185, col: 5, offset: 67807},
+							label: "group",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2185, col: 11, offset: 67813},
+								name: "OptGroupClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2186, col: 5, offset: 67832},
+							label: "having",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2186, col: 12, offset: 67839},
+								name: "OptHavingClause",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2188, col: 5, offset: 67894},
+							label: "windows",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2188, col: 13, offset: 67902},
+								name: "OptWindowClause",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OptWindowClause",
+			pos:  position{line: 2214, col: 1, offset: 68512},
+			expr: &choiceExpr{
+				pos: position{line: 2215, col: 5, offset: 68532},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2215, col: 5, offset: 68532},
+						run: (*parser).callonOptWindowClause2,
+						expr: &seqExpr{
+							pos: position{line: 2215, col: 5, offset: 68532},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2215, col: 5, offset: 68532},
+									name: "_",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2215, col: 7, offset: 68534},
+									name: "WINDOW",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2215, col: 14, offset: 68541},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2215, col: 16, offset: 68543},
+									label: "first",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2215, col: 22, offset: 68549},
+										name: "SQLWindow",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 2215, col: 32, offset: 68559},
+									label: "rest",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 2215, col: 37, offset: 68564},
+										expr: &actionExpr{
+											pos: position{line: 2215, col: 38, offset: 68565},
+											run: (*parser).callonOptWindowClause11,
+											expr: &seqExpr{
+												pos: position{line: 2215, col: 38, offset: 68565},
+												exprs: []any{
+													&ruleRefExpr{
+														pos:  position{line: 2215, col: 38, offset: 68565},
+														name: "__",
+													},
+													&litMatcher{
+														pos:        position{line: 2215, col: 41, offset: 68568},
+														val:        ",",
+														ignoreCase: false,
+														want:       "\",\"",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 2215, col: 45, offset: 68572},
+														name: "__",
+													},
+													&labeledExpr{
+														pos:   position{line: 2215, col: 48, offset: 68575},
+														label: "w",
+														expr: &ruleRefExpr{
+															pos:  position{line: 2215, col: 50, offset: 68577},
+															name: "SQLWindow",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2218, col: 5, offset: 68658},
+						run: (*parser).callonOptWindowClause18,
+						expr: &litMatcher{
+							pos:        position{line: 2218, col: 5, offset: 68658},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
 						},
 					},
 				},
@@ -13808,84 +14929,57 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "FromSelect",
-			pos:  position{line: 2058, col: 1, offset: 63902},
+			name: "SQLWindow",
+			pos:  position{line: 2220, col: 1, offset: 68682},
 			expr: &actionExpr{
-				pos: position{line: 2059, col: 5, offset: 63917},
-				run: (*parser).callonFromSelect1,
+				pos: position{line: 2221, col: 5, offset: 68696},
+				run: (*parser).callonSQLWindow1,
 				expr: &seqExpr{
-					pos: position{line: 2059, col: 5, offset: 63917},
+					pos: position{line: 2221, col: 5, offset: 68696},
 					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 2059, col: 5, offset: 63917},
-							name: "FROM",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2059, col: 10, offset: 63922},
-							name: "_",
-						},
 						&labeledExpr{
-							pos:   position{line: 2059, col: 12, offset: 63924},
-							label: "from",
+							pos:   position{line: 2221, col: 5, offset: 68696},
+							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2059, col: 17, offset: 63929},
-								name: "JoinedTable",
+								pos:  position{line: 2221, col: 10, offset: 68701},
+								name: "Identifier",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2059, col: 29, offset: 63941},
+							pos:  position{line: 2221, col: 21, offset: 68712},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2059, col: 31, offset: 63943},
-							name: "SELECT",
-						},
-						&labeledExpr{
-							pos:   position{line: 2060, col: 5, offset: 63954},
-							label: "distinct",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2060, col: 14, offset: 63963},
-								name: "OptDistinct",
-							},
+							pos:  position{line: 2221, col: 23, offset: 68714},
+							name: "AS",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2063, col: 5, offset: 64099},
-							name: "_",
+							pos:  position{line: 2221, col: 26, offset: 68717},
+							name: "__",
 						},
-						&labeledExpr{
-							pos:   position{line: 2063, col: 7, offset: 64101},
-							label: "selection",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2063, col: 17, offset: 64111},
-								name: "Selection",
-							},
+						&litMatcher{
+							pos:        position{line: 2221, col: 29, offset: 68720},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 2064, col: 5, offset: 64125},
-							label: "where",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 2064, col: 11, offset: 64131},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2064, col: 11, offset: 64131},
-									name: "WhereClause",
-								},
-							},
+						&ruleRefExpr{
+							pos:  position{line: 2221, col: 33, offset: 68724},
+							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2065, col: 5, offset: 64148},
-							label: "group",
+							pos:   position{line: 2221, col: 36, offset: 68727},
+							label: "spec",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2065, col: 11, offset: 64154},
-								name: "OptGroupClause",
+								pos:  position{line: 2221, col: 41, offset: 68732},
+								name: "WindowSpec",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 2066, col: 5, offset: 64173},
-							label: "having",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2066, col: 12, offset: 64180},
-								name: "OptHavingClause",
-							},
+						&litMatcher{
+							pos:        position{line: 2221, col: 52, offset: 68743},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
 						},
 					},
 				},
@@ -13895,30 +14989,30 @@ var g = &grammar{
 		},
 		{
 			name: "WhereClause",
-			pos:  position{line: 2091, col: 1, offset: 64771},
+			pos:  position{line: 2229, col: 1, offset: 68886},
 			expr: &actionExpr{
-				pos: position{line: 2091, col: 15, offset: 64785},
+				pos: position{line: 2229, col: 15, offset: 68900},
 				run: (*parser).callonWhereClause1,
 				expr: &seqExpr{
-					pos: position{line: 2091, col: 15, offset: 64785},
+					pos: position{line: 2229, col: 15, offset: 68900},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2091, col: 15, offset: 64785},
+							pos:  position{line: 2229, col: 15, offset: 68900},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2091, col: 17, offset: 64787},
+							pos:  position{line: 2229, col: 17, offset: 68902},
 							name: "WHERE",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2091, col: 23, offset: 64793},
+							pos:  position{line: 2229, col: 23, offset: 68908},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2091, col: 25, offset: 64795},
+							pos:   position{line: 2229, col: 25, offset: 68910},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2091, col: 30, offset: 64800},
+								pos:  position{line: 2229, col: 30, offset: 68915},
 								name: "LogicalOrExpr",
 							},
 						},
@@ -13930,26 +15024,26 @@ var g = &grammar{
 		},
 		{
 			name: "SQLValues",
-			pos:  position{line: 2093, col: 1, offset: 64836},
+			pos:  position{line: 2231, col: 1, offset: 68951},
 			expr: &actionExpr{
-				pos: position{line: 2094, col: 5, offset: 64850},
+				pos: position{line: 2232, col: 5, offset: 68965},
 				run: (*parser).callonSQLValues1,
 				expr: &seqExpr{
-					pos: position{line: 2094, col: 5, offset: 64850},
+					pos: position{line: 2232, col: 5, offset: 68965},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2094, col: 5, offset: 64850},
+							pos:  position{line: 2232, col: 5, offset: 68965},
 							name: "VALUES",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2094, col: 12, offset: 64857},
+							pos:  position{line: 2232, col: 12, offset: 68972},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2094, col: 15, offset: 64860},
+							pos:   position{line: 2232, col: 15, offset: 68975},
 							label: "tuples",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2094, col: 22, offset: 64867},
+								pos:  position{line: 2232, col: 22, offset: 68982},
 								name: "SQLTuples",
 							},
 						},
@@ -13961,26 +15055,26 @@ var g = &grammar{
 		},
 		{
 			name: "ValuesOp",
-			pos:  position{line: 2102, col: 1, offset: 65024},
+			pos:  position{line: 2240, col: 1, offset: 69139},
 			expr: &actionExpr{
-				pos: position{line: 2103, col: 5, offset: 65037},
+				pos: position{line: 2241, col: 5, offset: 69152},
 				run: (*parser).callonValuesOp1,
 				expr: &seqExpr{
-					pos: position{line: 2103, col: 5, offset: 65037},
+					pos: position{line: 2241, col: 5, offset: 69152},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2103, col: 5, offset: 65037},
+							pos:  position{line: 2241, col: 5, offset: 69152},
 							name: "VALUES",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2103, col: 12, offset: 65044},
+							pos:  position{line: 2241, col: 12, offset: 69159},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2103, col: 14, offset: 65046},
+							pos:   position{line: 2241, col: 14, offset: 69161},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2103, col: 20, offset: 65052},
+								pos:  position{line: 2241, col: 20, offset: 69167},
 								name: "Exprs",
 							},
 						},
@@ -13992,51 +15086,51 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTuples",
-			pos:  position{line: 2112, col: 1, offset: 65203},
+			pos:  position{line: 2250, col: 1, offset: 69318},
 			expr: &actionExpr{
-				pos: position{line: 2113, col: 5, offset: 65217},
+				pos: position{line: 2251, col: 5, offset: 69332},
 				run: (*parser).callonSQLTuples1,
 				expr: &seqExpr{
-					pos: position{line: 2113, col: 5, offset: 65217},
+					pos: position{line: 2251, col: 5, offset: 69332},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2113, col: 5, offset: 65217},
+							pos:   position{line: 2251, col: 5, offset: 69332},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2113, col: 11, offset: 65223},
+								pos:  position{line: 2251, col: 11, offset: 69338},
 								name: "SQLTuple",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2113, col: 20, offset: 65232},
+							pos:   position{line: 2251, col: 20, offset: 69347},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2113, col: 25, offset: 65237},
+								pos: position{line: 2251, col: 25, offset: 69352},
 								expr: &actionExpr{
-									pos: position{line: 2113, col: 26, offset: 65238},
+									pos: position{line: 2251, col: 26, offset: 69353},
 									run: (*parser).callonSQLTuples7,
 									expr: &seqExpr{
-										pos: position{line: 2113, col: 26, offset: 65238},
+										pos: position{line: 2251, col: 26, offset: 69353},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2113, col: 26, offset: 65238},
+												pos:  position{line: 2251, col: 26, offset: 69353},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2113, col: 29, offset: 65241},
+												pos:        position{line: 2251, col: 29, offset: 69356},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2113, col: 33, offset: 65245},
+												pos:  position{line: 2251, col: 33, offset: 69360},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2113, col: 36, offset: 65248},
+												pos:   position{line: 2251, col: 36, offset: 69363},
 												label: "t",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2113, col: 38, offset: 65250},
+													pos:  position{line: 2251, col: 38, offset: 69365},
 													name: "SQLTuple",
 												},
 											},
@@ -14053,37 +15147,37 @@ var g = &grammar{
 		},
 		{
 			name: "SQLTuple",
-			pos:  position{line: 2117, col: 1, offset: 65327},
+			pos:  position{line: 2255, col: 1, offset: 69442},
 			expr: &actionExpr{
-				pos: position{line: 2118, col: 5, offset: 65340},
+				pos: position{line: 2256, col: 5, offset: 69455},
 				run: (*parser).callonSQLTuple1,
 				expr: &seqExpr{
-					pos: position{line: 2118, col: 5, offset: 65340},
+					pos: position{line: 2256, col: 5, offset: 69455},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2118, col: 5, offset: 65340},
+							pos:        position{line: 2256, col: 5, offset: 69455},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2118, col: 9, offset: 65344},
+							pos:  position{line: 2256, col: 9, offset: 69459},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2118, col: 12, offset: 65347},
+							pos:   position{line: 2256, col: 12, offset: 69462},
 							label: "exprs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2118, col: 18, offset: 65353},
+								pos:  position{line: 2256, col: 18, offset: 69468},
 								name: "Exprs",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2118, col: 24, offset: 65359},
+							pos:  position{line: 2256, col: 24, offset: 69474},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2118, col: 27, offset: 65362},
+							pos:        position{line: 2256, col: 27, offset: 69477},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -14096,49 +15190,49 @@ var g = &grammar{
 		},
 		{
 			name: "OptDistinct",
-			pos:  position{line: 2126, col: 1, offset: 65506},
+			pos:  position{line: 2264, col: 1, offset: 69621},
 			expr: &choiceExpr{
-				pos: position{line: 2127, col: 5, offset: 65522},
+				pos: position{line: 2265, col: 5, offset: 69637},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2127, col: 5, offset: 65522},
+						pos: position{line: 2265, col: 5, offset: 69637},
 						run: (*parser).callonOptDistinct2,
 						expr: &seqExpr{
-							pos: position{line: 2127, col: 5, offset: 65522},
+							pos: position{line: 2265, col: 5, offset: 69637},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2127, col: 5, offset: 65522},
+									pos:  position{line: 2265, col: 5, offset: 69637},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2127, col: 7, offset: 65524},
+									pos:  position{line: 2265, col: 7, offset: 69639},
 									name: "ALL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2128, col: 5, offset: 65561},
+						pos: position{line: 2266, col: 5, offset: 69676},
 						run: (*parser).callonOptDistinct6,
 						expr: &seqExpr{
-							pos: position{line: 2128, col: 5, offset: 65561},
+							pos: position{line: 2266, col: 5, offset: 69676},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2128, col: 5, offset: 65561},
+									pos:  position{line: 2266, col: 5, offset: 69676},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2128, col: 7, offset: 65563},
+									pos:  position{line: 2266, col: 7, offset: 69678},
 									name: "DISTINCT",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2129, col: 5, offset: 65599},
+						pos: position{line: 2267, col: 5, offset: 69714},
 						run: (*parser).callonOptDistinct10,
 						expr: &litMatcher{
-							pos:        position{line: 2129, col: 5, offset: 65599},
+							pos:        position{line: 2267, col: 5, offset: 69714},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14151,19 +15245,19 @@ var g = &grammar{
 		},
 		{
 			name: "OptWithClause",
-			pos:  position{line: 2131, col: 1, offset: 65638},
+			pos:  position{line: 2269, col: 1, offset: 69753},
 			expr: &choiceExpr{
-				pos: position{line: 2132, col: 5, offset: 65656},
+				pos: position{line: 2270, col: 5, offset: 69771},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2132, col: 5, offset: 65656},
+						pos:  position{line: 2270, col: 5, offset: 69771},
 						name: "WithClause",
 					},
 					&actionExpr{
-						pos: position{line: 2133, col: 5, offset: 65671},
+						pos: position{line: 2271, col: 5, offset: 69786},
 						run: (*parser).callonOptWithClause3,
 						expr: &litMatcher{
-							pos:        position{line: 2133, col: 5, offset: 65671},
+							pos:        position{line: 2271, col: 5, offset: 69786},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14176,39 +15270,39 @@ var g = &grammar{
 		},
 		{
 			name: "WithClause",
-			pos:  position{line: 2135, col: 1, offset: 65704},
+			pos:  position{line: 2273, col: 1, offset: 69819},
 			expr: &actionExpr{
-				pos: position{line: 2136, col: 5, offset: 65719},
+				pos: position{line: 2274, col: 5, offset: 69834},
 				run: (*parser).callonWithClause1,
 				expr: &seqExpr{
-					pos: position{line: 2136, col: 5, offset: 65719},
+					pos: position{line: 2274, col: 5, offset: 69834},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2136, col: 5, offset: 65719},
+							pos:  position{line: 2274, col: 5, offset: 69834},
 							name: "WITH",
 						},
 						&labeledExpr{
-							pos:   position{line: 2136, col: 10, offset: 65724},
+							pos:   position{line: 2274, col: 10, offset: 69839},
 							label: "r",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2136, col: 12, offset: 65726},
+								pos:  position{line: 2274, col: 12, offset: 69841},
 								name: "OptRecursive",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2136, col: 25, offset: 65739},
+							pos:  position{line: 2274, col: 25, offset: 69854},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2136, col: 27, offset: 65741},
+							pos:   position{line: 2274, col: 27, offset: 69856},
 							label: "ctes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2136, col: 32, offset: 65746},
+								pos:  position{line: 2274, col: 32, offset: 69861},
 								name: "CteList",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2136, col: 40, offset: 65754},
+							pos:  position{line: 2274, col: 40, offset: 69869},
 							name: "__",
 						},
 					},
@@ -14219,32 +15313,32 @@ var g = &grammar{
 		},
 		{
 			name: "OptRecursive",
-			pos:  position{line: 2144, col: 1, offset: 65913},
+			pos:  position{line: 2282, col: 1, offset: 70028},
 			expr: &choiceExpr{
-				pos: position{line: 2145, col: 5, offset: 65930},
+				pos: position{line: 2283, col: 5, offset: 70045},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2145, col: 5, offset: 65930},
+						pos: position{line: 2283, col: 5, offset: 70045},
 						run: (*parser).callonOptRecursive2,
 						expr: &seqExpr{
-							pos: position{line: 2145, col: 5, offset: 65930},
+							pos: position{line: 2283, col: 5, offset: 70045},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2145, col: 5, offset: 65930},
+									pos:  position{line: 2283, col: 5, offset: 70045},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2145, col: 7, offset: 65932},
+									pos:  position{line: 2283, col: 7, offset: 70047},
 									name: "RECURSIVE",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2146, col: 5, offset: 65968},
+						pos: position{line: 2284, col: 5, offset: 70083},
 						run: (*parser).callonOptRecursive6,
 						expr: &litMatcher{
-							pos:        position{line: 2146, col: 5, offset: 65968},
+							pos:        position{line: 2284, col: 5, offset: 70083},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14257,51 +15351,51 @@ var g = &grammar{
 		},
 		{
 			name: "CteList",
-			pos:  position{line: 2148, col: 1, offset: 66007},
+			pos:  position{line: 2286, col: 1, offset: 70122},
 			expr: &actionExpr{
-				pos: position{line: 2148, col: 11, offset: 66017},
+				pos: position{line: 2286, col: 11, offset: 70132},
 				run: (*parser).callonCteList1,
 				expr: &seqExpr{
-					pos: position{line: 2148, col: 11, offset: 66017},
+					pos: position{line: 2286, col: 11, offset: 70132},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2148, col: 11, offset: 66017},
+							pos:   position{line: 2286, col: 11, offset: 70132},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2148, col: 17, offset: 66023},
+								pos:  position{line: 2286, col: 17, offset: 70138},
 								name: "Cte",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2148, col: 21, offset: 66027},
+							pos:   position{line: 2286, col: 21, offset: 70142},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2148, col: 26, offset: 66032},
+								pos: position{line: 2286, col: 26, offset: 70147},
 								expr: &actionExpr{
-									pos: position{line: 2148, col: 28, offset: 66034},
+									pos: position{line: 2286, col: 28, offset: 70149},
 									run: (*parser).callonCteList7,
 									expr: &seqExpr{
-										pos: position{line: 2148, col: 28, offset: 66034},
+										pos: position{line: 2286, col: 28, offset: 70149},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2148, col: 28, offset: 66034},
+												pos:  position{line: 2286, col: 28, offset: 70149},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2148, col: 31, offset: 66037},
+												pos:        position{line: 2286, col: 31, offset: 70152},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2148, col: 35, offset: 66041},
+												pos:  position{line: 2286, col: 35, offset: 70156},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2148, col: 38, offset: 66044},
+												pos:   position{line: 2286, col: 38, offset: 70159},
 												label: "cte",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2148, col: 42, offset: 66048},
+													pos:  position{line: 2286, col: 42, offset: 70163},
 													name: "Cte",
 												},
 											},
@@ -14318,65 +15412,65 @@ var g = &grammar{
 		},
 		{
 			name: "Cte",
-			pos:  position{line: 2152, col: 1, offset: 66116},
+			pos:  position{line: 2290, col: 1, offset: 70231},
 			expr: &actionExpr{
-				pos: position{line: 2153, col: 5, offset: 66124},
+				pos: position{line: 2291, col: 5, offset: 70239},
 				run: (*parser).callonCte1,
 				expr: &seqExpr{
-					pos: position{line: 2153, col: 5, offset: 66124},
+					pos: position{line: 2291, col: 5, offset: 70239},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2153, col: 5, offset: 66124},
+							pos:   position{line: 2291, col: 5, offset: 70239},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2153, col: 10, offset: 66129},
+								pos:  position{line: 2291, col: 10, offset: 70244},
 								name: "TableAlias",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2153, col: 21, offset: 66140},
+							pos:  position{line: 2291, col: 21, offset: 70255},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2153, col: 23, offset: 66142},
+							pos:  position{line: 2291, col: 23, offset: 70257},
 							name: "AS",
 						},
 						&labeledExpr{
-							pos:   position{line: 2153, col: 26, offset: 66145},
+							pos:   position{line: 2291, col: 26, offset: 70260},
 							label: "m",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2153, col: 28, offset: 66147},
+								pos:  position{line: 2291, col: 28, offset: 70262},
 								name: "OptMaterialized",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2153, col: 44, offset: 66163},
+							pos:  position{line: 2291, col: 44, offset: 70278},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2153, col: 47, offset: 66166},
+							pos:        position{line: 2291, col: 47, offset: 70281},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2153, col: 51, offset: 66170},
+							pos:  position{line: 2291, col: 51, offset: 70285},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2153, col: 54, offset: 66173},
+							pos:   position{line: 2291, col: 54, offset: 70288},
 							label: "s",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2153, col: 56, offset: 66175},
+								pos:  position{line: 2291, col: 56, offset: 70290},
 								name: "SQLQueryOrSetExpr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2153, col: 74, offset: 66193},
+							pos:  position{line: 2291, col: 74, offset: 70308},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2153, col: 77, offset: 66196},
+							pos:        position{line: 2291, col: 77, offset: 70311},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -14389,65 +15483,65 @@ var g = &grammar{
 		},
 		{
 			name: "OptMaterialized",
-			pos:  position{line: 2162, col: 1, offset: 66394},
+			pos:  position{line: 2300, col: 1, offset: 70509},
 			expr: &choiceExpr{
-				pos: position{line: 2163, col: 5, offset: 66414},
+				pos: position{line: 2301, col: 5, offset: 70529},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2163, col: 5, offset: 66414},
+						pos: position{line: 2301, col: 5, offset: 70529},
 						run: (*parser).callonOptMaterialized2,
 						expr: &seqExpr{
-							pos: position{line: 2163, col: 5, offset: 66414},
+							pos: position{line: 2301, col: 5, offset: 70529},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2163, col: 5, offset: 66414},
+									pos:  position{line: 2301, col: 5, offset: 70529},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2163, col: 7, offset: 66416},
+									pos:  position{line: 2301, col: 7, offset: 70531},
 									name: "MATERIALIZED",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2163, col: 20, offset: 66429},
+									pos:  position{line: 2301, col: 20, offset: 70544},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2164, col: 5, offset: 66468},
+						pos: position{line: 2302, col: 5, offset: 70583},
 						run: (*parser).callonOptMaterialized7,
 						expr: &seqExpr{
-							pos: position{line: 2164, col: 5, offset: 66468},
+							pos: position{line: 2302, col: 5, offset: 70583},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2164, col: 5, offset: 66468},
+									pos:  position{line: 2302, col: 5, offset: 70583},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2164, col: 7, offset: 66470},
+									pos:  position{line: 2302, col: 7, offset: 70585},
 									name: "NOT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2164, col: 11, offset: 66474},
+									pos:  position{line: 2302, col: 11, offset: 70589},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2164, col: 13, offset: 66476},
+									pos:  position{line: 2302, col: 13, offset: 70591},
 									name: "MATERIALIZED",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2164, col: 26, offset: 66489},
+									pos:  position{line: 2302, col: 26, offset: 70604},
 									name: "_",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2165, col: 5, offset: 66520},
+						pos: position{line: 2303, col: 5, offset: 70635},
 						run: (*parser).callonOptMaterialized14,
 						expr: &litMatcher{
-							pos:        position{line: 2165, col: 5, offset: 66520},
+							pos:        position{line: 2303, col: 5, offset: 70635},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14460,25 +15554,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptAllClause",
-			pos:  position{line: 2167, col: 1, offset: 66575},
+			pos:  position{line: 2305, col: 1, offset: 70690},
 			expr: &choiceExpr{
-				pos: position{line: 2168, col: 5, offset: 66592},
+				pos: position{line: 2306, col: 5, offset: 70707},
 				alternatives: []any{
 					&seqExpr{
-						pos: position{line: 2168, col: 5, offset: 66592},
+						pos: position{line: 2306, col: 5, offset: 70707},
 						exprs: []any{
 							&ruleRefExpr{
-								pos:  position{line: 2168, col: 5, offset: 66592},
+								pos:  position{line: 2306, col: 5, offset: 70707},
 								name: "_",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 2168, col: 7, offset: 66594},
+								pos:  position{line: 2306, col: 7, offset: 70709},
 								name: "ALL",
 							},
 						},
 					},
 					&litMatcher{
-						pos:        position{line: 2169, col: 5, offset: 66602},
+						pos:        position{line: 2307, col: 5, offset: 70717},
 						val:        "",
 						ignoreCase: false,
 						want:       "\"\"",
@@ -14490,33 +15584,33 @@ var g = &grammar{
 		},
 		{
 			name: "OptFromClause",
-			pos:  position{line: 2171, col: 1, offset: 66606},
+			pos:  position{line: 2309, col: 1, offset: 70721},
 			expr: &choiceExpr{
-				pos: position{line: 2172, col: 5, offset: 66624},
+				pos: position{line: 2310, col: 5, offset: 70739},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2172, col: 5, offset: 66624},
+						pos: position{line: 2310, col: 5, offset: 70739},
 						run: (*parser).callonOptFromClause2,
 						expr: &seqExpr{
-							pos: position{line: 2172, col: 5, offset: 66624},
+							pos: position{line: 2310, col: 5, offset: 70739},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2172, col: 5, offset: 66624},
+									pos:  position{line: 2310, col: 5, offset: 70739},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2172, col: 7, offset: 66626},
+									pos:  position{line: 2310, col: 7, offset: 70741},
 									name: "FROM",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2172, col: 12, offset: 66631},
+									pos:  position{line: 2310, col: 12, offset: 70746},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2172, col: 14, offset: 66633},
+									pos:   position{line: 2310, col: 14, offset: 70748},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2172, col: 19, offset: 66638},
+										pos:  position{line: 2310, col: 19, offset: 70753},
 										name: "JoinedTable",
 									},
 								},
@@ -14524,95 +15618,314 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2175, col: 5, offset: 66685},
-						run: (*parser).callonOptFromClause9,
-						expr: &litMatcher{
-							pos:        position{line: 2175, col: 5, offset: 66685},
-							val:        "",
-							ignoreCase: false,
-							want:       "\"\"",
-						},
-					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "OptGroupClause",
-			pos:  position{line: 2177, col: 1, offset: 66726},
-			expr: &choiceExpr{
-				pos: position{line: 2178, col: 5, offset: 66745},
-				alternatives: []any{
-					&actionExpr{
-						pos: position{line: 2178, col: 5, offset: 66745},
-						run: (*parser).callonOptGroupClause2,
+						pos: position{line: 2313, col: 5, offset: 70800},
+						run: (*parser).callonOptFromClause9,
+						expr: &litMatcher{
+							pos:        position{line: 2313, col: 5, offset: 70800},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OptGroupClause",
+			pos:  position{line: 2315, col: 1, offset: 70841},
+			expr: &choiceExpr{
+				pos: position{line: 2316, col: 5, offset: 70860},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2316, col: 5, offset: 70860},
+						run: (*parser).callonOptGroupClause2,
+						expr: &seqExpr{
+							pos: position{line: 2316, col: 5, offset: 70860},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2316, col: 5, offset: 70860},
+									name: "_",
+								},
+								&labeledExpr{
+									pos:   position{line: 2316, col: 7, offset: 70862},
+									label: "group",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2316, col: 13, offset: 70868},
+										name: "GroupClause",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2317, col: 5, offset: 70906},
+						run: (*parser).callonOptGroupClause7,
+						expr: &litMatcher{
+							pos:        position{line: 2317, col: 5, offset: 70906},
+							val:        "",
+							ignoreCase: false,
+							want:       "\"\"",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GroupClause",
+			pos:  position{line: 2319, col: 1, offset: 70947},
+			expr: &actionExpr{
+				pos: position{line: 2320, col: 5, offset: 70963},
+				run: (*parser).callonGroupClause1,
+				expr: &seqExpr{
+					pos: position{line: 2320, col: 5, offset: 70963},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 2320, col: 5, offset: 70963},
+							name: "GROUP",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2320, col: 11, offset: 70969},
+							name: "_",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2320, col: 13, offset: 70971},
+							name: "BY",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2320, col: 16, offset: 70974},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 2320, col: 18, offset: 70976},
+							label: "list",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2320, col: 23, offset: 70981},
+								name: "GroupByList",
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GroupByList",
+			pos:  position{line: 2322, col: 1, offset: 71015},
+			expr: &actionExpr{
+				pos: position{line: 2323, col: 5, offset: 71031},
+				run: (*parser).callonGroupByList1,
+				expr: &seqExpr{
+					pos: position{line: 2323, col: 5, offset: 71031},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 2323, col: 5, offset: 71031},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2323, col: 11, offset: 71037},
+								name: "GroupByItem",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2323, col: 23, offset: 71049},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2323, col: 28, offset: 71054},
+								expr: &actionExpr{
+									pos: position{line: 2323, col: 30, offset: 71056},
+									run: (*parser).callonGroupByList7,
+									expr: &seqExpr{
+										pos: position{line: 2323, col: 30, offset: 71056},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 2323, col: 30, offset: 71056},
+												name: "__",
+											},
+											&litMatcher{
+												pos:        position{line: 2323, col: 33, offset: 71059},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 2323, col: 37, offset: 71063},
+												name: "__",
+											},
+											&labeledExpr{
+												pos:   position{line: 2323, col: 40, offset: 71066},
+												label: "g",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2323, col: 42, offset: 71068},
+													name: "GroupByItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GroupByItem",
+			pos:  position{line: 2327, col: 1, offset: 71149},
+			expr: &choiceExpr{
+				pos: position{line: 2328, col: 5, offset: 71165},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2328, col: 5, offset: 71165},
+						run: (*parser).callonGroupByItem2,
+						expr: &seqExpr{
+							pos: position{line: 2328, col: 5, offset: 71165},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2328, col: 5, offset: 71165},
+									name: "ROLLUP",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2328, col: 12, offset: 71172},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2328, col: 15, offset: 71175},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2328, col: 19, offset: 71179},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 2328, col: 22, offset: 71182},
+									label: "sets",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2328, col: 27, offset: 71187},
+										name: "GroupingElems",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2328, col: 41, offset: 71201},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2328, col: 44, offset: 71204},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2336, col: 5, offset: 71395},
+						run: (*parser).callonGroupByItem12,
+						expr: &seqExpr{
+							pos: position{line: 2336, col: 5, offset: 71395},
+							exprs: []any{
+								&ruleRefExpr{
+									pos:  position{line: 2336, col: 5, offset: 71395},
+									name: "CUBE",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2336, col: 10, offset: 71400},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2336, col: 13, offset: 71403},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2336, col: 17, offset: 71407},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 2336, col: 20, offset: 71410},
+									label: "sets",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2336, col: 25, offset: 71415},
+										name: "GroupingElems",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2336, col: 39, offset: 71429},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2336, col: 42, offset: 71432},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2344, col: 5, offset: 71621},
+						run: (*parser).callonGroupByItem22,
 						expr: &seqExpr{
-							pos: position{line: 2178, col: 5, offset: 66745},
+							pos: position{line: 2344, col: 5, offset: 71621},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2178, col: 5, offset: 66745},
+									pos:  position{line: 2344, col: 5, offset: 71621},
+									name: "GROUPING",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2344, col: 14, offset: 71630},
 									name: "_",
 								},
+								&ruleRefExpr{
+									pos:  position{line: 2344, col: 16, offset: 71632},
+									name: "SETS",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2344, col: 21, offset: 71637},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2344, col: 24, offset: 71640},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2344, col: 28, offset: 71644},
+									name: "__",
+								},
 								&labeledExpr{
-									pos:   position{line: 2178, col: 7, offset: 66747},
-									label: "group",
+									pos:   position{line: 2344, col: 31, offset: 71647},
+									label: "sets",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2178, col: 13, offset: 66753},
-										name: "GroupClause",
+										pos:  position{line: 2344, col: 36, offset: 71652},
+										name: "GroupingElems",
 									},
 								},
+								&ruleRefExpr{
+									pos:  position{line: 2344, col: 50, offset: 71666},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2344, col: 53, offset: 71669},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
 							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 2179, col: 5, offset: 66791},
-						run: (*parser).callonOptGroupClause7,
-						expr: &litMatcher{
-							pos:        position{line: 2179, col: 5, offset: 66791},
-							val:        "",
-							ignoreCase: false,
-							want:       "\"\"",
-						},
-					},
-				},
-			},
-			leader:        false,
-			leftRecursive: false,
-		},
-		{
-			name: "GroupClause",
-			pos:  position{line: 2181, col: 1, offset: 66832},
-			expr: &actionExpr{
-				pos: position{line: 2182, col: 5, offset: 66848},
-				run: (*parser).callonGroupClause1,
-				expr: &seqExpr{
-					pos: position{line: 2182, col: 5, offset: 66848},
-					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 2182, col: 5, offset: 66848},
-							name: "GROUP",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2182, col: 11, offset: 66854},
-							name: "_",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2182, col: 13, offset: 66856},
-							name: "BY",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2182, col: 16, offset: 66859},
-							name: "_",
-						},
-						&labeledExpr{
-							pos:   position{line: 2182, col: 18, offset: 66861},
-							label: "list",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2182, col: 23, offset: 66866},
-								name: "GroupByList",
-							},
-						},
+					&ruleRefExpr{
+						pos:  position{line: 2352, col: 5, offset: 71867},
+						name: "Expr",
 					},
 				},
 			},
@@ -14620,53 +15933,53 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "GroupByList",
-			pos:  position{line: 2184, col: 1, offset: 66900},
+			name: "GroupingElems",
+			pos:  position{line: 2354, col: 1, offset: 71873},
 			expr: &actionExpr{
-				pos: position{line: 2185, col: 5, offset: 66916},
-				run: (*parser).callonGroupByList1,
+				pos: position{line: 2355, col: 5, offset: 71891},
+				run: (*parser).callonGroupingElems1,
 				expr: &seqExpr{
-					pos: position{line: 2185, col: 5, offset: 66916},
+					pos: position{line: 2355, col: 5, offset: 71891},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2185, col: 5, offset: 66916},
+							pos:   position{line: 2355, col: 5, offset: 71891},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2185, col: 11, offset: 66922},
-								name: "GroupByItem",
+								pos:  position{line: 2355, col: 11, offset: 71897},
+								name: "GroupingElem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2185, col: 23, offset: 66934},
+							pos:   position{line: 2355, col: 24, offset: 71910},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2185, col: 28, offset: 66939},
+								pos: position{line: 2355, col: 29, offset: 71915},
 								expr: &actionExpr{
-									pos: position{line: 2185, col: 30, offset: 66941},
-									run: (*parser).callonGroupByList7,
+									pos: position{line: 2355, col: 31, offset: 71917},
+									run: (*parser).callonGroupingElems7,
 									expr: &seqExpr{
-										pos: position{line: 2185, col: 30, offset: 66941},
+										pos: position{line: 2355, col: 31, offset: 71917},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2185, col: 30, offset: 66941},
+												pos:  position{line: 2355, col: 31, offset: 71917},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2185, col: 33, offset: 66944},
+												pos:        position{line: 2355, col: 34, offset: 71920},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2185, col: 37, offset: 66948},
+												pos:  position{line: 2355, col: 38, offset: 71924},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2185, col: 40, offset: 66951},
+												pos:   position{line: 2355, col: 41, offset: 71927},
 												label: "g",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2185, col: 42, offset: 66953},
-													name: "GroupByItem",
+													pos:  position{line: 2355, col: 43, offset: 71929},
+													name: "GroupingElem",
 												},
 											},
 										},
@@ -14681,36 +15994,140 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "GroupByItem",
-			pos:  position{line: 2189, col: 1, offset: 67034},
-			expr: &ruleRefExpr{
-				pos:  position{line: 2189, col: 15, offset: 67048},
-				name: "Expr",
+			name: "GroupingElem",
+			pos:  position{line: 2359, col: 1, offset: 72011},
+			expr: &choiceExpr{
+				pos: position{line: 2360, col: 5, offset: 72028},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 2360, col: 5, offset: 72028},
+						run: (*parser).callonGroupingElem2,
+						expr: &seqExpr{
+							pos: position{line: 2360, col: 5, offset: 72028},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 2360, col: 5, offset: 72028},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2360, col: 9, offset: 72032},
+									name: "__",
+								},
+								&labeledExpr{
+									pos:   position{line: 2360, col: 12, offset: 72035},
+									label: "exprs",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2360, col: 18, offset: 72041},
+										name: "Exprs",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2360, col: 24, offset: 72047},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2360, col: 27, offset: 72050},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+								&andExpr{
+									pos: position{line: 2360, col: 31, offset: 72054},
+									expr: &seqExpr{
+										pos: position{line: 2360, col: 33, offset: 72056},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 2360, col: 33, offset: 72056},
+												name: "__",
+											},
+											&choiceExpr{
+												pos: position{line: 2360, col: 37, offset: 72060},
+												alternatives: []any{
+													&litMatcher{
+														pos:        position{line: 2360, col: 37, offset: 72060},
+														val:        ",",
+														ignoreCase: false,
+														want:       "\",\"",
+													},
+													&litMatcher{
+														pos:        position{line: 2360, col: 43, offset: 72066},
+														val:        ")",
+														ignoreCase: false,
+														want:       "\")\"",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2363, col: 5, offset: 72167},
+						run: (*parser).callonGroupingElem16,
+						expr: &seqExpr{
+							pos: position{line: 2363, col: 5, offset: 72167},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 2363, col: 5, offset: 72167},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2363, col: 9, offset: 72171},
+									name: "__",
+								},
+								&litMatcher{
+									pos:        position{line: 2363, col: 12, offset: 72174},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2366, col: 5, offset: 72240},
+						run: (*parser).callonGroupingElem21,
+						expr: &labeledExpr{
+							pos:   position{line: 2366, col: 5, offset: 72240},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2366, col: 7, offset: 72242},
+								name: "Expr",
+							},
+						},
+					},
+				},
 			},
 			leader:        false,
 			leftRecursive: false,
 		},
 		{
 			name: "OptHavingClause",
-			pos:  position{line: 2191, col: 1, offset: 67054},
+			pos:  position{line: 2370, col: 1, offset: 72339},
 			expr: &choiceExpr{
-				pos: position{line: 2192, col: 5, offset: 67074},
+				pos: position{line: 2371, col: 5, offset: 72359},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2192, col: 5, offset: 67074},
+						pos: position{line: 2371, col: 5, offset: 72359},
 						run: (*parser).callonOptHavingClause2,
 						expr: &seqExpr{
-							pos: position{line: 2192, col: 5, offset: 67074},
+							pos: position{line: 2371, col: 5, offset: 72359},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2192, col: 5, offset: 67074},
+									pos:  position{line: 2371, col: 5, offset: 72359},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2192, col: 7, offset: 67076},
+									pos:   position{line: 2371, col: 7, offset: 72361},
 									label: "h",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2192, col: 9, offset: 67078},
+										pos:  position{line: 2371, col: 9, offset: 72363},
 										name: "HavingClause",
 									},
 								},
@@ -14718,10 +16135,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2193, col: 5, offset: 67113},
+						pos: position{line: 2372, col: 5, offset: 72398},
 						run: (*parser).callonOptHavingClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2193, col: 5, offset: 67113},
+							pos:        position{line: 2372, col: 5, offset: 72398},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -14734,26 +16151,26 @@ var g = &grammar{
 		},
 		{
 			name: "HavingClause",
-			pos:  position{line: 2195, col: 1, offset: 67137},
+			pos:  position{line: 2374, col: 1, offset: 72422},
 			expr: &actionExpr{
-				pos: position{line: 2196, col: 5, offset: 67154},
+				pos: position{line: 2375, col: 5, offset: 72439},
 				run: (*parser).callonHavingClause1,
 				expr: &seqExpr{
-					pos: position{line: 2196, col: 5, offset: 67154},
+					pos: position{line: 2375, col: 5, offset: 72439},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2196, col: 5, offset: 67154},
+							pos:  position{line: 2375, col: 5, offset: 72439},
 							name: "HAVING",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2196, col: 12, offset: 67161},
+							pos:  position{line: 2375, col: 12, offset: 72446},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2196, col: 14, offset: 67163},
+							pos:   position{line: 2375, col: 14, offset: 72448},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2196, col: 16, offset: 67165},
+								pos:  position{line: 2375, col: 16, offset: 72450},
 								name: "Expr",
 							},
 						},
@@ -14765,16 +16182,16 @@ var g = &grammar{
 		},
 		{
 			name: "JoinOperation",
-			pos:  position{line: 2198, col: 1, offset: 67189},
+			pos:  position{line: 2377, col: 1, offset: 72474},
 			expr: &choiceExpr{
-				pos: position{line: 2199, col: 5, offset: 67207},
+				pos: position{line: 2378, col: 5, offset: 72492},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2199, col: 5, offset: 67207},
+						pos:  position{line: 2378, col: 5, offset: 72492},
 						name: "CrossJoin",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2200, col: 5, offset: 67221},
+						pos:  position{line: 2379, col: 5, offset: 72506},
 						name: "ConditionJoin",
 					},
 				},
@@ -14784,56 +16201,56 @@ var g = &grammar{
 		},
 		{
 			name: "CrossJoin",
-			pos:  position{line: 2202, col: 1, offset: 67236},
+			pos:  position{line: 2381, col: 1, offset: 72521},
 			expr: &actionExpr{
-				pos: position{line: 2203, col: 5, offset: 67250},
+				pos: position{line: 2382, col: 5, offset: 72535},
 				run: (*parser).callonCrossJoin1,
 				expr: &seqExpr{
-					pos: position{line: 2203, col: 5, offset: 67250},
+					pos: position{line: 2382, col: 5, offset: 72535},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 2203, col: 6, offset: 67251},
+							pos: position{line: 2382, col: 6, offset: 72536},
 							alternatives: []any{
 								&seqExpr{
-									pos: position{line: 2203, col: 6, offset: 67251},
+									pos: position{line: 2382, col: 6, offset: 72536},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 2203, col: 6, offset: 67251},
+											pos:  position{line: 2382, col: 6, offset: 72536},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2203, col: 8, offset: 67253},
+											pos:  position{line: 2382, col: 8, offset: 72538},
 											name: "CROSS",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2203, col: 14, offset: 67259},
+											pos:  position{line: 2382, col: 14, offset: 72544},
 											name: "_",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2203, col: 16, offset: 67261},
+											pos:  position{line: 2382, col: 16, offset: 72546},
 											name: "JOIN",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2203, col: 21, offset: 67266},
+											pos:  position{line: 2382, col: 21, offset: 72551},
 											name: "_",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 2203, col: 25, offset: 67270},
+									pos: position{line: 2382, col: 25, offset: 72555},
 									exprs: []any{
 										&ruleRefExpr{
-											pos:  position{line: 2203, col: 25, offset: 67270},
+											pos:  position{line: 2382, col: 25, offset: 72555},
 											name: "__",
 										},
 										&litMatcher{
-											pos:        position{line: 2203, col: 28, offset: 67273},
+											pos:        position{line: 2382, col: 28, offset: 72558},
 											val:        ",",
 											ignoreCase: false,
 											want:       "\",\"",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2203, col: 32, offset: 67277},
+											pos:  position{line: 2382, col: 32, offset: 72562},
 											name: "__",
 										},
 									},
@@ -14841,10 +16258,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2203, col: 36, offset: 67281},
+							pos:   position{line: 2382, col: 36, offset: 72566},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2203, col: 42, offset: 67287},
+								pos:  position{line: 2382, col: 42, offset: 72572},
 								name: "SQLTableExpr",
 							},
 						},
@@ -14856,42 +16273,42 @@ var g = &grammar{
 		},
 		{
 			name: "ConditionJoin",
-			pos:  position{line: 2211, col: 1, offset: 67462},
+			pos:  position{line: 2390, col: 1, offset: 72747},
 			expr: &actionExpr{
-				pos: position{line: 2212, col: 5, offset: 67480},
+				pos: position{line: 2391, col: 5, offset: 72765},
 				run: (*parser).callonConditionJoin1,
 				expr: &seqExpr{
-					pos: position{line: 2212, col: 5, offset: 67480},
+					pos: position{line: 2391, col: 5, offset: 72765},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2212, col: 5, offset: 67480},
+							pos:   position{line: 2391, col: 5, offset: 72765},
 							label: "style",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2212, col: 11, offset: 67486},
+								pos:  position{line: 2391, col: 11, offset: 72771},
 								name: "SQLJoinStyle",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2212, col: 24, offset: 67499},
+							pos:  position{line: 2391, col: 24, offset: 72784},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2212, col: 26, offset: 67501},
+							pos:   position{line: 2391, col: 26, offset: 72786},
 							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2212, col: 32, offset: 67507},
+								pos:  position{line: 2391, col: 32, offset: 72792},
 								name: "SQLTableExpr",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2212, col: 45, offset: 67520},
+							pos:  position{line: 2391, col: 45, offset: 72805},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2212, col: 47, offset: 67522},
+							pos:   position{line: 2391, col: 47, offset: 72807},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2212, col: 49, offset: 67524},
+								pos:  position{line: 2391, col: 49, offset: 72809},
 								name: "JoinCond",
 							},
 						},
@@ -14903,186 +16320,186 @@ var g = &grammar{
 		},
 		{
 			name: "SQLJoinStyle",
-			pos:  position{line: 2222, col: 1, offset: 67756},
+			pos:  position{line: 2401, col: 1, offset: 73041},
 			expr: &choiceExpr{
-				pos: position{line: 2223, col: 5, offset: 67773},
+				pos: position{line: 2402, col: 5, offset: 73058},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2223, col: 5, offset: 67773},
+						pos: position{line: 2402, col: 5, offset: 73058},
 						run: (*parser).callonSQLJoinStyle2,
 						expr: &seqExpr{
-							pos: position{line: 2223, col: 5, offset: 67773},
+							pos: position{line: 2402, col: 5, offset: 73058},
 							exprs: []any{
 								&zeroOrOneExpr{
-									pos: position{line: 2223, col: 5, offset: 67773},
+									pos: position{line: 2402, col: 5, offset: 73058},
 									expr: &seqExpr{
-										pos: position{line: 2223, col: 6, offset: 67774},
+										pos: position{line: 2402, col: 6, offset: 73059},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2223, col: 6, offset: 67774},
+												pos:  position{line: 2402, col: 6, offset: 73059},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2223, col: 8, offset: 67776},
+												pos:  position{line: 2402, col: 8, offset: 73061},
 												name: "INNER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2223, col: 16, offset: 67784},
+									pos:  position{line: 2402, col: 16, offset: 73069},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2223, col: 18, offset: 67786},
+									pos:  position{line: 2402, col: 18, offset: 73071},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2224, col: 5, offset: 67831},
+						pos: position{line: 2403, col: 5, offset: 73116},
 						run: (*parser).callonSQLJoinStyle10,
 						expr: &seqExpr{
-							pos: position{line: 2224, col: 5, offset: 67831},
+							pos: position{line: 2403, col: 5, offset: 73116},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2224, col: 5, offset: 67831},
+									pos:  position{line: 2403, col: 5, offset: 73116},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2224, col: 7, offset: 67833},
+									pos:  position{line: 2403, col: 7, offset: 73118},
 									name: "ANTI",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2224, col: 12, offset: 67838},
+									pos:  position{line: 2403, col: 12, offset: 73123},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2224, col: 14, offset: 67840},
+									pos:  position{line: 2403, col: 14, offset: 73125},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2225, col: 5, offset: 67872},
+						pos: position{line: 2404, col: 5, offset: 73157},
 						run: (*parser).callonSQLJoinStyle16,
 						expr: &seqExpr{
-							pos: position{line: 2225, col: 5, offset: 67872},
+							pos: position{line: 2404, col: 5, offset: 73157},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2225, col: 5, offset: 67872},
+									pos:  position{line: 2404, col: 5, offset: 73157},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2225, col: 7, offset: 67874},
+									pos:  position{line: 2404, col: 7, offset: 73159},
 									name: "FULL",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2225, col: 12, offset: 67879},
+									pos: position{line: 2404, col: 12, offset: 73164},
 									expr: &seqExpr{
-										pos: position{line: 2225, col: 13, offset: 67880},
+										pos: position{line: 2404, col: 13, offset: 73165},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2225, col: 13, offset: 67880},
+												pos:  position{line: 2404, col: 13, offset: 73165},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2225, col: 15, offset: 67882},
+												pos:  position{line: 2404, col: 15, offset: 73167},
 												name: "OUTER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2225, col: 23, offset: 67890},
+									pos:  position{line: 2404, col: 23, offset: 73175},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2225, col: 25, offset: 67892},
+									pos:  position{line: 2404, col: 25, offset: 73177},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2226, col: 5, offset: 67926},
+						pos: position{line: 2405, col: 5, offset: 73211},
 						run: (*parser).callonSQLJoinStyle26,
 						expr: &seqExpr{
-							pos: position{line: 2226, col: 5, offset: 67926},
+							pos: position{line: 2405, col: 5, offset: 73211},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2226, col: 5, offset: 67926},
+									pos:  position{line: 2405, col: 5, offset: 73211},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2226, col: 7, offset: 67928},
+									pos:  position{line: 2405, col: 7, offset: 73213},
 									name: "LEFT",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2226, col: 12, offset: 67933},
+									pos: position{line: 2405, col: 12, offset: 73218},
 									expr: &seqExpr{
-										pos: position{line: 2226, col: 13, offset: 67934},
+										pos: position{line: 2405, col: 13, offset: 73219},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2226, col: 13, offset: 67934},
+												pos:  position{line: 2405, col: 13, offset: 73219},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2226, col: 15, offset: 67936},
+												pos:  position{line: 2405, col: 15, offset: 73221},
 												name: "OUTER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2226, col: 23, offset: 67944},
+									pos:  position{line: 2405, col: 23, offset: 73229},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2226, col: 25, offset: 67946},
+									pos:  position{line: 2405, col: 25, offset: 73231},
 									name: "JOIN",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2227, col: 5, offset: 67980},
+						pos: position{line: 2406, col: 5, offset: 73265},
 						run: (*parser).callonSQLJoinStyle36,
 						expr: &seqExpr{
-							pos: position{line: 2227, col: 5, offset: 67980},
+							pos: position{line: 2406, col: 5, offset: 73265},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2227, col: 5, offset: 67980},
+									pos:  position{line: 2406, col: 5, offset: 73265},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2227, col: 7, offset: 67982},
+									pos:  position{line: 2406, col: 7, offset: 73267},
 									name: "RIGHT",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2227, col: 13, offset: 67988},
+									pos: position{line: 2406, col: 13, offset: 73273},
 									expr: &seqExpr{
-										pos: position{line: 2227, col: 14, offset: 67989},
+										pos: position{line: 2406, col: 14, offset: 73274},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2227, col: 14, offset: 67989},
+												pos:  position{line: 2406, col: 14, offset: 73274},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2227, col: 16, offset: 67991},
+												pos:  position{line: 2406, col: 16, offset: 73276},
 												name: "OUTER",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2227, col: 24, offset: 67999},
+									pos:  position{line: 2406, col: 24, offset: 73284},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2227, col: 26, offset: 68001},
+									pos:  position{line: 2406, col: 26, offset: 73286},
 									name: "JOIN",
 								},
 							},
@@ -15095,29 +16512,29 @@ var g = &grammar{
 		},
 		{
 			name: "JoinCond",
-			pos:  position{line: 2229, col: 1, offset: 68033},
+			pos:  position{line: 2408, col: 1, offset: 73318},
 			expr: &choiceExpr{
-				pos: position{line: 2230, col: 5, offset: 68046},
+				pos: position{line: 2409, col: 5, offset: 73331},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2230, col: 5, offset: 68046},
+						pos: position{line: 2409, col: 5, offset: 73331},
 						run: (*parser).callonJoinCond2,
 						expr: &seqExpr{
-							pos: position{line: 2230, col: 5, offset: 68046},
+							pos: position{line: 2409, col: 5, offset: 73331},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2230, col: 5, offset: 68046},
+									pos:  position{line: 2409, col: 5, offset: 73331},
 									name: "ON",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2230, col: 8, offset: 68049},
+									pos:  position{line: 2409, col: 8, offset: 73334},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2230, col: 10, offset: 68051},
+									pos:   position{line: 2409, col: 10, offset: 73336},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2230, col: 12, offset: 68053},
+										pos:  position{line: 2409, col: 12, offset: 73338},
 										name: "Expr",
 									},
 								},
@@ -15125,43 +16542,43 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2237, col: 5, offset: 68206},
+						pos: position{line: 2416, col: 5, offset: 73491},
 						run: (*parser).callonJoinCond8,
 						expr: &seqExpr{
-							pos: position{line: 2237, col: 5, offset: 68206},
+							pos: position{line: 2416, col: 5, offset: 73491},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2237, col: 5, offset: 68206},
+									pos:  position{line: 2416, col: 5, offset: 73491},
 									name: "USING",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2237, col: 11, offset: 68212},
+									pos:  position{line: 2416, col: 11, offset: 73497},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2237, col: 14, offset: 68215},
+									pos:        position{line: 2416, col: 14, offset: 73500},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2237, col: 18, offset: 68219},
+									pos:  position{line: 2416, col: 18, offset: 73504},
 									name: "__",
 								},
 								&labeledExpr{
-									pos:   position{line: 2237, col: 21, offset: 68222},
+									pos:   position{line: 2416, col: 21, offset: 73507},
 									label: "fields",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2237, col: 28, offset: 68229},
+										pos:  position{line: 2416, col: 28, offset: 73514},
 										name: "Identifiers",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2237, col: 40, offset: 68241},
+									pos:  position{line: 2416, col: 40, offset: 73526},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2237, col: 43, offset: 68244},
+									pos:        position{line: 2416, col: 43, offset: 73529},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -15176,40 +16593,40 @@ var g = &grammar{
 		},
 		{
 			name: "OptOrdinality",
-			pos:  position{line: 2245, col: 1, offset: 68413},
+			pos:  position{line: 2424, col: 1, offset: 73698},
 			expr: &choiceExpr{
-				pos: position{line: 2246, col: 5, offset: 68431},
+				pos: position{line: 2425, col: 5, offset: 73716},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2246, col: 5, offset: 68431},
+						pos: position{line: 2425, col: 5, offset: 73716},
 						run: (*parser).callonOptOrdinality2,
 						expr: &seqExpr{
-							pos: position{line: 2246, col: 5, offset: 68431},
+							pos: position{line: 2425, col: 5, offset: 73716},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2246, col: 5, offset: 68431},
+									pos:  position{line: 2425, col: 5, offset: 73716},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2246, col: 7, offset: 68433},
+									pos:  position{line: 2425, col: 7, offset: 73718},
 									name: "WITH",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2246, col: 12, offset: 68438},
+									pos:  position{line: 2425, col: 12, offset: 73723},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2246, col: 14, offset: 68440},
+									pos:  position{line: 2425, col: 14, offset: 73725},
 									name: "ORDINALITY",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2251, col: 5, offset: 68537},
+						pos: position{line: 2430, col: 5, offset: 73822},
 						run: (*parser).callonOptOrdinality8,
 						expr: &litMatcher{
-							pos:        position{line: 2251, col: 5, offset: 68537},
+							pos:        position{line: 2430, col: 5, offset: 73822},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15222,25 +16639,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptAlias",
-			pos:  position{line: 2253, col: 1, offset: 68586},
+			pos:  position{line: 2432, col: 1, offset: 73871},
 			expr: &choiceExpr{
-				pos: position{line: 2254, col: 5, offset: 68599},
+				pos: position{line: 2433, col: 5, offset: 73884},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2254, col: 5, offset: 68599},
+						pos: position{line: 2433, col: 5, offset: 73884},
 						run: (*parser).callonOptAlias2,
 						expr: &seqExpr{
-							pos: position{line: 2254, col: 5, offset: 68599},
+							pos: position{line: 2433, col: 5, offset: 73884},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2254, col: 5, offset: 68599},
+									pos:  position{line: 2433, col: 5, offset: 73884},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2254, col: 7, offset: 68601},
+									pos:   position{line: 2433, col: 7, offset: 73886},
 									label: "a",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2254, col: 9, offset: 68603},
+										pos:  position{line: 2433, col: 9, offset: 73888},
 										name: "AliasClause",
 									},
 								},
@@ -15248,10 +16665,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2255, col: 5, offset: 68637},
+						pos: position{line: 2434, col: 5, offset: 73922},
 						run: (*parser).callonOptAlias7,
 						expr: &litMatcher{
-							pos:        position{line: 2255, col: 5, offset: 68637},
+							pos:        position{line: 2434, col: 5, offset: 73922},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15264,41 +16681,41 @@ var g = &grammar{
 		},
 		{
 			name: "AliasClause",
-			pos:  position{line: 2257, col: 1, offset: 68674},
+			pos:  position{line: 2436, col: 1, offset: 73959},
 			expr: &actionExpr{
-				pos: position{line: 2258, col: 4, offset: 68689},
+				pos: position{line: 2437, col: 4, offset: 73974},
 				run: (*parser).callonAliasClause1,
 				expr: &seqExpr{
-					pos: position{line: 2258, col: 4, offset: 68689},
+					pos: position{line: 2437, col: 4, offset: 73974},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 2258, col: 4, offset: 68689},
+							pos: position{line: 2437, col: 4, offset: 73974},
 							expr: &seqExpr{
-								pos: position{line: 2258, col: 5, offset: 68690},
+								pos: position{line: 2437, col: 5, offset: 73975},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 2258, col: 5, offset: 68690},
+										pos:  position{line: 2437, col: 5, offset: 73975},
 										name: "AS",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 2258, col: 8, offset: 68693},
+										pos:  position{line: 2437, col: 8, offset: 73978},
 										name: "_",
 									},
 								},
 							},
 						},
 						&notExpr{
-							pos: position{line: 2258, col: 12, offset: 68697},
+							pos: position{line: 2437, col: 12, offset: 73982},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2258, col: 13, offset: 68698},
+								pos:  position{line: 2437, col: 13, offset: 73983},
 								name: "SQLGuard",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2258, col: 22, offset: 68707},
+							pos:   position{line: 2437, col: 22, offset: 73992},
 							label: "alias",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2258, col: 28, offset: 68713},
+								pos:  position{line: 2437, col: 28, offset: 73998},
 								name: "TableAlias",
 							},
 						},
@@ -15310,28 +16727,28 @@ var g = &grammar{
 		},
 		{
 			name: "TableAlias",
-			pos:  position{line: 2260, col: 1, offset: 68747},
+			pos:  position{line: 2439, col: 1, offset: 74032},
 			expr: &actionExpr{
-				pos: position{line: 2261, col: 4, offset: 68761},
+				pos: position{line: 2440, col: 4, offset: 74046},
 				run: (*parser).callonTableAlias1,
 				expr: &seqExpr{
-					pos: position{line: 2261, col: 4, offset: 68761},
+					pos: position{line: 2440, col: 4, offset: 74046},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2261, col: 4, offset: 68761},
+							pos:   position{line: 2440, col: 4, offset: 74046},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2261, col: 9, offset: 68766},
+								pos:  position{line: 2440, col: 9, offset: 74051},
 								name: "SQLIdentifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2261, col: 23, offset: 68780},
+							pos:   position{line: 2440, col: 23, offset: 74065},
 							label: "cols",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 2261, col: 28, offset: 68785},
+								pos: position{line: 2440, col: 28, offset: 74070},
 								expr: &ruleRefExpr{
-									pos:  position{line: 2261, col: 28, offset: 68785},
+									pos:  position{line: 2440, col: 28, offset: 74070},
 									name: "Columns",
 								},
 							},
@@ -15344,65 +16761,65 @@ var g = &grammar{
 		},
 		{
 			name: "Columns",
-			pos:  position{line: 2269, col: 1, offset: 68970},
+			pos:  position{line: 2448, col: 1, offset: 74255},
 			expr: &actionExpr{
-				pos: position{line: 2270, col: 5, offset: 68982},
+				pos: position{line: 2449, col: 5, offset: 74267},
 				run: (*parser).callonColumns1,
 				expr: &seqExpr{
-					pos: position{line: 2270, col: 5, offset: 68982},
+					pos: position{line: 2449, col: 5, offset: 74267},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2270, col: 5, offset: 68982},
+							pos:  position{line: 2449, col: 5, offset: 74267},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2270, col: 8, offset: 68985},
+							pos:        position{line: 2449, col: 8, offset: 74270},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2270, col: 12, offset: 68989},
+							pos:  position{line: 2449, col: 12, offset: 74274},
 							name: "__",
 						},
 						&labeledExpr{
-							pos:   position{line: 2270, col: 15, offset: 68992},
+							pos:   position{line: 2449, col: 15, offset: 74277},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2270, col: 21, offset: 68998},
+								pos:  position{line: 2449, col: 21, offset: 74283},
 								name: "SQLIdentifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2270, col: 35, offset: 69012},
+							pos:   position{line: 2449, col: 35, offset: 74297},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2270, col: 40, offset: 69017},
+								pos: position{line: 2449, col: 40, offset: 74302},
 								expr: &actionExpr{
-									pos: position{line: 2270, col: 42, offset: 69019},
+									pos: position{line: 2449, col: 42, offset: 74304},
 									run: (*parser).callonColumns10,
 									expr: &seqExpr{
-										pos: position{line: 2270, col: 42, offset: 69019},
+										pos: position{line: 2449, col: 42, offset: 74304},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2270, col: 42, offset: 69019},
+												pos:  position{line: 2449, col: 42, offset: 74304},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2270, col: 45, offset: 69022},
+												pos:        position{line: 2449, col: 45, offset: 74307},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2270, col: 49, offset: 69026},
+												pos:  position{line: 2449, col: 49, offset: 74311},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2270, col: 52, offset: 69029},
+												pos:   position{line: 2449, col: 52, offset: 74314},
 												label: "s",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2270, col: 54, offset: 69031},
+													pos:  position{line: 2449, col: 54, offset: 74316},
 													name: "SQLIdentifier",
 												},
 											},
@@ -15412,11 +16829,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2270, col: 87, offset: 69064},
+							pos:  position{line: 2449, col: 87, offset: 74349},
 							name: "__",
 						},
 						&litMatcher{
-							pos:        position{line: 2270, col: 90, offset: 69067},
+							pos:        position{line: 2449, col: 90, offset: 74352},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -15429,51 +16846,51 @@ var g = &grammar{
 		},
 		{
 			name: "Selection",
-			pos:  position{line: 2274, col: 1, offset: 69138},
+			pos:  position{line: 2453, col: 1, offset: 74423},
 			expr: &actionExpr{
-				pos: position{line: 2275, col: 5, offset: 69152},
+				pos: position{line: 2454, col: 5, offset: 74437},
 				run: (*parser).callonSelection1,
 				expr: &seqExpr{
-					pos: position{line: 2275, col: 5, offset: 69152},
+					pos: position{line: 2454, col: 5, offset: 74437},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2275, col: 5, offset: 69152},
+							pos:   position{line: 2454, col: 5, offset: 74437},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2275, col: 11, offset: 69158},
+								pos:  position{line: 2454, col: 11, offset: 74443},
 								name: "SelectElem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2275, col: 22, offset: 69169},
+							pos:   position{line: 2454, col: 22, offset: 74454},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2275, col: 27, offset: 69174},
+								pos: position{line: 2454, col: 27, offset: 74459},
 								expr: &actionExpr{
-									pos: position{line: 2275, col: 29, offset: 69176},
+									pos: position{line: 2454, col: 29, offset: 74461},
 									run: (*parser).callonSelection7,
 									expr: &seqExpr{
-										pos: position{line: 2275, col: 29, offset: 69176},
+										pos: position{line: 2454, col: 29, offset: 74461},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2275, col: 29, offset: 69176},
+												pos:  position{line: 2454, col: 29, offset: 74461},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2275, col: 32, offset: 69179},
+												pos:        position{line: 2454, col: 32, offset: 74464},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2275, col: 36, offset: 69183},
+												pos:  position{line: 2454, col: 36, offset: 74468},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2275, col: 39, offset: 69186},
+												pos:   position{line: 2454, col: 39, offset: 74471},
 												label: "s",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2275, col: 41, offset: 69188},
+													pos:  position{line: 2454, col: 41, offset: 74473},
 													name: "SelectElem",
 												},
 											},
@@ -15490,26 +16907,26 @@ var g = &grammar{
 		},
 		{
 			name: "SelectElem",
-			pos:  position{line: 2282, col: 1, offset: 69350},
+			pos:  position{line: 2461, col: 1, offset: 74635},
 			expr: &actionExpr{
-				pos: position{line: 2283, col: 5, offset: 69365},
+				pos: position{line: 2462, col: 5, offset: 74650},
 				run: (*parser).callonSelectElem1,
 				expr: &seqExpr{
-					pos: position{line: 2283, col: 5, offset: 69365},
+					pos: position{line: 2462, col: 5, offset: 74650},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2283, col: 5, offset: 69365},
+							pos:   position{line: 2462, col: 5, offset: 74650},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2283, col: 10, offset: 69370},
+								pos:  position{line: 2462, col: 10, offset: 74655},
 								name: "ColumnExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2283, col: 21, offset: 69381},
+							pos:   position{line: 2462, col: 21, offset: 74666},
 							label: "as",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2283, col: 24, offset: 69384},
+								pos:  position{line: 2462, col: 24, offset: 74669},
 								name: "OptAsClause",
 							},
 						},
@@ -15521,40 +16938,40 @@ var g = &grammar{
 		},
 		{
 			name: "ColumnExpr",
-			pos:  position{line: 2296, col: 1, offset: 69658},
+			pos:  position{line: 2475, col: 1, offset: 74943},
 			expr: &choiceExpr{
-				pos: position{line: 2297, col: 5, offset: 69673},
+				pos: position{line: 2476, col: 5, offset: 74958},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2297, col: 5, offset: 69673},
+						pos: position{line: 2476, col: 5, offset: 74958},
 						run: (*parser).callonColumnExpr2,
 						expr: &seqExpr{
-							pos: position{line: 2297, col: 5, offset: 69673},
+							pos: position{line: 2476, col: 5, offset: 74958},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 2297, col: 5, offset: 69673},
+									pos:   position{line: 2476, col: 5, offset: 74958},
 									label: "table",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2297, col: 11, offset: 69679},
+										pos:  position{line: 2476, col: 11, offset: 74964},
 										name: "SQLIdentifier",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2297, col: 25, offset: 69693},
+									pos:  position{line: 2476, col: 25, offset: 74978},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2297, col: 28, offset: 69696},
+									pos:        position{line: 2476, col: 28, offset: 74981},
 									val:        ".",
 									ignoreCase: false,
 									want:       "\".\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2297, col: 32, offset: 69700},
+									pos:  position{line: 2476, col: 32, offset: 74985},
 									name: "__",
 								},
 								&litMatcher{
-									pos:        position{line: 2297, col: 35, offset: 69703},
+									pos:        position{line: 2476, col: 35, offset: 74988},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -15563,17 +16980,17 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2304, col: 5, offset: 69844},
+						pos: position{line: 2483, col: 5, offset: 75129},
 						run: (*parser).callonColumnExpr10,
 						expr: &litMatcher{
-							pos:        position{line: 2304, col: 5, offset: 69844},
+							pos:        position{line: 2483, col: 5, offset: 75129},
 							val:        "*",
 							ignoreCase: false,
 							want:       "\"*\"",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2307, col: 5, offset: 69923},
+						pos:  position{line: 2486, col: 5, offset: 75208},
 						name: "Expr",
 					},
 				},
@@ -15583,33 +17000,33 @@ var g = &grammar{
 		},
 		{
 			name: "OptAsClause",
-			pos:  position{line: 2309, col: 1, offset: 69929},
+			pos:  position{line: 2488, col: 1, offset: 75214},
 			expr: &choiceExpr{
-				pos: position{line: 2310, col: 5, offset: 69945},
+				pos: position{line: 2489, col: 5, offset: 75230},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2310, col: 5, offset: 69945},
+						pos: position{line: 2489, col: 5, offset: 75230},
 						run: (*parser).callonOptAsClause2,
 						expr: &seqExpr{
-							pos: position{line: 2310, col: 5, offset: 69945},
+							pos: position{line: 2489, col: 5, offset: 75230},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2310, col: 5, offset: 69945},
+									pos:  position{line: 2489, col: 5, offset: 75230},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2310, col: 7, offset: 69947},
+									pos:  position{line: 2489, col: 7, offset: 75232},
 									name: "AS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2310, col: 10, offset: 69950},
+									pos:  position{line: 2489, col: 10, offset: 75235},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2310, col: 12, offset: 69952},
+									pos:   position{line: 2489, col: 12, offset: 75237},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2310, col: 15, offset: 69955},
+										pos:  position{line: 2489, col: 15, offset: 75240},
 										name: "SQLIdentifier",
 									},
 								},
@@ -15617,27 +17034,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2311, col: 5, offset: 69992},
+						pos: position{line: 2490, col: 5, offset: 75277},
 						run: (*parser).callonOptAsClause9,
 						expr: &seqExpr{
-							pos: position{line: 2311, col: 5, offset: 69992},
+							pos: position{line: 2490, col: 5, offset: 75277},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2311, col: 5, offset: 69992},
+									pos:  position{line: 2490, col: 5, offset: 75277},
 									name: "_",
 								},
 								&notExpr{
-									pos: position{line: 2311, col: 7, offset: 69994},
+									pos: position{line: 2490, col: 7, offset: 75279},
 									expr: &ruleRefExpr{
-										pos:  position{line: 2311, col: 8, offset: 69995},
+										pos:  position{line: 2490, col: 8, offset: 75280},
 										name: "SQLGuard",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2311, col: 17, offset: 70004},
+									pos:   position{line: 2490, col: 17, offset: 75289},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2311, col: 20, offset: 70007},
+										pos:  position{line: 2490, col: 20, offset: 75292},
 										name: "SQLIdentifier",
 									},
 								},
@@ -15645,10 +17062,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2312, col: 5, offset: 70044},
+						pos: position{line: 2491, col: 5, offset: 75329},
 						run: (*parser).callonOptAsClause16,
 						expr: &litMatcher{
-							pos:        position{line: 2312, col: 5, offset: 70044},
+							pos:        position{line: 2491, col: 5, offset: 75329},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15661,41 +17078,41 @@ var g = &grammar{
 		},
 		{
 			name: "OptOrderByClause",
-			pos:  position{line: 2314, col: 1, offset: 70069},
+			pos:  position{line: 2493, col: 1, offset: 75354},
 			expr: &choiceExpr{
-				pos: position{line: 2315, col: 5, offset: 70090},
+				pos: position{line: 2494, col: 5, offset: 75375},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2315, col: 5, offset: 70090},
+						pos: position{line: 2494, col: 5, offset: 75375},
 						run: (*parser).callonOptOrderByClause2,
 						expr: &seqExpr{
-							pos: position{line: 2315, col: 5, offset: 70090},
+							pos: position{line: 2494, col: 5, offset: 75375},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2315, col: 5, offset: 70090},
+									pos:  position{line: 2494, col: 5, offset: 75375},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2315, col: 7, offset: 70092},
+									pos:  position{line: 2494, col: 7, offset: 75377},
 									name: "ORDER",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2315, col: 13, offset: 70098},
+									pos:  position{line: 2494, col: 13, offset: 75383},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2315, col: 15, offset: 70100},
+									pos:  position{line: 2494, col: 15, offset: 75385},
 									name: "BY",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2315, col: 18, offset: 70103},
+									pos:  position{line: 2494, col: 18, offset: 75388},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2315, col: 20, offset: 70105},
+									pos:   position{line: 2494, col: 20, offset: 75390},
 									label: "list",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2315, col: 25, offset: 70110},
+										pos:  position{line: 2494, col: 25, offset: 75395},
 										name: "OrderByList",
 									},
 								},
@@ -15703,10 +17120,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2321, col: 5, offset: 70244},
+						pos: position{line: 2500, col: 5, offset: 75529},
 						run: (*parser).callonOptOrderByClause11,
 						expr: &litMatcher{
-							pos:        position{line: 2321, col: 5, offset: 70244},
+							pos:        position{line: 2500, col: 5, offset: 75529},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15719,51 +17136,51 @@ var g = &grammar{
 		},
 		{
 			name: "OrderByList",
-			pos:  position{line: 2323, col: 1, offset: 70277},
+			pos:  position{line: 2502, col: 1, offset: 75562},
 			expr: &actionExpr{
-				pos: position{line: 2324, col: 5, offset: 70293},
+				pos: position{line: 2503, col: 5, offset: 75578},
 				run: (*parser).callonOrderByList1,
 				expr: &seqExpr{
-					pos: position{line: 2324, col: 5, offset: 70293},
+					pos: position{line: 2503, col: 5, offset: 75578},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2324, col: 5, offset: 70293},
+							pos:   position{line: 2503, col: 5, offset: 75578},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2324, col: 11, offset: 70299},
+								pos:  position{line: 2503, col: 11, offset: 75584},
 								name: "OrderByItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2324, col: 23, offset: 70311},
+							pos:   position{line: 2503, col: 23, offset: 75596},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 2324, col: 28, offset: 70316},
+								pos: position{line: 2503, col: 28, offset: 75601},
 								expr: &actionExpr{
-									pos: position{line: 2324, col: 30, offset: 70318},
+									pos: position{line: 2503, col: 30, offset: 75603},
 									run: (*parser).callonOrderByList7,
 									expr: &seqExpr{
-										pos: position{line: 2324, col: 30, offset: 70318},
+										pos: position{line: 2503, col: 30, offset: 75603},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2324, col: 30, offset: 70318},
+												pos:  position{line: 2503, col: 30, offset: 75603},
 												name: "__",
 											},
 											&litMatcher{
-												pos:        position{line: 2324, col: 33, offset: 70321},
+												pos:        position{line: 2503, col: 33, offset: 75606},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2324, col: 37, offset: 70325},
+												pos:  position{line: 2503, col: 37, offset: 75610},
 												name: "__",
 											},
 											&labeledExpr{
-												pos:   position{line: 2324, col: 40, offset: 70328},
+												pos:   position{line: 2503, col: 40, offset: 75613},
 												label: "o",
 												expr: &ruleRefExpr{
-													pos:  position{line: 2324, col: 42, offset: 70330},
+													pos:  position{line: 2503, col: 42, offset: 75615},
 													name: "OrderByItem",
 												},
 											},
@@ -15780,34 +17197,34 @@ var g = &grammar{
 		},
 		{
 			name: "OrderByItem",
-			pos:  position{line: 2328, col: 1, offset: 70431},
+			pos:  position{line: 2507, col: 1, offset: 75716},
 			expr: &actionExpr{
-				pos: position{line: 2329, col: 5, offset: 70447},
+				pos: position{line: 2508, col: 5, offset: 75732},
 				run: (*parser).callonOrderByItem1,
 				expr: &seqExpr{
-					pos: position{line: 2329, col: 5, offset: 70447},
+					pos: position{line: 2508, col: 5, offset: 75732},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 2329, col: 5, offset: 70447},
+							pos:   position{line: 2508, col: 5, offset: 75732},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2329, col: 7, offset: 70449},
+								pos:  position{line: 2508, col: 7, offset: 75734},
 								name: "Expr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2329, col: 12, offset: 70454},
+							pos:   position{line: 2508, col: 12, offset: 75739},
 							label: "order",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2329, col: 18, offset: 70460},
+								pos:  position{line: 2508, col: 18, offset: 75745},
 								name: "OptAscDesc",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2329, col: 29, offset: 70471},
+							pos:   position{line: 2508, col: 29, offset: 75756},
 							label: "nulls",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2329, col: 35, offset: 70477},
+								pos:  position{line: 2508, col: 35, offset: 75762},
 								name: "OptNullsOrder",
 							},
 						},
@@ -15819,49 +17236,49 @@ var g = &grammar{
 		},
 		{
 			name: "OptAscDesc",
-			pos:  position{line: 2340, col: 1, offset: 70709},
+			pos:  position{line: 2519, col: 1, offset: 75994},
 			expr: &choiceExpr{
-				pos: position{line: 2341, col: 5, offset: 70724},
+				pos: position{line: 2520, col: 5, offset: 76009},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2341, col: 5, offset: 70724},
+						pos: position{line: 2520, col: 5, offset: 76009},
 						run: (*parser).callonOptAscDesc2,
 						expr: &seqExpr{
-							pos: position{line: 2341, col: 5, offset: 70724},
+							pos: position{line: 2520, col: 5, offset: 76009},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2341, col: 5, offset: 70724},
+									pos:  position{line: 2520, col: 5, offset: 76009},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2341, col: 7, offset: 70726},
+									pos:  position{line: 2520, col: 7, offset: 76011},
 									name: "ASC",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2342, col: 5, offset: 70786},
+						pos: position{line: 2521, col: 5, offset: 76071},
 						run: (*parser).callonOptAscDesc6,
 						expr: &seqExpr{
-							pos: position{line: 2342, col: 5, offset: 70786},
+							pos: position{line: 2521, col: 5, offset: 76071},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2342, col: 5, offset: 70786},
+									pos:  position{line: 2521, col: 5, offset: 76071},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2342, col: 7, offset: 70788},
+									pos:  position{line: 2521, col: 7, offset: 76073},
 									name: "DESC",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2343, col: 5, offset: 70848},
+						pos: position{line: 2522, col: 5, offset: 76133},
 						run: (*parser).callonOptAscDesc10,
 						expr: &litMatcher{
-							pos:        position{line: 2343, col: 5, offset: 70848},
+							pos:        position{line: 2522, col: 5, offset: 76133},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15874,65 +17291,65 @@ var g = &grammar{
 		},
 		{
 			name: "OptNullsOrder",
-			pos:  position{line: 2345, col: 1, offset: 70880},
+			pos:  position{line: 2524, col: 1, offset: 76165},
 			expr: &choiceExpr{
-				pos: position{line: 2346, col: 5, offset: 70898},
+				pos: position{line: 2525, col: 5, offset: 76183},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2346, col: 5, offset: 70898},
+						pos: position{line: 2525, col: 5, offset: 76183},
 						run: (*parser).callonOptNullsOrder2,
 						expr: &seqExpr{
-							pos: position{line: 2346, col: 5, offset: 70898},
+							pos: position{line: 2525, col: 5, offset: 76183},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2346, col: 5, offset: 70898},
+									pos:  position{line: 2525, col: 5, offset: 76183},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2346, col: 7, offset: 70900},
+									pos:  position{line: 2525, col: 7, offset: 76185},
 									name: "NULLS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2346, col: 13, offset: 70906},
+									pos:  position{line: 2525, col: 13, offset: 76191},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2346, col: 15, offset: 70908},
+									pos:  position{line: 2525, col: 15, offset: 76193},
 									name: "FIRST",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2347, col: 5, offset: 70972},
+						pos: position{line: 2526, col: 5, offset: 76257},
 						run: (*parser).callonOptNullsOrder8,
 						expr: &seqExpr{
-							pos: position{line: 2347, col: 5, offset: 70972},
+							pos: position{line: 2526, col: 5, offset: 76257},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2347, col: 5, offset: 70972},
+									pos:  position{line: 2526, col: 5, offset: 76257},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2347, col: 7, offset: 70974},
+									pos:  position{line: 2526, col: 7, offset: 76259},
 									name: "NULLS",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2347, col: 13, offset: 70980},
+									pos:  position{line: 2526, col: 13, offset: 76265},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2347, col: 15, offset: 70982},
+									pos:  position{line: 2526, col: 15, offset: 76267},
 									name: "LAST",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2348, col: 5, offset: 71045},
+						pos: position{line: 2527, col: 5, offset: 76330},
 						run: (*parser).callonOptNullsOrder14,
 						expr: &litMatcher{
-							pos:        position{line: 2348, col: 5, offset: 71045},
+							pos:        position{line: 2527, col: 5, offset: 76330},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15945,25 +17362,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptSQLLimitOffset",
-			pos:  position{line: 2350, col: 1, offset: 71090},
+			pos:  position{line: 2529, col: 1, offset: 76375},
 			expr: &choiceExpr{
-				pos: position{line: 2351, col: 5, offset: 71112},
+				pos: position{line: 2530, col: 5, offset: 76397},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2351, col: 5, offset: 71112},
+						pos: position{line: 2530, col: 5, offset: 76397},
 						run: (*parser).callonOptSQLLimitOffset2,
 						expr: &seqExpr{
-							pos: position{line: 2351, col: 5, offset: 71112},
+							pos: position{line: 2530, col: 5, offset: 76397},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2351, col: 5, offset: 71112},
+									pos:  position{line: 2530, col: 5, offset: 76397},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2351, col: 7, offset: 71114},
+									pos:   position{line: 2530, col: 7, offset: 76399},
 									label: "op",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2351, col: 10, offset: 71117},
+										pos:  position{line: 2530, col: 10, offset: 76402},
 										name: "SQLLimitOffset",
 									},
 								},
@@ -15971,10 +17388,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2352, col: 5, offset: 71155},
+						pos: position{line: 2531, col: 5, offset: 76440},
 						run: (*parser).callonOptSQLLimitOffset7,
 						expr: &litMatcher{
-							pos:        position{line: 2352, col: 5, offset: 71155},
+							pos:        position{line: 2531, col: 5, offset: 76440},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -15987,29 +17404,29 @@ var g = &grammar{
 		},
 		{
 			name: "SQLLimitOffset",
-			pos:  position{line: 2354, col: 1, offset: 71196},
+			pos:  position{line: 2533, col: 1, offset: 76481},
 			expr: &choiceExpr{
-				pos: position{line: 2355, col: 5, offset: 71215},
+				pos: position{line: 2534, col: 5, offset: 76500},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2355, col: 5, offset: 71215},
+						pos: position{line: 2534, col: 5, offset: 76500},
 						run: (*parser).callonSQLLimitOffset2,
 						expr: &seqExpr{
-							pos: position{line: 2355, col: 5, offset: 71215},
+							pos: position{line: 2534, col: 5, offset: 76500},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 2355, col: 5, offset: 71215},
+									pos:   position{line: 2534, col: 5, offset: 76500},
 									label: "l",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2355, col: 7, offset: 71217},
+										pos:  position{line: 2534, col: 7, offset: 76502},
 										name: "LimitClause",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2355, col: 19, offset: 71229},
+									pos:   position{line: 2534, col: 19, offset: 76514},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2355, col: 21, offset: 71231},
+										pos:  position{line: 2534, col: 21, offset: 76516},
 										name: "OptOffsetClause",
 									},
 								},
@@ -16017,24 +17434,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2367, col: 5, offset: 71463},
+						pos: position{line: 2546, col: 5, offset: 76748},
 						run: (*parser).callonSQLLimitOffset8,
 						expr: &seqExpr{
-							pos: position{line: 2367, col: 5, offset: 71463},
+							pos: position{line: 2546, col: 5, offset: 76748},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 2367, col: 5, offset: 71463},
+									pos:   position{line: 2546, col: 5, offset: 76748},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2367, col: 7, offset: 71465},
+										pos:  position{line: 2546, col: 7, offset: 76750},
 										name: "OffsetClause",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 2367, col: 20, offset: 71478},
+									pos:   position{line: 2546, col: 20, offset: 76763},
 									label: "l",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2367, col: 22, offset: 71480},
+										pos:  position{line: 2546, col: 22, offset: 76765},
 										name: "OptLimitClause",
 									},
 								},
@@ -16048,25 +17465,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptLimitClause",
-			pos:  position{line: 2378, col: 1, offset: 71677},
+			pos:  position{line: 2557, col: 1, offset: 76962},
 			expr: &choiceExpr{
-				pos: position{line: 2379, col: 5, offset: 71696},
+				pos: position{line: 2558, col: 5, offset: 76981},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2379, col: 5, offset: 71696},
+						pos: position{line: 2558, col: 5, offset: 76981},
 						run: (*parser).callonOptLimitClause2,
 						expr: &seqExpr{
-							pos: position{line: 2379, col: 5, offset: 71696},
+							pos: position{line: 2558, col: 5, offset: 76981},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2379, col: 5, offset: 71696},
+									pos:  position{line: 2558, col: 5, offset: 76981},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2379, col: 7, offset: 71698},
+									pos:   position{line: 2558, col: 7, offset: 76983},
 									label: "l",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2379, col: 9, offset: 71700},
+										pos:  position{line: 2558, col: 9, offset: 76985},
 										name: "LimitClause",
 									},
 								},
@@ -16074,10 +17491,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2380, col: 5, offset: 71734},
+						pos: position{line: 2559, col: 5, offset: 77019},
 						run: (*parser).callonOptLimitClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2380, col: 5, offset: 71734},
+							pos:        position{line: 2559, col: 5, offset: 77019},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16090,50 +17507,50 @@ var g = &grammar{
 		},
 		{
 			name: "LimitClause",
-			pos:  position{line: 2382, col: 1, offset: 71771},
+			pos:  position{line: 2561, col: 1, offset: 77056},
 			expr: &choiceExpr{
-				pos: position{line: 2383, col: 5, offset: 71787},
+				pos: position{line: 2562, col: 5, offset: 77072},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2383, col: 5, offset: 71787},
+						pos: position{line: 2562, col: 5, offset: 77072},
 						run: (*parser).callonLimitClause2,
 						expr: &seqExpr{
-							pos: position{line: 2383, col: 5, offset: 71787},
+							pos: position{line: 2562, col: 5, offset: 77072},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2383, col: 5, offset: 71787},
+									pos:  position{line: 2562, col: 5, offset: 77072},
 									name: "LIMIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2383, col: 11, offset: 71793},
+									pos:  position{line: 2562, col: 11, offset: 77078},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2383, col: 13, offset: 71795},
+									pos:  position{line: 2562, col: 13, offset: 77080},
 									name: "ALL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2384, col: 5, offset: 71823},
+						pos: position{line: 2563, col: 5, offset: 77108},
 						run: (*parser).callonLimitClause7,
 						expr: &seqExpr{
-							pos: position{line: 2384, col: 5, offset: 71823},
+							pos: position{line: 2563, col: 5, offset: 77108},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2384, col: 5, offset: 71823},
+									pos:  position{line: 2563, col: 5, offset: 77108},
 									name: "LIMIT",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2384, col: 11, offset: 71829},
+									pos:  position{line: 2563, col: 11, offset: 77114},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2384, col: 13, offset: 71831},
+									pos:   position{line: 2563, col: 13, offset: 77116},
 									label: "e",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2384, col: 15, offset: 71833},
+										pos:  position{line: 2563, col: 15, offset: 77118},
 										name: "Expr",
 									},
 								},
@@ -16147,25 +17564,25 @@ var g = &grammar{
 		},
 		{
 			name: "OptOffsetClause",
-			pos:  position{line: 2386, col: 1, offset: 71857},
+			pos:  position{line: 2565, col: 1, offset: 77142},
 			expr: &choiceExpr{
-				pos: position{line: 2387, col: 5, offset: 71877},
+				pos: position{line: 2566, col: 5, offset: 77162},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2387, col: 5, offset: 71877},
+						pos: position{line: 2566, col: 5, offset: 77162},
 						run: (*parser).callonOptOffsetClause2,
 						expr: &seqExpr{
-							pos: position{line: 2387, col: 5, offset: 71877},
+							pos: position{line: 2566, col: 5, offset: 77162},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2387, col: 5, offset: 71877},
+									pos:  position{line: 2566, col: 5, offset: 77162},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 2387, col: 7, offset: 71879},
+									pos:   position{line: 2566, col: 7, offset: 77164},
 									label: "o",
 									expr: &ruleRefExpr{
-										pos:  position{line: 2387, col: 9, offset: 71881},
+										pos:  position{line: 2566, col: 9, offset: 77166},
 										name: "OffsetClause",
 									},
 								},
@@ -16173,10 +17590,10 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2388, col: 5, offset: 71917},
+						pos: position{line: 2567, col: 5, offset: 77202},
 						run: (*parser).callonOptOffsetClause7,
 						expr: &litMatcher{
-							pos:        position{line: 2388, col: 5, offset: 71917},
+							pos:        position{line: 2567, col: 5, offset: 77202},
 							val:        "",
 							ignoreCase: false,
 							want:       "\"\"",
@@ -16189,26 +17606,26 @@ var g = &grammar{
 		},
 		{
 			name: "OffsetClause",
-			pos:  position{line: 2390, col: 1, offset: 71942},
+			pos:  position{line: 2569, col: 1, offset: 77227},
 			expr: &actionExpr{
-				pos: position{line: 2391, col: 5, offset: 71959},
+				pos: position{line: 2570, col: 5, offset: 77244},
 				run: (*parser).callonOffsetClause1,
 				expr: &seqExpr{
-					pos: position{line: 2391, col: 5, offset: 71959},
+					pos: position{line: 2570, col: 5, offset: 77244},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 2391, col: 5, offset: 71959},
+							pos:  position{line: 2570, col: 5, offset: 77244},
 							name: "OFFSET",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2391, col: 12, offset: 71966},
+							pos:  position{line: 2570, col: 12, offset: 77251},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 2391, col: 14, offset: 71968},
+							pos:   position{line: 2570, col: 14, offset: 77253},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2391, col: 16, offset: 71970},
+								pos:  position{line: 2570, col: 16, offset: 77255},
 								name: "Expr",
 							},
 						},
@@ -16220,60 +17637,60 @@ var g = &grammar{
 		},
 		{
 			name: "SetOp",
-			pos:  position{line: 2393, col: 1, offset: 71995},
+			pos:  position{line: 2572, col: 1, offset: 77280},
 			expr: &choiceExpr{
-				pos: position{line: 2394, col: 5, offset: 72005},
+				pos: position{line: 2573, col: 5, offset: 77290},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 2394, col: 5, offset: 72005},
+						pos: position{line: 2573, col: 5, offset: 77290},
 						run: (*parser).callonSetOp2,
 						expr: &seqExpr{
-							pos: position{line: 2394, col: 5, offset: 72005},
+							pos: position{line: 2573, col: 5, offset: 77290},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2394, col: 5, offset: 72005},
+									pos:  position{line: 2573, col: 5, offset: 77290},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2394, col: 7, offset: 72007},
+									pos:  position{line: 2573, col: 7, offset: 77292},
 									name: "UNION",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2394, col: 13, offset: 72013},
+									pos:  position{line: 2573, col: 13, offset: 77298},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2394, col: 15, offset: 72015},
+									pos:  position{line: 2573, col: 15, offset: 77300},
 									name: "ALL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2395, col: 5, offset: 72051},
+						pos: position{line: 2574, col: 5, offset: 77336},
 						run: (*parser).callonSetOp8,
 						expr: &seqExpr{
-							pos: position{line: 2395, col: 5, offset: 72051},
+							pos: position{line: 2574, col: 5, offset: 77336},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 2395, col: 5, offset: 72051},
+									pos:  position{line: 2574, col: 5, offset: 77336},
 									name: "_",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2395, col: 7, offset: 72053},
+									pos:  position{line: 2574, col: 7, offset: 77338},
 									name: "UNION",
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 2395, col: 13, offset: 72059},
+									pos: position{line: 2574, col: 13, offset: 77344},
 									expr: &seqExpr{
-										pos: position{line: 2395, col: 14, offset: 72060},
+										pos: position{line: 2574, col: 14, offset: 77345},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 2395, col: 14, offset: 72060},
+												pos:  position{line: 2574, col: 14, offset: 77345},
 												name: "_",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2395, col: 16, offset: 72062},
+												pos:  position{line: 2574, col: 16, offset: 77347},
 												name: "DISTINCT",
 											},
 										},
@@ -16289,88 +17706,92 @@ var g = &grammar{
 		},
 		{
 			name: "SQLGuard",
-			pos:  position{line: 2398, col: 1, offset: 72114},
+			pos:  position{line: 2577, col: 1, offset: 77399},
 			expr: &choiceExpr{
-				pos: position{line: 2399, col: 5, offset: 72129},
+				pos: position{line: 2578, col: 5, offset: 77414},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 2399, col: 5, offset: 72129},
+						pos:  position{line: 2578, col: 5, offset: 77414},
 						name: "FROM",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2399, col: 12, offset: 72136},
+						pos:  position{line: 2578, col: 12, offset: 77421},
 						name: "GROUP",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2399, col: 20, offset: 72144},
+						pos:  position{line: 2578, col: 20, offset: 77429},
 						name: "HAVING",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2399, col: 29, offset: 72153},
+						pos:  position{line: 2578, col: 29, offset: 77438},
 						name: "SELECT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2399, col: 38, offset: 72162},
+						pos:  position{line: 2578, col: 38, offset: 77447},
 						name: "RECURSIVE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2400, col: 5, offset: 72176},
+						pos:  position{line: 2579, col: 5, offset: 77461},
 						name: "ANTI",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2400, col: 12, offset: 72183},
+						pos:  position{line: 2579, col: 12, offset: 77468},
 						name: "INNER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2400, col: 20, offset: 72191},
+						pos:  position{line: 2579, col: 20, offset: 77476},
 						name: "LEFT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2400, col: 27, offset: 72198},
+						pos:  position{line: 2579, col: 27, offset: 77483},
 						name: "RIGHT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2400, col: 35, offset: 72206},
+						pos:  position{line: 2579, col: 35, offset: 77491},
 						name: "OUTER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2400, col: 43, offset: 72214},
+						pos:  position{line: 2579, col: 43, offset: 77499},
 						name: "CROSS",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2400, col: 51, offset: 72222},
+						pos:  position{line: 2579, col: 51, offset: 77507},
 						name: "JOIN",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2401, col: 5, offset: 72231},
+						pos:  position{line: 2580, col: 5, offset: 77516},
 						name: "UNION",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2402, col: 5, offset: 72241},
+						pos:  position{line: 2581, col: 5, offset: 77526},
 						name: "ORDER",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2403, col: 5, offset: 72251},
+						pos:  position{line: 2582, col: 5, offset: 77536},
 						name: "OFFSET",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2404, col: 5, offset: 72262},
+						pos:  position{line: 2583, col: 5, offset: 77547},
 						name: "LIMIT",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2405, col: 5, offset: 72272},
+						pos:  position{line: 2584, col: 5, offset: 77557},
 						name: "WHERE",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2406, col: 5, offset: 72282},
+						pos:  position{line: 2585, col: 5, offset: 77567},
+						name: "WINDOW",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2586, col: 5, offset: 77578},
 						name: "WITH",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2407, col: 5, offset: 72291},
+						pos:  position{line: 2587, col: 5, offset: 77587},
 						name: "USING",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2408, col: 5, offset: 72301},
+						pos:  position{line: 2588, col: 5, offset: 77597},
 						name: "ON",
 					},
 				},
@@ -16380,20 +17801,20 @@ var g = &grammar{
 		},
 		{
 			name: "AGGREGATE",
-			pos:  position{line: 2410, col: 1, offset: 72305},
+			pos:  position{line: 2590, col: 1, offset: 77601},
 			expr: &seqExpr{
-				pos: position{line: 2410, col: 14, offset: 72318},
+				pos: position{line: 2590, col: 14, offset: 77614},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2410, col: 14, offset: 72318},
+						pos:        position{line: 2590, col: 14, offset: 77614},
 						val:        "aggregate",
 						ignoreCase: true,
 						want:       "\"AGGREGATE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2410, col: 33, offset: 72337},
+						pos: position{line: 2590, col: 33, offset: 77633},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2410, col: 34, offset: 72338},
+							pos:  position{line: 2590, col: 34, offset: 77634},
 							name: "IdentifierRest",
 						},
 					},
@@ -16404,20 +17825,44 @@ var g = &grammar{
 		},
 		{
 			name: "ALL",
-			pos:  position{line: 2411, col: 1, offset: 72353},
+			pos:  position{line: 2591, col: 1, offset: 77649},
 			expr: &seqExpr{
-				pos: position{line: 2411, col: 14, offset: 72366},
+				pos: position{line: 2591, col: 14, offset: 77662},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2411, col: 14, offset: 72366},
+						pos:        position{line: 2591, col: 14, offset: 77662},
 						val:        "all",
 						ignoreCase: true,
 						want:       "\"ALL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2411, col: 33, offset: 72385},
+						pos: position{line: 2591, col: 33, offset: 77681},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2591, col: 34, offset: 77682},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ANALYZE",
+			pos:  position{line: 2592, col: 1, offset: 77697},
+			expr: &seqExpr{
+				pos: position{line: 2592, col: 14, offset: 77710},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2592, col: 14, offset: 77710},
+						val:        "analyze",
+						ignoreCase: true,
+						want:       "\"ANALYZE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2592, col: 33, offset: 77729},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2411, col: 34, offset: 72386},
+							pos:  position{line: 2592, col: 34, offset: 77730},
 							name: "IdentifierRest",
 						},
 					},
@@ -16428,23 +17873,23 @@ var g = &grammar{
 		},
 		{
 			name: "AND",
-			pos:  position{line: 2412, col: 1, offset: 72401},
+			pos:  position{line: 2593, col: 1, offset: 77745},
 			expr: &actionExpr{
-				pos: position{line: 2412, col: 14, offset: 72414},
+				pos: position{line: 2593, col: 14, offset: 77758},
 				run: (*parser).callonAND1,
 				expr: &seqExpr{
-					pos: position{line: 2412, col: 14, offset: 72414},
+					pos: position{line: 2593, col: 14, offset: 77758},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2412, col: 14, offset: 72414},
+							pos:        position{line: 2593, col: 14, offset: 77758},
 							val:        "and",
 							ignoreCase: true,
 							want:       "\"AND\"i",
 						},
 						&notExpr{
-							pos: position{line: 2412, col: 33, offset: 72433},
+							pos: position{line: 2593, col: 33, offset: 77777},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2412, col: 34, offset: 72434},
+								pos:  position{line: 2593, col: 34, offset: 77778},
 								name: "IdentifierRest",
 							},
 						},
@@ -16456,20 +17901,20 @@ var g = &grammar{
 		},
 		{
 			name: "ANTI",
-			pos:  position{line: 2413, col: 1, offset: 72471},
+			pos:  position{line: 2594, col: 1, offset: 77815},
 			expr: &seqExpr{
-				pos: position{line: 2413, col: 14, offset: 72484},
+				pos: position{line: 2594, col: 14, offset: 77828},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2413, col: 14, offset: 72484},
+						pos:        position{line: 2594, col: 14, offset: 77828},
 						val:        "anti",
 						ignoreCase: true,
 						want:       "\"ANTI\"i",
 					},
 					&notExpr{
-						pos: position{line: 2413, col: 33, offset: 72503},
+						pos: position{line: 2594, col: 33, offset: 77847},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2413, col: 34, offset: 72504},
+							pos:  position{line: 2594, col: 34, offset: 77848},
 							name: "IdentifierRest",
 						},
 					},
@@ -16480,20 +17925,20 @@ var g = &grammar{
 		},
 		{
 			name: "AS",
-			pos:  position{line: 2414, col: 1, offset: 72519},
+			pos:  position{line: 2595, col: 1, offset: 77863},
 			expr: &seqExpr{
-				pos: position{line: 2414, col: 14, offset: 72532},
+				pos: position{line: 2595, col: 14, offset: 77876},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2414, col: 14, offset: 72532},
+						pos:        position{line: 2595, col: 14, offset: 77876},
 						val:        "as",
 						ignoreCase: true,
 						want:       "\"AS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2414, col: 33, offset: 72551},
+						pos: position{line: 2595, col: 33, offset: 77895},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2414, col: 34, offset: 72552},
+							pos:  position{line: 2595, col: 34, offset: 77896},
 							name: "IdentifierRest",
 						},
 					},
@@ -16504,23 +17949,23 @@ var g = &grammar{
 		},
 		{
 			name: "ASC",
-			pos:  position{line: 2415, col: 1, offset: 72567},
+			pos:  position{line: 2596, col: 1, offset: 77911},
 			expr: &actionExpr{
-				pos: position{line: 2415, col: 14, offset: 72580},
+				pos: position{line: 2596, col: 14, offset: 77924},
 				run: (*parser).callonASC1,
 				expr: &seqExpr{
-					pos: position{line: 2415, col: 14, offset: 72580},
+					pos: position{line: 2596, col: 14, offset: 77924},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2415, col: 14, offset: 72580},
+							pos:        position{line: 2596, col: 14, offset: 77924},
 							val:        "asc",
 							ignoreCase: true,
 							want:       "\"ASC\"i",
 						},
 						&notExpr{
-							pos: position{line: 2415, col: 33, offset: 72599},
+							pos: position{line: 2596, col: 33, offset: 77943},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2415, col: 34, offset: 72600},
+								pos:  position{line: 2596, col: 34, offset: 77944},
 								name: "IdentifierRest",
 							},
 						},
@@ -16532,20 +17977,20 @@ var g = &grammar{
 		},
 		{
 			name: "ASSERT",
-			pos:  position{line: 2416, col: 1, offset: 72637},
+			pos:  position{line: 2597, col: 1, offset: 77981},
 			expr: &seqExpr{
-				pos: position{line: 2416, col: 14, offset: 72650},
+				pos: position{line: 2597, col: 14, offset: 77994},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2416, col: 14, offset: 72650},
+						pos:        position{line: 2597, col: 14, offset: 77994},
 						val:        "assert",
 						ignoreCase: true,
 						want:       "\"ASSERT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2416, col: 33, offset: 72669},
+						pos: position{line: 2597, col: 33, offset: 78013},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2416, col: 34, offset: 72670},
+							pos:  position{line: 2597, col: 34, offset: 78014},
 							name: "IdentifierRest",
 						},
 					},
@@ -16556,20 +18001,20 @@ var g = &grammar{
 		},
 		{
 			name: "AT",
-			pos:  position{line: 2417, col: 1, offset: 72685},
+			pos:  position{line: 2598, col: 1, offset: 78029},
 			expr: &seqExpr{
-				pos: position{line: 2417, col: 14, offset: 72698},
+				pos: position{line: 2598, col: 14, offset: 78042},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2417, col: 14, offset: 72698},
+						pos:        position{line: 2598, col: 14, offset: 78042},
 						val:        "at",
 						ignoreCase: true,
 						want:       "\"AT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2417, col: 33, offset: 72717},
+						pos: position{line: 2598, col: 33, offset: 78061},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2417, col: 34, offset: 72718},
+							pos:  position{line: 2598, col: 34, offset: 78062},
 							name: "IdentifierRest",
 						},
 					},
@@ -16580,20 +18025,20 @@ var g = &grammar{
 		},
 		{
 			name: "BETWEEN",
-			pos:  position{line: 2418, col: 1, offset: 72733},
+			pos:  position{line: 2599, col: 1, offset: 78077},
 			expr: &seqExpr{
-				pos: position{line: 2418, col: 14, offset: 72746},
+				pos: position{line: 2599, col: 14, offset: 78090},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2418, col: 14, offset: 72746},
+						pos:        position{line: 2599, col: 14, offset: 78090},
 						val:        "between",
 						ignoreCase: true,
 						want:       "\"BETWEEN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2418, col: 33, offset: 72765},
+						pos: position{line: 2599, col: 33, offset: 78109},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2418, col: 34, offset: 72766},
+							pos:  position{line: 2599, col: 34, offset: 78110},
 							name: "IdentifierRest",
 						},
 					},
@@ -16604,20 +18049,20 @@ var g = &grammar{
 		},
 		{
 			name: "BY",
-			pos:  position{line: 2419, col: 1, offset: 72781},
+			pos:  position{line: 2600, col: 1, offset: 78125},
 			expr: &seqExpr{
-				pos: position{line: 2419, col: 14, offset: 72794},
+				pos: position{line: 2600, col: 14, offset: 78138},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2419, col: 14, offset: 72794},
+						pos:        position{line: 2600, col: 14, offset: 78138},
 						val:        "by",
 						ignoreCase: true,
 						want:       "\"BY\"i",
 					},
 					&notExpr{
-						pos: position{line: 2419, col: 33, offset: 72813},
+						pos: position{line: 2600, col: 33, offset: 78157},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2419, col: 34, offset: 72814},
+							pos:  position{line: 2600, col: 34, offset: 78158},
 							name: "IdentifierRest",
 						},
 					},
@@ -16628,20 +18073,20 @@ var g = &grammar{
 		},
 		{
 			name: "CALL",
-			pos:  position{line: 2420, col: 1, offset: 72829},
+			pos:  position{line: 2601, col: 1, offset: 78173},
 			expr: &seqExpr{
-				pos: position{line: 2420, col: 14, offset: 72842},
+				pos: position{line: 2601, col: 14, offset: 78186},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2420, col: 14, offset: 72842},
+						pos:        position{line: 2601, col: 14, offset: 78186},
 						val:        "call",
 						ignoreCase: true,
 						want:       "\"CALL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2420, col: 33, offset: 72861},
+						pos: position{line: 2601, col: 33, offset: 78205},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2420, col: 34, offset: 72862},
+							pos:  position{line: 2601, col: 34, offset: 78206},
 							name: "IdentifierRest",
 						},
 					},
@@ -16652,20 +18097,20 @@ var g = &grammar{
 		},
 		{
 			name: "CASE",
-			pos:  position{line: 2421, col: 1, offset: 72877},
+			pos:  position{line: 2602, col: 1, offset: 78221},
 			expr: &seqExpr{
-				pos: position{line: 2421, col: 14, offset: 72890},
+				pos: position{line: 2602, col: 14, offset: 78234},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2421, col: 14, offset: 72890},
+						pos:        position{line: 2602, col: 14, offset: 78234},
 						val:        "case",
 						ignoreCase: true,
 						want:       "\"CASE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2421, col: 33, offset: 72909},
+						pos: position{line: 2602, col: 33, offset: 78253},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2421, col: 34, offset: 72910},
+							pos:  position{line: 2602, col: 34, offset: 78254},
 							name: "IdentifierRest",
 						},
 					},
@@ -16676,20 +18121,20 @@ var g = &grammar{
 		},
 		{
 			name: "CAST",
-			pos:  position{line: 2422, col: 1, offset: 72925},
+			pos:  position{line: 2603, col: 1, offset: 78269},
 			expr: &seqExpr{
-				pos: position{line: 2422, col: 14, offset: 72938},
+				pos: position{line: 2603, col: 14, offset: 78282},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2422, col: 14, offset: 72938},
+						pos:        position{line: 2603, col: 14, offset: 78282},
 						val:        "cast",
 						ignoreCase: true,
 						want:       "\"CAST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2422, col: 33, offset: 72957},
+						pos: position{line: 2603, col: 33, offset: 78301},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2422, col: 34, offset: 72958},
+							pos:  position{line: 2603, col: 34, offset: 78302},
 							name: "IdentifierRest",
 						},
 					},
@@ -16700,20 +18145,20 @@ var g = &grammar{
 		},
 		{
 			name: "CONST",
-			pos:  position{line: 2423, col: 1, offset: 72973},
+			pos:  position{line: 2604, col: 1, offset: 78317},
 			expr: &seqExpr{
-				pos: position{line: 2423, col: 14, offset: 72986},
+				pos: position{line: 2604, col: 14, offset: 78330},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2423, col: 14, offset: 72986},
+						pos:        position{line: 2604, col: 14, offset: 78330},
 						val:        "const",
 						ignoreCase: true,
 						want:       "\"CONST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2423, col: 33, offset: 73005},
+						pos: position{line: 2604, col: 33, offset: 78349},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2423, col: 34, offset: 73006},
+							pos:  position{line: 2604, col: 34, offset: 78350},
 							name: "IdentifierRest",
 						},
 					},
@@ -16724,20 +18169,20 @@ var g = &grammar{
 		},
 		{
 			name: "COUNT",
-			pos:  position{line: 2424, col: 1, offset: 73021},
+			pos:  position{line: 2605, col: 1, offset: 78365},
 			expr: &seqExpr{
-				pos: position{line: 2424, col: 14, offset: 73034},
+				pos: position{line: 2605, col: 14, offset: 78378},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2424, col: 14, offset: 73034},
+						pos:        position{line: 2605, col: 14, offset: 78378},
 						val:        "count",
 						ignoreCase: true,
 						want:       "\"COUNT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2424, col: 33, offset: 73053},
+						pos: position{line: 2605, col: 33, offset: 78397},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2424, col: 34, offset: 73054},
+							pos:  position{line: 2605, col: 34, offset: 78398},
 							name: "IdentifierRest",
 						},
 					},
@@ -16748,20 +18193,68 @@ var g = &grammar{
 		},
 		{
 			name: "CROSS",
-			pos:  position{line: 2425, col: 1, offset: 73069},
+			pos:  position{line: 2606, col: 1, offset: 78413},
 			expr: &seqExpr{
-				pos: position{line: 2425, col: 14, offset: 73082},
+				pos: position{line: 2606, col: 14, offset: 78426},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2425, col: 14, offset: 73082},
+						pos:        position{line: 2606, col: 14, offset: 78426},
 						val:        "cross",
 						ignoreCase: true,
 						want:       "\"CROSS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2425, col: 33, offset: 73101},
+						pos: position{line: 2606, col: 33, offset: 78445},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2606, col: 34, offset: 78446},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "CUBE",
+			pos:  position{line: 2607, col: 1, offset: 78461},
+			expr: &seqExpr{
+				pos: position{line: 2607, col: 14, offset: 78474},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2607, col: 14, offset: 78474},
+						val:        "cube",
+						ignoreCase: true,
+						want:       "\"CUBE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2607, col: 33, offset: 78493},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2607, col: 34, offset: 78494},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "CURRENT",
+			pos:  position{line: 2608, col: 1, offset: 78509},
+			expr: &seqExpr{
+				pos: position{line: 2608, col: 14, offset: 78522},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2608, col: 14, offset: 78522},
+						val:        "current",
+						ignoreCase: true,
+						want:       "\"CURRENT\"i",
+					},
+					&notExpr{
+						pos: position{line: 2608, col: 33, offset: 78541},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2425, col: 34, offset: 73102},
+							pos:  position{line: 2608, col: 34, offset: 78542},
 							name: "IdentifierRest",
 						},
 					},
@@ -16772,20 +18265,20 @@ var g = &grammar{
 		},
 		{
 			name: "CUT",
-			pos:  position{line: 2426, col: 1, offset: 73117},
+			pos:  position{line: 2609, col: 1, offset: 78557},
 			expr: &seqExpr{
-				pos: position{line: 2426, col: 14, offset: 73130},
+				pos: position{line: 2609, col: 14, offset: 78570},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2426, col: 14, offset: 73130},
+						pos:        position{line: 2609, col: 14, offset: 78570},
 						val:        "cut",
 						ignoreCase: true,
 						want:       "\"CUT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2426, col: 33, offset: 73149},
+						pos: position{line: 2609, col: 33, offset: 78589},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2426, col: 34, offset: 73150},
+							pos:  position{line: 2609, col: 34, offset: 78590},
 							name: "IdentifierRest",
 						},
 					},
@@ -16796,23 +18289,23 @@ var g = &grammar{
 		},
 		{
 			name: "DATE",
-			pos:  position{line: 2427, col: 1, offset: 73165},
+			pos:  position{line: 2610, col: 1, offset: 78605},
 			expr: &actionExpr{
-				pos: position{line: 2427, col: 14, offset: 73178},
+				pos: position{line: 2610, col: 14, offset: 78618},
 				run: (*parser).callonDATE1,
 				expr: &seqExpr{
-					pos: position{line: 2427, col: 14, offset: 73178},
+					pos: position{line: 2610, col: 14, offset: 78618},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2427, col: 14, offset: 73178},
+							pos:        position{line: 2610, col: 14, offset: 78618},
 							val:        "date",
 							ignoreCase: true,
 							want:       "\"DATE\"i",
 						},
 						&notExpr{
-							pos: position{line: 2427, col: 33, offset: 73197},
+							pos: position{line: 2610, col: 33, offset: 78637},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2427, col: 34, offset: 73198},
+								pos:  position{line: 2610, col: 34, offset: 78638},
 								name: "IdentifierRest",
 							},
 						},
@@ -16824,20 +18317,20 @@ var g = &grammar{
 		},
 		{
 			name: "DEBUG",
-			pos:  position{line: 2428, col: 1, offset: 73236},
+			pos:  position{line: 2611, col: 1, offset: 78676},
 			expr: &seqExpr{
-				pos: position{line: 2428, col: 14, offset: 73249},
+				pos: position{line: 2611, col: 14, offset: 78689},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2428, col: 14, offset: 73249},
+						pos:        position{line: 2611, col: 14, offset: 78689},
 						val:        "debug",
 						ignoreCase: true,
 						want:       "\"DEBUG\"i",
 					},
 					&notExpr{
-						pos: position{line: 2428, col: 33, offset: 73268},
+						pos: position{line: 2611, col: 33, offset: 78708},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2428, col: 34, offset: 73269},
+							pos:  position{line: 2611, col: 34, offset: 78709},
 							name: "IdentifierRest",
 						},
 					},
@@ -16848,20 +18341,20 @@ var g = &grammar{
 		},
 		{
 			name: "DEFAULT",
-			pos:  position{line: 2429, col: 1, offset: 73284},
+			pos:  position{line: 2612, col: 1, offset: 78724},
 			expr: &seqExpr{
-				pos: position{line: 2429, col: 14, offset: 73297},
+				pos: position{line: 2612, col: 14, offset: 78737},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2429, col: 14, offset: 73297},
+						pos:        position{line: 2612, col: 14, offset: 78737},
 						val:        "default",
 						ignoreCase: true,
 						want:       "\"DEFAULT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2429, col: 33, offset: 73316},
+						pos: position{line: 2612, col: 33, offset: 78756},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2429, col: 34, offset: 73317},
+							pos:  position{line: 2612, col: 34, offset: 78757},
 							name: "IdentifierRest",
 						},
 					},
@@ -16872,23 +18365,23 @@ var g = &grammar{
 		},
 		{
 			name: "DESC",
-			pos:  position{line: 2430, col: 1, offset: 73332},
+			pos:  position{line: 2613, col: 1, offset: 78772},
 			expr: &actionExpr{
-				pos: position{line: 2430, col: 14, offset: 73345},
+				pos: position{line: 2613, col: 14, offset: 78785},
 				run: (*parser).callonDESC1,
 				expr: &seqExpr{
-					pos: position{line: 2430, col: 14, offset: 73345},
+					pos: position{line: 2613, col: 14, offset: 78785},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2430, col: 14, offset: 73345},
+							pos:        position{line: 2613, col: 14, offset: 78785},
 							val:        "desc",
 							ignoreCase: true,
 							want:       "\"DESC\"i",
 						},
 						&notExpr{
-							pos: position{line: 2430, col: 33, offset: 73364},
+							pos: position{line: 2613, col: 33, offset: 78804},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2430, col: 34, offset: 73365},
+								pos:  position{line: 2613, col: 34, offset: 78805},
 								name: "IdentifierRest",
 							},
 						},
@@ -16900,20 +18393,20 @@ var g = &grammar{
 		},
 		{
 			name: "DISTINCT",
-			pos:  position{line: 2431, col: 1, offset: 73403},
+			pos:  position{line: 2614, col: 1, offset: 78843},
 			expr: &seqExpr{
-				pos: position{line: 2431, col: 14, offset: 73416},
+				pos: position{line: 2614, col: 14, offset: 78856},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2431, col: 14, offset: 73416},
+						pos:        position{line: 2614, col: 14, offset: 78856},
 						val:        "distinct",
 						ignoreCase: true,
 						want:       "\"DISTINCT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2431, col: 33, offset: 73435},
+						pos: position{line: 2614, col: 33, offset: 78875},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2431, col: 34, offset: 73436},
+							pos:  position{line: 2614, col: 34, offset: 78876},
 							name: "IdentifierRest",
 						},
 					},
@@ -16924,20 +18417,20 @@ var g = &grammar{
 		},
 		{
 			name: "DROP",
-			pos:  position{line: 2432, col: 1, offset: 73451},
+			pos:  position{line: 2615, col: 1, offset: 78891},
 			expr: &seqExpr{
-				pos: position{line: 2432, col: 14, offset: 73464},
+				pos: position{line: 2615, col: 14, offset: 78904},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2432, col: 14, offset: 73464},
+						pos:        position{line: 2615, col: 14, offset: 78904},
 						val:        "drop",
 						ignoreCase: true,
 						want:       "\"DROP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2432, col: 33, offset: 73483},
+						pos: position{line: 2615, col: 33, offset: 78923},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2432, col: 34, offset: 73484},
+							pos:  position{line: 2615, col: 34, offset: 78924},
 							name: "IdentifierRest",
 						},
 					},
@@ -16948,20 +18441,20 @@ var g = &grammar{
 		},
 		{
 			name: "ELSE",
-			pos:  position{line: 2433, col: 1, offset: 73499},
+			pos:  position{line: 2616, col: 1, offset: 78939},
 			expr: &seqExpr{
-				pos: position{line: 2433, col: 14, offset: 73512},
+				pos: position{line: 2616, col: 14, offset: 78952},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2433, col: 14, offset: 73512},
+						pos:        position{line: 2616, col: 14, offset: 78952},
 						val:        "else",
 						ignoreCase: true,
 						want:       "\"ELSE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2433, col: 33, offset: 73531},
+						pos: position{line: 2616, col: 33, offset: 78971},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2433, col: 34, offset: 73532},
+							pos:  position{line: 2616, col: 34, offset: 78972},
 							name: "IdentifierRest",
 						},
 					},
@@ -16972,20 +18465,20 @@ var g = &grammar{
 		},
 		{
 			name: "END",
-			pos:  position{line: 2434, col: 1, offset: 73547},
+			pos:  position{line: 2617, col: 1, offset: 78987},
 			expr: &seqExpr{
-				pos: position{line: 2434, col: 14, offset: 73560},
+				pos: position{line: 2617, col: 14, offset: 79000},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2434, col: 14, offset: 73560},
+						pos:        position{line: 2617, col: 14, offset: 79000},
 						val:        "end",
 						ignoreCase: true,
 						want:       "\"END\"i",
 					},
 					&notExpr{
-						pos: position{line: 2434, col: 33, offset: 73579},
+						pos: position{line: 2617, col: 33, offset: 79019},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2434, col: 34, offset: 73580},
+							pos:  position{line: 2617, col: 34, offset: 79020},
 							name: "IdentifierRest",
 						},
 					},
@@ -16996,20 +18489,20 @@ var g = &grammar{
 		},
 		{
 			name: "ENUM",
-			pos:  position{line: 2435, col: 1, offset: 73595},
+			pos:  position{line: 2618, col: 1, offset: 79035},
 			expr: &seqExpr{
-				pos: position{line: 2435, col: 14, offset: 73608},
+				pos: position{line: 2618, col: 14, offset: 79048},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2435, col: 14, offset: 73608},
+						pos:        position{line: 2618, col: 14, offset: 79048},
 						val:        "enum",
 						ignoreCase: true,
 						want:       "\"ENUM\"i",
 					},
 					&notExpr{
-						pos: position{line: 2435, col: 33, offset: 73627},
+						pos: position{line: 2618, col: 33, offset: 79067},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2435, col: 34, offset: 73628},
+							pos:  position{line: 2618, col: 34, offset: 79068},
 							name: "IdentifierRest",
 						},
 					},
@@ -17020,20 +18513,20 @@ var g = &grammar{
 		},
 		{
 			name: "ERROR",
-			pos:  position{line: 2436, col: 1, offset: 73643},
+			pos:  position{line: 2619, col: 1, offset: 79083},
 			expr: &seqExpr{
-				pos: position{line: 2436, col: 14, offset: 73656},
+				pos: position{line: 2619, col: 14, offset: 79096},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2436, col: 14, offset: 73656},
+						pos:        position{line: 2619, col: 14, offset: 79096},
 						val:        "error",
 						ignoreCase: true,
 						want:       "\"ERROR\"i",
 					},
 					&notExpr{
-						pos: position{line: 2436, col: 33, offset: 73675},
+						pos: position{line: 2619, col: 33, offset: 79115},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2436, col: 34, offset: 73676},
+							pos:  position{line: 2619, col: 34, offset: 79116},
 							name: "IdentifierRest",
 						},
 					},
@@ -17044,20 +18537,44 @@ var g = &grammar{
 		},
 		{
 			name: "EXISTS",
-			pos:  position{line: 2437, col: 1, offset: 73691},
+			pos:  position{line: 2620, col: 1, offset: 79131},
 			expr: &seqExpr{
-				pos: position{line: 2437, col: 14, offset: 73704},
+				pos: position{line: 2620, col: 14, offset: 79144},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2437, col: 14, offset: 73704},
+						pos:        position{line: 2620, col: 14, offset: 79144},
 						val:        "exists",
 						ignoreCase: true,
 						want:       "\"EXISTS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2437, col: 33, offset: 73723},
+						pos: position{line: 2620, col: 33, offset: 79163},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2620, col: 34, offset: 79164},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "EXPLAIN",
+			pos:  position{line: 2621, col: 1, offset: 79179},
+			expr: &seqExpr{
+				pos: position{line: 2621, col: 14, offset: 79192},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2621, col: 14, offset: 79192},
+						val:        "explain",
+						ignoreCase: true,
+						want:       "\"EXPLAIN\"i",
+					},
+					&notExpr{
+						pos: position{line: 2621, col: 33, offset: 79211},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2437, col: 34, offset: 73724},
+							pos:  position{line: 2621, col: 34, offset: 79212},
 							name: "IdentifierRest",
 						},
 					},
@@ -17068,20 +18585,20 @@ var g = &grammar{
 		},
 		{
 			name: "EXTRACT",
-			pos:  position{line: 2438, col: 1, offset: 73739},
+			pos:  position{line: 2622, col: 1, offset: 79227},
 			expr: &seqExpr{
-				pos: position{line: 2438, col: 14, offset: 73752},
+				pos: position{line: 2622, col: 14, offset: 79240},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2438, col: 14, offset: 73752},
+						pos:        position{line: 2622, col: 14, offset: 79240},
 						val:        "extract",
 						ignoreCase: true,
 						want:       "\"EXTRACT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2438, col: 33, offset: 73771},
+						pos: position{line: 2622, col: 33, offset: 79259},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2438, col: 34, offset: 73772},
+							pos:  position{line: 2622, col: 34, offset: 79260},
 							name: "IdentifierRest",
 						},
 					},
@@ -17092,20 +18609,20 @@ var g = &grammar{
 		},
 		{
 			name: "FALSE",
-			pos:  position{line: 2439, col: 1, offset: 73787},
+			pos:  position{line: 2623, col: 1, offset: 79275},
 			expr: &seqExpr{
-				pos: position{line: 2439, col: 14, offset: 73800},
+				pos: position{line: 2623, col: 14, offset: 79288},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2439, col: 14, offset: 73800},
+						pos:        position{line: 2623, col: 14, offset: 79288},
 						val:        "false",
 						ignoreCase: true,
 						want:       "\"FALSE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2439, col: 33, offset: 73819},
+						pos: position{line: 2623, col: 33, offset: 79307},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2439, col: 34, offset: 73820},
+							pos:  position{line: 2623, col: 34, offset: 79308},
 							name: "IdentifierRest",
 						},
 					},
@@ -17116,20 +18633,44 @@ var g = &grammar{
 		},
 		{
 			name: "FILTER",
-			pos:  position{line: 2440, col: 1, offset: 73835},
+			pos:  position{line: 2624, col: 1, offset: 79323},
+			expr: &seqExpr{
+				pos: position{line: 2624, col: 14, offset: 79336},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2624, col: 14, offset: 79336},
+						val:        "filter",
+						ignoreCase: true,
+						want:       "\"FILTER\"i",
+					},
+					&notExpr{
+						pos: position{line: 2624, col: 33, offset: 79355},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2624, col: 34, offset: 79356},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "FIRST",
+			pos:  position{line: 2625, col: 1, offset: 79371},
 			expr: &seqExpr{
-				pos: position{line: 2440, col: 14, offset: 73848},
+				pos: position{line: 2625, col: 14, offset: 79384},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2440, col: 14, offset: 73848},
-						val:        "filter",
+						pos:        position{line: 2625, col: 14, offset: 79384},
+						val:        "first",
 						ignoreCase: true,
-						want:       "\"FILTER\"i",
+						want:       "\"FIRST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2440, col: 33, offset: 73867},
+						pos: position{line: 2625, col: 33, offset: 79403},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2440, col: 34, offset: 73868},
+							pos:  position{line: 2625, col: 34, offset: 79404},
 							name: "IdentifierRest",
 						},
 					},
@@ -17139,21 +18680,21 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "FIRST",
-			pos:  position{line: 2441, col: 1, offset: 73883},
+			name: "FN",
+			pos:  position{line: 2626, col: 1, offset: 79419},
 			expr: &seqExpr{
-				pos: position{line: 2441, col: 14, offset: 73896},
+				pos: position{line: 2626, col: 14, offset: 79432},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2441, col: 14, offset: 73896},
-						val:        "first",
+						pos:        position{line: 2626, col: 14, offset: 79432},
+						val:        "fn",
 						ignoreCase: true,
-						want:       "\"FIRST\"i",
+						want:       "\"FN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2441, col: 33, offset: 73915},
+						pos: position{line: 2626, col: 33, offset: 79451},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2441, col: 34, offset: 73916},
+							pos:  position{line: 2626, col: 34, offset: 79452},
 							name: "IdentifierRest",
 						},
 					},
@@ -17163,21 +18704,21 @@ var g = &grammar{
 			leftRecursive: false,
 		},
 		{
-			name: "FN",
-			pos:  position{line: 2442, col: 1, offset: 73931},
+			name: "FOLLOWING",
+			pos:  position{line: 2627, col: 1, offset: 79467},
 			expr: &seqExpr{
-				pos: position{line: 2442, col: 14, offset: 73944},
+				pos: position{line: 2627, col: 14, offset: 79480},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2442, col: 14, offset: 73944},
-						val:        "fn",
+						pos:        position{line: 2627, col: 14, offset: 79480},
+						val:        "following",
 						ignoreCase: true,
-						want:       "\"FN\"i",
+						want:       "\"FOLLOWING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2442, col: 33, offset: 73963},
+						pos: position{line: 2627, col: 33, offset: 79499},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2442, col: 34, offset: 73964},
+							pos:  position{line: 2627, col: 34, offset: 79500},
 							name: "IdentifierRest",
 						},
 					},
@@ -17188,20 +18729,20 @@ var g = &grammar{
 		},
 		{
 			name: "FOR",
-			pos:  position{line: 2443, col: 1, offset: 73979},
+			pos:  position{line: 2628, col: 1, offset: 79515},
 			expr: &seqExpr{
-				pos: position{line: 2443, col: 14, offset: 73992},
+				pos: position{line: 2628, col: 14, offset: 79528},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2443, col: 14, offset: 73992},
+						pos:        position{line: 2628, col: 14, offset: 79528},
 						val:        "for",
 						ignoreCase: true,
 						want:       "\"FOR\"i",
 					},
 					&notExpr{
-						pos: position{line: 2443, col: 33, offset: 74011},
+						pos: position{line: 2628, col: 33, offset: 79547},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2443, col: 34, offset: 74012},
+							pos:  position{line: 2628, col: 34, offset: 79548},
 							name: "IdentifierRest",
 						},
 					},
@@ -17212,20 +18753,20 @@ var g = &grammar{
 		},
 		{
 			name: "FORK",
-			pos:  position{line: 2444, col: 1, offset: 74027},
+			pos:  position{line: 2629, col: 1, offset: 79563},
 			expr: &seqExpr{
-				pos: position{line: 2444, col: 14, offset: 74040},
+				pos: position{line: 2629, col: 14, offset: 79576},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2444, col: 14, offset: 74040},
+						pos:        position{line: 2629, col: 14, offset: 79576},
 						val:        "fork",
 						ignoreCase: true,
 						want:       "\"FORK\"i",
 					},
 					&notExpr{
-						pos: position{line: 2444, col: 33, offset: 74059},
+						pos: position{line: 2629, col: 33, offset: 79595},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2444, col: 34, offset: 74060},
+							pos:  position{line: 2629, col: 34, offset: 79596},
 							name: "IdentifierRest",
 						},
 					},
@@ -17236,20 +18777,20 @@ var g = &grammar{
 		},
 		{
 			name: "FROM",
-			pos:  position{line: 2445, col: 1, offset: 74075},
+			pos:  position{line: 2630, col: 1, offset: 79611},
 			expr: &seqExpr{
-				pos: position{line: 2445, col: 14, offset: 74088},
+				pos: position{line: 2630, col: 14, offset: 79624},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2445, col: 14, offset: 74088},
+						pos:        position{line: 2630, col: 14, offset: 79624},
 						val:        "from",
 						ignoreCase: true,
 						want:       "\"FROM\"i",
 					},
 					&notExpr{
-						pos: position{line: 2445, col: 33, offset: 74107},
+						pos: position{line: 2630, col: 33, offset: 79643},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2445, col: 34, offset: 74108},
+							pos:  position{line: 2630, col: 34, offset: 79644},
 							name: "IdentifierRest",
 						},
 					},
@@ -17260,20 +18801,20 @@ var g = &grammar{
 		},
 		{
 			name: "FULL",
-			pos:  position{line: 2446, col: 1, offset: 74123},
+			pos:  position{line: 2631, col: 1, offset: 79659},
 			expr: &seqExpr{
-				pos: position{line: 2446, col: 14, offset: 74136},
+				pos: position{line: 2631, col: 14, offset: 79672},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2446, col: 14, offset: 74136},
+						pos:        position{line: 2631, col: 14, offset: 79672},
 						val:        "full",
 						ignoreCase: true,
 						want:       "\"FULL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2446, col: 33, offset: 74155},
+						pos: position{line: 2631, col: 33, offset: 79691},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2446, col: 34, offset: 74156},
+							pos:  position{line: 2631, col: 34, offset: 79692},
 							name: "IdentifierRest",
 						},
 					},
@@ -17284,20 +18825,20 @@ var g = &grammar{
 		},
 		{
 			name: "FUSE",
-			pos:  position{line: 2447, col: 1, offset: 74171},
+			pos:  position{line: 2632, col: 1, offset: 79707},
 			expr: &seqExpr{
-				pos: position{line: 2447, col: 14, offset: 74184},
+				pos: position{line: 2632, col: 14, offset: 79720},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2447, col: 14, offset: 74184},
+						pos:        position{line: 2632, col: 14, offset: 79720},
 						val:        "fuse",
 						ignoreCase: true,
 						want:       "\"FUSE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2447, col: 33, offset: 74203},
+						pos: position{line: 2632, col: 33, offset: 79739},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2447, col: 34, offset: 74204},
+							pos:  position{line: 2632, col: 34, offset: 79740},
 							name: "IdentifierRest",
 						},
 					},
@@ -17308,20 +18849,44 @@ var g = &grammar{
 		},
 		{
 			name: "GROUP",
-			pos:  position{line: 2448, col: 1, offset: 74219},
+			pos:  position{line: 2633, col: 1, offset: 79755},
 			expr: &seqExpr{
-				pos: position{line: 2448, col: 14, offset: 74232},
+				pos: position{line: 2633, col: 14, offset: 79768},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2448, col: 14, offset: 74232},
+						pos:        position{line: 2633, col: 14, offset: 79768},
 						val:        "group",
 						ignoreCase: true,
 						want:       "\"GROUP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2448, col: 33, offset: 74251},
+						pos: position{line: 2633, col: 33, offset: 79787},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2633, col: 34, offset: 79788},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "GROUPING",
+			pos:  position{line: 2634, col: 1, offset: 79803},
+			expr: &seqExpr{
+				pos: position{line: 2634, col: 14, offset: 79816},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2634, col: 14, offset: 79816},
+						val:        "grouping",
+						ignoreCase: true,
+						want:       "\"GROUPING\"i",
+					},
+					&notExpr{
+						pos: position{line: 2634, col: 33, offset: 79835},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2448, col: 34, offset: 74252},
+							pos:  position{line: 2634, col: 34, offset: 79836},
 							name: "IdentifierRest",
 						},
 					},
@@ -17332,20 +18897,20 @@ var g = &grammar{
 		},
 		{
 			name: "HAVING",
-			pos:  position{line: 2449, col: 1, offset: 74267},
+			pos:  position{line: 2635, col: 1, offset: 79851},
 			expr: &seqExpr{
-				pos: position{line: 2449, col: 14, offset: 74280},
+				pos: position{line: 2635, col: 14, offset: 79864},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2449, col: 14, offset: 74280},
+						pos:        position{line: 2635, col: 14, offset: 79864},
 						val:        "having",
 						ignoreCase: true,
 						want:       "\"HAVING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2449, col: 33, offset: 74299},
+						pos: position{line: 2635, col: 33, offset: 79883},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2449, col: 34, offset: 74300},
+							pos:  position{line: 2635, col: 34, offset: 79884},
 							name: "IdentifierRest",
 						},
 					},
@@ -17356,20 +18921,20 @@ var g = &grammar{
 		},
 		{
 			name: "HEAD",
-			pos:  position{line: 2450, col: 1, offset: 74315},
+			pos:  position{line: 2636, col: 1, offset: 79899},
 			expr: &seqExpr{
-				pos: position{line: 2450, col: 14, offset: 74328},
+				pos: position{line: 2636, col: 14, offset: 79912},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2450, col: 14, offset: 74328},
+						pos:        position{line: 2636, col: 14, offset: 79912},
 						val:        "head",
 						ignoreCase: true,
 						want:       "\"HEAD\"i",
 					},
 					&notExpr{
-						pos: position{line: 2450, col: 33, offset: 74347},
+						pos: position{line: 2636, col: 33, offset: 79931},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2450, col: 34, offset: 74348},
+							pos:  position{line: 2636, col: 34, offset: 79932},
 							name: "IdentifierRest",
 						},
 					},
@@ -17380,20 +18945,20 @@ var g = &grammar{
 		},
 		{
 			name: "IN",
-			pos:  position{line: 2451, col: 1, offset: 74363},
+			pos:  position{line: 2637, col: 1, offset: 79947},
 			expr: &seqExpr{
-				pos: position{line: 2451, col: 14, offset: 74376},
+				pos: position{line: 2637, col: 14, offset: 79960},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2451, col: 14, offset: 74376},
+						pos:        position{line: 2637, col: 14, offset: 79960},
 						val:        "in",
 						ignoreCase: true,
 						want:       "\"IN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2451, col: 33, offset: 74395},
+						pos: position{line: 2637, col: 33, offset: 79979},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2451, col: 34, offset: 74396},
+							pos:  position{line: 2637, col: 34, offset: 79980},
 							name: "IdentifierRest",
 						},
 					},
@@ -17404,20 +18969,20 @@ var g = &grammar{
 		},
 		{
 			name: "INNER",
-			pos:  position{line: 2452, col: 1, offset: 74411},
+			pos:  position{line: 2638, col: 1, offset: 79995},
 			expr: &seqExpr{
-				pos: position{line: 2452, col: 14, offset: 74424},
+				pos: position{line: 2638, col: 14, offset: 80008},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2452, col: 14, offset: 74424},
+						pos:        position{line: 2638, col: 14, offset: 80008},
 						val:        "inner",
 						ignoreCase: true,
 						want:       "\"INNER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2452, col: 33, offset: 74443},
+						pos: position{line: 2638, col: 33, offset: 80027},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2452, col: 34, offset: 74444},
+							pos:  position{line: 2638, col: 34, offset: 80028},
 							name: "IdentifierRest",
 						},
 					},
@@ -17428,20 +18993,20 @@ var g = &grammar{
 		},
 		{
 			name: "IS",
-			pos:  position{line: 2453, col: 1, offset: 74459},
+			pos:  position{line: 2639, col: 1, offset: 80043},
 			expr: &seqExpr{
-				pos: position{line: 2453, col: 14, offset: 74472},
+				pos: position{line: 2639, col: 14, offset: 80056},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2453, col: 14, offset: 74472},
+						pos:        position{line: 2639, col: 14, offset: 80056},
 						val:        "is",
 						ignoreCase: true,
 						want:       "\"IS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2453, col: 33, offset: 74491},
+						pos: position{line: 2639, col: 33, offset: 80075},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2453, col: 34, offset: 74492},
+							pos:  position{line: 2639, col: 34, offset: 80076},
 							name: "IdentifierRest",
 						},
 					},
@@ -17452,20 +19017,20 @@ var g = &grammar{
 		},
 		{
 			name: "JOIN",
-			pos:  position{line: 2454, col: 1, offset: 74507},
+			pos:  position{line: 2640, col: 1, offset: 80091},
 			expr: &seqExpr{
-				pos: position{line: 2454, col: 14, offset: 74520},
+				pos: position{line: 2640, col: 14, offset: 80104},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2454, col: 14, offset: 74520},
+						pos:        position{line: 2640, col: 14, offset: 80104},
 						val:        "join",
 						ignoreCase: true,
 						want:       "\"JOIN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2454, col: 33, offset: 74539},
+						pos: position{line: 2640, col: 33, offset: 80123},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2454, col: 34, offset: 74540},
+							pos:  position{line: 2640, col: 34, offset: 80124},
 							name: "IdentifierRest",
 						},
 					},
@@ -17476,20 +19041,20 @@ var g = &grammar{
 		},
 		{
 			name: "LAMBDA",
-			pos:  position{line: 2455, col: 1, offset: 74555},
+			pos:  position{line: 2641, col: 1, offset: 80139},
 			expr: &seqExpr{
-				pos: position{line: 2455, col: 14, offset: 74568},
+				pos: position{line: 2641, col: 14, offset: 80152},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2455, col: 14, offset: 74568},
+						pos:        position{line: 2641, col: 14, offset: 80152},
 						val:        "lambda",
 						ignoreCase: true,
 						want:       "\"LAMBDA\"i",
 					},
 					&notExpr{
-						pos: position{line: 2455, col: 33, offset: 74587},
+						pos: position{line: 2641, col: 33, offset: 80171},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2455, col: 34, offset: 74588},
+							pos:  position{line: 2641, col: 34, offset: 80172},
 							name: "IdentifierRest",
 						},
 					},
@@ -17500,20 +19065,20 @@ var g = &grammar{
 		},
 		{
 			name: "LAST",
-			pos:  position{line: 2456, col: 1, offset: 74603},
+			pos:  position{line: 2642, col: 1, offset: 80187},
 			expr: &seqExpr{
-				pos: position{line: 2456, col: 14, offset: 74616},
+				pos: position{line: 2642, col: 14, offset: 80200},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2456, col: 14, offset: 74616},
+						pos:        position{line: 2642, col: 14, offset: 80200},
 						val:        "last",
 						ignoreCase: true,
 						want:       "\"LAST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2456, col: 33, offset: 74635},
+						pos: position{line: 2642, col: 33, offset: 80219},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2456, col: 34, offset: 74636},
+							pos:  position{line: 2642, col: 34, offset: 80220},
 							name: "IdentifierRest",
 						},
 					},
@@ -17524,20 +19089,20 @@ var g = &grammar{
 		},
 		{
 			name: "LEFT",
-			pos:  position{line: 2457, col: 1, offset: 74651},
+			pos:  position{line: 2643, col: 1, offset: 80235},
 			expr: &seqExpr{
-				pos: position{line: 2457, col: 14, offset: 74664},
+				pos: position{line: 2643, col: 14, offset: 80248},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2457, col: 14, offset: 74664},
+						pos:        position{line: 2643, col: 14, offset: 80248},
 						val:        "left",
 						ignoreCase: true,
 						want:       "\"LEFT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2457, col: 33, offset: 74683},
+						pos: position{line: 2643, col: 33, offset: 80267},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2457, col: 34, offset: 74684},
+							pos:  position{line: 2643, col: 34, offset: 80268},
 							name: "IdentifierRest",
 						},
 					},
@@ -17548,20 +19113,20 @@ var g = &grammar{
 		},
 		{
 			name: "LET",
-			pos:  position{line: 2458, col: 1, offset: 74699},
+			pos:  position{line: 2644, col: 1, offset: 80283},
 			expr: &seqExpr{
-				pos: position{line: 2458, col: 14, offset: 74712},
+				pos: position{line: 2644, col: 14, offset: 80296},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2458, col: 14, offset: 74712},
+						pos:        position{line: 2644, col: 14, offset: 80296},
 						val:        "let",
 						ignoreCase: true,
 						want:       "\"LET\"i",
 					},
 					&notExpr{
-						pos: position{line: 2458, col: 33, offset: 74731},
+						pos: position{line: 2644, col: 33, offset: 80315},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2458, col: 34, offset: 74732},
+							pos:  position{line: 2644, col: 34, offset: 80316},
 							name: "IdentifierRest",
 						},
 					},
@@ -17572,20 +19137,20 @@ var g = &grammar{
 		},
 		{
 			name: "LIKE",
-			pos:  position{line: 2459, col: 1, offset: 74747},
+			pos:  position{line: 2645, col: 1, offset: 80331},
 			expr: &seqExpr{
-				pos: position{line: 2459, col: 14, offset: 74760},
+				pos: position{line: 2645, col: 14, offset: 80344},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2459, col: 14, offset: 74760},
+						pos:        position{line: 2645, col: 14, offset: 80344},
 						val:        "like",
 						ignoreCase: true,
 						want:       "\"LIKE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2459, col: 33, offset: 74779},
+						pos: position{line: 2645, col: 33, offset: 80363},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2459, col: 34, offset: 74780},
+							pos:  position{line: 2645, col: 34, offset: 80364},
 							name: "IdentifierRest",
 						},
 					},
@@ -17596,20 +19161,20 @@ var g = &grammar{
 		},
 		{
 			name: "LIMIT",
-			pos:  position{line: 2460, col: 1, offset: 74795},
+			pos:  position{line: 2646, col: 1, offset: 80379},
 			expr: &seqExpr{
-				pos: position{line: 2460, col: 14, offset: 74808},
+				pos: position{line: 2646, col: 14, offset: 80392},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2460, col: 14, offset: 74808},
+						pos:        position{line: 2646, col: 14, offset: 80392},
 						val:        "limit",
 						ignoreCase: true,
 						want:       "\"LIMIT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2460, col: 33, offset: 74827},
+						pos: position{line: 2646, col: 33, offset: 80411},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2460, col: 34, offset: 74828},
+							pos:  position{line: 2646, col: 34, offset: 80412},
 							name: "IdentifierRest",
 						},
 					},
@@ -17620,20 +19185,20 @@ var g = &grammar{
 		},
 		{
 			name: "LOAD",
-			pos:  position{line: 2461, col: 1, offset: 74843},
+			pos:  position{line: 2647, col: 1, offset: 80427},
 			expr: &seqExpr{
-				pos: position{line: 2461, col: 14, offset: 74856},
+				pos: position{line: 2647, col: 14, offset: 80440},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2461, col: 14, offset: 74856},
+						pos:        position{line: 2647, col: 14, offset: 80440},
 						val:        "load",
 						ignoreCase: true,
 						want:       "\"LOAD\"i",
 					},
 					&notExpr{
-						pos: position{line: 2461, col: 33, offset: 74875},
+						pos: position{line: 2647, col: 33, offset: 80459},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2461, col: 34, offset: 74876},
+							pos:  position{line: 2647, col: 34, offset: 80460},
 							name: "IdentifierRest",
 						},
 					},
@@ -17644,20 +19209,20 @@ var g = &grammar{
 		},
 		{
 			name: "MATERIALIZED",
-			pos:  position{line: 2462, col: 1, offset: 74891},
+			pos:  position{line: 2648, col: 1, offset: 80475},
 			expr: &seqExpr{
-				pos: position{line: 2462, col: 16, offset: 74906},
+				pos: position{line: 2648, col: 16, offset: 80490},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2462, col: 16, offset: 74906},
+						pos:        position{line: 2648, col: 16, offset: 80490},
 						val:        "materialized",
 						ignoreCase: true,
 						want:       "\"MATERIALIZED\"i",
 					},
 					&notExpr{
-						pos: position{line: 2462, col: 33, offset: 74923},
+						pos: position{line: 2648, col: 33, offset: 80507},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2462, col: 34, offset: 74924},
+							pos:  position{line: 2648, col: 34, offset: 80508},
 							name: "IdentifierRest",
 						},
 					},
@@ -17668,20 +19233,20 @@ var g = &grammar{
 		},
 		{
 			name: "MAP",
-			pos:  position{line: 2463, col: 1, offset: 74939},
+			pos:  position{line: 2649, col: 1, offset: 80523},
 			expr: &seqExpr{
-				pos: position{line: 2463, col: 14, offset: 74952},
+				pos: position{line: 2649, col: 14, offset: 80536},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2463, col: 14, offset: 74952},
+						pos:        position{line: 2649, col: 14, offset: 80536},
 						val:        "map",
 						ignoreCase: true,
 						want:       "\"MAP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2463, col: 33, offset: 74971},
+						pos: position{line: 2649, col: 33, offset: 80555},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2463, col: 34, offset: 74972},
+							pos:  position{line: 2649, col: 34, offset: 80556},
 							name: "IdentifierRest",
 						},
 					},
@@ -17692,20 +19257,20 @@ var g = &grammar{
 		},
 		{
 			name: "MERGE",
-			pos:  position{line: 2464, col: 1, offset: 74987},
+			pos:  position{line: 2650, col: 1, offset: 80571},
 			expr: &seqExpr{
-				pos: position{line: 2464, col: 14, offset: 75000},
+				pos: position{line: 2650, col: 14, offset: 80584},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2464, col: 14, offset: 75000},
+						pos:        position{line: 2650, col: 14, offset: 80584},
 						val:        "merge",
 						ignoreCase: true,
 						want:       "\"MERGE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2464, col: 33, offset: 75019},
+						pos: position{line: 2650, col: 33, offset: 80603},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2464, col: 34, offset: 75020},
+							pos:  position{line: 2650, col: 34, offset: 80604},
 							name: "IdentifierRest",
 						},
 					},
@@ -17716,20 +19281,20 @@ var g = &grammar{
 		},
 		{
 			name: "NOT",
-			pos:  position{line: 2465, col: 1, offset: 75035},
+			pos:  position{line: 2651, col: 1, offset: 80619},
 			expr: &seqExpr{
-				pos: position{line: 2465, col: 14, offset: 75048},
+				pos: position{line: 2651, col: 14, offset: 80632},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2465, col: 14, offset: 75048},
+						pos:        position{line: 2651, col: 14, offset: 80632},
 						val:        "not",
 						ignoreCase: true,
 						want:       "\"NOT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2465, col: 33, offset: 75067},
+						pos: position{line: 2651, col: 33, offset: 80651},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2465, col: 34, offset: 75068},
+							pos:  position{line: 2651, col: 34, offset: 80652},
 							name: "IdentifierRest",
 						},
 					},
@@ -17740,20 +19305,20 @@ var g = &grammar{
 		},
 		{
 			name: "NULL",
-			pos:  position{line: 2466, col: 1, offset: 75083},
+			pos:  position{line: 2652, col: 1, offset: 80667},
 			expr: &seqExpr{
-				pos: position{line: 2466, col: 14, offset: 75096},
+				pos: position{line: 2652, col: 14, offset: 80680},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2466, col: 14, offset: 75096},
+						pos:        position{line: 2652, col: 14, offset: 80680},
 						val:        "null",
 						ignoreCase: true,
 						want:       "\"NULL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2466, col: 33, offset: 75115},
+						pos: position{line: 2652, col: 33, offset: 80699},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2466, col: 34, offset: 75116},
+							pos:  position{line: 2652, col: 34, offset: 80700},
 							name: "IdentifierRest",
 						},
 					},
@@ -17764,20 +19329,20 @@ var g = &grammar{
 		},
 		{
 			name: "NULLS",
-			pos:  position{line: 2467, col: 1, offset: 75131},
+			pos:  position{line: 2653, col: 1, offset: 80715},
 			expr: &seqExpr{
-				pos: position{line: 2467, col: 14, offset: 75144},
+				pos: position{line: 2653, col: 14, offset: 80728},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2467, col: 14, offset: 75144},
+						pos:        position{line: 2653, col: 14, offset: 80728},
 						val:        "nulls",
 						ignoreCase: true,
 						want:       "\"NULLS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2467, col: 33, offset: 75163},
+						pos: position{line: 2653, col: 33, offset: 80747},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2467, col: 34, offset: 75164},
+							pos:  position{line: 2653, col: 34, offset: 80748},
 							name: "IdentifierRest",
 						},
 					},
@@ -17788,20 +19353,20 @@ var g = &grammar{
 		},
 		{
 			name: "OFFSET",
-			pos:  position{line: 2468, col: 1, offset: 75179},
+			pos:  position{line: 2654, col: 1, offset: 80763},
 			expr: &seqExpr{
-				pos: position{line: 2468, col: 14, offset: 75192},
+				pos: position{line: 2654, col: 14, offset: 80776},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2468, col: 14, offset: 75192},
+						pos:        position{line: 2654, col: 14, offset: 80776},
 						val:        "offset",
 						ignoreCase: true,
 						want:       "\"OFFSET\"i",
 					},
 					&notExpr{
-						pos: position{line: 2468, col: 33, offset: 75211},
+						pos: position{line: 2654, col: 33, offset: 80795},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2468, col: 34, offset: 75212},
+							pos:  position{line: 2654, col: 34, offset: 80796},
 							name: "IdentifierRest",
 						},
 					},
@@ -17812,20 +19377,20 @@ var g = &grammar{
 		},
 		{
 			name: "ON",
-			pos:  position{line: 2469, col: 1, offset: 75227},
+			pos:  position{line: 2655, col: 1, offset: 80811},
 			expr: &seqExpr{
-				pos: position{line: 2469, col: 14, offset: 75240},
+				pos: position{line: 2655, col: 14, offset: 80824},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2469, col: 14, offset: 75240},
+						pos:        position{line: 2655, col: 14, offset: 80824},
 						val:        "on",
 						ignoreCase: true,
 						want:       "\"ON\"i",
 					},
 					&notExpr{
-						pos: position{line: 2469, col: 33, offset: 75259},
+						pos: position{line: 2655, col: 33, offset: 80843},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2469, col: 34, offset: 75260},
+							pos:  position{line: 2655, col: 34, offset: 80844},
 							name: "IdentifierRest",
 						},
 					},
@@ -17836,20 +19401,20 @@ var g = &grammar{
 		},
 		{
 			name: "OP",
-			pos:  position{line: 2470, col: 1, offset: 75275},
+			pos:  position{line: 2656, col: 1, offset: 80859},
 			expr: &seqExpr{
-				pos: position{line: 2470, col: 14, offset: 75288},
+				pos: position{line: 2656, col: 14, offset: 80872},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2470, col: 14, offset: 75288},
+						pos:        position{line: 2656, col: 14, offset: 80872},
 						val:        "op",
 						ignoreCase: true,
 						want:       "\"OP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2470, col: 33, offset: 75307},
+						pos: position{line: 2656, col: 33, offset: 80891},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2470, col: 34, offset: 75308},
+							pos:  position{line: 2656, col: 34, offset: 80892},
 							name: "IdentifierRest",
 						},
 					},
@@ -17860,23 +19425,23 @@ var g = &grammar{
 		},
 		{
 			name: "OR",
-			pos:  position{line: 2471, col: 1, offset: 75323},
+			pos:  position{line: 2657, col: 1, offset: 80907},
 			expr: &actionExpr{
-				pos: position{line: 2471, col: 14, offset: 75336},
+				pos: position{line: 2657, col: 14, offset: 80920},
 				run: (*parser).callonOR1,
 				expr: &seqExpr{
-					pos: position{line: 2471, col: 14, offset: 75336},
+					pos: position{line: 2657, col: 14, offset: 80920},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2471, col: 14, offset: 75336},
+							pos:        position{line: 2657, col: 14, offset: 80920},
 							val:        "or",
 							ignoreCase: true,
 							want:       "\"OR\"i",
 						},
 						&notExpr{
-							pos: position{line: 2471, col: 33, offset: 75355},
+							pos: position{line: 2657, col: 33, offset: 80939},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2471, col: 34, offset: 75356},
+								pos:  position{line: 2657, col: 34, offset: 80940},
 								name: "IdentifierRest",
 							},
 						},
@@ -17888,20 +19453,20 @@ var g = &grammar{
 		},
 		{
 			name: "ORDER",
-			pos:  position{line: 2472, col: 1, offset: 75392},
+			pos:  position{line: 2658, col: 1, offset: 80976},
 			expr: &seqExpr{
-				pos: position{line: 2472, col: 14, offset: 75405},
+				pos: position{line: 2658, col: 14, offset: 80989},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2472, col: 14, offset: 75405},
+						pos:        position{line: 2658, col: 14, offset: 80989},
 						val:        "order",
 						ignoreCase: true,
 						want:       "\"ORDER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2472, col: 33, offset: 75424},
+						pos: position{line: 2658, col: 33, offset: 81008},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2472, col: 34, offset: 75425},
+							pos:  position{line: 2658, col: 34, offset: 81009},
 							name: "IdentifierRest",
 						},
 					},
@@ -17912,20 +19477,20 @@ var g = &grammar{
 		},
 		{
 			name: "ORDINALITY",
-			pos:  position{line: 2473, col: 1, offset: 75440},
+			pos:  position{line: 2659, col: 1, offset: 81024},
 			expr: &seqExpr{
-				pos: position{line: 2473, col: 14, offset: 75453},
+				pos: position{line: 2659, col: 14, offset: 81037},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2473, col: 14, offset: 75453},
+						pos:        position{line: 2659, col: 14, offset: 81037},
 						val:        "ordinality",
 						ignoreCase: true,
 						want:       "\"ORDINALITY\"i",
 					},
 					&notExpr{
-						pos: position{line: 2473, col: 33, offset: 75472},
+						pos: position{line: 2659, col: 33, offset: 81056},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2473, col: 34, offset: 75473},
+							pos:  position{line: 2659, col: 34, offset: 81057},
 							name: "IdentifierRest",
 						},
 					},
@@ -17936,20 +19501,20 @@ var g = &grammar{
 		},
 		{
 			name: "OUTER",
-			pos:  position{line: 2474, col: 1, offset: 75488},
+			pos:  position{line: 2660, col: 1, offset: 81072},
 			expr: &seqExpr{
-				pos: position{line: 2474, col: 14, offset: 75501},
+				pos: position{line: 2660, col: 14, offset: 81085},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2474, col: 14, offset: 75501},
+						pos:        position{line: 2660, col: 14, offset: 81085},
 						val:        "outer",
 						ignoreCase: true,
 						want:       "\"OUTER\"i",
 					},
 					&notExpr{
-						pos: position{line: 2474, col: 33, offset: 75520},
+						pos: position{line: 2660, col: 33, offset: 81104},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2474, col: 34, offset: 75521},
+							pos:  position{line: 2660, col: 34, offset: 81105},
 							name: "IdentifierRest",
 						},
 					},
@@ -17960,20 +19525,68 @@ var g = &grammar{
 		},
 		{
 			name: "OUTPUT",
-			pos:  position{line: 2475, col: 1, offset: 75536},
+			pos:  position{line: 2661, col: 1, offset: 81120},
 			expr: &seqExpr{
-				pos: position{line: 2475, col: 14, offset: 75549},
+				pos: position{line: 2661, col: 14, offset: 81133},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2475, col: 14, offset: 75549},
+						pos:        position{line: 2661, col: 14, offset: 81133},
 						val:        "output",
 						ignoreCase: true,
 						want:       "\"OUTPUT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2475, col: 33, offset: 75568},
+						pos: position{line: 2661, col: 33, offset: 81152},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2661, col: 34, offset: 81153},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "OVER",
+			pos:  position{line: 2662, col: 1, offset: 81168},
+			expr: &seqExpr{
+				pos: position{line: 2662, col: 14, offset: 81181},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2662, col: 14, offset: 81181},
+						val:        "over",
+						ignoreCase: true,
+						want:       "\"OVER\"i",
+					},
+					&notExpr{
+						pos: position{line: 2662, col: 33, offset: 81200},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2662, col: 34, offset: 81201},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PARTITION",
+			pos:  position{line: 2663, col: 1, offset: 81216},
+			expr: &seqExpr{
+				pos: position{line: 2663, col: 14, offset: 81229},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2663, col: 14, offset: 81229},
+						val:        "partition",
+						ignoreCase: true,
+						want:       "\"PARTITION\"i",
+					},
+					&notExpr{
+						pos: position{line: 2663, col: 33, offset: 81248},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2475, col: 34, offset: 75569},
+							pos:  position{line: 2663, col: 34, offset: 81249},
 							name: "IdentifierRest",
 						},
 					},
@@ -17984,20 +19597,20 @@ var g = &grammar{
 		},
 		{
 			name: "PASS",
-			pos:  position{line: 2476, col: 1, offset: 75584},
+			pos:  position{line: 2664, col: 1, offset: 81264},
 			expr: &seqExpr{
-				pos: position{line: 2476, col: 14, offset: 75597},
+				pos: position{line: 2664, col: 14, offset: 81277},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2476, col: 14, offset: 75597},
+						pos:        position{line: 2664, col: 14, offset: 81277},
 						val:        "pass",
 						ignoreCase: true,
 						want:       "\"PASS\"i",
 					},
 					&notExpr{
-						pos: position{line: 2476, col: 33, offset: 75616},
+						pos: position{line: 2664, col: 33, offset: 81296},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2476, col: 34, offset: 75617},
+							pos:  position{line: 2664, col: 34, offset: 81297},
 							name: "IdentifierRest",
 						},
 					},
@@ -18008,20 +19621,20 @@ var g = &grammar{
 		},
 		{
 			name: "PUT",
-			pos:  position{line: 2477, col: 1, offset: 75632},
+			pos:  position{line: 2665, col: 1, offset: 81312},
 			expr: &seqExpr{
-				pos: position{line: 2477, col: 14, offset: 75645},
+				pos: position{line: 2665, col: 14, offset: 81325},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2477, col: 14, offset: 75645},
+						pos:        position{line: 2665, col: 14, offset: 81325},
 						val:        "put",
 						ignoreCase: true,
 						want:       "\"PUT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2477, col: 33, offset: 75664},
+						pos: position{line: 2665, col: 33, offset: 81344},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2477, col: 34, offset: 75665},
+							pos:  position{line: 2665, col: 34, offset: 81345},
 							name: "IdentifierRest",
 						},
 					},
@@ -18032,20 +19645,68 @@ var g = &grammar{
 		},
 		{
 			name: "PRAGMA",
-			pos:  position{line: 2478, col: 1, offset: 75680},
+			pos:  position{line: 2666, col: 1, offset: 81360},
 			expr: &seqExpr{
-				pos: position{line: 2478, col: 14, offset: 75693},
+				pos: position{line: 2666, col: 14, offset: 81373},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2478, col: 14, offset: 75693},
+						pos:        position{line: 2666, col: 14, offset: 81373},
 						val:        "pragma",
 						ignoreCase: true,
 						want:       "\"PRAGMA\"i",
 					},
 					&notExpr{
-						pos: position{line: 2478, col: 33, offset: 75712},
+						pos: position{line: 2666, col: 33, offset: 81392},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2666, col: 34, offset: 81393},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "PRECEDING",
+			pos:  position{line: 2667, col: 1, offset: 81408},
+			expr: &seqExpr{
+				pos: position{line: 2667, col: 14, offset: 81421},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2667, col: 14, offset: 81421},
+						val:        "preceding",
+						ignoreCase: true,
+						want:       "\"PRECEDING\"i",
+					},
+					&notExpr{
+						pos: position{line: 2667, col: 33, offset: 81440},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2667, col: 34, offset: 81441},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "RANGE",
+			pos:  position{line: 2668, col: 1, offset: 81456},
+			expr: &seqExpr{
+				pos: position{line: 2668, col: 14, offset: 81469},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2668, col: 14, offset: 81469},
+						val:        "range",
+						ignoreCase: true,
+						want:       "\"RANGE\"i",
+					},
+					&notExpr{
+						pos: position{line: 2668, col: 33, offset: 81488},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2478, col: 34, offset: 75713},
+							pos:  position{line: 2668, col: 34, offset: 81489},
 							name: "IdentifierRest",
 						},
 					},
@@ -18056,20 +19717,20 @@ var g = &grammar{
 		},
 		{
 			name: "RECURSIVE",
-			pos:  position{line: 2479, col: 1, offset: 75728},
+			pos:  position{line: 2669, col: 1, offset: 81504},
 			expr: &seqExpr{
-				pos: position{line: 2479, col: 14, offset: 75741},
+				pos: position{line: 2669, col: 14, offset: 81517},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2479, col: 14, offset: 75741},
+						pos:        position{line: 2669, col: 14, offset: 81517},
 						val:        "recursive",
 						ignoreCase: true,
 						want:       "\"RECURSIVE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2479, col: 33, offset: 75760},
+						pos: position{line: 2669, col: 33, offset: 81536},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2479, col: 34, offset: 75761},
+							pos:  position{line: 2669, col: 34, offset: 81537},
 							name: "IdentifierRest",
 						},
 					},
@@ -18080,20 +19741,20 @@ var g = &grammar{
 		},
 		{
 			name: "RENAME",
-			pos:  position{line: 2480, col: 1, offset: 75776},
+			pos:  position{line: 2670, col: 1, offset: 81552},
 			expr: &seqExpr{
-				pos: position{line: 2480, col: 14, offset: 75789},
+				pos: position{line: 2670, col: 14, offset: 81565},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2480, col: 14, offset: 75789},
+						pos:        position{line: 2670, col: 14, offset: 81565},
 						val:        "rename",
 						ignoreCase: true,
 						want:       "\"RENAME\"i",
 					},
 					&notExpr{
-						pos: position{line: 2480, col: 33, offset: 75808},
+						pos: position{line: 2670, col: 33, offset: 81584},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2480, col: 34, offset: 75809},
+							pos:  position{line: 2670, col: 34, offset: 81585},
 							name: "IdentifierRest",
 						},
 					},
@@ -18104,20 +19765,92 @@ var g = &grammar{
 		},
 		{
 			name: "RIGHT",
-			pos:  position{line: 2481, col: 1, offset: 75824},
+			pos:  position{line: 2671, col: 1, offset: 81600},
 			expr: &seqExpr{
-				pos: position{line: 2481, col: 14, offset: 75837},
+				pos: position{line: 2671, col: 14, offset: 81613},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2481, col: 14, offset: 75837},
+						pos:        position{line: 2671, col: 14, offset: 81613},
 						val:        "right",
 						ignoreCase: true,
 						want:       "\"RIGHT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2481, col: 33, offset: 75856},
+						pos: position{line: 2671, col: 33, offset: 81632},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2671, col: 34, offset: 81633},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ROLLUP",
+			pos:  position{line: 2672, col: 1, offset: 81648},
+			expr: &seqExpr{
+				pos: position{line: 2672, col: 14, offset: 81661},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2672, col: 14, offset: 81661},
+						val:        "rollup",
+						ignoreCase: true,
+						want:       "\"ROLLUP\"i",
+					},
+					&notExpr{
+						pos: position{line: 2672, col: 33, offset: 81680},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2672, col: 34, offset: 81681},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ROW",
+			pos:  position{line: 2673, col: 1, offset: 81696},
+			expr: &seqExpr{
+				pos: position{line: 2673, col: 14, offset: 81709},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2673, col: 14, offset: 81709},
+						val:        "row",
+						ignoreCase: true,
+						want:       "\"ROW\"i",
+					},
+					&notExpr{
+						pos: position{line: 2673, col: 33, offset: 81728},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2673, col: 34, offset: 81729},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "ROWS",
+			pos:  position{line: 2674, col: 1, offset: 81744},
+			expr: &seqExpr{
+				pos: position{line: 2674, col: 14, offset: 81757},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2674, col: 14, offset: 81757},
+						val:        "rows",
+						ignoreCase: true,
+						want:       "\"ROWS\"i",
+					},
+					&notExpr{
+						pos: position{line: 2674, col: 33, offset: 81776},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2481, col: 34, offset: 75857},
+							pos:  position{line: 2674, col: 34, offset: 81777},
 							name: "IdentifierRest",
 						},
 					},
@@ -18128,20 +19861,20 @@ var g = &grammar{
 		},
 		{
 			name: "SHAPES",
-			pos:  position{line: 2482, col: 1, offset: 75872},
+			pos:  position{line: 2675, col: 1, offset: 81792},
 			expr: &seqExpr{
-				pos: position{line: 2482, col: 14, offset: 75885},
+				pos: position{line: 2675, col: 14, offset: 81805},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2482, col: 14, offset: 75885},
+						pos:        position{line: 2675, col: 14, offset: 81805},
 						val:        "shapes",
 						ignoreCase: true,
 						want:       "\"SHAPES\"i",
 					},
 					&notExpr{
-						pos: position{line: 2482, col: 33, offset: 75904},
+						pos: position{line: 2675, col: 33, offset: 81824},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2482, col: 34, offset: 75905},
+							pos:  position{line: 2675, col: 34, offset: 81825},
 							name: "IdentifierRest",
 						},
 					},
@@ -18152,20 +19885,20 @@ var g = &grammar{
 		},
 		{
 			name: "SEARCH",
-			pos:  position{line: 2483, col: 1, offset: 75920},
+			pos:  position{line: 2676, col: 1, offset: 81840},
 			expr: &seqExpr{
-				pos: position{line: 2483, col: 14, offset: 75933},
+				pos: position{line: 2676, col: 14, offset: 81853},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2483, col: 14, offset: 75933},
+						pos:        position{line: 2676, col: 14, offset: 81853},
 						val:        "search",
 						ignoreCase: true,
 						want:       "\"SEARCH\"i",
 					},
 					&notExpr{
-						pos: position{line: 2483, col: 33, offset: 75952},
+						pos: position{line: 2676, col: 33, offset: 81872},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2483, col: 34, offset: 75953},
+							pos:  position{line: 2676, col: 34, offset: 81873},
 							name: "IdentifierRest",
 						},
 					},
@@ -18176,20 +19909,44 @@ var g = &grammar{
 		},
 		{
 			name: "SELECT",
-			pos:  position{line: 2484, col: 1, offset: 75968},
+			pos:  position{line: 2677, col: 1, offset: 81888},
 			expr: &seqExpr{
-				pos: position{line: 2484, col: 14, offset: 75981},
+				pos: position{line: 2677, col: 14, offset: 81901},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2484, col: 14, offset: 75981},
+						pos:        position{line: 2677, col: 14, offset: 81901},
 						val:        "select",
 						ignoreCase: true,
 						want:       "\"SELECT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2484, col: 33, offset: 76000},
+						pos: position{line: 2677, col: 33, offset: 81920},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2677, col: 34, offset: 81921},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "SETS",
+			pos:  position{line: 2678, col: 1, offset: 81936},
+			expr: &seqExpr{
+				pos: position{line: 2678, col: 14, offset: 81949},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2678, col: 14, offset: 81949},
+						val:        "sets",
+						ignoreCase: true,
+						want:       "\"SETS\"i",
+					},
+					&notExpr{
+						pos: position{line: 2678, col: 33, offset: 81968},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2484, col: 34, offset: 76001},
+							pos:  position{line: 2678, col: 34, offset: 81969},
 							name: "IdentifierRest",
 						},
 					},
@@ -18200,20 +19957,20 @@ var g = &grammar{
 		},
 		{
 			name: "SHAPE",
-			pos:  position{line: 2485, col: 1, offset: 76016},
+			pos:  position{line: 2679, col: 1, offset: 81984},
 			expr: &seqExpr{
-				pos: position{line: 2485, col: 14, offset: 76029},
+				pos: position{line: 2679, col: 14, offset: 81997},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2485, col: 14, offset: 76029},
+						pos:        position{line: 2679, col: 14, offset: 81997},
 						val:        "shape",
 						ignoreCase: true,
 						want:       "\"SHAPE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2485, col: 33, offset: 76048},
+						pos: position{line: 2679, col: 33, offset: 82016},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2485, col: 34, offset: 76049},
+							pos:  position{line: 2679, col: 34, offset: 82017},
 							name: "IdentifierRest",
 						},
 					},
@@ -18224,20 +19981,20 @@ var g = &grammar{
 		},
 		{
 			name: "SKIP",
-			pos:  position{line: 2486, col: 1, offset: 76064},
+			pos:  position{line: 2680, col: 1, offset: 82032},
 			expr: &seqExpr{
-				pos: position{line: 2486, col: 14, offset: 76077},
+				pos: position{line: 2680, col: 14, offset: 82045},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2486, col: 14, offset: 76077},
+						pos:        position{line: 2680, col: 14, offset: 82045},
 						val:        "skip",
 						ignoreCase: true,
 						want:       "\"SKIP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2486, col: 33, offset: 76096},
+						pos: position{line: 2680, col: 33, offset: 82064},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2486, col: 34, offset: 76097},
+							pos:  position{line: 2680, col: 34, offset: 82065},
 							name: "IdentifierRest",
 						},
 					},
@@ -18248,20 +20005,20 @@ var g = &grammar{
 		},
 		{
 			name: "SORT",
-			pos:  position{line: 2487, col: 1, offset: 76112},
+			pos:  position{line: 2681, col: 1, offset: 82080},
 			expr: &seqExpr{
-				pos: position{line: 2487, col: 14, offset: 76125},
+				pos: position{line: 2681, col: 14, offset: 82093},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2487, col: 14, offset: 76125},
+						pos:        position{line: 2681, col: 14, offset: 82093},
 						val:        "sort",
 						ignoreCase: true,
 						want:       "\"SORT\"i",
 					},
 					&notExpr{
-						pos: position{line: 2487, col: 33, offset: 76144},
+						pos: position{line: 2681, col: 33, offset: 82112},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2487, col: 34, offset: 76145},
+							pos:  position{line: 2681, col: 34, offset: 82113},
 							name: "IdentifierRest",
 						},
 					},
@@ -18272,20 +20029,20 @@ var g = &grammar{
 		},
 		{
 			name: "SUBSTRING",
-			pos:  position{line: 2488, col: 1, offset: 76160},
+			pos:  position{line: 2682, col: 1, offset: 82128},
 			expr: &seqExpr{
-				pos: position{line: 2488, col: 14, offset: 76173},
+				pos: position{line: 2682, col: 14, offset: 82141},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2488, col: 14, offset: 76173},
+						pos:        position{line: 2682, col: 14, offset: 82141},
 						val:        "substring",
 						ignoreCase: true,
 						want:       "\"SUBSTRING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2488, col: 33, offset: 76192},
+						pos: position{line: 2682, col: 33, offset: 82160},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2488, col: 34, offset: 76193},
+							pos:  position{line: 2682, col: 34, offset: 82161},
 							name: "IdentifierRest",
 						},
 					},
@@ -18296,20 +20053,20 @@ var g = &grammar{
 		},
 		{
 			name: "SUMMARIZE",
-			pos:  position{line: 2489, col: 1, offset: 76208},
+			pos:  position{line: 2683, col: 1, offset: 82176},
 			expr: &seqExpr{
-				pos: position{line: 2489, col: 14, offset: 76221},
+				pos: position{line: 2683, col: 14, offset: 82189},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2489, col: 14, offset: 76221},
+						pos:        position{line: 2683, col: 14, offset: 82189},
 						val:        "summarize",
 						ignoreCase: true,
 						want:       "\"SUMMARIZE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2489, col: 33, offset: 76240},
+						pos: position{line: 2683, col: 33, offset: 82208},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2489, col: 34, offset: 76241},
+							pos:  position{line: 2683, col: 34, offset: 82209},
 							name: "IdentifierRest",
 						},
 					},
@@ -18320,20 +20077,20 @@ var g = &grammar{
 		},
 		{
 			name: "SWITCH",
-			pos:  position{line: 2490, col: 1, offset: 76256},
+			pos:  position{line: 2684, col: 1, offset: 82224},
 			expr: &seqExpr{
-				pos: position{line: 2490, col: 14, offset: 76269},
+				pos: position{line: 2684, col: 14, offset: 82237},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2490, col: 14, offset: 76269},
+						pos:        position{line: 2684, col: 14, offset: 82237},
 						val:        "switch",
 						ignoreCase: true,
 						want:       "\"SWITCH\"i",
 					},
 					&notExpr{
-						pos: position{line: 2490, col: 33, offset: 76288},
+						pos: position{line: 2684, col: 33, offset: 82256},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2490, col: 34, offset: 76289},
+							pos:  position{line: 2684, col: 34, offset: 82257},
 							name: "IdentifierRest",
 						},
 					},
@@ -18344,20 +20101,20 @@ var g = &grammar{
 		},
 		{
 			name: "TAIL",
-			pos:  position{line: 2491, col: 1, offset: 76304},
+			pos:  position{line: 2685, col: 1, offset: 82272},
 			expr: &seqExpr{
-				pos: position{line: 2491, col: 14, offset: 76317},
+				pos: position{line: 2685, col: 14, offset: 82285},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2491, col: 14, offset: 76317},
+						pos:        position{line: 2685, col: 14, offset: 82285},
 						val:        "tail",
 						ignoreCase: true,
 						want:       "\"TAIL\"i",
 					},
 					&notExpr{
-						pos: position{line: 2491, col: 33, offset: 76336},
+						pos: position{line: 2685, col: 33, offset: 82304},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2491, col: 34, offset: 76337},
+							pos:  position{line: 2685, col: 34, offset: 82305},
 							name: "IdentifierRest",
 						},
 					},
@@ -18368,20 +20125,20 @@ var g = &grammar{
 		},
 		{
 			name: "THEN",
-			pos:  position{line: 2492, col: 1, offset: 76352},
+			pos:  position{line: 2686, col: 1, offset: 82320},
 			expr: &seqExpr{
-				pos: position{line: 2492, col: 14, offset: 76365},
+				pos: position{line: 2686, col: 14, offset: 82333},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2492, col: 14, offset: 76365},
+						pos:        position{line: 2686, col: 14, offset: 82333},
 						val:        "then",
 						ignoreCase: true,
 						want:       "\"THEN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2492, col: 33, offset: 76384},
+						pos: position{line: 2686, col: 33, offset: 82352},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2492, col: 34, offset: 76385},
+							pos:  position{line: 2686, col: 34, offset: 82353},
 							name: "IdentifierRest",
 						},
 					},
@@ -18392,23 +20149,23 @@ var g = &grammar{
 		},
 		{
 			name: "TIMESTAMP",
-			pos:  position{line: 2493, col: 1, offset: 76400},
+			pos:  position{line: 2687, col: 1, offset: 82368},
 			expr: &actionExpr{
-				pos: position{line: 2493, col: 14, offset: 76413},
+				pos: position{line: 2687, col: 14, offset: 82381},
 				run: (*parser).callonTIMESTAMP1,
 				expr: &seqExpr{
-					pos: position{line: 2493, col: 14, offset: 76413},
+					pos: position{line: 2687, col: 14, offset: 82381},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 2493, col: 14, offset: 76413},
+							pos:        position{line: 2687, col: 14, offset: 82381},
 							val:        "timestamp",
 							ignoreCase: true,
 							want:       "\"TIMESTAMP\"i",
 						},
 						&notExpr{
-							pos: position{line: 2493, col: 33, offset: 76432},
+							pos: position{line: 2687, col: 33, offset: 82400},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2493, col: 34, offset: 76433},
+								pos:  position{line: 2687, col: 34, offset: 82401},
 								name: "IdentifierRest",
 							},
 						},
@@ -18420,20 +20177,20 @@ var g = &grammar{
 		},
 		{
 			name: "TOP",
-			pos:  position{line: 2494, col: 1, offset: 76476},
+			pos:  position{line: 2688, col: 1, offset: 82444},
 			expr: &seqExpr{
-				pos: position{line: 2494, col: 14, offset: 76489},
+				pos: position{line: 2688, col: 14, offset: 82457},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2494, col: 14, offset: 76489},
+						pos:        position{line: 2688, col: 14, offset: 82457},
 						val:        "top",
 						ignoreCase: true,
 						want:       "\"TOP\"i",
 					},
 					&notExpr{
-						pos: position{line: 2494, col: 33, offset: 76508},
+						pos: position{line: 2688, col: 33, offset: 82476},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2494, col: 34, offset: 76509},
+							pos:  position{line: 2688, col: 34, offset: 82477},
 							name: "IdentifierRest",
 						},
 					},
@@ -18444,20 +20201,20 @@ var g = &grammar{
 		},
 		{
 			name: "TRUE",
-			pos:  position{line: 2495, col: 1, offset: 76524},
+			pos:  position{line: 2689, col: 1, offset: 82492},
 			expr: &seqExpr{
-				pos: position{line: 2495, col: 14, offset: 76537},
+				pos: position{line: 2689, col: 14, offset: 82505},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2495, col: 14, offset: 76537},
+						pos:        position{line: 2689, col: 14, offset: 82505},
 						val:        "true",
 						ignoreCase: true,
 						want:       "\"TRUE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2495, col: 33, offset: 76556},
+						pos: position{line: 2689, col: 33, offset: 82524},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2495, col: 34, offset: 76557},
+							pos:  position{line: 2689, col: 34, offset: 82525},
 							name: "IdentifierRest",
 						},
 					},
@@ -18468,20 +20225,44 @@ var g = &grammar{
 		},
 		{
 			name: "TYPE",
-			pos:  position{line: 2496, col: 1, offset: 76572},
+			pos:  position{line: 2690, col: 1, offset: 82540},
 			expr: &seqExpr{
-				pos: position{line: 2496, col: 14, offset: 76585},
+				pos: position{line: 2690, col: 14, offset: 82553},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2496, col: 14, offset: 76585},
+						pos:        position{line: 2690, col: 14, offset: 82553},
 						val:        "type",
 						ignoreCase: true,
 						want:       "\"TYPE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2496, col: 33, offset: 76604},
+						pos: position{line: 2690, col: 33, offset: 82572},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2690, col: 34, offset: 82573},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "UNBOUNDED",
+			pos:  position{line: 2691, col: 1, offset: 82588},
+			expr: &seqExpr{
+				pos: position{line: 2691, col: 14, offset: 82601},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2691, col: 14, offset: 82601},
+						val:        "unbounded",
+						ignoreCase: true,
+						want:       "\"UNBOUNDED\"i",
+					},
+					&notExpr{
+						pos: position{line: 2691, col: 33, offset: 82620},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2496, col: 34, offset: 76605},
+							pos:  position{line: 2691, col: 34, offset: 82621},
 							name: "IdentifierRest",
 						},
 					},
@@ -18492,20 +20273,20 @@ var g = &grammar{
 		},
 		{
 			name: "UNION",
-			pos:  position{line: 2497, col: 1, offset: 76620},
+			pos:  position{line: 2692, col: 1, offset: 82636},
 			expr: &seqExpr{
-				pos: position{line: 2497, col: 14, offset: 76633},
+				pos: position{line: 2692, col: 14, offset: 82649},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2497, col: 14, offset: 76633},
+						pos:        position{line: 2692, col: 14, offset: 82649},
 						val:        "union",
 						ignoreCase: true,
 						want:       "\"UNION\"i",
 					},
 					&notExpr{
-						pos: position{line: 2497, col: 33, offset: 76652},
+						pos: position{line: 2692, col: 33, offset: 82668},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2497, col: 34, offset: 76653},
+							pos:  position{line: 2692, col: 34, offset: 82669},
 							name: "IdentifierRest",
 						},
 					},
@@ -18516,20 +20297,20 @@ var g = &grammar{
 		},
 		{
 			name: "UNIQ",
-			pos:  position{line: 2498, col: 1, offset: 76668},
+			pos:  position{line: 2693, col: 1, offset: 82684},
 			expr: &seqExpr{
-				pos: position{line: 2498, col: 14, offset: 76681},
+				pos: position{line: 2693, col: 14, offset: 82697},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2498, col: 14, offset: 76681},
+						pos:        position{line: 2693, col: 14, offset: 82697},
 						val:        "uniq",
 						ignoreCase: true,
 						want:       "\"UNIQ\"i",
 					},
 					&notExpr{
-						pos: position{line: 2498, col: 33, offset: 76700},
+						pos: position{line: 2693, col: 33, offset: 82716},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2498, col: 34, offset: 76701},
+							pos:  position{line: 2693, col: 34, offset: 82717},
 							name: "IdentifierRest",
 						},
 					},
@@ -18540,20 +20321,20 @@ var g = &grammar{
 		},
 		{
 			name: "UNNEST",
-			pos:  position{line: 2499, col: 1, offset: 76716},
+			pos:  position{line: 2694, col: 1, offset: 82732},
 			expr: &seqExpr{
-				pos: position{line: 2499, col: 14, offset: 76729},
+				pos: position{line: 2694, col: 14, offset: 82745},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2499, col: 14, offset: 76729},
+						pos:        position{line: 2694, col: 14, offset: 82745},
 						val:        "unnest",
 						ignoreCase: true,
 						want:       "\"UNNEST\"i",
 					},
 					&notExpr{
-						pos: position{line: 2499, col: 33, offset: 76748},
+						pos: position{line: 2694, col: 33, offset: 82764},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2499, col: 34, offset: 76749},
+							pos:  position{line: 2694, col: 34, offset: 82765},
 							name: "IdentifierRest",
 						},
 					},
@@ -18564,20 +20345,20 @@ var g = &grammar{
 		},
 		{
 			name: "USING",
-			pos:  position{line: 2500, col: 1, offset: 76764},
+			pos:  position{line: 2695, col: 1, offset: 82780},
 			expr: &seqExpr{
-				pos: position{line: 2500, col: 14, offset: 76777},
+				pos: position{line: 2695, col: 14, offset: 82793},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2500, col: 14, offset: 76777},
+						pos:        position{line: 2695, col: 14, offset: 82793},
 						val:        "using",
 						ignoreCase: true,
 						want:       "\"USING\"i",
 					},
 					&notExpr{
-						pos: position{line: 2500, col: 33, offset: 76796},
+						pos: position{line: 2695, col: 33, offset: 82812},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2500, col: 34, offset: 76797},
+							pos:  position{line: 2695, col: 34, offset: 82813},
 							name: "IdentifierRest",
 						},
 					},
@@ -18588,20 +20369,20 @@ var g = &grammar{
 		},
 		{
 			name: "VALUE",
-			pos:  position{line: 2501, col: 1, offset: 76812},
+			pos:  position{line: 2696, col: 1, offset: 82828},
 			expr: &seqExpr{
-				pos: position{line: 2501, col: 14, offset: 76825},
+				pos: position{line: 2696, col: 14, offset: 82841},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2501, col: 14, offset: 76825},
+						pos:        position{line: 2696, col: 14, offset: 82841},
 						val:        "value",
 						ignoreCase: true,
 						want:       "\"VALUE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2501, col: 33, offset: 76844},
+						pos: position{line: 2696, col: 33, offset: 82860},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2501, col: 34, offset: 76845},
+							pos:  position{line: 2696, col: 34, offset: 82861},
 							name: "IdentifierRest",
 						},
 					},
@@ -18612,20 +20393,20 @@ var g = &grammar{
 		},
 		{
 			name: "VALUES",
-			pos:  position{line: 2502, col: 1, offset: 76860},
+			pos:  position{line: 2697, col: 1, offset: 82876},
 			expr: &seqExpr{
-				pos: position{line: 2502, col: 14, offset: 76873},
+				pos: position{line: 2697, col: 14, offset: 82889},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2502, col: 14, offset: 76873},
+						pos:        position{line: 2697, col: 14, offset: 82889},
 						val:        "values",
 						ignoreCase: true,
 						want:       "\"VALUES\"i",
 					},
 					&notExpr{
-						pos: position{line: 2502, col: 33, offset: 76892},
+						pos: position{line: 2697, col: 33, offset: 82908},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2502, col: 34, offset: 76893},
+							pos:  position{line: 2697, col: 34, offset: 82909},
 							name: "IdentifierRest",
 						},
 					},
@@ -18636,20 +20417,20 @@ var g = &grammar{
 		},
 		{
 			name: "WHEN",
-			pos:  position{line: 2503, col: 1, offset: 76908},
+			pos:  position{line: 2698, col: 1, offset: 82924},
 			expr: &seqExpr{
-				pos: position{line: 2503, col: 14, offset: 76921},
+				pos: position{line: 2698, col: 14, offset: 82937},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2503, col: 14, offset: 76921},
+						pos:        position{line: 2698, col: 14, offset: 82937},
 						val:        "when",
 						ignoreCase: true,
 						want:       "\"WHEN\"i",
 					},
 					&notExpr{
-						pos: position{line: 2503, col: 33, offset: 76940},
+						pos: position{line: 2698, col: 33, offset: 82956},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2503, col: 34, offset: 76941},
+							pos:  position{line: 2698, col: 34, offset: 82957},
 							name: "IdentifierRest",
 						},
 					},
@@ -18660,20 +20441,44 @@ var g = &grammar{
 		},
 		{
 			name: "WHERE",
-			pos:  position{line: 2504, col: 1, offset: 76956},
+			pos:  position{line: 2699, col: 1, offset: 82972},
 			expr: &seqExpr{
-				pos: position{line: 2504, col: 14, offset: 76969},
+				pos: position{line: 2699, col: 14, offset: 82985},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2504, col: 14, offset: 76969},
+						pos:        position{line: 2699, col: 14, offset: 82985},
 						val:        "where",
 						ignoreCase: true,
 						want:       "\"WHERE\"i",
 					},
 					&notExpr{
-						pos: position{line: 2504, col: 33, offset: 76988},
+						pos: position{line: 2699, col: 33, offset: 83004},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2699, col: 34, offset: 83005},
+							name: "IdentifierRest",
+						},
+					},
+				},
+			},
+			leader:        false,
+			leftRecursive: false,
+		},
+		{
+			name: "WINDOW",
+			pos:  position{line: 2700, col: 1, offset: 83020},
+			expr: &seqExpr{
+				pos: position{line: 2700, col: 14, offset: 83033},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 2700, col: 14, offset: 83033},
+						val:        "window",
+						ignoreCase: true,
+						want:       "\"WINDOW\"i",
+					},
+					&notExpr{
+						pos: position{line: 2700, col: 33, offset: 83052},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2504, col: 34, offset: 76989},
+							pos:  position{line: 2700, col: 34, offset: 83053},
 							name: "IdentifierRest",
 						},
 					},
@@ -18684,20 +20489,20 @@ var g = &grammar{
 		},
 		{
 			name: "WITH",
-			pos:  position{line: 2505, col: 1, offset: 77004},
+			pos:  position{line: 2701, col: 1, offset: 83068},
 			expr: &seqExpr{
-				pos: position{line: 2505, col: 14, offset: 77017},
+				pos: position{line: 2701, col: 14, offset: 83081},
 				exprs: []any{
 					&litMatcher{
-						pos:        position{line: 2505, col: 14, offset: 77017},
+						pos:        position{line: 2701, col: 14, offset: 83081},
 						val:        "with",
 						ignoreCase: true,
 						want:       "\"WITH\"i",
 					},
 					&notExpr{
-						pos: position{line: 2505, col: 33, offset: 77036},
+						pos: position{line: 2701, col: 33, offset: 83100},
 						expr: &ruleRefExpr{
-							pos:  position{line: 2505, col: 34, offset: 77037},
+							pos:  position{line: 2701, col: 34, offset: 83101},
 							name: "IdentifierRest",
 						},
 					},
@@ -18709,14 +20514,18 @@ var g = &grammar{
 	},
 }
 
-func (c *current) onstart1(q any) (any, error) {
+func (c *current) onstart1(explain, q any) (any, error) {
+	if explain != nil {
+		setExplain(c)
+	}
 	return q, nil
+
 }
 
 func (p *parser) callonstart1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onstart1(stack["q"])
+	return p.cur.onstart1(stack["explain"], stack["q"])
 }
 
 func (c *current) onQuery2(scope any) (any, error) {
@@ -19873,21 +21682,108 @@ func (c *current) onUniqOp2() (any, error) {
 
 }
 
-func (p *parser) callonUniqOp2() (any, error) {
+func (p *parser) callonUniqOp2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUniqOp2()
+}
+
+func (c *current) onUniqOp7() (any, error) {
+	return &ast.UniqOp{Kind: "UniqOp", Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonUniqOp7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUniqOp7()
+}
+
+func (c *current) onWindowOp9(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonWindowOp9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOp9(stack["e"])
+}
+
+func (c *current) onWindowOp1(funcs, keys any) (any, error) {
+	op := &ast.WindowOp{
+		Kind:  "WindowOp",
+		Funcs: sliceOf[ast.Assignment](funcs),
+		Loc:   loc(c),
+	}
+	if keys != nil {
+		op.Keys = sliceOf[ast.Expr](keys)
+	}
+	return op, nil
+
+}
+
+func (p *parser) callonWindowOp1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowOp1(stack["funcs"], stack["keys"])
+}
+
+func (c *current) onWindowAssignments7(a any) (any, error) {
+	return a, nil
+}
+
+func (p *parser) callonWindowAssignments7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowAssignments7(stack["a"])
+}
+
+func (c *current) onWindowAssignments1(first, rest any) (any, error) {
+	return prepend(first, rest), nil
+
+}
+
+func (p *parser) callonWindowAssignments1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowAssignments1(stack["first"], stack["rest"])
+}
+
+func (c *current) onWindowAssignment2(lval, f any) (any, error) {
+	return ast.Assignment{LHS: lval.(ast.Expr), RHS: f.(ast.Expr), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonWindowAssignment2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowAssignment2(stack["lval"], stack["f"])
+}
+
+func (c *current) onWindowAssignment11(f, lhs any) (any, error) {
+	a := ast.Assignment{RHS: f.(ast.Expr), Loc: loc(c)}
+	if lhs != nil {
+		a.LHS = lhs.(ast.Expr)
+	}
+	return a, nil
+
+}
+
+func (p *parser) callonWindowAssignment11() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUniqOp2()
+	return p.cur.onWindowAssignment11(stack["f"], stack["lhs"])
 }
 
-func (c *current) onUniqOp7() (any, error) {
-	return &ast.UniqOp{Kind: "UniqOp", Loc: loc(c)}, nil
+func (c *current) onWindowCall2(f, args any) (any, error) {
+	return newCall(c, f, args), nil
 
 }
 
-func (p *parser) callonUniqOp7() (any, error) {
+func (p *parser) callonWindowCall2() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onUniqOp7()
+	return p.cur.onWindowCall2(stack["f"], stack["args"])
 }
 
 func (c *current) onPutOp1(args any) (any, error) {
@@ -21112,15 +23008,230 @@ func (p *parser) callonFunction44() (any, error) {
 	return p.cur.onFunction44(stack["expr"], stack["from"], stack["for_"])
 }
 
-func (c *current) onFunction71(f, args any) (any, error) {
-	return newCall(c, f, args), nil
+func (c *current) onFunction71(f, args, over any) (any, error) {
+	call := newCall(c, f, args)
+	if over != nil {
+		return newWindowExpr(c, call, over), nil
+	}
+	return call, nil
 
 }
 
 func (p *parser) callonFunction71() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFunction71(stack["f"], stack["args"])
+	return p.cur.onFunction71(stack["f"], stack["args"], stack["over"])
+}
+
+func (c *current) onFunction88(agg, over any) (any, error) {
+	if over != nil {
+		return newWindowExpr(c, agg, over), nil
+	}
+	return agg, nil
+
+}
+
+func (p *parser) callonFunction88() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onFunction88(stack["agg"], stack["over"])
+}
+
+func (c *current) onOptOverClause2(spec any) (any, error) {
+	return spec, nil
+}
+
+func (p *parser) callonOptOverClause2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptOverClause2(stack["spec"])
+}
+
+func (c *current) onOptOverClause12(name any) (any, error) {
+	return &ast.WindowExpr{Kind: "WindowExpr", Name: name.(*ast.ID)}, nil
+
+}
+
+func (p *parser) callonOptOverClause12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptOverClause12(stack["name"])
+}
+
+func (c *current) onOptOverClause19() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonOptOverClause19() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptOverClause19()
+}
+
+func (c *current) onWindowSpec5(id any) (any, error) {
+	return id, nil
+}
+
+func (p *parser) callonWindowSpec5() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec5(stack["id"])
+}
+
+func (c *current) onWindowSpec14(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonWindowSpec14() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec14(stack["e"])
+}
+
+func (c *current) onWindowSpec25(list any) (any, error) {
+	return list, nil
+}
+
+func (p *parser) callonWindowSpec25() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec25(stack["list"])
+}
+
+func (c *current) onWindowSpec36(f any) (any, error) {
+	return f, nil
+}
+
+func (p *parser) callonWindowSpec36() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec36(stack["f"])
+}
+
+func (c *current) onWindowSpec1(name, partition, orderby, frame any) (any, error) {
+	w := &ast.WindowExpr{Kind: "WindowExpr"}
+	if name != nil {
+		w.Name = name.(*ast.ID)
+	}
+	if partition != nil {
+		w.PartitionBy = sliceOf[ast.Expr](partition)
+	}
+	if orderby != nil {
+		w.OrderBy = orderby.([]ast.SortExpr)
+	}
+	if frame != nil {
+		w.Frame = frame.(*ast.WindowFrame)
+	}
+	return w, nil
+
+}
+
+func (p *parser) callonWindowSpec1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowSpec1(stack["name"], stack["partition"], stack["orderby"], stack["frame"])
+}
+
+func (c *current) onWindowFrame2(unit, start, end any) (any, error) {
+	return &ast.WindowFrame{
+		Unit:  unit.(string),
+		Start: start.(ast.WindowBound),
+		End:   end.(ast.WindowBound),
+		Loc:   loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonWindowFrame2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrame2(stack["unit"], stack["start"], stack["end"])
+}
+
+func (c *current) onWindowFrame16(unit, start any) (any, error) {
+	return &ast.WindowFrame{
+		Unit:  unit.(string),
+		Start: start.(ast.WindowBound),
+		End:   ast.WindowBound{Type: "current row", Loc: loc(c)},
+		Loc:   loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonWindowFrame16() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrame16(stack["unit"], stack["start"])
+}
+
+func (c *current) onWindowFrameUnit2() (any, error) {
+	return "rows", nil
+}
+
+func (p *parser) callonWindowFrameUnit2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrameUnit2()
+}
+
+func (c *current) onWindowFrameUnit4() (any, error) {
+	return "range", nil
+}
+
+func (p *parser) callonWindowFrameUnit4() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrameUnit4()
+}
+
+func (c *current) onWindowFrameBound2() (any, error) {
+	return ast.WindowBound{Type: "unbounded preceding", Loc: loc(c)}, nil
+}
+
+func (p *parser) callonWindowFrameBound2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrameBound2()
+}
+
+func (c *current) onWindowFrameBound7() (any, error) {
+	return ast.WindowBound{Type: "unbounded following", Loc: loc(c)}, nil
+}
+
+func (p *parser) callonWindowFrameBound7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrameBound7()
+}
+
+func (c *current) onWindowFrameBound12() (any, error) {
+	return ast.WindowBound{Type: "current row", Loc: loc(c)}, nil
+}
+
+func (p *parser) callonWindowFrameBound12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrameBound12()
+}
+
+func (c *current) onWindowFrameBound17(e any) (any, error) {
+	return ast.WindowBound{Type: "preceding", Offset: e.(ast.Expr), Loc: loc(c)}, nil
+}
+
+func (p *parser) callonWindowFrameBound17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrameBound17(stack["e"])
+}
+
+func (c *current) onWindowFrameBound23(e any) (any, error) {
+	return ast.WindowBound{Type: "following", Offset: e.(ast.Expr), Loc: loc(c)}, nil
+}
+
+func (p *parser) callonWindowFrameBound23() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onWindowFrameBound23(stack["e"])
 }
 
 func (c *current) onCallable3(id any) (any, error) {
@@ -21198,47 +23309,57 @@ func (p *parser) callonExprs1() (any, error) {
 	return p.cur.onExprs1(stack["first"], stack["rest"])
 }
 
-func (c *current) onPrimary8(id any) (any, error) {
+func (c *current) onPrimary9(id any) (any, error) {
 	return &ast.IDExpr{Kind: "IDExpr", ID: *(id.(*ast.ID))}, nil
 }
 
-func (p *parser) callonPrimary8() (any, error) {
+func (p *parser) callonPrimary9() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary8(stack["id"])
+	return p.cur.onPrimary9(stack["id"])
 }
 
-func (c *current) onPrimary12(expr any) (any, error) {
-	return expr, nil
+func (c *current) onPrimary12() (any, error) {
+	return newParam(c), nil
 }
 
 func (p *parser) callonPrimary12() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary12(stack["expr"])
+	return p.cur.onPrimary12()
+}
+
+func (c *current) onPrimary15(expr any) (any, error) {
+	return expr, nil
+}
+
+func (p *parser) callonPrimary15() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary15(stack["expr"])
 }
 
-func (c *current) onPrimary20(expr any) (any, error) {
+func (c *current) onPrimary23(expr any) (any, error) {
 	return expr, nil
 }
 
-func (p *parser) callonPrimary20() (any, error) {
+func (p *parser) callonPrimary23() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary20(stack["expr"])
+	return p.cur.onPrimary23(stack["expr"])
 }
 
-func (c *current) onPrimary28(expr any) (any, error) {
+func (c *current) onPrimary31(expr any) (any, error) {
 
 	expr.(*ast.SubqueryExpr).Array = true
 	return expr, nil
 
 }
 
-func (p *parser) callonPrimary28() (any, error) {
+func (p *parser) callonPrimary31() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary28(stack["expr"])
+	return p.cur.onPrimary31(stack["expr"])
 }
 
 func (c *current) onCaseExpr2(whens, else_ any) (any, error) {
@@ -22347,6 +24468,27 @@ func (p *parser) callonSQLIdentifier3() (any, error) {
 	return p.cur.onSQLIdentifier3(stack["s"])
 }
 
+func (c *current) onParam5() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonParam5() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParam5()
+}
+
+func (c *current) onParam1(name any) (any, error) {
+	return &ast.ParamExpr{Kind: "ParamExpr", Name: name.(string), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonParam1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onParam1(stack["name"])
+}
+
 func (c *current) onIdentifierName2() (any, error) {
 	return string(c.text), nil
 }
@@ -22977,7 +25119,7 @@ func (p *parser) callonSQLQueryBody5() (any, error) {
 	return p.cur.onSQLQueryBody5(stack["s"])
 }
 
-func (c *current) onSelect1(distinct, selection, from, where, group, having any) (any, error) {
+func (c *current) onSelect1(distinct, selection, from, where, group, having, windows any) (any, error) {
 	sel := &ast.SQLSelect{
 		Kind:      "SQLSelect",
 		Distinct:  distinct.(bool),
@@ -22996,6 +25138,9 @@ func (c *current) onSelect1(distinct, selection, from, where, group, having any)
 	if having != nil {
 		sel.Having = having.(ast.Expr)
 	}
+	if windows != nil {
+		sel.Windows = sliceOf[ast.SQLWindow](windows)
+	}
 	return sel, nil
 
 }
@@ -23003,10 +25148,10 @@ func (c *current) onSelect1(distinct, selection, from, where, group, having any)
 func (p *parser) callonSelect1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelect1(stack["distinct"], stack["selection"], stack["from"], stack["where"], stack["group"], stack["having"])
+	return p.cur.onSelect1(stack["distinct"], stack["selection"], stack["from"], stack["where"], stack["group"], stack["having"], stack["windows"])
 }
 
-func (c *current) onFromSelect1(from, distinct, selection, where, group, having any) (any, error) {
+func (c *current) onFromSelect1(from, distinct, selection, where, group, having, windows any) (any, error) {
 	sel := &ast.SQLSelect{
 		Kind:      "SQLSelect",
 		Distinct:  distinct.(bool),
@@ -23025,6 +25170,9 @@ func (c *current) onFromSelect1(from, distinct, selection, where, group, having
 	if having != nil {
 		sel.Having = having.(ast.Expr)
 	}
+	if windows != nil {
+		sel.Windows = sliceOf[ast.SQLWindow](windows)
+	}
 	return sel, nil
 
 }
@@ -23032,7 +25180,53 @@ func (c *current) onFromSelect1(from, distinct, selection, where, group, having
 func (p *parser) callonFromSelect1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFromSelect1(stack["from"], stack["distinct"], stack["selection"], stack["where"], stack["group"], stack["having"])
+	return p.cur.onFromSelect1(stack["from"], stack["distinct"], stack["selection"], stack["where"], stack["group"], stack["having"], stack["windows"])
+}
+
+func (c *current) onOptWindowClause11(w any) (any, error) {
+	return w, nil
+}
+
+func (p *parser) callonOptWindowClause11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptWindowClause11(stack["w"])
+}
+
+func (c *current) onOptWindowClause2(first, rest any) (any, error) {
+	return prepend(first, rest), nil
+
+}
+
+func (p *parser) callonOptWindowClause2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptWindowClause2(stack["first"], stack["rest"])
+}
+
+func (c *current) onOptWindowClause18() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonOptWindowClause18() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptWindowClause18()
+}
+
+func (c *current) onSQLWindow1(name, spec any) (any, error) {
+	return ast.SQLWindow{
+		Name: name.(*ast.ID),
+		Spec: spec.(*ast.WindowExpr),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonSQLWindow1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSQLWindow1(stack["name"], stack["spec"])
 }
 
 func (c *current) onWhereClause1(expr any) (any, error) {
@@ -23324,6 +25518,108 @@ func (p *parser) callonGroupByList1() (any, error) {
 	return p.cur.onGroupByList1(stack["first"], stack["rest"])
 }
 
+func (c *current) onGroupByItem2(sets any) (any, error) {
+	return &ast.SQLGroupingSets{
+		Kind: "SQLGroupingSets",
+		Type: "rollup",
+		Sets: sliceOf[ast.SQLGroupingSet](sets),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonGroupByItem2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupByItem2(stack["sets"])
+}
+
+func (c *current) onGroupByItem12(sets any) (any, error) {
+	return &ast.SQLGroupingSets{
+		Kind: "SQLGroupingSets",
+		Type: "cube",
+		Sets: sliceOf[ast.SQLGroupingSet](sets),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonGroupByItem12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupByItem12(stack["sets"])
+}
+
+func (c *current) onGroupByItem22(sets any) (any, error) {
+	return &ast.SQLGroupingSets{
+		Kind: "SQLGroupingSets",
+		Type: "grouping sets",
+		Sets: sliceOf[ast.SQLGroupingSet](sets),
+		Loc:  loc(c),
+	}, nil
+
+}
+
+func (p *parser) callonGroupByItem22() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupByItem22(stack["sets"])
+}
+
+func (c *current) onGroupingElems7(g any) (any, error) {
+	return g, nil
+}
+
+func (p *parser) callonGroupingElems7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupingElems7(stack["g"])
+}
+
+func (c *current) onGroupingElems1(first, rest any) (any, error) {
+	return prepend(first, rest), nil
+
+}
+
+func (p *parser) callonGroupingElems1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupingElems1(stack["first"], stack["rest"])
+}
+
+func (c *current) onGroupingElem2(exprs any) (any, error) {
+	return ast.SQLGroupingSet{Exprs: sliceOf[ast.Expr](exprs), Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonGroupingElem2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupingElem2(stack["exprs"])
+}
+
+func (c *current) onGroupingElem16() (any, error) {
+	return ast.SQLGroupingSet{Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonGroupingElem16() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupingElem16()
+}
+
+func (c *current) onGroupingElem21(e any) (any, error) {
+	return ast.SQLGroupingSet{Exprs: []ast.Expr{e.(ast.Expr)}, Loc: loc(c)}, nil
+
+}
+
+func (p *parser) callonGroupingElem21() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGroupingElem21(stack["e"])
+}
+
 func (c *current) onOptHavingClause2(h any) (any, error) {
 	return h, nil
 }
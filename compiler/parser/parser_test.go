@@ -95,3 +95,20 @@ func TestInvalid(t *testing.T) {
 		assert.Error(t, err, "query: %q", line)
 	}
 }
+
+func TestExplainAnalyze(t *testing.T) {
+	for _, c := range []struct {
+		query   string
+		explain bool
+	}{
+		{"EXPLAIN ANALYZE SELECT 1", true},
+		{"explain analyze values 1 | count()", true},
+		{"explain", false},
+		{"values explain", false},
+	} {
+		ast, err := parser.ParseText(c.query)
+		require.NoError(t, err, "query: %q", c.query)
+		assert.Equal(t, c.explain, ast.Explain(), "query: %q", c.query)
+		assert.Equal(t, c.explain, ast.ExplainAnalyze(), "query: %q", c.query)
+	}
+}
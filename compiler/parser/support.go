@@ -3,6 +3,8 @@ package parser
 import (
 	"bytes"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 
 	"github.com/brimdata/super/compiler/ast"
@@ -66,6 +68,13 @@ func newCall(c *current, name, args any) ast.Expr {
 	}
 }
 
+func newWindowExpr(c *current, f, over any) ast.Expr {
+	w := over.(*ast.WindowExpr)
+	w.Func = f.(ast.Expr)
+	w.Loc = loc(c)
+	return w
+}
+
 func loc(c *current) ast.Loc {
 	return ast.NewLoc(c.pos.offset, c.pos.offset+len(c.text)-1)
 }
@@ -116,3 +125,38 @@ func makeUnicodeChar(chars any) string {
 
 	return string(r)
 }
+
+// params tracks the positional parameters created while parsing so they
+// may be numbered in order of appearance once parsing completes.  Since
+// the parser may backtrack, the ParamExprs at an offset may include some
+// that are not in the final AST.
+type params map[int][]*ast.ParamExpr
+
+// explain is set when a query begins with EXPLAIN ANALYZE.
+type explain struct {
+	on bool
+}
+
+func setExplain(c *current) {
+	if e, ok := c.globalStore["explain"].(*explain); ok {
+		e.on = true
+	}
+}
+
+func newParam(c *current) *ast.ParamExpr {
+	p := &ast.ParamExpr{Kind: "ParamExpr", Loc: loc(c)}
+	if ps, ok := c.globalStore["params"].(params); ok {
+		ps[c.pos.offset] = append(ps[c.pos.offset], p)
+	}
+	return p
+}
+
+// number sets the name of each positional parameter to its number.
+func (p params) number() {
+	offsets := slices.Sorted(maps.Keys(p))
+	for k, off := range offsets {
+		for _, param := range p[off] {
+			param.Name = strconv.Itoa(k + 1)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/compiler/parser"
+)
+
+// splitPartials replaces the body of main with the stage p of the body,
+// which must read its input from the compiler's readers and compute its
+// output with a final aggregate, so the aggregate can be computed
+// incrementally by merging its partial results.  The operators upstream of
+// the aggregate must compute the same values from the union of two inputs as
+// from each input alone.
+func splitPartials(main *dag.Main, p parser.Partials) error {
+	seq := main.Body
+	if len(seq) == 0 {
+		return errors.New("internal error: empty DAG")
+	}
+	scan, ok := seq[0].(*dag.DefaultScan)
+	if !ok {
+		return errors.New("incremental query must not have a from clause")
+	}
+	output, ok := seq[len(seq)-1].(*dag.OutputOp)
+	if !ok {
+		return errors.New("incremental query must have a single output")
+	}
+	at := -1
+	for k, o := range seq {
+		if _, ok := o.(*dag.AggregateOp); ok {
+			at = k
+		}
+	}
+	if at < 0 {
+		return errors.New("incremental query must compute an aggregate")
+	}
+	for _, o := range seq[1:at] {
+		switch o.(type) {
+		case *dag.AggregateOp, *dag.CountOp, *dag.DistinctOp, *dag.HeadOp,
+			*dag.SkipOp, *dag.StreamWindowOp, *dag.TailOp, *dag.TopOp,
+			*dag.UniqOp, *dag.WindowOp:
+			return fmt.Errorf("incremental query cannot have a %s operator before its aggregate", opName(o))
+		}
+	}
+	agg := seq[at].(*dag.AggregateOp)
+	// Partials do not flow in the sort order of the input.
+	agg.InputSortDir = 0
+	if p == parser.PartialsOut {
+		agg.PartialsOut = true
+		main.Body = append(seq[:at+1], output)
+		return nil
+	}
+	// The partials hold the key values, so the merging aggregate
+	// references the keys by name as in Optimizer.Parallelize.
+	for k := range agg.Keys {
+		agg.Keys[k].RHS = agg.Keys[k].LHS
+	}
+	agg.PartialsIn = true
+	body := dag.Seq{scan, agg}
+	if p == parser.PartialsMerge {
+		agg.PartialsOut = true
+		body = append(body, output)
+	} else {
+		body = append(body, seq[at+1:]...)
+	}
+	main.Body = body
+	return nil
+}
+
+func opName(o dag.Op) string {
+	name := strings.TrimSuffix(fmt.Sprintf("%T", o), "Op")
+	return strings.ToLower(strings.TrimPrefix(name, "*dag."))
+}
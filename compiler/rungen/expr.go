@@ -54,6 +54,8 @@ func (b *Builder) compileExpr(e dag.Expr) (expr.Evaluator, error) {
 		return b.compileArrayExpr(e)
 	case *dag.BinaryExpr:
 		return b.compileBinary(e)
+	case *dag.BloomFilterExpr:
+		return b.compileBloomFilter(e)
 	case *dag.CondExpr:
 		return b.compileConditional(*e)
 	case *dag.CallExpr:
@@ -149,6 +151,18 @@ func (b *Builder) compileSearch(search *dag.SearchExpr) (expr.Evaluator, error)
 	return expr.NewSearch(search.Text, val, e)
 }
 
+func (b *Builder) compileBloomFilter(bloom *dag.BloomFilterExpr) (expr.Evaluator, error) {
+	val, err := sup.ParseValue(b.sctx(), bloom.Value)
+	if err != nil {
+		return nil, err
+	}
+	e, err := b.compileExpr(bloom.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return expr.NewBloomFilter(e, val, bloom.Search), nil
+}
+
 func (b *Builder) compileSliceExpr(slice *dag.SliceExpr) (expr.Evaluator, error) {
 	e, err := b.compileExpr(slice.Expr)
 	if err != nil {
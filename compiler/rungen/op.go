@@ -15,6 +15,7 @@ import (
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/runtime/profile"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/runtime/sam/op"
 	"github.com/brimdata/super/runtime/sam/op/combine"
@@ -61,6 +62,7 @@ type Builder struct {
 	funcs           map[string]*dag.FuncDef
 	compiledUDFs    map[string]*expr.UDF
 	compiledVamUDFs map[string]*vamexpr.UDF
+	profile         *profile.Profile
 }
 
 func NewBuilder(rctx *runtime.Context, env *exec.Environment) *Builder {
@@ -225,11 +227,22 @@ func (b *Builder) compileLeaf(o dag.Op, parent sbuf.Puller) (sbuf.Puller, error)
 				return nil, err
 			}
 		}
-		return meta.NewSortedLister(b.rctx.Context, b.mctx, pool, v.Commit, pruner)
+		l, err := meta.NewSortedLister(b.rctx.Context, b.mctx, pool, v.Commit, pruner)
+		if err != nil {
+			return nil, err
+		}
+		if mf := v.MetaFilter; mf != nil {
+			filter, err := b.compileExpr(mf.Expr)
+			if err != nil {
+				return nil, err
+			}
+			l.SetMetaFilter(b.sctx(), b.env.DB().VectorCache(), filter, field.NewProjection(mf.Projection))
+		}
+		return l, nil
 	case *dag.NullScan:
 		return sbuf.NewPuller(sbuf.NewArray([]super.Value{super.Null})), nil
 	case *dag.PoolMetaScan:
-		return meta.NewPoolMetaScanner(b.rctx.Context, b.sctx(), b.env.DB(), v.ID, v.Meta)
+		return meta.NewPoolMetaScanner(b.rctx.Context, b.sctx(), b.env.DB(), v.ID, v.Meta, v.At)
 	case *dag.PoolScan:
 		if parent != nil {
 			return nil, errors.New("internal error: pool scan cannot have a parent operator")
@@ -321,7 +334,7 @@ func (b *Builder) compileLeaf(o dag.Op, parent sbuf.Puller) (sbuf.Puller, error)
 	case *dag.HeadOp:
 		return head.New(parent, v.Count), nil
 	case *dag.LoadOp:
-		return load.New(b.rctx, b.env.DB(), parent, v.Pool, v.Branch, v.Author, v.Message, v.Meta), nil
+		return load.New(b.rctx, b.env.DB(), b.env.Compiler, parent, v.Pool, v.Branch, v.Author, v.Message, v.Meta), nil
 	case *dag.OutputOp:
 		b.channels[v.Name] = append(b.channels[v.Name], parent)
 		return parent, nil
@@ -373,6 +386,10 @@ func (b *Builder) compileLeaf(o dag.Op, parent sbuf.Puller) (sbuf.Puller, error)
 		return top.New(b.sctx(), parent, v.Limit, sortExprs, v.Reverse), nil
 	case *dag.UniqOp:
 		return uniq.New(b.rctx, parent, v.Cflag), nil
+	case *dag.StreamWindowOp:
+		return b.compileStreamWindow(v, parent)
+	case *dag.WindowOp:
+		return b.compileWindow(v, parent)
 	case *dag.UnnestOp:
 		expr, err := b.compileExpr(v.Expr)
 		if err != nil {
@@ -548,15 +565,25 @@ func (b *Builder) compile(o dag.Op, parents []sbuf.Puller) ([]sbuf.Puller, error
 			return nil, err
 		}
 		cmp := expr.NewComparator(exprs...).WithMissingAsNull()
-		return []sbuf.Puller{merge.New(b.rctx, parents, cmp.Compare)}, nil
+		p := merge.New(b.rctx, b.meterInputs(o, parents), cmp.Compare)
+		return []sbuf.Puller{b.meterOutput(o, p)}, nil
 	case *dag.CombineOp:
-		return []sbuf.Puller{combine.New(b.rctx, parents)}, nil
-	default:
-		p, err := b.compileLeaf(o, b.combine(parents))
+		p := combine.New(b.rctx, b.meterInputs(o, parents))
+		return []sbuf.Puller{b.meterOutput(o, p)}, nil
+	case *dag.OutputOp:
+		// The output's parent becomes a channel so meter it here.
+		parent := b.meterOutput(o, b.combine(b.meterInputs(o, parents)))
+		p, err := b.compileLeaf(o, parent)
 		if err != nil {
 			return nil, err
 		}
 		return []sbuf.Puller{p}, nil
+	default:
+		p, err := b.compileLeaf(o, b.combine(b.meterInputs(o, parents)))
+		if err != nil {
+			return nil, err
+		}
+		return []sbuf.Puller{b.meterOutput(o, p)}, nil
 	}
 }
 
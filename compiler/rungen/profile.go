@@ -0,0 +1,54 @@
+package rungen
+
+import (
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/runtime/profile"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/vector"
+)
+
+// EnableProfile instruments the flowgraph subsequently built for main so
+// that the returned profile collects the runtime statistics of each of its
+// operators.
+func (b *Builder) EnableProfile(main *dag.Main) *profile.Profile {
+	b.profile = profile.New(main)
+	return b.profile
+}
+
+func (b *Builder) meterInputs(o dag.Op, parents []sbuf.Puller) []sbuf.Puller {
+	if b.profile == nil {
+		return parents
+	}
+	op := b.profile.Operator(o)
+	inputs := make([]sbuf.Puller, 0, len(parents))
+	for _, p := range parents {
+		inputs = append(inputs, op.Input(p))
+	}
+	return inputs
+}
+
+func (b *Builder) meterOutput(o dag.Op, p sbuf.Puller) sbuf.Puller {
+	if b.profile == nil {
+		return p
+	}
+	return b.profile.Operator(o).Output(p)
+}
+
+func (b *Builder) meterVamInputs(o dag.Op, parents []vector.Puller) []vector.Puller {
+	if b.profile == nil {
+		return parents
+	}
+	op := b.profile.Operator(o)
+	inputs := make([]vector.Puller, 0, len(parents))
+	for _, p := range parents {
+		inputs = append(inputs, op.VectorInput(p))
+	}
+	return inputs
+}
+
+func (b *Builder) meterVamOutput(o dag.Op, p vector.Puller) vector.Puller {
+	if b.profile == nil {
+		return p
+	}
+	return b.profile.Operator(o).VectorOutput(p)
+}
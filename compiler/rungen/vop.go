@@ -23,7 +23,8 @@ import (
 func (b *Builder) compileVam(o dag.Op, parents []vector.Puller) ([]vector.Puller, error) {
 	switch o := o.(type) {
 	case *dag.CombineOp:
-		return []vector.Puller{b.combineVam(parents)}, nil
+		p := b.combineVam(b.meterVamInputs(o, parents))
+		return []vector.Puller{b.meterVamOutput(o, p)}, nil
 	case *dag.ForkOp:
 		return b.compileVamFork(o, b.combineVam(parents))
 	case *dag.HashJoinOp:
@@ -38,8 +39,9 @@ func (b *Builder) compileVam(o dag.Op, parents []vector.Puller) ([]vector.Puller
 		if err != nil {
 			return nil, err
 		}
-		join := vamop.NewHashJoin(b.rctx, o.Style, parents[0], parents[1], leftKey, rightKey, o.LeftAlias, o.RightAlias)
-		return []vector.Puller{join}, nil
+		parents = b.meterVamInputs(o, parents)
+		join := vamop.NewHashJoin(b.rctx, o.Style, parents[0], parents[1], leftKey, rightKey, o.LeftAlias, o.RightAlias, o.Build)
+		return []vector.Puller{b.meterVamOutput(o, join)}, nil
 	case *dag.JoinOp:
 		if len(parents) != 2 {
 			return nil, ErrJoinParents
@@ -52,15 +54,17 @@ func (b *Builder) compileVam(o dag.Op, parents []vector.Puller) ([]vector.Puller
 				return nil, err
 			}
 		}
+		parents = b.meterVamInputs(o, parents)
 		join := vamop.NewNestedLoopJoin(b.rctx, parents[0], parents[1], o.Style, o.LeftAlias, o.RightAlias, cond)
-		return []vector.Puller{join}, nil
+		return []vector.Puller{b.meterVamOutput(o, join)}, nil
 	case *dag.MergeOp:
 		exprs, err := b.compileSortExprs(o.Exprs)
 		if err != nil {
 			return nil, err
 		}
 		cmp := expr.NewComparator(exprs...).WithMissingAsNull()
-		return []vector.Puller{vamop.NewMerge(b.rctx, parents, cmp.Compare)}, nil
+		p := vamop.NewMerge(b.rctx, b.meterVamInputs(o, parents), cmp.Compare)
+		return []vector.Puller{b.meterVamOutput(o, p)}, nil
 	case *dag.ScatterOp:
 		return b.compileVamScatter(o, parents)
 	case *dag.SwitchOp:
@@ -69,12 +73,20 @@ func (b *Builder) compileVam(o dag.Op, parents []vector.Puller) ([]vector.Puller
 			return b.compileVamExprSwitch(o, parent)
 		}
 		return b.compileVamSwitch(o, parent)
-	default:
-		p, err := b.compileVamLeaf(o, b.combineVam(parents))
+	case *dag.OutputOp:
+		// The output's parent becomes a channel so meter it here.
+		parent := b.meterVamOutput(o, b.combineVam(b.meterVamInputs(o, parents)))
+		p, err := b.compileVamLeaf(o, parent)
 		if err != nil {
 			return nil, err
 		}
 		return []vector.Puller{p}, nil
+	default:
+		p, err := b.compileVamLeaf(o, b.combineVam(b.meterVamInputs(o, parents)))
+		if err != nil {
+			return nil, err
+		}
+		return []vector.Puller{b.meterVamOutput(o, p)}, nil
 	}
 }
 
@@ -299,17 +311,26 @@ func (b *Builder) compileVamLeaf(o dag.Op, parent vector.Puller) (vector.Puller,
 		}
 		return vam.NewDematerializer(sbufPuller), nil
 	case *dag.SortOp:
-		var sortExprs []expr.SortExpr
+		var sortExprs []vamexpr.SortExpr
 		for _, e := range o.Exprs {
-			k, err := b.compileExpr(e.Key)
+			k, err := b.compileVamExpr(e.Key)
 			if err != nil {
 				return nil, err
 			}
-			sortExprs = append(sortExprs, expr.NewSortExpr(k, e.Order, e.Nulls))
+			sortExprs = append(sortExprs, vamexpr.NewSortExpr(k, e.Order, e.Nulls))
+		}
+		// The sequential sort expressions are used to merge spilled runs.
+		samSortExprs, err := b.compileSortExprs(o.Exprs)
+		if err != nil {
+			return nil, err
 		}
-		return vamop.NewSort(b.rctx, parent, sortExprs, o.Reverse), nil
+		return vamop.NewSort(b.rctx, parent, sortExprs, samSortExprs, o.Reverse), nil
 	case *dag.TailOp:
 		return vamop.NewTail(parent, o.Count), nil
+	case *dag.StreamWindowOp:
+		return b.compileVamStreamWindow(o, parent)
+	case *dag.WindowOp:
+		return b.compileVamWindow(o, parent)
 	case *dag.UniqOp:
 		sbufPuller, err := b.compileLeaf(o, vam.NewMaterializer(parent))
 		if err != nil {
@@ -420,7 +441,7 @@ func (b *Builder) compileVamAggregate(s *dag.AggregateOp, parent vector.Puller)
 	if len(keyExprs) == 0 {
 		return aggregate.NewScalar(parent, b.sctx(), aggs, aggNames, aggExprs, s.PartialsIn, s.PartialsOut)
 	}
-	return aggregate.New(parent, b.sctx(), aggNames, aggExprs, aggs, keyNames, keyExprs, s.PartialsIn, s.PartialsOut)
+	return aggregate.New(b.rctx, parent, aggNames, aggExprs, aggs, keyNames, keyExprs, s.PartialsIn, s.PartialsOut)
 }
 
 func (b *Builder) compileVamAgg(agg *dag.AggExpr) (*vamexpr.Aggregator, error) {
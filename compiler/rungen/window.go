@@ -0,0 +1,287 @@
+package rungen
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/op/sort"
+	"github.com/brimdata/super/runtime/sam/op/window"
+	vamexpr "github.com/brimdata/super/runtime/vam/expr"
+	vamop "github.com/brimdata/super/runtime/vam/op"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/vector"
+)
+
+// compileWindow compiles a window operator preceded by a sort of its
+// input by the partition keys and then the order keys.
+func (b *Builder) compileWindow(o *dag.WindowOp, parent sbuf.Puller) (sbuf.Puller, error) {
+	if sortExprs := windowSortExprs(o); len(sortExprs) > 0 {
+		exprs, err := b.compileSortExprs(sortExprs)
+		if err != nil {
+			return nil, err
+		}
+		parent = sort.New(b.rctx, parent, exprs, false)
+	}
+	return b.compileWindowOp(o, parent)
+}
+
+// compileVamWindow is like compileWindow but for the vector runtime.
+func (b *Builder) compileVamWindow(o *dag.WindowOp, parent vector.Puller) (vector.Puller, error) {
+	if sortExprs := windowSortExprs(o); len(sortExprs) > 0 {
+		exprs, err := b.compileVamSortExprs(sortExprs)
+		if err != nil {
+			return nil, err
+		}
+		samExprs, err := b.compileSortExprs(sortExprs)
+		if err != nil {
+			return nil, err
+		}
+		parent = vamop.NewSort(b.rctx, parent, exprs, samExprs, false)
+	}
+	partitionBy, err := b.compileVamExprs(o.PartitionBy)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := b.compileVamSortExprs(o.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	var paths []field.Path
+	var funcs []*vamop.WindowFunc
+	for _, f := range o.Funcs {
+		this, ok := f.LHS.(*dag.ThisExpr)
+		if !ok {
+			return nil, errors.New("window: function result must be assigned to a field")
+		}
+		paths = append(paths, this.Path)
+		fn := &vamop.WindowFunc{Name: f.Name}
+		if f.Agg != nil {
+			if fn.Agg, err = b.compileVamAgg(f.Agg); err != nil {
+				return nil, err
+			}
+		}
+		if fn.Args, err = b.compileVamExprs(f.Args); err != nil {
+			return nil, err
+		}
+		if fn.Frame, err = b.compileWindowFrame(f.Frame); err != nil {
+			return nil, err
+		}
+		funcs = append(funcs, fn)
+	}
+	return vamop.NewWindow(b.sctx(), parent, partitionBy, orderBy, paths, funcs)
+}
+
+func (b *Builder) compileVamSortExprs(sortExprs []dag.SortExpr) ([]vamexpr.SortExpr, error) {
+	var exprs []vamexpr.SortExpr
+	for _, e := range sortExprs {
+		k, err := b.compileVamExpr(e.Key)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, vamexpr.NewSortExpr(k, e.Order, e.Nulls))
+	}
+	return exprs, nil
+}
+
+func windowSortExprs(o *dag.WindowOp) []dag.SortExpr {
+	var exprs []dag.SortExpr
+	for _, e := range o.PartitionBy {
+		exprs = append(exprs, dag.SortExpr{Key: e, Order: order.Asc, Nulls: order.NullsLast})
+	}
+	return append(exprs, o.OrderBy...)
+}
+
+func (b *Builder) compileWindowOp(o *dag.WindowOp, parent sbuf.Puller) (sbuf.Puller, error) {
+	partitionBy, err := b.compileExprs(o.PartitionBy)
+	if err != nil {
+		return nil, err
+	}
+	orderBy, err := b.compileSortExprs(o.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	var paths []field.Path
+	var funcs []*window.Func
+	for _, f := range o.Funcs {
+		this, ok := f.LHS.(*dag.ThisExpr)
+		if !ok {
+			return nil, errors.New("window: function result must be assigned to a field")
+		}
+		paths = append(paths, this.Path)
+		fn, err := b.compileWindowFunc(f)
+		if err != nil {
+			return nil, err
+		}
+		funcs = append(funcs, fn)
+	}
+	return window.New(b.rctx, parent, partitionBy, orderBy, paths, funcs)
+}
+
+func (b *Builder) compileWindowFunc(f dag.WindowFunc) (*window.Func, error) {
+	fn := &window.Func{Name: f.Name}
+	var err error
+	if f.Agg != nil {
+		if fn.Agg, err = b.compileAgg(f.Agg); err != nil {
+			return nil, err
+		}
+	}
+	if fn.Args, err = b.compileExprs(f.Args); err != nil {
+		return nil, err
+	}
+	if fn.Frame, err = b.compileWindowFrame(f.Frame); err != nil {
+		return nil, err
+	}
+	return fn, nil
+}
+
+func (b *Builder) compileWindowFrame(frame *dag.WindowFrame) (*window.Frame, error) {
+	if frame == nil {
+		return nil, nil
+	}
+	start, err := b.compileWindowBound(frame.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := b.compileWindowBound(frame.End)
+	if err != nil {
+		return nil, err
+	}
+	return &window.Frame{Unit: frame.Unit, Start: start, End: end}, nil
+}
+
+func (b *Builder) compileWindowBound(bound dag.WindowBound) (window.Bound, error) {
+	out := window.Bound{Type: bound.Type}
+	if bound.Offset != nil {
+		val, err := b.evalAtCompileTime(bound.Offset)
+		if err != nil {
+			return window.Bound{}, err
+		}
+		out.Offset = val
+	}
+	return out, nil
+}
+
+func (b *Builder) compileStreamWindow(o *dag.StreamWindowOp, parent sbuf.Puller) (sbuf.Puller, error) {
+	keys, err := b.compileExprs(o.Keys)
+	if err != nil {
+		return nil, err
+	}
+	paths, funcs, exprs, err := b.streamWindowFuncs(o.Funcs)
+	if err != nil {
+		return nil, err
+	}
+	compile := func(e dag.Expr) (expr.Evaluator, error) {
+		if e == nil {
+			return nil, nil
+		}
+		return b.compileExpr(e)
+	}
+	var args []window.StreamArg
+	for _, e := range exprs {
+		var a window.StreamArg
+		if a.Arg, err = compile(e.arg); err != nil {
+			return nil, err
+		}
+		if a.Default, err = compile(e.def); err != nil {
+			return nil, err
+		}
+		if a.Filter, err = compile(e.filter); err != nil {
+			return nil, err
+		}
+		args = append(args, a)
+	}
+	return window.NewStreamOp(b.rctx, parent, keys, o.InputSorted, paths, funcs, args)
+}
+
+func (b *Builder) compileVamStreamWindow(o *dag.StreamWindowOp, parent vector.Puller) (vector.Puller, error) {
+	keys, err := b.compileVamExprs(o.Keys)
+	if err != nil {
+		return nil, err
+	}
+	paths, funcs, exprs, err := b.streamWindowFuncs(o.Funcs)
+	if err != nil {
+		return nil, err
+	}
+	var out []*vamop.StreamWindowFunc
+	for k, f := range funcs {
+		fn := &vamop.StreamWindowFunc{Name: f.Name, Offset: f.Offset}
+		if a := o.Funcs[k].Agg; a != nil {
+			if fn.Agg, err = b.compileVamAgg(a); err != nil {
+				return nil, err
+			}
+		} else {
+			if exprs[k].arg != nil {
+				if fn.Arg, err = b.compileVamExpr(exprs[k].arg); err != nil {
+					return nil, err
+				}
+			}
+			if exprs[k].def != nil {
+				if fn.Default, err = b.compileVamExpr(exprs[k].def); err != nil {
+					return nil, err
+				}
+			}
+		}
+		out = append(out, fn)
+	}
+	return vamop.NewStreamWindow(b.sctx(), parent, keys, o.InputSorted, paths, out)
+}
+
+// streamArgExprs holds the argument, default, and filter expressions of
+// a window function computed by a stream window operator.
+type streamArgExprs struct {
+	arg    dag.Expr
+	def    dag.Expr
+	filter dag.Expr
+}
+
+func (b *Builder) streamWindowFuncs(funcs []dag.WindowFunc) ([]field.Path, []window.StreamFunc, []streamArgExprs, error) {
+	var paths []field.Path
+	var out []window.StreamFunc
+	var exprs []streamArgExprs
+	for _, f := range funcs {
+		this, ok := f.LHS.(*dag.ThisExpr)
+		if !ok {
+			return nil, nil, nil, errors.New("window: function result must be assigned to a field")
+		}
+		paths = append(paths, this.Path)
+		fn := window.StreamFunc{Name: f.Name}
+		var e streamArgExprs
+		if f.Agg != nil {
+			pattern, err := agg.NewPattern(f.Agg.Name, f.Agg.Distinct, f.Agg.Expr != nil)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			fn.Agg = pattern
+			e.arg = f.Agg.Expr
+			e.filter = f.Agg.Filter
+		} else {
+			if len(f.Args) > 0 {
+				e.arg = f.Args[0]
+			}
+			if len(f.Args) > 1 {
+				val, err := b.evalAtCompileTime(f.Args[1])
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				if !super.IsInteger(super.TypeUnder(val.Type()).ID()) || val.IsNull() || val.AsInt() < 0 {
+					return nil, nil, nil, fmt.Errorf("%s: offset must be a non-negative integer", f.Name)
+				}
+				fn.Offset = int(val.AsInt())
+			} else if f.Name == "lag" || f.Name == "lead" {
+				fn.Offset = 1
+			}
+			if len(f.Args) > 2 {
+				e.def = f.Args[2]
+			}
+		}
+		out = append(out, fn)
+		exprs = append(exprs, e)
+	}
+	return paths, out, exprs, nil
+}
@@ -3,6 +3,7 @@ package semantic
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/brimdata/super"
@@ -12,6 +13,7 @@ import (
 	"github.com/brimdata/super/compiler/semantic/sem"
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/sup"
 )
 
 // Analyze performs a semantic analysis of the AST, translating it from AST
@@ -19,6 +21,9 @@ import (
 // After semantic analysis, the DAG is ready for either optimization or compilation.
 func Analyze(ctx context.Context, p *parser.AST, env *exec.Environment, extInput bool) (*dag.Main, error) {
 	t := newTranslator(ctx, reporter{p.Files()}, env)
+	if err := t.bindParams(p.Params()); err != nil {
+		return nil, err
+	}
 	astseq := p.Parsed()
 	if extInput {
 		astseq.Prepend(&ast.DefaultScan{Kind: "DefaultScan"})
@@ -43,6 +48,9 @@ func Analyze(ctx context.Context, p *parser.AST, env *exec.Environment, extInput
 		}
 	}
 	main := newDagen(t.reporter).assemble(seq, t.resolver.funcs)
+	if err := authorize(ctx, main); err != nil {
+		return nil, err
+	}
 	if env.Runtime == exec.RuntimeAuto && t.hasVectorizedInput {
 		env.Runtime = exec.RuntimeVAM
 	}
@@ -65,6 +73,7 @@ type translator struct {
 	env                *exec.Environment
 	scope              *Scope
 	sctx               *super.Context
+	params             map[string]super.Value
 }
 
 func newTranslator(ctx context.Context, r reporter, env *exec.Environment) *translator {
@@ -81,6 +90,33 @@ func newTranslator(ctx context.Context, r reporter, env *exec.Environment) *tran
 	return t
 }
 
+// bindParams parses the SUP values of the query parameters, which
+// replace references to them with literals.
+func (t *translator) bindParams(params map[string]string) error {
+	t.params = make(map[string]super.Value)
+	for name, text := range params {
+		val, err := parseParam(t.sctx, text)
+		if err != nil {
+			return fmt.Errorf("parameter %q: %w", name, err)
+		}
+		t.params[name] = val
+	}
+	return nil
+}
+
+// parseParam parses text as a single SUP value.
+func parseParam(sctx *super.Context, text string) (super.Value, error) {
+	p := sup.NewParser(strings.NewReader(text))
+	v, err := p.ParseValue()
+	if err != nil {
+		return super.Null, err
+	}
+	if extra, err := p.ParseValue(); v == nil || err != nil || extra != nil {
+		return super.Null, fmt.Errorf("invalid SUP value %q", text)
+	}
+	return sup.ParseValueFromAST(sctx, v)
+}
+
 func HasSource(seq sem.Seq) bool {
 	if len(seq) == 0 {
 		return false
@@ -142,7 +178,7 @@ type reporter struct {
 }
 
 func (r reporter) error(n ast.Node, err error) {
-	r.AddError(err.Error(), n.Pos(), n.End())
+	r.AddErr(err, n.Pos(), n.End())
 }
 
 func isURL(s string) bool {
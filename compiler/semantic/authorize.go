@@ -0,0 +1,42 @@
+package semantic
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/grants"
+)
+
+// authorize checks that the Authorizer of ctx permits each access to a
+// pool by main.
+func authorize(ctx context.Context, main *dag.Main) error {
+	var err error
+	check := func(op dag.Op) dag.Op {
+		if err != nil {
+			return op
+		}
+		switch op := op.(type) {
+		case *dag.CommitMetaScan:
+			err = db.Authorize(ctx, op.Pool, grants.Read)
+		case *dag.DeleteScan:
+			err = db.Authorize(ctx, op.ID, grants.Read)
+		case *dag.DeleterScan:
+			err = db.Authorize(ctx, op.Pool, grants.Delete)
+		case *dag.ListerScan:
+			err = db.Authorize(ctx, op.Pool, grants.Read)
+		case *dag.LoadOp:
+			err = db.Authorize(ctx, op.Pool, grants.Load)
+		case *dag.PoolMetaScan:
+			err = db.Authorize(ctx, op.ID, grants.Read)
+		case *dag.PoolScan:
+			err = db.Authorize(ctx, op.ID, grants.Read)
+		case *dag.SeqScan:
+			err = db.Authorize(ctx, op.Pool, grants.Read)
+		}
+		return op
+	}
+	dag.WalkT(reflect.ValueOf(main), check)
+	return err
+}
@@ -155,6 +155,13 @@ func (c *checker) op(typ super.Type, op sem.Op) super.Type {
 		return c.seq(c.unnest(op.Expr, c.expr(typ, op.Expr)), op.Body)
 	case *sem.ValuesOp:
 		return c.fuse(c.exprs(typ, op.Exprs))
+	case *sem.WindowOp:
+		c.exprs(typ, op.PartitionBy)
+		c.sortExprs(typ, op.OrderBy)
+		return c.windowFuncs(typ, op.Funcs)
+	case *sem.StreamWindowOp:
+		c.exprs(typ, op.Keys)
+		return c.windowFuncs(typ, op.Funcs)
 	default:
 		panic(op)
 	}
@@ -259,6 +266,42 @@ func (c *checker) assignments(in super.Type, assignments []sem.Assignment) []pat
 	return paths
 }
 
+func (c *checker) windowFuncs(typ super.Type, funcs []sem.WindowFunc) super.Type {
+	var fields []pathType
+	for _, f := range funcs {
+		var path []string
+		if this, ok := f.LHS.(*sem.ThisExpr); ok {
+			path = this.Path
+		}
+		fields = append(fields, pathType{path, c.windowFunc(typ, f)})
+	}
+	return c.putPaths(typ, fields)
+}
+
+func (c *checker) windowFunc(typ super.Type, f sem.WindowFunc) super.Type {
+	c.exprs(typ, f.Args)
+	if f.Frame != nil {
+		c.expr(typ, f.Frame.Start.Offset)
+		c.expr(typ, f.Frame.End.Offset)
+	}
+	if f.Agg != nil {
+		return c.expr(typ, f.Agg)
+	}
+	return c.windowFuncType(f.Name)
+}
+
+func (c *checker) windowFuncType(name string) super.Type {
+	switch name {
+	case "row_number", "rank", "dense_rank", "ntile":
+		return super.TypeInt64
+	case "percent_rank", "cume_dist":
+		return super.TypeFloat64
+	}
+	// The value functions return a value of their argument or the
+	// default so the type is not known here.
+	return c.unknown
+}
+
 func (c *checker) sortExprs(typ super.Type, exprs []sem.SortExpr) {
 	for _, se := range exprs {
 		c.expr(typ, se.Expr)
@@ -283,6 +326,10 @@ func (c *checker) expr(typ super.Type, e sem.Expr) super.Type {
 		// XXX This will be handled in a subsequent PR where we add type signatures
 		// to the package containing the agg func implementatons.
 		return c.unknown
+	case *sem.WindowRef:
+		// Like AggRefs, WindowRefs are replaced before type checking
+		// matters.
+		return c.unknown
 	case *sem.AggRef:
 		// AggRef's should never appear here but they can show up when
 		// we are traversing the demand exprs in a SQL SELECT where we
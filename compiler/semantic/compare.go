@@ -51,7 +51,7 @@ func eqOp(aop, bop sem.Op) bool {
 		return ok
 	case *sem.PoolMetaScan:
 		b, ok := bop.(*sem.PoolMetaScan)
-		return ok && a.ID == b.ID && a.Meta == b.Meta
+		return ok && a.ID == b.ID && a.Meta == b.Meta && a.At == b.At
 	case *sem.PoolScan:
 		b, ok := bop.(*sem.PoolScan)
 		return ok && a.ID == b.ID && a.Commit == b.Commit
@@ -151,6 +151,15 @@ func eqOp(aop, bop sem.Op) bool {
 	case *sem.ValuesOp:
 		b, ok := bop.(*sem.ValuesOp)
 		return ok && eqExprs(a.Exprs, b.Exprs)
+	case *sem.WindowOp:
+		b, ok := bop.(*sem.WindowOp)
+		if !ok || !eqWindowSpec(a, b) {
+			return false
+		}
+		return eqWindowFuncs(a.Funcs, b.Funcs)
+	case *sem.StreamWindowOp:
+		b, ok := bop.(*sem.StreamWindowOp)
+		return ok && eqExprs(a.Keys, b.Keys) && eqWindowFuncs(a.Funcs, b.Funcs)
 	default:
 		panic(a)
 	}
@@ -171,6 +180,41 @@ func eqAssignments(a, b []sem.Assignment) bool {
 	return true
 }
 
+// eqWindowSpec returns true if window ops a and b have the same
+// partitioning and ordering.
+func eqWindowSpec(a, b *sem.WindowOp) bool {
+	return eqExprs(a.PartitionBy, b.PartitionBy) && eqSortExprs(a.OrderBy, b.OrderBy)
+}
+
+func eqWindowFuncs(a, b []sem.WindowFunc) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k := range a {
+		if !eqWindowFunc(a[k], b[k]) {
+			return false
+		}
+	}
+	return true
+}
+
+func eqWindowFunc(a, b sem.WindowFunc) bool {
+	if a.Name != b.Name || !eqExpr(a.LHS, b.LHS) || !eqExprs(a.Args, b.Args) {
+		return false
+	}
+	if (a.Agg == nil) != (b.Agg == nil) || a.Agg != nil && !eqExpr(a.Agg, b.Agg) {
+		return false
+	}
+	if a.Frame == nil || b.Frame == nil {
+		return a.Frame == b.Frame
+	}
+	return a.Frame.Unit == b.Frame.Unit && eqWindowBound(a.Frame.Start, b.Frame.Start) && eqWindowBound(a.Frame.End, b.Frame.End)
+}
+
+func eqWindowBound(a, b sem.WindowBound) bool {
+	return a.Type == b.Type && eqExpr(a.Offset, b.Offset)
+}
+
 func eqSortExprs(a, b []sem.SortExpr) bool {
 	if len(a) != len(b) {
 		return false
@@ -285,6 +329,9 @@ func eqExpr(aexpr, bexpr sem.Expr) bool {
 	case *sem.UnaryExpr:
 		b, ok := bexpr.(*sem.UnaryExpr)
 		return ok && a.Op == b.Op && eqExpr(a.Operand, b.Operand)
+	case *sem.WindowRef:
+		b, ok := bexpr.(*sem.WindowRef)
+		return ok && a.Index == b.Index
 	default:
 		panic(aexpr)
 	}
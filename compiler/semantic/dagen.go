@@ -107,6 +107,7 @@ func (d *dagen) op(op sem.Op) dag.Op {
 			Kind: "PoolMetaScan",
 			ID:   op.ID,
 			Meta: op.Meta,
+			At:   op.At,
 		}
 	case *sem.PoolScan:
 		return &dag.PoolScan{
@@ -267,10 +268,60 @@ func (d *dagen) op(op sem.Op) dag.Op {
 			Kind:  "ValuesOp",
 			Exprs: d.exprs(op.Exprs),
 		}
+	case *sem.WindowOp:
+		return &dag.WindowOp{
+			Kind:        "WindowOp",
+			PartitionBy: d.exprs(op.PartitionBy),
+			OrderBy:     d.sortExprs(op.OrderBy),
+			Funcs:       d.windowFuncs(op.Funcs),
+		}
+	case *sem.StreamWindowOp:
+		return &dag.StreamWindowOp{
+			Kind:  "StreamWindowOp",
+			Keys:  d.exprs(op.Keys),
+			Funcs: d.windowFuncs(op.Funcs),
+		}
 	}
 	panic(op)
 }
 
+func (d *dagen) windowFuncs(funcs []sem.WindowFunc) []dag.WindowFunc {
+	var out []dag.WindowFunc
+	for _, f := range funcs {
+		wf := dag.WindowFunc{
+			LHS:  d.expr(f.LHS),
+			Name: f.Name,
+			Args: d.exprs(f.Args),
+		}
+		if f.Agg != nil {
+			wf.Agg = &dag.AggExpr{
+				Kind:     "AggExpr",
+				Name:     f.Agg.Name,
+				Distinct: f.Agg.Distinct,
+				Expr:     d.expr(f.Agg.Expr),
+				Filter:   d.expr(f.Agg.Filter),
+			}
+		}
+		if f.Frame != nil {
+			wf.Frame = &dag.WindowFrame{
+				Unit:  f.Frame.Unit,
+				Start: d.windowBound(f.Frame.Start),
+				End:   d.windowBound(f.Frame.End),
+			}
+		}
+		out = append(out, wf)
+	}
+	return out
+}
+
+func (d *dagen) windowBound(b sem.WindowBound) dag.WindowBound {
+	var offset dag.Expr
+	if b.Offset != nil {
+		offset = d.expr(b.Offset)
+	}
+	return dag.WindowBound{Type: b.Type, Offset: offset}
+}
+
 func (d *dagen) unnest(op *sem.UnnestOp) dag.Op {
 	u := &dag.UnnestOp{
 		Kind: "UnnestOp",
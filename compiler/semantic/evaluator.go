@@ -167,11 +167,27 @@ func (e *evaluator) op(op sem.Op) bool {
 		return e.seq(op.Body)
 	case *sem.ValuesOp:
 		return e.exprs(op.Exprs)
+	case *sem.WindowOp:
+		isConst := e.exprs(op.PartitionBy) && e.sortExprs(op.OrderBy)
+		return e.windowFuncs(op.Funcs) && isConst && e.constThis
+	case *sem.StreamWindowOp:
+		isConst := e.exprs(op.Keys)
+		return e.windowFuncs(op.Funcs) && isConst && e.constThis
 	default:
 		panic(op)
 	}
 }
 
+func (e *evaluator) windowFuncs(funcs []sem.WindowFunc) bool {
+	isConst := true
+	for _, f := range funcs {
+		if !e.exprs(f.Args) || f.Agg != nil && !e.expr(f.Agg) {
+			isConst = false
+		}
+	}
+	return isConst
+}
+
 func (e *evaluator) assignments(assignments []sem.Assignment) bool {
 	isConst := true
 	for _, a := range assignments {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/araddon/dateparse"
@@ -148,6 +149,15 @@ func (t *translator) expr(e ast.Expr, inType super.Type) (sem.Expr, super.Type)
 		}, super.TypeBool
 	case *ast.IDExpr:
 		return t.idExpr(e, false, inType)
+	case *ast.ParamExpr:
+		if val, ok := t.params[e.Name]; ok {
+			return sem.NewLiteral(e, val), val.Type()
+		}
+		if id, ok := paramID(e); ok {
+			return t.idExpr(id, false, inType)
+		}
+		t.error(e, fmt.Errorf("no value bound to positional parameter %s", e.Name))
+		return badExpr, t.checker.unknown
 	case *ast.IndexExpr:
 		container, containerType := t.expr(e.Expr, inType)
 		index, indexType := t.expr(e.Index, inType)
@@ -413,6 +423,8 @@ func (t *translator) expr(e ast.Expr, inType super.Type) (sem.Expr, super.Type)
 			return operand, typ
 		}
 		return sem.NewUnaryExpr(e, e.Op, operand), typ
+	case *ast.WindowExpr:
+		return t.windowExpr(e, inType)
 	case nil:
 		panic("semantic analysis: illegal null value encountered in AST")
 	}
@@ -425,7 +437,15 @@ func (t *translator) dot(e *ast.BinaryExpr, inType super.Type) (sem.Expr, super.
 		t.error(e, errors.New("RHS of dot operator is not an identifier"))
 		return badExpr, badType
 	}
-	if lhs, ok := e.LHS.(*ast.IDExpr); ok {
+	lhsExpr := e.LHS
+	if p, ok := lhsExpr.(*ast.ParamExpr); ok {
+		if _, bound := t.params[p.Name]; !bound {
+			if id, ok := paramID(p); ok {
+				lhsExpr = id
+			}
+		}
+	}
+	if lhs, ok := lhsExpr.(*ast.IDExpr); ok {
 		// Check for plain-ID this (not double quoted) and resolve accordingly.
 		if lhs.Name == "this" && t.scope.sql != nil {
 			this, typ := t.scope.resolveThis(t, lhs, inType)
@@ -467,6 +487,16 @@ func (t *translator) deref(loc ast.Node, lhs sem.Expr, id *ast.IDExpr, inType su
 	}, typ
 }
 
+// paramID returns the identifier "$name" for the named parameter e.  When
+// no value is bound to the parameter, "$name" refers to a field as it did
+// before parameters existed.  Positional parameters have no identifier.
+func paramID(e *ast.ParamExpr) (*ast.IDExpr, bool) {
+	if _, err := strconv.Atoi(e.Name); err == nil {
+		return nil, false
+	}
+	return &ast.IDExpr{Kind: "IDExpr", ID: ast.ID{Name: "$" + e.Name, Loc: e.Loc}}, true
+}
+
 func (t *translator) idExpr(id *ast.IDExpr, lval bool, inType super.Type) (sem.Expr, super.Type) {
 	if e, typ := t.idExpand(id, lval, inType); e != nil {
 		return e, typ
@@ -713,6 +743,11 @@ func (t *translator) semCaseExpr(c *ast.CaseExpr, inType super.Type) (sem.Expr,
 }
 
 func (t *translator) semCall(call *ast.CallExpr, inType super.Type) (sem.Expr, super.Type) {
+	if f, ok := call.Func.(*ast.FuncNameExpr); ok && strings.ToLower(f.Name) == "grouping" {
+		if scope, ok := t.scope.sql.(*selectScope); ok {
+			return t.groupingCall(scope, call, inType)
+		}
+	}
 	if e, typ := t.maybeConvertAgg(call, inType); e != nil {
 		return e, typ
 	}
@@ -976,6 +1011,13 @@ func (t *translator) lval(e ast.Expr) sem.Expr {
 	if t.scope.sql != nil {
 		panic(t)
 	}
+	if p, ok := e.(*ast.ParamExpr); ok {
+		// An assignment to "$name" assigns the field, which is never
+		// replaced with the value of a parameter.
+		if id, ok := paramID(p); ok {
+			e = id
+		}
+	}
 	var out sem.Expr
 	if id, ok := e.(*ast.IDExpr); ok {
 		out, _ = t.idExpr(id, true, t.checker.unknown)
@@ -1028,6 +1070,10 @@ func deriveNameFromExpr(e ast.Expr) string {
 			return "that"
 		}
 		return e.Name
+	case *ast.ParamExpr:
+		if id, ok := paramID(e); ok {
+			return id.Name
+		}
 	}
 	return sfmt.ASTExpr(e)
 }
@@ -1055,6 +1101,10 @@ func idString(e ast.Expr) (string, bool) {
 	switch e := e.(type) {
 	case *ast.IDExpr:
 		return e.Name, true
+	case *ast.ParamExpr:
+		if id, ok := paramID(e); ok {
+			return id.Name, true
+		}
 	case *ast.DoubleQuoteExpr:
 		return quoteString(e)
 	}
@@ -1200,6 +1250,10 @@ func DotExprToFieldPath(e ast.Expr) *sem.ThisExpr {
 		return this
 	case *ast.IDExpr:
 		return sem.NewThis(e, []string{e.Name})
+	case *ast.ParamExpr:
+		if id, ok := paramID(e); ok {
+			return sem.NewThis(id, []string{id.Name})
+		}
 	}
 	// This includes a null Expr, which can happen if the AST is missing
 	// a field or sets it to null.
@@ -116,11 +116,36 @@ func clrOp(op sem.Op) {
 	case *sem.ValuesOp:
 		op.Node = nil
 		clrExprs(op.Exprs)
+	case *sem.WindowOp:
+		op.Node = nil
+		clrExprs(op.PartitionBy)
+		clrSortExprs(op.OrderBy)
+		clrWindowFuncs(op.Funcs)
+	case *sem.StreamWindowOp:
+		op.Node = nil
+		clrExprs(op.Keys)
+		clrWindowFuncs(op.Funcs)
 	default:
 		panic(op)
 	}
 }
 
+func clrWindowFuncs(funcs []sem.WindowFunc) {
+	for k := range funcs {
+		f := &funcs[k]
+		f.Node = nil
+		clrExpr(f.LHS)
+		clrExprs(f.Args)
+		if f.Agg != nil {
+			clrExpr(f.Agg)
+		}
+		if f.Frame != nil {
+			clrExpr(f.Frame.Start.Offset)
+			clrExpr(f.Frame.End.Offset)
+		}
+	}
+}
+
 func clrAssignments(assignments []sem.Assignment) {
 	for _, a := range assignments {
 		clrExpr(a.LHS)
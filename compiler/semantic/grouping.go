@@ -0,0 +1,228 @@
+package semantic
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/semantic/sem"
+)
+
+// maxGroupingSets limits the number of grouping sets a GROUP BY clause
+// may expand to since each input row is aggregated once per set.
+const maxGroupingSets = 4096
+
+// groupingSets translates a GROUPING SETS, ROLLUP, or CUBE element of a
+// GROUP BY clause.  Each expression is entered into groupings unless an
+// equal expression is already present and the returned sets hold the
+// indexes into groupings of the expressions of each grouping set.
+func (t *translator) groupingSets(sch *selectScope, e *ast.SQLGroupingSets, groupings []exprloc, inType super.Type) ([]exprloc, [][]int) {
+	var elems [][]int
+	for _, set := range e.Sets {
+		var elem []int
+		for _, expr := range set.Exprs {
+			e, typ := t.groupByExpr(sch, expr, inType)
+			k := exprMatch(e, groupings)
+			if k < 0 {
+				k = len(groupings)
+				groupings = append(groupings, exprloc{expr, e, typ})
+			}
+			elem = append(elem, k)
+		}
+		elems = append(elems, elem)
+	}
+	if n := numGroupingSets(e.Type, len(elems)); n < 0 {
+		t.error(e, fmt.Errorf("%s has more than %d grouping sets", strings.ToUpper(e.Type), maxGroupingSets))
+		return groupings, [][]int{nil}
+	}
+	switch e.Type {
+	case "grouping sets":
+		return groupings, elems
+	case "rollup":
+		// ROLLUP(e1, ..., en) is the n+1 prefixes of e1, ..., en from
+		// longest to shortest.
+		var sets [][]int
+		for n := len(elems); n >= 0; n-- {
+			sets = append(sets, slices.Concat(elems[:n]...))
+		}
+		return groupings, sets
+	case "cube":
+		// CUBE(e1, ..., en) is all 2^n subsets of e1, ..., en where a set
+		// containing ek precedes the otherwise equal set without it.
+		n := len(elems)
+		var sets [][]int
+		for mask := 1<<n - 1; mask >= 0; mask-- {
+			var set []int
+			for k, elem := range elems {
+				if mask&(1<<(n-1-k)) != 0 {
+					set = append(set, elem...)
+				}
+			}
+			sets = append(sets, set)
+		}
+		return groupings, sets
+	default:
+		panic(e.Type)
+	}
+}
+
+// numGroupingSets returns the number of grouping sets of a GROUPING SETS,
+// ROLLUP, or CUBE element of typ with n elements or -1 if the number
+// exceeds maxGroupingSets.
+func numGroupingSets(typ string, n int) int {
+	switch typ {
+	case "rollup":
+		n++
+	case "cube":
+		// Check n before shifting since 1<<n overflows for large n.
+		if n >= bits.Len(maxGroupingSets) {
+			return -1
+		}
+		n = 1 << n
+	}
+	if n > maxGroupingSets {
+		return -1
+	}
+	return n
+}
+
+// crossGroupingSets returns the grouping sets formed by the union of each
+// set in a with each set in b or false if their number would exceed
+// maxGroupingSets.
+func crossGroupingSets(a, b [][]int) ([][]int, bool) {
+	if len(b) > 0 && len(a) > maxGroupingSets/len(b) {
+		return nil, false
+	}
+	var out [][]int
+	for _, x := range a {
+		for _, y := range b {
+			set := slices.Concat(x, y)
+			slices.Sort(set)
+			out = append(out, slices.Compact(set))
+		}
+	}
+	return out, true
+}
+
+// genGroupingSets appends to seq an unnest that copies each input row once
+// per grouping set and tags each copy with the index of its set in the
+// "gs" field.  The aggregate then groups by gs along with the grouping
+// expressions of the row's set so every grouping level is computed in one
+// pass over the input.
+func (t *translator) genGroupingSets(loc ast.Loc, scope *selectScope, seq sem.Seq) sem.Seq {
+	var elems []sem.ArrayElem
+	for k := range scope.groupingSets {
+		elems = append(elems, &sem.ExprElem{Node: loc, Expr: intLiteral(loc, k)})
+	}
+	rec := &sem.RecordExpr{
+		Node: loc,
+		Elems: []sem.RecordElem{
+			&sem.FieldElem{Node: loc, Name: "in", Value: sem.NewThis(loc, []string{"in"})},
+			&sem.FieldElem{Node: loc, Name: "gs", Value: &sem.ArrayExpr{Node: loc, Elems: elems}},
+		},
+	}
+	return append(seq, &sem.UnnestOp{Node: loc, Expr: rec})
+}
+
+// groupingSetKey returns the aggregate key for the grouping expression at
+// index k, which is null for the rows of the grouping sets without it.
+func groupingSetKey(scope *selectScope, k int, e exprloc) sem.Expr {
+	var cond sem.Expr
+	for j, set := range scope.groupingSets {
+		if slices.Contains(set, k) {
+			continue
+		}
+		eq := sem.NewBinaryExpr(e.loc, "==", sem.NewThis(e.loc, []string{"gs"}), intLiteral(e.loc, j))
+		if cond == nil {
+			cond = eq
+		} else {
+			cond = sem.NewBinaryExpr(e.loc, "or", cond, eq)
+		}
+	}
+	if cond == nil {
+		return e.expr
+	}
+	return &sem.CondExpr{
+		Node: e.loc,
+		Cond: cond,
+		Then: &sem.PrimitiveExpr{Node: e.loc, Value: "null"},
+		Else: e.expr,
+	}
+}
+
+// groupingCall translates a call to grouping(), which returns an integer
+// bit mask where the bit for each argument is set when the argument is not
+// in the grouping set of the current row.  The leftmost argument is the most
+// significant bit.
+func (t *translator) groupingCall(scope *selectScope, call *ast.CallExpr, inType super.Type) (sem.Expr, super.Type) {
+	if len(call.Args) == 0 || len(call.Args) > 63 {
+		t.error(call, errors.New("grouping() requires between 1 and 63 arguments"))
+		return badExpr, t.checker.unknown
+	}
+	if len(scope.groupings) == 0 {
+		t.error(call, errors.New("grouping() requires a GROUP BY clause"))
+		return badExpr, t.checker.unknown
+	}
+	var index []int
+	for _, arg := range call.Args {
+		e, _ := t.expr(arg, inType)
+		k := groupingIndex(e, scope.groupings)
+		if k < 0 {
+			t.error(arg, errors.New("arguments to grouping() must be grouping expressions"))
+			return badExpr, t.checker.unknown
+		}
+		index = append(index, k)
+	}
+	masks := make([]int, max(len(scope.groupingSets), 1))
+	for j, set := range scope.groupingSets {
+		for _, k := range index {
+			masks[j] <<= 1
+			if !slices.Contains(set, k) {
+				masks[j] |= 1
+			}
+		}
+	}
+	// Test the gs tag of the row from the last set to the first to form a
+	// conditional chain that yields the mask of the row's set.
+	var out sem.Expr = intLiteral(call, masks[len(masks)-1])
+	for j := len(masks) - 2; j >= 0; j-- {
+		if masks[j] == masks[j+1] && isLiteral(out) {
+			continue
+		}
+		out = &sem.CondExpr{
+			Node: call,
+			Cond: sem.NewBinaryExpr(call, "==", sem.NewThis(call, []string{"g", "gs"}), intLiteral(call, j)),
+			Then: intLiteral(call, masks[j]),
+			Else: out,
+		}
+	}
+	return out, super.TypeInt64
+}
+
+// groupingIndex returns the index of e in groupings or -1 if it is not
+// present.  Since column aliases outside the SELECT body resolve to the
+// grouped columns, e may also be a reference to a grouping key.
+func groupingIndex(e sem.Expr, groupings []exprloc) int {
+	if this, ok := e.(*sem.ThisExpr); ok && len(this.Path) == 2 && this.Path[0] == "g" {
+		for k := range groupings {
+			if this.Path[1] == groupTmp(k) {
+				return k
+			}
+		}
+	}
+	return exprMatch(e, groupings)
+}
+
+func isLiteral(e sem.Expr) bool {
+	_, ok := e.(*sem.PrimitiveExpr)
+	return ok
+}
+
+func intLiteral(n ast.Node, v int) *sem.PrimitiveExpr {
+	return &sem.PrimitiveExpr{Node: n, Value: strconv.Itoa(v)}
+}
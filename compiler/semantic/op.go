@@ -17,6 +17,7 @@ import (
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/plural"
 	"github.com/brimdata/super/pkg/reglob"
 	"github.com/brimdata/super/runtime/sam/expr"
@@ -454,8 +455,25 @@ func (t *translator) pool(node ast.Node, poolName string, args []ast.OpArg) sem.
 		t.error(node, err)
 		return badOp
 	}
-	var commitID ksuid.KSUID
 	commit, commitLoc := t.textArg(opArgs, "commit")
+	meta, metaLoc := t.textArg(opArgs, "meta")
+	if _, ok := dag.PoolMetas[meta]; ok {
+		// Pool metadata has no commit but may be viewed as of a time.
+		var at nano.Ts
+		if commit != "" {
+			if at, err = dbid.ParseTime(commit); err != nil {
+				t.error(commitLoc, err)
+				return badOp
+			}
+		}
+		return &sem.PoolMetaScan{
+			Node: node,
+			Meta: meta,
+			ID:   poolID,
+			At:   at,
+		}
+	}
+	var commitID ksuid.KSUID
 	if commit != "" {
 		if commitID, err = dbid.ParseID(commit); err != nil {
 			commitID, err = t.env.CommitObject(t.ctx, poolID, commit)
@@ -465,7 +483,6 @@ func (t *translator) pool(node ast.Node, poolName string, args []ast.OpArg) sem.
 			}
 		}
 	}
-	meta, metaLoc := t.textArg(opArgs, "meta")
 	if meta != "" {
 		if _, ok := dag.CommitMetas[meta]; ok {
 			if commitID == ksuid.Nil {
@@ -485,13 +502,6 @@ func (t *translator) pool(node ast.Node, poolName string, args []ast.OpArg) sem.
 				Tap:    tap,
 			}
 		}
-		if _, ok := dag.PoolMetas[meta]; ok {
-			return &sem.PoolMetaScan{
-				Node: node,
-				Meta: meta,
-				ID:   poolID,
-			}
-		}
 		t.error(metaLoc, fmt.Errorf("unknown metadata type %q", meta))
 		return badOp
 	}
@@ -840,6 +850,9 @@ func (t *translator) semOp(o ast.Op, seq sem.Seq, inType super.Type) (sem.Seq, s
 			Node:  o,
 			Cflag: o.Cflag,
 		}), typ
+	case *ast.WindowOp:
+		op, typ := t.streamWindowOp(o, inType)
+		return append(seq, op), typ
 	case *ast.PassOp:
 		return append(seq, &sem.PassOp{Node: o}), inType
 	case *ast.ExprOp:
@@ -118,6 +118,7 @@ func exprWalk(e sem.Expr, visit exprVisitor) sem.Expr {
 	case *sem.ThisExpr:
 	case *sem.UnaryExpr:
 		e.Operand = exprWalk(e.Operand, visit)
+	case *sem.WindowRef:
 	default:
 		panic(e)
 	}
@@ -84,6 +84,20 @@ type (
 		// The grouped field true when resolution has access only to the outputs.
 		groupings []exprloc
 
+		// groupingSets holds the grouping sets of a GROUP BY with
+		// GROUPING SETS, ROLLUP, or CUBE as indexes into groupings.
+		// It is nil for an ordinary GROUP BY.
+		groupingSets [][]int
+
+		// windowOk is set when it's ok to process window functions, which
+		// are allowed only in the projection.  windows holds the window
+		// functions of the projection, which are computed by WindowOps
+		// ahead of the projection.
+		windowOk bool
+		windows  []*sem.WindowOp
+		// namedWindows holds the resolved windows of the WINDOW clause.
+		namedWindows map[string]*ast.WindowExpr
+
 		// groupByLoc is set when invoking t.expr() from an ordinal reference
 		// so that we can produce a more understandable error message.
 		groupByLoc ast.Node
@@ -591,6 +605,9 @@ func (s *selectScope) gType(sctx *super.Context) super.Type {
 	for k, e := range s.groupings {
 		fields = append(fields, super.NewField(groupTmp(k), e.typ))
 	}
+	if s.groupingSets != nil {
+		fields = append(fields, super.NewField("gs", super.TypeInt64))
+	}
 	for k := range s.aggs {
 		fields = append(fields, super.NewField(aggTmp(k), s.aggTypes[k]))
 	}
@@ -3,6 +3,7 @@ package semantic
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/ast"
@@ -165,6 +166,11 @@ func (s *Scope) resolve(t *translator, n ast.Node, path field.Path, inType super
 		for _, c := range sch.columns {
 			if c.name == path[0] {
 				e := extend(n, c.semExpr, path[1:])
+				if c.window {
+					// Window function results are available only
+					// from the output.
+					e = sem.NewThis(n, slices.Concat(field.Path{"out"}, path))
+				}
 				return e, t.checker.expr(inType, e)
 			}
 		}
@@ -268,6 +274,11 @@ func resolveLateralColumn(t *translator, scope *selectScope, col string, inType
 	}
 	for _, c := range scope.columns {
 		if c.lateral && c.name == col {
+			if c.window && scope.out != nil {
+				// Window function results are available only
+				// from the output.
+				return sem.NewThis(c.astExpr, field.Path{"out", col}), c.typ
+			}
 			defer func() {
 				scope.lateral = true
 			}()
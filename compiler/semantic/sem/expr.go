@@ -126,6 +126,12 @@ type (
 		Op      string
 		Operand Expr
 	}
+	// WindowRef refers to a window function of a SQL SELECT, which is
+	// computed by a WindowOp ahead of the projection.
+	WindowRef struct {
+		ast.Node
+		Index int
+	}
 )
 
 // Support structures embedded in Expr nodes
@@ -199,6 +205,7 @@ func (*SliceExpr) exprNode()        {}
 func (*SubqueryExpr) exprNode()     {}
 func (*ThisExpr) exprNode()         {}
 func (*UnaryExpr) exprNode()        {}
+func (*WindowRef) exprNode()        {}
 
 // FuncRef is a pseudo-expression that represents a function reference as a value.
 // It is not used by the runtime (but could be if we wanted to support this).  Instead,
@@ -434,6 +441,11 @@ func CopyExpr(e Expr) Expr {
 			Op:      e.Op,
 			Operand: CopyExpr(e.Operand),
 		}
+	case *WindowRef:
+		return &WindowRef{
+			Node:  e.Node,
+			Index: e.Index,
+		}
 	default:
 		panic(e)
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/ast"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/segmentio/ksuid"
 )
 
@@ -65,6 +66,7 @@ type (
 		ast.Node
 		ID   ksuid.KSUID
 		Meta string
+		At   nano.Ts
 	}
 	PoolScan struct {
 		ast.Node
@@ -217,6 +219,19 @@ type (
 		ast.Node
 		Exprs []Expr
 	}
+	WindowOp struct {
+		ast.Node
+		PartitionBy []Expr
+		OrderBy     []SortExpr
+		Funcs       []WindowFunc
+	}
+	// StreamWindowOp is the pipe window operator, which computes Funcs
+	// over the values of each partition given by Keys in input order.
+	StreamWindowOp struct {
+		ast.Node
+		Keys  []Expr
+		Funcs []WindowFunc
+	}
 )
 
 // Suport structs for Ops
@@ -237,34 +252,55 @@ type (
 		Order order.Which
 		Nulls order.Nulls
 	}
+	// WindowFunc inside of WindowOp where Agg is set for aggregate
+	// functions and Name and Args otherwise.
+	WindowFunc struct {
+		ast.Node
+		LHS   Expr
+		Name  string
+		Args  []Expr
+		Agg   *AggFunc
+		Frame *WindowFrame
+	}
+	WindowFrame struct {
+		Unit  string
+		Start WindowBound
+		End   WindowBound
+	}
+	WindowBound struct {
+		Type   string
+		Offset Expr
+	}
 )
 
-func (*AggregateOp) opNode() {}
-func (*BadOp) opNode()       {}
-func (*CountOp) opNode()     {}
-func (*CutOp) opNode()       {}
-func (*DebugOp) opNode()     {}
-func (*DistinctOp) opNode()  {}
-func (*DropOp) opNode()      {}
-func (*FilterOp) opNode()    {}
-func (*ForkOp) opNode()      {}
-func (*FuseOp) opNode()      {}
-func (*HeadOp) opNode()      {}
-func (*JoinOp) opNode()      {}
-func (*LoadOp) opNode()      {}
-func (*MergeOp) opNode()     {}
-func (*OutputOp) opNode()    {}
-func (*PassOp) opNode()      {}
-func (*PutOp) opNode()       {}
-func (*RenameOp) opNode()    {}
-func (*SkipOp) opNode()      {}
-func (*SortOp) opNode()      {}
-func (*SwitchOp) opNode()    {}
-func (*TailOp) opNode()      {}
-func (*TopOp) opNode()       {}
-func (*UnnestOp) opNode()    {}
-func (*UniqOp) opNode()      {}
-func (*ValuesOp) opNode()    {}
+func (*AggregateOp) opNode()    {}
+func (*BadOp) opNode()          {}
+func (*CountOp) opNode()        {}
+func (*CutOp) opNode()          {}
+func (*DebugOp) opNode()        {}
+func (*DistinctOp) opNode()     {}
+func (*DropOp) opNode()         {}
+func (*FilterOp) opNode()       {}
+func (*ForkOp) opNode()         {}
+func (*FuseOp) opNode()         {}
+func (*HeadOp) opNode()         {}
+func (*JoinOp) opNode()         {}
+func (*LoadOp) opNode()         {}
+func (*MergeOp) opNode()        {}
+func (*OutputOp) opNode()       {}
+func (*PassOp) opNode()         {}
+func (*PutOp) opNode()          {}
+func (*RenameOp) opNode()       {}
+func (*SkipOp) opNode()         {}
+func (*SortOp) opNode()         {}
+func (*StreamWindowOp) opNode() {}
+func (*SwitchOp) opNode()       {}
+func (*TailOp) opNode()         {}
+func (*TopOp) opNode()          {}
+func (*UnnestOp) opNode()       {}
+func (*UniqOp) opNode()         {}
+func (*ValuesOp) opNode()       {}
+func (*WindowOp) opNode()       {}
 
 func NewValues(n ast.Node, expr ...Expr) *ValuesOp {
 	return &ValuesOp{Node: n, Exprs: expr}
@@ -332,6 +368,7 @@ func CopyOp(op Op) Op {
 			Node: op.Node,
 			ID:   op.ID,
 			Meta: op.Meta,
+			At:   op.At,
 		}
 	case *PoolScan:
 		return &PoolScan{
@@ -495,11 +532,51 @@ func CopyOp(op Op) Op {
 			Node:  op.Node,
 			Exprs: copyExprs(op.Exprs),
 		}
+	case *WindowOp:
+		return &WindowOp{
+			Node:        op.Node,
+			PartitionBy: copyExprs(op.PartitionBy),
+			OrderBy:     copySortExprs(op.OrderBy),
+			Funcs:       copyWindowFuncs(op.Funcs),
+		}
+	case *StreamWindowOp:
+		return &StreamWindowOp{
+			Node:  op.Node,
+			Keys:  copyExprs(op.Keys),
+			Funcs: copyWindowFuncs(op.Funcs),
+		}
 	default:
 		panic(op)
 	}
 }
 
+func copyWindowFuncs(funcs []WindowFunc) []WindowFunc {
+	var out []WindowFunc
+	for _, f := range funcs {
+		var agg *AggFunc
+		if f.Agg != nil {
+			agg = CopyExpr(f.Agg).(*AggFunc)
+		}
+		var frame *WindowFrame
+		if f.Frame != nil {
+			frame = &WindowFrame{
+				Unit:  f.Frame.Unit,
+				Start: WindowBound{Type: f.Frame.Start.Type, Offset: CopyExpr(f.Frame.Start.Offset)},
+				End:   WindowBound{Type: f.Frame.End.Type, Offset: CopyExpr(f.Frame.End.Offset)},
+			}
+		}
+		out = append(out, WindowFunc{
+			Node:  f.Node,
+			LHS:   CopyExpr(f.LHS),
+			Name:  f.Name,
+			Args:  copyExprs(f.Args),
+			Agg:   agg,
+			Frame: frame,
+		})
+	}
+	return out
+}
+
 func copyAssignments(assignment []Assignment) []Assignment {
 	var out []Assignment
 	for _, a := range assignment {
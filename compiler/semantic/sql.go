@@ -50,6 +50,7 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 	// GROUP BY expressions that reference them.
 	fromType := fromScope.superType(t.sctx, t.checker.unknown)
 	inType := t.sctx.MustLookupTypeRecord([]super.Field{super.NewField("in", fromType)})
+	scope.namedWindows = t.sqlWindows(sel.Windows)
 	scope.columns = t.formProjection(scope, sel.Selection.Args, inType)
 	var where sem.Expr
 	if sel.Where != nil {
@@ -71,9 +72,15 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 	// encountered and entered into the scope.aggs table and the real
 	// type checking will be handled above by the ORDER BY logic.
 	t.checker.pushErrs()
+	// Window functions referenced via lateral column aliases are allowed
+	// here but discarded as they are entered into scope.windows when the
+	// projection is analyzed below.
+	scope.windowOk = true
 	for _, e := range demand {
 		t.expr(e, inType)
 	}
+	scope.windowOk = false
+	scope.windows = nil
 	if sel.Having != nil {
 		scope.lateral = false
 		t.expr(sel.Having, inType)
@@ -85,11 +92,14 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 	// (in particular, we know this when there is no GROUP BY) so after this,
 	// we know for sure if the output is grouped or not.
 	scope.lateral = false
+	scope.windowOk = true
 	t.projection(scope.columns, inType)
+	scope.windowOk = false
 	scope.lateral = true
 	if scope.isGrouped() {
 		// Match grouping expressions in the projected column expressions.
 		t.replaceGroupings(scope, scope.columns)
+		t.replaceWindowGroupings(scope)
 	} else if sel.Having != nil {
 		t.error(sel.Having, errors.New("HAVING clause requires aggregation functions and/or a GROUP BY clause"))
 	}
@@ -103,6 +113,13 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 		seq = t.genAggregate(sel.Loc, scope, seq)
 		seq = valuesExpr(wrapThis(sel, "g"), seq)
 	}
+	// Window functions are computed after grouping and HAVING but ahead of
+	// the projection.
+	off := len(seq)
+	if len(scope.windows) > 0 {
+		seq = t.genWindows(scope, seq)
+		replaceWindowRefs(scope.columns)
+	}
 	seq, scope.out = t.emitProjection(scope.columns, scope.isGrouped(), seq)
 	if sel.Having != nil {
 		// We build the having expression after the projection but having
@@ -119,7 +136,14 @@ func (t *translator) sqlSelect(sel *ast.SQLSelect, demand []ast.Expr, seq sem.Se
 		scope.lateral = true
 		if having != nil {
 			t.checker.boolean(sel.Having, typ)
-			seq = append(seq, sem.NewFilter(sel.Having, having))
+			filter := sem.NewFilter(sel.Having, having)
+			if len(scope.windows) > 0 {
+				// HAVING filters the grouped rows ahead of the window
+				// functions.
+				seq = slices.Insert(seq, off, sem.Op(filter))
+			} else {
+				seq = append(seq, filter)
+			}
 		}
 	}
 	if sel.Distinct {
@@ -163,6 +187,9 @@ type column struct {
 	semExpr sem.Expr
 	typ     super.Type
 	lateral bool
+	// window is set when the column references the result of a window
+	// function, which is available only from the projection's output.
+	window bool
 }
 
 func (t *translator) formProjection(scope *selectScope, in []ast.SQLAsExpr, inType super.Type) []column {
@@ -260,6 +287,9 @@ func (t *translator) emitProjection(columns []column, grouped bool, seq sem.Seq)
 }
 
 func (t *translator) genAggregate(loc ast.Loc, scope *selectScope, seq sem.Seq) sem.Seq {
+	if scope.groupingSets != nil {
+		seq = t.genGroupingSets(loc, scope, seq)
+	}
 	var aggCols []sem.Assignment
 	for k, agg := range scope.aggs {
 		a := sem.Assignment{
@@ -271,10 +301,21 @@ func (t *translator) genAggregate(loc ast.Loc, scope *selectScope, seq sem.Seq)
 	}
 	var keyCols []sem.Assignment
 	for k, e := range scope.groupings {
+		rhs := e.expr
+		if scope.groupingSets != nil {
+			rhs = groupingSetKey(scope, k, e)
+		}
 		keyCols = append(keyCols, sem.Assignment{
 			Node: e.loc,
 			LHS:  sem.NewThis(loc, []string{groupTmp(k)}),
-			RHS:  e.expr,
+			RHS:  rhs,
+		})
+	}
+	if scope.groupingSets != nil {
+		keyCols = append(keyCols, sem.Assignment{
+			Node: loc,
+			LHS:  sem.NewThis(loc, []string{"gs"}),
+			RHS:  sem.NewThis(loc, []string{"gs"}),
 		})
 	}
 	return append(seq, &sem.AggregateOp{
@@ -664,27 +705,49 @@ type exprloc struct {
 
 func (t *translator) groupBy(sch *selectScope, in []ast.Expr, inType super.Type) []exprloc {
 	var out []exprloc
+	// sets is the cross product of the grouping sets of each element,
+	// where a plain expression is a single set comprising itself.
+	sets := [][]int{nil}
+	var hasSets bool
 	for _, expr := range in {
-		var typ super.Type
-		var e sem.Expr
-		if colno, ok := isOrdinal(expr); ok {
-			if colno < 1 || colno > len(sch.columns) {
-				t.error(expr, fmt.Errorf("position %d is not in select list", colno))
-				typ = t.checker.unknown
-			} else {
-				save := sch.groupByLoc
-				sch.groupByLoc = expr
-				e, typ = t.expr(sch.columns[colno-1].astExpr, inType)
-				sch.groupByLoc = save
-			}
+		var elemSets [][]int
+		if e, ok := expr.(*ast.SQLGroupingSets); ok {
+			hasSets = true
+			out, elemSets = t.groupingSets(sch, e, out, inType)
 		} else {
-			e, typ = t.expr(expr, inType)
+			e, typ := t.groupByExpr(sch, expr, inType)
+			out = append(out, exprloc{expr, e, typ})
+			elemSets = [][]int{{len(out) - 1}}
+		}
+		var ok bool
+		if sets, ok = crossGroupingSets(sets, elemSets); !ok {
+			t.error(expr, fmt.Errorf("GROUP BY has more than %d grouping sets", maxGroupingSets))
+			return out
 		}
-		out = append(out, exprloc{expr, e, typ})
+	}
+	if hasSets && len(sets) > 1 {
+		sch.groupingSets = sets
 	}
 	return out
 }
 
+func (t *translator) groupByExpr(sch *selectScope, expr ast.Expr, inType super.Type) (sem.Expr, super.Type) {
+	colno, ok := isOrdinal(expr)
+	if !ok {
+		return t.expr(expr, inType)
+	}
+	if colno < 1 || colno > len(sch.columns) {
+		t.error(expr, fmt.Errorf("position %d is not in select list", colno))
+		return nil, t.checker.unknown
+	}
+	save := sch.groupByLoc
+	sch.groupByLoc = expr
+	defer func() {
+		sch.groupByLoc = save
+	}()
+	return t.expr(sch.columns[colno-1].astExpr, inType)
+}
+
 func isOrdinal(e ast.Expr) (int, bool) {
 	if e, ok := e.(*ast.Primitive); ok && e.Type == "int64" {
 		colno, err := strconv.Atoi(e.Text)
@@ -0,0 +1,417 @@
+package semantic
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/semantic/sem"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/runtime/sam/op/window"
+)
+
+// windowExpr translates a window function call with an OVER clause.  Each
+// window function of a SELECT is entered into the scope's window table
+// and computed by a WindowOp ahead of the projection so the call is
+// replaced with a reference to its result.
+func (t *translator) windowExpr(e *ast.WindowExpr, inType super.Type) (sem.Expr, super.Type) {
+	scope, ok := t.scope.sql.(*selectScope)
+	if !ok || !scope.windowOk {
+		t.error(e, errors.New("window functions are allowed only in the SELECT list"))
+		return badExpr, t.checker.unknown
+	}
+	// Window functions cannot be nested.
+	scope.windowOk = false
+	defer func() {
+		scope.windowOk = true
+	}()
+	f := sem.WindowFunc{Node: e}
+	var typ super.Type
+	switch fn := e.Func.(type) {
+	case *ast.AggFuncExpr:
+		nameLower := strings.ToLower(fn.Name)
+		if fn.Expr == nil && nameLower != "count" {
+			t.error(fn, fmt.Errorf("aggregator '%s' requires argument", fn.Name))
+			return badExpr, t.checker.unknown
+		}
+		f.Agg, typ = t.windowAgg(scope, fn, nameLower, fn.Expr, fn.Filter, fn.Distinct, inType)
+	case *ast.CallExpr:
+		name, ok := fn.Func.(*ast.FuncNameExpr)
+		if !ok {
+			t.error(fn, errors.New("window function must be called by name"))
+			return badExpr, t.checker.unknown
+		}
+		nameLower := strings.ToLower(name.Name)
+		if window.IsFunc(nameLower) {
+			if err := window.CheckArgCount(nameLower, len(fn.Args)); err != nil {
+				t.error(fn, err)
+				return badExpr, t.checker.unknown
+			}
+			f.Name = nameLower
+			f.Args, _ = t.exprs(fn.Args, inType)
+			typ = t.checker.windowFuncType(nameLower)
+			break
+		}
+		if _, err := agg.NewPattern(nameLower, false, true); err != nil {
+			t.error(fn, fmt.Errorf("%q is not a window or aggregate function", name.Name))
+			return badExpr, t.checker.unknown
+		}
+		if err := function.CheckArgCount(len(fn.Args), 0, 1); err != nil {
+			t.error(fn, err)
+			return badExpr, t.checker.unknown
+		}
+		var arg ast.Expr
+		if len(fn.Args) == 1 {
+			arg = fn.Args[0]
+		}
+		f.Agg, typ = t.windowAgg(scope, fn, nameLower, arg, nil, false, inType)
+	default:
+		panic(fn)
+	}
+	spec := t.resolveWindow(scope.namedWindows, e)
+	var partitionBy []sem.Expr
+	for _, p := range spec.PartitionBy {
+		expr, _ := t.expr(p, inType)
+		partitionBy = append(partitionBy, expr)
+	}
+	var orderBy []sem.SortExpr
+	for _, s := range spec.OrderBy {
+		orderBy = append(orderBy, t.sortExpr(nil, s, false, inType))
+	}
+	if f.Agg != nil || usesFrame(f.Name) {
+		f.Frame = t.windowFrame(spec, len(orderBy))
+	} else if spec.Frame != nil {
+		t.error(spec.Frame.Loc, fmt.Errorf("window function %q does not accept a frame", f.Name))
+	}
+	k := len(scope.windows)
+	f.LHS = sem.NewThis(e, []string{windowTmp(k)})
+	scope.windows = append(scope.windows, &sem.WindowOp{
+		Node:        e,
+		PartitionBy: partitionBy,
+		OrderBy:     orderBy,
+		Funcs:       []sem.WindowFunc{f},
+	})
+	return &sem.WindowRef{Node: e, Index: k}, typ
+}
+
+// sqlWindows resolves the windows named in the WINDOW clause of a SELECT,
+// each of which may refer to a window named ahead of it.
+func (t *translator) sqlWindows(windows []ast.SQLWindow) map[string]*ast.WindowExpr {
+	if len(windows) == 0 {
+		return nil
+	}
+	named := make(map[string]*ast.WindowExpr)
+	for _, w := range windows {
+		if _, ok := named[w.Name.Name]; ok {
+			t.error(w.Name, fmt.Errorf("window %q is defined more than once", w.Name.Name))
+			continue
+		}
+		named[w.Name.Name] = t.resolveWindow(named, w.Spec)
+	}
+	return named
+}
+
+// resolveWindow returns the window specification of e.  When e refers to a
+// named window, e inherits its PARTITION BY clause and may add an ORDER BY
+// clause and a frame only if the named window has none.
+func (t *translator) resolveWindow(named map[string]*ast.WindowExpr, e *ast.WindowExpr) *ast.WindowExpr {
+	if e.Name == nil {
+		return e
+	}
+	base, ok := named[e.Name.Name]
+	if !ok {
+		t.error(e.Name, fmt.Errorf("window %q is not defined", e.Name.Name))
+		return e
+	}
+	spec := *e
+	spec.Name = nil
+	if len(e.PartitionBy) > 0 {
+		t.error(e.PartitionBy[0], fmt.Errorf("cannot override PARTITION BY of window %q", e.Name.Name))
+	}
+	spec.PartitionBy = base.PartitionBy
+	if len(base.OrderBy) > 0 {
+		if len(e.OrderBy) > 0 {
+			t.error(e.OrderBy[0].Expr, fmt.Errorf("cannot override ORDER BY of window %q", e.Name.Name))
+		}
+		spec.OrderBy = base.OrderBy
+	}
+	if base.Frame != nil {
+		if e.Frame != nil {
+			t.error(e.Frame.Loc, fmt.Errorf("cannot override frame of window %q", e.Name.Name))
+		}
+		spec.Frame = base.Frame
+	}
+	return &spec
+}
+
+// windowAgg translates an aggregate function computed over a window frame.
+// Unlike aggFunc, the function is not entered into the scope's agg table
+// since it is computed per row and not per group.
+func (t *translator) windowAgg(scope *selectScope, n ast.Node, name string, arg, filter ast.Expr, distinct bool, inType super.Type) (*sem.AggFunc, super.Type) {
+	save := scope.out
+	scope.out = nil
+	defer func() {
+		scope.out = save
+	}()
+	argExpr, argType := t.exprNullable(arg, inType)
+	filterExpr, filterType := t.exprNullable(filter, inType)
+	f := &sem.AggFunc{
+		Node:     n,
+		Name:     name,
+		Expr:     argExpr,
+		Filter:   filterExpr,
+		Distinct: distinct,
+	}
+	return f, t.checker.aggFunc(name, arg, argType, filter, filterType)
+}
+
+func usesFrame(name string) bool {
+	switch name {
+	case "first_value", "last_value", "nth_value":
+		return true
+	}
+	return false
+}
+
+// windowFrame translates the frame of e.  When e has no frame, the frame
+// defaults to the rows from the start of the partition through the peers of
+// the current row when there is an ORDER BY and to the whole partition
+// otherwise.
+func (t *translator) windowFrame(e *ast.WindowExpr, norderBy int) *sem.WindowFrame {
+	if e.Frame == nil {
+		if norderBy > 0 {
+			return &sem.WindowFrame{
+				Unit:  window.Range,
+				Start: sem.WindowBound{Type: window.UnboundedPreceding},
+				End:   sem.WindowBound{Type: window.CurrentRow},
+			}
+		}
+		return &sem.WindowFrame{
+			Unit:  window.Rows,
+			Start: sem.WindowBound{Type: window.UnboundedPreceding},
+			End:   sem.WindowBound{Type: window.UnboundedFollowing},
+		}
+	}
+	frame := &sem.WindowFrame{
+		Unit:  e.Frame.Unit,
+		Start: t.windowBound(e.Frame.Unit, e.Frame.Start),
+		End:   t.windowBound(e.Frame.Unit, e.Frame.End),
+	}
+	switch {
+	case frame.Start.Type == window.UnboundedFollowing:
+		t.error(e.Frame.Loc, errors.New("frame start cannot be UNBOUNDED FOLLOWING"))
+	case frame.End.Type == window.UnboundedPreceding:
+		t.error(e.Frame.Loc, errors.New("frame end cannot be UNBOUNDED PRECEDING"))
+	case boundRank(frame.Start.Type) > boundRank(frame.End.Type):
+		t.error(e.Frame.Loc, errors.New("frame starts after it ends"))
+	}
+	if frame.Unit == window.Range && norderBy != 1 && (frame.Start.Offset != nil || frame.End.Offset != nil) {
+		t.error(e.Frame.Loc, errors.New("RANGE with offset requires exactly one ORDER BY expression"))
+	}
+	return frame
+}
+
+func (t *translator) windowBound(unit string, b ast.WindowBound) sem.WindowBound {
+	if b.Offset == nil {
+		return sem.WindowBound{Type: b.Type}
+	}
+	e, _ := t.expr(b.Offset, super.TypeNull)
+	val, ok := t.mustEval(e)
+	if !ok {
+		return sem.WindowBound{Type: b.Type, Offset: badExpr}
+	}
+	id := super.TypeUnder(val.Type()).ID()
+	switch {
+	case val.IsNull():
+		t.error(b.Offset, errors.New("frame offset cannot be null"))
+	case unit == window.Rows && !super.IsInteger(id):
+		t.error(b.Offset, errors.New("ROWS frame offset must be an integer"))
+	case !super.IsNumber(id) && id != super.IDDuration:
+		t.error(b.Offset, errors.New("RANGE frame offset must be a number or duration"))
+	case super.IsSigned(id) && val.Int() < 0 || super.IsFloat(id) && val.Float() < 0:
+		t.error(b.Offset, errors.New("frame offset cannot be negative"))
+	}
+	return sem.WindowBound{Type: b.Type, Offset: sem.NewLiteral(b.Offset, val)}
+}
+
+func boundRank(typ string) int {
+	return slices.Index([]string{
+		window.UnboundedPreceding,
+		window.Preceding,
+		window.CurrentRow,
+		window.Following,
+		window.UnboundedFollowing,
+	}, typ)
+}
+
+// genWindows appends to seq a WindowOp for each distinct partitioning and
+// ordering of the window functions in scope.
+func (t *translator) genWindows(scope *selectScope, seq sem.Seq) sem.Seq {
+	var ops []*sem.WindowOp
+	for _, w := range scope.windows {
+		if k := slices.IndexFunc(ops, func(op *sem.WindowOp) bool { return eqWindowSpec(op, w) }); k >= 0 {
+			ops[k].Funcs = append(ops[k].Funcs, w.Funcs...)
+			continue
+		}
+		ops = append(ops, w)
+	}
+	for _, op := range ops {
+		seq = append(seq, op)
+	}
+	return seq
+}
+
+// replaceWindowGroupings matches the grouping expressions in the window
+// functions of a grouped select.
+func (t *translator) replaceWindowGroupings(scope *selectScope) {
+	for _, w := range scope.windows {
+		for k, e := range w.PartitionBy {
+			w.PartitionBy[k], _ = replaceGroupings(t, e, scope.groupings)
+		}
+		for k, s := range w.OrderBy {
+			w.OrderBy[k].Expr, _ = replaceGroupings(t, s.Expr, scope.groupings)
+		}
+		for k := range w.Funcs {
+			f := &w.Funcs[k]
+			for j, e := range f.Args {
+				f.Args[j], _ = replaceGroupings(t, e, scope.groupings)
+			}
+			if f.Agg != nil {
+				f.Agg.Expr, _ = replaceGroupings(t, f.Agg.Expr, scope.groupings)
+				f.Agg.Filter, _ = replaceGroupings(t, f.Agg.Filter, scope.groupings)
+			}
+		}
+	}
+}
+
+// replaceWindowRefs replaces the window function references in columns
+// with the fields computed by the WindowOps.
+func replaceWindowRefs(columns []column) {
+	for k, c := range columns {
+		columns[k].semExpr = exprWalk(c.semExpr, func(e sem.Expr) (sem.Expr, bool) {
+			if ref, ok := e.(*sem.WindowRef); ok {
+				columns[k].window = true
+				return sem.NewThis(ref.Node, []string{windowTmp(ref.Index)}), true
+			}
+			return e, false
+		})
+	}
+}
+
+func windowTmp(k int) string {
+	return fmt.Sprintf("w%d", k)
+}
+
+// streamWindowOp translates the window operator, which computes its
+// functions over each partition in input order.  Only the functions that
+// can be computed in a single pass are allowed and aggregate functions
+// are computed over the values of the partition seen so far.
+func (t *translator) streamWindowOp(o *ast.WindowOp, inType super.Type) (*sem.StreamWindowOp, super.Type) {
+	keys, _ := t.exprs(o.Keys, inType)
+	var funcs []sem.WindowFunc
+	var paths []pathType
+	var fields field.List
+	for k := range o.Funcs {
+		a := &o.Funcs[k]
+		f, typ, ok := t.streamWindowFunc(a.RHS, inType)
+		if !ok {
+			continue
+		}
+		if a.LHS == nil {
+			f.LHS = sem.NewThis(a.RHS, []string{deriveNameFromExpr(a.RHS)})
+		} else {
+			f.LHS = t.lval(a.LHS)
+		}
+		this, ok := f.LHS.(*sem.ThisExpr)
+		if !ok {
+			t.error(a, errors.New("output field must be static"))
+			continue
+		}
+		if len(this.Path) == 0 {
+			t.error(a, errors.New("cannot assign to 'this'"))
+			continue
+		}
+		funcs = append(funcs, f)
+		paths = append(paths, pathType{this.Path, typ})
+		fields = append(fields, this.Path)
+	}
+	if err := checkPutFields(fields); err != nil {
+		t.error(o, err)
+	}
+	op := &sem.StreamWindowOp{
+		Node:  o,
+		Keys:  keys,
+		Funcs: funcs,
+	}
+	return op, t.checker.putPaths(inType, paths)
+}
+
+func (t *translator) streamWindowFunc(e ast.Expr, inType super.Type) (sem.WindowFunc, super.Type, bool) {
+	f := sem.WindowFunc{Node: e}
+	switch e := e.(type) {
+	case *ast.AggFuncExpr:
+		nameLower := strings.ToLower(e.Name)
+		if e.Expr == nil && nameLower != "count" {
+			t.error(e, fmt.Errorf("aggregator '%s' requires argument", e.Name))
+			return f, nil, false
+		}
+		agg, typ := t.aggFunc(e, nameLower, e.Expr, e.Filter, e.Distinct, inType)
+		f.Agg, _ = agg.(*sem.AggFunc)
+		return f, typ, f.Agg != nil
+	case *ast.CallExpr:
+		name, ok := e.Func.(*ast.FuncNameExpr)
+		if !ok {
+			t.error(e, errors.New("window function must be called by name"))
+			return f, nil, false
+		}
+		nameLower := strings.ToLower(name.Name)
+		if window.IsStreamFunc(nameLower) {
+			if err := window.CheckArgCount(nameLower, len(e.Args)); err != nil {
+				t.error(e, err)
+				return f, nil, false
+			}
+			f.Name = nameLower
+			f.Args, _ = t.exprs(e.Args, inType)
+			if len(f.Args) > 1 {
+				// The offset of lag and lead must be constant to bound
+				// the state kept for each partition.
+				f.Args[1] = t.streamWindowOffset(e.Args[1], f.Args[1])
+			}
+			return f, t.checker.windowFuncType(nameLower), true
+		}
+		if window.IsFunc(nameLower) {
+			t.error(e, fmt.Errorf("window function %q is not supported by the window operator", name.Name))
+			return f, nil, false
+		}
+		agg, typ := t.maybeConvertAgg(e, inType)
+		if agg == nil {
+			t.error(e, fmt.Errorf("%q is not a window or aggregate function", name.Name))
+			return f, nil, false
+		}
+		f.Agg, _ = agg.(*sem.AggFunc)
+		return f, typ, f.Agg != nil
+	}
+	t.error(e, errors.New("window operator requires a window or aggregate function"))
+	return f, nil, false
+}
+
+func (t *translator) streamWindowOffset(n ast.Node, e sem.Expr) sem.Expr {
+	val, ok := t.mustEval(e)
+	if !ok {
+		return badExpr
+	}
+	if !super.IsInteger(super.TypeUnder(val.Type()).ID()) || val.IsNull() {
+		t.error(n, errors.New("window offset must be a constant integer"))
+		return badExpr
+	}
+	if val.AsInt() < 0 {
+		t.error(n, errors.New("window offset cannot be negative"))
+		return badExpr
+	}
+	return sem.NewLiteral(n, val)
+}
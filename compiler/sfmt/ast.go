@@ -95,6 +95,8 @@ func (c *canon) expr(e ast.Expr, parent string) {
 		c.literal(*e)
 	case *ast.IDExpr:
 		c.write(e.Name)
+	case *ast.ParamExpr:
+		c.write("$%s", e.Name)
 	case *ast.DoubleQuoteExpr:
 		c.write("%q", e.Text)
 	case *ast.UnaryExpr:
@@ -215,6 +217,29 @@ func (c *canon) expr(e ast.Expr, parent string) {
 			c.expr(e.For, "")
 		}
 		c.write(")")
+	case *ast.SQLGroupingSets:
+		c.write("%s(", e.Type)
+		for k, set := range e.Sets {
+			if k > 0 {
+				c.write(", ")
+			}
+			if len(set.Exprs) == 1 && e.Type != "grouping sets" {
+				c.expr(set.Exprs[0], "")
+				continue
+			}
+			c.write("(")
+			c.exprs(set.Exprs)
+			c.write(")")
+		}
+		c.write(")")
+	case *ast.WindowExpr:
+		c.expr(e.Func, "")
+		if e.Name != nil && len(e.PartitionBy) == 0 && len(e.OrderBy) == 0 && e.Frame == nil {
+			c.write(" over %s", e.Name.Name)
+			break
+		}
+		c.write(" over ")
+		c.windowSpec(e)
 	case *ast.ArrayExpr:
 		c.write("[")
 		c.arrayElems(e.Elems)
@@ -576,6 +601,14 @@ func (c *canon) op(p ast.Op) {
 		if p.Cflag {
 			c.write(" -c")
 		}
+	case *ast.WindowOp:
+		c.next()
+		c.write("window ")
+		c.assignments(p.Funcs)
+		if len(p.Keys) != 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
 	case *ast.PassOp:
 		c.next()
 		c.write("pass")
@@ -770,6 +803,17 @@ func (c *canon) sqlQueryBody(query ast.SQLQueryBody) {
 			c.write("having ")
 			c.expr(query.Having, "")
 		}
+		if len(query.Windows) > 0 {
+			c.ret()
+			c.write("window ")
+			for i, w := range query.Windows {
+				if i > 0 {
+					c.write(", ")
+				}
+				c.write("%s as ", w.Name.Name)
+				c.windowSpec(w.Spec)
+			}
+		}
 	case *ast.SQLUnion:
 		c.sqlQueryBody(query.Left)
 		c.ret()
@@ -960,6 +1004,40 @@ func (c *canon) sortExprs(sortExprs []ast.SortExpr) {
 	}
 }
 
+func (c *canon) windowSpec(e *ast.WindowExpr) {
+	c.write("(")
+	var sep string
+	if e.Name != nil {
+		c.write("%s", e.Name.Name)
+		sep = " "
+	}
+	if len(e.PartitionBy) > 0 {
+		c.write(sep + "partition by ")
+		c.exprs(e.PartitionBy)
+		sep = " "
+	}
+	if len(e.OrderBy) > 0 {
+		c.write(sep + "order by")
+		c.sortExprs(e.OrderBy)
+		sep = " "
+	}
+	if e.Frame != nil {
+		c.write("%s%s between ", sep, e.Frame.Unit)
+		c.windowBound(e.Frame.Start)
+		c.write(" and ")
+		c.windowBound(e.Frame.End)
+	}
+	c.write(")")
+}
+
+func (c *canon) windowBound(b ast.WindowBound) {
+	if b.Offset != nil {
+		c.expr(b.Offset, "")
+		c.space()
+	}
+	c.write(b.Type)
+}
+
 func (c *canon) opArgs(args []ast.OpArg) {
 	if len(args) == 0 {
 		return
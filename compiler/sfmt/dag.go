@@ -20,6 +20,20 @@ func DAG(main *dag.Main) string {
 	return d.String()
 }
 
+// AnnotatedDAG is like DAG but follows each operator with a comment holding
+// the text returned by annotate for that operator unless the text is empty.
+func AnnotatedDAG(main *dag.Main, annotate func(dag.Op) string) string {
+	d := &canonDAG{
+		shared:   shared{formatter: formatter{tab: 2}},
+		head:     true,
+		first:    true,
+		annotate: annotate,
+	}
+	d.main(main)
+	d.flush()
+	return d.String()
+}
+
 func DAGSeq(seq dag.Seq) string {
 	return DAG(&dag.Main{Body: seq})
 }
@@ -37,8 +51,9 @@ func DAGExpr(e dag.Expr) string {
 
 type canonDAG struct {
 	shared
-	head  bool
-	first bool
+	head     bool
+	first    bool
+	annotate func(dag.Op) string
 }
 
 func (c *canonDAG) assignments(assignments []dag.Assignment) {
@@ -91,6 +106,14 @@ func (c *canonDAG) expr(e dag.Expr, parent string) {
 
 	case *dag.BinaryExpr:
 		c.binary(e, parent)
+	case *dag.BloomFilterExpr:
+		if e.Search {
+			c.write("bloom_search(")
+		} else {
+			c.write("bloom_filter(")
+		}
+		c.expr(e.Expr, "")
+		c.write(", %s)", e.Value)
 	case *dag.CondExpr:
 		c.write("(")
 		c.expr(e.Cond, "")
@@ -162,6 +185,10 @@ func (c *canonDAG) expr(e dag.Expr, parent string) {
 		c.head = true
 		c.seq(e.Body)
 		c.close()
+		if c.annotate != nil {
+			// Keep the paren out of the last operator's comment.
+			c.ret()
+		}
 		c.write(")")
 	case *dag.ThisExpr:
 		c.fieldpath(e.Path)
@@ -274,6 +301,11 @@ func (c *canonDAG) next() {
 func (c *canonDAG) seq(seq dag.Seq) {
 	for _, p := range seq {
 		c.op(p)
+		if c.annotate != nil {
+			if s := c.annotate(p); s != "" {
+				c.write(" -- %s", s)
+			}
+		}
 	}
 }
 
@@ -351,6 +383,11 @@ func (c *canonDAG) op(p dag.Op) {
 			c.expr(p.KeyPruner, "")
 			c.write(")")
 		}
+		if mf := p.MetaFilter; mf != nil {
+			c.write(" metafilter (")
+			c.expr(mf.Expr, "")
+			c.write(")")
+		}
 		c.close()
 	case *dag.NullScan:
 		c.next()
@@ -467,6 +504,9 @@ func (c *canonDAG) op(p dag.Op) {
 		c.expr(p.LeftKey, "")
 		c.write("==")
 		c.expr(p.RightKey, "")
+		if p.Build != "" {
+			c.write(" build %s", p.Build)
+		}
 	case *dag.HeadOp:
 		c.next()
 		c.write("head %d", p.Count)
@@ -589,6 +629,29 @@ func (c *canonDAG) op(p dag.Op) {
 		c.next()
 		c.write("values ")
 		c.exprs(p.Exprs)
+	case *dag.StreamWindowOp:
+		c.next()
+		c.write("window ")
+		if p.InputSorted {
+			c.write("input-sorted ")
+		}
+		c.windowFuncs(p.Funcs)
+		if len(p.Keys) > 0 {
+			c.write(" by ")
+			c.exprs(p.Keys)
+		}
+	case *dag.WindowOp:
+		c.next()
+		c.write("window ")
+		c.windowFuncs(p.Funcs)
+		if len(p.PartitionBy) > 0 {
+			c.write(" partition by ")
+			c.exprs(p.PartitionBy)
+		}
+		if len(p.OrderBy) > 0 {
+			c.write(" order by")
+			c.sortExprs(p.OrderBy)
+		}
 	default:
 		c.next()
 		c.open("unknown operator: %T", p)
@@ -634,6 +697,37 @@ func (c *canonDAG) main(main *dag.Main) {
 	c.seq(main.Body)
 }
 
+func (c *canonDAG) windowFuncs(funcs []dag.WindowFunc) {
+	for k, f := range funcs {
+		if k > 0 {
+			c.write(",")
+		}
+		c.expr(f.LHS, "")
+		c.write(":=")
+		if f.Agg != nil {
+			c.expr(f.Agg, "")
+		} else {
+			c.write("%s(", f.Name)
+			c.exprs(f.Args)
+			c.write(")")
+		}
+		if f.Frame != nil {
+			c.write(" %s between ", f.Frame.Unit)
+			c.windowBound(f.Frame.Start)
+			c.write(" and ")
+			c.windowBound(f.Frame.End)
+		}
+	}
+}
+
+func (c *canonDAG) windowBound(b dag.WindowBound) {
+	if b.Offset != nil {
+		c.expr(b.Offset, "")
+		c.space()
+	}
+	c.write(b.Type)
+}
+
 func (c *canonDAG) sortExprs(sortExprs []dag.SortExpr) {
 	for i, s := range sortExprs {
 		if i > 0 {
@@ -10,7 +10,7 @@ type ErrorList []*Error
 
 // Append appends an Error to e.
 func (e *ErrorList) Append(list *List, msg string, pos, end int) {
-	*e = append(*e, &Error{msg, pos, end, list})
+	*e = append(*e, &Error{Msg: msg, Pos: pos, End: end, list: list})
 }
 
 // Bind takes errors that were created elsewhere (e.g., the service) using
@@ -37,9 +37,15 @@ type Error struct {
 	Msg  string
 	Pos  int
 	End  int
+	err  error
 	list *List
 }
 
+// Unwrap returns the error from which e was created, if any.
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
 func (e *Error) Error() string {
 	if e.list == nil || e.Pos < 0 {
 		return e.Msg
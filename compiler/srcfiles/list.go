@@ -38,6 +38,12 @@ func (l *List) AddError(msg string, pos, end int) {
 	l.errors.Append(l, msg, pos, end)
 }
 
+// AddErr is like AddError but keeps err so that it may be matched with
+// errors.Is and errors.As.
+func (l *List) AddErr(err error, pos, end int) {
+	l.errors = append(l.errors, &Error{Msg: err.Error(), Pos: pos, End: end, err: err, list: l})
+}
+
 func (l *List) Error() error {
 	if len(l.errors) == 0 {
 		return nil
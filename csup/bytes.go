@@ -6,6 +6,7 @@ import (
 	"math"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/bloom"
 	"github.com/brimdata/super/scode"
 	"golang.org/x/sync/errgroup"
 )
@@ -50,7 +51,6 @@ func (b *BytesEncoder) Encode(group *errgroup.Group) {
 		b.bytesFmt = fmt
 		b.bytesOut = out
 		b.bytesLen = uint64(len(b.bytes))
-		b.bytes = nil // send to GC
 		return nil
 	})
 	b.offsets.Encode(group)
@@ -64,13 +64,23 @@ func (b *BytesEncoder) Metadata(cctx *Context, off uint64) (uint64, ID) {
 		CompressionFormat: b.bytesFmt,
 	}
 	off, offsLoc := b.offsets.Segment(off + bytesLoc.Length)
+	count := uint32(len(b.offsets.vals) - 1)
+	stats := newLeafStats(cctx, int(count), bloom.Hashable(b.typ))
+	if stats.enabled() {
+		for slot := range count {
+			stats.add(bloom.HashBytes(b.typ, b.value(slot)))
+		}
+	}
+	b.bytes = nil // send to GC
 	return off, cctx.enter(&Bytes{
 		Typ:     b.typ,
 		Bytes:   bytesLoc,
 		Offsets: offsLoc,
 		Min:     b.min,
 		Max:     b.max,
-		Count:   uint32(len(b.offsets.vals) - 1),
+		Count:   count,
+		Bloom:   stats.bloom,
+		NDV:     stats.ndv(),
 	})
 }
 
@@ -18,6 +18,10 @@ type Context struct {
 	metas  []Metadata     // id to Metadata
 	values []super.Value  // id to unmarshaled Metadata
 	uctx   *sup.UnmarshalBSUPContext
+	// bloom and sketch are true if encoders add Bloom filters and
+	// distinct-value sketches, respectively, to leaf metadata.
+	bloom  bool
+	sketch bool
 }
 
 type ID uint32
@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/bloom"
 	"github.com/brimdata/super/pkg/byteconv"
 	"github.com/brimdata/super/scode"
 	"golang.org/x/sync/errgroup"
@@ -51,12 +52,20 @@ func (u *FloatEncoder) Metadata(cctx *Context, off uint64) (uint64, ID) {
 		CompressionFormat: u.fmt,
 	}
 	off += loc.Length
+	stats := newLeafStats(cctx, len(u.vals), true)
+	if stats.enabled() {
+		for _, v := range u.vals {
+			stats.add(bloom.HashFloat(v))
+		}
+	}
 	return off, cctx.enter(&Float{
 		Typ:      u.typ,
 		Location: loc,
 		Min:      u.min,
 		Max:      u.max,
 		Count:    uint32(len(u.vals)),
+		Bloom:    stats.bloom,
+		NDV:      stats.ndv(),
 	})
 }
 
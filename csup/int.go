@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/bloom"
 	"github.com/brimdata/super/pkg/byteconv"
 	"github.com/brimdata/super/scode"
 	"github.com/ronanh/intcomp"
@@ -50,12 +51,20 @@ func (i *IntEncoder) Metadata(cctx *Context, off uint64) (uint64, ID) {
 		CompressionFormat: CompressionFormatNone,
 	}
 	off += loc.MemLength
+	stats := newLeafStats(cctx, len(i.vals), true)
+	if stats.enabled() {
+		for _, v := range i.vals {
+			stats.add(bloom.HashInt(v))
+		}
+	}
 	return off, cctx.enter(&Int{
 		Typ:      i.typ,
 		Location: loc,
 		Min:      i.min,
 		Max:      i.max,
 		Count:    uint32(len(i.vals)),
+		Bloom:    stats.bloom,
+		NDV:      stats.ndv(),
 	})
 }
 
@@ -122,12 +131,20 @@ func (u *UintEncoder) Metadata(cctx *Context, off uint64) (uint64, ID) {
 		CompressionFormat: CompressionFormatNone,
 	}
 	off += loc.MemLength
+	stats := newLeafStats(cctx, len(u.vals), true)
+	if stats.enabled() {
+		for _, v := range u.vals {
+			stats.add(bloom.HashUint(v))
+		}
+	}
 	return off, cctx.enter(&Uint{
 		Typ:      u.typ,
 		Location: loc,
 		Min:      u.min,
 		Max:      u.max,
 		Count:    uint32(len(u.vals)),
+		Bloom:    stats.bloom,
+		NDV:      stats.ndv(),
 	})
 }
 
@@ -108,6 +108,8 @@ type Int struct {
 	Min      int64
 	Max      int64
 	Count    uint32
+	Bloom    []byte
+	NDV      []byte
 }
 
 func (i *Int) Type(*Context, *super.Context) super.Type {
@@ -124,6 +126,8 @@ type Uint struct {
 	Min      uint64
 	Max      uint64
 	Count    uint32
+	Bloom    []byte
+	NDV      []byte
 }
 
 func (u *Uint) Type(*Context, *super.Context) super.Type {
@@ -140,6 +144,8 @@ type Float struct {
 	Min      float64
 	Max      float64
 	Count    uint32
+	Bloom    []byte
+	NDV      []byte
 }
 
 func (f *Float) Type(*Context, *super.Context) super.Type {
@@ -157,6 +163,8 @@ type Bytes struct {
 	Min     []byte
 	Max     []byte
 	Count   uint32
+	Bloom   []byte
+	NDV     []byte
 }
 
 func (b *Bytes) Type(*Context, *super.Context) super.Type {
@@ -173,6 +181,8 @@ type Primitive struct {
 	Min      *super.Value
 	Max      *super.Value
 	Count    uint32
+	Bloom    []byte
+	NDV      []byte
 }
 
 func (p *Primitive) Type(*Context, *super.Context) super.Type {
@@ -258,32 +268,39 @@ func metadataValue(cctx *Context, sctx *super.Context, b *scode.Builder, id ID,
 		if m.Max != nil {
 			max = *m.Max
 		}
-		return metadataLeaf(sctx, b, min, max)
+		return metadataLeaf(sctx, b, min, max, m.Bloom)
 	case *Int:
-		return metadataLeaf(sctx, b, super.NewInt(m.Typ, m.Min), super.NewInt(m.Typ, m.Max))
+		return metadataLeaf(sctx, b, super.NewInt(m.Typ, m.Min), super.NewInt(m.Typ, m.Max), m.Bloom)
 	case *Uint:
-		return metadataLeaf(sctx, b, super.NewUint(m.Typ, m.Min), super.NewUint(m.Typ, m.Max))
+		return metadataLeaf(sctx, b, super.NewUint(m.Typ, m.Min), super.NewUint(m.Typ, m.Max), m.Bloom)
 	case *Float:
-		return metadataLeaf(sctx, b, super.NewFloat(m.Typ, m.Min), super.NewFloat(m.Typ, m.Max))
+		return metadataLeaf(sctx, b, super.NewFloat(m.Typ, m.Min), super.NewFloat(m.Typ, m.Max), m.Bloom)
 	case *Bytes:
-		return metadataLeaf(sctx, b, super.NewValue(m.Typ, m.Min), super.NewValue(m.Typ, m.Max))
+		return metadataLeaf(sctx, b, super.NewValue(m.Typ, m.Min), super.NewValue(m.Typ, m.Max), m.Bloom)
 	case *Const:
-		return metadataLeaf(sctx, b, m.Value, m.Value)
+		return metadataLeaf(sctx, b, m.Value, m.Value, nil)
 	default:
 		b.Append(nil)
 		return super.TypeNull
 	}
 }
 
-func metadataLeaf(sctx *super.Context, b *scode.Builder, min, max super.Value) super.Type {
+// metadataLeaf appends a record with the min and max of a leaf vector and,
+// if the vector has a Bloom filter, the filter.
+func metadataLeaf(sctx *super.Context, b *scode.Builder, min, max super.Value, bloom []byte) super.Type {
+	fields := []super.Field{
+		super.NewField("min", min.Type()),
+		super.NewField("max", max.Type()),
+	}
 	b.BeginContainer()
 	b.Append(min.Bytes())
 	b.Append(max.Bytes())
+	if bloom != nil {
+		b.Append(bloom)
+		fields = append(fields, super.NewField("bloom", super.TypeBytes))
+	}
 	b.EndContainer()
-	return sctx.MustLookupTypeRecord([]super.Field{
-		super.NewField("min", min.Type()),
-		super.NewField("max", max.Type()),
-	})
+	return sctx.MustLookupTypeRecord(fields)
 }
 
 func indexOfField(name string, fields []Field) int {
@@ -77,6 +77,10 @@ func (o *Object) Size() uint64 {
 	return o.header.ObjectSize()
 }
 
+func (o *Object) MetaSize() uint64 {
+	return o.header.MetaSize
+}
+
 func (o *Object) ProjectMetadata(sctx *super.Context, projection field.Projection) []super.Value {
 	var b scode.Builder
 	var values []super.Value
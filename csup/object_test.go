@@ -2,11 +2,13 @@ package csup_test
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/csup"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sup"
 	"github.com/stretchr/testify/require"
@@ -34,3 +36,60 @@ func TestObjectProjectMetadata(t *testing.T) {
 	require.Len(t, values, 1)
 	require.Equal(t, "{b:{d:{min:0.7,max:0.9}},a:{min:1,max:3}}", sup.FormatValue(values[0]))
 }
+
+func TestObjectProjectMetadataBloom(t *testing.T) {
+	var b bytes.Buffer
+	w := csup.NewWriterWithOpts(sio.NopCloser(&b), csup.WriterOpts{BloomFilters: true})
+	sctx := super.NewContext()
+	for _, s := range []string{`{a:1,s:"foo"}`, `{a:5,s:"bar"}`, `{a:9,s:"baz"}`} {
+		require.NoError(t, w.Write(sup.MustParseValue(sctx, s)))
+	}
+	require.NoError(t, w.Close())
+
+	o, err := csup.NewObject(bytes.NewReader(b.Bytes()))
+	require.NoError(t, err)
+	values := o.ProjectMetadata(sctx, nil)
+	require.Len(t, values, 1)
+	mayContain := func(path, literal string, search bool) bool {
+		e := expr.NewDottedExpr(sctx, field.Dotted(path))
+		val := expr.NewBloomFilter(e, sup.MustParseValue(sctx, literal), search).Eval(values[0])
+		return val.AsBool()
+	}
+	require.True(t, mayContain("a.bloom", "5", false))
+	require.True(t, mayContain("a.bloom", "5.0", false))
+	require.False(t, mayContain("a.bloom", "4", false))
+	require.True(t, mayContain("s.bloom", `"bar"`, false))
+	require.False(t, mayContain("s.bloom", `"bat"`, false))
+	require.True(t, mayContain("missing.bloom", `"bat"`, false))
+	// A search term may match a substring of any string.
+	require.True(t, mayContain("", "4", true))
+	require.False(t, mayContain("a", "4", true))
+}
+
+func TestObjectSketch(t *testing.T) {
+	var b bytes.Buffer
+	w := csup.NewWriterWithOpts(sio.NopCloser(&b), csup.WriterOpts{Sketches: true})
+	sctx := super.NewContext()
+	for i := range 1000 {
+		val := sup.MustParseValue(sctx, fmt.Sprintf(`{a:%d,r:{s:"%d"},c:1}`, i, i%10))
+		require.NoError(t, w.Write(val))
+	}
+	require.NoError(t, w.Write(sup.MustParseValue(sctx, `{a:"x"}`)))
+	require.NoError(t, w.Close())
+
+	o, err := csup.NewObject(bytes.NewReader(b.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, uint32(1001), o.Len())
+	estimate := func(path ...string) uint64 {
+		s, ok := o.Sketch(path)
+		require.True(t, ok)
+		return s.Estimate()
+	}
+	require.InDelta(t, 1001, estimate("a"), 20)
+	require.Equal(t, uint64(10), estimate("r", "s"))
+	require.Equal(t, uint64(1), estimate("c"))
+	_, ok := o.Sketch(field.Path{"r"})
+	require.False(t, ok)
+	_, ok = o.Sketch(field.Path{"missing"})
+	require.False(t, ok)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/bloom"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/scode"
 	"golang.org/x/sync/errgroup"
@@ -59,7 +60,6 @@ func (p *ScodeEncoder) Encode(group *errgroup.Group) {
 		p.format = fmt
 		p.out = out
 		p.bytesLen = uint64(len(p.bytes))
-		p.bytes = nil // send to GC
 		return nil
 	})
 }
@@ -72,12 +72,21 @@ func (p *ScodeEncoder) Metadata(cctx *Context, off uint64) (uint64, ID) {
 		CompressionFormat: p.format,
 	}
 	off += uint64(len(p.out))
+	stats := newLeafStats(cctx, int(p.count), bloom.Hashable(p.typ))
+	if stats.enabled() {
+		for it := p.bytes.Iter(); !it.Done(); {
+			stats.add(bloom.HashBytes(p.typ, it.Next()))
+		}
+	}
+	p.bytes = nil // send to GC
 	return off, cctx.enter(&Primitive{
 		Typ:      p.typ,
 		Location: loc,
 		Count:    p.count,
 		Min:      p.min,
 		Max:      p.max,
+		Bloom:    stats.bloom,
+		NDV:      stats.ndv(),
 	})
 }
 
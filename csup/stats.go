@@ -0,0 +1,147 @@
+package csup
+
+import (
+	"github.com/axiomhq/hyperloglog"
+	"github.com/brimdata/super/pkg/bloom"
+	"github.com/brimdata/super/pkg/field"
+)
+
+// leafStats accumulates the optional Bloom filter and distinct-value sketch
+// of the values of a primitive vector.
+type leafStats struct {
+	bloom  bloom.Filter
+	sketch *hyperloglog.Sketch
+}
+
+// newLeafStats returns the stats for a vector of n values of a type that is
+// hashable by the bloom package if hashable is true.
+func newLeafStats(cctx *Context, n int, hashable bool) leafStats {
+	var s leafStats
+	if !hashable {
+		return s
+	}
+	if cctx.bloom {
+		s.bloom = bloom.New(n)
+	}
+	if cctx.sketch {
+		s.sketch = hyperloglog.New()
+	}
+	return s
+}
+
+func (s *leafStats) enabled() bool {
+	return s.bloom != nil || s.sketch != nil
+}
+
+// add adds the value whose bloom package hash is h.
+func (s *leafStats) add(h uint64) {
+	if s.bloom != nil {
+		s.bloom.Add(h)
+	}
+	if s.sketch != nil {
+		s.sketch.InsertHash(h)
+	}
+}
+
+func (s *leafStats) ndv() []byte {
+	if s.sketch == nil {
+		return nil
+	}
+	b, err := s.sketch.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Len returns the number of values in o.
+func (o *Object) Len() uint32 {
+	return o.cctx.Lookup(o.Root()).Len(o.cctx)
+}
+
+// Sketch returns the distinct-value sketch of the values at path in o and
+// true or, if the path does not exist or any vector at the path lacks a
+// sketch, nil and false.
+func (o *Object) Sketch(path field.Path) (*hyperloglog.Sketch, bool) {
+	sketch := hyperloglog.New()
+	var found bool
+	ids := []ID{o.Root()}
+	if root, ok := o.cctx.Lookup(o.Root()).(*Dynamic); ok {
+		ids = root.Values
+	}
+	for _, id := range ids {
+		ok, complete := mergeSketch(o.cctx, sketch, id, path)
+		if !complete {
+			return nil, false
+		}
+		found = found || ok
+	}
+	if !found {
+		return nil, false
+	}
+	return sketch, true
+}
+
+// mergeSketch merges the sketches of the vectors at path under id into
+// sketch.  It returns whether the path exists and whether every vector found
+// has a sketch.
+func mergeSketch(cctx *Context, sketch *hyperloglog.Sketch, id ID, path field.Path) (bool, bool) {
+	var ndv []byte
+	switch m := under(cctx, cctx.Lookup(id)).(type) {
+	case *Record:
+		if len(path) == 0 {
+			return true, false
+		}
+		k := indexOfField(path[0], m.Fields)
+		if k < 0 {
+			return false, true
+		}
+		return mergeSketch(cctx, sketch, m.Fields[k].Values, path[1:])
+	case *Dict:
+		return mergeSketch(cctx, sketch, m.Values, path)
+	case *Error:
+		return mergeSketch(cctx, sketch, m.Values, path)
+	case *Union:
+		var found bool
+		for _, id := range m.Values {
+			ok, complete := mergeSketch(cctx, sketch, id, path)
+			if !complete {
+				return true, false
+			}
+			found = found || ok
+		}
+		return found, true
+	case *Const:
+		if len(path) != 0 {
+			return false, true
+		}
+		h, ok := bloom.Hash(m.Value)
+		if ok {
+			sketch.InsertHash(h)
+		}
+		return true, ok
+	case *Int:
+		ndv = m.NDV
+	case *Uint:
+		ndv = m.NDV
+	case *Float:
+		ndv = m.NDV
+	case *Bytes:
+		ndv = m.NDV
+	case *Primitive:
+		ndv = m.NDV
+	default:
+		if len(path) != 0 {
+			return false, true
+		}
+		return true, false
+	}
+	if len(path) != 0 {
+		return false, true
+	}
+	var s hyperloglog.Sketch
+	if ndv == nil || s.UnmarshalBinary(ndv) != nil {
+		return true, false
+	}
+	return true, sketch.Merge(&s) == nil
+}
@@ -17,16 +17,40 @@ var maxObjectSize uint32 = 120_000
 // CSUP object from a stream of super.Records.
 type Writer struct {
 	writer  io.WriteCloser
+	opts    WriterOpts
 	dynamic *DynamicEncoder
 }
 
+type WriterOpts struct {
+	// BloomFilters adds a Bloom filter of the values of each primitive
+	// vector to its metadata so that queries for equality can skip
+	// objects that do not contain a value.
+	BloomFilters bool
+	// Sketches adds a HyperLogLog sketch of the values of each primitive
+	// vector to its metadata so that the optimizer can estimate the
+	// number of distinct values of a field.
+	Sketches bool
+}
+
 var _ sio.Writer = (*Writer)(nil)
 
 func NewWriter(w io.WriteCloser) *Writer {
-	return &Writer{
-		writer:  w,
-		dynamic: NewDynamicEncoder(),
+	return NewWriterWithOpts(w, WriterOpts{})
+}
+
+func NewWriterWithOpts(w io.WriteCloser, opts WriterOpts) *Writer {
+	writer := &Writer{
+		writer: w,
+		opts:   opts,
 	}
+	writer.newDynamic()
+	return writer
+}
+
+func (w *Writer) newDynamic() {
+	w.dynamic = NewDynamicEncoder()
+	w.dynamic.cctx.bloom = w.opts.BloomFilters
+	w.dynamic.cctx.sketch = w.opts.Sketches
 }
 
 func (w *Writer) Close() error {
@@ -82,6 +106,6 @@ func (w *Writer) finalizeObject() error {
 		return fmt.Errorf("system error: could not write CSUP data section: %w", err)
 	}
 	// Set new dynamic so we can write the next object.
-	w.dynamic = NewDynamicEncoder()
+	w.newDynamic()
 	return nil
 }
@@ -12,7 +12,9 @@ import (
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/db"
 	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/db/tags"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sup"
@@ -22,23 +24,36 @@ import (
 
 type Interface interface {
 	Root() *db.Root
-	Query(ctx context.Context, query []srcfiles.Input) (sbuf.Scanner, error)
+	Query(ctx context.Context, query []srcfiles.Input, params map[string]string) (sbuf.Scanner, error)
 	PoolID(ctx context.Context, poolName string) (ksuid.KSUID, error)
-	CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName string) (ksuid.KSUID, error)
-	CreatePool(context.Context, string, order.SortKeys, int, int64) (ksuid.KSUID, error)
+	CommitObject(ctx context.Context, poolID ksuid.KSUID, revision string) (ksuid.KSUID, error)
+	CreatePool(context.Context, string, order.SortKeys, int, int64, pools.Vector) (ksuid.KSUID, error)
 	RemovePool(context.Context, ksuid.KSUID) error
 	RenamePool(context.Context, ksuid.KSUID, string) error
+	SetPoolRetention(context.Context, ksuid.KSUID, pools.Retention) error
+	SetPoolContract(context.Context, ksuid.KSUID, pools.Contract) error
 	CreateBranch(ctx context.Context, pool ksuid.KSUID, name string, parent ksuid.KSUID) error
 	RemoveBranch(ctx context.Context, pool ksuid.KSUID, branchName string) error
+	Tags(ctx context.Context, pool ksuid.KSUID) ([]tags.Config, error)
+	CreateTag(ctx context.Context, pool ksuid.KSUID, name string, commit ksuid.KSUID) error
+	RemoveTag(ctx context.Context, pool ksuid.KSUID, name string) error
+	Views(ctx context.Context) ([]api.View, error)
+	CreateView(ctx context.Context, pool ksuid.KSUID, branch, name, query string) error
+	RefreshView(ctx context.Context, name string) (ksuid.KSUID, error)
+	RemoveView(ctx context.Context, name string) error
 	MergeBranch(ctx context.Context, pool ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (ksuid.KSUID, error)
 	Compact(ctx context.Context, pool ksuid.KSUID, branch string, objects []ksuid.KSUID, writeVectors bool, message api.CommitMessage) (ksuid.KSUID, error)
 	Load(ctx context.Context, sctx *super.Context, pool ksuid.KSUID, branch string, r sio.Reader, message api.CommitMessage) (ksuid.KSUID, error)
+	Upsert(ctx context.Context, sctx *super.Context, pool ksuid.KSUID, branch string, r sio.Reader, key field.Path, message api.CommitMessage) (ksuid.KSUID, error)
 	Delete(ctx context.Context, poolID ksuid.KSUID, branchName string, tags []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error)
 	DeleteWhere(ctx context.Context, poolID ksuid.KSUID, branchName, src string, commit api.CommitMessage) (ksuid.KSUID, error)
 	Revert(ctx context.Context, poolID ksuid.KSUID, branch string, commitID ksuid.KSUID, commit api.CommitMessage) (ksuid.KSUID, error)
 	AddVectors(ctx context.Context, pool, revision string, objects []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error)
 	DeleteVectors(ctx context.Context, pool, revision string, objects []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error)
 	Vacuum(ctx context.Context, pool, revision string, dryrun bool) ([]ksuid.KSUID, error)
+	Grants(ctx context.Context) ([]api.Grant, error)
+	Grant(ctx context.Context, pool, role, perm string) error
+	Revoke(ctx context.Context, pool, role string) error
 }
 
 func Connect(ctx context.Context, logger *zap.Logger, u string) (Interface, error) {
@@ -55,7 +70,7 @@ func IsRemote(u string) bool {
 func LookupPoolByName(ctx context.Context, api Interface, name string) (*pools.Config, error) {
 	b := newBuffer(pools.Config{})
 	query := fmt.Sprintf("from :pools | name == '%s'", name)
-	q, err := api.Query(ctx, srcfiles.Plain(query))
+	q, err := api.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +94,7 @@ func LookupPoolByName(ctx context.Context, api Interface, name string) (*pools.C
 
 func GetPools(ctx context.Context, api Interface) ([]*pools.Config, error) {
 	b := newBuffer(pools.Config{})
-	q, err := api.Query(ctx, srcfiles.Plain("from :pools"))
+	q, err := api.Query(ctx, srcfiles.Plain("from :pools"), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +112,7 @@ func GetPools(ctx context.Context, api Interface) ([]*pools.Config, error) {
 func LookupPoolByID(ctx context.Context, api Interface, id ksuid.KSUID) (*pools.Config, error) {
 	b := newBuffer(pools.Config{})
 	query := fmt.Sprintf("from :pools | id == hex('%s')", idToHex(id))
-	q, err := api.Query(ctx, srcfiles.Plain(query))
+	q, err := api.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +137,7 @@ func LookupPoolByID(ctx context.Context, api Interface, id ksuid.KSUID) (*pools.
 func LookupBranchByName(ctx context.Context, api Interface, poolName, branchName string) (*db.BranchMeta, error) {
 	b := newBuffer(db.BranchMeta{})
 	query := fmt.Sprintf("from :branches | pool.name == '%s' branch.name == '%s'", poolName, branchName)
-	q, err := api.Query(ctx, srcfiles.Plain(query))
+	q, err := api.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -147,7 +162,7 @@ func LookupBranchByName(ctx context.Context, api Interface, poolName, branchName
 func LookupBranchByID(ctx context.Context, api Interface, id ksuid.KSUID) (*db.BranchMeta, error) {
 	b := newBuffer(db.BranchMeta{})
 	query := fmt.Sprintf("from :branches | branch.id == 'hex(%s)'", idToHex(id))
-	q, err := api.Query(ctx, srcfiles.Plain(query))
+	q, err := api.Query(ctx, srcfiles.Plain(query), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10,8 +10,12 @@ import (
 	"github.com/brimdata/super/compiler/parser"
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/grants"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/db/tags"
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
@@ -62,11 +66,11 @@ func (l *local) Root() *db.Root {
 	return l.db
 }
 
-func (l *local) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64) (ksuid.KSUID, error) {
+func (l *local) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64, vector pools.Vector) (ksuid.KSUID, error) {
 	if name == "" {
 		return ksuid.Nil, errors.New("no pool name provided")
 	}
-	pool, err := l.db.CreatePool(ctx, name, sortKeys, seekStride, thresh)
+	pool, err := l.db.CreatePool(ctx, name, sortKeys, seekStride, thresh, vector)
 	if err != nil {
 		return ksuid.Nil, err
 	}
@@ -85,6 +89,14 @@ func (l *local) RenamePool(ctx context.Context, id ksuid.KSUID, name string) err
 	return l.db.RenamePool(ctx, id, name)
 }
 
+func (l *local) SetPoolRetention(ctx context.Context, id ksuid.KSUID, retention pools.Retention) error {
+	return l.db.SetPoolRetention(ctx, id, retention)
+}
+
+func (l *local) SetPoolContract(ctx context.Context, id ksuid.KSUID, contract pools.Contract) error {
+	return l.db.SetPoolContract(ctx, l.compiler, id, contract)
+}
+
 func (l *local) CreateBranch(ctx context.Context, poolID ksuid.KSUID, name string, parent ksuid.KSUID) error {
 	_, err := l.db.CreateBranch(ctx, poolID, name, parent)
 	return err
@@ -94,8 +106,60 @@ func (l *local) RemoveBranch(ctx context.Context, poolID ksuid.KSUID, branchName
 	return l.db.RemoveBranch(ctx, poolID, branchName)
 }
 
+func (l *local) Tags(ctx context.Context, poolID ksuid.KSUID) ([]tags.Config, error) {
+	return l.db.Tags(ctx, poolID)
+}
+
+func (l *local) CreateTag(ctx context.Context, poolID ksuid.KSUID, name string, commit ksuid.KSUID) error {
+	_, err := l.db.CreateTag(ctx, poolID, name, commit)
+	return err
+}
+
+func (l *local) RemoveTag(ctx context.Context, poolID ksuid.KSUID, name string) error {
+	return l.db.RemoveTag(ctx, poolID, name)
+}
+
+func (l *local) Views(ctx context.Context) ([]api.View, error) {
+	list, err := l.db.Views(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.View, 0, len(list))
+	for _, v := range list {
+		pool := v.Source.String()
+		if p, err := l.db.OpenPool(ctx, v.Source); err == nil {
+			pool = p.Name
+		}
+		out = append(out, api.View{Name: v.Name, Query: v.Query, Pool: pool, Branch: v.Branch, Commit: v.Commit})
+	}
+	return out, nil
+}
+
+func (l *local) CreateView(ctx context.Context, poolID ksuid.KSUID, branch, name, query string) error {
+	_, err := l.db.CreateView(ctx, l.compiler, name, query, poolID, branch)
+	return err
+}
+
+func (l *local) RefreshView(ctx context.Context, name string) (ksuid.KSUID, error) {
+	view, err := l.db.LookupView(ctx, name)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	commit, err := l.db.RefreshView(ctx, l.compiler, name)
+	if err == nil && commit != ksuid.Nil {
+		l.db.MaintainViews(ctx, l.compiler, view.Target, "main")
+		l.db.WaitViews()
+	}
+	return commit, err
+}
+
+func (l *local) RemoveView(ctx context.Context, name string) error {
+	return l.db.RemoveView(ctx, name)
+}
+
 func (l *local) MergeBranch(ctx context.Context, poolID ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (ksuid.KSUID, error) {
-	return l.db.MergeBranch(ctx, poolID, childBranch, parentBranch, message.Author, message.Body)
+	commit, err := l.db.MergeBranch(ctx, poolID, childBranch, parentBranch, message.Author, message.Body)
+	return l.committed(ctx, poolID, parentBranch, commit, err)
 }
 
 func (l *local) Compact(ctx context.Context, poolID ksuid.KSUID, branchName string, objects []ksuid.KSUID, writeVectors bool, commit api.CommitMessage) (ksuid.KSUID, error) {
@@ -103,14 +167,16 @@ func (l *local) Compact(ctx context.Context, poolID ksuid.KSUID, branchName stri
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	return exec.Compact(ctx, l.db, pool, branchName, objects, writeVectors, commit.Author, commit.Body, commit.Meta)
+	id, err := exec.Compact(ctx, l.db, pool, branchName, objects, writeVectors, commit.Author, commit.Body, commit.Meta)
+	return l.committed(ctx, poolID, branchName, id, err)
 }
 
-func (l *local) Query(ctx context.Context, inputs []srcfiles.Input) (sbuf.Scanner, error) {
+func (l *local) Query(ctx context.Context, inputs []srcfiles.Input, params map[string]string) (sbuf.Scanner, error) {
 	ast, err := parser.ParseFiles(inputs)
 	if err != nil {
 		return nil, err
 	}
+	ast.BindParams(params)
 	q, err := runtime.CompileQueryForDB(ctx, super.NewContext(), l.compiler, ast)
 	if err != nil {
 		return nil, err
@@ -130,8 +196,8 @@ func (l *local) PoolID(ctx context.Context, poolName string) (ksuid.KSUID, error
 	return l.db.PoolID(ctx, poolName)
 }
 
-func (l *local) CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName string) (ksuid.KSUID, error) {
-	return l.db.CommitObject(ctx, poolID, branchName)
+func (l *local) CommitObject(ctx context.Context, poolID ksuid.KSUID, revision string) (ksuid.KSUID, error) {
+	return l.db.CommitObject(ctx, poolID, revision)
 }
 
 func (l *local) lookupBranch(ctx context.Context, poolID ksuid.KSUID, branchName string) (*db.Pool, *db.Branch, error) {
@@ -151,19 +217,26 @@ func (l *local) Load(ctx context.Context, ztcx *super.Context, poolID ksuid.KSUI
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	return branch.Load(ctx, ztcx, r, message.Author, message.Body, message.Meta)
+	commit, err := branch.Load(ctx, l.compiler, ztcx, r, message.Author, message.Body, message.Meta)
+	return l.committed(ctx, poolID, branchName, commit, err)
 }
 
-func (l *local) Delete(ctx context.Context, poolID ksuid.KSUID, branchName string, ids []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
+func (l *local) Upsert(ctx context.Context, sctx *super.Context, poolID ksuid.KSUID, branchName string, r sio.Reader, key field.Path, message api.CommitMessage) (ksuid.KSUID, error) {
 	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	commitID, err := branch.Delete(ctx, ids, message.Author, message.Body)
+	commit, err := branch.Upsert(ctx, l.compiler, sctx, r, key, message.Author, message.Body, message.Meta)
+	return l.committed(ctx, poolID, branchName, commit, err)
+}
+
+func (l *local) Delete(ctx context.Context, poolID ksuid.KSUID, branchName string, ids []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
+	_, branch, err := l.lookupBranch(ctx, poolID, branchName)
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	return commitID, nil
+	commitID, err := branch.Delete(ctx, ids, message.Author, message.Body)
+	return l.committed(ctx, poolID, branchName, commitID, err)
 }
 
 func (l *local) DeleteWhere(ctx context.Context, poolID ksuid.KSUID, branchName, src string, commit api.CommitMessage) (ksuid.KSUID, error) {
@@ -175,11 +248,24 @@ func (l *local) DeleteWhere(ctx context.Context, poolID ksuid.KSUID, branchName,
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	return branch.DeleteWhere(ctx, l.compiler, ast, commit.Author, commit.Body, commit.Meta)
+	id, err := branch.DeleteWhere(ctx, l.compiler, ast, commit.Author, commit.Body, commit.Meta)
+	return l.committed(ctx, poolID, branchName, id, err)
 }
 
 func (l *local) Revert(ctx context.Context, poolID ksuid.KSUID, branchName string, commitID ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
-	return l.db.Revert(ctx, poolID, branchName, commitID, message.Author, message.Body)
+	commit, err := l.db.Revert(ctx, poolID, branchName, commitID, message.Author, message.Body)
+	return l.committed(ctx, poolID, branchName, commit, err)
+}
+
+// committed maintains the views of a branch after a commit to it and
+// returns the commit and error of the commit.  It waits for the views to be
+// refreshed since the process may exit once the commit returns.
+func (l *local) committed(ctx context.Context, poolID ksuid.KSUID, branchName string, commit ksuid.KSUID, err error) (ksuid.KSUID, error) {
+	if err == nil {
+		l.db.MaintainViews(ctx, l.compiler, poolID, branchName)
+		l.db.WaitViews()
+	}
+	return commit, err
 }
 
 func (l *local) AddVectors(ctx context.Context, pool, revision string, ids []ksuid.KSUID, message api.CommitMessage) (ksuid.KSUID, error) {
@@ -221,3 +307,50 @@ func (l *local) Vacuum(ctx context.Context, pool, revision string, dryrun bool)
 	}
 	return p.Vacuum(ctx, commit, dryrun)
 }
+
+func (l *local) Grants(ctx context.Context) ([]api.Grant, error) {
+	list, err := l.db.Grants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.Grant, 0, len(list))
+	for _, g := range list {
+		pool := "*"
+		if g.Pool != ksuid.Nil {
+			pool = g.Pool.String()
+			if p, err := l.db.OpenPool(ctx, g.Pool); err == nil {
+				pool = p.Name
+			}
+		}
+		out = append(out, api.Grant{Role: g.Role, Pool: pool, Permission: g.Permission})
+	}
+	return out, nil
+}
+
+func (l *local) Grant(ctx context.Context, pool, role, perm string) error {
+	poolID, err := l.grantPoolID(ctx, pool)
+	if err != nil {
+		return err
+	}
+	p, err := grants.ParsePermission(perm)
+	if err != nil {
+		return err
+	}
+	return l.db.Grant(ctx, role, poolID, p)
+}
+
+func (l *local) Revoke(ctx context.Context, pool, role string) error {
+	poolID, err := l.grantPoolID(ctx, pool)
+	if err != nil {
+		return err
+	}
+	return l.db.Revoke(ctx, role, poolID)
+}
+
+// grantPoolID returns the ID of pool or ksuid.Nil if pool is "*".
+func (l *local) grantPoolID(ctx context.Context, pool string) (ksuid.KSUID, error) {
+	if pool == "*" {
+		return ksuid.Nil, nil
+	}
+	return l.PoolID(ctx, pool)
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/brimdata/super/api/queryio"
 	"github.com/brimdata/super/compiler/srcfiles"
 	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/db/tags"
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
@@ -47,20 +49,22 @@ func (r *remote) PoolID(ctx context.Context, poolName string) (ksuid.KSUID, erro
 	return config.ID, nil
 }
 
-func (r *remote) CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName string) (ksuid.KSUID, error) {
-	res, err := r.conn.BranchGet(ctx, poolID, branchName)
+func (r *remote) CommitObject(ctx context.Context, poolID ksuid.KSUID, revision string) (ksuid.KSUID, error) {
+	res, err := r.conn.BranchGet(ctx, poolID, revision)
 	return res.Commit, err
 }
 
-func (r *remote) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64) (ksuid.KSUID, error) {
+func (r *remote) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64, vector pools.Vector) (ksuid.KSUID, error) {
 	res, err := r.conn.CreatePool(ctx, api.PoolPostRequest{
 		Name: name,
 		SortKeys: api.SortKeys{
 			Order: sortKeys.Primary().Order,
 			Keys:  field.List{sortKeys.Primary().Key},
 		},
-		SeekStride: seekStride,
-		Thresh:     thresh,
+		SeekStride:   seekStride,
+		Thresh:       thresh,
+		BloomFilters: vector.BloomFilters,
+		Sketches:     vector.Sketches,
 	})
 	if err != nil {
 		return ksuid.Nil, err
@@ -80,6 +84,43 @@ func (r *remote) RemoveBranch(ctx context.Context, poolID ksuid.KSUID, branchNam
 	return errors.New("TBD remote.RemoveBranch")
 }
 
+func (r *remote) Tags(ctx context.Context, poolID ksuid.KSUID) ([]tags.Config, error) {
+	return r.conn.Tags(ctx, poolID)
+}
+
+func (r *remote) CreateTag(ctx context.Context, poolID ksuid.KSUID, name string, commit ksuid.KSUID) error {
+	_, err := r.conn.CreateTag(ctx, poolID, api.TagPostRequest{
+		Name:   name,
+		Commit: commit.String(),
+	})
+	return err
+}
+
+func (r *remote) RemoveTag(ctx context.Context, poolID ksuid.KSUID, name string) error {
+	return r.conn.RemoveTag(ctx, poolID, name)
+}
+
+func (r *remote) Views(ctx context.Context) ([]api.View, error) {
+	return r.conn.Views(ctx)
+}
+
+func (r *remote) CreateView(ctx context.Context, poolID ksuid.KSUID, branch, name, query string) error {
+	_, err := r.conn.CreateView(ctx, poolID, branch, api.ViewPostRequest{
+		Name:  name,
+		Query: query,
+	})
+	return err
+}
+
+func (r *remote) RefreshView(ctx context.Context, name string) (ksuid.KSUID, error) {
+	res, err := r.conn.RefreshView(ctx, name)
+	return res.Commit, err
+}
+
+func (r *remote) RemoveView(ctx context.Context, name string) error {
+	return r.conn.RemoveView(ctx, name)
+}
+
 func (r *remote) MergeBranch(ctx context.Context, poolID ksuid.KSUID, childBranch, parentBranch string, message api.CommitMessage) (ksuid.KSUID, error) {
 	res, err := r.conn.MergeBranch(ctx, poolID, childBranch, parentBranch, message)
 	return res.Commit, err
@@ -101,7 +142,35 @@ func (r *remote) RenamePool(ctx context.Context, pool ksuid.KSUID, name string)
 	return r.conn.RenamePool(ctx, pool, api.PoolPutRequest{Name: name})
 }
 
+func (r *remote) SetPoolRetention(ctx context.Context, pool ksuid.KSUID, retention pools.Retention) error {
+	return r.conn.SetPoolRetention(ctx, pool, api.PoolRetentionRequest{
+		Keep:    retention.Keep.String(),
+		MaxSize: retention.MaxSize,
+		MaxAge:  retention.MaxAge.String(),
+	})
+}
+
+func (r *remote) SetPoolContract(ctx context.Context, pool ksuid.KSUID, contract pools.Contract) error {
+	return r.conn.SetPoolContract(ctx, pool, api.PoolContractRequest{
+		Type:       contract.Type,
+		Mode:       contract.Mode,
+		Quarantine: contract.Quarantine,
+		Shaper:     contract.Shaper,
+	})
+}
+
 func (r *remote) Load(ctx context.Context, _ *super.Context, poolID ksuid.KSUID, branchName string, reader sio.Reader, commit api.CommitMessage) (ksuid.KSUID, error) {
+	return r.load(ctx, poolID, branchName, reader, nil, commit)
+}
+
+func (r *remote) Upsert(ctx context.Context, _ *super.Context, poolID ksuid.KSUID, branchName string, reader sio.Reader, key field.Path, commit api.CommitMessage) (ksuid.KSUID, error) {
+	if len(key) == 0 {
+		return ksuid.Nil, errors.New("upsert key must be a field")
+	}
+	return r.load(ctx, poolID, branchName, reader, key, commit)
+}
+
+func (r *remote) load(ctx context.Context, poolID ksuid.KSUID, branchName string, reader sio.Reader, key field.Path, commit api.CommitMessage) (ksuid.KSUID, error) {
 	pr, pw := io.Pipe()
 	go func() {
 		w := bsupio.NewWriter(sio.NopCloser(pw))
@@ -111,7 +180,13 @@ func (r *remote) Load(ctx context.Context, _ *super.Context, poolID ksuid.KSUID,
 		}
 		pw.CloseWithError(err)
 	}()
-	res, err := r.conn.Load(ctx, poolID, branchName, api.MediaTypeBSUP, pr, commit)
+	var res api.CommitResponse
+	var err error
+	if key != nil {
+		res, err = r.conn.Upsert(ctx, poolID, branchName, api.MediaTypeBSUP, key.String(), pr, commit)
+	} else {
+		res, err = r.conn.Load(ctx, poolID, branchName, api.MediaTypeBSUP, pr, commit)
+	}
 	return res.Commit, err
 }
 
@@ -120,8 +195,8 @@ func (r *remote) Revert(ctx context.Context, poolID ksuid.KSUID, branchName stri
 	return res.Commit, err
 }
 
-func (r *remote) Query(ctx context.Context, inputs []srcfiles.Input) (sbuf.Scanner, error) {
-	res, err := r.conn.Query(ctx, inputs)
+func (r *remote) Query(ctx context.Context, inputs []srcfiles.Input, params map[string]string) (sbuf.Scanner, error) {
+	res, err := r.conn.Query(ctx, inputs, params)
 	if err != nil {
 		return nil, err
 	}
@@ -152,3 +227,15 @@ func (r *remote) Vacuum(ctx context.Context, pool, revision string, dryrun bool)
 	res, err := r.conn.Vacuum(ctx, pool, revision, dryrun)
 	return res.ObjectIDs, err
 }
+
+func (r *remote) Grants(ctx context.Context) ([]api.Grant, error) {
+	return r.conn.Grants(ctx)
+}
+
+func (r *remote) Grant(ctx context.Context, pool, role, perm string) error {
+	return r.conn.Grant(ctx, pool, role, perm)
+}
+
+func (r *remote) Revoke(ctx context.Context, pool, role string) error {
+	return r.conn.Revoke(ctx, pool, role)
+}
@@ -0,0 +1,29 @@
+package db
+
+import (
+	"context"
+
+	"github.com/brimdata/super/db/grants"
+	"github.com/segmentio/ksuid"
+)
+
+// An Authorizer returns an error wrapping grants.ErrDenied if perm on
+// the pool with ID pool is not permitted.
+type Authorizer func(ctx context.Context, pool ksuid.KSUID, perm grants.Permission) error
+
+type authorizerKey struct{}
+
+// ContextWithAuthorizer returns a copy of ctx that checks access to pools
+// with a.
+func ContextWithAuthorizer(ctx context.Context, a Authorizer) context.Context {
+	return context.WithValue(ctx, authorizerKey{}, a)
+}
+
+// Authorize checks perm on the pool with ID pool using the Authorizer of
+// ctx.  Everything is permitted if ctx has no Authorizer.
+func Authorize(ctx context.Context, pool ksuid.KSUID, perm grants.Permission) error {
+	if a, ok := ctx.Value(authorizerKey{}).(Authorizer); ok {
+		return a(ctx, pool, perm)
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/brimdata/super/db/data"
 	"github.com/brimdata/super/db/journal"
 	"github.com/brimdata/super/dbid"
+	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/plural"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
@@ -47,28 +48,115 @@ func OpenBranch(ctx context.Context, config *branches.Config, engine storage.Eng
 	}, nil
 }
 
-func (b *Branch) Load(ctx context.Context, sctx *super.Context, r sio.Reader, author, message, meta string) (ksuid.KSUID, error) {
+// Load loads the values of r into the branch.  The compiler c runs the
+// shaper of the pool's contract, if any.
+func (b *Branch) Load(ctx context.Context, c runtime.Compiler, sctx *super.Context, r sio.Reader, author, message, meta string) (ksuid.KSUID, error) {
+	return b.load(ctx, c, sctx, r, nil, author, message, meta)
+}
+
+// load loads the values of r into the branch.  If key is not nil, the
+// values of the branch having the same key as a loaded value are replaced.
+func (b *Branch) load(ctx context.Context, c runtime.Compiler, sctx *super.Context, r sio.Reader, key field.Path, author, message, meta string) (ksuid.KSUID, error) {
 	w, err := NewWriter(ctx, sctx, b.pool)
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	err = sio.CopyWithContext(ctx, w, r)
+	var contract *contractReader
+	if !b.pool.Contract.IsZero() {
+		newQuarantine := func() (*Writer, error) {
+			return NewWriter(ctx, sctx, b.pool)
+		}
+		contract, err = newContractReader(ctx, sctx, c, r, b.pool.Contract, newQuarantine)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		r = contract
+	}
+	commit, err := b.loadWriter(ctx, sctx, w, r, contract, key, author, message, meta)
+	if contract == nil || !contract.quarantined() {
+		return commit, err
+	}
+	if err != nil {
+		// The quarantined values are committed only with the load.
+		b.removeQuarantine(ctx, contract)
+		return commit, err
+	}
+	quarantineCommit, err := b.commitQuarantine(ctx, contract, commit, author)
+	if err != nil {
+		b.removeQuarantine(ctx, contract)
+		if commit != ksuid.Nil {
+			return commit, fmt.Errorf("values loaded in commit %s but not quarantined: %w", commit, err)
+		}
+		return ksuid.Nil, err
+	}
+	if commit == ksuid.Nil {
+		return quarantineCommit, nil
+	}
+	return commit, nil
+}
+
+// loadWriter copies r to w and commits the written objects to the branch.
+// It returns ksuid.Nil and no error if all of the values of the load were
+// quarantined by contract.
+func (b *Branch) loadWriter(ctx context.Context, sctx *super.Context, w *Writer, r sio.Reader, contract *contractReader, key field.Path, author, message, meta string) (ksuid.KSUID, error) {
+	var upsert *upsertReader
+	if key != nil {
+		upsert = newUpsertReader(r, key)
+		r = upsert
+	}
+	err := sio.CopyWithContext(ctx, w, r)
 	if closeErr := w.Close(); err == nil {
 		err = closeErr
 	}
+	if contract != nil {
+		if closeErr := contract.close(); err == nil {
+			err = closeErr
+		}
+		if contract.quarantine != nil {
+			if closeErr := contract.quarantine.Close(); err == nil {
+				err = closeErr
+			}
+		}
+	}
 	if err != nil {
 		return ksuid.Nil, err
 	}
+	appMeta, err := loadMeta(sctx, meta)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	if contract != nil {
+		if err := contract.err(); err != nil {
+			return ksuid.Nil, err
+		}
+	}
+	if upsert != nil {
+		if err := upsert.err(); err != nil {
+			return ksuid.Nil, err
+		}
+	}
 	objects := w.Objects()
 	if len(objects) == 0 {
+		if contract != nil && contract.quarantined() {
+			return ksuid.Nil, nil
+		}
 		return ksuid.Nil, commits.ErrEmptyTransaction
 	}
-	if message == "" {
-		message = loadMessage(objects)
+	var quarantineNote string
+	if contract != nil && contract.quarantined() {
+		quarantineNote = fmt.Sprintf("\nquarantined to branch %q: %s\n",
+			contract.contract.Quarantine, contract.summary())
 	}
-	appMeta, err := loadMeta(sctx, meta)
-	if err != nil {
-		return ksuid.Nil, err
+	if upsert != nil {
+		rewrites := newUpsertRewrites()
+		commit, err := b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+			return b.upsertCommit(ctx, parent.Commit, retries, upsert, rewrites, objects, author, message, quarantineNote, appMeta)
+		})
+		rewrites.cleanup(ctx, b, err == nil)
+		return commit, err
+	}
+	if message == "" {
+		message = loadMessage(objects) + quarantineNote
 	}
 	// The load operation has only added new objects so we know its
 	// safe to merge at the tip and there can be no conflicts
@@ -234,6 +322,12 @@ func (b *Branch) Revert(ctx context.Context, commit ksuid.KSUID, author, message
 	})
 }
 
+// compactMeta is the meta value of a commit that compacts data objects,
+// which rewrites them without changing their data.
+type compactMeta struct {
+	Compact bool `super:"compact"`
+}
+
 func (b *Branch) CommitCompact(ctx context.Context, src, rollup []*data.Object, rollupVecs []ksuid.KSUID, author, message, meta string) (ksuid.KSUID, error) {
 	if len(rollup) < 1 {
 		return ksuid.Nil, errors.New("compact: one or more rollup objects required")
@@ -243,6 +337,13 @@ func (b *Branch) CommitCompact(ctx context.Context, src, rollup []*data.Object,
 	if err != nil {
 		return ksuid.Nil, err
 	}
+	if appMeta.IsNull() {
+		// Mark the commit so views can skip it.  A commit with
+		// application meta is instead handled like any other delete.
+		if appMeta, err = sup.MarshalBSUP(compactMeta{Compact: true}); err != nil {
+			return ksuid.Nil, err
+		}
+	}
 	return b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
 		base, err := b.pool.commits.Snapshot(ctx, parent.Commit)
 		if err != nil {
@@ -436,7 +537,7 @@ func (b *Branch) AddVectors(ctx context.Context, ids []ksuid.KSUID, author, mess
 	// XXX We should add some parallelism here to stream the next file while
 	// the CPU is chugging away on the current file.  See issue #4015.
 	for _, id := range ids {
-		if err := data.CreateVector(ctx, b.pool.engine, b.pool.DataPath, id); err != nil {
+		if err := data.CreateVector(ctx, b.pool.engine, b.pool.DataPath, id, b.pool.Vector.WriterOpts()); err != nil {
 			return ksuid.Nil, err
 		}
 	}
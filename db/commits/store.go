@@ -12,6 +12,7 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/bsupbytes"
 	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/bsupio"
@@ -34,6 +35,9 @@ type Store struct {
 	cache     *arc.ARCCache[ksuid.KSUID, *Object]
 	paths     *arc.ARCCache[ksuid.KSUID, []ksuid.KSUID]
 	snapshots *arc.ARCCache[ksuid.KSUID, *Snapshot]
+	// snapshotMu serializes snapshot computation so a concurrent
+	// caller never reads a snapshot file while it is being written.
+	snapshotMu sync.Mutex
 }
 
 func OpenStore(engine storage.Engine, logger *zap.Logger, path *storage.URI) (*Store, error) {
@@ -99,6 +103,11 @@ func (s *Store) Remove(ctx context.Context, o *Object) error {
 }
 
 func (s *Store) Snapshot(ctx context.Context, leaf ksuid.KSUID) (*Snapshot, error) {
+	if snap, ok := s.snapshots.Get(leaf); ok {
+		return snap, nil
+	}
+	s.snapshotMu.Lock()
+	defer s.snapshotMu.Unlock()
 	if snap, ok := s.snapshots.Get(leaf); ok {
 		return snap, nil
 	}
@@ -223,6 +232,24 @@ func (s *Store) PathRange(ctx context.Context, from, to ksuid.KSUID) ([]ksuid.KS
 	return path, nil
 }
 
+// CommitAt returns the latest commit at or before ts in the journal of
+// commits that ends at leaf.
+func (s *Store) CommitAt(ctx context.Context, leaf ksuid.KSUID, ts nano.Ts) (ksuid.KSUID, error) {
+	for at := leaf; at != ksuid.Nil; {
+		o, err := s.Get(ctx, at)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		if len(o.Actions) > 0 {
+			if commit, ok := o.Actions[0].(*Commit); ok && commit.Date <= ts {
+				return at, nil
+			}
+		}
+		at = o.Parent
+	}
+	return ksuid.Nil, fmt.Errorf("no commit at or before %s: %w", ts, ErrNotFound)
+}
+
 func (s *Store) GetBytes(ctx context.Context, commit ksuid.KSUID) ([]byte, *Commit, error) {
 	b, err := storage.Get(ctx, s.engine, s.pathOf(commit))
 	if err != nil {
@@ -0,0 +1,278 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/db/branches"
+	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/journal"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr/function"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/sio"
+	"github.com/brimdata/super/sup"
+	"github.com/segmentio/ksuid"
+)
+
+// maxViolations is the maximum number of contract violations reported.
+const maxViolations = 10
+
+var ErrContractViolation = errors.New("schema contract violation")
+
+// ContractShaperError is returned by SetPoolContract when the shaper of a
+// contract cannot be compiled.
+type ContractShaperError struct {
+	Err error
+}
+
+func (c *ContractShaperError) Error() string {
+	return c.Err.Error()
+}
+
+func (c *ContractShaperError) Unwrap() error {
+	return c.Err
+}
+
+// shaperQuery applies the shaper of a contract to each loaded value.  It
+// keeps the value so that it may be quarantined, and the shaped value is
+// missing if the shaper's result is.
+const shaperQuery = "values {in:this,out:(%s)}"
+
+// contractReader enforces the schema contract of a pool on the values of a
+// load.  It returns the values that conform to the contract, shaped by the
+// shaper and cast to the contract type in coerce and quarantine modes, and
+// records the offset of each value that does not.  In quarantine mode,
+// values that do not conform are written to quarantine as they are read.
+// In the other modes, reading continues after a violation only to report
+// the remaining violations.
+type contractReader struct {
+	sio.Reader
+	sctx     *super.Context
+	contract pools.Contract
+	// shaper is the query applying the contract's shaper, which reads the
+	// loaded values and is read by Reader.
+	shaper runtime.Query
+	typ    super.Type
+	caster function.Caster
+	// newQuarantine creates the writer for quarantined values.
+	newQuarantine func() (*Writer, error)
+
+	quarantine *Writer
+	offset     int
+	count      int
+	violations []string
+}
+
+func newContractReader(ctx context.Context, sctx *super.Context, comp runtime.Compiler, r sio.Reader, contract pools.Contract, newQuarantine func() (*Writer, error)) (*contractReader, error) {
+	typ, err := contract.LookupType(sctx)
+	if err != nil {
+		return nil, err
+	}
+	var caster function.Caster
+	if contract.Mode != pools.ContractStrict && typ != nil {
+		caster = function.NewCaster(sctx)
+	}
+	c := &contractReader{
+		Reader:        r,
+		sctx:          sctx,
+		contract:      contract,
+		typ:           typ,
+		caster:        caster,
+		newQuarantine: newQuarantine,
+	}
+	if contract.Shaper != "" {
+		if c.shaper, err = compileShaper(ctx, sctx, comp, contract.Shaper, r); err != nil {
+			return nil, err
+		}
+		c.Reader = sbuf.PullerReader(c.shaper)
+	}
+	return c, nil
+}
+
+// compileShaper returns the query applying shaper to the values of r.
+func compileShaper(ctx context.Context, sctx *super.Context, comp runtime.Compiler, shaper string, r sio.Reader) (runtime.Query, error) {
+	ast, err := parser.ParseText(fmt.Sprintf(shaperQuery, shaper))
+	if err != nil {
+		return nil, fmt.Errorf("contract shaper %q: %w", shaper, err)
+	}
+	q, err := runtime.CompileQuery(ctx, sctx, comp, ast, []sio.Reader{r})
+	if err != nil {
+		return nil, fmt.Errorf("contract shaper %q: %w", shaper, err)
+	}
+	return q, nil
+}
+
+// checkContract returns an error if the shaper of contract cannot be
+// compiled.
+func checkContract(ctx context.Context, comp runtime.Compiler, contract pools.Contract) error {
+	if contract.Shaper == "" {
+		return nil
+	}
+	q, err := compileShaper(ctx, super.NewContext(), comp, contract.Shaper, sbuf.NewArray(nil))
+	if err != nil {
+		return &ContractShaperError{err}
+	}
+	return q.Close()
+}
+
+func (c *contractReader) Read() (*super.Value, error) {
+	for {
+		val, err := c.Reader.Read()
+		if val == nil || err != nil {
+			return val, err
+		}
+		offset := c.offset
+		c.offset++
+		in, out := *val, *val
+		if c.shaper != nil {
+			in = *val.Deref("in")
+			out = c.sctx.Missing()
+			if shaped := val.Deref("out"); shaped != nil {
+				out = *shaped
+			}
+		}
+		if c.caster != nil {
+			out = c.caster.Cast(out, c.typ)
+		}
+		if c.conforms(out) {
+			if c.count > 0 && c.contract.Mode != pools.ContractQuarantine {
+				// The load will be rejected so drop conforming values.
+				continue
+			}
+			// The shaper's value is overwritten by the next Read.
+			out = out.Copy()
+			return &out, nil
+		}
+		c.violate(offset, in, out)
+		if c.contract.Mode == pools.ContractQuarantine {
+			if c.quarantine == nil {
+				if c.quarantine, err = c.newQuarantine(); err != nil {
+					return nil, err
+				}
+			}
+			if err := c.quarantine.Write(in); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// conforms returns true if val, which has been shaped and cast, is of the
+// contract type or, when the contract has no type, is not an error.
+func (c *contractReader) conforms(val super.Value) bool {
+	if c.typ == nil {
+		return !val.IsError()
+	}
+	return val.Type() == c.typ
+}
+
+func (c *contractReader) violate(offset int, in, out super.Value) {
+	c.count++
+	if len(c.violations) >= maxViolations {
+		return
+	}
+	s := fmt.Sprintf("offset %d: type %s", offset, sup.FormatType(in.Type()))
+	switch {
+	case c.shaper != nil && c.caster != nil:
+		s += " shapes and casts to " + sup.FormatType(out.Type())
+	case c.shaper != nil:
+		s += " shapes to " + sup.FormatType(out.Type())
+	case c.caster != nil:
+		s += " casts to " + sup.FormatType(out.Type())
+	}
+	c.violations = append(c.violations, s)
+}
+
+// close closes the shaper query, if any.
+func (c *contractReader) close() error {
+	if c.shaper == nil {
+		return nil
+	}
+	return c.shaper.Close()
+}
+
+// report describes the violations after the heading head.
+func (c *contractReader) report(head string) string {
+	var b strings.Builder
+	b.WriteString(head)
+	for _, v := range c.violations {
+		fmt.Fprintf(&b, "\n  %s", v)
+	}
+	if c.count > len(c.violations) {
+		b.WriteString("\n  ...")
+	}
+	return b.String()
+}
+
+func (c *contractReader) summary() string {
+	desc := fmt.Sprintf("contract version %d", c.contract.Version)
+	if c.contract.Type != "" {
+		desc += " type " + c.contract.Type
+	}
+	if c.contract.Shaper != "" {
+		desc += fmt.Sprintf(" shaper %q", c.contract.Shaper)
+	}
+	if c.count == 1 {
+		return "1 value does not conform to " + desc
+	}
+	return fmt.Sprintf("%d values do not conform to %s", c.count, desc)
+}
+
+// err returns an error reporting the violations of a load if the load must
+// be rejected.
+func (c *contractReader) err() error {
+	if c.count == 0 || c.contract.Mode == pools.ContractQuarantine {
+		return nil
+	}
+	return fmt.Errorf("%w: load rejected: %s", ErrContractViolation, c.report(c.summary()))
+}
+
+// commitQuarantine commits the quarantined values to the quarantine branch,
+// creating an empty branch if it does not exist.  The values were loaded
+// in commit, which is ksuid.Nil if no values were loaded.
+func (b *Branch) commitQuarantine(ctx context.Context, c *contractReader, commit ksuid.KSUID, author string) (ksuid.KSUID, error) {
+	objects := c.quarantine.Objects()
+	name := c.contract.Quarantine
+	config, err := b.pool.LookupBranchByName(ctx, name)
+	if errors.Is(err, branches.ErrNotFound) {
+		config = branches.NewConfig(name, ksuid.Nil)
+		if err = b.pool.branches.Add(ctx, config); err == journal.ErrKeyExists {
+			config, err = b.pool.LookupBranchByName(ctx, name)
+		}
+	}
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	quarantine, err := b.pool.openBranch(ctx, config)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	head := fmt.Sprintf("quarantined values loaded to branch %q", b.Name)
+	if commit != ksuid.Nil {
+		head += " in commit " + commit.String()
+	}
+	message := c.report(head + ": " + c.summary())
+	return quarantine.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		return commits.NewAddsObject(parent.Commit, retries, author, message, super.Null, objects), nil
+	})
+}
+
+// quarantined returns true if the load has quarantined any values.
+func (c *contractReader) quarantined() bool {
+	return c.quarantine != nil && len(c.quarantine.Objects()) > 0
+}
+
+// removeQuarantine deletes the objects holding the quarantined values,
+// which is done when the load is not committed.
+func (b *Branch) removeQuarantine(ctx context.Context, c *contractReader) {
+	for _, o := range c.quarantine.Objects() {
+		b.pool.engine.Delete(ctx, o.SequenceURI(b.pool.DataPath))
+		b.pool.engine.Delete(ctx, o.SeekIndexURI(b.pool.DataPath))
+	}
+}
@@ -17,7 +17,7 @@ import (
 )
 
 // CreateVector writes the vectorized form of an existing Object in the CSUP format.
-func CreateVector(ctx context.Context, engine storage.Engine, path *storage.URI, id ksuid.KSUID) error {
+func CreateVector(ctx context.Context, engine storage.Engine, path *storage.URI, id ksuid.KSUID, opts csup.WriterOpts) error {
 	get, err := engine.Get(ctx, SequenceURI(path, id))
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
@@ -26,7 +26,7 @@ func CreateVector(ctx context.Context, engine storage.Engine, path *storage.URI,
 		}
 		return err
 	}
-	w, err := NewVectorWriter(ctx, engine, path, id)
+	w, err := NewVectorWriter(ctx, engine, path, id, opts)
 	if err != nil {
 		get.Close()
 		return err
@@ -55,11 +55,11 @@ type VectorWriter struct {
 	delete func()
 }
 
-func (o *Object) NewVectorWriter(ctx context.Context, engine storage.Engine, path *storage.URI) (*VectorWriter, error) {
-	return NewVectorWriter(ctx, engine, path, o.ID)
+func (o *Object) NewVectorWriter(ctx context.Context, engine storage.Engine, path *storage.URI, opts csup.WriterOpts) (*VectorWriter, error) {
+	return NewVectorWriter(ctx, engine, path, o.ID, opts)
 }
 
-func NewVectorWriter(ctx context.Context, engine storage.Engine, path *storage.URI, id ksuid.KSUID) (*VectorWriter, error) {
+func NewVectorWriter(ctx context.Context, engine storage.Engine, path *storage.URI, id ksuid.KSUID, opts csup.WriterOpts) (*VectorWriter, error) {
 	put, err := engine.Put(ctx, VectorURI(path, id))
 	if err != nil {
 		return nil, err
@@ -68,7 +68,7 @@ func NewVectorWriter(ctx context.Context, engine storage.Engine, path *storage.U
 		DeleteVector(context.Background(), engine, path, id)
 	}
 	return &VectorWriter{
-		Writer: csupio.NewWriter(bufwriter.New(put)),
+		Writer: csupio.NewWriterWithOpts(bufwriter.New(put), opts),
 		delete: delete,
 	}, nil
 }
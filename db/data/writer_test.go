@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/csup"
 	"github.com/brimdata/super/db/data"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
@@ -26,7 +27,7 @@ func TestDataReaderWriterVector(t *testing.T) {
 	require.NoError(t, w.Write(sup.MustParseValue(sctx, "{a:2,b:5}")))
 	require.NoError(t, w.Write(sup.MustParseValue(sctx, "{a:3,b:6}")))
 	require.NoError(t, w.Close(ctx))
-	require.NoError(t, data.CreateVector(ctx, engine, tmp, object.ID))
+	require.NoError(t, data.CreateVector(ctx, engine, tmp, object.ID, csup.WriterOpts{}))
 	// Read back the CSUP file and make sure it's the same.
 	get, err := engine.Get(ctx, object.VectorURI(tmp))
 	require.NoError(t, err)
@@ -0,0 +1,151 @@
+// Package grants stores the permissions on pools granted to roles.
+package grants
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brimdata/super/db/journal"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrDenied   = errors.New("permission denied")
+	ErrNotFound = errors.New("grant not found")
+)
+
+// Permission is the level of access to a pool.  Each permission includes
+// the permissions that precede it, e.g., Delete includes Load and Read.
+type Permission int
+
+const (
+	None Permission = iota
+	Read
+	Load
+	Delete
+	Admin
+)
+
+func ParsePermission(s string) (Permission, error) {
+	switch s {
+	case "read":
+		return Read, nil
+	case "load":
+		return Load, nil
+	case "delete":
+		return Delete, nil
+	case "admin":
+		return Admin, nil
+	}
+	return None, fmt.Errorf("unknown permission %q (must be read, load, delete, or admin)", s)
+}
+
+func (p Permission) String() string {
+	switch p {
+	case None:
+		return "none"
+	case Read:
+		return "read"
+	case Load:
+		return "load"
+	case Delete:
+		return "delete"
+	case Admin:
+		return "admin"
+	}
+	return fmt.Sprintf("Permission(%d)", int(p))
+}
+
+// Grant gives the members of Role the permission named by Permission on
+// Pool or, when Pool is ksuid.Nil, on every pool in the database.
+type Grant struct {
+	Role       string      `super:"role"`
+	Pool       ksuid.KSUID `super:"pool"`
+	Permission string      `super:"permission"`
+}
+
+var _ journal.Entry = (*Grant)(nil)
+
+func (g *Grant) Key() string {
+	return g.Role + "@" + g.Pool.String()
+}
+
+type Store struct {
+	store *journal.Store
+}
+
+func CreateStore(ctx context.Context, engine storage.Engine, logger *zap.Logger, path *storage.URI) (*Store, error) {
+	store, err := journal.CreateStore(ctx, engine, logger, path, Grant{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+func OpenStore(ctx context.Context, engine storage.Engine, logger *zap.Logger, path *storage.URI) (*Store, error) {
+	store, err := journal.OpenStore(ctx, engine, logger, path, Grant{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+func (s *Store) All(ctx context.Context) ([]Grant, error) {
+	entries, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Grant, 0, len(entries))
+	for _, entry := range entries {
+		grant, ok := entry.(*Grant)
+		if !ok {
+			return nil, errors.New("corrupt grant journal")
+		}
+		list = append(list, *grant)
+	}
+	return list, nil
+}
+
+// Put adds g to the store, replacing any grant to the same role on the
+// same pool.
+func (s *Store) Put(ctx context.Context, g Grant) error {
+	err := s.store.Update(ctx, &g, nil)
+	if err == journal.ErrNoSuchKey {
+		err = s.store.Insert(ctx, &g)
+	}
+	return err
+}
+
+// Remove deletes the grant to role on pool.
+func (s *Store) Remove(ctx context.Context, role string, pool ksuid.KSUID) error {
+	g := Grant{Role: role, Pool: pool}
+	if err := s.store.Delete(ctx, g.Key(), nil); err != nil {
+		if err == journal.ErrNoSuchKey {
+			return fmt.Errorf("%w for role %q", ErrNotFound, role)
+		}
+		return err
+	}
+	return nil
+}
+
+// Lookup returns the greatest permission on pool granted to any of
+// roles.
+func Lookup(grants []Grant, roles []string, pool ksuid.KSUID) Permission {
+	perm := None
+	for _, g := range grants {
+		if g.Pool != pool && g.Pool != ksuid.Nil {
+			continue
+		}
+		for _, role := range roles {
+			if role == g.Role {
+				if p, err := ParsePermission(g.Permission); err == nil && p > perm {
+					perm = p
+				}
+			}
+		}
+	}
+	return perm
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"math/rand"
 	"strconv"
 	"strings"
@@ -234,7 +235,10 @@ func Create(ctx context.Context, engine storage.Engine, path *storage.URI, base
 func Open(ctx context.Context, engine storage.Engine, path *storage.URI) (*Queue, error) {
 	q := New(engine, path)
 	if _, err := q.ReadHead(ctx); err != nil {
-		return nil, fmt.Errorf("%s: no such journal", path)
+		if errors.Is(err, fs.ErrNotExist) {
+			err = fmt.Errorf("%s: no such journal: %w", path, err)
+		}
+		return nil, err
 	}
 	return q, nil
 }
@@ -356,6 +356,7 @@ func (s *Store) commit(ctx context.Context, fn func() error, entries ...Entry) e
 		// Force a reload after a change.
 		s.mu.Lock()
 		s.at = Nil
+		s.loadTime = time.Time{}
 		s.mu.Unlock()
 		return nil
 	}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"runtime"
+	"slices"
+	"sync"
+
+	"github.com/axiomhq/hyperloglog"
+	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/sio/csupio"
+	"github.com/segmentio/ksuid"
+	"golang.org/x/sync/errgroup"
+)
+
+type ndvKey struct {
+	commit ksuid.KSUID
+	path   string
+}
+
+// NDVs returns the estimated number of distinct values at each of paths in
+// the data objects at commit, which come from the distinct-value sketches
+// of their vector objects, or zero for a path whose number is unknown.
+// The estimates are cached by commit and path so that compiling a query
+// again does not read the vector objects again.
+func (p *Pool) NDVs(ctx context.Context, commit ksuid.KSUID, paths []field.Path) ([]int64, error) {
+	ndvs := make([]int64, len(paths))
+	// missing holds the indexes into paths of the estimates not cached.
+	var missing []int
+	var missingPaths []field.Path
+	for k, path := range paths {
+		if ndv, ok := p.ndvs.Get(ndvKey{commit, path.String()}); ok {
+			ndvs[k] = ndv
+		} else {
+			missing = append(missing, k)
+			missingPaths = append(missingPaths, path)
+		}
+	}
+	if len(missing) == 0 {
+		return ndvs, nil
+	}
+	snap, err := p.commits.Snapshot(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	objects := snap.SelectAll()
+	sketches := make([]*hyperloglog.Sketch, len(missing))
+	// The estimates are unknown if any data object lacks a vector object.
+	if len(objects) > 0 && !slices.ContainsFunc(objects, func(o *data.Object) bool { return !snap.HasVector(o.ID) }) {
+		for k := range sketches {
+			sketches[k] = hyperloglog.New()
+		}
+		if err := p.mergeSketches(ctx, objects, missingPaths, sketches); err != nil {
+			return nil, err
+		}
+	}
+	for i, k := range missing {
+		if s := sketches[i]; s != nil {
+			ndvs[k] = int64(s.Estimate())
+		}
+		p.ndvs.Add(ndvKey{commit, paths[k].String()}, ndvs[k])
+	}
+	return ndvs, nil
+}
+
+// mergeSketches concurrently reads the sketches at paths from the vector
+// objects of objects and merges them into sketches, setting to nil each
+// sketch that some vector object lacks.
+func (p *Pool) mergeSketches(ctx context.Context, objects []*data.Object, paths []field.Path, sketches []*hyperloglog.Sketch) error {
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(runtime.GOMAXPROCS(0))
+	var mu sync.Mutex
+	for _, o := range objects {
+		group.Go(func() error {
+			r, err := p.engine.Get(ctx, o.VectorURI(p.DataPath))
+			if err != nil {
+				return err
+			}
+			_, objectSketches, err := csupio.Stats(r, paths)
+			r.Close()
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for k := range sketches {
+				if sketches[k] == nil {
+					continue
+				}
+				if objectSketches[k] == nil || sketches[k].Merge(objectSketches[k]) != nil {
+					sketches[k] = nil
+				}
+			}
+			return nil
+		})
+	}
+	return group.Wait()
+}
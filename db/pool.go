@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/fs"
 	"runtime"
+	"slices"
 	"sync"
 
 	"github.com/brimdata/super"
@@ -14,11 +15,13 @@ import (
 	"github.com/brimdata/super/db/data"
 	"github.com/brimdata/super/db/pools"
 	"github.com/brimdata/super/dbid"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sup"
+	arc "github.com/hashicorp/golang-lru/arc/v2"
 	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -28,6 +31,7 @@ const (
 	DataTag     = "data"
 	BranchesTag = "branches"
 	CommitsTag  = "commits"
+	TagsTag     = "tags"
 )
 
 type Pool struct {
@@ -37,6 +41,8 @@ type Pool struct {
 	DataPath *storage.URI
 	branches *branches.Store
 	commits  *commits.Store
+	tags     *tagStore
+	ndvs     *arc.ARCCache[ndvKey, int64]
 }
 
 func CreatePool(ctx context.Context, engine storage.Engine, logger *zap.Logger, root *storage.URI, config *pools.Config) error {
@@ -84,6 +90,10 @@ func OpenPool(ctx context.Context, engine storage.Engine, logger *zap.Logger, ro
 	if err != nil {
 		return nil, err
 	}
+	ndvs, err := arc.NewARC[ndvKey, int64](1024)
+	if err != nil {
+		return nil, err
+	}
 	return &Pool{
 		Config:   *config,
 		engine:   engine,
@@ -91,6 +101,8 @@ func OpenPool(ctx context.Context, engine storage.Engine, logger *zap.Logger, ro
 		DataPath: DataPath(path),
 		branches: branches,
 		commits:  commits,
+		tags:     &tagStore{engine: engine, logger: logger, path: path.JoinPath(TagsTag)},
+		ndvs:     ndvs,
 	}, nil
 }
 
@@ -142,18 +154,70 @@ func (p *Pool) OpenBranchByName(ctx context.Context, name string) (*Branch, erro
 	return p.openBranch(ctx, branchRef)
 }
 
-// ResolveRevision returns the commit id for revision. revision can be either a
-// commit ID in string form or a branch name.
+// ResolveRevision returns the commit id for revision. revision can be a
+// commit id, a branch name, a tag name, a timestamp, which resolves to the
+// latest commit on the main branch at or before that time, or a timestamp
+// qualified by a branch name as in "branch@time", which resolves to the
+// latest commit on that branch at or before that time.
 func (p *Pool) ResolveRevision(ctx context.Context, revision string) (ksuid.KSUID, error) {
-	id, err := dbid.ParseID(revision)
+	if id, err := dbid.ParseID(revision); err == nil {
+		return id, nil
+	}
+	branch, err := p.LookupBranchByName(ctx, revision)
+	if errors.Is(err, branches.ErrNotFound) {
+		if tag, tagErr := p.LookupTagByName(ctx, revision); tagErr == nil {
+			return tag.Commit, nil
+		}
+		if ts, tsErr := dbid.ParseTime(revision); tsErr == nil {
+			return p.CommitAt(ctx, "main", ts)
+		}
+		if name, ts, ok := dbid.ParseBranchTime(revision); ok {
+			return p.CommitAt(ctx, name, ts)
+		}
+	}
 	if err != nil {
-		branch, err := p.LookupBranchByName(ctx, revision)
+		return ksuid.Nil, err
+	}
+	return branch.Commit, nil
+}
+
+// CommitAt returns the latest commit on the named branch at or before ts.
+func (p *Pool) CommitAt(ctx context.Context, branchName string, ts nano.Ts) (ksuid.KSUID, error) {
+	branch, err := p.LookupBranchByName(ctx, branchName)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	return p.commits.CommitAt(ctx, branch.Commit, ts)
+}
+
+// BatchifyBranchesAt returns the branches of the pool as of ts with the
+// commit of each branch being its latest commit at or before ts.  Branches
+// created after ts or with no such commit are omitted.
+func (p *Pool) BatchifyBranchesAt(ctx context.Context, m *sup.MarshalBSUPContext, ts nano.Ts) ([]super.Value, error) {
+	branchRefs, err := p.ListBranches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var recs []super.Value
+	for _, branchRef := range branchRefs {
+		if branchRef.Ts > ts {
+			continue
+		}
+		commit, err := p.commits.CommitAt(ctx, branchRef.Commit, ts)
+		if errors.Is(err, commits.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		branchRef.Commit = commit
+		rec, err := m.Marshal(&BranchMeta{p.Config, branchRef})
 		if err != nil {
-			return ksuid.Nil, err
+			return nil, err
 		}
-		id = branch.Commit
+		recs = append(recs, rec)
 	}
-	return id, nil
+	return recs, nil
 }
 
 func (p *Pool) BatchifyBranches(ctx context.Context, sctx *super.Context, recs []super.Value, m *sup.MarshalBSUPContext, f expr.Evaluator) ([]super.Value, error) {
@@ -211,17 +275,29 @@ func (p *Pool) ObjectExists(ctx context.Context, id ksuid.KSUID) (bool, error) {
 	return p.engine.Exists(ctx, data.SequenceURI(p.DataPath, id))
 }
 
+// Vacuum deletes the data objects in the path of commit that are not
+// referenced by its snapshot or by the snapshot of any tagged commit.
 func (p *Pool) Vacuum(ctx context.Context, commit ksuid.KSUID, dryrun bool) ([]ksuid.KSUID, error) {
+	tagged, err := p.taggedSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
 	group, ctx := errgroup.WithContext(ctx)
 	group.SetLimit(runtime.GOMAXPROCS(0))
 	ch := make(chan *data.Object)
-	group.Go(func() error {
+	// The objects are listed outside of group so that listing does not
+	// take one of its slots, which would deadlock when the limit is one.
+	var listErr error
+	go func() {
 		defer close(ch)
-		return p.commits.Vacuumable(ctx, commit, ch)
-	})
+		listErr = p.commits.Vacuumable(ctx, commit, ch)
+	}()
 	var vacuumed []ksuid.KSUID
 	var mu sync.Mutex
 	for o := range ch {
+		if slices.ContainsFunc(tagged, func(snap *commits.Snapshot) bool { return snap.Exists(o.ID) }) {
+			continue
+		}
 		if dryrun {
 			// For dryrun just check if the object exists and append existing
 			// objects to list of results.
@@ -260,6 +336,9 @@ func (p *Pool) Vacuum(ctx context.Context, commit ksuid.KSUID, dryrun bool) ([]k
 	if err := group.Wait(); err != nil {
 		return nil, err
 	}
+	if listErr != nil {
+		return nil, listErr
+	}
 	return vacuumed, nil
 }
 
@@ -2,6 +2,7 @@ package pools
 
 import (
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/csup"
 	"github.com/brimdata/super/db/data"
 	"github.com/brimdata/super/db/journal"
 	"github.com/brimdata/super/order"
@@ -19,6 +20,23 @@ type Config struct {
 	SortKeys   order.SortKeys `super:"layout"`
 	SeekStride int            `super:"seek_stride"`
 	Threshold  int64          `super:"threshold"`
+	Retention  Retention      `super:"retention"`
+	Contract   Contract       `super:"contract"`
+	Vector     Vector         `super:"vector"`
+}
+
+// Vector holds the options for writing the vector objects of a pool.
+type Vector struct {
+	// BloomFilters adds Bloom filters to the metadata of vector objects
+	// so that queries for equality can skip data objects.
+	BloomFilters bool `super:"bloom_filters"`
+	// Sketches adds distinct-value sketches to the metadata of vector
+	// objects for join planning.
+	Sketches bool `super:"sketches"`
+}
+
+func (v Vector) WriterOpts() csup.WriterOpts {
+	return csup.WriterOpts{BloomFilters: v.BloomFilters, Sketches: v.Sketches}
 }
 
 var _ journal.Entry = (*Config)(nil)
@@ -61,6 +79,9 @@ type marshalConfig struct {
 	SortKey    oldSortKey  `super:"layout"`
 	SeekStride int         `super:"seek_stride"`
 	Threshold  int64       `super:"threshold"`
+	Retention  Retention   `super:"retention"`
+	Contract   Contract    `super:"contract"`
+	Vector     Vector      `super:"vector"`
 }
 
 type oldSortKey struct {
@@ -81,6 +102,9 @@ func (p Config) MarshalBSUP(ctx *sup.MarshalBSUPContext) (super.Type, error) {
 		ID:         p.ID,
 		SeekStride: p.SeekStride,
 		Threshold:  p.Threshold,
+		Retention:  p.Retention,
+		Contract:   p.Contract,
+		Vector:     p.Vector,
 	}
 	if !p.SortKeys.IsNil() {
 		m.SortKey.Order = p.SortKeys[0].Order
@@ -103,6 +127,9 @@ func (p *Config) UnmarshalBSUP(ctx *sup.UnmarshalBSUPContext, val super.Value) e
 	p.ID = m.ID
 	p.SeekStride = m.SeekStride
 	p.Threshold = m.Threshold
+	p.Retention = m.Retention
+	p.Contract = m.Contract
+	p.Vector = m.Vector
 	for _, k := range m.SortKey.Keys {
 		p.SortKeys = append(p.SortKeys, order.NewSortKey(m.SortKey.Order, k))
 	}
@@ -0,0 +1,102 @@
+package pools
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+)
+
+const (
+	// ContractStrict rejects a load containing any value whose type is
+	// not the contract type or that the shaper turns into an error.
+	ContractStrict = "strict"
+	// ContractCoerce casts each value to the contract type and rejects a
+	// load containing any value that cannot be cast.
+	ContractCoerce = "coerce"
+	// ContractQuarantine casts each value to the contract type and loads
+	// the values that cannot be cast or shaped into the quarantine branch.
+	ContractQuarantine = "quarantine"
+
+	DefaultQuarantineBranch = "quarantine"
+)
+
+// Contract is the schema contract of a pool, which constrains the values
+// that may be loaded into it.  Each loaded value is first transformed by
+// the Shaper expression, if any, and must then be of Type, if any.  Each
+// change to the contract increments its version so a contract can be
+// traced through the pool journal.
+type Contract struct {
+	Version    int    `super:"version"`
+	Type       string `super:"type"`
+	Mode       string `super:"mode"`
+	Quarantine string `super:"quarantine"`
+	Shaper     string `super:"shaper"`
+}
+
+// IsZero returns true if no contract is in effect.
+func (c Contract) IsZero() bool {
+	return c.Type == "" && c.Shaper == ""
+}
+
+// LookupType returns the contract type in sctx or nil if the contract
+// has no type.
+func (c Contract) LookupType(sctx *super.Context) (super.Type, error) {
+	if c.Type == "" {
+		return nil, nil
+	}
+	typ, err := sup.ParseType(sctx, c.Type)
+	if err != nil {
+		return nil, fmt.Errorf("contract type %q: %w", c.Type, err)
+	}
+	return typ, nil
+}
+
+// Validate checks c and fills in its defaults.
+func (c *Contract) Validate() error {
+	if c.IsZero() {
+		*c = Contract{Version: c.Version}
+		return nil
+	}
+	typ, err := c.LookupType(super.NewContext())
+	if err != nil {
+		return err
+	}
+	if typ != nil {
+		// Canonicalize the type text.
+		c.Type = sup.FormatType(typ)
+	}
+	switch c.Mode {
+	case "":
+		c.Mode = ContractStrict
+	case ContractStrict, ContractCoerce, ContractQuarantine:
+	default:
+		return fmt.Errorf("unknown contract mode %q (must be %q, %q, or %q)", c.Mode, ContractStrict, ContractCoerce, ContractQuarantine)
+	}
+	if c.Mode == ContractCoerce && typ == nil {
+		return fmt.Errorf("contract mode %q requires a type", c.Mode)
+	}
+	if c.Mode != ContractQuarantine {
+		c.Quarantine = ""
+	} else if c.Quarantine == "" {
+		c.Quarantine = DefaultQuarantineBranch
+	}
+	return nil
+}
+
+func (c Contract) String() string {
+	if c.IsZero() {
+		return "none"
+	}
+	s := fmt.Sprintf("version %d: %s", c.Version, c.Mode)
+	if c.Shaper != "" {
+		s += fmt.Sprintf(" shaper %q", c.Shaper)
+	}
+	if c.Type != "" {
+		s += " " + c.Type
+	}
+	if c.Mode == ContractQuarantine {
+		s += fmt.Sprintf(" (quarantine branch %q)", c.Quarantine)
+	}
+	return s
+}
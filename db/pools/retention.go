@@ -0,0 +1,87 @@
+package pools
+
+import (
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/units"
+	"github.com/brimdata/super/sup"
+)
+
+// Retention is the policy by which the data of a pool expires.  Data is
+// expired a whole data object at a time, so a pool may hold some values
+// outside the policy until all of the values of their object are outside
+// it.  A zero field disables that part of the policy.
+type Retention struct {
+	// Keep is the span of time before the present for which values
+	// of the pool key are kept, i.e., keep values where key > now() - Keep.
+	// It is a duration rather than an arbitrary filter since objects are
+	// expired by the range of their keys, and it expires only objects
+	// whose keys are times.
+	Keep nano.Duration `super:"keep"`
+	// MaxSize is the maximum total size in bytes of the data objects
+	// of a branch.  Objects holding the smallest key values are expired
+	// first.
+	MaxSize int64 `super:"max_size"`
+	// MaxAge is the span of time before the present for which data
+	// objects are kept after the commit that added them.  Unlike Keep,
+	// it does not depend on the pool key.
+	MaxAge nano.Duration `super:"max_age"`
+}
+
+// marshalRetention is the BSUP form of Retention, whose nano.Duration
+// fields would otherwise be marshaled as integers.
+type marshalRetention struct {
+	Keep    super.Value `super:"keep"`
+	MaxSize int64       `super:"max_size"`
+	MaxAge  super.Value `super:"max_age"`
+}
+
+var retentionBindings = []sup.Binding{{Name: "pools.Retention", Template: marshalRetention{}}}
+
+func (r Retention) MarshalBSUP(ctx *sup.MarshalBSUPContext) (super.Type, error) {
+	ctx.NamedBindings(retentionBindings)
+	return ctx.MarshalValue(marshalRetention{
+		Keep:    super.NewDuration(r.Keep),
+		MaxSize: r.MaxSize,
+		MaxAge:  super.NewDuration(r.MaxAge),
+	})
+}
+
+func (r *Retention) UnmarshalBSUP(ctx *sup.UnmarshalBSUPContext, val super.Value) error {
+	ctx.NamedBindings(retentionBindings)
+	var m marshalRetention
+	if err := ctx.Unmarshal(val, &m); err != nil {
+		return err
+	}
+	*r = Retention{MaxSize: m.MaxSize}
+	if m.Keep.Type() == super.TypeDuration {
+		r.Keep = super.DecodeDuration(m.Keep.Bytes())
+	}
+	if m.MaxAge.Type() == super.TypeDuration {
+		r.MaxAge = super.DecodeDuration(m.MaxAge.Bytes())
+	}
+	return nil
+}
+
+func (r Retention) IsZero() bool {
+	return r.Keep == 0 && r.MaxSize == 0 && r.MaxAge == 0
+}
+
+func (r Retention) String() string {
+	if r.IsZero() {
+		return "none"
+	}
+	var parts []string
+	if r.Keep != 0 {
+		parts = append(parts, "keep "+r.Keep.String())
+	}
+	if r.MaxSize != 0 {
+		parts = append(parts, "max size "+units.Bytes(r.MaxSize).String())
+	}
+	if r.MaxAge != 0 {
+		parts = append(parts, "max age "+r.MaxAge.String())
+	}
+	return strings.Join(parts, ", ")
+}
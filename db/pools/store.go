@@ -98,6 +98,51 @@ func (s *Store) Rename(ctx context.Context, id ksuid.KSUID, newName string) erro
 	return err
 }
 
+// SetRetention replaces the retention policy of a pool.
+func (s *Store) SetRetention(ctx context.Context, id ksuid.KSUID, retention Retention) error {
+	config, err := s.LookupByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	config.Retention = retention
+	err = s.store.Update(ctx, config, func(v journal.Entry) bool {
+		p, ok := v.(*Config)
+		return ok && p.ID == id
+	})
+	switch err {
+	case journal.ErrNoSuchKey:
+		return fmt.Errorf("%s: %w", id, ErrNotFound)
+	case journal.ErrConstraint:
+		return fmt.Errorf("%s: pool %q renamed during update", config.Name, id)
+	}
+	return err
+}
+
+// SetContract replaces the schema contract of a pool and increments the
+// contract version.
+func (s *Store) SetContract(ctx context.Context, id ksuid.KSUID, contract Contract) error {
+	config, err := s.LookupByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	contract.Version = config.Contract.Version + 1
+	if err := contract.Validate(); err != nil {
+		return err
+	}
+	config.Contract = contract
+	err = s.store.Update(ctx, config, func(v journal.Entry) bool {
+		p, ok := v.(*Config)
+		return ok && p.ID == id && p.Contract.Version == contract.Version-1
+	})
+	switch err {
+	case journal.ErrNoSuchKey:
+		return fmt.Errorf("%s: %w", id, ErrNotFound)
+	case journal.ErrConstraint:
+		return fmt.Errorf("%s: pool %q changed during update", config.Name, id)
+	}
+	return err
+}
+
 // Remove deletes a pool from the configuration journal.
 func (s *Store) Remove(ctx context.Context, config Config) error {
 	err := s.store.Delete(ctx, config.Name, func(v journal.Entry) bool {
@@ -6,15 +6,20 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"sync"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/bsupbytes"
 	"github.com/brimdata/super/compiler/dag"
 	"github.com/brimdata/super/db/branches"
 	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/db/grants"
 	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/db/tags"
+	"github.com/brimdata/super/db/views"
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/runtime/vcache"
 	"github.com/brimdata/super/sbuf"
@@ -27,7 +32,9 @@ import (
 
 const (
 	Version     = 5
+	GrantsTag   = "grants"
 	PoolsTag    = "pools"
+	ViewsTag    = "views"
 	MagicFile   = "superdb.bsup"
 	MagicString = "SUPERDB"
 )
@@ -47,6 +54,18 @@ type Root struct {
 	poolCache *arc.ARCCache[ksuid.KSUID, *Pool]
 	pools     *pools.Store
 	vCache    *vcache.Cache
+
+	// grants is created when the first grant is made.
+	grants   *grants.Store
+	grantsMu sync.Mutex
+
+	// views is created when the first view is made.
+	views   *views.Store
+	viewsMu sync.Mutex
+	// viewLocks serializes the refreshes of each view.  It is guarded by
+	// viewsMu.
+	viewLocks map[string]*sync.Mutex
+	viewQueue viewQueue
 }
 
 type Magic struct {
@@ -182,6 +201,8 @@ func (r *Root) readMagic(ctx context.Context) error {
 	return nil
 }
 
+// BatchifyPools returns the configs of the pools that the Authorizer of ctx
+// permits reading.
 func (r *Root) BatchifyPools(ctx context.Context, sctx *super.Context, f expr.Evaluator) ([]super.Value, error) {
 	m := sup.NewBSUPMarshalerWithContext(sctx)
 	m.Decorate(sup.StylePackage)
@@ -191,6 +212,9 @@ func (r *Root) BatchifyPools(ctx context.Context, sctx *super.Context, f expr.Ev
 	}
 	var vals []super.Value
 	for k := range pools {
+		if Authorize(ctx, pools[k].ID, grants.Read) != nil {
+			continue
+		}
 		rec, err := m.Marshal(&pools[k])
 		if err != nil {
 			return nil, err
@@ -202,6 +226,8 @@ func (r *Root) BatchifyPools(ctx context.Context, sctx *super.Context, f expr.Ev
 	return vals, nil
 }
 
+// BatchifyBranches returns the branches of the pools that the Authorizer of
+// ctx permits reading.
 func (r *Root) BatchifyBranches(ctx context.Context, sctx *super.Context, f expr.Evaluator) ([]super.Value, error) {
 	m := sup.NewBSUPMarshalerWithContext(sctx)
 	m.Decorate(sup.StylePackage)
@@ -211,6 +237,9 @@ func (r *Root) BatchifyBranches(ctx context.Context, sctx *super.Context, f expr
 	}
 	var vals []super.Value
 	for k := range poolRefs {
+		if Authorize(ctx, poolRefs[k].ID, grants.Read) != nil {
+			continue
+		}
 		pool, err := r.openPool(ctx, &poolRefs[k])
 		if err != nil {
 			// We could have race here because a pool got deleted
@@ -233,6 +262,73 @@ type BranchMeta struct {
 	Branch branches.Config `super:"branch"`
 }
 
+// Grants returns the permissions granted to roles.
+func (r *Root) Grants(ctx context.Context) ([]grants.Grant, error) {
+	store, err := r.grantStore(ctx, false)
+	if store == nil || err != nil {
+		return nil, err
+	}
+	return store.All(ctx)
+}
+
+// Grant gives the members of role perm on the pool with ID pool or, when
+// pool is ksuid.Nil, on every pool, replacing any previous grant to role
+// on the pool.
+func (r *Root) Grant(ctx context.Context, role string, pool ksuid.KSUID, perm grants.Permission) error {
+	if role == "" {
+		return errors.New("no role given")
+	}
+	if perm == grants.None {
+		return errors.New("no permission given")
+	}
+	if pool != ksuid.Nil {
+		if _, err := r.pools.LookupByID(ctx, pool); err != nil {
+			return err
+		}
+	}
+	store, err := r.grantStore(ctx, true)
+	if err != nil {
+		return err
+	}
+	return store.Put(ctx, grants.Grant{Role: role, Pool: pool, Permission: perm.String()})
+}
+
+// Revoke removes the grant to role on the pool with ID pool.
+func (r *Root) Revoke(ctx context.Context, role string, pool ksuid.KSUID) error {
+	store, err := r.grantStore(ctx, false)
+	if err != nil {
+		return err
+	}
+	if store == nil {
+		return fmt.Errorf("%w for role %q", grants.ErrNotFound, role)
+	}
+	return store.Remove(ctx, role, pool)
+}
+
+func (r *Root) grantStore(ctx context.Context, create bool) (*grants.Store, error) {
+	r.grantsMu.Lock()
+	defer r.grantsMu.Unlock()
+	if r.grants != nil {
+		return r.grants, nil
+	}
+	path := r.path.JoinPath(GrantsTag)
+	store, err := grants.OpenStore(ctx, r.engine, r.logger, path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if !create {
+			// No grants have been made.
+			return nil, nil
+		}
+		if store, err = grants.CreateStore(ctx, r.engine, r.logger, path); err != nil {
+			return nil, err
+		}
+	}
+	r.grants = store
+	return store, nil
+}
+
 func (r *Root) ListPools(ctx context.Context) ([]pools.Config, error) {
 	return r.pools.All(ctx)
 }
@@ -248,16 +344,14 @@ func (r *Root) PoolID(ctx context.Context, poolName string) (ksuid.KSUID, error)
 	return poolRef.ID, nil
 }
 
-func (r *Root) CommitObject(ctx context.Context, poolID ksuid.KSUID, branchName string) (ksuid.KSUID, error) {
+// CommitObject returns the commit ID for revision as resolved by
+// Pool.ResolveRevision.
+func (r *Root) CommitObject(ctx context.Context, poolID ksuid.KSUID, revision string) (ksuid.KSUID, error) {
 	pool, err := r.OpenPool(ctx, poolID)
 	if err != nil {
 		return ksuid.Nil, err
 	}
-	branchRef, err := pool.LookupBranchByName(ctx, branchName)
-	if err != nil {
-		return ksuid.Nil, err
-	}
-	return branchRef.Commit, nil
+	return pool.ResolveRevision(ctx, revision)
 }
 
 func (r *Root) SortKeys(ctx context.Context, src dag.Op) order.SortKeys {
@@ -313,7 +407,21 @@ func (r *Root) RenamePool(ctx context.Context, id ksuid.KSUID, newName string) e
 	return r.pools.Rename(ctx, id, newName)
 }
 
-func (r *Root) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64) (*Pool, error) {
+// SetPoolRetention replaces the retention policy of a pool.
+func (r *Root) SetPoolRetention(ctx context.Context, id ksuid.KSUID, retention pools.Retention) error {
+	return r.pools.SetRetention(ctx, id, retention)
+}
+
+// SetPoolContract replaces the schema contract of a pool.  The compiler c
+// checks the contract's shaper.
+func (r *Root) SetPoolContract(ctx context.Context, c runtime.Compiler, id ksuid.KSUID, contract pools.Contract) error {
+	if err := checkContract(ctx, c, contract); err != nil {
+		return err
+	}
+	return r.pools.SetContract(ctx, id, contract)
+}
+
+func (r *Root) CreatePool(ctx context.Context, name string, sortKeys order.SortKeys, seekStride int, thresh int64, vector pools.Vector) (*Pool, error) {
 	if name == "HEAD" {
 		return nil, fmt.Errorf("pool cannot be named %q", name)
 	}
@@ -327,6 +435,7 @@ func (r *Root) CreatePool(ctx context.Context, name string, sortKeys order.SortK
 		return nil, errors.New("multiple pool keys not supported")
 	}
 	config := pools.NewConfig(name, sortKeys, thresh, seekStride)
+	config.Vector = vector
 	if err := CreatePool(ctx, r.engine, r.logger, r.path, config); err != nil {
 		return nil, err
 	}
@@ -359,12 +468,20 @@ func (r *Root) RemovePool(ctx context.Context, id ksuid.KSUID) error {
 	return RemovePool(ctx, r.engine, r.path, config)
 }
 
+// CreateBranch creates a branch of the pool with ID poolID.  A branch may
+// not have the name of a tag, since it would hide the tag when resolving
+// revisions.
 func (r *Root) CreateBranch(ctx context.Context, poolID ksuid.KSUID, name string, parent ksuid.KSUID) (*branches.Config, error) {
-	config, err := r.pools.LookupByID(ctx, poolID)
+	pool, err := r.OpenPool(ctx, poolID)
 	if err != nil {
 		return nil, err
 	}
-	return CreateBranch(ctx, r.engine, r.logger, r.path, config, name, parent)
+	if _, err := pool.LookupTagByName(ctx, name); err == nil {
+		return nil, fmt.Errorf("%q: %w", name, tags.ErrExists)
+	} else if !errors.Is(err, tags.ErrNotFound) {
+		return nil, err
+	}
+	return CreateBranch(ctx, r.engine, r.logger, r.path, &pool.Config, name, parent)
 }
 
 func (r *Root) RemoveBranch(ctx context.Context, poolID ksuid.KSUID, name string) error {
@@ -375,6 +492,30 @@ func (r *Root) RemoveBranch(ctx context.Context, poolID ksuid.KSUID, name string
 	return pool.removeBranch(ctx, name)
 }
 
+func (r *Root) Tags(ctx context.Context, poolID ksuid.KSUID) ([]tags.Config, error) {
+	pool, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	return pool.ListTags(ctx)
+}
+
+func (r *Root) CreateTag(ctx context.Context, poolID ksuid.KSUID, name string, commit ksuid.KSUID) (*tags.Config, error) {
+	pool, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	return pool.CreateTag(ctx, name, commit)
+}
+
+func (r *Root) RemoveTag(ctx context.Context, poolID ksuid.KSUID, name string) error {
+	pool, err := r.OpenPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	return pool.RemoveTag(ctx, name)
+}
+
 // MergeBranch merges the indicated branch into its parent returning the
 // commit tag of the new commit into the parent branch.
 func (r *Root) MergeBranch(ctx context.Context, poolID ksuid.KSUID, childBranch, parentBranch, author, message string) (ksuid.KSUID, error) {
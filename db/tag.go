@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sync"
+
+	"github.com/brimdata/super/db/branches"
+	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/tags"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// tagStore opens the tags journal of a pool when it is first needed, since
+// the journal is created when the first tag is made.
+type tagStore struct {
+	engine storage.Engine
+	logger *zap.Logger
+	path   *storage.URI
+
+	mu    sync.Mutex
+	store *tags.Store
+}
+
+func (t *tagStore) open(ctx context.Context, create bool) (*tags.Store, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.store != nil {
+		return t.store, nil
+	}
+	store, err := tags.OpenStore(ctx, t.engine, t.logger, t.path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if !create {
+			// No tags have been made.
+			return nil, nil
+		}
+		if store, err = tags.CreateStore(ctx, t.engine, t.logger, t.path); err != nil {
+			return nil, err
+		}
+	}
+	t.store = store
+	return store, nil
+}
+
+func (p *Pool) ListTags(ctx context.Context) ([]tags.Config, error) {
+	store, err := p.tags.open(ctx, false)
+	if store == nil || err != nil {
+		return nil, err
+	}
+	return store.All(ctx)
+}
+
+func (p *Pool) LookupTagByName(ctx context.Context, name string) (*tags.Config, error) {
+	store, err := p.tags.open(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		return nil, fmt.Errorf("%q: %w", name, tags.ErrNotFound)
+	}
+	return store.LookupByName(ctx, name)
+}
+
+// CreateTag names commit with a tag that cannot be moved.  A tag may not
+// have the name of a branch, since branch names take precedence when
+// resolving revisions, and Root.CreateBranch likewise rejects the name of
+// a tag.
+func (p *Pool) CreateTag(ctx context.Context, name string, commit ksuid.KSUID) (*tags.Config, error) {
+	if name == "" {
+		return nil, errors.New("no tag name given")
+	}
+	if _, err := p.LookupBranchByName(ctx, name); err == nil {
+		return nil, fmt.Errorf("%q: %w", name, branches.ErrExists)
+	} else if !errors.Is(err, branches.ErrNotFound) {
+		return nil, err
+	}
+	if _, err := p.commits.Get(ctx, commit); err != nil {
+		return nil, fmt.Errorf("%s: %w", commit, commits.ErrNotFound)
+	}
+	store, err := p.tags.open(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	config := tags.NewConfig(name, commit)
+	if err := store.Add(ctx, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (p *Pool) RemoveTag(ctx context.Context, name string) error {
+	store, err := p.tags.open(ctx, false)
+	if err != nil {
+		return err
+	}
+	if store == nil {
+		return fmt.Errorf("%q: %w", name, tags.ErrNotFound)
+	}
+	return store.Remove(ctx, name)
+}
+
+// taggedSnapshots returns the snapshots of the tagged commits.
+func (p *Pool) taggedSnapshots(ctx context.Context) ([]*commits.Snapshot, error) {
+	list, err := p.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var snaps []*commits.Snapshot
+	for _, tag := range list {
+		snap, err := p.commits.Snapshot(ctx, tag.Commit)
+		if err != nil {
+			return nil, err
+		}
+		snaps = append(snaps, snap)
+	}
+	return snaps, nil
+}
@@ -0,0 +1,113 @@
+// Package tags stores the tags of a pool, which are immutable names for
+// commits.
+package tags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brimdata/super/db/journal"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrExists   = errors.New("tag already exists")
+	ErrNotFound = errors.New("tag not found")
+)
+
+type Config struct {
+	Ts     nano.Ts     `super:"ts"`
+	Name   string      `super:"name"`
+	Commit ksuid.KSUID `super:"commit"`
+}
+
+var _ journal.Entry = (*Config)(nil)
+
+func NewConfig(name string, commit ksuid.KSUID) *Config {
+	return &Config{
+		Ts:     nano.Now(),
+		Name:   name,
+		Commit: commit,
+	}
+}
+
+func (c *Config) Key() string {
+	return c.Name
+}
+
+type Store struct {
+	store *journal.Store
+}
+
+func CreateStore(ctx context.Context, engine storage.Engine, logger *zap.Logger, path *storage.URI) (*Store, error) {
+	store, err := journal.CreateStore(ctx, engine, logger, path, Config{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+func OpenStore(ctx context.Context, engine storage.Engine, logger *zap.Logger, path *storage.URI) (*Store, error) {
+	store, err := journal.OpenStore(ctx, engine, logger, path, Config{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+func (s *Store) All(ctx context.Context) ([]Config, error) {
+	entries, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Config, 0, len(entries))
+	for _, entry := range entries {
+		tag, ok := entry.(*Config)
+		if !ok {
+			return nil, errors.New("corrupt tag journal")
+		}
+		list = append(list, *tag)
+	}
+	return list, nil
+}
+
+func (s *Store) LookupByName(ctx context.Context, name string) (*Config, error) {
+	entry, err := s.store.Lookup(ctx, name)
+	if err != nil {
+		if err == journal.ErrNoSuchKey {
+			return nil, fmt.Errorf("%q: %w", name, ErrNotFound)
+		}
+		return nil, err
+	}
+	tag, ok := entry.(*Config)
+	if !ok {
+		return nil, errors.New("corrupt tag journal")
+	}
+	return tag, nil
+}
+
+// Add adds config to the store.  Tags are never updated, so a tag may only
+// be moved to another commit by removing it and adding it again.
+func (s *Store) Add(ctx context.Context, config *Config) error {
+	if err := s.store.Insert(ctx, config); err != nil {
+		if err == journal.ErrKeyExists {
+			return fmt.Errorf("%q: %w", config.Name, ErrExists)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Store) Remove(ctx context.Context, name string) error {
+	if err := s.store.Delete(ctx, name, nil); err != nil {
+		if err == journal.ErrNoSuchKey {
+			return fmt.Errorf("%q: %w", name, ErrNotFound)
+		}
+		return err
+	}
+	return nil
+}
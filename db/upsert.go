@@ -0,0 +1,271 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/plural"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr/extent"
+	"github.com/brimdata/super/sio"
+	"github.com/brimdata/super/sio/bsupio"
+	"github.com/brimdata/super/sup"
+	"github.com/segmentio/ksuid"
+)
+
+var ErrDuplicateUpsertKey = errors.New("duplicate upsert key")
+
+// Upsert loads the values of r into the branch like Load but replaces the
+// values of the branch having the same upsert key as a loaded value.  Only
+// the values of data objects whose pool key range overlaps that of the
+// loaded values are replaced, and only the objects holding a replaced value
+// are rewritten.  The replaced objects, their rewritten remainders, and
+// the loaded objects are committed in a single commit object.
+func (b *Branch) Upsert(ctx context.Context, c runtime.Compiler, sctx *super.Context, r sio.Reader, key field.Path, author, message, meta string) (ksuid.KSUID, error) {
+	if len(key) == 0 {
+		return ksuid.Nil, errors.New("upsert key must be a field")
+	}
+	return b.load(ctx, c, sctx, r, key, author, message, meta)
+}
+
+// upsertReader records the upsert keys of the values it reads.  Values
+// without the key are loaded without replacing any values.
+type upsertReader struct {
+	sio.Reader
+	key     field.Path
+	keys    map[string]int
+	offset  int
+	dupErrs []string
+	ndups   int
+}
+
+func newUpsertReader(r sio.Reader, key field.Path) *upsertReader {
+	return &upsertReader{
+		Reader: r,
+		key:    key,
+		keys:   make(map[string]int),
+	}
+}
+
+func (u *upsertReader) Read() (*super.Value, error) {
+	val, err := u.Reader.Read()
+	if val == nil || err != nil {
+		return val, err
+	}
+	offset := u.offset
+	u.offset++
+	if k, ok := upsertKey(*val, u.key); ok {
+		if prev, ok := u.keys[k]; ok {
+			u.ndups++
+			if len(u.dupErrs) < maxViolations {
+				u.dupErrs = append(u.dupErrs, fmt.Sprintf("offsets %d and %d: %s", prev, offset, k))
+			}
+		}
+		u.keys[k] = offset
+	}
+	return val, nil
+}
+
+func (u *upsertReader) hasKey(val super.Value) bool {
+	k, ok := upsertKey(val, u.key)
+	if !ok {
+		return false
+	}
+	_, ok = u.keys[k]
+	return ok
+}
+
+// err returns an error if the loaded values have duplicate upsert keys,
+// since it is ambiguous which one should be kept.
+func (u *upsertReader) err() error {
+	if u.ndups == 0 {
+		return nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s is duplicated in loaded values", u.key)
+	for _, s := range u.dupErrs {
+		fmt.Fprintf(&b, "\n  %s", s)
+	}
+	if u.ndups > len(u.dupErrs) {
+		b.WriteString("\n  ...")
+	}
+	return fmt.Errorf("%w: %s", ErrDuplicateUpsertKey, b.String())
+}
+
+// upsertKey returns the value of val at path formatted so it is independent
+// of type context.
+func upsertKey(val super.Value, path field.Path) (string, bool) {
+	k := val.DerefPath(path)
+	if k == nil || k.IsMissing() {
+		return "", false
+	}
+	return sup.FormatValue(*k), true
+}
+
+// upsertRewrites holds the objects rewritten by the attempts of an upsert
+// commit.  The rewrite of an object depends only on the object and the
+// upsert keys, so an attempt that is retried after a commit conflict reuses
+// the rewrites of the objects it shares with the failed attempt rather than
+// writing them again.
+type upsertRewrites struct {
+	// survivors maps the ID of each object scanned to its rewritten
+	// objects as returned by rewriteObject.
+	survivors map[ksuid.KSUID][]data.Object
+	// used holds the IDs of the objects replaced by the latest attempt.
+	used map[ksuid.KSUID]struct{}
+}
+
+func newUpsertRewrites() *upsertRewrites {
+	return &upsertRewrites{survivors: make(map[ksuid.KSUID][]data.Object)}
+}
+
+// cleanup removes the rewritten objects that are not part of the commit of
+// the latest attempt or all of them if committed is false.  The objects are
+// not referenced by any commit, so errors removing them are ignored.
+func (r *upsertRewrites) cleanup(ctx context.Context, b *Branch, committed bool) {
+	for id, survivors := range r.survivors {
+		if _, ok := r.used[id]; ok && committed {
+			continue
+		}
+		for _, o := range survivors {
+			b.pool.engine.Delete(ctx, o.SequenceURI(b.pool.DataPath))
+			b.pool.engine.Delete(ctx, o.SeekIndexURI(b.pool.DataPath))
+		}
+	}
+}
+
+// upsertCommit returns the commit object of an upsert of objects into the
+// snapshot of parent.  If message is empty, a message describing the commit
+// followed by note is used.
+func (b *Branch) upsertCommit(ctx context.Context, parent ksuid.KSUID, retries int, u *upsertReader, rewrites *upsertRewrites, objects []data.Object, author, message, note string, meta super.Value) (*commits.Object, error) {
+	base, err := b.pool.commits.Snapshot(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	o := b.pool.SortKeys.Primary().Order
+	var span *extent.Generic
+	for _, obj := range objects {
+		if span == nil {
+			span = extent.NewGenericFromOrder(obj.Min, obj.Max, o)
+		} else {
+			span.Extend(obj.Min)
+			span.Extend(obj.Max)
+		}
+	}
+	patch := commits.NewPatch(base)
+	var deleted, rewritten []*data.Object
+	rewrites.used = make(map[ksuid.KSUID]struct{})
+	for _, obj := range base.Select(span, o) {
+		survivors, ok := rewrites.survivors[obj.ID]
+		if !ok {
+			survivors, err = b.rewriteObject(ctx, obj, u)
+			if err != nil {
+				return nil, err
+			}
+			rewrites.survivors[obj.ID] = survivors
+		}
+		if survivors == nil {
+			continue
+		}
+		rewrites.used[obj.ID] = struct{}{}
+		deleted = append(deleted, obj)
+		patch.DeleteObject(obj.ID)
+		for _, s := range survivors {
+			rewritten = append(rewritten, &s)
+			patch.AddDataObject(&s)
+		}
+	}
+	for _, obj := range objects {
+		patch.AddDataObject(&obj)
+	}
+	if message == "" {
+		message = upsertMessage(u.key, deleted, rewritten, objects) + note
+	}
+	return patch.NewCommitObject(parent, retries, author, message, meta), nil
+}
+
+// errFound stops a scan of an object when a value with an upsert key is found.
+var errFound = errors.New("found")
+
+// rewriteObject writes the values of obj that do not have an upsert key of
+// u into new objects.  It returns nil if no values of obj have a key of u
+// and an empty slice if all of them do.
+func (b *Branch) rewriteObject(ctx context.Context, obj *data.Object, u *upsertReader) ([]data.Object, error) {
+	sctx := super.NewContext()
+	err := b.scanObject(ctx, sctx, obj, func(val super.Value) error {
+		if u.hasKey(val) {
+			return errFound
+		}
+		return nil
+	})
+	if err == nil || err != errFound {
+		return nil, err
+	}
+	w, err := NewWriter(ctx, sctx, b.pool)
+	if err != nil {
+		return nil, err
+	}
+	err = b.scanObject(ctx, sctx, obj, func(val super.Value) error {
+		if u.hasKey(val) {
+			return nil
+		}
+		return w.Write(val)
+	})
+	if closeErr := w.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	objects := w.Objects()
+	if objects == nil {
+		objects = []data.Object{}
+	}
+	return objects, nil
+}
+
+func (b *Branch) scanObject(ctx context.Context, sctx *super.Context, obj *data.Object, fn func(super.Value) error) error {
+	rc, err := obj.NewReader(ctx, b.pool.engine, b.pool.DataPath, nil)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	r := bsupio.NewReader(sctx, rc)
+	defer r.Close()
+	for {
+		val, err := r.Read()
+		if val == nil || err != nil {
+			return err
+		}
+		if err := fn(*val); err != nil {
+			return err
+		}
+	}
+}
+
+func upsertMessage(key field.Path, deleted, rewritten []*data.Object, added []data.Object) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "upserted %d data object%s on key %s\n\n", len(added), plural.Slice(added, "s"), key)
+	for k, o := range added {
+		if k >= maxMessageObjects {
+			b.WriteString("  ...\n")
+			break
+		}
+		fmt.Fprintf(&b, "  %s\n", o)
+	}
+	if len(deleted) > 0 {
+		fmt.Fprintf(&b, "\nreplaced values in %d data object%s\n\n", len(deleted), plural.Slice(deleted, "s"))
+		printObjects(&b, deleted, maxMessageObjects)
+	}
+	if len(rewritten) > 0 {
+		fmt.Fprintf(&b, "\nrewrote remaining values to %d data object%s\n\n", len(rewritten), plural.Slice(rewritten, "s"))
+		printObjects(&b, rewritten, maxMessageObjects)
+	}
+	return b.String()
+}
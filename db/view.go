@@ -0,0 +1,643 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/db/branches"
+	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/db/views"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/sio"
+	"github.com/brimdata/super/sio/bsupio"
+	"github.com/brimdata/super/sup"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// PartialsBranch is the branch of the target pool of a view that holds the
+// partial results of the view query's aggregate.
+const PartialsBranch = "partials"
+
+// ViewAuthor is the author of the commits that refresh a view.
+const ViewAuthor = "db view"
+
+// ViewQueryError is returned by CreateView when a query cannot be compiled
+// into the stages used to maintain a view.
+type ViewQueryError struct {
+	Err error
+}
+
+func (v *ViewQueryError) Error() string {
+	return v.Err.Error()
+}
+
+func (v *ViewQueryError) Unwrap() error {
+	return v.Err
+}
+
+// Views returns the materialized views of the database.
+func (r *Root) Views(ctx context.Context) ([]views.Config, error) {
+	store, err := r.viewStore(ctx, false)
+	if store == nil || err != nil {
+		return nil, err
+	}
+	return store.All(ctx)
+}
+
+func (r *Root) LookupView(ctx context.Context, name string) (*views.Config, error) {
+	store, err := r.viewStore(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if store == nil {
+		return nil, fmt.Errorf("%q: %w", name, views.ErrNotFound)
+	}
+	return store.LookupByName(ctx, name)
+}
+
+// CreateView creates a materialized view named name of query over the
+// branch of the pool with ID source.  The query reads the values of the
+// branch as its input and must compute its results with a final aggregate.
+// The results are stored in the main branch of a new pool named name and
+// the partial results of the aggregate in the pool's partials branch.  If
+// the query ends with a sort whose first key is a field, the pool is keyed
+// by that field in the sort's order so the view keeps the order of its
+// results.
+func (r *Root) CreateView(ctx context.Context, c runtime.Compiler, name, query string, source ksuid.KSUID, branch string) (*views.Config, error) {
+	if name == "" {
+		return nil, errors.New("no view name given")
+	}
+	pool, err := r.OpenPool(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := pool.LookupBranchByName(ctx, branch); err != nil {
+		return nil, err
+	}
+	if err := checkView(ctx, c, query); err != nil {
+		return nil, err
+	}
+	store, err := r.viewStore(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := store.LookupByName(ctx, name); err == nil {
+		return nil, fmt.Errorf("%q: %w", name, views.ErrExists)
+	}
+	target, err := r.CreatePool(ctx, name, viewSortKeys(query), 0, 0, pools.Vector{})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.CreateBranch(ctx, target.ID, PartialsBranch, ksuid.Nil); err != nil {
+		r.RemovePool(ctx, target.ID)
+		return nil, err
+	}
+	if err := store.Add(ctx, views.NewConfig(name, query, source, branch, target.ID)); err != nil {
+		r.RemovePool(ctx, target.ID)
+		return nil, err
+	}
+	if _, err := r.RefreshView(ctx, c, name); err != nil {
+		store.Remove(ctx, name)
+		r.RemovePool(ctx, target.ID)
+		return nil, err
+	}
+	return store.LookupByName(ctx, name)
+}
+
+// RemoveView removes the view named name and its target pool.
+func (r *Root) RemoveView(ctx context.Context, name string) error {
+	view, err := r.LookupView(ctx, name)
+	if err != nil {
+		return err
+	}
+	store, err := r.viewStore(ctx, false)
+	if err != nil {
+		return err
+	}
+	if err := store.Remove(ctx, name); err != nil {
+		return err
+	}
+	if err := r.RemovePool(ctx, view.Target); err != nil && !errors.Is(err, pools.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// MaintainViews schedules refreshes of the views of the branch of the pool
+// with ID pool and returns without waiting for them.  A refresh that
+// commits new results to the target pool of a view schedules refreshes of
+// the views of the target pool in turn.  MaintainViews is called after a
+// commit to the branch, which has succeeded regardless of whether the views
+// can be refreshed, so errors are logged rather than returned.  A view that
+// fails to refresh remains at its previous commit and may be refreshed again
+// with RefreshView.  WaitViews waits for the scheduled refreshes to finish.
+func (r *Root) MaintainViews(ctx context.Context, c runtime.Compiler, pool ksuid.KSUID, branch string) {
+	list, err := r.Views(ctx)
+	if err != nil {
+		r.logger.Error("Listing views", zap.Error(err))
+		return
+	}
+	// The refreshes outlive the request that made the commit.
+	ctx = context.WithoutCancel(ctx)
+	for _, view := range list {
+		if view.Source != pool || view.Branch != branch {
+			continue
+		}
+		r.viewQueue.schedule(view.Name, func() {
+			commit, err := r.RefreshView(ctx, c, view.Name)
+			if err != nil {
+				r.logger.Warn("Refreshing view", zap.String("view", view.Name), zap.Error(err))
+				return
+			}
+			if commit != ksuid.Nil {
+				r.MaintainViews(ctx, c, view.Target, "main")
+			}
+		})
+	}
+}
+
+// WaitViews waits for the refreshes scheduled by MaintainViews to finish.
+func (r *Root) WaitViews() {
+	r.viewQueue.wg.Wait()
+}
+
+// viewQueue runs the refreshes scheduled by MaintainViews.  Each view has at
+// most one refresh running and, while it runs, at most one more queued, so
+// a burst of commits to the source branch of a view is folded into a single
+// refresh.  The refreshes of different views run concurrently.
+type viewQueue struct {
+	mu sync.Mutex
+	// queued maps the name of each view with a running refresh to whether
+	// another refresh is queued behind it.
+	queued map[string]bool
+	wg     sync.WaitGroup
+}
+
+func (q *viewQueue) schedule(name string, refresh func()) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.queued[name]; ok {
+		q.queued[name] = true
+		return
+	}
+	if q.queued == nil {
+		q.queued = make(map[string]bool)
+	}
+	q.queued[name] = false
+	q.wg.Add(1)
+	go q.run(name, refresh)
+}
+
+func (q *viewQueue) run(name string, refresh func()) {
+	defer q.wg.Done()
+	for {
+		refresh()
+		q.mu.Lock()
+		again := q.queued[name]
+		if again {
+			q.queued[name] = false
+		} else {
+			delete(q.queued, name)
+		}
+		q.mu.Unlock()
+		if !again {
+			return
+		}
+	}
+}
+
+// RefreshView brings the results of the view named name up to date with the
+// tip of its source branch and returns the resulting commit of the main
+// branch of its target pool or ksuid.Nil if the view was up to date.
+//
+// The view is maintained incrementally.  Only the data objects added to the
+// source branch since the commit that the partial results in the target's
+// partials branch were computed from are read, and the partial results of
+// the view query's aggregate over them are merged into the partial results.
+// If data objects were deleted from the source branch since that commit,
+// e.g., by a delete or a compaction, the view is recomputed from all of the
+// branch's data.
+//
+// Each commit to the partials branch records the source commit it was
+// computed from in its meta value, so a refresh that fails after updating
+// the partials branch resumes from the updated partials and does not merge
+// the same data objects into them twice.
+func (r *Root) RefreshView(ctx context.Context, c runtime.Compiler, name string) (ksuid.KSUID, error) {
+	mu := r.viewLock(name)
+	mu.Lock()
+	defer mu.Unlock()
+	view, err := r.LookupView(ctx, name)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	source, err := r.OpenPool(ctx, view.Source)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	branch, err := source.LookupBranchByName(ctx, view.Branch)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	if branch.Commit == view.Commit {
+		return ksuid.Nil, nil
+	}
+	target, err := r.OpenPool(ctx, view.Target)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	partials, err := target.OpenBranchByName(ctx, PartialsBranch)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	from, err := target.partialsSource(ctx, partials.Commit)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	added, incremental, err := source.addedSince(ctx, from, branch.Commit)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	main, err := target.OpenBranchByName(ctx, "main")
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	var prev []*data.Object
+	if incremental {
+		snap, err := target.commits.Snapshot(ctx, partials.Commit)
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		prev = snap.SelectAll()
+	}
+	sctx := super.NewContext()
+	in := newObjectReader(ctx, sctx, source, added)
+	defer in.Close()
+	delta, err := runView(ctx, sctx, c, view.Query, parser.PartialsOut, in)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	in = newObjectReader(ctx, sctx, target, prev)
+	defer in.Close()
+	merged, err := runView(ctx, sctx, c, view.Query, parser.PartialsMerge, sio.ConcatReader(in, sbuf.NewArray(delta)))
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	results, err := runView(ctx, sctx, c, view.Query, parser.PartialsIn, sbuf.NewArray(merged))
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	message := fmt.Sprintf("refreshed view %q from commit %s of %s@%s", name, branch.Commit, source.Name, view.Branch)
+	if !incremental && from != ksuid.Nil {
+		message += " (recomputed)"
+	}
+	if from != branch.Commit {
+		meta, err := sup.MarshalBSUP(partialsMeta{Source: branch.Commit})
+		if err != nil {
+			return ksuid.Nil, err
+		}
+		if _, err := partials.replace(ctx, sctx, merged, message, meta); err != nil {
+			return ksuid.Nil, err
+		}
+	}
+	commit, err := main.replace(ctx, sctx, results, message, super.Null)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	store, err := r.viewStore(ctx, false)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	if err := store.Advance(ctx, name, view.Commit, branch.Commit); err != nil {
+		return ksuid.Nil, err
+	}
+	return commit, nil
+}
+
+// viewLock returns the mutex that serializes the refreshes of the view named
+// name.
+func (r *Root) viewLock(name string) *sync.Mutex {
+	r.viewsMu.Lock()
+	defer r.viewsMu.Unlock()
+	mu, ok := r.viewLocks[name]
+	if !ok {
+		if r.viewLocks == nil {
+			r.viewLocks = make(map[string]*sync.Mutex)
+		}
+		mu = new(sync.Mutex)
+		r.viewLocks[name] = mu
+	}
+	return mu
+}
+
+// checkView returns an error if query cannot be compiled into each of the
+// stages used to maintain a view.
+func checkView(ctx context.Context, c runtime.Compiler, query string) error {
+	sctx := super.NewContext()
+	for _, p := range []parser.Partials{parser.PartialsOut, parser.PartialsMerge, parser.PartialsIn} {
+		if _, err := runView(ctx, sctx, c, query, p, sbuf.NewArray(nil)); err != nil {
+			return &ViewQueryError{err}
+		}
+	}
+	return nil
+}
+
+// viewSortKeys returns the pool key of the target pool of a view of query,
+// which is the first key of the sort that ends the query if that key is a
+// field, or nil otherwise.
+func viewSortKeys(query string) order.SortKeys {
+	p, err := parser.ParseText(query)
+	if err != nil {
+		return nil
+	}
+	seq := p.Parsed()
+	if len(seq) == 0 {
+		return nil
+	}
+	sort, ok := seq[len(seq)-1].(*ast.SortOp)
+	if !ok || len(sort.Exprs) == 0 {
+		return nil
+	}
+	key := sort.Exprs[0]
+	path, ok := fieldPath(key.Expr)
+	if !ok || len(path) == 0 {
+		return nil
+	}
+	which := order.Asc
+	if key.Order != nil {
+		if which, err = order.Parse(key.Order.Name); err != nil {
+			return nil
+		}
+	}
+	if sort.Reverse {
+		which = !which
+	}
+	return order.SortKeys{order.NewSortKey(which, path)}
+}
+
+func fieldPath(e ast.Expr) (field.Path, bool) {
+	switch e := e.(type) {
+	case *ast.IDExpr:
+		if e.Name == "this" {
+			return field.Path{}, true
+		}
+		return field.Path{e.Name}, true
+	case *ast.BinaryExpr:
+		if e.Op != "." {
+			return nil, false
+		}
+		path, ok := fieldPath(e.LHS)
+		if !ok {
+			return nil, false
+		}
+		id, ok := e.RHS.(*ast.IDExpr)
+		if !ok {
+			return nil, false
+		}
+		return append(path, id.Name), true
+	}
+	return nil, false
+}
+
+// runView runs stage p of the view query over the values of r and returns
+// its results.
+func runView(ctx context.Context, sctx *super.Context, c runtime.Compiler, query string, p parser.Partials, r sio.Reader) ([]super.Value, error) {
+	ast, err := parser.ParseText(query)
+	if err != nil {
+		return nil, err
+	}
+	ast.SetPartials(p)
+	q, err := runtime.CompileQuery(ctx, sctx, c, ast, []sio.Reader{r})
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+	results := sbuf.NewArray(nil)
+	if err := sbuf.CopyPuller(results, q); err != nil {
+		return nil, err
+	}
+	return results.Values(), nil
+}
+
+// partialsMeta is the meta value of a commit to the partials branch of a
+// view's target pool.
+type partialsMeta struct {
+	// Source is the commit of the view's source branch that the partial
+	// results were computed from.
+	Source ksuid.KSUID `super:"source"`
+}
+
+// partialsSource returns the commit of the source branch of a view that the
+// partial results at commit of the view's partials branch were computed
+// from or ksuid.Nil if there are no partial results.
+func (p *Pool) partialsSource(ctx context.Context, commit ksuid.KSUID) (ksuid.KSUID, error) {
+	if commit == ksuid.Nil {
+		return ksuid.Nil, nil
+	}
+	o, err := p.commits.Get(ctx, commit)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	for _, action := range o.Actions {
+		if c, ok := action.(*commits.Commit); ok && !c.Meta.IsNull() {
+			var meta partialsMeta
+			if err := sup.UnmarshalBSUP(c.Meta, &meta); err != nil {
+				return ksuid.Nil, err
+			}
+			return meta.Source, nil
+		}
+	}
+	return ksuid.Nil, nil
+}
+
+// addedSince returns the data objects added to the journal ending at leaf
+// since the commit from and true.  Compactions are skipped since they don't
+// change the data.  If from is not in the journal, data objects were deleted
+// since from, or a compaction rewrote data objects added since from,
+// addedSince instead returns all of the data objects of leaf and false.
+func (p *Pool) addedSince(ctx context.Context, from, leaf ksuid.KSUID) ([]*data.Object, bool, error) {
+	if from != ksuid.Nil {
+		path, err := p.commits.PathRange(ctx, leaf, from)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(path) > 0 && path[len(path)-1] == from {
+			added, ok, err := p.addedOnPath(ctx, path[:len(path)-1])
+			if ok || err != nil {
+				return added, ok, err
+			}
+		}
+	}
+	snap, err := p.commits.Snapshot(ctx, leaf)
+	if err != nil {
+		return nil, false, err
+	}
+	return snap.SelectAll(), false, nil
+}
+
+func (p *Pool) addedOnPath(ctx context.Context, path []ksuid.KSUID) ([]*data.Object, bool, error) {
+	var added []*data.Object
+	// Visit the commits in the order they were made.
+	for k := len(path) - 1; k >= 0; k-- {
+		o, err := p.commits.Get(ctx, path[k])
+		if err != nil {
+			return nil, false, err
+		}
+		if isCompaction(o) {
+			for _, action := range o.Actions {
+				if d, ok := action.(*commits.Delete); ok && slices.ContainsFunc(added, func(a *data.Object) bool {
+					return a.ID == d.ID
+				}) {
+					return nil, false, nil
+				}
+			}
+			continue
+		}
+		for _, action := range o.Actions {
+			switch action := action.(type) {
+			case *commits.Add:
+				added = append(added, &action.Object)
+			case *commits.Delete:
+				return nil, false, nil
+			}
+		}
+	}
+	return added, true, nil
+}
+
+// isCompaction returns true if o is a commit made by CommitCompact.
+func isCompaction(o *commits.Object) bool {
+	for _, action := range o.Actions {
+		if c, ok := action.(*commits.Commit); ok && !c.Meta.IsNull() {
+			var meta compactMeta
+			return sup.UnmarshalBSUP(c.Meta, &meta) == nil && meta.Compact
+		}
+	}
+	return false
+}
+
+// replace commits vals to the branch in place of all of its data objects.
+func (b *Branch) replace(ctx context.Context, sctx *super.Context, vals []super.Value, message string, meta super.Value) (ksuid.KSUID, error) {
+	w, err := NewWriter(ctx, sctx, b.pool)
+	if err != nil {
+		return ksuid.Nil, err
+	}
+	for _, val := range vals {
+		if err := w.Write(val); err != nil {
+			w.Close()
+			return ksuid.Nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return ksuid.Nil, err
+	}
+	objects := w.Objects()
+	return b.commit(ctx, func(parent *branches.Config, retries int) (*commits.Object, error) {
+		base, err := b.pool.commits.Snapshot(ctx, parent.Commit)
+		if err != nil {
+			return nil, err
+		}
+		patch := commits.NewPatch(base)
+		for _, o := range base.SelectAll() {
+			patch.DeleteObject(o.ID)
+		}
+		for _, o := range objects {
+			patch.AddDataObject(&o)
+		}
+		return patch.NewCommitObject(parent.Commit, retries, ViewAuthor, message, meta), nil
+	})
+}
+
+// objectReader reads the values of a sequence of data objects.
+type objectReader struct {
+	ctx     context.Context
+	sctx    *super.Context
+	pool    *Pool
+	objects []*data.Object
+
+	closer io.Closer
+	reader *bsupio.Reader
+}
+
+func newObjectReader(ctx context.Context, sctx *super.Context, pool *Pool, objects []*data.Object) *objectReader {
+	return &objectReader{
+		ctx:     ctx,
+		sctx:    sctx,
+		pool:    pool,
+		objects: objects,
+	}
+}
+
+func (o *objectReader) Read() (*super.Value, error) {
+	for {
+		if o.reader == nil {
+			if len(o.objects) == 0 {
+				return nil, nil
+			}
+			rc, err := o.objects[0].NewReader(o.ctx, o.pool.engine, o.pool.DataPath, nil)
+			if err != nil {
+				return nil, err
+			}
+			o.objects = o.objects[1:]
+			o.closer = rc
+			o.reader = bsupio.NewReader(o.sctx, rc)
+		}
+		val, err := o.reader.Read()
+		if val != nil || err != nil {
+			return val, err
+		}
+		if err := o.Close(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (o *objectReader) Close() error {
+	if o.reader == nil {
+		return nil
+	}
+	err := o.reader.Close()
+	if closeErr := o.closer.Close(); err == nil {
+		err = closeErr
+	}
+	o.reader = nil
+	o.closer = nil
+	return err
+}
+
+func (r *Root) viewStore(ctx context.Context, create bool) (*views.Store, error) {
+	r.viewsMu.Lock()
+	defer r.viewsMu.Unlock()
+	if r.views != nil {
+		return r.views, nil
+	}
+	path := r.path.JoinPath(ViewsTag)
+	store, err := views.OpenStore(ctx, r.engine, r.logger, path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if !create {
+			// No views have been made.
+			return nil, nil
+		}
+		if store, err = views.CreateStore(ctx, r.engine, r.logger, path); err != nil {
+			return nil, err
+		}
+	}
+	r.views = store
+	return store, nil
+}
@@ -0,0 +1,147 @@
+// Package views stores the materialized views of a database, which are
+// queries over a branch of a source pool whose results are stored in a
+// target pool and kept up to date as the branch changes.
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/brimdata/super/db/journal"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrExists   = errors.New("view already exists")
+	ErrNotFound = errors.New("view not found")
+)
+
+type Config struct {
+	Ts     nano.Ts     `super:"ts"`
+	Name   string      `super:"name"`
+	Query  string      `super:"query"`
+	Source ksuid.KSUID `super:"source"`
+	Branch string      `super:"branch"`
+	Target ksuid.KSUID `super:"target"`
+	// Commit is the commit of the source branch that the results in the
+	// target pool are computed from.
+	Commit ksuid.KSUID `super:"commit"`
+}
+
+var _ journal.Entry = (*Config)(nil)
+
+func NewConfig(name, query string, source ksuid.KSUID, branch string, target ksuid.KSUID) *Config {
+	return &Config{
+		Ts:     nano.Now(),
+		Name:   name,
+		Query:  query,
+		Source: source,
+		Branch: branch,
+		Target: target,
+	}
+}
+
+func (c *Config) Key() string {
+	return c.Name
+}
+
+type Store struct {
+	store *journal.Store
+}
+
+func CreateStore(ctx context.Context, engine storage.Engine, logger *zap.Logger, path *storage.URI) (*Store, error) {
+	store, err := journal.CreateStore(ctx, engine, logger, path, Config{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+func OpenStore(ctx context.Context, engine storage.Engine, logger *zap.Logger, path *storage.URI) (*Store, error) {
+	store, err := journal.OpenStore(ctx, engine, logger, path, Config{})
+	if err != nil {
+		return nil, err
+	}
+	return &Store{store}, nil
+}
+
+// All returns the configs of all views sorted by name.
+func (s *Store) All(ctx context.Context) ([]Config, error) {
+	entries, err := s.store.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Config, 0, len(entries))
+	for _, entry := range entries {
+		view, ok := entry.(*Config)
+		if !ok {
+			return nil, errors.New("corrupt view journal")
+		}
+		list = append(list, *view)
+	}
+	slices.SortFunc(list, func(a, b Config) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return list, nil
+}
+
+func (s *Store) LookupByName(ctx context.Context, name string) (*Config, error) {
+	entry, err := s.store.Lookup(ctx, name)
+	if err != nil {
+		if err == journal.ErrNoSuchKey {
+			return nil, fmt.Errorf("%q: %w", name, ErrNotFound)
+		}
+		return nil, err
+	}
+	view, ok := entry.(*Config)
+	if !ok {
+		return nil, errors.New("corrupt view journal")
+	}
+	return view, nil
+}
+
+func (s *Store) Add(ctx context.Context, config *Config) error {
+	if err := s.store.Insert(ctx, config); err != nil {
+		if err == journal.ErrKeyExists {
+			return fmt.Errorf("%q: %w", config.Name, ErrExists)
+		}
+		return err
+	}
+	return nil
+}
+
+// Advance updates the commit of the view named name from prev to commit.
+// It fails with journal.ErrConstraint if the view's commit is not prev,
+// e.g., because the view was refreshed concurrently.
+func (s *Store) Advance(ctx context.Context, name string, prev, commit ksuid.KSUID) error {
+	config, err := s.LookupByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	update := *config
+	update.Commit = commit
+	err = s.store.Update(ctx, &update, func(e journal.Entry) bool {
+		view, ok := e.(*Config)
+		return ok && view.Commit == prev
+	})
+	if err == journal.ErrNoSuchKey {
+		err = fmt.Errorf("%q: %w", name, ErrNotFound)
+	}
+	return err
+}
+
+func (s *Store) Remove(ctx context.Context, name string) error {
+	if err := s.store.Delete(ctx, name, nil); err != nil {
+		if err == journal.ErrNoSuchKey {
+			return fmt.Errorf("%q: %w", name, ErrNotFound)
+		}
+		return err
+	}
+	return nil
+}
@@ -238,7 +238,7 @@ func (w *SortedWriter) newWriter() error {
 		return err
 	}
 	if w.vectorEnabled {
-		w.vectorWriter, err = o.NewVectorWriter(w.ctx, w.pool.engine, w.pool.DataPath)
+		w.vectorWriter, err = o.NewVectorWriter(w.ctx, w.pool.engine, w.pool.DataPath, w.pool.Vector.WriterOpts())
 		if err != nil {
 			return err
 		}
@@ -19,7 +19,14 @@ func ParseCommitish(commitish string) (*Commitish, error) {
 		return nil, errors.New("pool and branch names may not contain single quote characters")
 	}
 	if i := strings.LastIndexByte(commitish, '@'); i > -1 {
-		return &Commitish{Pool: commitish[:i], Branch: commitish[i+1:]}, nil
+		pool, branch := commitish[:i], commitish[i+1:]
+		// A time may be qualified by a branch as in "pool@branch@time".
+		if j := strings.LastIndexByte(pool, '@'); j > -1 {
+			if _, err := ParseTime(branch); err == nil {
+				pool, branch = commitish[:j], commitish[j+1:]
+			}
+		}
+		return &Commitish{Pool: pool, Branch: branch}, nil
 	}
 	return &Commitish{Pool: commitish}, nil
 }
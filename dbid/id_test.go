@@ -2,6 +2,7 @@ package dbid
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -27,3 +28,58 @@ func TestParseID(t *testing.T) {
 	_, err = ParseID("0A42ooXOWFkGit78ZjPVLpDkRg")
 	assert.EqualError(t, err, "invalid ID: 0A42ooXOWFkGit78ZjPVLpDkRg")
 }
+
+func TestParseTime(t *testing.T) {
+	ts, err := ParseTime("2026-01-01T09:30Z")
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-01T09:30:00Z", ts.Time().UTC().Format(time.RFC3339))
+
+	ts, err = ParseTime("2026-01-01T09:30:00.5-05:00")
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-01T14:30:00.5Z", ts.Time().UTC().Format(time.RFC3339Nano))
+
+	// Times without a time zone are in UTC.
+	ts, err = ParseTime("2026-01-01T09:30:15")
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-01T09:30:15Z", ts.Time().UTC().Format(time.RFC3339))
+
+	ts, err = ParseTime("2026-01-01")
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-01-01T00:00:00Z", ts.Time().UTC().Format(time.RFC3339))
+
+	_, err = ParseTime("main")
+	assert.EqualError(t, err, "invalid time: main")
+
+	_, err = ParseTime("Jan 1, 2026")
+	assert.EqualError(t, err, "invalid time: Jan 1, 2026")
+
+	_, err = ParseTime("0A42ooXOWFkGit78ZjPVLpDkRgn")
+	assert.EqualError(t, err, "invalid time: 0A42ooXOWFkGit78ZjPVLpDkRgn")
+}
+
+func TestParseBranchTime(t *testing.T) {
+	branch, ts, ok := ParseBranchTime("dev@2026-01-01T09:30Z")
+	assert.True(t, ok)
+	assert.Equal(t, "dev", branch)
+	assert.Equal(t, "2026-01-01T09:30:00Z", ts.Time().UTC().Format(time.RFC3339))
+
+	_, _, ok = ParseBranchTime("2026-01-01T09:30Z")
+	assert.False(t, ok)
+
+	_, _, ok = ParseBranchTime("dev@main")
+	assert.False(t, ok)
+}
+
+func TestParseCommitish(t *testing.T) {
+	c, err := ParseCommitish("pool@dev")
+	assert.NoError(t, err)
+	assert.Equal(t, Commitish{Pool: "pool", Branch: "dev"}, *c)
+
+	c, err = ParseCommitish("pool@2026-01-01T09:30Z")
+	assert.NoError(t, err)
+	assert.Equal(t, Commitish{Pool: "pool", Branch: "2026-01-01T09:30Z"}, *c)
+
+	c, err = ParseCommitish("pool@dev@2026-01-01T09:30Z")
+	assert.NoError(t, err)
+	assert.Equal(t, Commitish{Pool: "pool", Branch: "dev@2026-01-01T09:30Z"}, *c)
+}
@@ -0,0 +1,45 @@
+package dbid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/brimdata/super/pkg/nano"
+)
+
+// timeLayouts are the layouts of RFC 3339 accepted by ParseTime along with
+// forms omitting the seconds, the time zone, or the time of day.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// ParseTime parses a revision that is an RFC 3339 timestamp like
+// "2026-01-01T09:00:00Z".  The seconds may be omitted, and timestamps
+// without a time zone are in UTC.
+func ParseTime(s string) (nano.Ts, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return nano.TimeToTs(t), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid time: %s", s)
+}
+
+// ParseBranchTime parses a revision of the form "branch@time" where time
+// is a timestamp as accepted by ParseTime.
+func ParseBranchTime(s string) (string, nano.Ts, bool) {
+	i := strings.LastIndexByte(s, '@')
+	if i < 1 {
+		return "", 0, false
+	}
+	ts, err := ParseTime(s[i+1:])
+	if err != nil {
+		return "", 0, false
+	}
+	return s[:i], ts, true
+}
@@ -0,0 +1,128 @@
+// Package bloom implements the Bloom filters stored in CSUP metadata to
+// rule out equality matches without reading vector data.
+package bloom
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/brimdata/super"
+)
+
+const (
+	// BitsPerValue and NumHashes give a false positive rate of about 1%.
+	BitsPerValue = 10
+	NumHashes    = 7
+)
+
+// Filter is a Bloom filter whose first byte is the number of hash functions
+// and whose remaining bytes are the bit array.
+type Filter []byte
+
+// New returns an empty filter sized for n values.
+func New(n int) Filter {
+	nbytes := max((n*BitsPerValue+7)/8, 8)
+	f := make(Filter, 1+nbytes)
+	f[0] = NumHashes
+	return f
+}
+
+// Add adds the value with hash h to f.
+func (f Filter) Add(h uint64) {
+	bits := f[1:]
+	n := uint64(len(bits)) * 8
+	h1, h2 := h&math.MaxUint32, h>>32
+	for i := range uint64(f[0]) {
+		k := (h1 + i*h2) % n
+		bits[k/8] |= 1 << (k % 8)
+	}
+}
+
+// MayContain returns false if the value with hash h was certainly not added
+// to f.  It returns true for a malformed filter.
+func (f Filter) MayContain(h uint64) bool {
+	if len(f) < 2 {
+		return true
+	}
+	bits := f[1:]
+	n := uint64(len(bits)) * 8
+	h1, h2 := h&math.MaxUint32, h>>32
+	for i := range uint64(f[0]) {
+		k := (h1 + i*h2) % n
+		if bits[k/8]&(1<<(k%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns the hash of val and true if val can be added to a filter.
+// Values that compare equal have the same hash, so numbers of different
+// types hash by their float64 value.
+func Hash(val super.Value) (uint64, bool) {
+	if val.IsNull() {
+		return 0, false
+	}
+	typ := super.TypeUnder(val.Type())
+	switch id := typ.ID(); {
+	case super.IsSigned(id):
+		return HashInt(val.Int()), true
+	case super.IsUnsigned(id):
+		return HashUint(val.Uint()), true
+	case super.IsFloat(id):
+		return HashFloat(val.Float()), true
+	case Hashable(typ):
+		return HashBytes(typ, val.Bytes()), true
+	}
+	return 0, false
+}
+
+// Hashable returns true if the non-numeric values of typ can be added to a
+// filter by their bodies.  Values of other types, e.g., type values whose
+// bodies depend on their type context, are not added.
+func Hashable(typ super.Type) bool {
+	switch typ.ID() {
+	case super.IDBool, super.IDBytes, super.IDString, super.IDIP, super.IDNet:
+		return true
+	}
+	return false
+}
+
+func HashInt(v int64) uint64 {
+	return HashFloat(float64(v))
+}
+
+func HashUint(v uint64) uint64 {
+	return HashFloat(float64(v))
+}
+
+func HashFloat(v float64) uint64 {
+	if v == 0 {
+		// Normalize negative zero.
+		v = 0
+	}
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+	return hash(super.IDFloat64, b[:])
+}
+
+// HashBytes returns the hash of the body of a non-numeric value of type typ.
+func HashBytes(typ super.Type, body []byte) uint64 {
+	return hash(typ.ID(), body)
+}
+
+// hash is 64-bit FNV-1a followed by the MurmurHash3 finalizer so that both
+// halves of the result are well mixed for double hashing.
+func hash(id int, b []byte) uint64 {
+	h := uint64(14695981039346656037)
+	h = (h ^ uint64(id)) * 1099511628211
+	for _, c := range b {
+		h = (h ^ uint64(c)) * 1099511628211
+	}
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
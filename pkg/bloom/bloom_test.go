@@ -0,0 +1,45 @@
+package bloom
+
+import (
+	"math"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter(t *testing.T) {
+	f := New(100)
+	for i := range 100 {
+		f.Add(HashInt(int64(i)))
+	}
+	for i := range 100 {
+		assert.True(t, f.MayContain(HashInt(int64(i))))
+	}
+	var falsePositives int
+	for i := 100; i < 10100; i++ {
+		if f.MayContain(HashInt(int64(i))) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 300)
+	assert.True(t, Filter(nil).MayContain(HashInt(1)))
+}
+
+func TestHash(t *testing.T) {
+	h, ok := Hash(super.NewInt64(-5))
+	require.True(t, ok)
+	h2, ok := Hash(super.NewFloat64(-5))
+	require.True(t, ok)
+	assert.Equal(t, h, h2)
+	h, ok = Hash(super.NewUint64(7))
+	require.True(t, ok)
+	assert.Equal(t, HashInt(7), h)
+	assert.Equal(t, HashFloat(0), HashFloat(math.Copysign(0, -1)))
+	assert.NotEqual(t, HashBytes(super.TypeString, []byte("a")), HashBytes(super.TypeBytes, []byte("a")))
+	_, ok = Hash(super.Null)
+	assert.False(t, ok)
+	_, ok = Hash(super.NewValue(super.TypeType, []byte{1}))
+	assert.False(t, ok)
+}
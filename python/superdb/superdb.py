@@ -1,8 +1,10 @@
 import binascii
+import datetime
 import decimal
 import getpass
 import ipaddress
 import json
+import math
 import os
 import os.path
 import urllib.parse
@@ -54,14 +56,18 @@ class Client():
         r = self.session.post(url, headers=headers, data=data)
         self.__raise_for_status(r)
 
-    def query(self, query):
-        r = self.query_raw(query)
+    def query(self, query, params=None):
+        r = self.query_raw(query, params=params)
         jsup = (json.loads(line) for line in r.iter_lines() if line)
         return decode_jsup(jsup)
 
-    def query_raw(self, query, headers=None):
+    def query_raw(self, query, headers=None, params=None):
+        body = {'query': query}
+        if params is not None:
+            body['sup_params'] = {k: encode_param(v)
+                                  for k, v in params.items()}
         r = self.session.post(self.base_url + '/query', headers=headers,
-                              json={'query': query}, stream=True)
+                              json=body, stream=True)
         self.__raise_for_status(r)
         return r
 
@@ -88,6 +94,54 @@ class QueryError(Exception):
     pass
 
 
+def encode_param(value):
+    """Encode a native Python value as the SUP value of a query parameter.
+
+    Values keep their types, e.g., a datetime is sent as a time, an
+    ipaddress.IPv4Address as an ip, and bytes as bytes.
+    """
+    if value is None:
+        return 'null'
+    if isinstance(value, bool):
+        return 'true' if value else 'false'
+    if isinstance(value, int):
+        return str(value)
+    if isinstance(value, float):
+        if math.isnan(value):
+            return 'NaN'
+        if math.isinf(value):
+            return '+Inf' if value > 0 else '-Inf'
+        return repr(value)
+    if isinstance(value, str):
+        return json.dumps(value)
+    if isinstance(value, dict):
+        fields = (json.dumps(str(k)) + ':' + encode_param(v)
+                  for k, v in value.items())
+        return '{' + ','.join(fields) + '}'
+    if isinstance(value, (list, tuple)):
+        return '[' + ','.join(encode_param(v) for v in value) + ']'
+    if isinstance(value, (set, frozenset)):
+        return '|[' + ','.join(encode_param(v) for v in value) + ']|'
+    if isinstance(value, datetime.datetime):
+        if value.tzinfo is None:
+            value = value.replace(tzinfo=datetime.timezone.utc)
+        return value.astimezone(datetime.timezone.utc).isoformat().replace(
+            '+00:00', 'Z')
+    if isinstance(value, datetime.date):
+        return json.dumps(value.isoformat())
+    if isinstance(value, datetime.timedelta):
+        return durationpy.to_str(value)
+    if isinstance(value, decimal.Decimal):
+        return format(value, 'f')
+    if isinstance(value, (bytes, bytearray)):
+        return '0x' + value.hex()
+    if isinstance(value, (ipaddress.IPv4Address, ipaddress.IPv6Address,
+                          ipaddress.IPv4Network, ipaddress.IPv6Network)):
+        return str(value)
+    raise TypeError('cannot encode query parameter of type ' +
+                    type(value).__name__)
+
+
 def decode_jsup(jsup):
     types = {}
     for msg in jsup:
@@ -7,6 +7,7 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/compiler/parser"
 	"github.com/brimdata/super/dbid"
+	"github.com/brimdata/super/runtime/profile"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio"
 	"github.com/segmentio/ksuid"
@@ -22,6 +23,9 @@ type Query interface {
 	io.Closer
 	Progress() sbuf.Progress
 	Meter() sbuf.Meter
+	// Profile returns the profile of a query run in EXPLAIN ANALYZE mode
+	// or nil otherwise.
+	Profile() *profile.Profile
 }
 
 type DeleteQuery interface {
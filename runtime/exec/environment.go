@@ -13,6 +13,7 @@ import (
 	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/vam"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio/anyio"
@@ -50,6 +51,9 @@ type Environment struct {
 	engine storage.Engine
 	db     *db.Root
 
+	// Compiler is the compiler using the environment, which runs the
+	// contract shapers of loads into the database.
+	Compiler         runtime.Compiler
 	Dynamic          bool
 	IgnoreOpenErrors bool
 	ReaderOpts       anyio.ReaderOpts
@@ -89,9 +93,9 @@ func (e *Environment) PoolID(ctx context.Context, name string) (ksuid.KSUID, err
 	return e.db.PoolID(ctx, name)
 }
 
-func (e *Environment) CommitObject(ctx context.Context, id ksuid.KSUID, name string) (ksuid.KSUID, error) {
+func (e *Environment) CommitObject(ctx context.Context, id ksuid.KSUID, revision string) (ksuid.KSUID, error) {
 	if e.db != nil {
-		return e.db.CommitObject(ctx, id, name)
+		return e.db.CommitObject(ctx, id, revision)
 	}
 	return ksuid.Nil, nil
 }
@@ -2,6 +2,7 @@ package exec
 
 import (
 	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/profile"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio"
 )
@@ -11,17 +12,25 @@ import (
 // methods provide a convenient means to run a flowgraph as sio.Reader.
 type Query struct {
 	sbuf.Puller
-	rctx  *runtime.Context
-	meter sbuf.Meter
+	rctx    *runtime.Context
+	meter   sbuf.Meter
+	profile *profile.Profile
 }
 
 var _ runtime.Query = (*Query)(nil)
 
 func NewQuery(rctx *runtime.Context, puller sbuf.Puller, meter sbuf.Meter) *Query {
+	return NewProfiledQuery(rctx, puller, meter, nil)
+}
+
+// NewProfiledQuery is like NewQuery for a flowgraph instrumented to collect
+// prof.
+func NewProfiledQuery(rctx *runtime.Context, puller sbuf.Puller, meter sbuf.Meter, prof *profile.Profile) *Query {
 	return &Query{
-		Puller: puller,
-		rctx:   rctx,
-		meter:  meter,
+		Puller:  puller,
+		rctx:    rctx,
+		meter:   meter,
+		profile: prof,
 	}
 }
 
@@ -37,6 +46,10 @@ func (q *Query) Meter() sbuf.Meter {
 	return q.meter
 }
 
+func (q *Query) Profile() *profile.Profile {
+	return q.profile
+}
+
 func (q *Query) Close() error {
 	q.rctx.Cancel()
 	return nil
@@ -0,0 +1,161 @@
+package exec
+
+import (
+	"context"
+
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/axiomhq/hyperloglog"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sio/csupio"
+	"github.com/brimdata/super/sio/parquetio"
+)
+
+// SourceStats holds the cardinality statistics of a data source that the
+// optimizer uses to order joins.
+type SourceStats struct {
+	Objects int64
+	Bytes   int64
+	// Rows is the number of values or zero if unknown.
+	Rows int64
+	// NDVs holds the estimated number of distinct values at each path
+	// passed to Environment.SourceStats or zero if unknown.
+	NDVs []int64
+}
+
+// SourceStats returns the statistics of the data source src and the number of
+// distinct values at each of paths.  It returns nil if the statistics of src
+// are unknown.  Pool statistics come from the objects in the commit
+// snapshot and the distinct-value sketches of their vector objects, whose
+// estimates the pool caches.  File statistics come from CSUP and Parquet
+// metadata.
+func (e *Environment) SourceStats(ctx context.Context, src dag.Op, paths []field.Path) (*SourceStats, error) {
+	switch src := src.(type) {
+	case *dag.PoolScan:
+		if e.db == nil {
+			return nil, nil
+		}
+		return e.poolStats(ctx, src, paths)
+	case *dag.FileScan:
+		if e.engine == nil {
+			return nil, nil
+		}
+		return e.fileStats(ctx, src, paths)
+	}
+	return nil, nil
+}
+
+func (e *Environment) poolStats(ctx context.Context, scan *dag.PoolScan, paths []field.Path) (*SourceStats, error) {
+	pool, err := e.db.OpenPool(ctx, scan.ID)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := pool.Snapshot(ctx, scan.Commit)
+	if err != nil {
+		return nil, err
+	}
+	stats := &SourceStats{NDVs: make([]int64, len(paths))}
+	for _, o := range snap.SelectAll() {
+		stats.Objects++
+		stats.Bytes += o.Size
+		stats.Rows += int64(o.Count)
+	}
+	if len(paths) > 0 {
+		if stats.NDVs, err = pool.NDVs(ctx, scan.Commit, paths); err != nil {
+			return nil, err
+		}
+	}
+	return stats, nil
+}
+
+func (e *Environment) fileStats(ctx context.Context, scan *dag.FileScan, paths []field.Path) (*SourceStats, error) {
+	stats := &SourceStats{NDVs: make([]int64, len(paths))}
+	sketches := make([]*hyperloglog.Sketch, len(paths))
+	for k := range sketches {
+		sketches[k] = hyperloglog.New()
+	}
+	var rowsUnknown bool
+	for _, path := range scan.Paths {
+		if path == "-" {
+			return nil, nil
+		}
+		uri, err := storage.ParseURI(path)
+		if err != nil {
+			return nil, err
+		}
+		size, err := e.engine.Size(ctx, uri)
+		if err != nil {
+			return nil, nil
+		}
+		stats.Objects++
+		stats.Bytes += size
+		switch scan.Format {
+		case "csup":
+			r, err := e.engine.Get(ctx, uri)
+			if err != nil {
+				return nil, err
+			}
+			rows, fileSketches, err := csupio.Stats(r, paths)
+			r.Close()
+			if err != nil {
+				return nil, err
+			}
+			stats.Rows += int64(rows)
+			mergeSketches(sketches, fileSketches)
+		case "parquet":
+			rows, ndvs, err := e.parquetStats(ctx, uri, paths)
+			if err != nil {
+				return nil, err
+			}
+			stats.Rows += rows
+			// Parquet distinct counts cannot be merged across files.
+			clear(sketches)
+			if len(scan.Paths) == 1 {
+				copy(stats.NDVs, ndvs)
+			}
+		default:
+			rowsUnknown = true
+			clear(sketches)
+		}
+	}
+	if rowsUnknown {
+		stats.Rows = 0
+	}
+	setNDVs(stats, sketches)
+	return stats, nil
+}
+
+func (e *Environment) parquetStats(ctx context.Context, uri *storage.URI, paths []field.Path) (int64, []int64, error) {
+	r, err := e.engine.Get(ctx, uri)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer r.Close()
+	ras, ok := r.(parquet.ReaderAtSeeker)
+	if !ok {
+		return 0, make([]int64, len(paths)), nil
+	}
+	return parquetio.Stats(ras, paths)
+}
+
+// mergeSketches merges each sketch in from into the corresponding sketch in
+// to and clears the sketches in to whose counterparts in from are nil.
+func mergeSketches(to, from []*hyperloglog.Sketch) {
+	for k := range to {
+		if to[k] == nil {
+			continue
+		}
+		if from[k] == nil || to[k].Merge(from[k]) != nil {
+			to[k] = nil
+		}
+	}
+}
+
+func setNDVs(stats *SourceStats, sketches []*hyperloglog.Sketch) {
+	for k, s := range sketches {
+		if s != nil {
+			stats.NDVs[k] = int64(s.Estimate())
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package profile
+
+import (
+	"runtime"
+
+	"golang.org/x/sys/unix"
+)
+
+const haveThreadCPU = true
+
+func lockThread()   { runtime.LockOSThread() }
+func unlockThread() { runtime.UnlockOSThread() }
+
+// threadID returns the ID of the calling thread.
+func threadID() int {
+	return unix.Gettid()
+}
+
+// threadCPU returns the CPU time consumed by the calling thread.
+func threadCPU() int64 {
+	var ts unix.Timespec
+	if err := unix.ClockGettime(unix.CLOCK_THREAD_CPUTIME_ID, &ts); err != nil {
+		return 0
+	}
+	return ts.Nano()
+}
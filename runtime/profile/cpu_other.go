@@ -0,0 +1,15 @@
+//go:build !linux
+
+package profile
+
+// CPU time is measured only on Linux, where a thread's CPU clock is
+// available, so elsewhere it is reported as zero.
+
+const haveThreadCPU = false
+
+func lockThread()   {}
+func unlockThread() {}
+
+func threadID() int { return 0 }
+
+func threadCPU() int64 { return 0 }
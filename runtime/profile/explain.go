@@ -0,0 +1,53 @@
+package profile
+
+import (
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sbuf"
+)
+
+// Explain returns a puller that implements the EXPLAIN ANALYZE statement.
+// It runs the query of parent to completion, discarding its results, and
+// then returns each line of the annotated DAG of the query's report as a
+// string on the main output.
+func (p *Profile) Explain(parent sbuf.Puller) sbuf.Puller {
+	return &explainer{profile: p, parent: parent}
+}
+
+type explainer struct {
+	profile *Profile
+	parent  sbuf.Puller
+	eoc     bool
+	eos     bool
+}
+
+func (e *explainer) Pull(done bool) (sbuf.Batch, error) {
+	if e.eos {
+		return nil, nil
+	}
+	if e.eoc {
+		e.eos = true
+		eoc := sbuf.EndOfChannel("main")
+		return &eoc, nil
+	}
+	if done {
+		return e.parent.Pull(true)
+	}
+	for {
+		batch, err := e.parent.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			break
+		}
+		batch.Unref()
+	}
+	e.eoc = true
+	var vals []super.Value
+	for line := range strings.SplitSeq(strings.TrimSuffix(e.profile.Report().DAG, "\n"), "\n") {
+		vals = append(vals, super.NewString(line))
+	}
+	return sbuf.Label("main", sbuf.NewArray(vals)), nil
+}
@@ -0,0 +1,232 @@
+// Package profile collects the per-operator runtime statistics of a query
+// for EXPLAIN ANALYZE.  The flowgraph builder wraps the pullers of each DAG
+// operator so that the rows and batches flowing into and out of the operator
+// are counted and the time spent pulling from it is measured.
+package profile
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/compiler/sfmt"
+	"github.com/brimdata/super/pkg/units"
+)
+
+// Buffer is implemented by operators that hold values in memory and spill
+// them to disk when they exceed a memory limit.
+type Buffer interface {
+	// MemPeak returns the high-water mark in bytes of the memory used to
+	// hold values.
+	MemPeak() int64
+	// Spilled returns the number of bytes written to disk.
+	Spilled() int64
+}
+
+// Profile holds an Operator for each operator in a DAG.
+type Profile struct {
+	main *dag.Main
+	mu   sync.Mutex
+	ops  map[dag.Op]*Operator
+	// threads maps the ID of each thread that has pulled from an operator
+	// to its *thread.
+	threads sync.Map
+}
+
+func New(main *dag.Main) *Profile {
+	return &Profile{
+		main: main,
+		ops:  make(map[dag.Op]*Operator),
+	}
+}
+
+// Operator returns the Operator for o, creating it if needed.  An operator
+// that is compiled more than once, e.g., the body of a correlated subquery,
+// accumulates the statistics of all of its instances.
+func (p *Profile) Operator(o dag.Op) *Operator {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	op, ok := p.ops[o]
+	if !ok {
+		op = &Operator{profile: p}
+		p.ops[o] = op
+	}
+	return op
+}
+
+// Report returns the statistics of each operator in the order the operators
+// appear in the DAG along with the DAG annotated with these statistics.
+// Report should be called after the query has finished.
+func (p *Profile) Report() *Report {
+	var stats []Stats
+	cpu := make(map[*Operator]int64)
+	text := sfmt.AnnotatedDAG(p.main, func(o dag.Op) string {
+		p.mu.Lock()
+		op, ok := p.ops[o]
+		p.mu.Unlock()
+		if !ok {
+			return ""
+		}
+		s := op.stats(len(stats)+1, o, cpu)
+		stats = append(stats, s)
+		return s.String()
+	})
+	return &Report{DAG: text, Operators: stats}
+}
+
+// Operator accumulates the statistics of a DAG operator.
+type Operator struct {
+	profile    *Profile
+	rowsIn     atomic.Int64
+	rowsOut    atomic.Int64
+	batchesIn  atomic.Int64
+	batchesOut atomic.Int64
+	wall       atomic.Int64
+	// inputWall is the wall-clock time spent pulling from the operator's
+	// inputs, which is subtracted from wall to give the time spent in the
+	// operator itself.
+	inputWall atomic.Int64
+	// selfCPU is the CPU time spent in the operator itself, i.e., in its
+	// Pull method outside of pulls from its inputs and, for an operator
+	// that pulls its inputs in goroutines of its own, in those goroutines
+	// between the pulls.
+	selfCPU atomic.Int64
+	// buffers and inputs are protected by profile.mu.
+	buffers []Buffer
+	inputs  []*inputStats
+}
+
+// inputStats holds the statistics of an input of an operator.  child is the
+// operator whose output is pulled by the input, which is found when the
+// input's first pull calls the child's Pull method in the same thread, and
+// cpu is the CPU time spent by the threads pulling from the input.
+type inputStats struct {
+	op    *Operator
+	child atomic.Pointer[Operator]
+	cpu   atomic.Int64
+}
+
+func (o *Operator) addInput() *inputStats {
+	in := &inputStats{op: o}
+	o.profile.mu.Lock()
+	o.inputs = append(o.inputs, in)
+	o.profile.mu.Unlock()
+	return in
+}
+
+func (o *Operator) addBuffer(b Buffer) {
+	o.profile.mu.Lock()
+	o.buffers = append(o.buffers, b)
+	o.profile.mu.Unlock()
+}
+
+func (o *Operator) in(rows int) {
+	o.rowsIn.Add(int64(rows))
+	o.batchesIn.Add(1)
+}
+
+func (o *Operator) out(rows int) {
+	o.rowsOut.Add(int64(rows))
+	o.batchesOut.Add(1)
+}
+
+func (o *Operator) stats(id int, op dag.Op, cpu map[*Operator]int64) Stats {
+	o.profile.mu.Lock()
+	defer o.profile.mu.Unlock()
+	s := Stats{
+		ID:         id,
+		Op:         strings.TrimPrefix(fmt.Sprintf("%T", op), "*dag."),
+		RowsIn:     o.rowsIn.Load(),
+		RowsOut:    o.rowsOut.Load(),
+		BatchesIn:  o.batchesIn.Load(),
+		BatchesOut: o.batchesOut.Load(),
+		WallNS:     o.wall.Load(),
+		CPUNS:      o.cpu(cpu),
+		SelfWallNS: max(o.wall.Load()-o.inputWall.Load(), 0),
+		SelfCPUNS:  max(o.selfCPU.Load(), 0),
+		hasInput:   len(o.inputs) > 0,
+		hasBuffer:  len(o.buffers) > 0,
+	}
+	for _, b := range o.buffers {
+		s.MemPeak = max(s.MemPeak, b.MemPeak())
+		s.SpillBytes += b.Spilled()
+	}
+	return s
+}
+
+// cpu returns the CPU time spent in o and its upstream operators, which is
+// the CPU time spent in o itself plus that of the operator pulled by each
+// input or, if that operator is unknown, the CPU time spent pulling from the
+// input.  Results are memoized in m.  It must be called with profile.mu
+// held.
+func (o *Operator) cpu(m map[*Operator]int64) int64 {
+	if cpu, ok := m[o]; ok {
+		return cpu
+	}
+	m[o] = 0
+	cpu := max(o.selfCPU.Load(), 0)
+	for _, in := range o.inputs {
+		if child := in.child.Load(); child != nil {
+			cpu += child.cpu(m)
+		} else {
+			cpu += in.cpu.Load()
+		}
+	}
+	m[o] = cpu
+	return cpu
+}
+
+// Stats are the statistics of an operator.  WallNS is the nanoseconds spent
+// in the operator's Pull method and so includes the time spent in upstream
+// operators that run in the same goroutine.  CPUNS is the CPU time spent in
+// the operator and its upstream operators, including the time spent in
+// goroutines in which the operator pulls its inputs.  SelfWallNS and
+// SelfCPUNS exclude the time spent pulling from the operator's inputs.
+type Stats struct {
+	ID         int    `super:"id" json:"id"`
+	Op         string `super:"op" json:"op"`
+	RowsIn     int64  `super:"rows_in" json:"rows_in"`
+	RowsOut    int64  `super:"rows_out" json:"rows_out"`
+	BatchesIn  int64  `super:"batches_in" json:"batches_in"`
+	BatchesOut int64  `super:"batches_out" json:"batches_out"`
+	WallNS     int64  `super:"wall_ns" json:"wall_ns"`
+	CPUNS      int64  `super:"cpu_ns" json:"cpu_ns"`
+	SelfWallNS int64  `super:"self_wall_ns" json:"self_wall_ns"`
+	SelfCPUNS  int64  `super:"self_cpu_ns" json:"self_cpu_ns"`
+	MemPeak    int64  `super:"mem_peak" json:"mem_peak"`
+	SpillBytes int64  `super:"spill_bytes" json:"spill_bytes"`
+
+	hasInput  bool
+	hasBuffer bool
+}
+
+// String formats s as the annotation of its operator in an annotated DAG.
+func (s Stats) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "#%d", s.ID)
+	if s.hasInput {
+		fmt.Fprintf(&b, " rows=%d->%d batches=%d->%d", s.RowsIn, s.RowsOut, s.BatchesIn, s.BatchesOut)
+	} else {
+		fmt.Fprintf(&b, " rows=%d batches=%d", s.RowsOut, s.BatchesOut)
+	}
+	fmt.Fprintf(&b, " wall=%s cpu=%s self=%s/%s", duration(s.WallNS), duration(s.CPUNS), duration(s.SelfWallNS), duration(s.SelfCPUNS))
+	if s.hasBuffer {
+		fmt.Fprintf(&b, " mem=%s spill=%s", units.Bytes(s.MemPeak).Abbrev(), units.Bytes(s.SpillBytes).Abbrev())
+	}
+	return b.String()
+}
+
+func duration(ns int64) string {
+	return time.Duration(ns).Round(time.Microsecond).String()
+}
+
+// Report is the profile of a query.
+type Report struct {
+	// DAG is the query's DAG in the format of sfmt.DAG with each operator
+	// followed by a comment holding its statistics.
+	DAG       string  `super:"dag" json:"dag"`
+	Operators []Stats `super:"operators" json:"operators"`
+}
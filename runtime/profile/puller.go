@@ -0,0 +1,115 @@
+package profile
+
+import (
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/vector"
+)
+
+// Input returns a puller that counts the values pulled from parent as input
+// to o.  It returns nil if parent is nil.
+func (o *Operator) Input(parent sbuf.Puller) sbuf.Puller {
+	if parent == nil {
+		return nil
+	}
+	return &input{stats: o.addInput(), parent: parent}
+}
+
+// Output returns a puller that counts and times the values pulled from
+// parent, which implements o.  If parent implements Buffer, its memory and
+// spill statistics are included in those of o.
+func (o *Operator) Output(parent sbuf.Puller) sbuf.Puller {
+	if b, ok := parent.(Buffer); ok {
+		o.addBuffer(b)
+	}
+	return &output{op: o, parent: parent}
+}
+
+// VectorInput is like Input for a vector.Puller.
+func (o *Operator) VectorInput(parent vector.Puller) vector.Puller {
+	if parent == nil {
+		return nil
+	}
+	return &vectorInput{stats: o.addInput(), parent: parent}
+}
+
+// VectorOutput is like Output for a vector.Puller.
+func (o *Operator) VectorOutput(parent vector.Puller) vector.Puller {
+	if b, ok := parent.(Buffer); ok {
+		o.addBuffer(b)
+	}
+	return &vectorOutput{op: o, parent: parent}
+}
+
+type input struct {
+	stats  *inputStats
+	parent sbuf.Puller
+}
+
+func (i *input) Pull(done bool) (sbuf.Batch, error) {
+	var batch sbuf.Batch
+	var err error
+	i.stats.timeInput(func() bool {
+		batch, err = i.parent.Pull(done)
+		return batch == nil || err != nil || done
+	})
+	if batch != nil {
+		if n := sbuf.BatchLen(batch); n > 0 {
+			i.stats.op.in(n)
+		}
+	}
+	return batch, err
+}
+
+type output struct {
+	op     *Operator
+	parent sbuf.Puller
+}
+
+func (o *output) Pull(done bool) (sbuf.Batch, error) {
+	var batch sbuf.Batch
+	var err error
+	o.op.timePull(func() {
+		batch, err = o.parent.Pull(done)
+	})
+	if batch != nil {
+		if n := sbuf.BatchLen(batch); n > 0 {
+			o.op.out(n)
+		}
+	}
+	return batch, err
+}
+
+type vectorInput struct {
+	stats  *inputStats
+	parent vector.Puller
+}
+
+func (v *vectorInput) Pull(done bool) (vector.Any, error) {
+	var vec vector.Any
+	var err error
+	v.stats.timeInput(func() bool {
+		vec, err = v.parent.Pull(done)
+		return vec == nil || err != nil || done
+	})
+	if vec != nil {
+		v.stats.op.in(int(vec.Len()))
+	}
+	return vec, err
+}
+
+type vectorOutput struct {
+	op     *Operator
+	parent vector.Puller
+}
+
+func (v *vectorOutput) Pull(done bool) (vector.Any, error) {
+	var vec vector.Any
+	var err error
+	v.op.timePull(func() {
+		vec, err = v.parent.Pull(done)
+	})
+	if vec != nil {
+		v.op.out(int(vec.Len()))
+	}
+	return vec, err
+}
@@ -0,0 +1,144 @@
+package profile
+
+import (
+	"sync"
+	"time"
+)
+
+// thread is the state of a thread that pulls from operators.  It is used
+// only by the goroutine locked to the thread but is passed between
+// goroutines as the thread is reused, so it is protected by a mutex.
+type thread struct {
+	mu sync.Mutex
+	// ops is the stack of operators whose Pull methods are running in the
+	// thread.
+	ops []*Operator
+	// pending is the input being pulled by the thread, which is linked to
+	// the first operator whose Pull method is then called in the thread.
+	pending *inputStats
+	// workers maps an operator that pulls its inputs in this thread from
+	// a goroutine of its own to the thread's CPU clock at the end of the
+	// goroutine's last pull, while the goroutine stays locked to the
+	// thread until its next pull.
+	workers map[*Operator]int64
+}
+
+func (p *Profile) thread() *thread {
+	t, _ := p.threads.LoadOrStore(threadID(), &thread{})
+	return t.(*thread)
+}
+
+// enter records that the Pull method of o is running in t and links o to
+// the input being pulled by t, if any.
+func (t *thread) enter(o *Operator) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if in := t.pending; in != nil {
+		in.child.CompareAndSwap(nil, o)
+		t.pending = nil
+	}
+	t.ops = append(t.ops, o)
+}
+
+func (t *thread) exit() {
+	t.mu.Lock()
+	t.ops = t.ops[:len(t.ops)-1]
+	t.mu.Unlock()
+}
+
+// startInput records that t is pulling from in and returns true if the
+// pull is made by the Pull method of the input's operator.  Otherwise, it
+// returns the CPU time t spent since its last pull from an input of the
+// operator, if any, and false.
+func (t *thread) startInput(in *inputStats) (int64, bool, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = in
+	if n := len(t.ops); n > 0 && t.ops[n-1] == in.op {
+		return 0, false, true
+	}
+	cpu, ok := t.workers[in.op]
+	if ok {
+		delete(t.workers, in.op)
+		cpu = threadCPU() - cpu
+	}
+	return cpu, ok, false
+}
+
+// endInput records the end of a pull from an input of o and, if worker is
+// true, the CPU clock of t at the end of the pull.
+func (t *thread) endInput(o *Operator, worker bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.pending = nil
+	if worker {
+		if t.workers == nil {
+			t.workers = make(map[*Operator]int64)
+		}
+		t.workers[o] = threadCPU()
+	}
+}
+
+// timePull calls pull and adds the wall-clock time and the CPU time of the
+// calling thread that it took to the operator's totals.  The goroutine is
+// locked to its thread so the thread's CPU clock measures only this call.
+func (o *Operator) timePull(pull func()) {
+	if !haveThreadCPU {
+		start := time.Now()
+		pull()
+		o.wall.Add(int64(time.Since(start)))
+		return
+	}
+	lockThread()
+	defer unlockThread()
+	t := o.profile.thread()
+	t.enter(o)
+	startCPU := threadCPU()
+	start := time.Now()
+	pull()
+	o.wall.Add(int64(time.Since(start)))
+	o.selfCPU.Add(threadCPU() - startCPU)
+	t.exit()
+}
+
+// timeInput calls pull, which pulls from the input in, and adds the time it
+// took to the operator's input totals.  When the pull is made by the
+// operator's Pull method, its CPU time is deducted from the operator's own.
+// Otherwise, the operator pulls its inputs in a goroutine of its own, so
+// the goroutine stays locked to its thread between pulls and the CPU time
+// the thread spends between them is added to the operator's own.  pull
+// returns true if it ended the input.
+func (in *inputStats) timeInput(pull func() bool) {
+	o := in.op
+	if !haveThreadCPU {
+		start := time.Now()
+		pull()
+		o.inputWall.Add(int64(time.Since(start)))
+		return
+	}
+	lockThread()
+	t := o.profile.thread()
+	workerCPU, locked, sync := t.startInput(in)
+	if locked {
+		o.selfCPU.Add(workerCPU)
+		// Release the lock held since the last pull.
+		unlockThread()
+	}
+	startCPU := threadCPU()
+	start := time.Now()
+	eos := pull()
+	cpu := threadCPU() - startCPU
+	o.inputWall.Add(int64(time.Since(start)))
+	in.cpu.Add(cpu)
+	if sync {
+		o.selfCPU.Add(-cpu)
+	}
+	worker := !sync && !eos
+	t.endInput(o, worker)
+	if worker {
+		// Hold the lock until the next pull so the work done in
+		// between is measured by this thread's CPU clock.
+		lockThread()
+	}
+	unlockThread()
+}
@@ -0,0 +1,77 @@
+package expr
+
+import (
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/bloom"
+	"github.com/brimdata/super/scode"
+)
+
+// bloomFilter evaluates to false when the Bloom filters in the CSUP
+// metadata computed by expr rule out a value equal to a given value.  The
+// metadata is either a filter or a record whose leaves are records with
+// fields min, max, and optionally bloom, as created by csup.Object's
+// ProjectMetadata.
+type bloomFilter struct {
+	expr   Evaluator
+	hash   uint64
+	ok     bool
+	search bool
+}
+
+// NewBloomFilter returns an Evaluator for pruning CSUP metadata.  If search
+// is true, val is a search term, which may also match any string.
+func NewBloomFilter(e Evaluator, val super.Value, search bool) Evaluator {
+	hash, ok := bloom.Hash(val)
+	return &bloomFilter{expr: e, hash: hash, ok: ok, search: search}
+}
+
+func (b *bloomFilter) Eval(this super.Value) super.Value {
+	if !b.ok {
+		return super.True
+	}
+	return super.NewBool(b.mayContain(b.expr.Eval(this)))
+}
+
+func (b *bloomFilter) mayContain(val super.Value) bool {
+	if val.IsNull() {
+		return true
+	}
+	switch typ := super.TypeUnder(val.Type()).(type) {
+	case *super.TypeOfBytes:
+		return bloom.Filter(val.Bytes()).MayContain(b.hash)
+	case *super.TypeRecord:
+		if len(typ.Fields) == 0 {
+			// There is nothing to rule out.
+			return true
+		}
+		leaf := isMetadataLeaf(typ)
+		if leaf && (len(typ.Fields) == 2 || b.search && super.TypeUnder(typ.Fields[0].Type) == super.TypeString) {
+			return true
+		}
+		it := scode.NewRecordIter(val.Bytes(), typ.Opts)
+		for k, f := range typ.Fields {
+			body, none := it.Next(f.Opt)
+			if leaf && k < 2 {
+				continue
+			}
+			if none || b.mayContain(super.NewValue(f.Type, body)) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// isMetadataLeaf returns true if typ is the type of the metadata of a leaf
+// vector.
+func isMetadataLeaf(typ *super.TypeRecord) bool {
+	fields := typ.Fields
+	if len(fields) < 2 || len(fields) > 3 || fields[0].Name != "min" || fields[1].Name != "max" {
+		return false
+	}
+	if _, ok := super.TypeUnder(fields[0].Type).(*super.TypeRecord); ok {
+		return false
+	}
+	return len(fields) == 2 || fields[2].Name == "bloom" && fields[2].Type == super.TypeBytes
+}
@@ -332,7 +332,7 @@ func (c *collectionBuilder) appendSpread(inner super.Type, b scode.Bytes) {
 func (c *collectionBuilder) iter(sctx *super.Context) collectionIter {
 	// uniqueTypes must be copied since super.UniqueTypes operates on the type
 	// array in place and thus we'll lose order.
-	c.uniqueTypes = append(c.uniqueTypes[:0], c.types...)
+	c.uniqueTypes = super.UniqueTypes(append(c.uniqueTypes[:0], c.types...))
 	return collectionIter{
 		typ:   unionOf(sctx, c.uniqueTypes),
 		bytes: c.bytes,
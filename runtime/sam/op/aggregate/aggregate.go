@@ -63,6 +63,9 @@ type Aggregator struct {
 	spiller        *spill.MergeSort
 	partialsIn     bool
 	partialsOut    bool
+	// nbytes approximates the memory used by table as the size of its keys.
+	nbytes int
+	spill.Stats
 }
 
 type Row struct {
@@ -168,6 +171,16 @@ func (o *Op) Pull(done bool) (sbuf.Batch, error) {
 	return nil, o.rctx.Err()
 }
 
+// MemPeak returns the memory high-water mark of the aggregation table.
+func (o *Op) MemPeak() int64 {
+	return o.agg.MemPeak()
+}
+
+// Spilled returns the number of bytes the aggregation spilled to disk.
+func (o *Op) Spilled() int64 {
+	return o.agg.Spilled()
+}
+
 func (o *Op) run() {
 	defer func() {
 		if o.agg.spiller != nil {
@@ -285,6 +298,7 @@ func (o *Op) reset() {
 		o.agg.spiller = nil
 	}
 	o.agg.table = make(map[string]*Row)
+	o.agg.nbytes = 0
 	if o.batch != nil {
 		o.batch.Unref()
 		o.batch = nil
@@ -350,6 +364,8 @@ func (a *Aggregator) Consume(batch sbuf.Batch, this super.Value) error {
 			reducers: newValRow(a.aggs),
 		}
 		a.table[string(keyBytes)] = row
+		a.nbytes += len(keyBytes)
+		a.UpdateMem(a.nbytes)
 	}
 
 	if a.partialsIn {
@@ -366,7 +382,7 @@ func (a *Aggregator) spillTable(eof bool, ref sbuf.Batch) error {
 		return err
 	}
 	if a.spiller == nil {
-		a.spiller, err = spill.NewMergeSort(a.keysComparator)
+		a.spiller, err = spill.NewMergeSort(a.keysComparator, &a.Stats)
 		if err != nil {
 			return err
 		}
@@ -564,6 +580,7 @@ func (a *Aggregator) readTable(flush, partialsOut bool, batch sbuf.Batch) (sbuf.
 		// unnecessarily by holding back the table entries from GC
 		// until this loop finished.
 		delete(a.table, key)
+		a.nbytes -= len(key)
 	}
 	if len(recs) == 0 {
 		return nil, nil
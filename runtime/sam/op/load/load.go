@@ -11,6 +11,7 @@ import (
 type Op struct {
 	rctx    *runtime.Context
 	root    *db.Root
+	comp    runtime.Compiler
 	parent  sbuf.Puller
 	pool    ksuid.KSUID
 	branch  string
@@ -20,10 +21,11 @@ type Op struct {
 	done    bool
 }
 
-func New(rctx *runtime.Context, root *db.Root, parent sbuf.Puller, pool ksuid.KSUID, branch, author, message, meta string) *Op {
+func New(rctx *runtime.Context, root *db.Root, comp runtime.Compiler, parent sbuf.Puller, pool ksuid.KSUID, branch, author, message, meta string) *Op {
 	return &Op{
 		rctx:    rctx,
 		root:    root,
+		comp:    comp,
 		parent:  parent,
 		pool:    pool,
 		branch:  branch,
@@ -62,7 +64,7 @@ func (o *Op) Pull(done bool) (sbuf.Batch, error) {
 	if err != nil {
 		return nil, err
 	}
-	commitID, err := branch.Load(o.rctx.Context, o.rctx.Sctx, reader, o.author, o.message, o.meta)
+	commitID, err := branch.Load(o.rctx.Context, o.comp, o.rctx.Sctx, reader, o.author, o.message, o.meta)
 	if err != nil {
 		return nil, err
 	}
@@ -11,7 +11,9 @@ import (
 	"github.com/brimdata/super/db/commits"
 	"github.com/brimdata/super/db/data"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/vcache"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sup"
 	"github.com/segmentio/ksuid"
@@ -27,6 +29,7 @@ type Lister struct {
 	pool      *db.Pool
 	snap      commits.View
 	pruner    *pruner
+	meta      *metaPruner
 	group     *errgroup.Group
 	marshaler *sup.MarshalBSUPContext
 	mu        sync.Mutex
@@ -68,6 +71,13 @@ func NewSortedListerFromSnap(ctx context.Context, sctx *super.Context, pool *db.
 	return l
 }
 
+// SetMetaFilter sets a filter that prunes the data objects having a vector
+// object whose CSUP metadata, projected by projection and read through
+// cache, rules out all of their values.
+func (l *Lister) SetMetaFilter(sctx *super.Context, cache *vcache.Cache, filter expr.Evaluator, projection field.Projection) {
+	l.meta = &metaPruner{sctx: sctx, cache: cache, filter: filter, projection: projection}
+}
+
 func (l *Lister) Snapshot() commits.View {
 	return l.snap
 }
@@ -89,9 +99,20 @@ func (l *Lister) Pull(done bool) (sbuf.Batch, error) {
 			l.err = err
 			return nil, err
 		}
-		if !l.pruner.prune(val) {
-			return sbuf.NewArray([]super.Value{val}), nil
+		if l.pruner.prune(val) {
+			continue
+		}
+		if l.meta != nil && l.snap.HasVector(o.ID) {
+			prune, err := l.meta.prune(l.ctx, l.pool, o)
+			if err != nil {
+				l.err = err
+				return nil, err
+			}
+			if prune {
+				continue
+			}
 		}
+		return sbuf.NewArray([]super.Value{val}), nil
 	}
 	return nil, nil
 }
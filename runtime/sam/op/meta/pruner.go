@@ -1,8 +1,14 @@
 package meta
 
 import (
+	"context"
+
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/data"
+	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/vcache"
 )
 
 type pruner struct {
@@ -22,3 +28,33 @@ func (p *pruner) prune(val super.Value) bool {
 	result := p.pred.Eval(val)
 	return result.Type() == super.TypeBool && result.Bool()
 }
+
+// metaPruner prunes data objects using the CSUP metadata of their vector
+// objects, which is read through the vector cache so that the metadata of
+// each object is read from storage once across queries.
+type metaPruner struct {
+	sctx       *super.Context
+	cache      *vcache.Cache
+	filter     expr.Evaluator
+	projection field.Projection
+}
+
+func (m *metaPruner) prune(ctx context.Context, pool *db.Pool, o *data.Object) (bool, error) {
+	object, err := m.cache.Fetch(ctx, o.VectorURI(pool.DataPath), o.ID)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		// A vector object that can't be read, e.g., because it was
+		// written with an older CSUP version, can't prune its data
+		// object, which is scanned instead.
+		return false, nil
+	}
+	defer object.Unpin()
+	for _, val := range object.ProjectMetadata(m.sctx, m.projection) {
+		if m.filter.Eval(val).Ptr().AsBool() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
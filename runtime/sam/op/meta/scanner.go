@@ -8,6 +8,7 @@ import (
 	"github.com/brimdata/super/db"
 	"github.com/brimdata/super/db/commits"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/runtime/sam/expr"
 	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/sio"
@@ -32,7 +33,9 @@ func NewDBMetaScanner(ctx context.Context, sctx *super.Context, r *db.Root, meta
 	return sbuf.NewScanner(ctx, sbuf.NewArray(vals), nil)
 }
 
-func NewPoolMetaScanner(ctx context.Context, sctx *super.Context, r *db.Root, poolID ksuid.KSUID, meta string) (sbuf.Scanner, error) {
+// NewPoolMetaScanner returns a scanner of the pool metadata meta as of time
+// at or, if at is zero, as of now.
+func NewPoolMetaScanner(ctx context.Context, sctx *super.Context, r *db.Root, poolID ksuid.KSUID, meta string, at nano.Ts) (sbuf.Scanner, error) {
 	p, err := r.OpenPool(ctx, poolID)
 	if err != nil {
 		return nil, err
@@ -42,7 +45,11 @@ func NewPoolMetaScanner(ctx context.Context, sctx *super.Context, r *db.Root, po
 	case "branches":
 		m := sup.NewBSUPMarshalerWithContext(sctx)
 		m.Decorate(sup.StylePackage)
-		vals, err = p.BatchifyBranches(ctx, sctx, nil, m, nil)
+		if at != 0 {
+			vals, err = p.BatchifyBranchesAt(ctx, m, at)
+		} else {
+			vals, err = p.BatchifyBranches(ctx, sctx, nil, m, nil)
+		}
 		if err != nil {
 			return nil, err
 		}
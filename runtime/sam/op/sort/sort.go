@@ -27,6 +27,7 @@ type Op struct {
 	once           sync.Once
 	resultCh       chan op.Result
 	comparator     *expr.Comparator
+	spill.Stats
 }
 
 func New(rctx *runtime.Context, parent sbuf.Puller, fields []expr.SortExpr, guessReverse bool) *Op {
@@ -119,11 +120,12 @@ func (o *Op) run() {
 			o.comparator = NewComparator(o.rctx.Sctx, o.fieldResolvers, out[0], o.guessReverse)
 		}
 		nbytes += delta
+		o.UpdateMem(nbytes)
 		if nbytes < MemMaxBytes {
 			continue
 		}
 		if spiller == nil {
-			spiller, err = spill.NewMergeSort(o.comparator)
+			spiller, err = spill.NewMergeSort(o.comparator, &o.Stats)
 			if err != nil {
 				if ok := o.sendResult(nil, err); !ok {
 					return
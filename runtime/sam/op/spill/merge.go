@@ -22,6 +22,7 @@ type MergeSort struct {
 	tempDir    string
 	spillSize  int64
 	sctx       *super.Context
+	stats      *Stats
 }
 
 const TempPrefix = "super-spill-"
@@ -36,8 +37,9 @@ func TempFile() (*os.File, error) {
 
 // NewMergeSort returns a MergeSort to implement external merge sorts of a large
 // BSUP stream.  It creates a temporary directory to hold the collection
-// of spilled chunks.  Call Cleanup to remove it.
-func NewMergeSort(comparator *expr.Comparator) (*MergeSort, error) {
+// of spilled chunks.  Call Cleanup to remove it.  If stats is not nil, the
+// size of each spilled chunk is added to it.
+func NewMergeSort(comparator *expr.Comparator, stats *Stats) (*MergeSort, error) {
 	tempDir, err := TempDir()
 	if err != nil {
 		return nil, err
@@ -46,6 +48,7 @@ func NewMergeSort(comparator *expr.Comparator) (*MergeSort, error) {
 		comparator: comparator,
 		tempDir:    tempDir,
 		sctx:       super.NewContext(),
+		stats:      stats,
 	}, nil
 }
 
@@ -68,6 +71,12 @@ func (r *MergeSort) Spill(ctx context.Context, vals []super.Value) error {
 	}); err != nil {
 		return err
 	}
+	return r.SpillSorted(ctx, zr)
+}
+
+// SpillSorted is like Spill but spills a run of values read from zr, which
+// must already be in sorted order.
+func (r *MergeSort) SpillSorted(ctx context.Context, zr sio.Reader) error {
 	filename := filepath.Join(r.tempDir, strconv.Itoa(r.nspill))
 	runFile, err := newPeeker(ctx, r.sctx, filename, r.nspill, zr)
 	if err != nil {
@@ -79,6 +88,9 @@ func (r *MergeSort) Spill(ctx context.Context, vals []super.Value) error {
 	}
 	r.nspill++
 	r.spillSize += size
+	if r.stats != nil {
+		r.stats.AddSpilled(size)
+	}
 	heap.Push(r, runFile)
 	return nil
 }
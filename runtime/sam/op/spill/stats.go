@@ -0,0 +1,36 @@
+package spill
+
+import "sync/atomic"
+
+// Stats tracks the memory high-water mark and the number of bytes spilled
+// to disk by an operator that buffers values.  Operators embed Stats so a
+// query profile can report these figures.  Stats is safe for concurrent use.
+type Stats struct {
+	memPeak atomic.Int64
+	spilled atomic.Int64
+}
+
+// UpdateMem records that nbytes of values are currently buffered in memory.
+func (s *Stats) UpdateMem(nbytes int) {
+	for {
+		peak := s.memPeak.Load()
+		if int64(nbytes) <= peak || s.memPeak.CompareAndSwap(peak, int64(nbytes)) {
+			return
+		}
+	}
+}
+
+// AddSpilled records that nbytes were written to disk.
+func (s *Stats) AddSpilled(nbytes int64) {
+	s.spilled.Add(nbytes)
+}
+
+// MemPeak returns the largest number of bytes passed to UpdateMem.
+func (s *Stats) MemPeak() int64 {
+	return s.memPeak.Load()
+}
+
+// Spilled returns the total number of bytes passed to AddSpilled.
+func (s *Stats) Spilled() int64 {
+	return s.spilled.Load()
+}
@@ -0,0 +1,442 @@
+package window
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+)
+
+// Frame units
+const (
+	Rows  = "rows"
+	Range = "range"
+)
+
+// Frame bound types
+const (
+	UnboundedPreceding = "unbounded preceding"
+	Preceding          = "preceding"
+	CurrentRow         = "current row"
+	Following          = "following"
+	UnboundedFollowing = "unbounded following"
+)
+
+// Frame specifies the rows of a partition over which an aggregate or value
+// function is computed for each row.
+type Frame struct {
+	Unit  string
+	Start Bound
+	End   Bound
+}
+
+// Bound is the start or end of a Frame.  Offset is used only for the
+// Preceding and Following types.
+type Bound struct {
+	Type   string
+	Offset super.Value
+}
+
+// Func is a window function.  When Agg is non-nil, the function is an
+// aggregate function computed over Frame.  Otherwise, Name is a ranking or
+// value function with arguments Args.  Frame is used only by aggregates and
+// by the first_value, last_value, and nth_value functions.
+type Func struct {
+	Name  string
+	Args  []expr.Evaluator
+	Agg   *expr.Aggregator
+	Frame *Frame
+
+	sctx *super.Context
+	// rangeKey is the order key used to compute RANGE frames with
+	// offsets, and rangeDir is 1 when it's ascending or -1 when it's
+	// descending.
+	rangeKey expr.Evaluator
+	rangeDir float64
+	// keys holds the numeric values of rangeKey for the current partition
+	// where rows [keysLo, keysHi) have numeric keys.
+	keys           []float64
+	keysLo, keysHi int
+}
+
+// Ranking and value functions and their numbers of arguments.
+var funcs = map[string][2]int{
+	"row_number":   {0, 0},
+	"rank":         {0, 0},
+	"dense_rank":   {0, 0},
+	"percent_rank": {0, 0},
+	"cume_dist":    {0, 0},
+	"ntile":        {1, 1},
+	"lag":          {1, 3},
+	"lead":         {1, 3},
+	"first_value":  {1, 1},
+	"last_value":   {1, 1},
+	"nth_value":    {2, 2},
+}
+
+// IsFunc returns true if name is a ranking or value window function.
+func IsFunc(name string) bool {
+	_, ok := funcs[name]
+	return ok
+}
+
+// CheckArgCount returns an error if the number of arguments n is invalid
+// for the window function name.
+func CheckArgCount(name string, n int) error {
+	minmax, ok := funcs[name]
+	if !ok {
+		return fmt.Errorf("unknown window function %q", name)
+	}
+	if n < minmax[0] || n > minmax[1] {
+		return fmt.Errorf("%s: wrong number of arguments", name)
+	}
+	return nil
+}
+
+func (f *Func) init(sctx *super.Context, orderBy []expr.SortExpr) error {
+	f.sctx = sctx
+	if f.Agg == nil {
+		if err := CheckArgCount(f.Name, len(f.Args)); err != nil {
+			return err
+		}
+	}
+	if f.Frame == nil {
+		return nil
+	}
+	if f.Frame.HasRangeOffset() {
+		if len(orderBy) != 1 {
+			return errors.New("window: RANGE with offset requires exactly one ORDER BY expression")
+		}
+		f.rangeKey = orderBy[0].Evaluator
+		f.rangeDir = 1
+		if orderBy[0].Order == order.Desc {
+			f.rangeDir = -1
+		}
+	}
+	return nil
+}
+
+// HasRangeOffset returns true if f is a RANGE frame with an offset bound,
+// which requires exactly one ORDER BY expression.
+func (f *Frame) HasRangeOffset() bool {
+	return f.Unit == Range && (hasOffset(f.Start) || hasOffset(f.End))
+}
+
+func hasOffset(b Bound) bool {
+	return b.Type == Preceding || b.Type == Following
+}
+
+// partition holds the rows of a partition along with the bounds of the
+// peer group of each row, i.e., the rows that are equal in window order.
+type partition struct {
+	rows      []super.Value
+	peerStart []int
+	peerEnd   []int
+}
+
+func newPartition(rows []super.Value, peers *expr.Comparator) *partition {
+	n := len(rows)
+	p := &partition{
+		rows:      rows,
+		peerStart: make([]int, n),
+		peerEnd:   make([]int, n),
+	}
+	// Without ORDER BY, all rows of the partition are peers.
+	for start := 0; start < n; {
+		end := n
+		if peers != nil {
+			end = start + 1
+			for end < n && peers.Compare(rows[start], rows[end]) == 0 {
+				end++
+			}
+		}
+		for i := start; i < end; i++ {
+			p.peerStart[i] = start
+			p.peerEnd[i] = end
+		}
+		start = end
+	}
+	return p
+}
+
+func (f *Func) compute(p *partition) []super.Value {
+	if f.rangeKey != nil {
+		f.loadKeys(p)
+	}
+	if f.Agg != nil {
+		return f.computeAgg(p)
+	}
+	n := len(p.rows)
+	out := make([]super.Value, n)
+	switch f.Name {
+	case "row_number":
+		for i := range out {
+			out[i] = super.NewInt64(int64(i + 1))
+		}
+	case "rank":
+		for i := range out {
+			out[i] = super.NewInt64(int64(p.peerStart[i] + 1))
+		}
+	case "dense_rank":
+		var rank int64
+		for i := range out {
+			if p.peerStart[i] == i {
+				rank++
+			}
+			out[i] = super.NewInt64(rank)
+		}
+	case "percent_rank":
+		for i := range out {
+			var v float64
+			if n > 1 {
+				v = float64(p.peerStart[i]) / float64(n-1)
+			}
+			out[i] = super.NewFloat64(v)
+		}
+	case "cume_dist":
+		for i := range out {
+			out[i] = super.NewFloat64(float64(p.peerEnd[i]) / float64(n))
+		}
+	case "ntile":
+		f.ntile(p, out)
+	case "lag", "lead":
+		dir := -1
+		if f.Name == "lead" {
+			dir = 1
+		}
+		f.shift(p, dir, out)
+	case "first_value", "last_value", "nth_value":
+		f.nth(p, out)
+	default:
+		panic(f.Name)
+	}
+	return out
+}
+
+func (f *Func) ntile(p *partition, out []super.Value) {
+	n := len(p.rows)
+	buckets, ok := f.positiveInt(p.rows[0], f.Args[0])
+	if !ok {
+		err := f.sctx.NewErrorf("ntile: argument must be a positive integer")
+		for i := range out {
+			out[i] = err
+		}
+		return
+	}
+	// The first n%buckets buckets have one more row than the others.
+	size, extra := n/int(buckets), n%int(buckets)
+	var i int
+	for b := range int(buckets) {
+		m := size
+		if b < extra {
+			m++
+		}
+		for range m {
+			out[i] = super.NewInt64(int64(b + 1))
+			i++
+		}
+	}
+}
+
+func (f *Func) shift(p *partition, dir int, out []super.Value) {
+	for i, row := range p.rows {
+		offset := int64(1)
+		if len(f.Args) > 1 {
+			var ok bool
+			if offset, ok = f.nonNegativeInt(row, f.Args[1]); !ok {
+				out[i] = f.sctx.NewErrorf("%s: offset must be a non-negative integer", f.Name)
+				continue
+			}
+		}
+		j := int64(i) + int64(dir)*offset
+		if j >= 0 && j < int64(len(p.rows)) {
+			out[i] = f.Args[0].Eval(p.rows[j]).Copy()
+		} else if len(f.Args) > 2 {
+			out[i] = f.Args[2].Eval(row).Copy()
+		} else {
+			out[i] = super.Null
+		}
+	}
+}
+
+func (f *Func) nth(p *partition, out []super.Value) {
+	for i, row := range p.rows {
+		lo, hi := f.frame(p, i)
+		var j int
+		switch f.Name {
+		case "first_value":
+			j = lo
+		case "last_value":
+			j = hi - 1
+		case "nth_value":
+			n, ok := f.positiveInt(row, f.Args[1])
+			if !ok {
+				out[i] = f.sctx.NewErrorf("nth_value: argument must be a positive integer")
+				continue
+			}
+			j = lo + int(n) - 1
+		}
+		if j >= lo && j < hi {
+			out[i] = f.Args[0].Eval(p.rows[j]).Copy()
+		} else {
+			out[i] = super.Null
+		}
+	}
+}
+
+func (f *Func) positiveInt(row super.Value, e expr.Evaluator) (int64, bool) {
+	n, ok := f.nonNegativeInt(row, e)
+	return n, ok && n > 0
+}
+
+func (f *Func) nonNegativeInt(row super.Value, e expr.Evaluator) (int64, bool) {
+	return NonNegativeInt(e.Eval(row))
+}
+
+// NonNegativeInt returns the value of val if it is a non-negative integer.
+func NonNegativeInt(val super.Value) (int64, bool) {
+	val = val.Under()
+	if !super.IsInteger(val.Type().ID()) || val.IsNull() {
+		return 0, false
+	}
+	n, ok := coerce.ToInt(val, super.TypeInt64)
+	return n, ok && n >= 0
+}
+
+func (f *Func) computeAgg(p *partition) []super.Value {
+	out := make([]super.Value, len(p.rows))
+	if f.Frame == nil || f.Frame.Start.Type == UnboundedPreceding {
+		// The frame of each row includes the frame of the previous row
+		// so the aggregate is computed incrementally.
+		fn := f.Agg.NewFunction()
+		var next int
+		for i := range p.rows {
+			_, hi := f.frame(p, i)
+			for ; next < hi; next++ {
+				f.Agg.Apply(f.sctx, fn, p.rows[next])
+			}
+			out[i] = fn.Result(f.sctx).Copy()
+		}
+		return out
+	}
+	prevLo, prevHi := -1, -1
+	for i := range p.rows {
+		lo, hi := f.frame(p, i)
+		if lo == prevLo && hi == prevHi {
+			out[i] = out[i-1]
+			continue
+		}
+		out[i] = f.aggregate(p.rows[lo:max(lo, hi)])
+		prevLo, prevHi = lo, hi
+	}
+	return out
+}
+
+func (f *Func) aggregate(rows []super.Value) super.Value {
+	fn := f.Agg.NewFunction()
+	for _, row := range rows {
+		f.Agg.Apply(f.sctx, fn, row)
+	}
+	return fn.Result(f.sctx).Copy()
+}
+
+// frame returns the bounds [lo, hi) of the frame of row i.  The frame is
+// empty when hi <= lo.
+func (f *Func) frame(p *partition, i int) (int, int) {
+	n := len(p.rows)
+	if f.Frame == nil {
+		return 0, n
+	}
+	lo := f.bound(p, i, f.Frame.Start, true)
+	hi := f.bound(p, i, f.Frame.End, false)
+	return min(max(lo, 0), n), min(max(hi, 0), n)
+}
+
+func (f *Func) bound(p *partition, i int, b Bound, start bool) int {
+	switch b.Type {
+	case UnboundedPreceding:
+		return 0
+	case UnboundedFollowing:
+		return len(p.rows)
+	case CurrentRow:
+		if f.Frame.Unit == Range {
+			if start {
+				return p.peerStart[i]
+			}
+			return p.peerEnd[i]
+		}
+		if start {
+			return i
+		}
+		return i + 1
+	}
+	if f.Frame.Unit == Range {
+		return f.rangeBound(p, i, b, start)
+	}
+	offset := int(coerce.ToNumeric[int64](b.Offset))
+	if b.Type == Preceding {
+		offset = -offset
+	}
+	if start {
+		return i + offset
+	}
+	return i + offset + 1
+}
+
+// loadKeys loads the numeric values of the order key of p's rows for
+// computing RANGE frames.  Nulls sort first or last so the rows with
+// numeric keys are assumed to be contiguous.
+func (f *Func) loadKeys(p *partition) {
+	f.keys = f.keys[:0]
+	f.keysLo, f.keysHi = len(p.rows), len(p.rows)
+	for i, row := range p.rows {
+		key, ok := f.rangeKeyOf(row)
+		if ok && f.keysLo == len(p.rows) {
+			f.keysLo = i
+		}
+		if ok {
+			f.keysHi = i + 1
+		}
+		f.keys = append(f.keys, key)
+	}
+}
+
+func (f *Func) rangeKeyOf(row super.Value) (float64, bool) {
+	val := f.rangeKey.Eval(row).Under()
+	id := val.Type().ID()
+	if val.IsNull() || !super.IsNumber(id) && !super.IsSigned(id) {
+		return 0, false
+	}
+	return coerce.ToFloat(val, super.TypeFloat64)
+}
+
+// rangeBound returns the bound of a RANGE frame with an offset, which
+// includes the rows whose order key is within the offset of the order key
+// of row i.  A row without a numeric order key has only its peers in its
+// frame and is not in the frame of any other row.
+func (f *Func) rangeBound(p *partition, i int, b Bound, start bool) int {
+	lo, hi := f.keysLo, f.keysHi
+	if i < lo || i >= hi {
+		if start {
+			return p.peerStart[i]
+		}
+		return p.peerEnd[i]
+	}
+	offset, _ := coerce.ToFloat(b.Offset, super.TypeFloat64)
+	if b.Type == Preceding {
+		offset = -offset
+	}
+	// The distance from the key of row i is nondecreasing in window order.
+	key := f.keys[i]
+	dist := func(j int) float64 {
+		return (f.keys[lo+j] - key) * f.rangeDir
+	}
+	if start {
+		return lo + sort.Search(hi-lo, func(j int) bool { return dist(j) >= offset })
+	}
+	return lo + sort.Search(hi-lo, func(j int) bool { return dist(j) > offset })
+}
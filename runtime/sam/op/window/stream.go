@@ -0,0 +1,379 @@
+package window
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/agg"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/scode"
+)
+
+// IsStreamFunc returns true if name is a window function that can be
+// computed in a single pass over its partition by a Streamer.
+func IsStreamFunc(name string) bool {
+	switch name {
+	case "row_number", "lag", "lead", "first_value":
+		return true
+	}
+	return false
+}
+
+// StreamFunc is a window function computed by a Streamer.  When Agg is
+// non-nil, the function is an aggregate over the values of the partition
+// through the current row.  Otherwise, Name is one of the functions
+// accepted by IsStreamFunc and Offset is the offset of lag or lead.
+type StreamFunc struct {
+	Name   string
+	Offset int
+	Agg    agg.Pattern
+}
+
+// MaxPartitions is the maximum number of partitions a Streamer holds at
+// once.  The partitions are held until Flush is called, which happens at
+// the end of the input or, when the input is sorted by the first partition
+// key, whenever that key changes.
+var MaxPartitions = 1024 * 1024
+
+// MaxPendingRows is the maximum number of rows whose results a Streamer
+// holds before they are returned.  Since results are returned in the order
+// the rows were added, a row awaiting lead holds the results of all of the
+// rows added after it, including those in other partitions.
+var MaxPendingRows = 1024 * 1024
+
+// Streamer computes window functions over partitions of a sequence of rows
+// in the order the rows are added.  The results for a row are available
+// once they are known, which for lead is when the row at its offset in the
+// partition is added or the partition is flushed.  Results are returned in
+// the order the rows were added.
+type Streamer struct {
+	sctx       *super.Context
+	funcs      []StreamFunc
+	partitions map[string]*streamPartition
+	pending    []*streamRow
+	sortKey    []byte
+}
+
+type streamRow struct {
+	results    []super.Value
+	unresolved int
+}
+
+type streamPartition struct {
+	states []streamState
+}
+
+// streamState holds the state of one function for one partition.
+type streamState struct {
+	// n is the number of rows in the partition.
+	n int
+	// ring holds the last Offset argument values for lag.
+	ring []super.Value
+	// leads holds the rows awaiting the value of lead.
+	leads []leadSlot
+	first *super.Value
+	agg   agg.Function
+}
+
+type leadSlot struct {
+	row *streamRow
+	def super.Value
+}
+
+func NewStreamer(sctx *super.Context, funcs []StreamFunc) *Streamer {
+	return &Streamer{
+		sctx:       sctx,
+		funcs:      funcs,
+		partitions: make(map[string]*streamPartition),
+	}
+}
+
+// AppendKey appends to key an encoding of val that is distinct for
+// distinct values so that a partition key may be formed from several
+// values.
+func AppendKey(key []byte, val super.Value) []byte {
+	key = binary.LittleEndian.AppendUint32(key, uint32(val.Type().ID()))
+	return scode.Append(key, val.Bytes())
+}
+
+// Add adds a row in the partition identified by key.  For each function,
+// args holds the value of its argument, which is ignored by row_number and
+// skipped by aggregates when missing, and defaults holds the value of
+// lag or lead when there is no row at its offset.  Add returns an error if
+// MaxPartitions or MaxPendingRows is exceeded.
+func (s *Streamer) Add(key []byte, args, defaults []super.Value) error {
+	p, ok := s.partitions[string(key)]
+	if !ok {
+		if len(s.partitions) >= MaxPartitions {
+			return fmt.Errorf("window: number of partitions exceeds %d (sort the input by the first partition key)", MaxPartitions)
+		}
+		p = &streamPartition{states: make([]streamState, len(s.funcs))}
+		s.partitions[string(key)] = p
+	}
+	if len(s.pending) >= MaxPendingRows {
+		return fmt.Errorf("window: number of rows awaiting lead exceeds %d (sort the input by the first partition key)", MaxPendingRows)
+	}
+	row := &streamRow{results: make([]super.Value, len(s.funcs))}
+	s.pending = append(s.pending, row)
+	for k, f := range s.funcs {
+		state := &p.states[k]
+		state.n++
+		if f.Agg != nil {
+			if state.agg == nil {
+				state.agg = f.Agg()
+			}
+			if arg := args[k].Deunion(); !arg.IsMissing() {
+				state.agg.Consume(arg)
+			}
+			row.results[k] = state.agg.Result(s.sctx).Copy()
+			continue
+		}
+		switch f.Name {
+		case "row_number":
+			row.results[k] = super.NewInt64(int64(state.n))
+		case "lag":
+			if f.Offset == 0 {
+				row.results[k] = args[k].Copy()
+				break
+			}
+			if len(state.ring) < f.Offset {
+				row.results[k] = defaults[k].Copy()
+			} else {
+				row.results[k] = state.ring[0]
+				state.ring = state.ring[1:]
+			}
+			state.ring = append(state.ring, args[k].Copy())
+		case "lead":
+			if f.Offset == 0 {
+				row.results[k] = args[k].Copy()
+				break
+			}
+			if len(state.leads) == f.Offset {
+				state.leads[0].row.resolve(k, args[k].Copy())
+				state.leads = state.leads[1:]
+			}
+			state.leads = append(state.leads, leadSlot{row, defaults[k].Copy()})
+			row.unresolved++
+		case "first_value":
+			if state.first == nil {
+				first := args[k].Copy()
+				state.first = &first
+			}
+			row.results[k] = *state.first
+		default:
+			panic(f.Name)
+		}
+	}
+	return nil
+}
+
+func (r *streamRow) resolve(k int, val super.Value) {
+	r.results[k] = val
+	r.unresolved--
+}
+
+// Next returns the results of the earliest row not yet returned or nil if
+// they are not known yet.
+func (s *Streamer) Next() []super.Value {
+	if len(s.pending) == 0 || s.pending[0].unresolved > 0 {
+		return nil
+	}
+	row := s.pending[0]
+	s.pending[0] = nil
+	s.pending = s.pending[1:]
+	return row.results
+}
+
+// Flush ends all partitions.  It resolves the rows awaiting lead with their
+// defaults so that the results of all added rows are returned by Next and
+// discards the partitions so that rows added later begin new ones.  Flush
+// is called at the end of the input and, when the input is sorted by the
+// first partition key, whenever that key changes.
+func (s *Streamer) Flush() {
+	for _, p := range s.partitions {
+		for k := range p.states {
+			for _, slot := range p.states[k].leads {
+				slot.row.resolve(k, slot.def)
+			}
+		}
+	}
+	clear(s.partitions)
+}
+
+// SetSortKey is called before Add when the input is sorted by the first
+// partition key with the encoding of that key for the next row.  When the
+// key changes, no more rows will be added to the current partitions, so
+// they are flushed.
+func (s *Streamer) SetSortKey(key []byte) {
+	if s.sortKey != nil && string(s.sortKey) != string(key) {
+		s.Flush()
+	}
+	s.sortKey = append(s.sortKey[:0], key...)
+}
+
+// Reset discards all state.
+func (s *Streamer) Reset() {
+	clear(s.partitions)
+	s.pending = nil
+	s.sortKey = nil
+}
+
+// StreamOp computes window functions over the partitions of its input
+// given by keys in input order and sets the field for each function in
+// each value to the function's result.  Unlike Op, it does not require
+// sorted input and preserves the order of its input.  When the input is
+// sorted by the first key, each partition ends when that key changes.
+type StreamOp struct {
+	rctx     *runtime.Context
+	parent   sbuf.Puller
+	keys     []expr.Evaluator
+	sorted   bool
+	args     []expr.Evaluator
+	defaults []expr.Evaluator
+	filters  []expr.Evaluator
+	streamer *Streamer
+	putter   expr.Evaluator
+
+	rows []super.Value
+	key  []byte
+	eos  bool
+
+	// results holds the results of the functions for the current row,
+	// which are read by the putter via streamRef.
+	results []super.Value
+}
+
+// StreamArg holds the evaluators of the argument of a function computed
+// by a StreamOp.  Default is the value of lag or lead when there is no
+// row at its offset, and Filter, when non-nil, selects the values
+// consumed by an aggregate.
+type StreamArg struct {
+	Arg     expr.Evaluator
+	Default expr.Evaluator
+	Filter  expr.Evaluator
+}
+
+// NewStreamOp returns an operator that computes funcs with arguments args
+// over the partitions of parent given by keys.  The result of funcs[k] is
+// stored in the field at paths[k].  If sorted is true, parent must be
+// sorted by keys[0].
+func NewStreamOp(rctx *runtime.Context, parent sbuf.Puller, keys []expr.Evaluator, sorted bool, paths []field.Path, funcs []StreamFunc, args []StreamArg) (*StreamOp, error) {
+	o := &StreamOp{
+		rctx:     rctx,
+		parent:   parent,
+		keys:     keys,
+		sorted:   sorted && len(keys) > 0,
+		streamer: NewStreamer(rctx.Sctx, funcs),
+	}
+	var refs []expr.Evaluator
+	for k, a := range args {
+		o.args = append(o.args, a.Arg)
+		o.defaults = append(o.defaults, a.Default)
+		o.filters = append(o.filters, a.Filter)
+		refs = append(refs, &streamRef{o, k})
+	}
+	rec, err := newRecordExpr(rctx.Sctx, nil, paths, refs)
+	if err != nil {
+		return nil, err
+	}
+	o.putter = expr.NewPutter(rctx.Sctx, rec)
+	return o, nil
+}
+
+func (o *StreamOp) Pull(done bool) (sbuf.Batch, error) {
+	if done {
+		o.reset()
+		return o.parent.Pull(true)
+	}
+	for !o.eos {
+		batch, err := o.parent.Pull(false)
+		if err != nil {
+			o.reset()
+			return nil, err
+		}
+		if batch == nil {
+			o.eos = true
+			o.streamer.Flush()
+		} else {
+			err := o.add(batch.Values())
+			batch.Unref()
+			if err != nil {
+				o.reset()
+				return nil, err
+			}
+		}
+		if out := o.next(); len(out) > 0 {
+			return sbuf.NewArray(out), nil
+		}
+	}
+	o.reset()
+	return nil, nil
+}
+
+func (o *StreamOp) add(vals []super.Value) error {
+	args := make([]super.Value, len(o.args))
+	defaults := make([]super.Value, len(o.args))
+	for _, val := range vals {
+		o.key = o.key[:0]
+		for k, e := range o.keys {
+			o.key = AppendKey(o.key, e.Eval(val))
+			if k == 0 && o.sorted {
+				o.streamer.SetSortKey(o.key)
+			}
+		}
+		for k := range o.args {
+			args[k] = super.True
+			if o.args[k] != nil {
+				args[k] = o.args[k].Eval(val)
+			}
+			if o.filters[k] != nil {
+				if b := expr.EvalBool(o.rctx.Sctx, val, o.filters[k]); !b.AsBool() {
+					args[k] = o.rctx.Sctx.Missing()
+				}
+			}
+			defaults[k] = super.Null
+			if o.defaults[k] != nil {
+				defaults[k] = o.defaults[k].Eval(val)
+			}
+		}
+		if err := o.streamer.Add(o.key, args, defaults); err != nil {
+			return err
+		}
+		o.rows = append(o.rows, val.Copy())
+	}
+	return nil
+}
+
+func (o *StreamOp) next() []super.Value {
+	var out []super.Value
+	for {
+		o.results = o.streamer.Next()
+		if o.results == nil {
+			return out
+		}
+		out = append(out, o.putter.Eval(o.rows[0]).Copy())
+		o.rows[0] = super.Value{}
+		o.rows = o.rows[1:]
+	}
+}
+
+func (o *StreamOp) reset() {
+	o.streamer.Reset()
+	o.rows = nil
+	o.eos = false
+}
+
+// streamRef is an evaluator for the result of a function for the current
+// row of a StreamOp.
+type streamRef struct {
+	op *StreamOp
+	k  int
+}
+
+func (s *streamRef) Eval(super.Value) super.Value {
+	return s.op.results[s.k]
+}
@@ -0,0 +1,51 @@
+package window_test
+
+import (
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime/sam/op/window"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamerSortKeyEndsPartitions(t *testing.T) {
+	s := window.NewStreamer(super.NewContext(), []window.StreamFunc{{Name: "lead", Offset: 1}})
+	add := func(partition, x int64) {
+		key := window.AppendKey(nil, super.NewInt64(partition))
+		s.SetSortKey(key)
+		require.NoError(t, s.Add(key, []super.Value{super.NewInt64(x)}, []super.Value{super.Null}))
+	}
+	add(1, 10)
+	add(1, 11)
+	require.Equal(t, []super.Value{super.NewInt64(11)}, s.Next())
+	require.Nil(t, s.Next())
+	// A new value of the sort key ends the partition, so the last row of
+	// partition 1 is known before the input ends.
+	add(2, 20)
+	require.Equal(t, []super.Value{super.Null}, s.Next())
+	require.Nil(t, s.Next())
+}
+
+func TestStreamerLimits(t *testing.T) {
+	savedPartitions, savedRows := window.MaxPartitions, window.MaxPendingRows
+	window.MaxPartitions, window.MaxPendingRows = 2, 3
+	defer func() {
+		window.MaxPartitions, window.MaxPendingRows = savedPartitions, savedRows
+	}()
+	args := []super.Value{super.NewInt64(1)}
+	defaults := []super.Value{super.Null}
+	key := func(partition int64) []byte {
+		return window.AppendKey(nil, super.NewInt64(partition))
+	}
+
+	s := window.NewStreamer(super.NewContext(), []window.StreamFunc{{Name: "row_number"}})
+	require.NoError(t, s.Add(key(1), args, defaults))
+	require.NoError(t, s.Add(key(2), args, defaults))
+	require.ErrorContains(t, s.Add(key(3), args, defaults), "number of partitions exceeds 2")
+
+	s = window.NewStreamer(super.NewContext(), []window.StreamFunc{{Name: "lead", Offset: 4}})
+	for range 3 {
+		require.NoError(t, s.Add(key(1), args, defaults))
+	}
+	require.ErrorContains(t, s.Add(key(1), args, defaults), "number of rows awaiting lead exceeds 3")
+}
@@ -0,0 +1,224 @@
+// Package window implements the window operator, which computes window
+// functions over partitions of its input.
+package window
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/sbuf"
+)
+
+// batchLen is the minimum number of values in an output batch unless the
+// input is exhausted.
+const batchLen = 1024
+
+// Op computes window functions over partitions of its input and sets the
+// field for each function in each value to the function's result.  The
+// input must be sorted by the partition keys and then by the order keys
+// so that each partition is read in sequence and its values are in window
+// order.
+type Op struct {
+	rctx      *runtime.Context
+	parent    sbuf.Puller
+	partition *expr.Comparator
+	peers     *expr.Comparator
+	funcs     []*Func
+	putter    expr.Evaluator
+
+	batch   sbuf.Batch
+	pending []super.Value
+	rows    []super.Value
+	eos     bool
+
+	// results holds the results of each function for the current
+	// partition, which are read by the putter via resultRef.
+	results [][]super.Value
+	row     int
+}
+
+// New returns a window operator that computes funcs over the partitions
+// of parent defined by the partitionBy expressions where the rows of each
+// partition are ordered by orderBy.  The result of funcs[k] is stored in
+// the field at paths[k].
+func New(rctx *runtime.Context, parent sbuf.Puller, partitionBy []expr.Evaluator, orderBy []expr.SortExpr, paths []field.Path, funcs []*Func) (*Op, error) {
+	o := &Op{
+		rctx:    rctx,
+		parent:  parent,
+		funcs:   funcs,
+		results: make([][]super.Value, len(funcs)),
+	}
+	if len(partitionBy) > 0 {
+		var exprs []expr.SortExpr
+		for _, e := range partitionBy {
+			exprs = append(exprs, expr.SortExpr{Evaluator: e})
+		}
+		o.partition = expr.NewComparator(exprs...).WithMissingAsNull()
+	}
+	if len(orderBy) > 0 {
+		o.peers = expr.NewComparator(orderBy...).WithMissingAsNull()
+	}
+	var refs []expr.Evaluator
+	for k, f := range funcs {
+		if err := f.init(rctx.Sctx, orderBy); err != nil {
+			return nil, err
+		}
+		refs = append(refs, &resultRef{o, k})
+	}
+	rec, err := newRecordExpr(rctx.Sctx, nil, paths, refs)
+	if err != nil {
+		return nil, err
+	}
+	o.putter = expr.NewPutter(rctx.Sctx, rec)
+	return o, nil
+}
+
+func (o *Op) Pull(done bool) (sbuf.Batch, error) {
+	if done {
+		o.reset()
+		return o.parent.Pull(true)
+	}
+	var out []super.Value
+	for len(out) < batchLen {
+		rows, err := o.nextPartition()
+		if err != nil {
+			o.reset()
+			return nil, err
+		}
+		if rows == nil {
+			break
+		}
+		out = o.appendPartition(out, rows)
+	}
+	if len(out) == 0 {
+		o.eos = false
+		return nil, nil
+	}
+	return sbuf.NewArray(out), nil
+}
+
+// nextPartition returns the values of the next partition or nil once the
+// input is exhausted.
+func (o *Op) nextPartition() ([]super.Value, error) {
+	for {
+		for len(o.pending) > 0 {
+			val := o.pending[0]
+			if len(o.rows) > 0 && o.partition != nil && o.partition.Compare(o.rows[0], val) != 0 {
+				rows := o.rows
+				o.rows = nil
+				return rows, nil
+			}
+			o.rows = append(o.rows, val.Copy())
+			o.pending = o.pending[1:]
+		}
+		if o.batch != nil {
+			o.batch.Unref()
+			o.batch = nil
+		}
+		if o.eos {
+			return nil, nil
+		}
+		batch, err := o.parent.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			o.eos = true
+			rows := o.rows
+			o.rows = nil
+			return rows, nil
+		}
+		o.batch = batch
+		o.pending = batch.Values()
+	}
+}
+
+func (o *Op) appendPartition(out []super.Value, rows []super.Value) []super.Value {
+	p := newPartition(rows, o.peers)
+	for k, f := range o.funcs {
+		o.results[k] = f.compute(p)
+	}
+	for o.row = range rows {
+		out = append(out, o.putter.Eval(rows[o.row]).Copy())
+	}
+	return out
+}
+
+func (o *Op) reset() {
+	if o.batch != nil {
+		o.batch.Unref()
+		o.batch = nil
+	}
+	o.pending = nil
+	o.rows = nil
+	o.eos = false
+}
+
+// resultRef is an evaluator for the result of a function for the current
+// row.
+type resultRef struct {
+	op *Op
+	k  int
+}
+
+func (r *resultRef) Eval(super.Value) super.Value {
+	return r.op.results[r.k][r.op.row]
+}
+
+// newRecordExpr returns an evaluator that spreads the record at prefix and
+// sets the fields at paths relative to prefix to the values of exprs.
+func newRecordExpr(sctx *super.Context, prefix field.Path, paths []field.Path, exprs []expr.Evaluator) (expr.Evaluator, error) {
+	return RecordExpr(prefix, paths, exprs, func(prefix field.Path, names []string, fields []expr.Evaluator) (expr.Evaluator, error) {
+		elems := []expr.RecordElem{{Spread: expr.NewDottedExpr(sctx, prefix)}}
+		for k, name := range names {
+			elems = append(elems, expr.RecordElem{Name: name, Field: fields[k]})
+		}
+		return expr.NewRecordExpr(sctx, elems)
+	})
+}
+
+// RecordExpr returns an expression that spreads the record at prefix and
+// sets the fields at paths relative to prefix to the values of exprs.
+// Paths sharing a leading field are grouped into a nested record
+// expression.  The record function returns the expression for a record
+// that spreads the record at its prefix and sets the named fields, so
+// that the runtimes share this grouping while building their own
+// evaluators.
+func RecordExpr[E any](prefix field.Path, paths []field.Path, exprs []E, record func(prefix field.Path, names []string, fields []E) (E, error)) (E, error) {
+	var names []string
+	children := make(map[string][]int)
+	for k, path := range paths {
+		if _, ok := children[path[0]]; !ok {
+			names = append(names, path[0])
+		}
+		children[path[0]] = append(children[path[0]], k)
+	}
+	var fields []E
+	for _, name := range names {
+		index := children[name]
+		if len(index) == 1 && len(paths[index[0]]) == 1 {
+			fields = append(fields, exprs[index[0]])
+			continue
+		}
+		var childPaths []field.Path
+		var childExprs []E
+		for _, k := range index {
+			if len(paths[k]) == 1 {
+				var zero E
+				return zero, fmt.Errorf("window: conflicting assignments to field %q", slices.Concat(prefix, paths[k]))
+			}
+			childPaths = append(childPaths, paths[k][1:])
+			childExprs = append(childExprs, exprs[k])
+		}
+		e, err := RecordExpr(slices.Concat(prefix, field.Path{name}), childPaths, childExprs, record)
+		if err != nil {
+			return e, err
+		}
+		fields = append(fields, e)
+	}
+	return record(prefix, names, fields)
+}
@@ -1,6 +1,8 @@
 package agg
 
 import (
+	"slices"
+
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/anymath"
 	"github.com/brimdata/super/vector"
@@ -98,7 +100,7 @@ func minNumeric[T numeric](state T, vec vector.Any) T {
 	case *vector.Const:
 		return min(state, constToNumeric[T](vec))
 	case *vector.Dict:
-		return minFlat(state, vec.Any, nil)
+		return minFlat(state, vec.Any, dictIndex(vec))
 	case *vector.View:
 		return minFlat(state, vec.Any, vec.Index)
 	default:
@@ -137,7 +139,7 @@ func maxNumeric[T numeric](state T, vec vector.Any) T {
 	case *vector.Const:
 		return max(state, constToNumeric[T](vec))
 	case *vector.Dict:
-		return maxFlat(state, vec.Any, nil)
+		return maxFlat(state, vec.Any, dictIndex(vec))
 	case *vector.View:
 		return maxFlat(state, vec.Any, vec.Index)
 	default:
@@ -171,6 +173,22 @@ func maxOf[T numeric, E numeric](state T, vals []E, index []uint32) T {
 	return state
 }
 
+// dictIndex returns the index of the entries of d that occur in d, which
+// may not include all entries if d was picked from another dictionary, or
+// nil if they all occur.
+func dictIndex(d *vector.Dict) []uint32 {
+	if !slices.Contains(d.Counts, 0) {
+		return nil
+	}
+	index := make([]uint32, 0, len(d.Counts))
+	for k, count := range d.Counts {
+		if count > 0 {
+			index = append(index, uint32(k))
+		}
+	}
+	return index
+}
+
 func constToNumeric[T numeric](vec *vector.Const) T {
 	val := vec.Value()
 	switch id := vec.Type().ID(); {
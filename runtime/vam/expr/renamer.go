@@ -23,13 +23,12 @@ func (r *Renamer) Eval(vec vector.Any) vector.Any {
 
 func (r *Renamer) eval(vecs ...vector.Any) vector.Any {
 	vec := vecs[0]
-	recVec, ok := vector.Under(vec).(*vector.Record)
-	if !ok {
+	if _, ok := vector.Under(vec).Type().(*super.TypeRecord); !ok {
 		return vec
 	}
 	val, err := r.renamer.EvalToValAndError(super.NewValue(vec.Type(), nil))
 	if err != nil {
 		return vector.NewWrappedError(r.sctx, err.Error(), vec)
 	}
-	return recVec.ChangeType(val.Type().(*super.TypeRecord))
+	return vector.ChangeRecordType(vec, val.Type().(*super.TypeRecord))
 }
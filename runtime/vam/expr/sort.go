@@ -0,0 +1,19 @@
+package expr
+
+import "github.com/brimdata/super/order"
+
+type SortExpr struct {
+	Evaluator
+	Order order.Which
+	Nulls order.Nulls
+}
+
+func NewSortExpr(eval Evaluator, o order.Which, n order.Nulls) SortExpr {
+	return SortExpr{eval, o, n}
+}
+
+// NullsMax reports whether s treats null as the maximum value.
+func (s *SortExpr) NullsMax() bool {
+	return s.Order == order.Asc && s.Nulls == order.NullsLast ||
+		s.Order == order.Desc && s.Nulls == order.NullsFirst
+}
@@ -27,7 +27,38 @@ func (m *Materializer) Pull(done bool) (sbuf.Batch, error) {
 	if vec == nil || err != nil {
 		return nil, err
 	}
-	return Materialize(vec), nil
+	return &Batch{vec: vec}, nil
+}
+
+// Batch is an sbuf.Batch whose values are materialized from a vector when
+// first requested so consumers that handle vectors can use the vector
+// directly.
+type Batch struct {
+	vec  vector.Any
+	once sync.Once
+	vals []super.Value
+}
+
+var _ sbuf.Batch = (*Batch)(nil)
+
+func (*Batch) Ref()   {}
+func (*Batch) Unref() {}
+
+func (b *Batch) Values() []super.Value {
+	b.once.Do(func() {
+		b.vals = Materialize(b.vec).Values()
+	})
+	return b.vals
+}
+
+// Len returns the number of values in the batch without materializing them.
+func (b *Batch) Len() int {
+	return int(b.vec.Len())
+}
+
+// Vector returns the vector of the batch.
+func (b *Batch) Vector() vector.Any {
+	return b.vec
 }
 
 func Materialize(vec vector.Any) sbuf.Batch {
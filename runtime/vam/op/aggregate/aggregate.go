@@ -3,17 +3,27 @@ package aggregate
 import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/spill"
 	"github.com/brimdata/super/runtime/vam/expr"
 	"github.com/brimdata/super/vector"
 )
 
+// MemMaxBytes specifies the approximate maximum amount of memory that each
+// aggregate uses for its group tables.  When this is exceeded, the partial
+// results of the groups are spilled to disk and merged at end of stream.
+var MemMaxBytes = 128 * 1024 * 1024
+
 type Aggregate struct {
+	rctx   *runtime.Context
 	parent vector.Puller
 	sctx   *super.Context
 	// XX Abstract this runtime into a generic table computation.
 	// Then the generic interface can execute fast paths for simple scenarios.
 	aggs        []*expr.Aggregator
 	aggExprs    []expr.Evaluator
+	keyNames    []field.Path
 	keyExprs    []expr.Evaluator
 	typeTable   *super.TypeVectorTable
 	builder     *vector.RecordBuilder
@@ -23,18 +33,27 @@ type Aggregate struct {
 	types   []super.Type
 	tables  map[int]aggTable
 	results []aggTable
+
+	spiller    *spill.MergeSort
+	stop       func() bool
+	comparator *samexpr.Comparator
+	merger     *Aggregate
+	spill.Stats
 }
 
-func New(parent vector.Puller, sctx *super.Context, aggNames []field.Path, aggExprs []expr.Evaluator, aggs []*expr.Aggregator, keyNames []field.Path, keyExprs []expr.Evaluator, partialsIn, partialsOut bool) (*Aggregate, error) {
+func New(rctx *runtime.Context, parent vector.Puller, aggNames []field.Path, aggExprs []expr.Evaluator, aggs []*expr.Aggregator, keyNames []field.Path, keyExprs []expr.Evaluator, partialsIn, partialsOut bool) (*Aggregate, error) {
+	sctx := rctx.Sctx
 	builder, err := vector.NewRecordBuilder(sctx, append(keyNames, aggNames...))
 	if err != nil {
 		return nil, err
 	}
 	return &Aggregate{
+		rctx:        rctx,
 		parent:      parent,
 		sctx:        sctx,
 		aggs:        aggs,
 		aggExprs:    aggExprs,
+		keyNames:    keyNames,
 		keyExprs:    keyExprs,
 		tables:      make(map[int]aggTable),
 		typeTable:   super.NewTypeVectorTable(),
@@ -47,47 +66,86 @@ func New(parent vector.Puller, sctx *super.Context, aggNames []field.Path, aggEx
 
 func (a *Aggregate) Pull(done bool) (vector.Any, error) {
 	if done {
+		a.reset()
 		_, err := a.parent.Pull(done)
 		return nil, err
 	}
 	if a.results != nil {
-		return a.next(), nil
+		return a.nextResult()
 	}
 	for {
 		//XXX check context Done
 		vec, err := a.parent.Pull(false)
 		if err != nil {
+			a.reset()
 			return nil, err
 		}
 		if vec == nil {
-			for _, t := range a.tables {
-				a.results = append(a.results, t)
+			if a.spiller != nil {
+				// Spill what remains so all results come from the merge.
+				if err := a.spill(); err != nil {
+					a.reset()
+					return nil, err
+				}
+				a.results = []aggTable{}
+				return a.nextResult()
 			}
-			clear(a.tables)
+			a.results = a.flush()
 			return a.next(), nil
 		}
-		var keys, vals []vector.Any
-		for _, e := range a.keyExprs {
-			keys = append(keys, e.Eval(vec))
-		}
-		if a.partialsIn {
-			for _, e := range a.aggExprs {
-				vals = append(vals, e.Eval(vec))
-			}
-		} else {
-			for _, e := range a.aggs {
-				vals = append(vals, e.Eval(vec))
+		a.update(vec)
+		size := a.size()
+		a.UpdateMem(size)
+		if size >= MemMaxBytes {
+			if err := a.spill(); err != nil {
+				a.reset()
+				return nil, err
 			}
 		}
-		vector.Apply(true, func(args ...vector.Any) vector.Any {
-			a.consume(args[:len(keys)], args[len(keys):])
-			// XXX Perhaps there should be a "consume" version of Apply where
-			// no return value is expected.
-			return vector.NewConst(super.Null, args[0].Len())
-		}, append(keys, vals...)...)
 	}
 }
 
+func (a *Aggregate) update(vec vector.Any) {
+	var keys, vals []vector.Any
+	for _, e := range a.keyExprs {
+		keys = append(keys, e.Eval(vec))
+	}
+	if a.partialsIn {
+		for _, e := range a.aggExprs {
+			vals = append(vals, e.Eval(vec))
+		}
+	} else {
+		for _, e := range a.aggs {
+			vals = append(vals, e.Eval(vec))
+		}
+	}
+	vector.Apply(true, func(args ...vector.Any) vector.Any {
+		a.consume(args[:len(keys)], args[len(keys):])
+		// XXX Perhaps there should be a "consume" version of Apply where
+		// no return value is expected.
+		return vector.NewConst(super.Null, args[0].Len())
+	}, append(keys, vals...)...)
+}
+
+// flush removes and returns the tables.
+func (a *Aggregate) flush() []aggTable {
+	tables := make([]aggTable, 0, len(a.tables))
+	for _, t := range a.tables {
+		tables = append(tables, t)
+	}
+	clear(a.tables)
+	return tables
+}
+
+// size returns the approximate memory footprint of the tables.
+func (a *Aggregate) size() int {
+	var n int
+	for _, t := range a.tables {
+		n += t.size()
+	}
+	return n
+}
+
 func (a *Aggregate) consume(keys []vector.Any, vals []vector.Any) {
 	var keyTypes []super.Type
 	for _, k := range keys {
@@ -109,12 +167,11 @@ func (a *Aggregate) newAggTable(keyTypes []super.Type) aggTable {
 		return newCountByString(a.builder, a.partialsIn)
 	}
 	return &superTable{
-		aggs:        a.aggs,
-		builder:     a.builder,
-		partialsIn:  a.partialsIn,
-		partialsOut: a.partialsOut,
-		table:       make(map[string]int),
-		sctx:        a.sctx,
+		aggs:       a.aggs,
+		builder:    a.builder,
+		partialsIn: a.partialsIn,
+		table:      make(map[string]int),
+		sctx:       a.sctx,
 	}
 }
 
@@ -130,5 +187,5 @@ func (a *Aggregate) next() vector.Any {
 	}
 	t := a.results[0]
 	a.results = a.results[1:]
-	return t.materialize()
+	return t.materialize(a.partialsOut)
 }
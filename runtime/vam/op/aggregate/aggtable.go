@@ -13,20 +13,26 @@ import (
 // XXX use super.Value for slow path stuff, e.g., when the grouping key is
 // a complex type.  when we improve the super.Value impl this will get better.
 
+// rowOverhead approximates the memory used by a table row in addition to
+// its key.
+const rowOverhead = 64
+
 // one aggTable per fixed set of types of aggs and keys.
 type aggTable interface {
 	update([]vector.Any, []vector.Any)
-	materialize() vector.Any
+	materialize(partialsOut bool) vector.Any
+	// size returns the approximate memory footprint of the table.
+	size() int
 }
 
 type superTable struct {
-	aggs        []*expr.Aggregator
-	builder     *vector.RecordBuilder
-	partialsIn  bool
-	partialsOut bool
-	table       map[string]int
-	rows        []aggRow
-	sctx        *super.Context
+	aggs       []*expr.Aggregator
+	builder    *vector.RecordBuilder
+	partialsIn bool
+	table      map[string]int
+	rows       []aggRow
+	nbytes     int
+	sctx       *super.Context
 }
 
 var _ aggTable = (*superTable)(nil)
@@ -57,9 +63,19 @@ func (s *superTable) update(keys []vector.Any, args []vector.Any) {
 			id = len(s.rows)
 			s.table[rowKey] = id
 			s.rows = append(s.rows, s.newRow(keys, index))
+			// The key is held both in the table and in the row.
+			s.nbytes += 2*len(rowKey) + rowOverhead*(1+len(s.aggs))
 		}
 		row := s.rows[id]
 		for i, arg := range args {
+			if s.partialsIn && len(index) > 1 {
+				// Partials are consumed one at a time.  A group can
+				// have multiple partials, e.g., when merging spills.
+				for _, slot := range index {
+					row.funcs[i].ConsumeAsPartial(vector.Pick(arg, []uint32{slot}))
+				}
+				continue
+			}
 			if len(m) > 1 {
 				arg = vector.Pick(arg, index)
 			}
@@ -86,7 +102,11 @@ func (s *superTable) newRow(keys []vector.Any, index []uint32) aggRow {
 	return row
 }
 
-func (s *superTable) materialize() vector.Any {
+func (s *superTable) size() int {
+	return s.nbytes
+}
+
+func (s *superTable) materialize(partialsOut bool) vector.Any {
 	if len(s.rows) == 0 {
 		return vector.NewConst(super.Null, 0)
 	}
@@ -95,7 +115,7 @@ func (s *superTable) materialize() vector.Any {
 		vecs = append(vecs, s.materializeKey(i))
 	}
 	for i := range s.rows[0].funcs {
-		vecs = append(vecs, s.materializeAgg(i))
+		vecs = append(vecs, s.materializeAgg(i, partialsOut))
 	}
 	// Since aggs can return dynamic values need to do apply to create record.
 	return vector.Apply(false, func(vecs ...vector.Any) vector.Any {
@@ -111,10 +131,10 @@ func (s *superTable) materializeKey(i int) vector.Any {
 	return b.Build()
 }
 
-func (s *superTable) materializeAgg(i int) vector.Any {
+func (s *superTable) materializeAgg(i int, partialsOut bool) vector.Any {
 	b := vector.NewDynamicBuilder()
 	for _, row := range s.rows {
-		if s.partialsOut {
+		if partialsOut {
 			b.Write(row.funcs[i].ResultAsPartial(s.sctx))
 		} else {
 			b.Write(row.funcs[i].Result(s.sctx))
@@ -124,8 +144,12 @@ func (s *superTable) materializeAgg(i int) vector.Any {
 }
 
 type countByString struct {
-	nulls      int64
-	table      map[string]int64
+	nulls int64
+	table map[string]int64
+	// keyBytes and nkeys track the average length of the keys seen,
+	// which is used to estimate the size of the table.
+	keyBytes   int
+	nkeys      int
 	builder    *vector.RecordBuilder
 	partialsIn bool
 }
@@ -158,18 +182,24 @@ func (c *countByString) update(keys, vals []vector.Any) {
 }
 
 func (c *countByString) updatePartial(keyvec, valvec vector.Any) {
-	key, ok1 := keyvec.(*vector.String)
-	val, ok2 := valvec.(*vector.Int)
-	if !ok1 || !ok2 {
+	if keyvec.Kind() != vector.KindString || valvec.Kind() != vector.KindInt {
 		panic("count by string: invalid partials in")
 	}
-	for i := range key.Len() {
-		c.table[key.Value(i)] += val.Values[i]
+	for i := range keyvec.Len() {
+		key := vector.StringValue(keyvec, i)
+		c.sample(len(key), 1)
+		c.table[key] += vector.IntValue(valvec, i)
 	}
 }
 
+func (c *countByString) sample(keyBytes, nkeys int) {
+	c.keyBytes += keyBytes
+	c.nkeys += nkeys
+}
+
 func (c *countByString) count(vec *vector.String) {
 	offs, bytes := vec.Table().Slices()
+	c.sample(len(bytes), int(vec.Len()))
 	for k := range vec.Len() {
 		c.table[string(bytes[offs[k]:offs[k+1]])]++
 	}
@@ -177,6 +207,7 @@ func (c *countByString) count(vec *vector.String) {
 
 func (c *countByString) countDict(vec *vector.String, counts []uint32) {
 	offs, bytes := vec.Table().Slices()
+	c.sample(len(bytes), int(vec.Len()))
 	for k := range vec.Len() {
 		if counts[k] > 0 {
 			c.table[string(bytes[offs[k]:offs[k+1]])] += int64(counts[k])
@@ -196,12 +227,22 @@ func (c *countByString) countFixed(vec *vector.Const) {
 
 func (c *countByString) countView(vec *vector.View) {
 	strVec := vec.Any.(*vector.String)
+	_, bytes := strVec.Table().Slices()
+	c.sample(len(bytes), int(strVec.Len()))
 	for _, slot := range vec.Index {
 		c.table[strVec.Value(slot)]++
 	}
 }
 
-func (c *countByString) materialize() vector.Any {
+func (c *countByString) size() int {
+	var avg int
+	if c.nkeys > 0 {
+		avg = c.keyBytes / c.nkeys
+	}
+	return len(c.table) * (avg + rowOverhead)
+}
+
+func (c *countByString) materialize(bool) vector.Any {
 	length := len(c.table)
 	counts := make([]int64, length)
 	var bytes []byte
@@ -0,0 +1,137 @@
+package aggregate
+
+import (
+	"context"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/brimdata/super/runtime/vam"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/vector"
+)
+
+// mergeLen is the minimum number of spilled values that are merged at a
+// time.
+const mergeLen = 2048
+
+// spill writes the partial results of the tables to a sorted run on disk
+// and clears the tables.
+func (a *Aggregate) spill() error {
+	if a.spiller == nil {
+		if err := a.newSpiller(); err != nil {
+			return err
+		}
+	}
+	var vals []super.Value
+	for _, t := range a.flush() {
+		vals = append(vals, vam.Materialize(t.materialize(true)).Values()...)
+	}
+	if len(vals) == 0 {
+		return nil
+	}
+	// Note that this will sort vals by the grouping keys.
+	return a.spiller.Spill(a.rctx, vals)
+}
+
+func (a *Aggregate) newSpiller() error {
+	var sortExprs []samexpr.SortExpr
+	var keyRefs []expr.Evaluator
+	for _, name := range a.keyNames {
+		e := samexpr.NewDottedExpr(a.sctx, name)
+		sortExprs = append(sortExprs, samexpr.NewSortExpr(e, order.Asc, order.NullsLast))
+		keyRefs = append(keyRefs, expr.NewDottedExpr(a.sctx, name))
+	}
+	comparator := samexpr.NewComparator(sortExprs...).WithMissingAsNull()
+	spiller, err := spill.NewMergeSort(comparator, &a.Stats)
+	if err != nil {
+		return err
+	}
+	a.spiller = spiller
+	// Remove spill files if the query is canceled before we finish.
+	a.rctx.WaitGroup.Add(1)
+	a.stop = context.AfterFunc(a.rctx, func() {
+		spiller.Cleanup()
+		a.rctx.WaitGroup.Done()
+	})
+	// The merger combines the spilled partial results of each group.
+	a.merger = &Aggregate{
+		sctx:        a.sctx,
+		aggs:        a.aggs,
+		aggExprs:    a.aggExprs,
+		keyExprs:    keyRefs,
+		tables:      make(map[int]aggTable),
+		typeTable:   super.NewTypeVectorTable(),
+		builder:     a.builder,
+		partialsIn:  true,
+		partialsOut: a.partialsOut,
+	}
+	a.comparator = comparator
+	return nil
+}
+
+// nextResult returns the next result vector, merging spilled results as
+// needed.
+func (a *Aggregate) nextResult() (vector.Any, error) {
+	for a.spiller != nil && len(a.results) == 0 {
+		ok, err := a.mergeSpills()
+		if err != nil {
+			a.reset()
+			return nil, err
+		}
+		if !ok {
+			a.reset()
+		}
+	}
+	return a.next(), nil
+}
+
+// mergeSpills reads the next values from the spiller and merges them into
+// a.results.  Since the spilled values are read in key order, all partial
+// results for a group are read together.  It returns false when the spilled
+// values have been exhausted.
+func (a *Aggregate) mergeSpills() (bool, error) {
+	var vals []super.Value
+	for {
+		val, err := a.spiller.Peek()
+		if err != nil {
+			return false, err
+		}
+		if val == nil {
+			break
+		}
+		if len(vals) >= mergeLen && a.comparator.Compare(vals[len(vals)-1], *val) != 0 {
+			break
+		}
+		vals = append(vals, val.Copy())
+		if _, err := a.spiller.Read(); err != nil {
+			return false, err
+		}
+	}
+	if len(vals) == 0 {
+		return false, nil
+	}
+	b := vector.NewDynamicBuilder()
+	for _, val := range vals {
+		b.Write(val)
+	}
+	a.merger.update(b.Build())
+	a.results = a.merger.flush()
+	return true, nil
+}
+
+func (a *Aggregate) reset() {
+	if a.spiller != nil {
+		if a.stop() {
+			a.spiller.Cleanup()
+			a.rctx.WaitGroup.Done()
+		}
+		a.spiller = nil
+		a.stop = nil
+		a.merger = nil
+		a.comparator = nil
+	}
+	clear(a.tables)
+	a.results = nil
+}
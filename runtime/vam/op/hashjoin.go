@@ -7,12 +7,17 @@ import (
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/op/spill"
 	"github.com/brimdata/super/runtime/vam/expr"
 	"github.com/brimdata/super/scode"
 	"github.com/brimdata/super/vector"
 	"golang.org/x/sync/errgroup"
 )
 
+// HashJoinMemMaxBytes specifies the maximum amount of memory that each hash
+// join will use for its table before partitioning its inputs to disk.
+var HashJoinMemMaxBytes = 128 * 1024 * 1024
+
 type HashJoin struct {
 	rctx       *runtime.Context
 	style      string
@@ -23,14 +28,26 @@ type HashJoin struct {
 	leftAlias  string
 	rightAlias string
 
-	hashJoin *hashJoin
+	// build is "left" or "right" to build the table from that input or
+	// empty to build it from whichever input reaches EOS first.
+	build     string
+	hashJoin  *hashJoin
+	spiller   *joinSpiller
+	buildLeft bool
+	spill.Stats
 }
 
 func NewHashJoin(rctx *runtime.Context, style string, left, right vector.Puller,
-	leftKey, rightKey expr.Evaluator, leftAlias, rightAlias string) *HashJoin {
+	leftKey, rightKey expr.Evaluator, leftAlias, rightAlias, build string) *HashJoin {
 	if style == "right" {
 		leftKey, rightKey = rightKey, leftKey
 		left, right = right, left
+		switch build {
+		case "left":
+			build = "right"
+		case "right":
+			build = "left"
+		}
 	}
 	if style == "cross" {
 		panic("cross join not compatible with hash join")
@@ -44,6 +61,7 @@ func NewHashJoin(rctx *runtime.Context, style string, left, right vector.Puller,
 		rightKey:   rightKey,
 		leftAlias:  leftAlias,
 		rightAlias: rightAlias,
+		build:      build,
 	}
 }
 
@@ -53,59 +71,136 @@ func (h *HashJoin) Pull(done bool) (vector.Any, error) {
 		if err == nil {
 			_, err = h.right.Pull(true)
 		}
-		h.hashJoin = nil
+		h.reset()
 		return nil, err
 	}
 	if h.hashJoin == nil {
 		if err := h.tableInit(); err != nil {
+			h.reset()
 			return nil, err
 		}
 	}
-	vec, err := h.hashJoin.Pull()
-	if vec == nil || err != nil {
-		h.hashJoin = nil
+	for {
+		vec, err := h.hashJoin.Pull()
+		if vec != nil && err == nil {
+			return vec, nil
+		}
+		if err == nil && h.spiller != nil {
+			// Move on to the next spilled partition.
+			h.hashJoin, err = h.spiller.next(h.newHashJoin)
+			if h.hashJoin != nil && err == nil {
+				continue
+			}
+		}
+		h.reset()
+		return nil, err
+	}
+}
+
+func (h *HashJoin) reset() {
+	h.hashJoin = nil
+	if h.spiller != nil {
+		h.spiller.cleanup()
+		h.spiller = nil
 	}
-	return vec, err
 }
 
 func (h *HashJoin) tableInit() error {
-	// Read from both leftBuf and rightBuf parent and find the shortest parent to
-	// create the table from.
-	leftBuf, rightBuf, err := pullRace(h.rctx.Context, h.left, h.right)
+	var leftBuf, rightBuf *bufPuller
+	if h.build != "" {
+		// The optimizer chose the build side from the input statistics.
+		leftBuf, rightBuf = &bufPuller{puller: h.left}, &bufPuller{puller: h.right}
+		h.buildLeft = h.build == "left"
+	} else {
+		// Read from both leftBuf and rightBuf parent and find the shortest parent to
+		// create the table from.
+		var err error
+		leftBuf, rightBuf, err = pullRace(h.rctx.Context, h.left, h.right, HashJoinMemMaxBytes)
+		if err != nil {
+			return err
+		}
+		if !leftBuf.EOS && !rightBuf.EOS {
+			// Neither input fits in memory so partition both to disk
+			// and build from the one that has used less of the budget.
+			h.buildLeft = leftBuf.nbytes <= rightBuf.nbytes
+			build, probe, buildKey, probeKey := h.sides(leftBuf, rightBuf)
+			return h.spill(nil, build, probe, buildKey, probeKey)
+		}
+		h.buildLeft = !rightBuf.EOS
+	}
+	build, probe, buildKey, probeKey := h.sides(leftBuf, rightBuf)
+	table := map[string][]super.Value{}
+	ok, err := buildTable(table, build, buildKey, HashJoinMemMaxBytes, &h.Stats)
 	if err != nil {
 		return err
 	}
-	var table map[string][]super.Value
-	var left, right vector.Puller
-	if rightBuf.EOS {
-		table = buildTable(rightBuf, h.rightKey)
-		left = leftBuf
-	} else {
-		table = buildTable(leftBuf, h.leftKey)
-		right = rightBuf
+	if ok {
+		h.hashJoin = h.newHashJoin(table, probe)
+		return nil
 	}
-	h.hashJoin = &hashJoin{
+	// The table doesn't fit in memory so partition both inputs to disk.
+	return h.spill(table, build, probe, buildKey, probeKey)
+}
+
+// sides returns the build and probe inputs and their keys according to
+// h.buildLeft.
+func (h *HashJoin) sides(left, right vector.Puller) (vector.Puller, vector.Puller, expr.Evaluator, expr.Evaluator) {
+	if h.buildLeft {
+		return left, right, h.leftKey, h.rightKey
+	}
+	return right, left, h.rightKey, h.leftKey
+}
+
+// spill partitions table and the values remaining in build and probe to
+// disk and starts joining the first partition.
+func (h *HashJoin) spill(table map[string][]super.Value, build, probe vector.Puller, buildKey, probeKey expr.Evaluator) error {
+	h.spiller = newJoinSpiller(h.rctx, buildKey, probeKey, &h.Stats)
+	if err := h.spiller.partition(table, build, probe, 0); err != nil {
+		return err
+	}
+	var err error
+	h.hashJoin, err = h.spiller.next(h.newHashJoin)
+	if h.hashJoin == nil && err == nil {
+		// All values had missing keys.
+		h.hashJoin = h.newHashJoin(nil, vector.NewPuller())
+	}
+	return err
+}
+
+// newHashJoin returns a hashJoin that probes table, which was built from
+// the left input if h.buildLeft is true and from the right input otherwise,
+// with the values from probe.
+func (h *HashJoin) newHashJoin(table map[string][]super.Value, probe vector.Puller) *hashJoin {
+	j := &hashJoin{
 		sctx:       h.rctx.Sctx,
 		style:      h.style,
 		table:      table,
-		left:       left,
-		right:      right,
 		leftAlias:  h.leftAlias,
 		rightAlias: h.rightAlias,
 		leftKey:    h.leftKey,
 		rightKey:   h.rightKey,
 		hits:       make(map[string]bool),
 	}
-	return nil
+	if h.buildLeft {
+		j.right = probe
+	} else {
+		j.left = probe
+	}
+	return j
 }
 
-func buildTable(p vector.Puller, key expr.Evaluator) map[string][]super.Value {
-	table := map[string][]super.Value{}
+// buildTable adds the values pulled from p to table under their keys until
+// p reaches EOS or, if limit is positive, until the size of the values added
+// exceeds limit.  It returns false if the limit was exceeded, in which case
+// the values not yet pulled remain in p.  The size of the table is recorded
+// in stats.
+func buildTable(table map[string][]super.Value, p vector.Puller, key expr.Evaluator, limit int, stats *spill.Stats) (bool, error) {
 	var keyBuilder, valBuilder scode.Builder
+	var nbytes int
 	for {
-		vec, _ := p.Pull(false)
-		if vec == nil {
-			break
+		vec, err := p.Pull(false)
+		if vec == nil || err != nil {
+			return true, err
 		}
 		rightKeyVec := key.Eval(vec)
 		for i := range vec.Len() {
@@ -116,33 +211,41 @@ func buildTable(p vector.Puller, key expr.Evaluator) map[string][]super.Value {
 			}
 			key := hashKey(keyVal)
 			valBuilder.Reset()
-			table[key] = append(table[key], vectorValue(&valBuilder, vec, i))
+			val := vectorValue(&valBuilder, vec, i)
+			table[key] = append(table[key], val)
+			nbytes += len(key) + len(val.Bytes())
+		}
+		stats.UpdateMem(nbytes)
+		if limit > 0 && nbytes > limit {
+			return false, nil
 		}
 	}
-	return table
 }
 
-// pullRace pulls from a and b concurrently until one reaches EOS.  It returns
-// bufPullers for a and b containing the vectors pulled from each.
-func pullRace(ctx context.Context, a, b vector.Puller) (*bufPuller, *bufPuller, error) {
+// pullRace pulls from a and b concurrently until one reaches EOS or, if limit
+// is positive, until the estimated size of the vectors pulled from both
+// exceeds limit.  It returns bufPullers for a and b containing the vectors
+// pulled from each.
+func pullRace(ctx context.Context, a, b vector.Puller, limit int) (*bufPuller, *bufPuller, error) {
 	var aBuf, bBuf *bufPuller
 	var done atomic.Bool
+	var nbytes atomic.Int64
 	group, ctx := errgroup.WithContext(ctx)
 	group.Go(func() error {
 		var err error
-		aBuf, err = pullUntilEOSOrDone(ctx, &done, a)
+		aBuf, err = pullUntilEOSOrDone(ctx, &done, &nbytes, limit, a)
 		return err
 	})
 	group.Go(func() error {
 		var err error
-		bBuf, err = pullUntilEOSOrDone(ctx, &done, b)
+		bBuf, err = pullUntilEOSOrDone(ctx, &done, &nbytes, limit, b)
 		return err
 	})
 	err := group.Wait()
 	return aBuf, bBuf, err
 }
 
-func pullUntilEOSOrDone(ctx context.Context, done *atomic.Bool, parent vector.Puller) (*bufPuller, error) {
+func pullUntilEOSOrDone(ctx context.Context, done *atomic.Bool, nbytes *atomic.Int64, limit int, parent vector.Puller) (*bufPuller, error) {
 	b := &bufPuller{puller: parent}
 	for ctx.Err() == nil && !done.Load() {
 		vec, err := parent.Pull(false)
@@ -152,6 +255,14 @@ func pullUntilEOSOrDone(ctx context.Context, done *atomic.Bool, parent vector.Pu
 			return b, err
 		}
 		b.vecs = append(b.vecs, vec)
+		if vec.Len() == 0 {
+			continue
+		}
+		size := estimateSize(vec)
+		b.nbytes += size
+		if limit > 0 && nbytes.Add(int64(size)) > int64(limit) {
+			done.Store(true)
+		}
 	}
 	return b, nil
 }
@@ -296,6 +407,7 @@ func (j *hashJoin) wrap(l, r *super.Value) super.Value {
 
 type bufPuller struct {
 	vecs   []vector.Any
+	nbytes int
 	EOS    bool
 	puller vector.Puller
 	done   bool
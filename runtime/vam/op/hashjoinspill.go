@@ -0,0 +1,241 @@
+package op
+
+import (
+	"context"
+	"hash/maphash"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/sam/op/spill"
+	"github.com/brimdata/super/runtime/vam"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+const (
+	// joinPartitions is the number of partitions into which a grace
+	// hash join splits its inputs.
+	joinPartitions = 16
+	// maxJoinDepth is the maximum number of times a partition whose
+	// build side does not fit in memory will be repartitioned.  Beyond
+	// this, the partition's table is built in memory regardless of size.
+	maxJoinDepth = 3
+)
+
+// joinSpiller implements a grace hash join for HashJoin when the build side
+// exceeds HashJoinMemMaxBytes.  The build and probe inputs are split by the
+// hash of their join keys into partitions stored in temporary files so that
+// each partition can then be joined in memory.
+type joinSpiller struct {
+	rctx     *runtime.Context
+	buildKey expr.Evaluator
+	probeKey expr.Evaluator
+
+	parts []*joinPartition
+	cur   *joinPartition
+	stop  func() bool
+	stats *spill.Stats
+}
+
+func newJoinSpiller(rctx *runtime.Context, buildKey, probeKey expr.Evaluator, stats *spill.Stats) *joinSpiller {
+	s := &joinSpiller{
+		rctx:     rctx,
+		buildKey: buildKey,
+		probeKey: probeKey,
+		stats:    stats,
+	}
+	// Remove spill files if the query is canceled before we finish.
+	rctx.WaitGroup.Add(1)
+	s.stop = context.AfterFunc(rctx, func() {
+		s.removeAll()
+		rctx.WaitGroup.Done()
+	})
+	return s
+}
+
+// partition partitions table and the values remaining in build and probe
+// and queues the resulting partitions ahead of any existing ones.
+func (s *joinSpiller) partition(table map[string][]super.Value, build, probe vector.Puller, depth int) error {
+	p := &joinPartitioner{seed: maphash.MakeSeed()}
+	err := p.partition(s.rctx, table, build, probe, s.buildKey, s.probeKey, depth)
+	s.parts = append(p.parts, s.parts...)
+	return err
+}
+
+// next returns a hashJoin for the next partition or nil if none remain.
+func (s *joinSpiller) next(newHashJoin func(map[string][]super.Value, vector.Puller) *hashJoin) (*hashJoin, error) {
+	for len(s.parts) > 0 {
+		part := s.parts[0]
+		s.parts = s.parts[1:]
+		s.cur = part
+		build, probe, err := part.open(s.rctx.Sctx)
+		if err != nil {
+			part.remove()
+			return nil, err
+		}
+		s.stats.AddSpilled(part.size())
+		table := map[string][]super.Value{}
+		ok, err := buildTable(table, build, s.buildKey, HashJoinMemMaxBytes, s.stats)
+		if err == nil && !ok {
+			if part.depth < maxJoinDepth {
+				err = s.partition(table, build, probe, part.depth+1)
+				part.remove()
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+			_, err = buildTable(table, build, s.buildKey, 0, s.stats)
+		}
+		if err != nil {
+			part.remove()
+			return nil, err
+		}
+		// The probe side is read lazily so the partition's files are
+		// removed when the probe puller reaches EOS.
+		return newHashJoin(table, &partitionPuller{Puller: probe, part: part}), nil
+	}
+	return nil, nil
+}
+
+// cleanup removes any remaining spill files.
+func (s *joinSpiller) cleanup() {
+	if s.stop() {
+		s.removeAll()
+		s.rctx.WaitGroup.Done()
+	}
+}
+
+func (s *joinSpiller) removeAll() {
+	for _, part := range s.parts {
+		part.remove()
+	}
+	s.parts = nil
+	if s.cur != nil {
+		s.cur.remove()
+		s.cur = nil
+	}
+}
+
+type joinPartitioner struct {
+	seed  maphash.Seed
+	parts []*joinPartition
+}
+
+func (p *joinPartitioner) partition(rctx *runtime.Context, table map[string][]super.Value, build, probe vector.Puller, buildKey, probeKey expr.Evaluator, depth int) error {
+	for k := range joinPartitions {
+		p.parts = append(p.parts, &joinPartition{depth: depth})
+		var err error
+		if p.parts[k].build, err = spill.NewTempFile(); err != nil {
+			return err
+		}
+		if p.parts[k].probe, err = spill.NewTempFile(); err != nil {
+			return err
+		}
+	}
+	for key, vals := range table {
+		part := p.parts[p.index(key)]
+		for _, val := range vals {
+			if err := part.build.Write(val); err != nil {
+				return err
+			}
+		}
+		if err := rctx.Err(); err != nil {
+			return err
+		}
+	}
+	if err := p.write(rctx, build, buildKey, func(part *joinPartition) *spill.File { return part.build }); err != nil {
+		return err
+	}
+	return p.write(rctx, probe, probeKey, func(part *joinPartition) *spill.File { return part.probe })
+}
+
+// write writes the values pulled from puller to the partitions' files
+// returned by file.  Values with a missing key are dropped as they never
+// match and are not emitted by hashJoin.
+func (p *joinPartitioner) write(ctx context.Context, puller vector.Puller, key expr.Evaluator, file func(*joinPartition) *spill.File) error {
+	var keyBuilder, valBuilder scode.Builder
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		vec, err := puller.Pull(false)
+		if vec == nil || err != nil {
+			return err
+		}
+		keyVec := key.Eval(vec)
+		for i := range vec.Len() {
+			keyBuilder.Truncate()
+			keyVal := vectorValue(&keyBuilder, keyVec, i)
+			if keyVal.IsMissing() {
+				continue
+			}
+			valBuilder.Truncate()
+			f := file(p.parts[p.index(hashKey(keyVal))])
+			if err := f.Write(vectorValue(&valBuilder, vec, i)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (p *joinPartitioner) index(key string) int {
+	return int(maphash.String(p.seed, key) % joinPartitions)
+}
+
+type joinPartition struct {
+	build *spill.File
+	probe *spill.File
+	depth int
+}
+
+func (j *joinPartition) open(sctx *super.Context) (vector.Puller, vector.Puller, error) {
+	if err := j.build.Rewind(sctx); err != nil {
+		return nil, nil, err
+	}
+	if err := j.probe.Rewind(sctx); err != nil {
+		return nil, nil, err
+	}
+	build := vam.NewDematerializer(sbuf.NewPuller(j.build))
+	probe := vam.NewDematerializer(sbuf.NewPuller(j.probe))
+	return build, probe, nil
+}
+
+// size returns the number of bytes in the partition's files.
+func (j *joinPartition) size() int64 {
+	var n int64
+	for _, f := range []*spill.File{j.build, j.probe} {
+		if size, err := f.Size(); err == nil {
+			n += size
+		}
+	}
+	return n
+}
+
+func (j *joinPartition) remove() {
+	if j.build != nil {
+		j.build.CloseAndRemove()
+		j.build = nil
+	}
+	if j.probe != nil {
+		j.probe.CloseAndRemove()
+		j.probe = nil
+	}
+}
+
+// partitionPuller removes a partition's files once its probe side has been
+// read.
+type partitionPuller struct {
+	vector.Puller
+	part *joinPartition
+}
+
+func (p *partitionPuller) Pull(done bool) (vector.Any, error) {
+	vec, err := p.Puller.Pull(done)
+	if vec == nil || err != nil {
+		p.part.remove()
+	}
+	return vec, err
+}
@@ -68,7 +68,7 @@ func (n *nestedLoopJoin) done() {
 // join performs the join, passing results to yield.  It implements iter.Seq2.
 func (n *nestedLoopJoin) join(yield func(vector.Any, error) bool) {
 	// outer and inner are inputs for the outer and inner loops below.
-	outer, inner, err := pullRace(n.rctx.Context, n.left, n.right)
+	outer, inner, err := pullRace(n.rctx.Context, n.left, n.right, 0)
 	if err != nil {
 		yield(nil, err)
 		return
@@ -1,6 +1,7 @@
 package op
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -26,8 +27,14 @@ type Scanner struct {
 	projection field.Projection
 	cache      *vcache.Cache
 	progress   *sbuf.Progress
-	resultCh   chan result
+	resultCh   chan scanResult
 	doneCh     chan struct{}
+
+	// pinned is the object of the vector last returned by Pull.  It stays
+	// pinned in the cache until the consumer pulls again, which releases
+	// the vector, or the query ends.
+	mu     sync.Mutex
+	pinned *vcache.Object
 }
 
 var _ vector.Puller = (*Scanner)(nil)
@@ -42,7 +49,7 @@ func NewScanner(rctx *runtime.Context, cache *vcache.Cache, parent sbuf.Puller,
 		projection: field.NewProjection(paths),
 		progress:   progress,
 		doneCh:     make(chan struct{}),
-		resultCh:   make(chan result),
+		resultCh:   make(chan scanResult),
 	}
 }
 
@@ -58,7 +65,12 @@ func NewScanner(rctx *runtime.Context, cache *vcache.Cache, parent sbuf.Puller,
 // vector of error("missing").
 
 func (s *Scanner) Pull(done bool) (vector.Any, error) {
-	s.once.Do(func() { go s.run() })
+	s.once.Do(func() {
+		context.AfterFunc(s.rctx.Context, func() { s.hold(nil) })
+		go s.run()
+	})
+	// Pulling again releases the vector returned by the previous Pull.
+	s.hold(nil)
 	if done {
 		select {
 		case s.doneCh <- struct{}{}:
@@ -68,43 +80,67 @@ func (s *Scanner) Pull(done bool) (vector.Any, error) {
 		}
 	}
 	if r, ok := <-s.resultCh; ok {
+		s.hold(r.object)
 		return r.vector, r.err
 	}
 	return nil, s.rctx.Err()
 }
 
+// hold unpins the object held for the vector previously returned by Pull
+// and holds object in its place.  Once the query has ended, object is
+// unpinned immediately.
+func (s *Scanner) hold(object *vcache.Object) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pinned != nil {
+		s.pinned.Unpin()
+	}
+	if object != nil && s.rctx.Err() != nil {
+		object.Unpin()
+		object = nil
+	}
+	s.pinned = object
+}
+
 func (s *Scanner) run() {
 	for {
 		meta, err := s.parent.Pull(false)
 		if meta == nil {
-			s.sendResult(nil, err)
+			s.sendResult(nil, nil, err)
 			return
 		}
 		object, err := s.cache.Fetch(s.rctx.Context, meta.VectorURI(s.pool.DataPath), meta.ID)
 		if err != nil {
-			s.sendResult(nil, err)
+			s.sendResult(nil, nil, err)
 			return
 		}
 		vec, err := object.Fetch(s.rctx.Sctx, s.projection)
-		s.sendResult(vec, err)
 		if err != nil {
+			object.Unpin()
+			s.sendResult(nil, nil, err)
 			return
 		}
+		s.sendResult(object, vec, nil)
 	}
 }
 
-func (s *Scanner) sendResult(vec vector.Any, err error) (bool, bool) {
+// sendResult sends a result to Pull.  If object is not nil, its pin passes
+// to Pull along with vec or is released if Pull does not receive vec.
+func (s *Scanner) sendResult(object *vcache.Object, vec vector.Any, err error) (bool, bool) {
 	select {
-	case s.resultCh <- result{vec, err}:
+	case s.resultCh <- scanResult{vec, object, err}:
 		return false, true
 	case <-s.doneCh:
+		if object != nil {
+			object.Unpin()
+		}
 		_, pullErr := s.parent.Pull(true)
 		if err == nil {
 			err = pullErr
 		}
 		if err != nil {
 			select {
-			case s.resultCh <- result{err: err}:
+			case s.resultCh <- scanResult{err: err}:
 				return true, false
 			case <-s.rctx.Done():
 				return false, false
@@ -112,6 +148,9 @@ func (s *Scanner) sendResult(vec vector.Any, err error) (bool, bool) {
 		}
 		return true, true
 	case <-s.rctx.Done():
+		if object != nil {
+			object.Unpin()
+		}
 		return false, false
 	}
 }
@@ -121,6 +160,12 @@ type result struct {
 	err    error //XXX go err vs vector.Any err?
 }
 
+type scanResult struct {
+	vector vector.Any
+	object *vcache.Object
+	err    error
+}
+
 type objectPuller struct {
 	parent      sbuf.Puller
 	unmarshaler *sup.UnmarshalBSUPContext
@@ -69,10 +69,14 @@ func (s *Searcher) run() {
 		}
 		vec, err := object.Fetch(s.rctx.Sctx, s.projection)
 		if err != nil {
+			object.Unpin()
 			s.sendResult(nil, nil, err)
 			return
 		}
+		// The vector is not passed on, so the object is unpinned once the
+		// filter has been evaluated.
 		b, ok := s.filter.Eval(vec).(*vector.Bool)
+		object.Unpin()
 		if !ok {
 			s.sendResult(nil, nil, errors.New("system error: vam.Searcher encountered a non-boolean filter result"))
 			return
@@ -1,32 +1,332 @@
 package op
 
 import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
 	"github.com/brimdata/super/runtime"
-	"github.com/brimdata/super/runtime/sam/expr"
-	"github.com/brimdata/super/runtime/sam/op/sort"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	samsort "github.com/brimdata/super/runtime/sam/op/sort"
+	"github.com/brimdata/super/runtime/sam/op/spill"
 	"github.com/brimdata/super/runtime/vam"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/scode"
 	"github.com/brimdata/super/vector"
 )
 
+// Sort is a columnar sort.  It buffers its input vectors, evaluates the sort
+// keys over each one, and computes a permutation over all buffered values
+// from which it builds views of its input in sorted order.  When the
+// buffered input exceeds sort.MemMaxBytes, Sort spills a sorted run to disk
+// and, at end of stream, merges the runs into its output.
 type Sort struct {
-	rctx    *runtime.Context
-	samsort *sort.Op
+	rctx         *runtime.Context
+	parent       vector.Puller
+	exprs        []expr.SortExpr
+	samExprs     []samexpr.SortExpr
+	guessReverse bool
+
+	compareFns []samexpr.CompareFn
+	comparator *samexpr.Comparator
+	guessed    bool
+
+	vecs    []vector.Any
+	starts  []uint32
+	keys    []*sortKeys
+	maxLen  uint32
+	nbytes  int
+	spiller *spill.MergeSort
+	stop    func() bool
+
+	out     []vector.Any
+	merger  vector.Puller
+	sending bool
+	spill.Stats
 }
 
-func NewSort(rctx *runtime.Context, parent vector.Puller, fields []expr.SortExpr, guessReverse bool) *Sort {
-	materializer := vam.NewMaterializer(parent)
-	s := sort.New(rctx, materializer, fields, guessReverse)
-	return &Sort{rctx: rctx, samsort: s}
+func NewSort(rctx *runtime.Context, parent vector.Puller, exprs []expr.SortExpr, samExprs []samexpr.SortExpr, guessReverse bool) *Sort {
+	return &Sort{
+		rctx:         rctx,
+		parent:       parent,
+		exprs:        exprs,
+		samExprs:     samExprs,
+		guessReverse: guessReverse,
+	}
 }
 
 func (s *Sort) Pull(done bool) (vector.Any, error) {
-	batch, err := s.samsort.Pull(done)
-	if batch == nil || err != nil {
-		return nil, err
+	if done {
+		if s.sending {
+			// We already consumed our parent's EOS.
+			s.reset()
+			return nil, nil
+		}
+		s.reset()
+		return s.parent.Pull(true)
+	}
+	if !s.sending {
+		if err := s.consume(); err != nil {
+			s.reset()
+			return nil, err
+		}
+		s.sending = true
+	}
+	if s.merger != nil {
+		vec, err := s.merger.Pull(false)
+		if vec == nil || err != nil {
+			s.reset()
+		}
+		return vec, err
+	}
+	if len(s.out) == 0 {
+		s.reset()
+		return nil, nil
+	}
+	vec := s.out[0]
+	s.out = s.out[1:]
+	return vec, nil
+}
+
+// consume pulls from s.parent until EOS, spilling as needed, and prepares
+// the sorted output.
+func (s *Sort) consume() error {
+	for {
+		vec, err := s.parent.Pull(false)
+		if err != nil {
+			return err
+		}
+		if vec == nil {
+			break
+		}
+		if vec.Len() == 0 {
+			continue
+		}
+		s.append(vec)
+		if s.nbytes < samsort.MemMaxBytes {
+			continue
+		}
+		if err := s.spill(); err != nil {
+			return err
+		}
+	}
+	if s.spiller == nil {
+		s.out = s.views(s.sort())
+		s.clear()
+		return nil
+	}
+	if len(s.vecs) > 0 {
+		if err := s.spill(); err != nil {
+			return err
+		}
+	}
+	// Reading from the spiller merges the spilled runs.
+	s.merger = vam.NewDematerializer(sbuf.NewPuller(s.spiller))
+	return nil
+}
+
+func (s *Sort) append(vec vector.Any) {
+	if !s.guessed {
+		s.guess(vec)
+	}
+	var start uint32
+	if n := len(s.vecs); n > 0 {
+		start = s.starts[n-1] + s.vecs[n-1].Len()
+	}
+	s.vecs = append(s.vecs, vec)
+	s.starts = append(s.starts, start)
+	s.maxLen = max(s.maxLen, vec.Len())
+	for k, e := range s.exprs {
+		s.keys[k].append(e.Eval(vec), nil)
+	}
+	s.nbytes += estimateSize(vec)
+	s.UpdateMem(s.nbytes)
+}
+
+// guess sets up the sort keys and comparators, guessing a sort key from the
+// first value of vec if none was provided.  As in sam, the key is guessed
+// only once.
+func (s *Sort) guess(vec vector.Any) {
+	s.guessed = true
+	guessVal := valueAt(vec, 0)
+	if len(s.exprs) == 0 {
+		e := expr.NewDottedExpr(s.rctx.Sctx, samsort.GuessSortKey(guessVal))
+		o := order.Asc
+		if s.guessReverse {
+			o = order.Desc
+		}
+		s.exprs = []expr.SortExpr{expr.NewSortExpr(e, o, order.NullsLast)}
+	}
+	s.comparator = samsort.NewComparator(s.rctx.Sctx, s.samExprs, guessVal, s.guessReverse)
+	for _, e := range s.exprs {
+		// compareFn compares values in ascending order, so choose a
+		// nulls order that places nulls where e does.
+		nulls := order.NullsFirst
+		if e.NullsMax() {
+			nulls = order.NullsLast
+		}
+		s.compareFns = append(s.compareFns, samexpr.NewValueCompareFn(order.Asc, nulls))
+		s.keys = append(s.keys, &sortKeys{})
+	}
+}
+
+// sort returns the indexes of the buffered values in sorted order.
+func (s *Sort) sort() []uint32 {
+	var n int
+	if len(s.keys) > 0 {
+		n = s.keys[0].len()
+	}
+	index := make([]uint32, n)
+	for i := range index {
+		index[i] = uint32(i)
 	}
-	b := vector.NewDynamicBuilder()
-	for _, val := range batch.Values() {
-		b.Write(val)
+	slices.SortStableFunc(index, func(i, j uint32) int {
+		for k, e := range s.exprs {
+			a, b := i, j
+			if e.Order == order.Desc {
+				a, b = b, a
+			}
+			if c := s.keys[k].compare(a, b, e.NullsMax(), s.compareFns[k]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+	return index
+}
+
+// views returns vectors comprising the buffered values in the order given
+// by index.  Each vector holds at most s.maxLen values.
+func (s *Sort) views(index []uint32) []vector.Any {
+	// Each buffered vector, or each value vector of a buffered Dynamic,
+	// is a leaf from which output views are picked.
+	var leaves []vector.Any
+	leafBases := make([]uint32, len(s.vecs))
+	for i, vec := range s.vecs {
+		leafBases[i] = uint32(len(leaves))
+		if d, ok := vec.(*vector.Dynamic); ok {
+			leaves = append(leaves, d.Values...)
+		} else {
+			leaves = append(leaves, vec)
+		}
+	}
+	var out []vector.Any
+	for len(index) > 0 {
+		n := min(len(index), int(s.maxLen))
+		out = append(out, s.view(index[:n], leaves, leafBases))
+		index = index[n:]
+	}
+	return out
+}
+
+func (s *Sort) view(index []uint32, leaves []vector.Any, leafBases []uint32) vector.Any {
+	leafTags := make([]uint32, len(index))
+	leafIndexes := make([][]uint32, len(leaves))
+	for k, i := range index {
+		b := sort.Search(len(s.starts), func(j int) bool { return s.starts[j] > i }) - 1
+		slot := i - s.starts[b]
+		leaf := leafBases[b]
+		if d, ok := s.vecs[b].(*vector.Dynamic); ok {
+			leaf += d.Tags[slot]
+			slot = d.ForwardTagMap()[slot]
+		}
+		leafTags[k] = leaf
+		leafIndexes[leaf] = append(leafIndexes[leaf], slot)
+	}
+	var views []vector.Any
+	tags := make([]uint32, len(leaves))
+	for leaf, index := range leafIndexes {
+		if len(index) > 0 {
+			tags[leaf] = uint32(len(views))
+			views = append(views, vector.Pick(leaves[leaf], index))
+		}
+	}
+	if len(views) == 1 {
+		return views[0]
+	}
+	for k, leaf := range leafTags {
+		leafTags[k] = tags[leaf]
+	}
+	return vector.NewDynamic(leafTags, views)
+}
+
+// spill sorts the buffered values and writes them as a run to the spiller.
+func (s *Sort) spill() error {
+	if s.spiller == nil {
+		spiller, err := spill.NewMergeSort(s.comparator, &s.Stats)
+		if err != nil {
+			return err
+		}
+		s.spiller = spiller
+		// Remove spill files if the query is canceled before we finish.
+		s.rctx.WaitGroup.Add(1)
+		s.stop = context.AfterFunc(s.rctx, func() {
+			spiller.Cleanup()
+			s.rctx.WaitGroup.Done()
+		})
+	}
+	var vals []super.Value
+	for _, vec := range s.views(s.sort()) {
+		vals = append(vals, vam.Materialize(vec).Values()...)
+	}
+	s.clear()
+	return s.spiller.SpillSorted(s.rctx, sbuf.NewArray(vals))
+}
+
+// clear drops the buffered input.
+func (s *Sort) clear() {
+	s.vecs = nil
+	s.starts = nil
+	s.maxLen = 0
+	s.nbytes = 0
+	for _, k := range s.keys {
+		k.reset()
+	}
+}
+
+func (s *Sort) reset() {
+	s.clear()
+	if s.spiller != nil {
+		if s.stop() {
+			s.spiller.Cleanup()
+			s.rctx.WaitGroup.Done()
+		}
+		s.spiller = nil
+		s.stop = nil
+	}
+	s.out = nil
+	s.merger = nil
+	s.sending = false
+}
+
+func valueAt(vec vector.Any, slot uint32) super.Value {
+	var typ super.Type
+	if d, ok := vec.(*vector.Dynamic); ok {
+		typ = d.TypeOf(slot)
+	} else {
+		typ = vec.Type()
+	}
+	var b scode.Builder
+	vec.Serialize(&b, slot)
+	return super.NewValue(typ, b.Bytes().Body())
+}
+
+// estimateSize estimates the memory footprint of vec from the serialized
+// size of a sample of its values.
+func estimateSize(vec vector.Any) int {
+	const maxSamples = 32
+	n := vec.Len()
+	stride := max(n/maxSamples, 1)
+	var b scode.Builder
+	var samples, nbytes int
+	for slot := uint32(0); slot < n; slot += stride {
+		b.Truncate()
+		vec.Serialize(&b, slot)
+		nbytes += len(b.Bytes())
+		samples++
 	}
-	return b.Build(), nil
+	return nbytes * int(n) / samples
 }
@@ -0,0 +1,287 @@
+package op
+
+import (
+	"cmp"
+
+	"github.com/brimdata/super"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+const (
+	keyNull = iota
+	keyInt
+	keyUint
+	keyFloat
+	keyString
+	keyValue
+)
+
+// sortKeys holds the values of one sort key for each value buffered by
+// Sort.  Keys taken from Int, Uint, Float, and String vectors (including
+// dictionaries, views, constants, and dynamics over them) are stored in
+// native slices so they can be compared without materialization.  All
+// other keys are materialized as super.Values.
+type sortKeys struct {
+	kinds   []byte
+	offsets []uint32
+	ints    []int64
+	uints   []uint64
+	floats  []float64
+	strs    []string
+	vals    []super.Value
+}
+
+func (s *sortKeys) len() int {
+	return len(s.kinds)
+}
+
+func (s *sortKeys) reset() {
+	s.kinds = s.kinds[:0]
+	s.offsets = s.offsets[:0]
+	s.ints = s.ints[:0]
+	s.uints = s.uints[:0]
+	s.floats = s.floats[:0]
+	s.strs = s.strs[:0]
+	s.vals = s.vals[:0]
+}
+
+// append appends the keys in vec at the slots in index or, if index is nil,
+// at all slots.
+func (s *sortKeys) append(vec vector.Any, index []uint32) {
+	n := len(index)
+	if index == nil {
+		n = int(vec.Len())
+	}
+	slot := func(k int) uint32 {
+		if index == nil {
+			return uint32(k)
+		}
+		return index[k]
+	}
+	switch vec := vector.Under(vec).(type) {
+	case *vector.Int:
+		for k := range n {
+			s.appendInt(vec.Values[slot(k)])
+		}
+	case *vector.Uint:
+		for k := range n {
+			s.appendUint(vec.Values[slot(k)])
+		}
+	case *vector.Float:
+		for k := range n {
+			s.appendFloat(vec.Values[slot(k)])
+		}
+	case *vector.String:
+		for k := range n {
+			s.appendString(vec.Value(slot(k)))
+		}
+	case *vector.Const:
+		var one sortKeys
+		one.appendValue(vec.Value())
+		for range n {
+			s.appendFrom(&one, 0)
+		}
+	case *vector.Dict:
+		inner := make([]uint32, n)
+		for k := range n {
+			inner[k] = uint32(vec.Index[slot(k)])
+		}
+		s.append(vec.Any, inner)
+	case *vector.View:
+		inner := make([]uint32, n)
+		for k := range n {
+			inner[k] = vec.Index[slot(k)]
+		}
+		s.append(vec.Any, inner)
+	case *vector.Union:
+		s.appendDynamic(vec.Dynamic, n, slot)
+	case *vector.Dynamic:
+		s.appendDynamic(vec, n, slot)
+	default:
+		var b scode.Builder
+		typ := vec.Type()
+		for k := range n {
+			b.Truncate()
+			vec.Serialize(&b, slot(k))
+			s.appendValue(super.NewValue(typ, b.Bytes().Body()).Copy())
+		}
+	}
+}
+
+func (s *sortKeys) appendDynamic(d *vector.Dynamic, n int, slot func(int) uint32) {
+	forward := d.ForwardTagMap()
+	indexes := make([][]uint32, len(d.Values))
+	for k := range n {
+		tag := d.Tags[slot(k)]
+		indexes[tag] = append(indexes[tag], forward[slot(k)])
+	}
+	values := make([]sortKeys, len(d.Values))
+	for tag, index := range indexes {
+		if len(index) > 0 {
+			values[tag].append(d.Values[tag], index)
+		}
+	}
+	offs := make([]uint32, len(d.Values))
+	for k := range n {
+		tag := d.Tags[slot(k)]
+		s.appendFrom(&values[tag], offs[tag])
+		offs[tag]++
+	}
+}
+
+func (s *sortKeys) appendValue(val super.Value) {
+	if val.IsMissing() {
+		s.appendNull()
+		return
+	}
+	val = val.Under()
+	switch id := val.Type().ID(); {
+	case val.IsNull():
+		s.appendNull()
+	case super.IsUnsigned(id):
+		s.appendUint(val.Uint())
+	case super.IsSigned(id):
+		s.appendInt(val.Int())
+	case super.IsFloat(id):
+		s.appendFloat(val.Float())
+	case id == super.IDString:
+		s.appendString(super.DecodeString(val.Bytes()))
+	default:
+		s.add(keyValue, len(s.vals))
+		s.vals = append(s.vals, val)
+	}
+}
+
+func (s *sortKeys) appendFrom(from *sortKeys, slot uint32) {
+	off := from.offsets[slot]
+	switch from.kinds[slot] {
+	case keyNull:
+		s.appendNull()
+	case keyInt:
+		s.appendInt(from.ints[off])
+	case keyUint:
+		s.appendUint(from.uints[off])
+	case keyFloat:
+		s.appendFloat(from.floats[off])
+	case keyString:
+		s.appendString(from.strs[off])
+	case keyValue:
+		s.add(keyValue, len(s.vals))
+		s.vals = append(s.vals, from.vals[off])
+	}
+}
+
+func (s *sortKeys) appendNull() {
+	s.add(keyNull, 0)
+}
+
+func (s *sortKeys) appendInt(v int64) {
+	s.add(keyInt, len(s.ints))
+	s.ints = append(s.ints, v)
+}
+
+func (s *sortKeys) appendUint(v uint64) {
+	s.add(keyUint, len(s.uints))
+	s.uints = append(s.uints, v)
+}
+
+func (s *sortKeys) appendFloat(v float64) {
+	s.add(keyFloat, len(s.floats))
+	s.floats = append(s.floats, v)
+}
+
+func (s *sortKeys) appendString(v string) {
+	s.add(keyString, len(s.strs))
+	s.strs = append(s.strs, v)
+}
+
+func (s *sortKeys) add(kind byte, off int) {
+	s.kinds = append(s.kinds, kind)
+	s.offsets = append(s.offsets, uint32(off))
+}
+
+// compare compares the keys at slots i and j with the same semantics as
+// sam's expr.Comparator.  Null compares greater than all other values if
+// nullsMax is true and less than all other values otherwise.  compareVals
+// is used to compare keys that could not be stored natively.
+func (s *sortKeys) compare(i, j uint32, nullsMax bool, compareVals samexpr.CompareFn) int {
+	ik, jk := s.kinds[i], s.kinds[j]
+	ioff, joff := s.offsets[i], s.offsets[j]
+	if ik == keyNull || jk == keyNull {
+		switch {
+		case ik == jk:
+			return 0
+		case (ik == keyNull) == nullsMax:
+			return 1
+		}
+		return -1
+	}
+	if ik == jk {
+		switch ik {
+		case keyInt:
+			return cmp.Compare(s.ints[ioff], s.ints[joff])
+		case keyUint:
+			return cmp.Compare(s.uints[ioff], s.uints[joff])
+		case keyFloat:
+			return cmp.Compare(s.floats[ioff], s.floats[joff])
+		case keyString:
+			return cmp.Compare(s.strs[ioff], s.strs[joff])
+		}
+		return compareVals(s.vals[ioff], s.vals[joff])
+	}
+	if ik == keyValue || jk == keyValue {
+		return compareVals(s.value(i), s.value(j))
+	}
+	switch {
+	case ik == keyString:
+		// Numbers sort before strings.
+		return 1
+	case jk == keyString:
+		return -1
+	case ik == keyFloat:
+		return cmp.Compare(s.floats[ioff], s.toFloat(j))
+	case jk == keyFloat:
+		return cmp.Compare(s.toFloat(i), s.floats[joff])
+	case ik == keyInt:
+		// j is a uint.
+		if v := s.ints[ioff]; v >= 0 {
+			return cmp.Compare(uint64(v), s.uints[joff])
+		}
+		return -1
+	}
+	// i is a uint and j is an int.
+	if v := s.ints[joff]; v >= 0 {
+		return cmp.Compare(s.uints[ioff], uint64(v))
+	}
+	return 1
+}
+
+func (s *sortKeys) toFloat(slot uint32) float64 {
+	off := s.offsets[slot]
+	switch s.kinds[slot] {
+	case keyInt:
+		return float64(s.ints[off])
+	case keyUint:
+		return float64(s.uints[off])
+	}
+	return s.floats[off]
+}
+
+func (s *sortKeys) value(slot uint32) super.Value {
+	off := s.offsets[slot]
+	switch s.kinds[slot] {
+	case keyInt:
+		return super.NewInt64(s.ints[off])
+	case keyUint:
+		return super.NewUint64(s.uints[off])
+	case keyFloat:
+		return super.NewFloat64(s.floats[off])
+	case keyString:
+		return super.NewString(s.strs[off])
+	case keyValue:
+		return s.vals[off]
+	}
+	return super.Null
+}
@@ -0,0 +1,405 @@
+package op
+
+import (
+	"fmt"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/runtime/sam/op/window"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/expr/agg"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+// StreamWindow computes window functions over the partitions of its input
+// given by keys in input order and sets the field for each function in each
+// value to the function's result.  It buffers its input vectors, evaluating
+// the keys and function arguments over each, and returns the leading rows
+// whose results are known, which for lead is once the row at its offset in
+// the partition arrives or the partition ends.  When the input is sorted by
+// the first key, each partition ends when that key changes.  The limits on
+// partitions and pending rows are those of the sequential runtime's
+// window.Streamer.
+type StreamWindow struct {
+	sctx   *super.Context
+	parent vector.Puller
+	keys   []expr.Evaluator
+	sorted bool
+	funcs  []*StreamWindowFunc
+	rec    expr.Evaluator
+	// maxLead is the largest offset of lead.
+	maxLead int64
+
+	buf        windowBuffer
+	partitions map[string]*streamPartition
+	sortKey    []byte
+	// rows holds the partition of each buffered row, and seq is the
+	// sequence number of the first buffered row.
+	rows []streamRow
+	seq  uint64
+	out  []vector.Any
+	eos  bool
+
+	// results holds the result vectors of the functions for the vector
+	// being evaluated by rec, which are read via streamWindowRef.
+	results []vector.Any
+}
+
+// StreamWindowFunc is a window function computed by StreamWindow.  When Agg
+// is non-nil, the function is an aggregate over the values of the partition
+// through the current row.  Otherwise, Name is one of the functions
+// accepted by window.IsStreamFunc, Arg is its argument, Offset is the
+// offset of lag or lead, and Default, when non-nil, is the value of lag or
+// lead when there is no row at its offset.
+type StreamWindowFunc struct {
+	Name    string
+	Arg     expr.Evaluator
+	Offset  int
+	Default expr.Evaluator
+	Agg     *expr.Aggregator
+
+	// col is the buffer column of the function's argument and def the
+	// column of its default.
+	col int
+	def int
+}
+
+type streamRow struct {
+	p *streamPartition
+	// pos is the position of the row in its partition.
+	pos int64
+}
+
+type streamPartition struct {
+	// base is the position of the first buffered row of the partition,
+	// which is the number of its rows already returned.
+	base int64
+	// seqs holds the sequence numbers of the buffered rows.
+	seqs   []uint64
+	states []streamState
+	done   bool
+	// emit is the number of rows returned by the current call to emit.
+	emit int
+}
+
+// streamState holds the state of one function for one partition.
+type streamState struct {
+	// ring holds the argument values of the last Offset rows returned
+	// for lag.
+	ring  []super.Value
+	first *super.Value
+	fn    agg.Func
+}
+
+// NewStreamWindow returns an operator that computes funcs over the
+// partitions of parent given by keys.  The result of funcs[k] is stored in
+// the field at paths[k].  If sorted is true, parent must be sorted by
+// keys[0].
+func NewStreamWindow(sctx *super.Context, parent vector.Puller, keys []expr.Evaluator, sorted bool, paths []field.Path, funcs []*StreamWindowFunc) (*StreamWindow, error) {
+	o := &StreamWindow{
+		sctx:       sctx,
+		parent:     parent,
+		keys:       keys,
+		sorted:     sorted && len(keys) > 0,
+		funcs:      funcs,
+		partitions: make(map[string]*streamPartition),
+	}
+	var refs []expr.Evaluator
+	col := 1
+	for k, f := range funcs {
+		f.col, f.def = -1, -1
+		if f.Agg != nil || f.Arg != nil {
+			f.col = col
+			col++
+		}
+		if f.Default != nil {
+			f.def = col
+			col++
+		}
+		if f.Name == "lead" && f.Agg == nil {
+			o.maxLead = max(o.maxLead, int64(f.Offset))
+		}
+		refs = append(refs, &streamWindowRef{o, k})
+	}
+	rec, err := newWindowRecordExpr(sctx, paths, refs)
+	if err != nil {
+		return nil, err
+	}
+	o.rec = rec
+	return o, nil
+}
+
+func (o *StreamWindow) Pull(done bool) (vector.Any, error) {
+	if done {
+		o.reset()
+		return o.parent.Pull(true)
+	}
+	for len(o.out) == 0 {
+		if o.eos {
+			o.reset()
+			return nil, nil
+		}
+		vec, err := o.parent.Pull(false)
+		if err != nil {
+			o.reset()
+			return nil, err
+		}
+		if vec == nil {
+			o.eos = true
+			o.endPartitions()
+			o.emit()
+			continue
+		}
+		if vec.Len() == 0 {
+			continue
+		}
+		if err := o.append(vec); err != nil {
+			o.reset()
+			return nil, err
+		}
+		o.emit()
+		if o.buf.n > uint32(window.MaxPendingRows) {
+			o.reset()
+			return nil, fmt.Errorf("window: number of rows awaiting lead exceeds %d (sort the input by the first partition key)", window.MaxPendingRows)
+		}
+	}
+	vec := o.out[0]
+	o.out = o.out[1:]
+	return vec, nil
+}
+
+// append buffers vec along with the function arguments evaluated over it
+// and adds each of its rows to its partition.
+func (o *StreamWindow) append(vec vector.Any) error {
+	cols := []vector.Any{vec}
+	for _, f := range o.funcs {
+		switch {
+		case f.Agg != nil:
+			cols = append(cols, f.Agg.Eval(vec))
+		case f.Arg != nil:
+			cols = append(cols, f.Arg.Eval(vec))
+		}
+		if f.Default != nil {
+			cols = append(cols, f.Default.Eval(vec))
+		}
+	}
+	keyVecs := make([]vector.Any, len(o.keys))
+	for k, e := range o.keys {
+		keyVecs[k] = e.Eval(vec)
+	}
+	seq := o.seq + uint64(o.buf.n)
+	var b scode.Builder
+	var key []byte
+	for i := range vec.Len() {
+		key = key[:0]
+		for k, keyVec := range keyVecs {
+			b.Truncate()
+			key = window.AppendKey(key, vectorValue(&b, keyVec, i))
+			if k == 0 && o.sorted {
+				o.setSortKey(key)
+			}
+		}
+		p, ok := o.partitions[string(key)]
+		if !ok {
+			if len(o.partitions) >= window.MaxPartitions {
+				return fmt.Errorf("window: number of partitions exceeds %d (sort the input by the first partition key)", window.MaxPartitions)
+			}
+			p = &streamPartition{states: make([]streamState, len(o.funcs))}
+			o.partitions[string(key)] = p
+		}
+		o.rows = append(o.rows, streamRow{p, p.base + int64(len(p.seqs))})
+		p.seqs = append(p.seqs, seq+uint64(i))
+	}
+	o.buf.append(cols)
+	return nil
+}
+
+// setSortKey ends the current partitions when the first key changes.
+func (o *StreamWindow) setSortKey(key []byte) {
+	if o.sortKey != nil && string(o.sortKey) != string(key) {
+		o.endPartitions()
+	}
+	o.sortKey = append(o.sortKey[:0], key...)
+}
+
+// endPartitions ends all partitions so that the results of their rows are
+// known and rows added later begin new partitions.
+func (o *StreamWindow) endPartitions() {
+	for _, p := range o.partitions {
+		p.done = true
+	}
+	clear(o.partitions)
+}
+
+// resolved returns the number of leading buffered rows whose results are
+// known.
+func (o *StreamWindow) resolved() uint32 {
+	if o.maxLead == 0 {
+		return o.buf.n
+	}
+	for i, r := range o.rows {
+		if !r.p.done && r.pos+o.maxLead >= r.p.base+int64(len(r.p.seqs)) {
+			return uint32(i)
+		}
+	}
+	return o.buf.n
+}
+
+// emit computes the functions for the leading buffered rows whose results
+// are known, appends the vectors holding those rows with the function
+// results set to o.out, and removes the rows from the buffer.
+func (o *StreamWindow) emit() {
+	n := o.resolved()
+	if n == 0 {
+		return
+	}
+	results := make([]*windowResult, len(o.funcs))
+	for k, f := range o.funcs {
+		results[k] = newWindowResult()
+		for i := range n {
+			o.compute(f, k, i, results[k])
+		}
+	}
+	o.advance(n)
+	var lo uint32
+	for _, vec := range o.buf.split(n) {
+		hi := lo + vec.Len()
+		vecs := []vector.Any{vec}
+		for _, r := range results {
+			vecs = append(vecs, r.vector(lo, hi))
+		}
+		o.out = append(o.out, vector.Apply(false, o.put, vecs...))
+		lo = hi
+	}
+	o.rows = o.rows[n:]
+	o.seq += uint64(n)
+}
+
+// compute appends the result of f, the k-th function, for buffered row i
+// to r.
+func (o *StreamWindow) compute(f *StreamWindowFunc, k int, i uint32, r *windowResult) {
+	row := o.rows[i]
+	p := row.p
+	state := &p.states[k]
+	if f.Agg != nil {
+		if state.fn == nil {
+			state.fn = f.Agg.Pattern()
+		}
+		o.buf.each(f.col, i, i+1, func(vec vector.Any) {
+			vector.Apply(true, func(vecs ...vector.Any) vector.Any {
+				state.fn.Consume(vecs[0])
+				return vector.NewConst(super.Null, vecs[0].Len())
+			}, vec)
+		})
+		r.appendValue(state.fn.Result(o.sctx))
+		return
+	}
+	switch f.Name {
+	case "row_number":
+		r.appendInt(row.pos + 1)
+	case "lag", "lead":
+		j := row.pos - int64(f.Offset)
+		if f.Name == "lead" {
+			j = row.pos + int64(f.Offset)
+		}
+		end := p.base + int64(len(p.seqs))
+		switch {
+		case j < 0 || j >= end:
+			o.appendDefault(f, i, r)
+		case j >= p.base:
+			r.appendRef(o.buf.ref(f.col, o.index(p, j)))
+		default:
+			// A returned row's argument is in the ring.
+			r.appendValue(state.ring[j-p.base+int64(len(state.ring))])
+		}
+	case "first_value":
+		if state.first != nil {
+			r.appendValue(*state.first)
+		} else {
+			r.appendRef(o.buf.ref(f.col, o.index(p, 0)))
+		}
+	default:
+		panic(f.Name)
+	}
+}
+
+func (o *StreamWindow) appendDefault(f *StreamWindowFunc, i uint32, r *windowResult) {
+	if f.def < 0 {
+		r.appendValue(super.Null)
+		return
+	}
+	r.appendRef(o.buf.ref(f.def, i))
+}
+
+// index returns the buffer index of the row at position pos in p.
+func (o *StreamWindow) index(p *streamPartition, pos int64) uint32 {
+	return uint32(p.seqs[pos-p.base] - o.seq)
+}
+
+// advance removes the first n buffered rows from their partitions, saving
+// the argument values needed by lag and first_value for later rows.
+func (o *StreamWindow) advance(n uint32) {
+	var touched []*streamPartition
+	for _, row := range o.rows[:n] {
+		if row.p.emit == 0 {
+			touched = append(touched, row.p)
+		}
+		row.p.emit++
+	}
+	for _, p := range touched {
+		m := int64(p.emit)
+		for k, f := range o.funcs {
+			state := &p.states[k]
+			switch {
+			case f.Agg != nil:
+			case f.Name == "lag" && f.Offset > 0:
+				for pos := max(p.base, p.base+m-int64(f.Offset)); pos < p.base+m; pos++ {
+					state.ring = append(state.ring, o.buf.valueAt(f.col, o.index(p, pos)))
+				}
+				if len(state.ring) > f.Offset {
+					state.ring = state.ring[len(state.ring)-f.Offset:]
+				}
+			case f.Name == "first_value" && state.first == nil:
+				first := o.buf.valueAt(f.col, o.index(p, p.base))
+				state.first = &first
+			}
+		}
+		p.seqs = p.seqs[m:]
+		p.base += m
+		p.emit = 0
+	}
+}
+
+func (o *StreamWindow) put(vecs ...vector.Any) vector.Any {
+	vec := vecs[0]
+	if k := vec.Type().Kind(); k != super.RecordKind {
+		if k == super.ErrorKind {
+			return vec
+		}
+		return vector.NewWrappedError(o.sctx, "put: not a record", vec)
+	}
+	o.results = vecs[1:]
+	return o.rec.Eval(vec)
+}
+
+func (o *StreamWindow) reset() {
+	o.buf.reset()
+	clear(o.partitions)
+	o.sortKey = nil
+	o.rows = nil
+	o.seq = 0
+	o.out = nil
+	o.eos = false
+}
+
+// streamWindowRef is an evaluator for the results of a function for the
+// vector being evaluated.
+type streamWindowRef struct {
+	op *StreamWindow
+	k  int
+}
+
+func (w *streamWindowRef) Eval(vector.Any) vector.Any {
+	return w.op.results[w.k]
+}
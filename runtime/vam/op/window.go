@@ -0,0 +1,608 @@
+package op
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	samexpr "github.com/brimdata/super/runtime/sam/expr"
+	"github.com/brimdata/super/runtime/sam/expr/coerce"
+	"github.com/brimdata/super/runtime/sam/op/window"
+	"github.com/brimdata/super/runtime/vam/expr"
+	"github.com/brimdata/super/runtime/vam/expr/agg"
+	"github.com/brimdata/super/vector"
+)
+
+// Window computes window functions over partitions of its input and sets
+// the field for each function in each value to the function's result.  The
+// input must be sorted by the partition keys and then by the order keys.
+// Window buffers its input until a partition ends, evaluating the keys and
+// function arguments over whole vectors, and builds the results of each
+// function as a vector that is computed natively or picked from the
+// argument vectors.
+type Window struct {
+	sctx        *super.Context
+	parent      vector.Puller
+	partitionBy []expr.Evaluator
+	orderBy     []expr.SortExpr
+	funcs       []*WindowFunc
+	rec         expr.Evaluator
+	compareFn   samexpr.CompareFn
+
+	buf   windowBuffer
+	pkeys []*sortKeys
+	okeys []*sortKeys
+	// scanned is the number of buffered rows searched for the start of a
+	// partition, and last is the start of the last partition found.
+	scanned uint32
+	last    uint32
+	out     []vector.Any
+	eos     bool
+
+	// results holds the result vectors of the functions for the vector
+	// being evaluated by rec, which are read via windowRef.
+	results []vector.Any
+}
+
+// WindowFunc is a window function computed by Window.  Its fields are as in
+// the sequential runtime's window.Func.
+type WindowFunc struct {
+	Name  string
+	Args  []expr.Evaluator
+	Agg   *expr.Aggregator
+	Frame *window.Frame
+
+	sctx *super.Context
+	// col is the buffer column of the function's first argument.
+	col int
+	// rangeKeys holds the order key used to compute RANGE frames with
+	// offsets, and rangeDir is 1 when it's ascending or -1 when it's
+	// descending.
+	rangeKeys *sortKeys
+	rangeDir  float64
+	// keys holds the numeric values of the order key for the current
+	// partition where rows [keysLo, keysHi) have numeric keys.
+	keys           []float64
+	keysLo, keysHi int
+}
+
+// NewWindow returns a window operator that computes funcs over the
+// partitions of parent defined by the partitionBy expressions where the
+// rows of each partition are ordered by orderBy.  The result of funcs[k] is
+// stored in the field at paths[k].
+func NewWindow(sctx *super.Context, parent vector.Puller, partitionBy []expr.Evaluator, orderBy []expr.SortExpr, paths []field.Path, funcs []*WindowFunc) (*Window, error) {
+	o := &Window{
+		sctx:        sctx,
+		parent:      parent,
+		partitionBy: partitionBy,
+		orderBy:     orderBy,
+		funcs:       funcs,
+		compareFn:   samexpr.NewValueCompareFn(order.Asc, order.NullsLast),
+	}
+	for range partitionBy {
+		o.pkeys = append(o.pkeys, &sortKeys{})
+	}
+	for range orderBy {
+		o.okeys = append(o.okeys, &sortKeys{})
+	}
+	col := 1
+	var refs []expr.Evaluator
+	for k, f := range funcs {
+		if err := f.init(sctx, orderBy, o.okeys, col); err != nil {
+			return nil, err
+		}
+		col += f.ncols()
+		refs = append(refs, &windowRef{o, k})
+	}
+	rec, err := newWindowRecordExpr(sctx, paths, refs)
+	if err != nil {
+		return nil, err
+	}
+	o.rec = rec
+	return o, nil
+}
+
+func (o *Window) Pull(done bool) (vector.Any, error) {
+	if done {
+		o.reset()
+		return o.parent.Pull(true)
+	}
+	for len(o.out) == 0 {
+		if o.eos {
+			o.reset()
+			return nil, nil
+		}
+		vec, err := o.parent.Pull(false)
+		if err != nil {
+			o.reset()
+			return nil, err
+		}
+		if vec == nil {
+			o.eos = true
+			o.flush(o.buf.n)
+			continue
+		}
+		if vec.Len() == 0 {
+			continue
+		}
+		o.append(vec)
+		if start := o.lastPartition(); start > 0 {
+			o.flush(start)
+		}
+	}
+	vec := o.out[0]
+	o.out = o.out[1:]
+	return vec, nil
+}
+
+func (o *Window) append(vec vector.Any) {
+	cols := []vector.Any{vec}
+	for _, f := range o.funcs {
+		if f.Agg != nil {
+			cols = append(cols, f.Agg.Eval(vec))
+			continue
+		}
+		for _, e := range f.Args {
+			cols = append(cols, e.Eval(vec))
+		}
+	}
+	o.buf.append(cols)
+	for k, e := range o.partitionBy {
+		o.pkeys[k].append(e.Eval(vec), nil)
+	}
+	for k, e := range o.orderBy {
+		o.okeys[k].append(e.Eval(vec), nil)
+	}
+}
+
+// lastPartition returns the first row of the last partition in the buffer,
+// which is zero while the buffer holds a single partition.
+func (o *Window) lastPartition() uint32 {
+	for i := max(o.scanned, 1); i < o.buf.n; i++ {
+		if !o.equal(o.pkeys, i-1, i) {
+			o.last = i
+		}
+	}
+	o.scanned = o.buf.n
+	return o.last
+}
+
+// equal returns true if the keys at rows i and j are equal.
+func (o *Window) equal(keys []*sortKeys, i, j uint32) bool {
+	for _, k := range keys {
+		if k.compare(i, j, true, o.compareFn) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// flush computes the functions over the partitions in the first n buffered
+// rows, appends the vectors holding those rows with the function results
+// set to o.out, and removes the rows from the buffer.
+func (o *Window) flush(n uint32) {
+	if n == 0 {
+		return
+	}
+	results := make([]*windowResult, len(o.funcs))
+	for k := range results {
+		results[k] = newWindowResult()
+	}
+	for lo := uint32(0); lo < n; {
+		hi := lo + 1
+		for hi < n && o.equal(o.pkeys, hi-1, hi) {
+			hi++
+		}
+		p := o.newPartition(lo, hi)
+		for k, f := range o.funcs {
+			f.compute(&o.buf, p, results[k])
+		}
+		lo = hi
+	}
+	var lo uint32
+	for _, vec := range o.buf.split(n) {
+		hi := lo + vec.Len()
+		vecs := []vector.Any{vec}
+		for _, r := range results {
+			vecs = append(vecs, r.vector(lo, hi))
+		}
+		o.out = append(o.out, vector.Apply(false, o.put, vecs...))
+		lo = hi
+	}
+	o.pkeys = dropKeys(o.pkeys, n)
+	o.okeys = dropKeys(o.okeys, n)
+	for _, f := range o.funcs {
+		if f.rangeKeys != nil {
+			f.rangeKeys = o.okeys[0]
+		}
+	}
+	o.scanned -= n
+	o.last = 0
+}
+
+// dropKeys returns keys without their first n rows.
+func dropKeys(keys []*sortKeys, n uint32) []*sortKeys {
+	out := make([]*sortKeys, len(keys))
+	for k, from := range keys {
+		out[k] = &sortKeys{}
+		for i := n; i < uint32(from.len()); i++ {
+			out[k].appendFrom(from, i)
+		}
+	}
+	return out
+}
+
+func (o *Window) put(vecs ...vector.Any) vector.Any {
+	vec := vecs[0]
+	if k := vec.Type().Kind(); k != super.RecordKind {
+		if k == super.ErrorKind {
+			return vec
+		}
+		return vector.NewWrappedError(o.sctx, "put: not a record", vec)
+	}
+	o.results = vecs[1:]
+	return o.rec.Eval(vec)
+}
+
+func (o *Window) reset() {
+	o.buf.reset()
+	for _, k := range o.pkeys {
+		k.reset()
+	}
+	for _, k := range o.okeys {
+		k.reset()
+	}
+	o.scanned = 0
+	o.last = 0
+	o.out = nil
+	o.eos = false
+}
+
+// windowPartition is a partition of the buffered rows [lo, lo+n) along with
+// the bounds of the peer group of each row, i.e., the rows that are equal
+// in window order.  The bounds are relative to lo.
+type windowPartition struct {
+	lo        uint32
+	n         int
+	peerStart []int
+	peerEnd   []int
+}
+
+func (o *Window) newPartition(lo, hi uint32) *windowPartition {
+	n := int(hi - lo)
+	p := &windowPartition{
+		lo:        lo,
+		n:         n,
+		peerStart: make([]int, n),
+		peerEnd:   make([]int, n),
+	}
+	// Without ORDER BY, all rows of the partition are peers.
+	for start := 0; start < n; {
+		end := n
+		if len(o.okeys) > 0 {
+			end = start + 1
+			for end < n && o.equal(o.okeys, lo+uint32(end-1), lo+uint32(end)) {
+				end++
+			}
+		}
+		for i := start; i < end; i++ {
+			p.peerStart[i] = start
+			p.peerEnd[i] = end
+		}
+		start = end
+	}
+	return p
+}
+
+func (f *WindowFunc) init(sctx *super.Context, orderBy []expr.SortExpr, okeys []*sortKeys, col int) error {
+	f.sctx = sctx
+	f.col = col
+	if f.Agg == nil {
+		if err := window.CheckArgCount(f.Name, len(f.Args)); err != nil {
+			return err
+		}
+	}
+	if f.Frame != nil && f.Frame.HasRangeOffset() {
+		if len(orderBy) != 1 {
+			return errors.New("window: RANGE with offset requires exactly one ORDER BY expression")
+		}
+		f.rangeKeys = okeys[0]
+		f.rangeDir = 1
+		if orderBy[0].Order == order.Desc {
+			f.rangeDir = -1
+		}
+	}
+	return nil
+}
+
+// ncols returns the number of buffer columns holding the arguments of f.
+func (f *WindowFunc) ncols() int {
+	if f.Agg != nil {
+		return 1
+	}
+	return len(f.Args)
+}
+
+// compute appends the results of f for the rows of p to r.
+func (f *WindowFunc) compute(buf *windowBuffer, p *windowPartition, r *windowResult) {
+	if f.rangeKeys != nil {
+		f.loadKeys(p)
+	}
+	if f.Agg != nil {
+		f.computeAgg(buf, p, r)
+		return
+	}
+	n := p.n
+	switch f.Name {
+	case "row_number":
+		for i := range n {
+			r.appendInt(int64(i + 1))
+		}
+	case "rank":
+		for i := range n {
+			r.appendInt(int64(p.peerStart[i] + 1))
+		}
+	case "dense_rank":
+		var rank int64
+		for i := range n {
+			if p.peerStart[i] == i {
+				rank++
+			}
+			r.appendInt(rank)
+		}
+	case "percent_rank":
+		for i := range n {
+			var v float64
+			if n > 1 {
+				v = float64(p.peerStart[i]) / float64(n-1)
+			}
+			r.appendFloat(v)
+		}
+	case "cume_dist":
+		for i := range n {
+			r.appendFloat(float64(p.peerEnd[i]) / float64(n))
+		}
+	case "ntile":
+		f.ntile(buf, p, r)
+	case "lag", "lead":
+		dir := -1
+		if f.Name == "lead" {
+			dir = 1
+		}
+		f.shift(buf, p, dir, r)
+	case "first_value", "last_value", "nth_value":
+		f.nth(buf, p, r)
+	default:
+		panic(f.Name)
+	}
+}
+
+func (f *WindowFunc) ntile(buf *windowBuffer, p *windowPartition, r *windowResult) {
+	n := p.n
+	buckets, ok := window.NonNegativeInt(buf.valueAt(f.col, p.lo))
+	if !ok || buckets == 0 {
+		err := f.sctx.NewErrorf("ntile: argument must be a positive integer")
+		r.appendValue(err)
+		for range n - 1 {
+			r.appendLast()
+		}
+		return
+	}
+	// The first n%buckets buckets have one more row than the others.
+	size, extra := n/int(buckets), n%int(buckets)
+	for b := range int(buckets) {
+		m := size
+		if b < extra {
+			m++
+		}
+		for range m {
+			r.appendInt(int64(b + 1))
+		}
+	}
+}
+
+func (f *WindowFunc) shift(buf *windowBuffer, p *windowPartition, dir int, r *windowResult) {
+	for i := range p.n {
+		row := p.lo + uint32(i)
+		offset := int64(1)
+		if len(f.Args) > 1 {
+			var ok bool
+			if offset, ok = window.NonNegativeInt(buf.valueAt(f.col+1, row)); !ok {
+				r.appendValue(f.sctx.NewErrorf("%s: offset must be a non-negative integer", f.Name))
+				continue
+			}
+		}
+		j := int64(i) + int64(dir)*offset
+		switch {
+		case j >= 0 && j < int64(p.n):
+			r.appendRef(buf.ref(f.col, p.lo+uint32(j)))
+		case len(f.Args) > 2:
+			r.appendRef(buf.ref(f.col+2, row))
+		default:
+			r.appendValue(super.Null)
+		}
+	}
+}
+
+func (f *WindowFunc) nth(buf *windowBuffer, p *windowPartition, r *windowResult) {
+	for i := range p.n {
+		lo, hi := f.frame(p, i)
+		var j int
+		switch f.Name {
+		case "first_value":
+			j = lo
+		case "last_value":
+			j = hi - 1
+		case "nth_value":
+			n, ok := window.NonNegativeInt(buf.valueAt(f.col+1, p.lo+uint32(i)))
+			if !ok || n == 0 {
+				r.appendValue(f.sctx.NewErrorf("nth_value: argument must be a positive integer"))
+				continue
+			}
+			j = lo + int(n) - 1
+		}
+		if j >= lo && j < hi {
+			r.appendRef(buf.ref(f.col, p.lo+uint32(j)))
+		} else {
+			r.appendValue(super.Null)
+		}
+	}
+}
+
+func (f *WindowFunc) computeAgg(buf *windowBuffer, p *windowPartition, r *windowResult) {
+	if f.Frame == nil || f.Frame.Start.Type == window.UnboundedPreceding {
+		// The frame of each row includes the frame of the previous row
+		// so the aggregate is computed incrementally.
+		fn := f.Agg.Pattern()
+		next := -1
+		for i := range p.n {
+			_, hi := f.frame(p, i)
+			if hi <= next {
+				r.appendLast()
+				continue
+			}
+			f.consume(buf, fn, p.lo+uint32(max(next, 0)), p.lo+uint32(max(hi, 0)))
+			r.appendValue(fn.Result(f.sctx))
+			next = hi
+		}
+		return
+	}
+	prevLo, prevHi := -1, -1
+	for i := range p.n {
+		lo, hi := f.frame(p, i)
+		if lo == prevLo && hi == prevHi {
+			r.appendLast()
+			continue
+		}
+		fn := f.Agg.Pattern()
+		f.consume(buf, fn, p.lo+uint32(lo), p.lo+uint32(max(lo, hi)))
+		r.appendValue(fn.Result(f.sctx))
+		prevLo, prevHi = lo, hi
+	}
+}
+
+// consume consumes the argument of f for rows [lo, hi) into fn.
+func (f *WindowFunc) consume(buf *windowBuffer, fn agg.Func, lo, hi uint32) {
+	buf.each(f.col, lo, hi, func(vec vector.Any) {
+		vector.Apply(true, func(vecs ...vector.Any) vector.Any {
+			fn.Consume(vecs[0])
+			return vector.NewConst(super.Null, vecs[0].Len())
+		}, vec)
+	})
+}
+
+// frame returns the bounds [lo, hi) of the frame of row i.  The frame is
+// empty when hi <= lo.
+func (f *WindowFunc) frame(p *windowPartition, i int) (int, int) {
+	n := p.n
+	if f.Frame == nil {
+		return 0, n
+	}
+	lo := f.bound(p, i, f.Frame.Start, true)
+	hi := f.bound(p, i, f.Frame.End, false)
+	return min(max(lo, 0), n), min(max(hi, 0), n)
+}
+
+func (f *WindowFunc) bound(p *windowPartition, i int, b window.Bound, start bool) int {
+	switch b.Type {
+	case window.UnboundedPreceding:
+		return 0
+	case window.UnboundedFollowing:
+		return p.n
+	case window.CurrentRow:
+		if f.Frame.Unit == window.Range {
+			if start {
+				return p.peerStart[i]
+			}
+			return p.peerEnd[i]
+		}
+		if start {
+			return i
+		}
+		return i + 1
+	}
+	if f.Frame.Unit == window.Range {
+		return f.rangeBound(p, i, b, start)
+	}
+	offset := int(coerce.ToNumeric[int64](b.Offset))
+	if b.Type == window.Preceding {
+		offset = -offset
+	}
+	if start {
+		return i + offset
+	}
+	return i + offset + 1
+}
+
+// loadKeys loads the numeric values of the order key of p's rows for
+// computing RANGE frames.  Nulls sort first or last so the rows with
+// numeric keys are assumed to be contiguous.
+func (f *WindowFunc) loadKeys(p *windowPartition) {
+	f.keys = f.keys[:0]
+	f.keysLo, f.keysHi = p.n, p.n
+	for i := range p.n {
+		row := p.lo + uint32(i)
+		var key float64
+		switch f.rangeKeys.kinds[row] {
+		case keyInt, keyUint, keyFloat:
+			key = f.rangeKeys.toFloat(row)
+			if f.keysLo == p.n {
+				f.keysLo = i
+			}
+			f.keysHi = i + 1
+		}
+		f.keys = append(f.keys, key)
+	}
+}
+
+// rangeBound returns the bound of a RANGE frame with an offset, which
+// includes the rows whose order key is within the offset of the order key
+// of row i.  A row without a numeric order key has only its peers in its
+// frame and is not in the frame of any other row.
+func (f *WindowFunc) rangeBound(p *windowPartition, i int, b window.Bound, start bool) int {
+	lo, hi := f.keysLo, f.keysHi
+	if i < lo || i >= hi {
+		if start {
+			return p.peerStart[i]
+		}
+		return p.peerEnd[i]
+	}
+	offset, _ := coerce.ToFloat(b.Offset, super.TypeFloat64)
+	if b.Type == window.Preceding {
+		offset = -offset
+	}
+	// The distance from the key of row i is nondecreasing in window order.
+	key := f.keys[i]
+	dist := func(j int) float64 {
+		return (f.keys[lo+j] - key) * f.rangeDir
+	}
+	if start {
+		return lo + sort.Search(hi-lo, func(j int) bool { return dist(j) >= offset })
+	}
+	return lo + sort.Search(hi-lo, func(j int) bool { return dist(j) > offset })
+}
+
+// windowRef is an evaluator for the results of a function for the vector
+// being evaluated.
+type windowRef struct {
+	op *Window
+	k  int
+}
+
+func (w *windowRef) Eval(vector.Any) vector.Any {
+	return w.op.results[w.k]
+}
+
+// newWindowRecordExpr returns an evaluator that spreads the record at prefix
+// and sets the fields at paths relative to prefix to the values of exprs.
+func newWindowRecordExpr(sctx *super.Context, paths []field.Path, exprs []expr.Evaluator) (expr.Evaluator, error) {
+	return window.RecordExpr(nil, paths, exprs, func(prefix field.Path, names []string, fields []expr.Evaluator) (expr.Evaluator, error) {
+		elems := []expr.RecordElem{{Expr: expr.NewDottedExpr(sctx, prefix)}}
+		for k, name := range names {
+			elems = append(elems, expr.RecordElem{Name: name, Expr: fields[k]})
+		}
+		return expr.NewRecordExpr(sctx, elems), nil
+	})
+}
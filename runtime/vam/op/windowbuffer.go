@@ -0,0 +1,286 @@
+package op
+
+import (
+	"sort"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+// windowBuffer holds the input vectors of a window operator in input order
+// along with vectors of expressions evaluated over them.  vecs[b][0] is the
+// b-th buffered input vector and vecs[b][c] for c > 0, which may be nil, is
+// the c-th column evaluated over it.  Rows are numbered from zero across all
+// buffered vectors.
+type windowBuffer struct {
+	vecs   [][]vector.Any
+	starts []uint32
+	n      uint32
+}
+
+func (w *windowBuffer) append(cols []vector.Any) {
+	w.vecs = append(w.vecs, cols)
+	w.starts = append(w.starts, w.n)
+	w.n += cols[0].Len()
+}
+
+// locate returns the index of the buffered vector holding row i and the
+// slot of row i in that vector.
+func (w *windowBuffer) locate(i uint32) (int, uint32) {
+	b := sort.Search(len(w.starts), func(j int) bool { return w.starts[j] > i }) - 1
+	return b, i - w.starts[b]
+}
+
+// ref returns the vector of column c holding row i and the slot of row i in
+// that vector.
+func (w *windowBuffer) ref(c int, i uint32) (vector.Any, uint32) {
+	b, slot := w.locate(i)
+	return w.vecs[b][c], slot
+}
+
+func (w *windowBuffer) valueAt(c int, i uint32) super.Value {
+	return valueAt(w.ref(c, i))
+}
+
+// each calls fn with the values of column c for rows [lo, hi), one vector
+// for each buffered vector holding those rows.
+func (w *windowBuffer) each(c int, lo, hi uint32, fn func(vector.Any)) {
+	for lo < hi {
+		b, slot := w.locate(lo)
+		vec := w.vecs[b][c]
+		end := min(vec.Len(), slot+hi-lo)
+		switch {
+		case end == slot+1:
+			// A single row, as when computing a running aggregate,
+			// is picked from its leaf to avoid picking every value
+			// vector of a dynamic.
+			leaf, leafSlot := leafOf(vec, slot)
+			vec = vector.Pick(leaf, []uint32{leafSlot})
+		case slot > 0 || end < vec.Len():
+			vec = vector.Pick(vec, spanIndex(slot, end))
+		}
+		fn(vec)
+		lo += end - slot
+	}
+}
+
+// leafOf returns the vector holding the value of vec at slot, which is
+// vec unless vec is a dynamic, and the slot of the value in that vector.
+func leafOf(vec vector.Any, slot uint32) (vector.Any, uint32) {
+	for {
+		d, ok := vec.(*vector.Dynamic)
+		if !ok {
+			return vec, slot
+		}
+		vec, slot = d.Values[d.Tags[slot]], d.ForwardTagMap()[slot]
+	}
+}
+
+// split removes the first n rows from the buffer and returns the input
+// vectors holding them.  A buffered vector holding rows on both sides of
+// the split is divided in two.
+func (w *windowBuffer) split(n uint32) []vector.Any {
+	var out []vector.Any
+	for len(w.vecs) > 0 && w.starts[0] < n {
+		cols := w.vecs[0]
+		m := n - w.starts[0]
+		if vlen := cols[0].Len(); m < vlen {
+			out = append(out, vector.Pick(cols[0], spanIndex(0, m)))
+			rest := make([]vector.Any, len(cols))
+			for c, vec := range cols {
+				if vec != nil {
+					rest[c] = vector.Pick(vec, spanIndex(m, vlen))
+				}
+			}
+			w.vecs[0] = rest
+			w.starts[0] = n
+			break
+		}
+		out = append(out, cols[0])
+		w.vecs = w.vecs[1:]
+		w.starts = w.starts[1:]
+	}
+	for b := range w.starts {
+		w.starts[b] -= n
+	}
+	w.n -= n
+	return out
+}
+
+func (w *windowBuffer) reset() {
+	w.vecs = nil
+	w.starts = nil
+	w.n = 0
+}
+
+// spanIndex returns the index of slots [lo, hi).
+func spanIndex(lo, hi uint32) []uint32 {
+	index := make([]uint32, hi-lo)
+	for k := range index {
+		index[k] = lo + uint32(k)
+	}
+	return index
+}
+
+// Leaves of a windowResult holding its computed values.
+const (
+	resultInts = iota
+	resultFloats
+	resultVals
+	resultLeaves
+)
+
+// windowResult holds the results of a window function for a sequence of
+// rows.  Each result is a reference to a slot of a leaf vector from which
+// the vector of results is picked.  Integer and float results are computed
+// into native leaves, other computed results are built into a dynamic
+// leaf, and results that are values of the function's arguments, like
+// those of lag or first_value, refer to the argument vectors without
+// copying their values.
+type windowResult struct {
+	leaves []vector.Any
+	leafOf map[vector.Any]uint32
+	tags   []uint32
+	slots  []uint32
+	ints   []int64
+	floats []float64
+	vals   *vector.DynamicBuilder
+	nvals  uint32
+	built  bool
+}
+
+func newWindowResult() *windowResult {
+	return &windowResult{
+		leaves: make([]vector.Any, resultLeaves),
+		leafOf: make(map[vector.Any]uint32),
+		vals:   vector.NewDynamicBuilder(),
+	}
+}
+
+func (r *windowResult) appendInt(v int64) {
+	r.add(resultInts, uint32(len(r.ints)))
+	r.ints = append(r.ints, v)
+}
+
+func (r *windowResult) appendFloat(v float64) {
+	r.add(resultFloats, uint32(len(r.floats)))
+	r.floats = append(r.floats, v)
+}
+
+func (r *windowResult) appendValue(val super.Value) {
+	r.add(resultVals, r.nvals)
+	r.vals.Write(val)
+	r.nvals++
+}
+
+// appendRef appends the value of vec at slot.
+func (r *windowResult) appendRef(vec vector.Any, slot uint32) {
+	vec, slot = leafOf(vec, slot)
+	leaf, ok := r.leafOf[vec]
+	if !ok {
+		leaf = uint32(len(r.leaves))
+		r.leaves = append(r.leaves, vec)
+		r.leafOf[vec] = leaf
+	}
+	r.add(leaf, slot)
+}
+
+// appendLast appends the last result again.
+func (r *windowResult) appendLast() {
+	n := len(r.tags) - 1
+	r.add(r.tags[n], r.slots[n])
+}
+
+func (r *windowResult) add(leaf, slot uint32) {
+	r.tags = append(r.tags, leaf)
+	r.slots = append(r.slots, slot)
+}
+
+// build builds the leaves holding computed results once all results are
+// appended.
+func (r *windowResult) build() {
+	r.built = true
+	r.leaves[resultInts] = vector.NewInt(super.TypeInt64, r.ints)
+	r.leaves[resultFloats] = vector.NewFloat(super.TypeFloat64, r.floats)
+	vals := r.vals.Build()
+	d, ok := vals.(*vector.Dynamic)
+	if !ok {
+		r.leaves[resultVals] = vals
+		return
+	}
+	// Replace the references to a dynamic with references to its values.
+	base := uint32(len(r.leaves))
+	r.leaves = append(r.leaves, d.Values...)
+	forward := d.ForwardTagMap()
+	for k, leaf := range r.tags {
+		if leaf == resultVals {
+			slot := r.slots[k]
+			r.tags[k] = base + d.Tags[slot]
+			r.slots[k] = forward[slot]
+		}
+	}
+}
+
+// vector returns the vector of results [lo, hi).  Results are picked from
+// their leaves except that the values of leaves sharing a type are copied
+// into a single vector, so the result has one vector for each type like a
+// vector built by a DynamicBuilder.  Otherwise, the vectors operators
+// derive from the result would fragment further with each window.
+func (r *windowResult) vector(lo, hi uint32) vector.Any {
+	if !r.built {
+		r.build()
+	}
+	tags := r.tags[lo:hi]
+	slots := r.slots[lo:hi]
+	used := make([]bool, len(r.leaves))
+	ntypes := make(map[super.Type]int)
+	for _, leaf := range tags {
+		if !used[leaf] {
+			used[leaf] = true
+			ntypes[r.leaves[leaf].Type()]++
+		}
+	}
+	var views []vector.Any
+	indexes := make([][]uint32, len(r.leaves))
+	viewOfLeaf := make([]uint32, len(r.leaves))
+	builders := make(map[super.Type]vector.Builder)
+	viewOfType := make(map[super.Type]uint32)
+	viewTags := make([]uint32, len(tags))
+	var b scode.Builder
+	for k, leaf := range tags {
+		vec := r.leaves[leaf]
+		if typ := vec.Type(); ntypes[typ] > 1 {
+			builder, ok := builders[typ]
+			if !ok {
+				builder = vector.NewBuilder(typ)
+				builders[typ] = builder
+				viewOfType[typ] = uint32(len(views))
+				views = append(views, nil)
+			}
+			b.Truncate()
+			vec.Serialize(&b, slots[k])
+			builder.Write(b.Bytes().Body())
+			viewTags[k] = viewOfType[typ]
+			continue
+		}
+		if indexes[leaf] == nil {
+			viewOfLeaf[leaf] = uint32(len(views))
+			views = append(views, nil)
+		}
+		indexes[leaf] = append(indexes[leaf], slots[k])
+		viewTags[k] = viewOfLeaf[leaf]
+	}
+	for leaf, index := range indexes {
+		if index != nil {
+			views[viewOfLeaf[leaf]] = vector.Pick(r.leaves[leaf], index)
+		}
+	}
+	for typ, builder := range builders {
+		views[viewOfType[typ]] = builder.Build()
+	}
+	if len(views) == 1 {
+		return views[0]
+	}
+	return vector.NewDynamic(viewTags, views)
+}
@@ -37,6 +37,7 @@ func (a *array) project(loader *loader, projection field.Projection) vector.Any
 	vec := a.values.project(loader, nil)
 	typ := loader.sctx.LookupTypeArray(vec.Type())
 	offs := a.load(loader)
+	loader.use(a, sizeOfUint32s(offs))
 	return vector.NewArray(typ, offs, vec)
 }
 
@@ -53,3 +54,9 @@ func (a *array) load(loader *loader) []uint32 {
 	a.offs = offs
 	return offs
 }
+
+func (a *array) evict() {
+	a.mu.Lock()
+	a.offs = nil
+	a.mu.Unlock()
+}
@@ -31,6 +31,8 @@ func (b *bytes) project(loader *loader, projection field.Projection) vector.Any
 		return vector.NewMissing(loader.sctx, b.length())
 	}
 	table := b.load(loader)
+	offs, bytes := table.Slices()
+	loader.use(b, sizeOfUint32s(offs)+int64(len(bytes)))
 	switch b.meta.Typ.ID() {
 	case super.IDString:
 		return vector.NewString(table)
@@ -61,3 +63,9 @@ func (b *bytes) load(loader *loader) vector.BytesTable {
 	b.table = &table
 	return table
 }
+
+func (b *bytes) evict() {
+	b.mu.Lock()
+	b.table = nil
+	b.mu.Unlock()
+}
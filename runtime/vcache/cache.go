@@ -1,73 +1,311 @@
 package vcache
 
 import (
+	"container/list"
 	"context"
 	"sync"
+	"time"
 
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/segmentio/ksuid"
 )
 
+const (
+	DefaultMaxBytes    = 1024 * 1024 * 1024
+	DefaultIdleTimeout = time.Minute
+)
+
+type Config struct {
+	// MaxBytes is the budget for the metadata and vector data of cached
+	// objects.  When it is exceeded, the least recently used vectors and
+	// objects that are not pinned are evicted.  Pinned objects and their
+	// vectors count against the budget, so it is exceeded only while they
+	// alone exceed it.  Zero selects DefaultMaxBytes.
+	MaxBytes int64
+	// IdleTimeout is how long the storage reader of a cached object may
+	// go unused before it is closed.  A closed reader is reopened when
+	// vector data that is not resident is needed.  Zero selects
+	// DefaultIdleTimeout.
+	IdleTimeout time.Duration
+}
+
+// Cache holds CSUP objects and the vectors loaded from them in memory
+// within a byte budget.  Objects and the vectors within them are kept on a
+// single LRU list and are evicted from its tail when the budget is
+// exceeded.  Evicting a vector does not affect vectors already returned by
+// Object.Fetch, which hold their data until they are garbage collected, so
+// a consumer of those vectors keeps their object pinned until it releases
+// them in order for their data to remain counted against the budget.
 type Cache struct {
-	mu     sync.Mutex
-	engine storage.Engine
-	// objects is currently a simple map but we will turn this into an
-	// LRU cache sometime soon.  First step is object-level granularity, though
-	// we might want LRU inside of objects based on vectors.  We can do that
-	// later if measurements warrant it.  XXX note that we keep the storage
-	// reader open for every object and never close it.  We should timeout
-	// files and close them and then reopen them when needed to access
-	// vectors that haven't yet been loaded.
+	mu      sync.Mutex
+	engine  storage.Engine
+	conf    Config
+	metrics metrics
 	objects map[ksuid.KSUID]*Object
-	locks   map[ksuid.KSUID]*sync.Mutex
+	locks   map[ksuid.KSUID]*objectLock
+	// lru holds an *entry for each resident object and vector with the
+	// most recently used at the front.
+	lru   *list.List
+	bytes int64
+	// open holds the objects whose storage readers may be open.
+	open  map[*Object]struct{}
+	sweep *time.Timer
+}
+
+// entry is an element of the LRU list.  It is an object's metadata and
+// storage reader if vec is nil and one of the object's vectors otherwise.
+type entry struct {
+	object *Object
+	vec    evicter
+	size   int64
+}
+
+// evicter is implemented by the shadows that hold vector data.
+type evicter interface {
+	// evict drops the vector data so it is reloaded when next needed.
+	evict()
 }
 
 func NewCache(engine storage.Engine) *Cache {
-	return &Cache{
+	c := &Cache{
 		engine:  engine,
 		objects: make(map[ksuid.KSUID]*Object),
-		locks:   make(map[ksuid.KSUID]*sync.Mutex),
+		locks:   make(map[ksuid.KSUID]*objectLock),
+		lru:     list.New(),
+		open:    make(map[*Object]struct{}),
 	}
+	c.metrics = newMetrics(c)
+	c.Configure(Config{})
+	return c
 }
 
-func (c *Cache) lock(id ksuid.KSUID) {
+// Configure changes the budget and idle timeout of the cache.  Zero values
+// in conf select the defaults.
+func (c *Cache) Configure(conf Config) {
+	if conf.MaxBytes <= 0 {
+		conf.MaxBytes = DefaultMaxBytes
+	}
+	if conf.IdleTimeout <= 0 {
+		conf.IdleTimeout = DefaultIdleTimeout
+	}
+	c.mu.Lock()
+	c.conf = conf
+	c.mu.Unlock()
+	c.shrink()
+}
+
+// Bytes returns the number of bytes resident in the cache.
+func (c *Cache) Bytes() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bytes
+}
+
+// PinnedBytes returns the number of bytes resident in the cache for pinned
+// objects and their vectors.
+func (c *Cache) PinnedBytes() int64 {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	mu, ok := c.locks[id]
+	var n int64
+	for _, object := range c.objects {
+		if object.pins == 0 {
+			continue
+		}
+		n += object.elem.Value.(*entry).size
+		for _, elem := range object.vecs {
+			n += elem.Value.(*entry).size
+		}
+	}
+	return n
+}
+
+// objectLock serializes the reading of an object's metadata.  It is
+// removed from Cache.locks when no Fetch holds or awaits it.
+type objectLock struct {
+	sync.Mutex
+	refs int
+}
+
+func (c *Cache) lock(id ksuid.KSUID) {
+	c.mu.Lock()
+	l, ok := c.locks[id]
 	if !ok {
-		mu = &sync.Mutex{}
-		c.locks[id] = mu
+		l = &objectLock{}
+		c.locks[id] = l
 	}
-	mu.Lock()
+	l.refs++
+	c.mu.Unlock()
+	l.Lock()
 }
 
 func (c *Cache) unlock(id ksuid.KSUID) {
 	c.mu.Lock()
-	c.locks[id].Unlock()
-	c.mu.Unlock()
+	defer c.mu.Unlock()
+	l := c.locks[id]
+	l.Unlock()
+	if l.refs--; l.refs == 0 {
+		delete(c.locks, id)
+	}
 }
 
+// Fetch returns the object with the given ID, reading its metadata from
+// uri if it is not in the cache.  The object is pinned so that neither it
+// nor its vectors are evicted until the caller calls Unpin.
 func (c *Cache) Fetch(ctx context.Context, uri *storage.URI, id ksuid.KSUID) (*Object, error) {
-	c.mu.Lock()
-	object, ok := c.objects[id]
-	c.mu.Unlock()
-	if ok {
+	if object := c.pin(id); object != nil {
+		c.metrics.hits.WithLabelValues("object").Inc()
 		return object, nil
 	}
 	c.lock(id)
 	defer c.unlock(id)
-	c.mu.Lock()
-	object, ok = c.objects[id]
-	c.mu.Unlock()
-	if ok {
+	if object := c.pin(id); object != nil {
+		c.metrics.hits.WithLabelValues("object").Inc()
 		return object, nil
 	}
-	object, err := NewObject(ctx, c.engine, uri)
+	c.metrics.misses.WithLabelValues("object").Inc()
+	sr, err := c.engine.Get(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	reader := newReader(c, uri, sr)
+	object, err := newObject(reader)
 	if err != nil {
+		reader.Close()
 		return nil, err
 	}
+	object.cache = c
+	object.id = id
+	object.reader = reader
+	object.vecs = make(map[evicter]*list.Element)
+	object.pins = 1
+	reader.object = object
 	c.mu.Lock()
 	c.objects[id] = object
+	object.elem = c.lru.PushFront(&entry{object: object, size: object.metaSize()})
+	c.bytes += object.metaSize()
 	c.mu.Unlock()
+	c.opened(object)
+	c.shrink()
 	return object, nil
 }
+
+func (c *Cache) pin(id ksuid.KSUID) *Object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	object, ok := c.objects[id]
+	if !ok {
+		return nil
+	}
+	object.pins++
+	c.lru.MoveToFront(object.elem)
+	return object
+}
+
+func (c *Cache) unpin(object *Object) {
+	c.mu.Lock()
+	object.pins--
+	c.mu.Unlock()
+	c.shrink()
+}
+
+// use marks vec of object as most recently used, adding it to the cache
+// with size bytes if it is not resident.
+func (c *Cache) use(object *Object, vec evicter, size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if object.elem == nil {
+		// The object has been evicted.
+		return
+	}
+	if elem, ok := object.vecs[vec]; ok {
+		c.metrics.hits.WithLabelValues("vector").Inc()
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.metrics.misses.WithLabelValues("vector").Inc()
+	object.vecs[vec] = c.lru.PushFront(&entry{object: object, vec: vec, size: size})
+	c.bytes += size
+}
+
+// shrink evicts the least recently used vectors and objects that are not
+// pinned until the cache is within its budget.  Evictions are made without
+// holding the cache lock since they lock the shadows.
+func (c *Cache) shrink() {
+	var vecs []evicter
+	var objects []*Object
+	c.mu.Lock()
+	for elem := c.lru.Back(); elem != nil && c.bytes > c.conf.MaxBytes; {
+		e := elem.Value.(*entry)
+		if e.object.pins > 0 {
+			elem = elem.Prev()
+			continue
+		}
+		if e.vec != nil {
+			prev := elem.Prev()
+			c.lru.Remove(elem)
+			delete(e.object.vecs, e.vec)
+			c.bytes -= e.size
+			vecs = append(vecs, e.vec)
+			c.metrics.evictions.WithLabelValues("vector").Inc()
+			elem = prev
+			continue
+		}
+		// Evict the object along with its vectors, which may be anywhere
+		// in the list, so start over from the back afterward.
+		for vec, velem := range e.object.vecs {
+			c.lru.Remove(velem)
+			c.bytes -= velem.Value.(*entry).size
+			vecs = append(vecs, vec)
+		}
+		e.object.vecs = nil
+		c.lru.Remove(elem)
+		c.bytes -= e.size
+		e.object.elem = nil
+		delete(c.objects, e.object.id)
+		delete(c.open, e.object)
+		objects = append(objects, e.object)
+		c.metrics.evictions.WithLabelValues("object").Inc()
+		elem = c.lru.Back()
+	}
+	c.mu.Unlock()
+	for _, vec := range vecs {
+		vec.evict()
+	}
+	for _, object := range objects {
+		object.reader.Close()
+	}
+}
+
+// opened records that the reader of object was opened and schedules a
+// sweep of idle readers if one is not already scheduled.  It returns false
+// if object has been evicted, in which case the reader is not swept.
+func (c *Cache) opened(object *Object) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if object.elem == nil {
+		return false
+	}
+	c.open[object] = struct{}{}
+	if c.sweep == nil {
+		c.sweep = time.AfterFunc(c.conf.IdleTimeout, c.closeIdle)
+	}
+	return true
+}
+
+// closeIdle closes the readers that have been idle for the idle timeout
+// and reschedules itself while any readers remain open.
+func (c *Cache) closeIdle() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for object := range c.open {
+		if object.reader.closeIfIdle(now, c.conf.IdleTimeout) {
+			delete(c.open, object)
+		}
+	}
+	if len(c.open) > 0 {
+		c.sweep.Reset(c.conf.IdleTimeout)
+	} else {
+		c.sweep = nil
+	}
+}
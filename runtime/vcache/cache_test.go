@@ -0,0 +1,175 @@
+package vcache_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/promtest"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime/vam"
+	"github.com/brimdata/super/runtime/vcache"
+	"github.com/brimdata/super/sio/csupio"
+	"github.com/brimdata/super/sup"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/segmentio/ksuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingEngine struct {
+	storage.Engine
+	gets atomic.Int64
+	open atomic.Int64
+}
+
+func (c *countingEngine) Get(ctx context.Context, u *storage.URI) (storage.Reader, error) {
+	r, err := c.Engine.Get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	c.gets.Add(1)
+	c.open.Add(1)
+	return &countingReader{r, c}, nil
+}
+
+type countingReader struct {
+	storage.Reader
+	engine *countingEngine
+}
+
+func (c *countingReader) Close() error {
+	c.engine.open.Add(-1)
+	return c.Reader.Close()
+}
+
+func writeObject(t *testing.T, n int) *storage.URI {
+	path := filepath.Join(t.TempDir(), "test.csup")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := csupio.NewWriter(f)
+	sctx := super.NewContext()
+	for k := range n {
+		val := sup.MustParseValue(sctx, fmt.Sprintf(`{a:%d,b:"%d"}`, k, k))
+		require.NoError(t, w.Write(val))
+	}
+	require.NoError(t, w.Close())
+	return storage.MustParseURI(path)
+}
+
+func fetch(t *testing.T, cache *vcache.Cache, uri *storage.URI, id ksuid.KSUID, path string) string {
+	object, err := cache.Fetch(t.Context(), uri, id)
+	require.NoError(t, err)
+	defer object.Unpin()
+	vec, err := object.Fetch(super.NewContext(), field.NewProjection([]field.Path{field.Dotted(path)}))
+	require.NoError(t, err)
+	var b strings.Builder
+	for _, val := range vam.Materialize(vec).Values() {
+		b.WriteString(sup.String(val))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func TestCacheEviction(t *testing.T) {
+	uri1, uri2 := writeObject(t, 1000), writeObject(t, 1000)
+	id1, id2 := ksuid.New(), ksuid.New()
+	cache := vcache.NewCache(storage.NewLocalEngine())
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(cache)
+	counter := func(name, kind string) float64 {
+		return promtest.CounterValue(t, registry, name, prometheus.Labels{"kind": kind})
+	}
+	expected := fetch(t, cache, uri1, id1, "a")
+	// Budget for one object's metadata and one of its vectors.
+	budget := cache.Bytes() + 100
+	cache.Configure(vcache.Config{MaxBytes: budget})
+	assert.Equal(t, expected, fetch(t, cache, uri1, id1, "a"))
+	assert.Equal(t, 1.0, counter("vector_cache_hits_total", "vector"))
+	// Loading b evicts a.
+	fetch(t, cache, uri1, id1, "b")
+	assert.LessOrEqual(t, cache.Bytes(), budget)
+	assert.Equal(t, 1.0, counter("vector_cache_evictions_total", "vector"))
+	// Loading another object evicts the first.
+	fetch(t, cache, uri2, id2, "a")
+	assert.LessOrEqual(t, cache.Bytes(), budget)
+	assert.Equal(t, 1.0, counter("vector_cache_evictions_total", "object"))
+	assert.Equal(t, expected, fetch(t, cache, uri1, id1, "a"))
+	assert.Equal(t, 3.0, counter("vector_cache_misses_total", "object"))
+}
+
+func TestCachePinning(t *testing.T) {
+	uri := writeObject(t, 1000)
+	id := ksuid.New()
+	cache := vcache.NewCache(storage.NewLocalEngine())
+	cache.Configure(vcache.Config{MaxBytes: 1})
+	object, err := cache.Fetch(t.Context(), uri, id)
+	require.NoError(t, err)
+	_, err = object.Fetch(super.NewContext(), nil)
+	require.NoError(t, err)
+	// The pinned object and its vectors are retained over budget.
+	assert.Greater(t, cache.Bytes(), int64(1))
+	object.Unpin()
+	assert.Zero(t, cache.Bytes())
+}
+
+func TestCachePinnedBytes(t *testing.T) {
+	uri1, uri2 := writeObject(t, 1000), writeObject(t, 1000)
+	id1, id2 := ksuid.New(), ksuid.New()
+	cache := vcache.NewCache(storage.NewLocalEngine())
+	cache.Configure(vcache.Config{MaxBytes: 1})
+	object, err := cache.Fetch(t.Context(), uri1, id1)
+	require.NoError(t, err)
+	_, err = object.Fetch(super.NewContext(), nil)
+	require.NoError(t, err)
+	pinned := cache.PinnedBytes()
+	assert.Equal(t, cache.Bytes(), pinned)
+	// The pinned bytes exceed the budget, so an unpinned object is evicted
+	// as soon as it is released.
+	fetch(t, cache, uri2, id2, "a")
+	assert.Equal(t, pinned, cache.Bytes())
+	assert.Equal(t, pinned, cache.PinnedBytes())
+	object.Unpin()
+	assert.Zero(t, cache.PinnedBytes())
+	assert.Zero(t, cache.Bytes())
+}
+
+func TestCacheIdleReopen(t *testing.T) {
+	uri := writeObject(t, 10)
+	id := ksuid.New()
+	engine := &countingEngine{Engine: storage.NewLocalEngine()}
+	cache := vcache.NewCache(engine)
+	cache.Configure(vcache.Config{IdleTimeout: time.Millisecond})
+	fetch(t, cache, uri, id, "a")
+	assert.Equal(t, int64(1), engine.gets.Load())
+	time.Sleep(50 * time.Millisecond)
+	// Loading another vector reopens the closed storage reader.
+	assert.Equal(t, `{b:"0"}`, fetch(t, cache, uri, id, "b")[:7])
+	assert.Equal(t, int64(2), engine.gets.Load())
+}
+
+func TestCacheEvictedReaderClosed(t *testing.T) {
+	uri := writeObject(t, 1000)
+	engine := &countingEngine{Engine: storage.NewLocalEngine()}
+	cache := vcache.NewCache(engine)
+	cache.Configure(vcache.Config{MaxBytes: 1})
+	object, err := cache.Fetch(t.Context(), uri, ksuid.New())
+	require.NoError(t, err)
+	// Unpinning the object over budget evicts it and closes its reader.
+	object.Unpin()
+	assert.Zero(t, cache.Bytes())
+	assert.Zero(t, engine.open.Load())
+	// Loading vectors from the evicted object reopens its reader, which
+	// is closed once the loads are done.
+	_, err = object.Fetch(super.NewContext(), nil)
+	require.NoError(t, err)
+	assert.Greater(t, engine.gets.Load(), int64(1))
+	assert.Zero(t, engine.open.Load())
+}
@@ -39,20 +39,30 @@ func (d *dict) project(loader *loader, projection field.Projection) vector.Any {
 		return vector.NewMissing(loader.sctx, d.length())
 	}
 	index, counts := d.load(loader)
+	loader.use(d, int64(len(index))+sizeOfUint32s(counts))
 	return vector.NewDict(d.values.project(loader, projection), index, counts)
 }
 
 func (d *dict) load(loader *loader) ([]byte, []uint32) {
 	d.mu.Lock()
 	defer d.mu.Unlock()
-	d.index = make([]byte, d.meta.Index.MemLength)
-	if err := d.meta.Index.Read(loader.r, d.index); err != nil {
+	if d.index != nil {
+		return d.index, d.counts
+	}
+	index := make([]byte, d.meta.Index.MemLength)
+	if err := d.meta.Index.Read(loader.r, index); err != nil {
 		panic(err)
 	}
-	v, err := csup.ReadUint32s(d.meta.Counts, loader.r)
+	counts, err := csup.ReadUint32s(d.meta.Counts, loader.r)
 	if err != nil {
 		panic(err)
 	}
-	d.counts = v
-	return d.index, d.counts
+	d.index, d.counts = index, counts
+	return index, counts
+}
+
+func (d *dict) evict() {
+	d.mu.Lock()
+	d.index, d.counts = nil, nil
+	d.mu.Unlock()
 }
@@ -42,6 +42,7 @@ func (d *dynamic) project(loader *loader, projection field.Projection) vector.An
 		vecs = append(vecs, shadow.project(loader, projection))
 	}
 	tags, _ := d.load(loader.r)
+	loader.use(d, sizeOfUint32s(tags))
 	return vector.NewDynamic(tags, vecs)
 }
 
@@ -65,3 +66,9 @@ func (d *dynamic) projectUnordered(vecs []vector.Any, loader *loader, projection
 	}
 	return vecs
 }
+
+func (d *dynamic) evict() {
+	d.mu.Lock()
+	d.tags = nil
+	d.mu.Unlock()
+}
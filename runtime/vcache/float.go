@@ -30,7 +30,9 @@ func (f *float) project(loader *loader, projection field.Projection) vector.Any
 	if len(projection) > 0 {
 		return vector.NewMissing(loader.sctx, f.length())
 	}
-	return vector.NewFloat(f.meta.Typ, f.load(loader))
+	vals := f.load(loader)
+	loader.use(f, int64(len(vals))*8)
+	return vector.NewFloat(f.meta.Typ, vals)
 }
 
 func (f *float) load(loader *loader) []float64 {
@@ -46,3 +48,9 @@ func (f *float) load(loader *loader) []float64 {
 	f.vals = byteconv.ReinterpretSlice[float64](bytes)
 	return f.vals
 }
+
+func (f *float) evict() {
+	f.mu.Lock()
+	f.vals = nil
+	f.mu.Unlock()
+}
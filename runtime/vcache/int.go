@@ -31,7 +31,9 @@ func (i *int_) project(loader *loader, projection field.Projection) vector.Any {
 	if len(projection) > 0 {
 		return vector.NewMissing(loader.sctx, i.length())
 	}
-	return vector.NewInt(i.meta.Typ, i.load(loader))
+	vals := i.load(loader)
+	loader.use(i, int64(len(vals))*8)
+	return vector.NewInt(i.meta.Typ, vals)
 }
 
 func (i *int_) load(loader *loader) []int64 {
@@ -47,3 +49,9 @@ func (i *int_) load(loader *loader) []int64 {
 	i.vals = intcomp.UncompressInt64(byteconv.ReinterpretSlice[uint64](bytes), nil)
 	return i.vals
 }
+
+func (i *int_) evict() {
+	i.mu.Lock()
+	i.vals = nil
+	i.mu.Unlock()
+}
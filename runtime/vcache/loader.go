@@ -20,9 +20,10 @@ import (
 // in shadowed vector.Any primitives that are shared).  We otherwise allocate all
 // vector.Any super.Types using the passed-in sctx.
 type loader struct {
-	cctx *csup.Context
-	sctx *super.Context
-	r    io.ReaderAt
+	cctx   *csup.Context
+	sctx   *super.Context
+	r      io.ReaderAt
+	object *Object
 }
 
 // Load all vector data into the in-memory shadow that is needed and not yet loaded
@@ -35,3 +36,25 @@ type loader struct {
 func (l *loader) load(projection field.Projection, s shadow) (vector.Any, error) {
 	return s.project(l, projection), nil
 }
+
+// use records that the vector data of vec, which takes size bytes, is in use
+// so that the cache holding the loader's object, if any, can account for it
+// and evict it when it is least recently used.  It must not be called while
+// holding a shadow lock.
+func (l *loader) use(vec evicter, size int64) {
+	if l.object != nil && l.object.cache != nil {
+		l.object.cache.use(l.object, vec, size)
+	}
+}
+
+// done brings the cache holding the loader's object, if any, within its
+// budget after a load.
+func (l *loader) done() {
+	if l.object != nil && l.object.cache != nil {
+		l.object.cache.shrink()
+	}
+}
+
+func sizeOfUint32s(s []uint32) int64 {
+	return int64(len(s)) * 4
+}
@@ -41,6 +41,7 @@ func (m *map_) project(loader *loader, projection field.Projection) vector.Any {
 	vals := m.values.project(loader, nil)
 	typ := loader.sctx.LookupTypeMap(keys.Type(), vals.Type())
 	offs := m.load(loader)
+	loader.use(m, sizeOfUint32s(offs))
 	return vector.NewMap(typ, offs, keys, vals)
 }
 
@@ -57,3 +58,9 @@ func (m *map_) load(loader *loader) []uint32 {
 	m.offs = offs
 	return offs
 }
+
+func (m *map_) evict() {
+	m.mu.Lock()
+	m.offs = nil
+	m.mu.Unlock()
+}
@@ -0,0 +1,69 @@
+package vcache
+
+import "github.com/prometheus/client_golang/prometheus"
+
+type metrics struct {
+	hits      *prometheus.CounterVec
+	misses    *prometheus.CounterVec
+	evictions *prometheus.CounterVec
+	bytes     prometheus.GaugeFunc
+	pinned    prometheus.GaugeFunc
+}
+
+func newMetrics(c *Cache) metrics {
+	return metrics{
+		hits: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "vector_cache_hits_total",
+				Help: "Number of hits for a vector cache lookup.",
+			},
+			[]string{"kind"},
+		),
+		misses: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "vector_cache_misses_total",
+				Help: "Number of misses for a vector cache lookup.",
+			},
+			[]string{"kind"},
+		),
+		evictions: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "vector_cache_evictions_total",
+				Help: "Number of evictions from the vector cache.",
+			},
+			[]string{"kind"},
+		),
+		bytes: prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: "vector_cache_resident_bytes",
+				Help: "Number of bytes resident in the vector cache.",
+			},
+			func() float64 { return float64(c.Bytes()) },
+		),
+		pinned: prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name: "vector_cache_pinned_bytes",
+				Help: "Number of bytes resident in the vector cache for objects in use.",
+			},
+			func() float64 { return float64(c.PinnedBytes()) },
+		),
+	}
+}
+
+func (m metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{m.hits, m.misses, m.evictions, m.bytes, m.pinned}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Cache) Describe(ch chan<- *prometheus.Desc) {
+	for _, collector := range c.metrics.collectors() {
+		collector.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *Cache) Collect(ch chan<- prometheus.Metric) {
+	for _, collector := range c.metrics.collectors() {
+		collector.Collect(ch)
+	}
+}
@@ -1,13 +1,17 @@
 package vcache
 
 import (
+	"container/list"
 	"context"
+	"io"
+	"sync"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/csup"
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/vector"
+	"github.com/segmentio/ksuid"
 )
 
 // Object is the interface to load a given CSUP object from storage into
@@ -17,8 +21,21 @@ import (
 // multiple callers of Cache and the super.Context in use is passed in for
 // each vector constructed from its in-memory shadow.
 type Object struct {
+	mu     sync.Mutex
 	object *csup.Object
 	root   shadow
+
+	// The remaining fields are set when the object is held by a Cache
+	// and, except for id and reader, are protected by the cache lock.
+	cache  *Cache
+	id     ksuid.KSUID
+	reader *reader
+	elem   *list.Element
+	vecs   map[evicter]*list.Element
+	pins   int
+
+	// closer is the storage reader of an object from NewObject.
+	closer io.Closer
 }
 
 // NewObject creates a new in-memory Object corresponding to a CSUP object
@@ -26,17 +43,21 @@ type Object struct {
 // the metadata is deserialized so that vectors can be loaded into the cache
 // on demand only as needed and retained in memory for future use.
 func NewObject(ctx context.Context, engine storage.Engine, uri *storage.URI) (*Object, error) {
-	// XXX currently we open a storage.Reader for every object and never close it.
-	// We should either close after a timeout and reopen when needed or change the
-	// storage API to have a more reasonable semantics around the Put/Get not leaving
-	// a file descriptor open for every long Get.  Perhaps there should be another
-	// method for intermittent random access.
-	// XXX maybe open the reader inside Fetch if needed?
 	reader, err := engine.Get(ctx, uri)
 	if err != nil {
 		return nil, err
 	}
-	object, err := csup.NewObject(reader)
+	object, err := newObject(reader)
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+	object.closer = reader
+	return object, nil
+}
+
+func newObject(r io.ReaderAt) (*Object, error) {
+	object, err := csup.NewObject(r)
 	if err != nil {
 		return nil, err
 	}
@@ -48,36 +69,76 @@ func NewObjectFromCSUP(object *csup.Object) *Object {
 }
 
 func (o *Object) Close() error {
+	if o.reader != nil {
+		return o.reader.Close()
+	}
+	if o.closer != nil {
+		return o.closer.Close()
+	}
 	return o.object.Close()
 }
 
+// Unpin releases the pin on an object returned by Cache.Fetch so that the
+// object and its vectors may be evicted.  Unpin is a no-op for an object
+// not held by a Cache.
+func (o *Object) Unpin() {
+	if o.cache != nil {
+		o.cache.unpin(o)
+	}
+}
+
+// ProjectMetadata returns the CSUP metadata of o projected by projection.
+func (o *Object) ProjectMetadata(sctx *super.Context, projection field.Projection) []super.Value {
+	return o.object.ProjectMetadata(sctx, projection)
+}
+
+func (o *Object) metaSize() int64 {
+	return int64(o.object.MetaSize())
+}
+
+// shadow returns the root shadow of o after unmarshaling the portion
+// needed for projection.
+func (o *Object) shadow(projection field.Projection) shadow {
+	cctx := o.object.Context()
+	o.mu.Lock()
+	if o.root == nil {
+		o.root = newShadow(cctx, o.object.Root())
+	}
+	root := o.root
+	o.mu.Unlock()
+	root.unmarshal(cctx, projection)
+	return root
+}
+
 // Fetch returns the indicated projection of data in this CSUP object.
 // If any required data is not memory resident, it will be fetched from
 // storage and cached in memory so that subsequent calls run from memory.
 // The vectors returned will have types from the provided sctx.  Multiple
 // Fetch calls to the same object may run concurrently.
 func (o *Object) Fetch(sctx *super.Context, projection field.Projection) (vector.Any, error) {
-	cctx := o.object.Context()
-	loader := &loader{cctx, sctx, o.object.DataReader()}
-	o.root = newShadow(cctx, o.object.Root())
-	o.root.unmarshal(cctx, projection)
-	return loader.load(projection, o.root)
+	root := o.shadow(projection)
+	loader := o.newLoader(sctx)
+	defer loader.done()
+	return loader.load(projection, root)
 }
 
 // FetchUnordered is like Fetch, but if o's root vector is dynamic,
 // FetchUnordered returns the underlying values vectors instead of a
 // vector.Dynamic.
 func (o *Object) FetchUnordered(vecs []vector.Any, sctx *super.Context, projection field.Projection) ([]vector.Any, error) {
-	cctx := o.object.Context()
-	o.root = newShadow(cctx, o.object.Root())
-	o.root.unmarshal(cctx, projection)
-	loader := &loader{cctx: cctx, sctx: sctx, r: o.object.DataReader()}
-	if d, ok := o.root.(*dynamic); ok {
+	root := o.shadow(projection)
+	loader := o.newLoader(sctx)
+	defer loader.done()
+	if d, ok := root.(*dynamic); ok {
 		return d.projectUnordered(vecs, loader, projection), nil
 	}
-	vec, err := loader.load(projection, o.root)
+	vec, err := loader.load(projection, root)
 	if err != nil {
 		return nil, err
 	}
 	return append(vecs, vec), nil
 }
+
+func (o *Object) newLoader(sctx *super.Context) *loader {
+	return &loader{cctx: o.object.Context(), sctx: sctx, r: o.object.DataReader(), object: o}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/netip"
 	"sync"
+	"unsafe"
 
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/csup"
@@ -108,6 +109,9 @@ func (p *primitive) loadAnyWithLock(loader *loader) any {
 }
 
 func (p *primitive) newVector(loader *loader) vector.Any {
+	if _, ok := p.meta.Typ.(*super.TypeOfNull); !ok {
+		loader.use(p, p.size(p.load(loader)))
+	}
 	switch typ := p.meta.Typ.(type) {
 	case *super.TypeOfUint8, *super.TypeOfUint16, *super.TypeOfUint32, *super.TypeOfUint64:
 		return vector.NewUint(typ, p.load(loader).([]uint64))
@@ -134,3 +138,31 @@ func (p *primitive) newVector(loader *loader) vector.Any {
 	}
 	panic(fmt.Errorf("internal error: vcache.loadPrimitive got unknown type %#v", p.meta.Typ))
 }
+
+// size returns the number of bytes in the vector data any.
+func (p *primitive) size(any any) int64 {
+	switch any := any.(type) {
+	case []uint64:
+		return int64(len(any)) * 8
+	case []int64:
+		return int64(len(any)) * 8
+	case []float64:
+		return int64(len(any)) * 8
+	case bitvec.Bits:
+		return (int64(p.length()) + 7) / 8
+	case vector.BytesTable:
+		offs, bytes := any.Slices()
+		return sizeOfUint32s(offs) + int64(len(bytes))
+	case []netip.Addr:
+		return int64(len(any)) * int64(unsafe.Sizeof(netip.Addr{}))
+	case []netip.Prefix:
+		return int64(len(any)) * int64(unsafe.Sizeof(netip.Prefix{}))
+	}
+	return 0
+}
+
+func (p *primitive) evict() {
+	p.mu.Lock()
+	p.any = nil
+	p.mu.Unlock()
+}
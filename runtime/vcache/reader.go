@@ -0,0 +1,110 @@
+package vcache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/brimdata/super/pkg/storage"
+)
+
+// reader is the io.ReaderAt of a cached object.  It opens the storage
+// reader on demand so that the cache can close it when it is idle and
+// it is transparently reopened when more vector data is needed.
+type reader struct {
+	cache  *Cache
+	uri    *storage.URI
+	object *Object
+
+	mu      sync.Mutex
+	r       storage.Reader
+	active  int
+	lastUse time.Time
+	closed  bool
+}
+
+func newReader(cache *Cache, uri *storage.URI, r storage.Reader) *reader {
+	return &reader{cache: cache, uri: uri, r: r, lastUse: time.Now()}
+}
+
+func (r *reader) ReadAt(b []byte, off int64) (int, error) {
+	sr, opened, err := r.acquire()
+	if err != nil {
+		return 0, err
+	}
+	if opened && r.object != nil && !r.cache.opened(r.object) {
+		// The object was evicted, so the cache no longer closes the
+		// reader when it is idle.  Close it once the reads are done.
+		r.mu.Lock()
+		r.closed = true
+		r.mu.Unlock()
+	}
+	n, err := sr.ReadAt(b, off)
+	r.release()
+	return n, err
+}
+
+func (r *reader) acquire() (storage.Reader, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var opened bool
+	if r.r == nil {
+		// The reopened storage reader outlives the query reopening it
+		// so it is not bound to the query's context.
+		sr, err := r.cache.engine.Get(context.Background(), r.uri)
+		if err != nil {
+			return nil, false, err
+		}
+		r.r = sr
+		r.closed = false
+		opened = true
+	}
+	r.active++
+	return r.r, opened, nil
+}
+
+func (r *reader) release() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.active--
+	r.lastUse = time.Now()
+	if r.active == 0 && r.closed {
+		r.closeWithLock()
+	}
+}
+
+// closeIfIdle closes the storage reader if it has not been used for
+// timeout and returns true if the storage reader is closed.
+func (r *reader) closeIfIdle(now time.Time, timeout time.Duration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.r == nil {
+		return true
+	}
+	if r.active > 0 || now.Sub(r.lastUse) < timeout {
+		return false
+	}
+	r.closeWithLock()
+	return true
+}
+
+// Close closes the storage reader once any reads in progress are done.
+func (r *reader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.active > 0 {
+		r.closed = true
+		return nil
+	}
+	return r.closeWithLock()
+}
+
+func (r *reader) closeWithLock() error {
+	if r.r == nil {
+		return nil
+	}
+	err := r.r.Close()
+	r.r = nil
+	r.closed = false
+	return err
+}
@@ -111,21 +111,30 @@ func (f *field_) unmarshal(cctx *csup.Context, projection field.Projection) {
 }
 
 func (f *field_) project(fields []*vector.Field, loader *loader, projection field.Projection) []*vector.Field {
+	var nones []uint32
 	if f.meta.Opt {
 		f.mu.Lock()
 		if !f.loaded {
-			nones, err := csup.ReadUint32s(f.meta.Nones, loader.r)
+			var err error
+			f.nones, err = csup.ReadUint32s(f.meta.Nones, loader.r)
 			if err != nil {
 				panic(err)
 			}
-			f.nones = nones
 			f.loaded = true
 		}
+		nones = f.nones
 		f.mu.Unlock()
+		loader.use(f, sizeOfUint32s(nones))
 	}
 	return append(fields, &vector.Field{
 		Val:  f.values.project(loader, projection),
-		Runs: f.nones,
+		Runs: nones,
 		Len:  f.len,
 	})
 }
+
+func (f *field_) evict() {
+	f.mu.Lock()
+	f.nones, f.loaded = nil, false
+	f.mu.Unlock()
+}
@@ -37,6 +37,7 @@ func (s *set) project(loader *loader, projection field.Projection) vector.Any {
 	vec := s.values.project(loader, nil)
 	typ := loader.sctx.LookupTypeSet(vec.Type())
 	offs := s.load(loader)
+	loader.use(s, sizeOfUint32s(offs))
 	return vector.NewSet(typ, offs, vec)
 }
 
@@ -53,3 +54,9 @@ func (s *set) load(loader *loader) []uint32 {
 	s.offs = offs
 	return offs
 }
+
+func (s *set) evict() {
+	s.mu.Lock()
+	s.offs = nil
+	s.mu.Unlock()
+}
@@ -31,7 +31,9 @@ func (u *uint_) project(loader *loader, projection field.Projection) vector.Any
 	if len(projection) > 0 {
 		return vector.NewMissing(loader.sctx, u.length())
 	}
-	return vector.NewUint(u.meta.Typ, u.load(loader))
+	vals := u.load(loader)
+	loader.use(u, int64(len(vals))*8)
+	return vector.NewUint(u.meta.Typ, vals)
 }
 
 func (u *uint_) load(loader *loader) []uint64 {
@@ -47,3 +49,9 @@ func (u *uint_) load(loader *loader) []uint64 {
 	u.vals = intcomp.UncompressUint64(byteconv.ReinterpretSlice[uint64](bytes), nil)
 	return u.vals
 }
+
+func (u *uint_) evict() {
+	u.mu.Lock()
+	u.vals = nil
+	u.mu.Unlock()
+}
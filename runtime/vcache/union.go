@@ -65,5 +65,12 @@ func (u *union) project(loader *loader, projection field.Projection) vector.Any
 	}
 	utyp := loader.sctx.LookupTypeUnion(types)
 	tags := u.load(loader)
+	loader.use(u, sizeOfUint32s(tags))
 	return vector.NewUnion(utyp, tags, vecs)
 }
+
+func (u *union) evict() {
+	u.mu.Lock()
+	u.tags = nil
+	u.mu.Unlock()
+}
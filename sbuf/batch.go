@@ -31,6 +31,16 @@ type Batch interface {
 	Values() []super.Value
 }
 
+// BatchLen returns the number of values in batch.  A batch whose values are
+// materialized on demand implements a Len method so that counting them does
+// not materialize them.
+func BatchLen(batch Batch) int {
+	if b, ok := batch.(interface{ Len() int }); ok {
+		return b.Len()
+	}
+	return len(batch.Values())
+}
+
 // WriteBatch writes the values in batch to zw.  If an error occurs, WriteBatch
 // stops and returns the error.
 func WriteBatch(zw sio.Writer, batch Batch) error {
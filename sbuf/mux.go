@@ -12,7 +12,7 @@ func Label(label string, batch Batch) Batch {
 func Unlabel(batch Batch) (Batch, string) {
 	var label string
 	if inner, ok := batch.(*labeled); ok {
-		batch = inner
+		batch = inner.Batch
 		label = inner.label
 	}
 	return batch, label
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	"github.com/brimdata/super/service/arrowflight"
+	"go.uber.org/zap"
+)
+
+// ServeFlightSQL serves Arrow Flight SQL clients that connect to l until
+// ctx is canceled.
+func (c *Core) ServeFlightSQL(ctx context.Context, l net.Listener) error {
+	c.logger.Info("Listening for Arrow Flight SQL clients", zap.Stringer("addr", l.Addr()))
+	b := &backend{core: c, protocol: "flightsql"}
+	srv, err := arrowflight.NewServer(b, c.conf.Version, c.logger.Named("flightsql"))
+	if err != nil {
+		return err
+	}
+	return srv.Serve(ctx, l)
+}
@@ -0,0 +1,321 @@
+// Package arrowflight implements an Arrow Flight SQL service so that
+// clients like ADBC drivers may fetch query results in Arrow format.
+//
+// Statements are SuperSQL queries whose results must be records of a
+// single type, as with the Arrow IPC output format.  Pools are presented
+// as catalogs and their branches as database schemas.  Prepared
+// statements, transactions, and updates are not supported.
+package arrowflight
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/flight"
+	"github.com/apache/arrow-go/v18/arrow/flight/flightsql"
+	"github.com/apache/arrow-go/v18/arrow/flight/flightsql/schema_ref"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Backend authenticates clients and runs their queries.
+type Backend interface {
+	// AuthRequired returns true if clients must send an access token.
+	AuthRequired() bool
+	// Authenticate returns the context for the queries of a client with
+	// token.
+	Authenticate(ctx context.Context, user, token string) (context.Context, error)
+	// Query compiles and starts query, whose source is text.
+	Query(ctx context.Context, query *parser.AST, text string) (runtime.Query, error)
+}
+
+type Server struct {
+	backend    Backend
+	grpc       *grpc.Server
+	logger     *zap.Logger
+	statements *statements
+}
+
+// NewServer returns a Server that runs queries with backend and reports
+// version as the version of the service.
+func NewServer(backend Backend, version string, logger *zap.Logger) (*Server, error) {
+	s := &Server{backend: backend, logger: logger, statements: newStatements()}
+	sql := &sqlServer{server: s}
+	sql.Alloc = memory.DefaultAllocator
+	if err := sql.RegisterSqlInfo(flightsql.SqlInfoFlightSqlServerName, "SuperDB"); err != nil {
+		return nil, err
+	}
+	if err := sql.RegisterSqlInfo(flightsql.SqlInfoFlightSqlServerVersion, version); err != nil {
+		return nil, err
+	}
+	s.grpc = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(s.authUnary),
+		grpc.ChainStreamInterceptor(s.authStream),
+	)
+	flight.RegisterFlightServiceServer(s.grpc, flightsql.NewFlightServer(sql))
+	return s, nil
+}
+
+// Serve accepts connections on l until ctx is canceled.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	stop := context.AfterFunc(ctx, s.grpc.Stop)
+	defer stop()
+	defer s.statements.closeAll()
+	err := s.grpc.Serve(l)
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// authenticate returns the context for a call whose metadata holds an
+// access token as a bearer token in the authorization header.
+func (s *Server) authenticate(ctx context.Context) (context.Context, error) {
+	var token string
+	md, _ := metadata.FromIncomingContext(ctx)
+	if vals := md.Get("authorization"); len(vals) > 0 {
+		var ok bool
+		if token, ok = strings.CutPrefix(vals[0], "Bearer "); !ok {
+			return nil, status.Error(codes.Unauthenticated, "authorization header must hold a bearer token")
+		}
+	} else if s.backend.AuthRequired() {
+		return nil, status.Error(codes.Unauthenticated, "no bearer token provided")
+	}
+	ctx, err := s.backend.Authenticate(ctx, "", token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return ctx, nil
+}
+
+func (s *Server) authUnary(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) authStream(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authenticate(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &serverStream{stream, ctx})
+}
+
+// serverStream is a grpc.ServerStream with the context of an
+// authenticated client.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// sqlServer implements the Flight SQL commands that are supported.
+type sqlServer struct {
+	flightsql.BaseServer
+	server *Server
+}
+
+func (s *sqlServer) GetFlightInfoStatement(ctx context.Context, cmd flightsql.StatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	if len(cmd.GetTransactionId()) != 0 {
+		return nil, status.Error(codes.Unimplemented, "transactions are not supported")
+	}
+	// Clients may read the schema from the flight info before calling
+	// DoGetStatement, so the query is started here and runs until its
+	// first result gives the schema, which also reports errors in the
+	// query before the clients have received the flight info.  The running
+	// query is then held under the ticket's handle for DoGetStatement.
+	text := cmd.GetQuery()
+	p, err := parser.ParseText(text)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// The query outlives this call, so it's canceled only by
+	// DoGetStatement or when its handle expires.
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	q, err := s.server.backend.Query(ctx, p, text)
+	if err != nil {
+		cancel()
+		return nil, statusError(err)
+	}
+	schema, chunks, err := stream(ctx, q)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	st := &statement{schema: schema, chunks: chunks, cancel: cancel}
+	handle, err := s.server.statements.add(st)
+	if err != nil {
+		st.close()
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ticket, err := flightsql.CreateStatementQueryTicket([]byte(handle))
+	if err != nil {
+		s.server.statements.take(handle).close()
+		return nil, err
+	}
+	return flightInfo(desc, ticket, schema), nil
+}
+
+// DoGetStatement returns the results of the statement started by
+// GetFlightInfoStatement whose handle is in ticket.
+func (s *sqlServer) DoGetStatement(ctx context.Context, ticket flightsql.StatementQueryTicket) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	st := s.server.statements.take(string(ticket.GetStatementHandle()))
+	if st == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "no statement for ticket (tickets may be used once and expire after %s)", StatementTTL)
+	}
+	// Cancel the query if the client stops reading its results.
+	context.AfterFunc(ctx, st.cancel)
+	return st.schema, st.chunks, nil
+}
+
+func (s *sqlServer) GetFlightInfoCatalogs(ctx context.Context, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	return flightInfo(desc, desc.Cmd, schema_ref.Catalogs), nil
+}
+
+// DoGetCatalogs returns the names of the pools.
+func (s *sqlServer) DoGetCatalogs(ctx context.Context) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	vals, err := s.queryValues(ctx, "from :pools | sort name | values name")
+	if err != nil {
+		return nil, nil, statusError(err)
+	}
+	b := array.NewStringBuilder(s.Alloc)
+	defer b.Release()
+	for _, val := range vals {
+		b.Append(val.AsString())
+	}
+	return records(schema_ref.Catalogs, b)
+}
+
+func (s *sqlServer) GetFlightInfoSchemas(ctx context.Context, cmd flightsql.GetDBSchemas, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	return flightInfo(desc, desc.Cmd, schema_ref.DBSchemas), nil
+}
+
+// DoGetDBSchemas returns the names of the branches of each pool.
+func (s *sqlServer) DoGetDBSchemas(ctx context.Context, cmd flightsql.GetDBSchemas) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	vals, err := s.queryValues(ctx, "from :branches | sort pool.name, branch.name | values {pool:pool.name,branch:branch.name}")
+	if err != nil {
+		return nil, nil, statusError(err)
+	}
+	var match func(string) bool
+	if pattern := cmd.GetDBSchemaFilterPattern(); pattern != nil {
+		if match, err = likeMatcher(*pattern); err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	catalogs := array.NewStringBuilder(s.Alloc)
+	defer catalogs.Release()
+	schemas := array.NewStringBuilder(s.Alloc)
+	defer schemas.Release()
+	for _, val := range vals {
+		pool, branch := val.Deref("pool").AsString(), val.Deref("branch").AsString()
+		if catalog := cmd.GetCatalog(); catalog != nil && *catalog != pool {
+			continue
+		}
+		if match != nil && !match(branch) {
+			continue
+		}
+		catalogs.Append(pool)
+		schemas.Append(branch)
+	}
+	return records(schema_ref.DBSchemas, catalogs, schemas)
+}
+
+// queryValues returns the values of query.
+func (s *sqlServer) queryValues(ctx context.Context, query string) ([]super.Value, error) {
+	p, err := parser.ParseText(query)
+	if err != nil {
+		return nil, err
+	}
+	q, err := s.server.backend.Query(ctx, p, query)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+	var vals []super.Value
+	for {
+		batch, err := q.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			return vals, nil
+		}
+		for _, val := range batch.Values() {
+			vals = append(vals, val.Copy())
+		}
+	}
+}
+
+func flightInfo(desc *flight.FlightDescriptor, ticket []byte, schema *arrow.Schema) *flight.FlightInfo {
+	info := &flight.FlightInfo{
+		Endpoint:         []*flight.FlightEndpoint{{Ticket: &flight.Ticket{Ticket: ticket}}},
+		FlightDescriptor: desc,
+		TotalRecords:     -1,
+		TotalBytes:       -1,
+	}
+	if schema != nil {
+		info.Schema = flight.SerializeSchema(schema, memory.DefaultAllocator)
+	}
+	return info
+}
+
+// records returns a stream of a single record batch with schema whose
+// columns are built by builders.
+func records(schema *arrow.Schema, builders ...array.Builder) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	var cols []arrow.Array
+	for _, b := range builders {
+		col := b.NewArray()
+		defer col.Release()
+		cols = append(cols, col)
+	}
+	var n int64
+	if len(cols) > 0 {
+		n = int64(cols[0].Len())
+	}
+	ch := make(chan flight.StreamChunk, 1)
+	ch <- flight.StreamChunk{Data: array.NewRecordBatch(schema, cols, n)}
+	close(ch)
+	return schema, ch, nil
+}
+
+// likeMatcher returns a function that matches strings with the SQL LIKE
+// pattern, in which "%" matches any sequence of characters and "_" matches
+// any single character.
+func likeMatcher(pattern string) (func(string) bool, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			b.WriteString(".*")
+		case '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return re.MatchString, nil
+}
@@ -0,0 +1,123 @@
+package arrowflight
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/flight"
+	"github.com/apache/arrow-go/v18/arrow/flight/flightsql"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type testBackend struct {
+	comp    runtime.Compiler
+	queries atomic.Int32
+}
+
+func (*testBackend) AuthRequired() bool { return false }
+
+func (*testBackend) Authenticate(ctx context.Context, _, _ string) (context.Context, error) {
+	return ctx, nil
+}
+
+func (b *testBackend) Query(ctx context.Context, query *parser.AST, _ string) (runtime.Query, error) {
+	b.queries.Add(1)
+	return runtime.CompileQuery(ctx, super.NewContext(), b.comp, query, nil)
+}
+
+// startServer starts a server with a test backend and returns a client
+// connected to it.
+func startServer(t *testing.T) (*testBackend, *flightsql.Client) {
+	backend := &testBackend{comp: compiler.NewCompiler(storage.NewLocalEngine())}
+	s, err := NewServer(backend, "test", zap.NewNop())
+	require.NoError(t, err)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+	go func() { done <- s.Serve(ctx, l) }()
+	client, err := flightsql.NewClient(l.Addr().String(), nil, nil, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		client.Close()
+		cancel()
+		require.NoError(t, <-done)
+	})
+	return backend, client
+}
+
+func TestFlightInfoSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.sup")
+	require.NoError(t, os.WriteFile(path, []byte(`{a:1,s:"x"} {a:2,s:"y"}`), 0666))
+	backend, client := startServer(t)
+	ctx := t.Context()
+
+	info, err := client.Execute(ctx, "from '"+path+"' | sort -r a")
+	require.NoError(t, err)
+	schema, err := flight.DeserializeSchema(info.Schema, memory.DefaultAllocator)
+	require.NoError(t, err)
+	assert.Equal(t, "schema:\n  fields: 2\n    - a: type=int64\n    - s: type=utf8", schema.String())
+	r, err := client.DoGet(ctx, info.Endpoint[0].Ticket)
+	require.NoError(t, err)
+	defer r.Release()
+	assert.True(t, schema.Equal(r.Schema()))
+	var vals []int64
+	for r.Next() {
+		vals = append(vals, r.RecordBatch().Column(0).(*array.Int64).Int64Values()...)
+	}
+	require.NoError(t, r.Err())
+	assert.Equal(t, []int64{2, 1}, vals)
+	assert.EqualValues(t, 1, backend.queries.Load(), "query run more than once")
+	assert.Equal(t, codes.NotFound, status.Code(doGetErr(ctx, client, info.Endpoint[0].Ticket)))
+
+	info, err = client.Execute(ctx, "from '"+path+"' | where a > 2")
+	require.NoError(t, err)
+	schema, err = flight.DeserializeSchema(info.Schema, memory.DefaultAllocator)
+	require.NoError(t, err)
+	assert.Zero(t, schema.NumFields())
+
+	_, err = client.Execute(ctx, "from '"+path+"' | values a")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStatementExpires(t *testing.T) {
+	saved := StatementTTL
+	StatementTTL = 10 * time.Millisecond
+	defer func() { StatementTTL = saved }()
+	_, client := startServer(t)
+	ctx := t.Context()
+	info, err := client.Execute(ctx, "values {a:1}")
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, codes.NotFound, status.Code(doGetErr(ctx, client, info.Endpoint[0].Ticket)))
+}
+
+// doGetErr returns the error from reading the results of ticket.
+func doGetErr(ctx context.Context, client *flightsql.Client, ticket *flight.Ticket) error {
+	r, err := client.DoGet(ctx, ticket)
+	if err != nil {
+		return err
+	}
+	defer r.Release()
+	for r.Next() {
+	}
+	return r.Err()
+}
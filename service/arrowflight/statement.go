@@ -0,0 +1,95 @@
+package arrowflight
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/flight"
+)
+
+// StatementTTL is how long a statement started by GetFlightInfoStatement
+// waits for DoGetStatement before it is canceled.
+var StatementTTL = time.Minute
+
+// statement is a running query whose results await DoGetStatement.
+type statement struct {
+	schema *arrow.Schema
+	chunks <-chan flight.StreamChunk
+	cancel context.CancelFunc
+	timer  *time.Timer
+}
+
+// close cancels the query and releases the results it has sent.
+func (s *statement) close() {
+	s.cancel()
+	go func() {
+		for chunk := range s.chunks {
+			if chunk.Data != nil {
+				chunk.Data.Release()
+			}
+		}
+	}()
+}
+
+// statements holds the statements awaiting DoGetStatement keyed by the
+// opaque handles in their tickets.
+type statements struct {
+	mu sync.Mutex
+	m  map[string]*statement
+}
+
+func newStatements() *statements {
+	return &statements{m: make(map[string]*statement)}
+}
+
+// add adds s and returns its handle.  The statement is closed if it is
+// not taken within StatementTTL.
+func (s *statements) add(st *statement) (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	handle := hex.EncodeToString(b[:])
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[handle] = st
+	st.timer = time.AfterFunc(StatementTTL, func() {
+		if st := s.remove(handle); st != nil {
+			st.close()
+		}
+	})
+	return handle, nil
+}
+
+// take removes and returns the statement with handle or returns nil if
+// there is no such statement.
+func (s *statements) take(handle string) *statement {
+	st := s.remove(handle)
+	if st != nil {
+		st.timer.Stop()
+	}
+	return st
+}
+
+func (s *statements) remove(handle string) *statement {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.m[handle]
+	delete(s.m, handle)
+	return st
+}
+
+// closeAll closes all of the statements.
+func (s *statements) closeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for handle, st := range s.m {
+		st.timer.Stop()
+		st.close()
+		delete(s.m, handle)
+	}
+}
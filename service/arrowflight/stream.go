@@ -0,0 +1,164 @@
+package arrowflight
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/flight"
+	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/db/branches"
+	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/grants"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/sio"
+	"github.com/brimdata/super/sio/arrowio"
+	"github.com/brimdata/super/vector"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// vectorBatch is implemented by batches materialized from vectors, whose
+// vectors are converted to Arrow arrays without materializing their values.
+type vectorBatch interface {
+	Vector() vector.Any
+}
+
+// stream runs q and returns the schema of its results and a channel of
+// their record batches.  The schema is that of the first result or, if
+// there are no results, an empty schema.
+func stream(ctx context.Context, q runtime.Query) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	s := &streamer{
+		ctx:    ctx,
+		query:  q,
+		schema: make(chan *arrow.Schema, 1),
+		chunks: make(chan flight.StreamChunk),
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		defer close(s.chunks)
+		if err := s.run(); err != nil {
+			if !s.started {
+				errCh <- err
+				return
+			}
+			s.send(flight.StreamChunk{Err: statusError(err)})
+		}
+	}()
+	select {
+	case schema := <-s.schema:
+		return schema, s.chunks, nil
+	case err := <-errCh:
+		return nil, nil, statusError(err)
+	}
+}
+
+type streamer struct {
+	ctx     context.Context
+	query   runtime.Query
+	schema  chan *arrow.Schema
+	chunks  chan flight.StreamChunk
+	started bool
+}
+
+func (s *streamer) run() error {
+	defer s.query.Close()
+	w := arrowio.NewWriter(sio.NopCloser(io.Discard))
+	w.NewWriterFunc = func(_ io.Writer, schema *arrow.Schema) (arrowio.WriteCloser, error) {
+		s.start(schema)
+		return s, nil
+	}
+	for {
+		batch, err := s.query.Pull(false)
+		if err != nil {
+			w.Close()
+			return err
+		}
+		if batch == nil {
+			break
+		}
+		batch, _ = sbuf.Unlabel(batch)
+		if vb, ok := batch.(vectorBatch); ok {
+			err = w.WriteVector(vb.Vector())
+		} else {
+			for _, val := range batch.Values() {
+				if err = w.Write(val); err != nil {
+					break
+				}
+			}
+		}
+		batch.Unref()
+		if err != nil {
+			w.Close()
+			return err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	// Send an empty schema if there were no results.
+	s.start(arrow.NewSchema(nil, nil))
+	return nil
+}
+
+func (s *streamer) start(schema *arrow.Schema) {
+	if !s.started {
+		s.started = true
+		s.schema <- schema
+	}
+}
+
+// Write implements arrowio.WriteCloser.
+func (s *streamer) Write(batch arrow.RecordBatch) error {
+	batch.Retain()
+	if !s.send(flight.StreamChunk{Data: batch}) {
+		batch.Release()
+		return s.ctx.Err()
+	}
+	return nil
+}
+
+// Close implements arrowio.WriteCloser.
+func (s *streamer) Close() error {
+	return nil
+}
+
+func (s *streamer) send(chunk flight.StreamChunk) bool {
+	select {
+	case s.chunks <- chunk:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+// statusError returns a gRPC status error for an error compiling or
+// running a query.
+func statusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := codes.Internal
+	switch {
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, grants.ErrDenied):
+		code = codes.PermissionDenied
+	case errors.Is(err, pools.ErrNotFound) || errors.Is(err, branches.ErrNotFound) ||
+		errors.Is(err, commits.ErrNotFound) || errors.Is(err, fs.ErrNotExist):
+		code = codes.NotFound
+	case errors.Is(err, arrowio.ErrMultipleTypes) || errors.Is(err, arrowio.ErrNotRecord) ||
+		errors.Is(err, arrowio.ErrUnsupportedType):
+		code = codes.InvalidArgument
+	default:
+		var list srcfiles.ErrorList
+		if errors.As(err, &list) {
+			code = codes.InvalidArgument
+		}
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,52 @@
+package arrowflight
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/sio/csupio"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamVectors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.csup")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := csupio.NewWriter(f)
+	sctx := super.NewContext()
+	for _, s := range []string{`{a:1::int32,b:1.5,s:"x",t:true}`, `{a:2::int32,b:2.5,s:"y",t:false}`} {
+		require.NoError(t, w.Write(sup.MustParseValue(sctx, s)))
+	}
+	require.NoError(t, w.Close())
+	ast, err := parser.ParseText("from '" + path + "'")
+	require.NoError(t, err)
+	env := exec.NewEnvironment(storage.NewLocalEngine(), nil)
+	env.Runtime = exec.RuntimeVAM
+	q, err := runtime.CompileQuery(t.Context(), sctx, compiler.NewCompilerWithEnv(env), ast, nil)
+	require.NoError(t, err)
+	schema, chunks, err := stream(t.Context(), q)
+	require.NoError(t, err)
+	assert.Equal(t, "schema:\n  fields: 4\n    - a: type=int32\n    - b: type=float64\n    - s: type=utf8\n    - t: type=bool", schema.String())
+	var rows int64
+	for chunk := range chunks {
+		require.NoError(t, chunk.Err)
+		rec := chunk.Data
+		assert.Equal(t, []int32{1, 2}, rec.Column(0).(*array.Int32).Int32Values())
+		assert.Equal(t, []float64{1.5, 2.5}, rec.Column(1).(*array.Float64).Float64Values())
+		assert.Equal(t, "x", rec.Column(2).(*array.String).Value(0))
+		assert.False(t, rec.Column(3).(*array.Boolean).Value(1))
+		rows += rec.NumRows()
+		rec.Release()
+	}
+	assert.EqualValues(t, 2, rows)
+}
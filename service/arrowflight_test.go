@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/flight"
+	"github.com/apache/arrow-go/v18/arrow/flight/flightsql"
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestFlightSQLQuery(t *testing.T) {
+	core, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader(`{a:1,s:"x"} {a:2,s:"y"}`))
+	client := newFlightSQLClient(t, core)
+	ctx := t.Context()
+	assert.Equal(t, []string{`{a:1,s:"x"}`, `{a:2,s:"y"}`}, flightSQLQuery(t, ctx, client, "from test | sort a"))
+	assert.Empty(t, flightSQLQuery(t, ctx, client, "from test | where a == 3"))
+	_, err := client.Execute(ctx, "from test |")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = flightSQLGet(ctx, client, "values 1")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestFlightSQLCatalogs(t *testing.T) {
+	core, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	commit := conn.TestLoad(poolID, "main", strings.NewReader(`{a:1}`))
+	conn.TestBranchPost(poolID, api.BranchPostRequest{Name: "dev", Commit: commit.String()})
+	conn.TestPoolPost(api.PoolPostRequest{Name: "other"})
+	client := newFlightSQLClient(t, core)
+	ctx := t.Context()
+	info, err := client.GetCatalogs(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`{catalog_name:"other"}`, `{catalog_name:"test"}`}, flightSQLRecords(t, ctx, client, info))
+	catalog, pattern := "test", "d%"
+	info, err = client.GetDBSchemas(ctx, &flightsql.GetDBSchemasOpts{Catalog: &catalog, DbSchemaFilterPattern: &pattern})
+	require.NoError(t, err)
+	assert.Equal(t, []string{`{catalog_name:"test",db_schema_name:"dev"}`}, flightSQLRecords(t, ctx, client, info))
+}
+
+func TestFlightSQLAuth(t *testing.T) {
+	core, conn := newCoreWithConfig(t, service.Config{Auth: testAuthConfig()})
+	conn.SetAuthToken(genToken(t, "tenant", "user"))
+	conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	client := newFlightSQLClient(t, core)
+	_, err := client.Execute(t.Context(), "from :pools | values {name}")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	ctx := metadata.AppendToOutgoingContext(t.Context(), "authorization", "Bearer badtoken")
+	_, err = client.Execute(ctx, "from :pools | values {name}")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	ctx = metadata.AppendToOutgoingContext(t.Context(), "authorization", "Bearer "+genToken(t, "tenant", "user"))
+	assert.Equal(t, []string{`{name:"test"}`}, flightSQLQuery(t, ctx, client, "from :pools | values {name}"))
+}
+
+func newFlightSQLClient(t *testing.T, core *service.Core) *flightsql.Client {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go core.ServeFlightSQL(t.Context(), l)
+	client, err := flightsql.NewClient(l.Addr().String(), nil, nil, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// flightSQLQuery runs query and returns its results as SUP.
+func flightSQLQuery(t *testing.T, ctx context.Context, client *flightsql.Client, query string) []string {
+	vals, err := flightSQLGet(ctx, client, query)
+	require.NoError(t, err)
+	return vals
+}
+
+func flightSQLGet(ctx context.Context, client *flightsql.Client, query string) ([]string, error) {
+	info, err := client.Execute(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return readFlightSQLRecords(ctx, client, info)
+}
+
+func flightSQLRecords(t *testing.T, ctx context.Context, client *flightsql.Client, info *flight.FlightInfo) []string {
+	vals, err := readFlightSQLRecords(ctx, client, info)
+	require.NoError(t, err)
+	return vals
+}
+
+func readFlightSQLRecords(ctx context.Context, client *flightsql.Client, info *flight.FlightInfo) ([]string, error) {
+	r, err := client.DoGet(ctx, info.Endpoint[0].Ticket)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Release()
+	var vals []string
+	for r.Next() {
+		rec := r.RecordBatch()
+		for i := range int(rec.NumRows()) {
+			var fields []string
+			for j, col := range rec.Columns() {
+				fields = append(fields, rec.ColumnName(j)+":"+supString(col, i))
+			}
+			vals = append(vals, "{"+strings.Join(fields, ",")+"}")
+		}
+	}
+	return vals, r.Err()
+}
+
+func supString(col arrow.Array, i int) string {
+	switch col := col.(type) {
+	case *array.String:
+		return `"` + col.Value(i) + `"`
+	case *array.Float64:
+		return strconv.FormatFloat(col.Value(i), 'g', -1, 64)
+	}
+	return col.ValueStr(i)
+}
@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"flag"
+	"fmt"
+	"slices"
 
 	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/grants"
 	"github.com/brimdata/super/service/auth"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 )
 
@@ -16,6 +21,12 @@ type AuthConfig struct {
 	Enabled  bool
 	JWKSPath string
 
+	// When RBAC is true, access to pools is limited to the permissions
+	// granted to the roles of the user, and members of AdminRole have
+	// admin permission on all pools.
+	RBAC      bool
+	AdminRole string
+
 	// Audience, ClientID, and Domain are sent in the /auth/method response so API
 	// clients can interact with the right Auth0 tenant (production, testing, etc.)
 	// to obtain tokens.
@@ -30,11 +41,15 @@ func (c *AuthConfig) SetFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.ClientID, "auth.clientid", "", "Auth0 client ID for API clients (will be publicly accessible)")
 	fs.StringVar(&c.Domain, "auth.domain", "", "Auth0 domain (as a URL) for API clients (will be publicly accessible)")
 	fs.StringVar(&c.JWKSPath, "auth.jwkspath", "", "path to JSON Web Key Set file")
+	fs.BoolVar(&c.RBAC, "auth.rbac", false, "limit access to pools to the permissions granted to user roles")
+	fs.StringVar(&c.AdminRole, "auth.adminrole", "admin", "role with admin permission on all pools when auth.rbac is set")
 }
 
 type Auth0Authenticator struct {
+	adminRole      string
 	logger         *zap.Logger
 	methodResponse api.AuthMethodResponse
+	rbac           bool
 	unauthorized   prometheus.Counter
 	validator      *auth.TokenValidator
 }
@@ -55,7 +70,8 @@ func NewAuthenticator(ctx context.Context, logger *zap.Logger, registerer promet
 		Help: "Number of request errors due to bad or missing authorization.",
 	})
 	return &Auth0Authenticator{
-		logger: logger.Named("auth"),
+		adminRole: config.AdminRole,
+		logger:    logger.Named("auth"),
 		methodResponse: api.AuthMethodResponse{
 			Kind: api.AuthMethodAuth0,
 			Auth0: &api.AuthMethodAuth0Details{
@@ -64,6 +80,7 @@ func NewAuthenticator(ctx context.Context, logger *zap.Logger, registerer promet
 				ClientID: config.ClientID,
 			},
 		},
+		rbac:         config.RBAC,
 		unauthorized: unauthorized,
 		validator:    validator,
 	}, nil
@@ -80,13 +97,48 @@ func (a *Auth0Authenticator) Middleware(next func(*Core, *ResponseWriter, *Reque
 			w.Error(err)
 			return
 		}
-		ctx := auth.ContextWithAuthToken(r.Context(), token)
-		ctx = auth.ContextWithIdentity(ctx, ident)
-		r.Request = r.WithContext(ctx)
+		r.Request = r.WithContext(a.newContext(r.Context(), c.root, token, ident))
 		next(c, w, r)
 	}
 }
 
+// newContext returns a copy of ctx for the user with token and ident.
+func (a *Auth0Authenticator) newContext(ctx context.Context, root *db.Root, token string, ident auth.Identity) context.Context {
+	ctx = auth.ContextWithAuthToken(ctx, token)
+	ctx = auth.ContextWithIdentity(ctx, ident)
+	if a.rbac {
+		ctx = db.ContextWithAuthorizer(ctx, a.authorizer(root))
+	}
+	return ctx
+}
+
+// authorizer returns a db.Authorizer that permits access to pools
+// according to the roles of the identity of its context and the grants
+// stored in root.
+func (a *Auth0Authenticator) authorizer(root *db.Root) db.Authorizer {
+	return func(ctx context.Context, pool ksuid.KSUID, perm grants.Permission) error {
+		ident := auth.IdentityFromContext(ctx)
+		if a.adminRole != "" && slices.Contains(ident.Roles, a.adminRole) {
+			return nil
+		}
+		list, err := root.Grants(ctx)
+		if err != nil {
+			return err
+		}
+		if grants.Lookup(list, ident.Roles, pool) >= perm {
+			return nil
+		}
+		what := "all pools"
+		if pool != ksuid.Nil {
+			what = "pool " + pool.String()
+			if p, err := root.OpenPool(ctx, pool); err == nil {
+				what = fmt.Sprintf("pool %q", p.Name)
+			}
+		}
+		return fmt.Errorf("%w: %s permission on %s required", grants.ErrDenied, perm, what)
+	}
+}
+
 func (a *Auth0Authenticator) MethodResponse() api.AuthMethodResponse {
 	return a.methodResponse
 }
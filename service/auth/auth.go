@@ -15,6 +15,8 @@ const (
 type Identity struct {
 	TenantID TenantID
 	UserID   UserID
+	// Roles are the roles whose permissions are granted to the user.
+	Roles []string
 }
 
 type identityKey struct{}
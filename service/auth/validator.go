@@ -19,6 +19,9 @@ const (
 	// access token.
 	TenantIDClaim = "https://db.brimdata.io/tenant_id"
 	UserIDClaim   = "https://db.brimdata.io/user_id"
+	// RolesClaim is an optional claim whose value is an array of the
+	// names of the roles of the user.
+	RolesClaim = "https://db.brimdata.io/roles"
 )
 
 type TokenValidator struct {
@@ -90,7 +93,7 @@ func (v *TokenValidator) Validate(token string) (Identity, error) {
 	if !claims.VerifyIssuer(v.expectedIssuer, true) {
 		return Identity{}, srverr.ErrNoCredentials("invalid issuer")
 	}
-	ident := Identity{AnonymousTenantID, AnonymousUserID}
+	ident := Identity{TenantID: AnonymousTenantID, UserID: AnonymousUserID}
 	if v, ok := claims[TenantIDClaim]; ok {
 		s, _ := v.(string)
 		if s == "" || TenantID(s) == AnonymousTenantID {
@@ -105,6 +108,19 @@ func (v *TokenValidator) Validate(token string) (Identity, error) {
 		}
 		ident.UserID = UserID(s)
 	}
+	if v, ok := claims[RolesClaim]; ok {
+		roles, ok := v.([]any)
+		if !ok {
+			return Identity{}, srverr.ErrNoCredentials("invalid roles")
+		}
+		for _, role := range roles {
+			s, ok := role.(string)
+			if !ok || s == "" {
+				return Identity{}, srverr.ErrNoCredentials("invalid roles")
+			}
+			ident.Roles = append(ident.Roles, s)
+		}
+	}
 	return ident, nil
 }
 
@@ -34,7 +34,7 @@ func TestValidate(t *testing.T) {
 		UserID:   "test_user_id",
 	}
 	token, err := GenerateAccessToken(testKeyID, testKeyFile, 1*time.Hour,
-		testAudience, "https://testdomain", "test_tenant_id", "test_user_id")
+		testAudience, "https://testdomain", "test_tenant_id", "test_user_id", nil)
 	require.NoError(t, err)
 	validator := testValidator(t)
 
@@ -65,7 +65,20 @@ func TestValidateNoTenantIDAndUserIDClaims(t *testing.T) {
 	require.NoError(t, err)
 	ident, err := testValidator(t).Validate(token)
 	require.NoError(t, err)
-	require.Equal(t, Identity{AnonymousTenantID, AnonymousUserID}, ident)
+	require.Equal(t, Identity{TenantID: AnonymousTenantID, UserID: AnonymousUserID}, ident)
+}
+
+func TestRoles(t *testing.T) {
+	token, err := GenerateAccessToken(testKeyID, testKeyFile, 1*time.Hour,
+		testAudience, "https://testdomain", "test_tenant_id", "test_user_id", []string{"analyst", "ops"})
+	require.NoError(t, err)
+	ident, err := testValidator(t).Validate(token)
+	require.NoError(t, err)
+	require.Equal(t, Identity{
+		TenantID: "test_tenant_id",
+		UserID:   "test_user_id",
+		Roles:    []string{"analyst", "ops"},
+	}, ident)
 }
 
 func TestBadClaims(t *testing.T) {
@@ -170,6 +183,28 @@ func TestBadClaims(t *testing.T) {
 				UserIDClaim:   "test_user_id",
 			}),
 		},
+		{
+			name: "roles not an array",
+			token: genToken(t, jwt.MapClaims{
+				"aud":         testAudience,
+				"exp":         time.Now().Add(1 * time.Hour).Unix(),
+				"iss":         "https://testdomain/",
+				TenantIDClaim: "test_tenant_id",
+				UserIDClaim:   "test_user_id",
+				RolesClaim:    "analyst",
+			}),
+		},
+		{
+			name: "empty role",
+			token: genToken(t, jwt.MapClaims{
+				"aud":         testAudience,
+				"exp":         time.Now().Add(1 * time.Hour).Unix(),
+				"iss":         "https://testdomain/",
+				TenantIDClaim: "test_tenant_id",
+				UserIDClaim:   "test_user_id",
+				RolesClaim:    []string{"analyst", ""},
+			}),
+		},
 	}
 
 	for _, c := range cases {
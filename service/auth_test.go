@@ -3,12 +3,14 @@ package service_test
 import (
 	"errors"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/api/client"
 	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/service"
 	"github.com/brimdata/super/service/auth"
 	"github.com/stretchr/testify/require"
@@ -24,10 +26,10 @@ func testAuthConfig() service.AuthConfig {
 	}
 }
 
-func genToken(t *testing.T, tenantID auth.TenantID, userID auth.UserID) string {
+func genToken(t *testing.T, tenantID auth.TenantID, userID auth.UserID, roles ...string) string {
 	ac := testAuthConfig()
 	token, err := auth.GenerateAccessToken("testkey", "testdata/auth-private-key",
-		1*time.Hour, ac.Audience, ac.Domain, tenantID, userID)
+		1*time.Hour, ac.Audience, ac.Domain, tenantID, userID, roles)
 	require.NoError(t, err)
 	return token
 }
@@ -37,7 +39,7 @@ func TestAuthIdentity(t *testing.T) {
 	core, conn := newCoreWithConfig(t, service.Config{
 		Auth: authConfig,
 	})
-	_, err := conn.Query(t.Context(), srcfiles.Plain("from [pools]"))
+	_, err := conn.Query(t.Context(), srcfiles.Plain("from [pools]"), nil)
 	require.Error(t, err)
 	require.Equal(t, 1.0, promCounterValue(core.Registry(), "request_errors_unauthorized_total"))
 
@@ -59,10 +61,111 @@ func TestAuthIdentity(t *testing.T) {
 		UserID:   "test_user_id",
 	}, res)
 
-	_, err = conn.Query(t.Context(), srcfiles.Plain("from :pools"))
+	_, err = conn.Query(t.Context(), srcfiles.Plain("from :pools"), nil)
 	require.NoError(t, err)
 }
 
+func TestAuthRBAC(t *testing.T) {
+	authConfig := testAuthConfig()
+	authConfig.RBAC = true
+	authConfig.AdminRole = "admin"
+	_, conn := newCoreWithConfig(t, service.Config{Auth: authConfig})
+	adminToken := genToken(t, "tenant", "admin_user", "admin")
+	userToken := genToken(t, "tenant", "user", "analyst")
+	requireStatus := func(t *testing.T, code int, err error) {
+		var errRes *client.ErrorResponse
+		require.True(t, errors.As(err, &errRes), "%v", err)
+		require.Equal(t, code, errRes.StatusCode)
+	}
+
+	conn.SetAuthToken(adminToken)
+	poolA := conn.TestPoolPost(api.PoolPostRequest{Name: "a"})
+	conn.TestLoad(poolA, "main", strings.NewReader("1"))
+	poolB := conn.TestPoolPost(api.PoolPostRequest{Name: "b"})
+	conn.TestLoad(poolB, "main", strings.NewReader("2"))
+
+	conn.SetAuthToken(userToken)
+	require.Equal(t, []string{"analyst"}, conn.TestAuthIdentity().Roles)
+	_, err := conn.Query(t.Context(), srcfiles.Plain("from a"), nil)
+	requireStatus(t, http.StatusForbidden, err)
+	_, err = conn.CreatePool(t.Context(), api.PoolPostRequest{Name: "c"})
+	requireStatus(t, http.StatusForbidden, err)
+	requireStatus(t, http.StatusForbidden, conn.Grant(t.Context(), "a", "analyst", "admin"))
+
+	conn.SetAuthToken(adminToken)
+	require.NoError(t, conn.Grant(t.Context(), "a", "analyst", "read"))
+
+	conn.SetAuthToken(userToken)
+	require.Equal(t, "1\n", conn.TestQuery("from a"))
+	require.Equal(t, "\"a\"\n", conn.TestQuery("from :pools | values name"))
+	require.Equal(t, "\"a\"\n", conn.TestQuery("from :branches | values pool.name"))
+	_, err = conn.Query(t.Context(), srcfiles.Plain("from b"), nil)
+	requireStatus(t, http.StatusForbidden, err)
+	_, err = conn.Query(t.Context(), srcfiles.Plain("from a | join (from b) on left.this=right.this"), nil)
+	requireStatus(t, http.StatusForbidden, err)
+	_, err = conn.Load(t.Context(), poolA, "main", "", strings.NewReader("3"), api.CommitMessage{})
+	requireStatus(t, http.StatusForbidden, err)
+	requireStatus(t, http.StatusForbidden, conn.RemovePool(t.Context(), poolA))
+	grants, err := conn.Grants(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, grants)
+
+	conn.SetAuthToken(adminToken)
+	require.NoError(t, conn.Grant(t.Context(), "*", "analyst", "load"))
+	grants, err = conn.Grants(t.Context())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []api.Grant{
+		{Role: "analyst", Pool: "a", Permission: "read"},
+		{Role: "analyst", Pool: "*", Permission: "load"},
+	}, grants)
+
+	conn.SetAuthToken(userToken)
+	conn.TestLoad(poolB, "main", strings.NewReader("3"))
+	require.Equal(t, "2\n3\n", conn.TestQuery("from b | sort this"))
+	requireStatus(t, http.StatusForbidden, conn.RemovePool(t.Context(), poolB))
+
+	conn.SetAuthToken(adminToken)
+	require.NoError(t, conn.Revoke(t.Context(), "*", "analyst"))
+	requireStatus(t, http.StatusNotFound, conn.Revoke(t.Context(), "*", "analyst"))
+
+	conn.SetAuthToken(userToken)
+	_, err = conn.Query(t.Context(), srcfiles.Plain("from b"), nil)
+	requireStatus(t, http.StatusForbidden, err)
+	require.Equal(t, "1\n", conn.TestQuery("from a"))
+
+	// Events are only sent for pools the subscriber may read.
+	ev, err := conn.SubscribeEvents(t.Context())
+	require.NoError(t, err)
+	defer ev.Close()
+	conn.SetAuthToken(adminToken)
+	conn.TestLoad(poolB, "main", strings.NewReader("4"))
+	commit := conn.TestLoad(poolA, "main", strings.NewReader("4"))
+	kind, v, err := ev.Recv()
+	require.NoError(t, err)
+	require.Equal(t, "branch-commit", kind)
+	require.Equal(t, &api.EventBranchCommit{PoolID: poolA, Branch: "main", CommitID: commit}, v)
+
+	// The status of a query is only available to its issuer or an admin.
+	req := conn.NewRequest(t.Context(), http.MethodPost, "/query", api.QueryRequest{Query: "from a"})
+	req.Header.Set("X-Request-ID", "admin-query")
+	_, err = conn.Do(req)
+	require.NoError(t, err)
+	status := conn.NewRequest(t.Context(), http.MethodGet, "/query/status/admin-query", nil)
+	_, err = conn.Do(status)
+	require.NoError(t, err)
+	conn.SetAuthToken(userToken)
+	_, err = conn.Do(conn.NewRequest(t.Context(), http.MethodGet, "/query/status/admin-query", nil))
+	requireStatus(t, http.StatusForbidden, err)
+}
+
+func TestAuthRBACRequiresAuth(t *testing.T) {
+	_, err := service.NewCore(t.Context(), service.Config{
+		Auth: service.AuthConfig{RBAC: true},
+		Root: storage.MustParseURI(t.TempDir()),
+	})
+	require.EqualError(t, err, "auth.rbac requires auth.enabled")
+}
+
 func TestAuthMethodGet(t *testing.T) {
 	t.Run("none", func(t *testing.T) {
 		_, connNoAuth := newCoreWithConfig(t, service.Config{})
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/sbuf"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// backend authenticates the clients of protocols other than HTTP, e.g.,
+// the PostgreSQL wire protocol, with access tokens and runs their queries
+// like handleQuery.
+type backend struct {
+	core     *Core
+	protocol string
+}
+
+func (b *backend) AuthRequired() bool {
+	return b.core.auth != nil
+}
+
+func (b *backend) Authenticate(ctx context.Context, user, password string) (context.Context, error) {
+	a := b.core.auth
+	if a == nil {
+		return ctx, nil
+	}
+	if password == "" {
+		a.unauthorized.Inc()
+		return nil, errors.New("no access token provided")
+	}
+	ident, err := a.validator.Validate(password)
+	if err != nil {
+		a.unauthorized.Inc()
+		a.logger.Info("Unauthorized client", zap.String("protocol", b.protocol), zap.String("user", user), zap.Error(err))
+		return nil, err
+	}
+	return a.newContext(ctx, b.core.root, password, ident), nil
+}
+
+func (b *backend) Query(ctx context.Context, ast *parser.AST, text string) (runtime.Query, error) {
+	id := ksuid.New().String()
+	ctx = context.WithValue(ctx, api.RequestIDHeader, id)
+	b.core.logger.Debug("Running Query", zap.String("protocol", b.protocol), zap.String("request_id", id), zap.String("query", text))
+	ctx, cancel := context.WithCancelCause(ctx)
+	q, err := runtime.CompileQueryForDB(ctx, super.NewContext(), b.core.compiler, ast)
+	if err != nil {
+		cancel(nil)
+		return nil, err
+	}
+	status := b.core.newQueryStatus(ctx, text, q.Meter(), cancel)
+	return &backendQuery{Query: q, ctx: ctx, cancel: cancel, status: status}, nil
+}
+
+// backendQuery reports the status of a query so it appears among the
+// running queries and can be killed.
+type backendQuery struct {
+	runtime.Query
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	status *queryStatus
+	closed bool
+}
+
+func (q *backendQuery) Pull(done bool) (sbuf.Batch, error) {
+	batch, err := q.Query.Pull(done)
+	if err != nil {
+		if q.ctx.Err() != nil {
+			// Report why the query was canceled, e.g., errQueryKilled.
+			err = context.Cause(q.ctx)
+		}
+		q.status.setError(err)
+	}
+	return batch, err
+}
+
+func (q *backendQuery) Close() error {
+	if q.closed {
+		return nil
+	}
+	q.closed = true
+	q.cancel(nil)
+	err := q.Query.Close()
+	q.status.Done()
+	return err
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
@@ -8,20 +9,27 @@ import (
 	"io"
 	"net/http"
 	"net/http/pprof"
+	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/compiler"
 	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
+	"github.com/brimdata/super/runtime/vcache"
+	"github.com/brimdata/super/sbuf"
+	"github.com/brimdata/super/service/auth"
 	"github.com/brimdata/super/sup"
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 )
 
@@ -48,6 +56,7 @@ type Config struct {
 	DefaultResponseFormat string
 	Root                  *storage.URI
 	RootContent           io.ReadSeeker
+	VectorCache           vcache.Config
 	Version               string
 	Logger                *zap.Logger
 }
@@ -89,6 +98,9 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 	registry.MustRegister(collectors.NewGoCollector())
 
 	var authenticator *Auth0Authenticator
+	if conf.Auth.RBAC && !conf.Auth.Enabled {
+		return nil, errors.New("auth.rbac requires auth.enabled")
+	}
 	if conf.Auth.Enabled {
 		var err error
 		if authenticator, err = NewAuthenticator(ctx, conf.Logger, registry, conf.Auth); err != nil {
@@ -112,6 +124,8 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 	if err != nil {
 		return nil, err
 	}
+	root.VectorCache().Configure(conf.VectorCache)
+	registry.MustRegister(root.VectorCache())
 
 	routerAux := mux.NewRouter()
 	routerAux.Use(corsMiddleware(conf.CORSAllowedOrigins))
@@ -166,6 +180,9 @@ func NewCore(ctx context.Context, conf Config) (*Core, error) {
 }
 
 func (c *Core) addAPIServerRoutes() {
+	c.authhandle("/auth/grant", handleGrantList).Methods("GET")
+	c.authhandle("/auth/grant/{pool}", handleGrantPost).Methods("POST")
+	c.authhandle("/auth/grant/{pool}/{role}", handleGrantDelete).Methods("DELETE")
 	c.authhandle("/auth/identity", handleAuthIdentityGet).Methods("GET")
 	// /auth/method intentionally requires no authentication
 	c.routerAPI.Handle("/auth/method", c.handler(handleAuthMethodGet)).Methods("GET")
@@ -182,13 +199,24 @@ func (c *Core) addAPIServerRoutes() {
 	c.authhandle("/pool/{pool}/branch/{branch}/delete", handleDelete).Methods("POST")
 	c.authhandle("/pool/{pool}/branch/{branch}/merge/{child}", handleBranchMerge).Methods("POST")
 	c.authhandle("/pool/{pool}/branch/{branch}/revert/{commit}", handleRevertPost).Methods("POST")
+	c.authhandle("/pool/{pool}/branch/{branch}/view", handleViewPost).Methods("POST")
 	c.authhandle("/pool/{pool}/revision/{revision}/vacuum", handleVacuum).Methods("POST")
 	c.authhandle("/pool/{pool}/revision/{revision}/vector", handleVectorPost).Methods("POST")
 	c.authhandle("/pool/{pool}/revision/{revision}/vector", handleVectorDelete).Methods("DELETE")
+	c.authhandle("/pool/{pool}/contract", handlePoolContractPut).Methods("PUT")
+	c.authhandle("/pool/{pool}/retention", handlePoolRetentionPut).Methods("PUT")
 	c.authhandle("/pool/{pool}/stats", handlePoolStats).Methods("GET")
+	c.authhandle("/pool/{pool}/tag", handleTagGet).Methods("GET")
+	c.authhandle("/pool/{pool}/tag", handleTagPost).Methods("POST")
+	c.authhandle("/pool/{pool}/tag/{tag}", handleTagDelete).Methods("DELETE")
 	c.authhandle("/query", handleQuery).Methods("OPTIONS", "POST")
+	c.authhandle("/query", handleQueryList).Methods("GET")
 	c.authhandle("/query/describe", handleQueryDescribe).Methods("OPTIONS", "POST")
+	c.authhandle("/query/{requestID}", handleQueryKill).Methods("DELETE")
 	c.authhandle("/query/status/{requestID}", handleQueryStatus).Methods("GET")
+	c.authhandle("/view", handleViewList).Methods("GET")
+	c.authhandle("/view/{view}", handleViewDelete).Methods("DELETE")
+	c.authhandle("/view/{view}/refresh", handleViewRefresh).Methods("POST")
 }
 
 func (c *Core) handler(f func(*Core, *ResponseWriter, *Request)) http.Handler {
@@ -227,8 +255,17 @@ func (c *Core) publishEvent(w *ResponseWriter, name string, data any) {
 		w.Logger.Error("Error marshaling published event", zap.Error(err))
 		return
 	}
+	var pool ksuid.KSUID
+	switch data := data.(type) {
+	case api.EventPool:
+		pool = data.PoolID
+	case api.EventBranch:
+		pool = data.PoolID
+	case api.EventBranchCommit:
+		pool = data.PoolID
+	}
 	go func() {
-		ev := event{name: name, value: zv}
+		ev := event{name: name, value: zv, pool: pool}
 		c.subscriptionsMu.RLock()
 		for sub := range c.subscriptions {
 			sub <- ev
@@ -237,8 +274,8 @@ func (c *Core) publishEvent(w *ResponseWriter, name string, data any) {
 	}()
 }
 
-func (c *Core) newQueryStatus(r *Request) *queryStatus {
-	id := r.ID()
+func (c *Core) newQueryStatus(ctx context.Context, query string, meter sbuf.Meter, cancel context.CancelCauseFunc) *queryStatus {
+	id := api.RequestIDFromContext(ctx)
 	remove := func() {
 		// Have query status wait around for a few seconds after done is signaled
 		// so late arriving queryStatus requests can still get the status.
@@ -247,7 +284,14 @@ func (c *Core) newQueryStatus(r *Request) *queryStatus {
 		delete(c.runningQueries, id)
 		c.runningQueriesMu.Unlock()
 	}
-	q := &queryStatus{remove: remove}
+	q := &queryStatus{
+		remove:    remove,
+		cancel:    cancel,
+		ident:     auth.IdentityFromContext(ctx),
+		meter:     meter,
+		query:     query,
+		startTime: nano.Now(),
+	}
 	q.wg.Add(1)
 	c.runningQueriesMu.Lock()
 	c.runningQueries[id] = q
@@ -259,6 +303,41 @@ type queryStatus struct {
 	wg     sync.WaitGroup
 	remove func()
 	error  string
+
+	cancel    context.CancelCauseFunc
+	done      atomic.Bool
+	ident     auth.Identity
+	meter     sbuf.Meter
+	query     string
+	startTime nano.Ts
+}
+
+func (q *queryStatus) info(id string) api.QueryInfo {
+	return api.QueryInfo{
+		RequestID: id,
+		TenantID:  string(q.ident.TenantID),
+		UserID:    string(q.ident.UserID),
+		Query:     q.query,
+		StartTime: q.startTime,
+		Progress:  q.meter.Progress(),
+	}
+}
+
+// runningQueryInfos returns a description of each query that has not
+// finished and for which visible returns true ordered by start time.
+func (c *Core) runningQueryInfos(visible func(*queryStatus) bool) []api.QueryInfo {
+	c.runningQueriesMu.Lock()
+	defer c.runningQueriesMu.Unlock()
+	infos := []api.QueryInfo{}
+	for id, q := range c.runningQueries {
+		if !q.done.Load() && visible(q) {
+			infos = append(infos, q.info(id))
+		}
+	}
+	slices.SortFunc(infos, func(a, b api.QueryInfo) int {
+		return cmp.Or(cmp.Compare(a.StartTime, b.StartTime), cmp.Compare(a.RequestID, b.RequestID))
+	})
+	return infos
 }
 
 func (q *queryStatus) setError(err error) {
@@ -268,6 +347,7 @@ func (q *queryStatus) setError(err error) {
 }
 
 func (q *queryStatus) Done() {
+	q.done.Store(true)
 	q.wg.Done()
 	go q.remove()
 }
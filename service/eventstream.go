@@ -8,11 +8,15 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/anyio"
+	"github.com/segmentio/ksuid"
 )
 
 type event struct {
 	name  string
 	value super.Value
+	// pool is the ID of the pool that the event is about, which a
+	// subscriber must be permitted to read to receive the event.
+	pool ksuid.KSUID
 }
 
 type eventStreamWriter struct {
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -17,9 +20,14 @@ import (
 	"github.com/brimdata/super/db"
 	dbapi "github.com/brimdata/super/db/api"
 	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/grants"
 	"github.com/brimdata/super/db/journal"
+	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/db/tags"
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/order"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime"
 	"github.com/brimdata/super/runtime/exec"
@@ -31,6 +39,8 @@ import (
 	"github.com/brimdata/super/sio/anyio"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sio/csvio"
+	"github.com/brimdata/super/sio/jsonio"
+	"github.com/brimdata/super/sup"
 	"github.com/segmentio/ksuid"
 	"go.uber.org/zap"
 )
@@ -60,7 +70,16 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(srverr.ErrInvalid(err))
 		return
 	}
-	flowgraph, err := runtime.CompileQueryForDB(r.Context(), super.NewContext(), c.compiler, ast)
+	params, err := paramsToSUP(req.Params, req.SUPParams)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	ast.BindParams(params)
+	ast.SetExplainAnalyze(req.Analyze)
+	ctx, cancel := context.WithCancelCause(r.Context())
+	defer cancel(nil)
+	flowgraph, err := runtime.CompileQueryForDB(ctx, super.NewContext(), c.compiler, ast)
 	if err != nil {
 		w.Error(srverr.ErrInvalid(err))
 		return
@@ -78,9 +97,13 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 	// Launch query status which will report and runtime errors (i.e., system
 	// errors that occur after the OK header has been sent) to the query status
 	// endpoint.
-	status := c.newQueryStatus(r)
+	status := c.newQueryStatus(r.Context(), req.Query, flowgraph.Meter(), cancel)
 	defer status.Done()
 	handleError := func(err error) {
+		if ctx.Err() != nil {
+			// Report why the query was canceled, e.g., errQueryKilled.
+			err = context.Cause(ctx)
+		}
 		writer.WriteError(err)
 		status.setError(err)
 	}
@@ -118,6 +141,13 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 				if err := writer.WriteProgress(meter.Progress()); err != nil {
 					w.Logger.Warn("Error writing progress", zap.Error(err))
 					handleError(err)
+					return
+				}
+				if prof := flowgraph.Profile(); prof != nil {
+					if err := writer.WriteProfile(prof.Report()); err != nil {
+						w.Logger.Warn("Error writing profile", zap.Error(err))
+						handleError(err)
+					}
 				}
 				return
 			}
@@ -142,6 +172,37 @@ func handleQuery(c *Core, w *ResponseWriter, r *Request) {
 	}
 }
 
+// paramsToSUP converts the JSON values of query parameters in params and
+// the SUP values in supParams to super values and returns them in SUP format.
+func paramsToSUP(params map[string]json.RawMessage, supParams map[string]string) (map[string]string, error) {
+	if len(params) == 0 && len(supParams) == 0 {
+		return nil, nil
+	}
+	sctx := super.NewContext()
+	out := make(map[string]string, len(params)+len(supParams))
+	for name, text := range supParams {
+		val, err := sup.ParseValue(sctx, text)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", name, err)
+		}
+		out[name] = sup.FormatValue(val)
+	}
+	for name, raw := range params {
+		if _, ok := out[name]; ok {
+			return nil, fmt.Errorf("parameter %q: value given in both params and sup_params", name)
+		}
+		val, err := jsonio.NewReader(sctx, bytes.NewReader(raw)).Read()
+		if err == nil && val == nil {
+			err = errors.New("missing value")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", name, err)
+		}
+		out[name] = sup.FormatValue(*val)
+	}
+	return out, nil
+}
+
 func handleQueryStatus(c *Core, w *ResponseWriter, r *Request) {
 	id, ok := r.StringFromPath(w, "requestID")
 	if !ok {
@@ -154,10 +215,53 @@ func handleQueryStatus(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(srverr.ErrInvalid("query not found"))
 		return
 	}
+	if !canManageQuery(r, q) {
+		w.Error(srverr.ErrForbidden("query was issued by another user"))
+		return
+	}
 	q.wg.Wait()
 	w.Respond(http.StatusOK, api.QueryError{Error: q.error})
 }
 
+func handleQueryList(c *Core, w *ResponseWriter, r *Request) {
+	w.Respond(http.StatusOK, c.runningQueryInfos(func(q *queryStatus) bool {
+		return canManageQuery(r, q)
+	}))
+}
+
+// canManageQuery returns true if the user of r issued q or has admin
+// permission on all pools.
+func canManageQuery(r *Request, q *queryStatus) bool {
+	ident := auth.IdentityFromContext(r.Context())
+	if ident.TenantID == q.ident.TenantID && ident.UserID == q.ident.UserID {
+		return true
+	}
+	return db.Authorize(r.Context(), ksuid.Nil, grants.Admin) == nil
+}
+
+var errQueryKilled = errors.New("query killed")
+
+func handleQueryKill(c *Core, w *ResponseWriter, r *Request) {
+	id, ok := r.StringFromPath(w, "requestID")
+	if !ok {
+		return
+	}
+	c.runningQueriesMu.Lock()
+	q, ok := c.runningQueries[id]
+	c.runningQueriesMu.Unlock()
+	if !ok || q.done.Load() {
+		w.Error(srverr.ErrNotFound("query not found"))
+		return
+	}
+	if !canManageQuery(r, q) {
+		w.Error(srverr.ErrForbidden("query was issued by another user"))
+		return
+	}
+	r.Logger.Info("Killing query", zap.String("query_request_id", id))
+	q.cancel(errQueryKilled)
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func handleCompile(c *Core, w *ResponseWriter, r *Request) {
 	var req api.QueryRequest
 	if !r.Unmarshal(w, &req) {
@@ -190,24 +294,24 @@ func handleBranchGet(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
-	pool, ok := r.openPool(w, c.root)
+	pool, ok := r.openPool(w, c.root, grants.Read)
 	if !ok {
 		return
 	}
 	if branchName != "" {
-		branch, err := pool.LookupBranchByName(r.Context(), branchName)
+		commit, err := pool.ResolveRevision(r.Context(), branchName)
 		if err != nil {
 			w.Error(err)
 			return
 		}
-		w.Respond(http.StatusOK, api.CommitResponse{Commit: branch.Commit})
+		w.Respond(http.StatusOK, api.CommitResponse{Commit: commit})
 		return
 	}
 	w.Respond(http.StatusOK, pool.Config)
 }
 
 func handlePoolStats(c *Core, w *ResponseWriter, r *Request) {
-	pool, ok := r.openPool(w, c.root)
+	pool, ok := r.openPool(w, c.root, grants.Read)
 	if !ok {
 		return
 	}
@@ -242,11 +346,16 @@ func handlePoolPost(c *Core, w *ResponseWriter, r *Request) {
 		fmt.Println("unmarshal.err")
 		return
 	}
+	if err := db.Authorize(r.Context(), ksuid.Nil, grants.Admin); err != nil {
+		w.Error(err)
+		return
+	}
 	var sortKeys order.SortKeys
 	if len(req.SortKeys.Keys) > 0 {
 		sortKeys = append(sortKeys, order.NewSortKey(req.SortKeys.Order, req.SortKeys.Keys[0]))
 	}
-	pool, err := c.root.CreatePool(r.Context(), req.Name, sortKeys, req.SeekStride, req.Thresh)
+	vector := pools.Vector{BloomFilters: req.BloomFilters, Sketches: req.Sketches}
+	pool, err := c.root.CreatePool(r.Context(), req.Name, sortKeys, req.SeekStride, req.Thresh, vector)
 	if err != nil {
 		w.Error(err)
 		return
@@ -265,7 +374,7 @@ func handlePoolPut(c *Core, w *ResponseWriter, r *Request) {
 	if !r.Unmarshal(w, &req) {
 		return
 	}
-	id, ok := r.PoolID(w, c.root)
+	id, ok := r.PoolID(w, c.root, grants.Admin)
 	if !ok {
 		return
 	}
@@ -277,12 +386,80 @@ func handlePoolPut(c *Core, w *ResponseWriter, r *Request) {
 	c.publishEvent(w, "pool-update", api.EventPool{PoolID: id})
 }
 
+func handlePoolRetentionPut(c *Core, w *ResponseWriter, r *Request) {
+	var req api.PoolRetentionRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	retention := pools.Retention{MaxSize: req.MaxSize}
+	if req.Keep != "" {
+		keep, err := nano.ParseDuration(req.Keep)
+		if err != nil {
+			w.Error(srverr.ErrInvalid(err))
+			return
+		}
+		retention.Keep = keep
+	}
+	if req.MaxAge != "" {
+		maxAge, err := nano.ParseDuration(req.MaxAge)
+		if err != nil {
+			w.Error(srverr.ErrInvalid(err))
+			return
+		}
+		retention.MaxAge = maxAge
+	}
+	if retention.Keep < 0 || retention.MaxSize < 0 || retention.MaxAge < 0 {
+		w.Error(srverr.ErrInvalid("retention policy cannot be negative"))
+		return
+	}
+	id, ok := r.PoolID(w, c.root, grants.Admin)
+	if !ok {
+		return
+	}
+	if err := c.root.SetPoolRetention(r.Context(), id, retention); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+	c.publishEvent(w, "pool-update", api.EventPool{PoolID: id})
+}
+
+func handlePoolContractPut(c *Core, w *ResponseWriter, r *Request) {
+	var req api.PoolContractRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	contract := pools.Contract{
+		Type:       req.Type,
+		Mode:       req.Mode,
+		Quarantine: req.Quarantine,
+		Shaper:     req.Shaper,
+	}
+	if err := contract.Validate(); err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	id, ok := r.PoolID(w, c.root, grants.Admin)
+	if !ok {
+		return
+	}
+	if err := c.root.SetPoolContract(r.Context(), c.compiler, id, contract); err != nil {
+		if errors.As(err, new(*db.ContractShaperError)) {
+			err = srverr.ErrInvalid(err)
+		}
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+	c.publishEvent(w, "pool-update", api.EventPool{PoolID: id})
+}
+
 func handleBranchPost(c *Core, w *ResponseWriter, r *Request) {
 	var req api.BranchPostRequest
 	if !r.Unmarshal(w, &req) {
 		return
 	}
-	poolID, ok := r.PoolID(w, c.root)
+	poolID, ok := r.PoolID(w, c.root, grants.Load)
 	if !ok {
 		return
 	}
@@ -300,8 +477,149 @@ func handleBranchPost(c *Core, w *ResponseWriter, r *Request) {
 	c.publishEvent(w, "branch-update", api.EventBranch{PoolID: poolID, Branch: branchRef.Name})
 }
 
+func handleTagGet(c *Core, w *ResponseWriter, r *Request) {
+	pool, ok := r.openPool(w, c.root, grants.Read)
+	if !ok {
+		return
+	}
+	list, err := pool.ListTags(r.Context())
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	if list == nil {
+		list = []tags.Config{}
+	}
+	w.Respond(http.StatusOK, list)
+}
+
+func handleTagPost(c *Core, w *ResponseWriter, r *Request) {
+	var req api.TagPostRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	pool, ok := r.openPool(w, c.root, grants.Load)
+	if !ok {
+		return
+	}
+	commit, err := pool.ResolveRevision(r.Context(), req.Commit)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	tag, err := pool.CreateTag(r.Context(), req.Name, commit)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, tag)
+}
+
+func handleTagDelete(c *Core, w *ResponseWriter, r *Request) {
+	pool, ok := r.openPool(w, c.root, grants.Delete)
+	if !ok {
+		return
+	}
+	name, ok := r.StringFromPath(w, "tag")
+	if !ok {
+		return
+	}
+	if err := pool.RemoveTag(r.Context(), name); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleViewList(c *Core, w *ResponseWriter, r *Request) {
+	list, err := c.root.Views(r.Context())
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	out := []api.View{}
+	for _, v := range list {
+		// Only readers of a view's results may see it.
+		if db.Authorize(r.Context(), v.Target, grants.Read) != nil {
+			continue
+		}
+		pool := v.Source.String()
+		if p, err := c.root.OpenPool(r.Context(), v.Source); err == nil {
+			pool = p.Name
+		}
+		out = append(out, api.View{Name: v.Name, Query: v.Query, Pool: pool, Branch: v.Branch, Commit: v.Commit})
+	}
+	w.Respond(http.StatusOK, out)
+}
+
+func handleViewPost(c *Core, w *ResponseWriter, r *Request) {
+	var req api.ViewPostRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	pool, ok := r.openPool(w, c.root, grants.Read)
+	if !ok {
+		return
+	}
+	branch, ok := r.StringFromPath(w, "branch")
+	if !ok {
+		return
+	}
+	// A view's results are stored in a new pool.
+	if err := db.Authorize(r.Context(), ksuid.Nil, grants.Admin); err != nil {
+		w.Error(err)
+		return
+	}
+	view, err := c.root.CreateView(r.Context(), c.compiler, req.Name, req.Query, pool.ID, branch)
+	if err != nil {
+		if errors.As(err, new(*db.ViewQueryError)) {
+			err = srverr.ErrInvalid(err)
+		}
+		w.Error(err)
+		return
+	}
+	w.Respond(http.StatusOK, api.View{Name: view.Name, Query: view.Query, Pool: pool.Name, Branch: view.Branch, Commit: view.Commit})
+	c.publishEvent(w, "pool-new", api.EventPool{PoolID: view.Target})
+}
+
+func handleViewRefresh(c *Core, w *ResponseWriter, r *Request) {
+	view, ok := r.view(w, c.root, grants.Load)
+	if !ok {
+		return
+	}
+	commit, err := c.root.RefreshView(r.Context(), c.compiler, view.Name)
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	if commit != ksuid.Nil {
+		c.root.MaintainViews(r.Context(), c.compiler, view.Target, "main")
+	}
+	w.Respond(http.StatusOK, api.CommitResponse{Commit: commit})
+	if commit != ksuid.Nil {
+		c.publishEvent(w, "branch-commit", api.EventBranchCommit{
+			CommitID: commit,
+			PoolID:   view.Target,
+			Branch:   "main",
+		})
+	}
+}
+
+func handleViewDelete(c *Core, w *ResponseWriter, r *Request) {
+	view, ok := r.view(w, c.root, grants.Admin)
+	if !ok {
+		return
+	}
+	if err := c.root.RemoveView(r.Context(), view.Name); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+	c.publishEvent(w, "pool-delete", api.EventPool{PoolID: view.Target})
+}
+
 func handleRevertPost(c *Core, w *ResponseWriter, r *Request) {
-	poolID, ok := r.PoolID(w, c.root)
+	poolID, ok := r.PoolID(w, c.root, grants.Delete)
 	if !ok {
 		return
 	}
@@ -322,6 +640,7 @@ func handleRevertPost(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(err)
 		return
 	}
+	c.root.MaintainViews(r.Context(), c.compiler, poolID, branch)
 	w.Respond(http.StatusOK, api.CommitResponse{Commit: commit})
 	c.publishEvent(w, "branch-commit", api.EventBranchCommit{
 		CommitID: commit,
@@ -331,7 +650,7 @@ func handleRevertPost(c *Core, w *ResponseWriter, r *Request) {
 }
 
 func handleBranchMerge(c *Core, w *ResponseWriter, r *Request) {
-	poolID, ok := r.PoolID(w, c.root)
+	poolID, ok := r.PoolID(w, c.root, grants.Load)
 	if !ok {
 		return
 	}
@@ -352,6 +671,7 @@ func handleBranchMerge(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(err)
 		return
 	}
+	c.root.MaintainViews(r.Context(), c.compiler, poolID, parentBranch)
 	w.Respond(http.StatusOK, api.CommitResponse{Commit: commit})
 	c.publishEvent(w, "branch-commit", api.EventBranchCommit{
 		CommitID: commit,
@@ -362,7 +682,7 @@ func handleBranchMerge(c *Core, w *ResponseWriter, r *Request) {
 }
 
 func handlePoolDelete(c *Core, w *ResponseWriter, r *Request) {
-	id, ok := r.PoolID(w, c.root)
+	id, ok := r.PoolID(w, c.root, grants.Admin)
 	if !ok {
 		return
 	}
@@ -375,7 +695,7 @@ func handlePoolDelete(c *Core, w *ResponseWriter, r *Request) {
 }
 
 func handleBranchDelete(c *Core, w *ResponseWriter, r *Request) {
-	poolID, ok := r.PoolID(w, c.root)
+	poolID, ok := r.PoolID(w, c.root, grants.Delete)
 	if !ok {
 		return
 	}
@@ -408,11 +728,15 @@ func handleBranchLoad(c *Core, w *ResponseWriter, r *Request) {
 		}
 		csvDelim = rune(s[0])
 	}
+	var upsertKey field.Path
+	if s := r.URL.Query().Get("upsert_key"); s != "" {
+		upsertKey = field.Dotted(s)
+	}
 	message, ok := r.decodeCommitMessage(w)
 	if !ok {
 		return
 	}
-	pool, ok := r.openPool(w, c.root)
+	pool, ok := r.openPool(w, c.root, grants.Load)
 	if !ok {
 		return
 	}
@@ -421,12 +745,12 @@ func handleBranchLoad(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(err)
 		return
 	}
-	reader, err := anyio.GzipReader(r.Body)
+	reader, err := anyio.DecompressReader(r.Body)
 	if err != nil {
 		w.Error(err)
 		return
 	}
-	if format == "parquet" || format == "csup" {
+	if format == "parquet" || format == "csup" || format == "orc" {
 		// These formats require a reader that implements io.ReaderAt and
 		// io.Seeker.  Copy the reader to a temporary file and use that.
 		//
@@ -462,7 +786,12 @@ func handleBranchLoad(c *Core, w *ResponseWriter, r *Request) {
 	}
 	defer zrc.Close()
 	wr := &warningsReader{zrc, []string{}}
-	kommit, err := branch.Load(r.Context(), sctx, wr, message.Author, message.Body, message.Meta)
+	var kommit ksuid.KSUID
+	if upsertKey != nil {
+		kommit, err = branch.Upsert(r.Context(), c.compiler, sctx, wr, upsertKey, message.Author, message.Body, message.Meta)
+	} else {
+		kommit, err = branch.Load(r.Context(), c.compiler, sctx, wr, message.Author, message.Body, message.Meta)
+	}
 	if err != nil {
 		if errors.Is(err, commits.ErrEmptyTransaction) {
 			err = srverr.ErrInvalid("no records in request")
@@ -470,9 +799,13 @@ func handleBranchLoad(c *Core, w *ResponseWriter, r *Request) {
 		if errors.Is(err, db.ErrInvalidCommitMeta) {
 			err = srverr.ErrInvalid("invalid commit metadata in request")
 		}
+		if errors.Is(err, db.ErrContractViolation) || errors.Is(err, db.ErrDuplicateUpsertKey) {
+			err = srverr.ErrInvalid(err)
+		}
 		w.Error(err)
 		return
 	}
+	c.root.MaintainViews(r.Context(), c.compiler, pool.ID, branch.Name)
 	w.Respond(http.StatusOK, api.CommitResponse{
 		Warnings: wr.warnings,
 		Commit:   kommit,
@@ -515,7 +848,7 @@ func handleCompact(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
-	pool, ok := r.openPool(w, c.root)
+	pool, ok := r.openPool(w, c.root, grants.Load)
 	if !ok {
 		return
 	}
@@ -524,6 +857,7 @@ func handleCompact(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(err)
 		return
 	}
+	c.root.MaintainViews(r.Context(), c.compiler, pool.ID, branch)
 	w.Respond(http.StatusOK, api.CommitResponse{Commit: commit})
 	c.publishEvent(w, "branch-commit", api.EventBranchCommit{
 		CommitID: commit,
@@ -545,7 +879,7 @@ func handleDelete(c *Core, w *ResponseWriter, r *Request) {
 	if !r.Unmarshal(w, &payload) {
 		return
 	}
-	pool, ok := r.openPool(w, c.root)
+	pool, ok := r.openPool(w, c.root, grants.Delete)
 	if !ok {
 		return
 	}
@@ -587,6 +921,7 @@ func handleDelete(c *Core, w *ResponseWriter, r *Request) {
 		w.Error(err)
 		return
 	}
+	c.root.MaintainViews(r.Context(), c.compiler, pool.ID, branchName)
 	w.Marshal(api.CommitResponse{Commit: commit})
 	c.publishEvent(w, "branch-commit", api.EventBranchCommit{
 		CommitID: commit,
@@ -600,6 +935,9 @@ func handleVacuum(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
+	if _, ok := r.PoolID(w, c.root, grants.Delete); !ok {
+		return
+	}
 	revision, ok := r.StringFromPath(w, "revision")
 	if !ok {
 		return
@@ -622,6 +960,9 @@ func handleVectorPost(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
+	if _, ok := r.PoolID(w, c.root, grants.Load); !ok {
+		return
+	}
 	revision, ok := r.StringFromPath(w, "revision")
 	if !ok {
 		return
@@ -648,6 +989,9 @@ func handleVectorDelete(c *Core, w *ResponseWriter, r *Request) {
 	if !ok {
 		return
 	}
+	if _, ok := r.PoolID(w, c.root, grants.Load); !ok {
+		return
+	}
 	revision, ok := r.StringFromPath(w, "revision")
 	if !ok {
 		return
@@ -674,9 +1018,71 @@ func handleAuthIdentityGet(c *Core, w *ResponseWriter, r *Request) {
 	w.Respond(http.StatusOK, api.AuthIdentityResponse{
 		TenantID: string(ident.TenantID),
 		UserID:   string(ident.UserID),
+		Roles:    ident.Roles,
 	})
 }
 
+func handleGrantList(c *Core, w *ResponseWriter, r *Request) {
+	list, err := c.root.Grants(r.Context())
+	if err != nil {
+		w.Error(err)
+		return
+	}
+	out := []api.Grant{}
+	for _, g := range list {
+		// Only admins of a pool may see the grants on it.
+		if db.Authorize(r.Context(), g.Pool, grants.Admin) != nil {
+			continue
+		}
+		pool := "*"
+		if g.Pool != ksuid.Nil {
+			pool = g.Pool.String()
+			if p, err := c.root.OpenPool(r.Context(), g.Pool); err == nil {
+				pool = p.Name
+			}
+		}
+		out = append(out, api.Grant{Role: g.Role, Pool: pool, Permission: g.Permission})
+	}
+	w.Respond(http.StatusOK, out)
+}
+
+func handleGrantPost(c *Core, w *ResponseWriter, r *Request) {
+	pool, ok := r.grantPoolID(w, c.root)
+	if !ok {
+		return
+	}
+	var req api.GrantRequest
+	if !r.Unmarshal(w, &req) {
+		return
+	}
+	perm, err := grants.ParsePermission(req.Permission)
+	if err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	if err := c.root.Grant(r.Context(), req.Role, pool, perm); err != nil {
+		w.Error(srverr.ErrInvalid(err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleGrantDelete(c *Core, w *ResponseWriter, r *Request) {
+	pool, ok := r.grantPoolID(w, c.root)
+	if !ok {
+		return
+	}
+	role, ok := r.StringFromPath(w, "role")
+	if !ok {
+		return
+	}
+	if err := c.root.Revoke(r.Context(), role, pool); err != nil {
+		w.Error(err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func handleAuthMethodGet(c *Core, w *ResponseWriter, r *Request) {
 	if c.auth == nil {
 		w.Respond(http.StatusOK, api.AuthMethodResponse{Kind: api.AuthMethodNone})
@@ -704,6 +1110,9 @@ func handleEvents(c *Core, w *ResponseWriter, r *Request) {
 	for {
 		select {
 		case ev := <-subscription:
+			if db.Authorize(r.Context(), ev.pool, grants.Read) != nil {
+				continue
+			}
 			if err := writer.writeEvent(ev); err != nil {
 				w.Error(err)
 				continue
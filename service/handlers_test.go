@@ -1,17 +1,27 @@
 package service_test
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/brimdata/super/api"
 	"github.com/brimdata/super/api/client"
+	"github.com/brimdata/super/compiler/srcfiles"
+	dbapi "github.com/brimdata/super/db/api"
 	"github.com/brimdata/super/pkg/nano"
 	"github.com/brimdata/super/pkg/storage"
 	"github.com/brimdata/super/runtime/exec"
+	"github.com/brimdata/super/sbuf"
 	"github.com/brimdata/super/service"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/segmentio/ksuid"
@@ -32,6 +42,264 @@ func TestQuery(t *testing.T) {
 	assert.Equal(t, expected, conn.TestQuery("from test | _path == 'b'"))
 }
 
+func TestQueryParams(t *testing.T) {
+	src := `
+{_path:"b",ts:1970-01-01T00:00:01Z}
+{_path:"a",ts:1970-01-01T00:00:02Z}
+{_path:"b",ts:1970-01-01T00:00:03Z}
+`
+	expected := `{_path:"b",ts:1970-01-01T00:00:03Z}
+`
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader(src))
+	query := "from test | _path == $path and ts > ?"
+	params := map[string]string{"path": `"b"`, "1": "1970-01-01T00:00:01Z"}
+	assert.Equal(t, expected, conn.TestQueryWithParams(query, params))
+	params["path"] = `"b' or true or '"`
+	assert.Equal(t, "", conn.TestQueryWithParams(query, params))
+	_, err := conn.Query(t.Context(), srcfiles.Plain(query), nil)
+	assert.ErrorContains(t, err, "no value bound to positional parameter 1")
+}
+
+func TestQueryParamsJSON(t *testing.T) {
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader(`{s:"a",x:1} {s:"b",x:2} {s:"b",x:3}`))
+	query := func(params map[string]json.RawMessage) (string, error) {
+		body := api.QueryRequest{Query: "from test | s == $s and x > ? | values {x,r:$r}", Params: params}
+		req := conn.NewRequest(t.Context(), http.MethodPost, "/query", body)
+		req.Header.Set("Accept", api.MediaTypeNDJSON)
+		res, err := conn.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer res.Body.Close()
+		b, err := io.ReadAll(res.Body)
+		return string(b), err
+	}
+	out, err := query(map[string]json.RawMessage{
+		"s": json.RawMessage(`"b"`),
+		"1": json.RawMessage(`2`),
+		"r": json.RawMessage(`{"a":[1.5,null,true]}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `{"x":3,"r":{"a":[1.5,null,true]}}`+"\n", out)
+	_, err = query(map[string]json.RawMessage{"s": json.RawMessage(`"b`)})
+	assert.ErrorContains(t, err, `parameter "s"`)
+}
+
+func TestQueryParamsSUP(t *testing.T) {
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader(`
+{ts:1970-01-01T00:00:01Z,addr:10.0.0.1}
+{ts:1970-01-01T00:00:02Z,addr:10.0.0.2}
+{ts:1970-01-01T00:00:03Z,addr:10.0.0.1}
+`))
+	query := func(body api.QueryRequest) (string, error) {
+		body.Query = "from test | addr == $addr and ts > $t | values {ts,net:$net}"
+		req := conn.NewRequest(t.Context(), http.MethodPost, "/query", body)
+		req.Header.Set("Accept", api.MediaTypeSUP)
+		res, err := conn.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer res.Body.Close()
+		b, err := io.ReadAll(res.Body)
+		return string(b), err
+	}
+	out, err := query(api.QueryRequest{SUPParams: map[string]string{
+		"addr": "10.0.0.1",
+		"t":    "1970-01-01T00:00:01Z",
+		"net":  "10.0.0.0/8",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "{ts:1970-01-01T00:00:03Z,net:10.0.0.0/8}\n", out)
+	_, err = query(api.QueryRequest{SUPParams: map[string]string{"addr": "10.0.0."}})
+	assert.ErrorContains(t, err, `parameter "addr"`)
+	_, err = query(api.QueryRequest{
+		Params:    map[string]json.RawMessage{"t": json.RawMessage(`1`)},
+		SUPParams: map[string]string{"t": "1970-01-01T00:00:01Z"},
+	})
+	assert.ErrorContains(t, err, `parameter "t": value given in both params and sup_params`)
+}
+
+func TestQueryAtTime(t *testing.T) {
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	commit := conn.TestLoad(poolID, "main", strings.NewReader("{a:1}"))
+	// Format the time with a positive offset since a plus sign must not
+	// be unescaped as a space in path parameters.
+	at := nano.Now().Time().In(time.FixedZone("", 3600)).Format(time.RFC3339Nano)
+	conn.TestLoad(poolID, "main", strings.NewReader("{a:2}"))
+	assert.Equal(t, "{a:1}\n", conn.TestQuery("from test@'"+at+"'"))
+	res, err := conn.BranchGet(t.Context(), poolID, at)
+	require.NoError(t, err)
+	assert.Equal(t, commit, res.Commit)
+	_, err = conn.BranchGet(t.Context(), poolID, "2000-01-01T00:00:00Z")
+	assert.ErrorIs(t, err, client.ErrBranchNotFound)
+}
+
+func TestTags(t *testing.T) {
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	commit := conn.TestLoad(poolID, "main", strings.NewReader("{a:1}"))
+	tag, err := conn.CreateTag(t.Context(), poolID, api.TagPostRequest{Name: "v1", Commit: "main"})
+	require.NoError(t, err)
+	assert.Equal(t, commit, tag.Commit)
+	_, err = conn.CreateTag(t.Context(), poolID, api.TagPostRequest{Name: "v1", Commit: commit.String()})
+	assert.ErrorIs(t, err, client.ErrTagExists)
+	conn.TestLoad(poolID, "main", strings.NewReader("{a:2}"))
+	assert.Equal(t, "{a:1}\n", conn.TestQuery("from test@v1"))
+	list, err := conn.Tags(t.Context(), poolID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "v1", list[0].Name)
+	require.NoError(t, conn.RemoveTag(t.Context(), poolID, "v1"))
+	err = conn.RemoveTag(t.Context(), poolID, "v1")
+	assert.ErrorContains(t, err, "tag not found")
+}
+
+func TestPoolRetention(t *testing.T) {
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	err := conn.SetPoolRetention(t.Context(), poolID, api.PoolRetentionRequest{Keep: "90d", MaxSize: 1024, MaxAge: "30d"})
+	require.NoError(t, err)
+	assert.Equal(t, "{keep:90d,max_size:1024,max_age:30d}::=pools.Retention\n", conn.TestQuery("from :pools | values retention"))
+	err = conn.SetPoolRetention(t.Context(), poolID, api.PoolRetentionRequest{Keep: "-1d"})
+	assert.ErrorContains(t, err, "retention policy cannot be negative")
+	err = conn.SetPoolRetention(t.Context(), poolID, api.PoolRetentionRequest{MaxAge: "-1d"})
+	assert.ErrorContains(t, err, "retention policy cannot be negative")
+	err = conn.SetPoolRetention(t.Context(), poolID, api.PoolRetentionRequest{Keep: "soon"})
+	assert.ErrorContains(t, err, "invalid duration")
+}
+
+func TestPoolContract(t *testing.T) {
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	err := conn.SetPoolContract(t.Context(), poolID, api.PoolContractRequest{Type: "{a:int64}", Mode: "coerce"})
+	require.NoError(t, err)
+	_, err = conn.Load(t.Context(), poolID, "main", "", strings.NewReader(`{a:1} {a:"x"}`), api.CommitMessage{})
+	var reqErr *client.ErrorResponse
+	require.ErrorAs(t, err, &reqErr)
+	assert.Equal(t, http.StatusBadRequest, reqErr.StatusCode)
+	assert.ErrorContains(t, err, "offset 1: type {a:string}")
+	conn.TestLoad(poolID, "main", strings.NewReader(`{a:1} {a:"2"}`))
+	assert.Equal(t, "{a:2}\n{a:1}\n", conn.TestQuery("from test"))
+	err = conn.SetPoolContract(t.Context(), poolID, api.PoolContractRequest{Type: "{a:int64}", Mode: "lenient"})
+	assert.ErrorContains(t, err, `unknown contract mode "lenient"`)
+}
+
+func TestUpsert(t *testing.T) {
+	_, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader(`{a:1,b:"x"} {a:2,b:"x"}`))
+	_, err := conn.Upsert(t.Context(), poolID, "main", "", "a", strings.NewReader(`{a:2,b:"y"} {a:3,b:"y"}`), api.CommitMessage{})
+	require.NoError(t, err)
+	assert.Equal(t, "{a:1,b:\"x\"}\n{a:2,b:\"y\"}\n{a:3,b:\"y\"}\n", conn.TestQuery("from test | sort a"))
+	_, err = conn.Upsert(t.Context(), poolID, "main", "", "a", strings.NewReader(`{a:4} {a:4}`), api.CommitMessage{})
+	var reqErr *client.ErrorResponse
+	require.ErrorAs(t, err, &reqErr)
+	assert.Equal(t, http.StatusBadRequest, reqErr.StatusCode)
+	assert.ErrorContains(t, err, "offsets 0 and 1: 4")
+}
+
+func TestUpsertConcurrent(t *testing.T) {
+	root := t.TempDir()
+	_, conn := newCoreAtDir(t, root)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	var b strings.Builder
+	for i := range 20 {
+		fmt.Fprintf(&b, "{ts:%d,id:%d}\n", i, i)
+	}
+	conn.TestLoad(poolID, "main", strings.NewReader(b.String()))
+	// Each upsert rewrites the same object so concurrent upserts conflict
+	// and are retried.
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := strings.NewReader(fmt.Sprintf("{ts:%d,id:%d,new:true}", i, i))
+			_, err := conn.Upsert(t.Context(), poolID, "main", "", "id", r, api.CommitMessage{})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, "8\n", conn.TestQuery("from test | where new | count()"))
+	assert.Equal(t, "20\n", conn.TestQuery("from test | count()"))
+	// Every data object is either in the branch or deleted by a commit, so
+	// no objects written by failed attempts remain.
+	files, err := filepath.Glob(filepath.Join(root, poolID.String(), "data", "*.bsup"))
+	require.NoError(t, err)
+	var nobjects int
+	for _, f := range files {
+		if !strings.HasSuffix(f, "-seek.bsup") {
+			nobjects++
+		}
+	}
+	vacuum, err := conn.Vacuum(t.Context(), "test", "main", true)
+	require.NoError(t, err)
+	current := conn.TestQuery("from test@main:objects | count()")
+	assert.Equal(t, current, fmt.Sprintf("%d\n", nobjects-len(vacuum.ObjectIDs)))
+}
+
+func TestQueryListAndKill(t *testing.T) {
+	// The source sends one value and then blocks so the query keeps
+	// running until it is killed.
+	release := make(chan struct{})
+	src := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{a:1}\n"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer src.Close()
+	defer close(release)
+	_, conn := newCore(t)
+	query := fmt.Sprintf("from '%s' (format sup)", src.URL)
+	errCh := make(chan error)
+	go func() {
+		q, err := dbapi.NewRemoteDB(conn.Connection).Query(t.Context(), srcfiles.Plain(query), nil)
+		if err == nil {
+			for {
+				var batch sbuf.Batch
+				batch, err = q.Pull(false)
+				if batch == nil || err != nil {
+					break
+				}
+			}
+		}
+		errCh <- err
+	}()
+	var infos []api.QueryInfo
+	require.Eventually(t, func() bool {
+		var err error
+		infos, err = conn.RunningQueries(t.Context())
+		require.NoError(t, err)
+		return len(infos) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, query, infos[0].Query)
+	assert.NotEqual(t, "", infos[0].RequestID)
+	assert.Equal(t, "user_000000000000000000000000001", infos[0].UserID)
+	require.NoError(t, conn.KillQuery(t.Context(), infos[0].RequestID))
+	select {
+	case err := <-errCh:
+		assert.ErrorContains(t, err, "query killed")
+	case <-time.After(10 * time.Second):
+		t.Fatal("killed query did not finish")
+	}
+	infos, err := conn.RunningQueries(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, infos, 0)
+	err = conn.KillQuery(t.Context(), "no-such-query")
+	require.Error(t, err)
+	assert.Equal(t, 404, err.(*client.ErrorResponse).StatusCode)
+}
+
 func TestQueryEmptyPool(t *testing.T) {
 	_, conn := newCore(t)
 	conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	"github.com/brimdata/super/service/pgwire"
+	"go.uber.org/zap"
+)
+
+// ServePostgres serves PostgreSQL wire protocol clients that connect to l
+// until ctx is canceled.
+func (c *Core) ServePostgres(ctx context.Context, l net.Listener) error {
+	c.logger.Info("Listening for PostgreSQL clients", zap.Stringer("addr", l.Addr()))
+	b := &backend{core: c, protocol: "pgwire"}
+	return pgwire.NewServer(b, c.logger.Named("pgwire")).Serve(ctx, l)
+}
@@ -0,0 +1,420 @@
+package pgwire
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/sup"
+)
+
+// The catalog presents each branch of each pool as a table named for the
+// pool in a schema named for the branch.  Queries may reference the
+// catalog tables below, whose values are computed when referenced, and
+// the tables of the catalog as "branch.pool".
+var catalogTables = map[string]func(*catalog) []string{
+	"information_schema.columns":  (*catalog).columns,
+	"information_schema.schemata": (*catalog).schemata,
+	"information_schema.tables":   (*catalog).tables,
+	"pg_catalog.pg_namespace":     (*catalog).namespaces,
+	"pg_catalog.pg_tables":        (*catalog).pgTables,
+}
+
+// sampleSize is the number of values of a table from which the types of
+// its columns are determined.
+const sampleSize = 1000
+
+type catalog struct {
+	ctx      context.Context
+	session  *session
+	database string
+	// poolNames holds the names of the pools, branches the names of the
+	// branches of each pool, and commits the commit of each branch by
+	// its table.
+	poolNames []string
+	branches  map[string][]string
+	commits   map[tableKey]string
+}
+
+// tableKey identifies the table of a pool in the schema of a branch.
+type tableKey struct {
+	schema string
+	table  string
+}
+
+// tableColumns holds the columns of a table as of a commit of its branch.
+type tableColumns struct {
+	commit string
+	fields []field
+}
+
+func (s *session) loadCatalog(ctx context.Context) (*catalog, error) {
+	c := &catalog{
+		ctx:      ctx,
+		session:  s,
+		database: s.database(),
+		branches: make(map[string][]string),
+		commits:  make(map[tableKey]string),
+	}
+	vals, err := s.queryValues(ctx, "from :branches | values {pool:pool.name,branch:branch.name,commit:ksuid(branch.commit)}", -1)
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range vals {
+		pool := val.Deref("pool").AsString()
+		if _, ok := c.branches[pool]; !ok {
+			c.poolNames = append(c.poolNames, pool)
+		}
+		branch := val.Deref("branch").AsString()
+		c.branches[pool] = append(c.branches[pool], branch)
+		c.commits[tableKey{branch, pool}] = val.Deref("commit").AsString()
+	}
+	slices.Sort(c.poolNames)
+	return c, nil
+}
+
+// schemaNames returns the names of the branches of all pools.
+func (c *catalog) schemaNames() []string {
+	var names []string
+	for _, branches := range c.branches {
+		names = append(names, branches...)
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+func (c *catalog) schemata() []string {
+	var recs []string
+	for _, name := range append(c.schemaNames(), "information_schema", "pg_catalog") {
+		recs = append(recs, record("catalog_name", c.database, "schema_name", name, "schema_owner", c.database))
+	}
+	return recs
+}
+
+func (c *catalog) namespaces() []string {
+	var recs []string
+	for k, name := range append(c.schemaNames(), "information_schema", "pg_catalog") {
+		recs = append(recs, record("oid", k+1, "nspname", name))
+	}
+	return recs
+}
+
+func (c *catalog) tables() []string {
+	var recs []string
+	c.walk(func(schema, table string) {
+		recs = append(recs, record("table_catalog", c.database, "table_schema", schema, "table_name", table, "table_type", "BASE TABLE"))
+	})
+	return recs
+}
+
+func (c *catalog) pgTables() []string {
+	var recs []string
+	c.walk(func(schema, table string) {
+		recs = append(recs, record("schemaname", schema, "tablename", table, "tableowner", c.database))
+	})
+	return recs
+}
+
+// columns describes the columns of each table given by the fields of the
+// fused type of a sample of its values.  Tables that cannot be read are
+// omitted.
+func (c *catalog) columns() []string {
+	var recs []string
+	c.walk(func(schema, table string) {
+		for k, f := range c.tableColumns(schema, table) {
+			recs = append(recs, record(
+				"table_catalog", c.database,
+				"table_schema", schema,
+				"table_name", table,
+				"column_name", f.name,
+				"ordinal_position", k+1,
+				"data_type", typeName(f.oid),
+				"is_nullable", "YES",
+			))
+		}
+	})
+	return recs
+}
+
+// tableColumns returns the columns of the table of schema, which are
+// sampled at the commit of its branch unless the session has already
+// sampled them at that commit.
+func (c *catalog) tableColumns(schema, table string) []field {
+	key := tableKey{schema, table}
+	commit := c.commits[key]
+	if cols, ok := c.session.columns[key]; ok && cols.commit == commit {
+		return cols.fields
+	}
+	query := fmt.Sprintf("from %s@%s | head %d | fuse | head 1", sup.QuotedString(table), commit, sampleSize)
+	vals, err := c.session.queryValues(c.ctx, query, 1)
+	if err != nil {
+		// Don't cache the error, which may be transient.
+		return nil
+	}
+	var fields []field
+	if len(vals) > 0 {
+		if typ, ok := super.TypeUnder(vals[0].Type()).(*super.TypeRecord); ok {
+			for _, f := range typ.Fields {
+				fields = append(fields, field{f.Name, typeOID(f.Type)})
+			}
+		}
+	}
+	c.session.columns[key] = &tableColumns{commit, fields}
+	return fields
+}
+
+func (c *catalog) walk(f func(schema, table string)) {
+	for _, pool := range c.poolNames {
+		for _, branch := range c.branches[pool] {
+			f(branch, pool)
+		}
+	}
+}
+
+// record returns the SUP text of a record with the given alternating
+// field names and values.
+func record(fields ...any) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for k := 0; k < len(fields); k += 2 {
+		if k > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(sup.QuotedName(fields[k].(string)))
+		b.WriteByte(':')
+		switch v := fields[k+1].(type) {
+		case string:
+			b.WriteString(sup.QuotedString(v))
+		case int:
+			b.WriteString(strconv.Itoa(v))
+		}
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+// rewriteCatalog replaces each reference in a FROM clause of seq to a
+// catalog table with the table's values and each reference to "branch.pool"
+// with a reference to the pool at the branch.
+func (s *session) rewriteCatalog(ctx context.Context, seq ast.Seq) error {
+	var items []*ast.SQLFromItem
+	dag.WalkT(reflect.ValueOf(seq), func(item *ast.SQLFromItem) *ast.SQLFromItem {
+		if name := fromName(item); strings.Contains(name, ".") || catalogName(name) != "" {
+			items = append(items, item)
+		}
+		return item
+	})
+	if len(items) == 0 {
+		return nil
+	}
+	c, err := s.loadCatalog(ctx)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		name := fromName(item)
+		if _, ok := c.branches[name]; ok {
+			// A pool's name takes precedence.
+			continue
+		}
+		if lower := catalogName(name); lower != "" {
+			values := catalogTables[lower]
+			body, err := valuesSeq(values(c))
+			if err != nil {
+				return err
+			}
+			input := item.Input.(*ast.FromItem)
+			item.Input = &ast.SQLPipe{Kind: "SQLPipe", Body: body, Loc: input.Loc}
+			if item.Alias == nil {
+				_, table, _ := strings.Cut(lower, ".")
+				item.Alias = &ast.TableAlias{Name: table, Loc: input.Loc}
+			}
+			continue
+		}
+		schema, table, _ := strings.Cut(name, ".")
+		if slices.Contains(c.branches[table], schema) {
+			input := item.Input.(*ast.FromItem)
+			if slices.ContainsFunc(input.Args, isCommitArg) {
+				return fmt.Errorf("%s: table of schema %q cannot also specify a commit", name, schema)
+			}
+			input.Source = &ast.Text{Kind: "Text", Text: table, Loc: input.Source.(*ast.Text).Loc}
+			input.Args = append(input.Args, &ast.ArgText{
+				Kind:  "ArgText",
+				Key:   "commit",
+				Value: &ast.Text{Kind: "Text", Text: schema, Loc: input.Loc},
+				Loc:   input.Loc,
+			})
+			if item.Alias == nil {
+				item.Alias = &ast.TableAlias{Name: table, Loc: input.Loc}
+			}
+		}
+	}
+	return nil
+}
+
+// catalogName returns the qualified name of the catalog table with name
+// or the empty string if there is no such table.  As in PostgreSQL, the
+// tables of pg_catalog need not be qualified.
+func catalogName(name string) string {
+	lower := strings.ToLower(name)
+	if _, ok := catalogTables["pg_catalog."+lower]; ok {
+		return "pg_catalog." + lower
+	}
+	if _, ok := catalogTables[lower]; ok {
+		return lower
+	}
+	return ""
+}
+
+// fromName returns the name of the table referenced by item or the empty
+// string if it does not reference a table by name.
+func fromName(item *ast.SQLFromItem) string {
+	if input, ok := item.Input.(*ast.FromItem); ok {
+		if text, ok := input.Source.(*ast.Text); ok {
+			return text.Text
+		}
+	}
+	return ""
+}
+
+func isCommitArg(arg ast.OpArg) bool {
+	a, ok := arg.(*ast.ArgText)
+	return ok && a.Key == "commit"
+}
+
+// valuesSeq returns a pipeline that produces the values of the records
+// recs, which are in SUP format.
+func valuesSeq(recs []string) (ast.Seq, error) {
+	text := "values " + strings.Join(recs, ",")
+	if len(recs) == 0 {
+		text = "values null | where false"
+	}
+	p, err := parser.ParseText(text)
+	if err != nil {
+		return nil, err
+	}
+	return p.Parsed(), nil
+}
+
+// A command is a statement that is not a query, e.g., SET, or a query for
+// session information, which clients issue on connecting to a server.
+type command struct {
+	tag    string
+	fields []field
+	row    []string
+	apply  func(*session) error
+}
+
+var errTransaction = errors.New("transactions are not supported")
+
+// parseCommand returns the command given by text or nil if text is a
+// query.
+func (s *session) parseCommand(text string) (*command, error) {
+	text = strings.TrimSpace(strings.TrimRight(strings.TrimSpace(text), ";"))
+	words := strings.Fields(strings.ToLower(text))
+	if len(words) == 0 {
+		return nil, nil
+	}
+	switch words[0] {
+	case "begin", "start":
+		return &command{tag: "BEGIN", apply: func(s *session) error {
+			s.inTx = true
+			return nil
+		}}, nil
+	case "commit", "end":
+		return &command{tag: "COMMIT", apply: func(s *session) error {
+			s.inTx = false
+			return nil
+		}}, nil
+	case "rollback", "abort":
+		return &command{tag: "ROLLBACK", apply: func(s *session) error {
+			s.inTx = false
+			return nil
+		}}, nil
+	case "savepoint", "release", "prepare":
+		return nil, errTransaction
+	case "set":
+		name, value, err := parseSet(text)
+		if err != nil {
+			return nil, err
+		}
+		return &command{tag: "SET", apply: func(s *session) error {
+			s.params[name] = value
+			return nil
+		}}, nil
+	case "show":
+		if len(words) != 2 {
+			return nil, fmt.Errorf("syntax error in %q", text)
+		}
+		name := words[1]
+		value, ok := s.params[name]
+		if !ok {
+			return nil, fmt.Errorf("unrecognized configuration parameter %q", name)
+		}
+		return &command{tag: "SHOW", fields: []field{{name, oidText}}, row: []string{value}}, nil
+	case "discard", "deallocate", "reset", "listen", "unlisten":
+		tag := strings.ToUpper(words[0])
+		if tag == "DISCARD" && len(words) > 1 {
+			tag += " " + strings.ToUpper(words[1])
+		}
+		return &command{tag: tag}, nil
+	case "select":
+		if len(words) != 2 {
+			return nil, nil
+		}
+		var value string
+		switch words[1] {
+		case "version()":
+			value = "PostgreSQL " + s.params["server_version"] + " on SuperDB"
+		case "current_database()", "current_catalog":
+			value = s.database()
+		case "current_schema()", "current_schema":
+			value = "main"
+		case "current_user", "session_user", "user":
+			value = s.params["user"]
+		case "pg_backend_pid()":
+			value = strconv.Itoa(int(s.pid))
+		default:
+			return nil, nil
+		}
+		name := strings.TrimSuffix(words[1], "()")
+		return &command{tag: "SELECT 1", fields: []field{{name, oidText}}, row: []string{value}}, nil
+	}
+	return nil, nil
+}
+
+// parseSet parses "SET [SESSION | LOCAL] name {TO | =} value" and
+// "SET [SESSION | LOCAL] TIME ZONE value".
+func parseSet(text string) (string, string, error) {
+	rest := strings.TrimSpace(text[len("set"):])
+	for _, prefix := range []string{"session ", "local "} {
+		if hasPrefixFold(rest, prefix) {
+			rest = strings.TrimSpace(rest[len(prefix):])
+		}
+	}
+	var name, value string
+	if hasPrefixFold(rest, "time zone ") {
+		name, value = "timezone", rest[len("time zone "):]
+	} else if i := strings.IndexByte(rest, '='); i > 0 {
+		name, value = rest[:i], rest[i+1:]
+	} else if i := strings.Index(strings.ToLower(rest), " to "); i > 0 {
+		name, value = rest[:i], rest[i+len(" to "):]
+	} else {
+		return "", "", fmt.Errorf("syntax error in %q", text)
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	value = strings.Trim(strings.TrimSpace(value), "'\"")
+	return name, value, nil
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
@@ -0,0 +1,100 @@
+package pgwire
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+
+	"github.com/brimdata/super/compiler/srcfiles"
+	"github.com/brimdata/super/db/branches"
+	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/grants"
+	"github.com/brimdata/super/db/pools"
+)
+
+// SQLSTATE codes of errors sent to clients.
+const (
+	codeDatatypeMismatch           = "42804"
+	codeDuplicatePreparedStatement = "42P05"
+	codeFeatureNotSupported        = "0A000"
+	codeInsufficientPrivilege      = "42501"
+	codeInternalError              = "XX000"
+	codeInvalidCursorName          = "34000"
+	codeInvalidParameterValue      = "22023"
+	codeInvalidPassword            = "28P01"
+	codeInvalidStatementName       = "26000"
+	codeProtocolViolation          = "08P01"
+	codeQueryCanceled              = "57014"
+	codeSyntaxError                = "42601"
+	codeUndefinedObject            = "42704"
+	codeUndefinedTable             = "42P01"
+)
+
+// A pgError is an error reported to a client with an ErrorResponse
+// message.  Errors of other types end the session.
+type pgError struct {
+	code string
+	err  error
+}
+
+func newError(code string, err error) *pgError {
+	return &pgError{code, err}
+}
+
+func (e *pgError) Error() string {
+	return e.err.Error()
+}
+
+func (e *pgError) Unwrap() error {
+	return e.err
+}
+
+// queryError returns a pgError for an error compiling or running a query.
+func queryError(err error) error {
+	var pgErr *pgError
+	if errors.As(err, &pgErr) {
+		return err
+	}
+	code := errorCode(err)
+	var list srcfiles.ErrorList
+	if code == codeInternalError && errors.As(err, &list) {
+		// A compilation error is a syntax error unless it is an error
+		// resolving a name such as that of an unknown pool.
+		code = codeSyntaxError
+		for _, e := range list {
+			if c := errorCode(e); c != codeInternalError {
+				code = c
+				break
+			}
+		}
+	}
+	return newError(code, err)
+}
+
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, errCanceled) || errors.Is(err, context.Canceled):
+		return codeQueryCanceled
+	case errors.Is(err, grants.ErrDenied):
+		return codeInsufficientPrivilege
+	case errors.Is(err, pools.ErrNotFound):
+		return codeUndefinedTable
+	case errors.Is(err, branches.ErrNotFound) || errors.Is(err, commits.ErrNotFound) ||
+		errors.Is(err, fs.ErrNotExist):
+		return codeUndefinedObject
+	}
+	return codeInternalError
+}
+
+// sendError sends an ErrorResponse message for err and flushes c.
+func sendError(c *conn, err *pgError) error {
+	m := message{}.byte('S').string("ERROR")
+	m = m.byte('V').string("ERROR")
+	m = m.byte('C').string(err.code)
+	m = m.byte('M').string(err.Error())
+	m = m.byte(0)
+	if err := c.send(msgErrorResponse, m); err != nil {
+		return err
+	}
+	return c.flush()
+}
@@ -0,0 +1,213 @@
+package pgwire
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+)
+
+// Codes of the startup packets, which lack a message type byte.
+const (
+	protocolVersion = 196608 // 3.0
+	cancelRequest   = 80877102
+	sslRequest      = 80877103
+	gssEncRequest   = 80877104
+)
+
+// Types of the frontend messages.
+const (
+	msgBind      = 'B'
+	msgClose     = 'C'
+	msgDescribe  = 'D'
+	msgExecute   = 'E'
+	msgFlush     = 'H'
+	msgParse     = 'P'
+	msgPassword  = 'p'
+	msgQuery     = 'Q'
+	msgSync      = 'S'
+	msgTerminate = 'X'
+)
+
+// Types of the backend messages.
+const (
+	msgAuthentication       = 'R'
+	msgBackendKeyData       = 'K'
+	msgBindComplete         = '2'
+	msgCloseComplete        = '3'
+	msgCommandComplete      = 'C'
+	msgDataRow              = 'D'
+	msgEmptyQueryResponse   = 'I'
+	msgErrorResponse        = 'E'
+	msgNoData               = 'n'
+	msgParameterDescription = 't'
+	msgParameterStatus      = 'S'
+	msgParseComplete        = '1'
+	msgPortalSuspended      = 's'
+	msgReadyForQuery        = 'Z'
+	msgRowDescription       = 'T'
+)
+
+// maxMessageSize limits the size of a frontend message.
+const maxMessageSize = 64 * 1024 * 1024
+
+var errMalformed = errors.New("malformed message")
+
+// conn reads frontend messages from and buffers backend messages to a
+// network connection.
+type conn struct {
+	net.Conn
+	r   *bufio.Reader
+	w   *bufio.Writer
+	buf []byte
+}
+
+func newConn(c net.Conn) *conn {
+	return &conn{
+		Conn: c,
+		r:    bufio.NewReader(c),
+		w:    bufio.NewWriter(c),
+	}
+}
+
+// readStartup reads a startup packet and returns its code and body.
+func (c *conn) readStartup() (uint32, []byte, error) {
+	body, err := c.readBody()
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(body) < 4 {
+		return 0, nil, errMalformed
+	}
+	return binary.BigEndian.Uint32(body), body[4:], nil
+}
+
+// readMessage reads a message and returns its type and body.  The body is
+// valid until the next call to readMessage.
+func (c *conn) readMessage() (byte, []byte, error) {
+	typ, err := c.r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	body, err := c.readBody()
+	return typ, body, err
+}
+
+func (c *conn) readBody() ([]byte, error) {
+	var hdr [4]byte
+	if _, err := io.ReadFull(c.r, hdr[:]); err != nil {
+		return nil, err
+	}
+	n := int(binary.BigEndian.Uint32(hdr[:]))
+	if n < 4 || n > maxMessageSize {
+		return nil, fmt.Errorf("invalid message length %d", n)
+	}
+	if cap(c.buf) < n-4 {
+		c.buf = make([]byte, n-4)
+	}
+	c.buf = c.buf[:n-4]
+	if _, err := io.ReadFull(c.r, c.buf); err != nil {
+		return nil, err
+	}
+	return c.buf, nil
+}
+
+// send buffers a message of type typ with body.
+func (c *conn) send(typ byte, body []byte) error {
+	var hdr [5]byte
+	hdr[0] = typ
+	binary.BigEndian.PutUint32(hdr[1:], uint32(len(body)+4))
+	if _, err := c.w.Write(hdr[:]); err != nil {
+		return err
+	}
+	_, err := c.w.Write(body)
+	return err
+}
+
+func (c *conn) flush() error {
+	return c.w.Flush()
+}
+
+// message builds the body of a backend message.
+type message []byte
+
+func (m message) byte(b byte) message {
+	return append(m, b)
+}
+
+func (m message) int16(n int) message {
+	return binary.BigEndian.AppendUint16(m, uint16(n))
+}
+
+func (m message) int32(n int) message {
+	return binary.BigEndian.AppendUint32(m, uint32(n))
+}
+
+func (m message) string(s string) message {
+	return append(append(m, s...), 0)
+}
+
+// reader parses the body of a frontend message.
+type reader struct {
+	b   []byte
+	err error
+}
+
+func (r *reader) byte() byte {
+	if len(r.b) < 1 {
+		r.err = errMalformed
+		return 0
+	}
+	b := r.b[0]
+	r.b = r.b[1:]
+	return b
+}
+
+func (r *reader) int16() int {
+	if len(r.b) < 2 {
+		r.err = errMalformed
+		return 0
+	}
+	n := int16(binary.BigEndian.Uint16(r.b))
+	r.b = r.b[2:]
+	return int(n)
+}
+
+func (r *reader) int32() int {
+	if len(r.b) < 4 {
+		r.err = errMalformed
+		return 0
+	}
+	n := int32(binary.BigEndian.Uint32(r.b))
+	r.b = r.b[4:]
+	return int(n)
+}
+
+func (r *reader) string() string {
+	for i, b := range r.b {
+		if b == 0 {
+			s := string(r.b[:i])
+			r.b = r.b[i+1:]
+			return s
+		}
+	}
+	r.err = errMalformed
+	return ""
+}
+
+// bytes returns the next n bytes or nil if n is negative, which denotes
+// a null value.
+func (r *reader) bytes(n int) []byte {
+	if n < 0 {
+		return nil
+	}
+	if len(r.b) < n {
+		r.err = errMalformed
+		return nil
+	}
+	b := r.b[:n:n]
+	r.b = r.b[n:]
+	return b
+}
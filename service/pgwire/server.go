@@ -0,0 +1,935 @@
+// Package pgwire implements the PostgreSQL frontend/backend protocol so that
+// PostgreSQL clients may query a database service.
+//
+// Both the simple and extended query protocols are supported.  Query text
+// is SuperSQL and, with the extended protocol, may reference parameters
+// as $1, $2, and so on.  Results are sent in text format or, when a client
+// requests it, in binary format for booleans, numbers, bytes, times,
+// durations, IP addresses, and networks.  Transactions are not supported,
+// though BEGIN, COMMIT, and ROLLBACK are accepted, and SSL is not
+// supported.
+package pgwire
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/compiler/ast"
+	"github.com/brimdata/super/compiler/dag"
+	"github.com/brimdata/super/compiler/parser"
+	"github.com/brimdata/super/db"
+	"github.com/brimdata/super/db/grants"
+	"github.com/brimdata/super/db/journal"
+	"github.com/brimdata/super/runtime"
+	"github.com/segmentio/ksuid"
+	"go.uber.org/zap"
+)
+
+// Backend authenticates the users of sessions and runs their queries.
+type Backend interface {
+	// AuthRequired returns true if users must authenticate with a password.
+	AuthRequired() bool
+	// Authenticate returns the context for queries of a session of user
+	// given password, which is ignored if authentication is not required.
+	Authenticate(ctx context.Context, user, password string) (context.Context, error)
+	// Query compiles and starts query, whose source is text.
+	Query(ctx context.Context, query *parser.AST, text string) (runtime.Query, error)
+}
+
+// serverVersion is the PostgreSQL version reported to clients.
+const serverVersion = "14.0"
+
+// parameterStatus holds the run-time parameters reported to clients on
+// connecting.
+var parameterStatus = []struct{ name, value string }{
+	{"client_encoding", "UTF8"},
+	{"DateStyle", "ISO, MDY"},
+	{"integer_datetimes", "on"},
+	{"IntervalStyle", "postgres"},
+	{"server_encoding", "UTF8"},
+	{"server_version", serverVersion},
+	{"standard_conforming_strings", "on"},
+	{"TimeZone", "UTC"},
+}
+
+type Server struct {
+	backend Backend
+	logger  *zap.Logger
+
+	mu       sync.Mutex
+	nextPID  uint32
+	sessions map[uint32]*session
+}
+
+func NewServer(backend Backend, logger *zap.Logger) *Server {
+	return &Server{
+		backend:  backend,
+		logger:   logger,
+		sessions: make(map[uint32]*session),
+	}
+}
+
+// Serve accepts connections on l until ctx is canceled.
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.serveConn(ctx, c)
+		}()
+	}
+}
+
+func (s *Server) serveConn(ctx context.Context, c net.Conn) {
+	defer c.Close()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// Close the connection on shutdown to interrupt a blocked read.
+	stop := context.AfterFunc(ctx, func() { c.Close() })
+	defer stop()
+	sess, err := s.startup(ctx, newConn(c))
+	if err != nil {
+		if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+			s.logger.Info("Connection startup failed", zap.Stringer("remote_addr", c.RemoteAddr()), zap.Error(err))
+		}
+		return
+	}
+	if sess == nil {
+		// Cancel request
+		return
+	}
+	defer s.removeSession(sess)
+	if err := sess.run(); err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+		s.logger.Info("Connection closed", zap.Uint32("pid", sess.pid), zap.Error(err))
+	}
+}
+
+// startup reads startup packets and authenticates the user, returning a
+// new session or nil if the connection was for a cancel request.
+func (s *Server) startup(ctx context.Context, c *conn) (*session, error) {
+	for {
+		code, body, err := c.readStartup()
+		if err != nil {
+			return nil, err
+		}
+		switch code {
+		case sslRequest, gssEncRequest:
+			// Decline encryption.
+			if _, err := c.Write([]byte{'N'}); err != nil {
+				return nil, err
+			}
+			continue
+		case cancelRequest:
+			r := &reader{b: body}
+			pid, secret := uint32(r.int32()), uint32(r.int32())
+			if r.err == nil {
+				s.cancel(pid, secret)
+			}
+			return nil, nil
+		case protocolVersion:
+		default:
+			err := fmt.Errorf("unsupported protocol version %d.%d", code>>16, code&0xffff)
+			sendError(c, newError(codeProtocolViolation, err))
+			return nil, err
+		}
+		params := map[string]string{}
+		r := &reader{b: body}
+		for len(r.b) > 1 && r.err == nil {
+			name := r.string()
+			params[strings.ToLower(name)] = r.string()
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+		return s.authenticate(ctx, c, params)
+	}
+}
+
+func (s *Server) authenticate(ctx context.Context, c *conn, params map[string]string) (*session, error) {
+	var password string
+	if s.backend.AuthRequired() {
+		// Request a cleartext password, which is an access token.
+		if err := c.send(msgAuthentication, message{}.int32(3)); err != nil {
+			return nil, err
+		}
+		if err := c.flush(); err != nil {
+			return nil, err
+		}
+		typ, body, err := c.readMessage()
+		if err != nil {
+			return nil, err
+		}
+		if typ != msgPassword {
+			err := fmt.Errorf("expected password message but got %q", typ)
+			sendError(c, newError(codeProtocolViolation, err))
+			return nil, err
+		}
+		r := &reader{b: body}
+		if password = r.string(); r.err != nil {
+			return nil, r.err
+		}
+	}
+	ctx, err := s.backend.Authenticate(ctx, params["user"], password)
+	if err != nil {
+		sendError(c, newError(codeInvalidPassword, err))
+		return nil, err
+	}
+	sess := s.newSession(ctx, c, params)
+	m := message{}.int32(0)
+	if err := c.send(msgAuthentication, m); err != nil {
+		return nil, err
+	}
+	for _, p := range parameterStatus {
+		sess.params[strings.ToLower(p.name)] = p.value
+		if err := c.send(msgParameterStatus, message{}.string(p.name).string(p.value)); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.send(msgBackendKeyData, message{}.int32(int(sess.pid)).int32(int(sess.secret))); err != nil {
+		return nil, err
+	}
+	return sess, nil
+}
+
+func (s *Server) newSession(ctx context.Context, c *conn, params map[string]string) *session {
+	var b [4]byte
+	rand.Read(b[:])
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextPID++
+	sess := &session{
+		server:  s,
+		conn:    c,
+		ctx:     ctx,
+		pid:     s.nextPID,
+		secret:  binary.BigEndian.Uint32(b[:]),
+		params:  params,
+		stmts:   make(map[string]*statement),
+		portals: make(map[string]*portal),
+		columns: make(map[tableKey]*tableColumns),
+		enc:     newEncoder(),
+	}
+	s.sessions[sess.pid] = sess
+	return sess
+}
+
+func (s *Server) removeSession(sess *session) {
+	s.mu.Lock()
+	delete(s.sessions, sess.pid)
+	s.mu.Unlock()
+	sess.closePortals()
+}
+
+func (s *Server) cancel(pid, secret uint32) {
+	s.mu.Lock()
+	sess, ok := s.sessions[pid]
+	s.mu.Unlock()
+	if ok && sess.secret == secret {
+		sess.cancelQuery()
+	}
+}
+
+var errCanceled = errors.New("canceling statement due to user request")
+
+type session struct {
+	server *Server
+	conn   *conn
+	ctx    context.Context
+	pid    uint32
+	secret uint32
+	// params holds the startup parameters and run-time parameters by
+	// lowercase name.
+	params  map[string]string
+	stmts   map[string]*statement
+	portals map[string]*portal
+	// columns caches the columns of the tables of the catalog.
+	columns map[tableKey]*tableColumns
+	enc     *encoder
+	inTx    bool
+	// failed is true after an error in the extended query protocol until
+	// the next Sync message.
+	failed bool
+
+	mu     sync.Mutex
+	cancel context.CancelCauseFunc
+}
+
+type field struct {
+	name string
+	oid  uint32
+}
+
+// A statement is a query or command prepared with a Parse message.
+type statement struct {
+	text    string
+	command *command
+	oids    []uint32
+	// fields describes the rows of the statement once known.
+	fields []field
+}
+
+// A portal is a statement with bound parameters.
+type portal struct {
+	stmt    *statement
+	params  map[string]string
+	formats []int
+	rows    *rows
+}
+
+func (s *session) database() string {
+	if db := s.params["database"]; db != "" {
+		return db
+	}
+	return s.params["user"]
+}
+
+func (s *session) run() error {
+	if err := s.sendReady(); err != nil {
+		return err
+	}
+	for {
+		typ, body, err := s.conn.readMessage()
+		if err != nil {
+			return err
+		}
+		if s.failed && typ != msgSync && typ != msgTerminate {
+			continue
+		}
+		r := &reader{b: body}
+		switch typ {
+		case msgQuery:
+			text := r.string()
+			if r.err != nil {
+				return r.err
+			}
+			if err := s.simpleQuery(text); err != nil {
+				return err
+			}
+		case msgParse:
+			err = s.parse(r)
+		case msgBind:
+			err = s.bind(r)
+		case msgDescribe:
+			err = s.describe(r)
+		case msgExecute:
+			err = s.execute(r)
+		case msgClose:
+			err = s.close(r)
+		case msgSync:
+			s.failed = false
+			if !s.inTx {
+				s.closePortals()
+			}
+			err = s.sendReady()
+		case msgFlush:
+			err = s.conn.flush()
+		case msgTerminate:
+			return nil
+		default:
+			return fmt.Errorf("unsupported message type %q", typ)
+		}
+		if err != nil {
+			var pgErr *pgError
+			if !errors.As(err, &pgErr) {
+				return err
+			}
+			s.failed = true
+			if err := s.sendError(pgErr); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *session) sendReady() error {
+	status := byte('I')
+	if s.inTx {
+		status = 'T'
+	}
+	if err := s.conn.send(msgReadyForQuery, message{}.byte(status)); err != nil {
+		return err
+	}
+	return s.conn.flush()
+}
+
+func (s *session) sendError(err *pgError) error {
+	s.server.logger.Debug("Query error", zap.Uint32("pid", s.pid), zap.Error(err))
+	return sendError(s.conn, err)
+}
+
+// simpleQuery runs text with the simple query protocol.
+func (s *session) simpleQuery(text string) error {
+	err := s.runSimple(text)
+	var pgErr *pgError
+	if errors.As(err, &pgErr) {
+		err = s.sendError(pgErr)
+	}
+	if err != nil {
+		return err
+	}
+	return s.sendReady()
+}
+
+func (s *session) runSimple(text string) error {
+	cmd, err := s.parseCommand(text)
+	if err != nil {
+		return newError(codeFeatureNotSupported, err)
+	}
+	if strings.TrimSpace(strings.Trim(strings.TrimSpace(text), ";")) == "" {
+		return s.conn.send(msgEmptyQueryResponse, nil)
+	}
+	if cmd != nil {
+		if cmd.fields != nil {
+			if err := s.sendRowDescription(cmd.fields, nil); err != nil {
+				return err
+			}
+		}
+		return s.runCommand(cmd, nil)
+	}
+	rows, err := s.startQuery(&statement{text: text}, nil)
+	if err != nil {
+		return err
+	}
+	defer rows.close()
+	fields, err := rows.describe()
+	if err != nil {
+		return err
+	}
+	if err := s.sendRowDescription(fields, nil); err != nil {
+		return err
+	}
+	return s.sendRows(rows, nil, 0)
+}
+
+func (s *session) runCommand(cmd *command, formats []int) error {
+	if cmd.apply != nil {
+		if err := cmd.apply(s); err != nil {
+			return newError(codeFeatureNotSupported, err)
+		}
+	}
+	if cmd.row != nil {
+		m := message{}.int16(len(cmd.row))
+		for _, v := range cmd.row {
+			m = m.int32(len(v))
+			m = append(m, v...)
+		}
+		if err := s.conn.send(msgDataRow, m); err != nil {
+			return err
+		}
+	}
+	return s.conn.send(msgCommandComplete, message{}.string(cmd.tag))
+}
+
+func (s *session) parse(r *reader) error {
+	name, text := r.string(), r.string()
+	n := r.int16()
+	var oids []uint32
+	for range n {
+		oids = append(oids, uint32(r.int32()))
+	}
+	if r.err != nil {
+		return r.err
+	}
+	cmd, err := s.parseCommand(text)
+	if err != nil {
+		return newError(codeFeatureNotSupported, err)
+	}
+	stmt := &statement{text: text, command: cmd, oids: oids}
+	if cmd == nil {
+		p, err := parser.ParseText(text)
+		if err != nil {
+			return newError(codeSyntaxError, err)
+		}
+		// Declare the parameters not given a type as unspecified.
+		for len(stmt.oids) < numParams(p.Parsed()) {
+			stmt.oids = append(stmt.oids, 0)
+		}
+	} else {
+		stmt.fields = cmd.fields
+	}
+	if name != "" {
+		if _, ok := s.stmts[name]; ok {
+			return newError(codeDuplicatePreparedStatement, fmt.Errorf("prepared statement %q already exists", name))
+		}
+	}
+	s.stmts[name] = stmt
+	return s.conn.send(msgParseComplete, nil)
+}
+
+var positionalParam = regexp.MustCompile(`^\$[1-9][0-9]*$`)
+
+// numParams returns the number of positional parameters referenced by seq.
+func numParams(seq ast.Seq) int {
+	var n int
+	dag.WalkT(reflect.ValueOf(seq), func(id ast.ID) ast.ID {
+		if positionalParam.MatchString(id.Name) {
+			k, _ := strconv.Atoi(id.Name[1:])
+			n = max(n, k)
+		}
+		return id
+	})
+	dag.WalkT(reflect.ValueOf(seq), func(p *ast.ParamExpr) *ast.ParamExpr {
+		k, _ := strconv.Atoi(p.Name)
+		n = max(n, k)
+		return p
+	})
+	return n
+}
+
+func (s *session) bind(r *reader) error {
+	portalName, stmtName := r.string(), r.string()
+	paramFormats := make([]int, r.int16())
+	for k := range paramFormats {
+		paramFormats[k] = r.int16()
+	}
+	values := make([][]byte, r.int16())
+	for k := range values {
+		if b := r.bytes(r.int32()); b != nil {
+			values[k] = slices.Clone(b)
+		}
+	}
+	formats := make([]int, r.int16())
+	for k := range formats {
+		formats[k] = r.int16()
+	}
+	if r.err != nil {
+		return r.err
+	}
+	stmt, ok := s.stmts[stmtName]
+	if !ok {
+		return newError(codeInvalidStatementName, fmt.Errorf("prepared statement %q does not exist", stmtName))
+	}
+	if len(values) != len(stmt.oids) && stmt.command == nil {
+		return newError(codeProtocolViolation, fmt.Errorf("bind message supplies %d parameters, but prepared statement %q requires %d", len(values), stmtName, len(stmt.oids)))
+	}
+	params := make(map[string]string)
+	for k, b := range values {
+		var oid uint32
+		if k < len(stmt.oids) {
+			oid = stmt.oids[k]
+		}
+		text, err := paramText(oid, formatOf(paramFormats, k), b)
+		if err != nil {
+			return newError(codeInvalidParameterValue, fmt.Errorf("parameter $%d: %w", k+1, err))
+		}
+		params[strconv.Itoa(k+1)] = text
+	}
+	if p, ok := s.portals[portalName]; ok {
+		p.close()
+	}
+	s.portals[portalName] = &portal{stmt: stmt, params: params, formats: formats}
+	return s.conn.send(msgBindComplete, nil)
+}
+
+// formatOf returns the format of the kth value given formats, which may
+// hold no formats, denoting text, or a single format for all values.
+func formatOf(formats []int, k int) int {
+	switch {
+	case len(formats) == 0:
+		return formatText
+	case len(formats) == 1:
+		return formats[0]
+	case k < len(formats):
+		return formats[k]
+	}
+	return formatText
+}
+
+func (s *session) describe(r *reader) error {
+	kind, name := r.byte(), r.string()
+	if r.err != nil {
+		return r.err
+	}
+	switch kind {
+	case 'S':
+		stmt, ok := s.stmts[name]
+		if !ok {
+			return newError(codeInvalidStatementName, fmt.Errorf("prepared statement %q does not exist", name))
+		}
+		// A parameter whose type the client did not specify is described
+		// as unspecified, OID 0, rather than text so that the client
+		// encodes it by the type of its value, e.g., an integer as a
+		// number, which paramText then recognizes.
+		m := message{}.int16(len(stmt.oids))
+		for _, oid := range stmt.oids {
+			m = m.int32(int(oid))
+		}
+		if err := s.conn.send(msgParameterDescription, m); err != nil {
+			return err
+		}
+		fields, err := s.describeStatement(stmt)
+		if err != nil {
+			return err
+		}
+		return s.sendRowDescription(fields, nil)
+	case 'P':
+		p, ok := s.portals[name]
+		if !ok {
+			return newError(codeInvalidCursorName, fmt.Errorf("portal %q does not exist", name))
+		}
+		if p.stmt.command != nil {
+			return s.sendRowDescription(p.stmt.command.fields, p.formats)
+		}
+		if p.rows == nil {
+			rows, err := s.startQuery(p.stmt, p.params)
+			if err != nil {
+				return err
+			}
+			p.rows = rows
+		}
+		fields, err := p.rows.describe()
+		if err != nil {
+			return err
+		}
+		return s.sendRowDescription(fields, p.formats)
+	}
+	return newError(codeProtocolViolation, fmt.Errorf("invalid describe kind %q", kind))
+}
+
+var errWrite = errors.New("statements that modify the database cannot be described before they are executed")
+
+// describeStatement returns the fields of the rows of stmt, which are
+// determined by running its query with its parameters set to null until
+// it produces a value.  Queries that require permissions other than read
+// permission are not run and are described as producing no rows.
+func (s *session) describeStatement(stmt *statement) ([]field, error) {
+	if stmt.fields != nil || stmt.command != nil {
+		return stmt.fields, nil
+	}
+	params := make(map[string]string)
+	for k := range stmt.oids {
+		params[strconv.Itoa(k+1)] = "null"
+	}
+	ctx := db.ContextWithAuthorizer(s.ctx, func(ctx context.Context, pool ksuid.KSUID, perm grants.Permission) error {
+		if perm > grants.Read {
+			return errWrite
+		}
+		return db.Authorize(s.ctx, pool, perm)
+	})
+	// The fields are those of the first value, so run the query only
+	// until it produces one.
+	rows, err := s.startQueryContext(ctx, stmt, params, true)
+	if err != nil {
+		if errors.Is(err, errWrite) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer rows.close()
+	fields, err := rows.describe()
+	if err != nil {
+		return nil, err
+	}
+	stmt.fields = fields
+	return fields, nil
+}
+
+func (s *session) execute(r *reader) error {
+	name, limit := r.string(), r.int32()
+	if r.err != nil {
+		return r.err
+	}
+	p, ok := s.portals[name]
+	if !ok {
+		return newError(codeInvalidCursorName, fmt.Errorf("portal %q does not exist", name))
+	}
+	if p.stmt.command != nil {
+		return s.runCommand(p.stmt.command, p.formats)
+	}
+	if p.rows == nil {
+		rows, err := s.startQuery(p.stmt, p.params)
+		if err != nil {
+			return err
+		}
+		p.rows = rows
+	}
+	if _, err := p.rows.describe(); err != nil {
+		return err
+	}
+	return s.sendRows(p.rows, p.formats, limit)
+}
+
+func (s *session) close(r *reader) error {
+	kind, name := r.byte(), r.string()
+	if r.err != nil {
+		return r.err
+	}
+	switch kind {
+	case 'S':
+		delete(s.stmts, name)
+	case 'P':
+		if p, ok := s.portals[name]; ok {
+			p.close()
+			delete(s.portals, name)
+		}
+	default:
+		return newError(codeProtocolViolation, fmt.Errorf("invalid close kind %q", kind))
+	}
+	return s.conn.send(msgCloseComplete, nil)
+}
+
+func (s *session) closePortals() {
+	for name, p := range s.portals {
+		p.close()
+		delete(s.portals, name)
+	}
+}
+
+func (p *portal) close() {
+	if p.rows != nil {
+		p.rows.close()
+		p.rows = nil
+	}
+}
+
+func (s *session) sendRowDescription(fields []field, formats []int) error {
+	if fields == nil {
+		return s.conn.send(msgNoData, nil)
+	}
+	m := message{}.int16(len(fields))
+	for k, f := range fields {
+		m = m.string(f.name)
+		m = m.int32(0).int16(0) // table OID and column number
+		m = m.int32(int(f.oid)).int16(typeSize(f.oid))
+		m = m.int32(-1) // type modifier
+		m = m.int16(formatOf(formats, k))
+	}
+	return s.conn.send(msgRowDescription, m)
+}
+
+// sendRows sends the rows of a query in formats followed by
+// CommandComplete or, if limit is positive and more than limit rows
+// remain, sends limit rows followed by PortalSuspended.
+func (s *session) sendRows(rows *rows, formats []int, limit int) error {
+	var m message
+	for n := 0; limit <= 0 || n < limit; n++ {
+		val, err := rows.next()
+		if err != nil {
+			return err
+		}
+		if val == nil {
+			rows.close()
+			return s.conn.send(msgCommandComplete, message{}.string("SELECT "+strconv.Itoa(rows.count)))
+		}
+		m = m[:0].int16(len(rows.fields))
+		for k, f := range rows.fields {
+			col, ok := column(*val, f.name, len(rows.fields))
+			if !ok || col.IsNull() {
+				m = m.int32(-1)
+				continue
+			}
+			off := len(m)
+			m = m.int32(0)
+			m, err = s.enc.appendValue(m, f.oid, formatOf(formats, k), col)
+			if err != nil {
+				return newError(codeDatatypeMismatch, fmt.Errorf("column %q: %w", f.name, err))
+			}
+			binary.BigEndian.PutUint32(m[off:], uint32(len(m)-off-4))
+		}
+		if err := s.conn.send(msgDataRow, m); err != nil {
+			return err
+		}
+	}
+	return s.conn.send(msgPortalSuspended, nil)
+}
+
+// column returns the value of the column named name of the row val.
+// Non-record values are rows with a single column.
+func column(val super.Value, name string, n int) (super.Value, bool) {
+	val = val.Under()
+	if _, ok := val.Type().(*super.TypeRecord); !ok {
+		return val, n == 1 && name == valueColumn
+	}
+	col := val.Deref(name)
+	if col == nil {
+		return super.Value{}, false
+	}
+	return *col, true
+}
+
+// valueColumn is the name of the column of rows that are not records.
+const valueColumn = "value"
+
+func (s *session) startQuery(stmt *statement, params map[string]string) (*rows, error) {
+	return s.startQueryContext(s.ctx, stmt, params, false)
+}
+
+// startQueryContext starts stmt with params in ctx.  If head is true, the
+// query stops after its first value.
+func (s *session) startQueryContext(ctx context.Context, stmt *statement, params map[string]string, head bool) (*rows, error) {
+	p, err := parser.ParseText(stmt.text)
+	if err != nil {
+		return nil, newError(codeSyntaxError, err)
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	if err := s.rewriteCatalog(ctx, p.Parsed()); err != nil {
+		cancel(nil)
+		return nil, queryError(err)
+	}
+	if head {
+		p.AppendHead()
+	}
+	p.BindParams(params)
+	q, err := s.server.backend.Query(ctx, p, stmt.text)
+	if err != nil {
+		cancel(nil)
+		return nil, queryError(err)
+	}
+	s.mu.Lock()
+	s.cancel = cancel
+	s.mu.Unlock()
+	return &rows{ctx: ctx, cancel: cancel, query: q}, nil
+}
+
+func (s *session) cancelQuery() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		s.cancel(errCanceled)
+	}
+}
+
+// queryValues runs query and returns up to limit of its values or all of
+// its values if limit is negative.
+func (s *session) queryValues(ctx context.Context, query string, limit int) ([]super.Value, error) {
+	p, err := parser.ParseText(query)
+	if err != nil {
+		return nil, err
+	}
+	q, err := s.server.backend.Query(ctx, p, query)
+	if err != nil {
+		return nil, err
+	}
+	defer q.Close()
+	var vals []super.Value
+	for limit < 0 || len(vals) < limit {
+		batch, err := q.Pull(false)
+		if err != nil {
+			return nil, err
+		}
+		if batch == nil {
+			break
+		}
+		for _, val := range batch.Values() {
+			vals = append(vals, val.Copy())
+		}
+	}
+	if limit >= 0 && len(vals) > limit {
+		vals = vals[:limit]
+	}
+	return vals, nil
+}
+
+// rows holds the state of a running query.
+type rows struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	query  runtime.Query
+	fields []field
+	vals   []super.Value
+	// first is the first value, which is pulled to describe the rows.
+	first  *super.Value
+	count  int
+	eos    bool
+	closed bool
+}
+
+// describe returns the fields of the rows, which are the fields of the
+// first value if it is a record.
+func (r *rows) describe() ([]field, error) {
+	if r.fields != nil {
+		return r.fields, nil
+	}
+	val, err := r.pull()
+	if err != nil {
+		return nil, err
+	}
+	r.fields = []field{}
+	if val == nil {
+		return r.fields, nil
+	}
+	r.first = val
+	under := val.Under()
+	if typ, ok := under.Type().(*super.TypeRecord); ok {
+		for _, f := range typ.Fields {
+			r.fields = append(r.fields, field{f.Name, typeOID(f.Type)})
+		}
+	} else {
+		r.fields = append(r.fields, field{valueColumn, typeOID(under.Type())})
+	}
+	return r.fields, nil
+}
+
+// next returns the next value or nil at the end of the rows.
+func (r *rows) next() (*super.Value, error) {
+	if val := r.first; val != nil {
+		r.first = nil
+		r.count++
+		return val, nil
+	}
+	val, err := r.pull()
+	if val != nil {
+		r.count++
+	}
+	return val, err
+}
+
+func (r *rows) pull() (*super.Value, error) {
+	for len(r.vals) == 0 {
+		if r.eos {
+			return nil, nil
+		}
+		batch, err := r.query.Pull(false)
+		if err != nil {
+			if r.ctx.Err() != nil {
+				err = context.Cause(r.ctx)
+			}
+			if errors.Is(err, journal.ErrEmpty) {
+				r.eos = true
+				return nil, nil
+			}
+			return nil, queryError(err)
+		}
+		if batch == nil {
+			r.eos = true
+			return nil, nil
+		}
+		r.vals = batch.Values()
+	}
+	val := &r.vals[0]
+	r.vals = r.vals[1:]
+	return val, nil
+}
+
+func (r *rows) close() {
+	if !r.closed {
+		r.closed = true
+		r.cancel(nil)
+		r.query.Close()
+	}
+}
@@ -0,0 +1,481 @@
+package pgwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/sio"
+	"github.com/brimdata/super/sio/jsonio"
+	"github.com/brimdata/super/sup"
+)
+
+// OIDs of the PostgreSQL types to which super types are mapped.
+const (
+	oidBool        = 16
+	oidBytea       = 17
+	oidInt8        = 20
+	oidInt2        = 21
+	oidInt4        = 23
+	oidText        = 25
+	oidJSON        = 114
+	oidCIDR        = 650
+	oidFloat4      = 700
+	oidFloat8      = 701
+	oidUnknown     = 705
+	oidInet        = 869
+	oidVarchar     = 1043
+	oidTimestamp   = 1114
+	oidTimestamptz = 1184
+	oidInterval    = 1186
+	oidNumeric     = 1700
+)
+
+// Format codes of parameter and result values.
+const (
+	formatText   = 0
+	formatBinary = 1
+)
+
+// pgEpoch is the origin of binary timestamps.
+var pgEpoch = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// typeOID returns the OID of the PostgreSQL type to which values of typ
+// are converted.  Complex values are converted to JSON, and values of
+// other types without a PostgreSQL counterpart are converted to text.
+func typeOID(typ super.Type) uint32 {
+	switch typ := super.TypeUnder(typ).(type) {
+	case *super.TypeRecord, *super.TypeArray, *super.TypeSet, *super.TypeMap:
+		return oidJSON
+	case *super.TypeOfBool:
+		return oidBool
+	case *super.TypeOfBytes:
+		return oidBytea
+	case *super.TypeOfString:
+		return oidText
+	case *super.TypeOfIP:
+		return oidInet
+	case *super.TypeOfNet:
+		return oidCIDR
+	case *super.TypeOfTime:
+		return oidTimestamptz
+	case *super.TypeOfDuration:
+		return oidInterval
+	default:
+		switch typ.ID() {
+		case super.IDInt8, super.IDInt16, super.IDUint8:
+			return oidInt2
+		case super.IDInt32, super.IDUint16:
+			return oidInt4
+		case super.IDInt64, super.IDUint32:
+			return oidInt8
+		case super.IDUint64:
+			return oidNumeric
+		case super.IDFloat16, super.IDFloat32:
+			return oidFloat4
+		case super.IDFloat64:
+			return oidFloat8
+		}
+	}
+	return oidText
+}
+
+// typeSize returns the size of the fixed-length type with OID oid or -1
+// for variable-length types.
+func typeSize(oid uint32) int {
+	switch oid {
+	case oidBool:
+		return 1
+	case oidInt2:
+		return 2
+	case oidInt4, oidFloat4:
+		return 4
+	case oidInt8, oidFloat8, oidTimestamptz:
+		return 8
+	case oidInterval:
+		return 16
+	}
+	return -1
+}
+
+// typeName returns the SQL name of the type with OID oid as reported in
+// the catalog.
+func typeName(oid uint32) string {
+	switch oid {
+	case oidBool:
+		return "boolean"
+	case oidBytea:
+		return "bytea"
+	case oidInt2:
+		return "smallint"
+	case oidInt4:
+		return "integer"
+	case oidInt8:
+		return "bigint"
+	case oidJSON:
+		return "json"
+	case oidCIDR:
+		return "cidr"
+	case oidFloat4:
+		return "real"
+	case oidFloat8:
+		return "double precision"
+	case oidInet:
+		return "inet"
+	case oidTimestamptz:
+		return "timestamp with time zone"
+	case oidInterval:
+		return "interval"
+	case oidNumeric:
+		return "numeric"
+	}
+	return "text"
+}
+
+// encoder appends the PostgreSQL encodings of values to a buffer.
+type encoder struct {
+	json    *jsonio.Writer
+	jsonBuf bytes.Buffer
+}
+
+func newEncoder() *encoder {
+	e := &encoder{}
+	e.json = jsonio.NewWriter(sio.NopCloser(&e.jsonBuf), jsonio.WriterOpts{})
+	return e
+}
+
+// appendValue appends val in format to dst for a column whose type has
+// OID oid.  Values whose type differs from the column's are encoded in
+// binary format only when they can be converted to the column's type.
+func (e *encoder) appendValue(dst []byte, oid uint32, format int, val super.Value) ([]byte, error) {
+	val = val.Under()
+	if format == formatBinary {
+		switch oid {
+		case oidText, oidVarchar, oidJSON, oidUnknown:
+		default:
+			return e.appendBinary(dst, oid, val)
+		}
+	}
+	return e.appendText(dst, val)
+}
+
+func (e *encoder) appendText(dst []byte, val super.Value) ([]byte, error) {
+	switch typ := val.Type().(type) {
+	case *super.TypeRecord, *super.TypeArray, *super.TypeSet, *super.TypeMap:
+		e.jsonBuf.Reset()
+		if err := e.json.Write(val); err != nil {
+			return nil, err
+		}
+		return append(dst, bytes.TrimSuffix(e.jsonBuf.Bytes(), []byte{'\n'})...), nil
+	case *super.TypeEnum:
+		s, err := typ.Symbol(int(super.DecodeUint(val.Bytes())))
+		if err != nil {
+			return nil, err
+		}
+		return append(dst, s...), nil
+	case *super.TypeOfBool:
+		if val.Bool() {
+			return append(dst, 't'), nil
+		}
+		return append(dst, 'f'), nil
+	case *super.TypeOfBytes:
+		dst = append(dst, `\x`...)
+		return hex.AppendEncode(dst, val.Bytes()), nil
+	case *super.TypeOfString:
+		return append(dst, val.Bytes()...), nil
+	case *super.TypeOfIP:
+		return super.DecodeIP(val.Bytes()).AppendTo(dst), nil
+	case *super.TypeOfNet:
+		return super.DecodeNet(val.Bytes()).AppendTo(dst), nil
+	case *super.TypeOfTime:
+		t := nano.Ts(val.Int()).Time().UTC()
+		return append(t.AppendFormat(dst, "2006-01-02 15:04:05.999999"), "+00"...), nil
+	case *super.TypeOfDuration:
+		return appendInterval(dst, val.Int()), nil
+	}
+	id := val.Type().ID()
+	switch {
+	case super.IsSigned(id):
+		return strconv.AppendInt(dst, val.Int(), 10), nil
+	case super.IsUnsigned(id):
+		return strconv.AppendUint(dst, val.Uint(), 10), nil
+	case super.IsFloat(id):
+		return appendFloat(dst, val.Float(), id), nil
+	}
+	return append(dst, sup.FormatValue(val)...), nil
+}
+
+// appendInterval appends the PostgreSQL text form of the duration of ns
+// nanoseconds, e.g., "-27:46:40.5".
+func appendInterval(dst []byte, ns int64) []byte {
+	d := time.Duration(ns)
+	if d < 0 {
+		dst = append(dst, '-')
+		d = -d
+	}
+	h := int64(d / time.Hour)
+	m := int64(d % time.Hour / time.Minute)
+	us := int64(d % time.Minute / time.Microsecond)
+	dst = fmt.Appendf(dst, "%02d:%02d:%02d", h, m, us/1e6)
+	if us%1e6 != 0 {
+		frac := strings.TrimRight(fmt.Sprintf("%06d", us%1e6), "0")
+		dst = append(append(dst, '.'), frac...)
+	}
+	return dst
+}
+
+func appendFloat(dst []byte, f float64, id int) []byte {
+	switch {
+	case math.IsNaN(f):
+		return append(dst, "NaN"...)
+	case math.IsInf(f, 1):
+		return append(dst, "Infinity"...)
+	case math.IsInf(f, -1):
+		return append(dst, "-Infinity"...)
+	}
+	bits := 64
+	if id != super.IDFloat64 {
+		bits = 32
+	}
+	return strconv.AppendFloat(dst, f, 'g', -1, bits)
+}
+
+func (e *encoder) appendBinary(dst []byte, oid uint32, val super.Value) ([]byte, error) {
+	id := val.Type().ID()
+	switch oid {
+	case oidBool:
+		if id == super.IDBool {
+			if val.Bool() {
+				return append(dst, 1), nil
+			}
+			return append(dst, 0), nil
+		}
+	case oidInt2, oidInt4, oidInt8:
+		if n, ok := asInt(val); ok {
+			switch oid {
+			case oidInt2:
+				if n == int64(int16(n)) {
+					return binary.BigEndian.AppendUint16(dst, uint16(n)), nil
+				}
+			case oidInt4:
+				if n == int64(int32(n)) {
+					return binary.BigEndian.AppendUint32(dst, uint32(n)), nil
+				}
+			default:
+				return binary.BigEndian.AppendUint64(dst, uint64(n)), nil
+			}
+		}
+	case oidFloat4, oidFloat8:
+		if super.IsFloat(id) {
+			if oid == oidFloat4 {
+				return binary.BigEndian.AppendUint32(dst, math.Float32bits(float32(val.Float()))), nil
+			}
+			return binary.BigEndian.AppendUint64(dst, math.Float64bits(val.Float())), nil
+		}
+	case oidNumeric:
+		if super.IsUnsigned(id) {
+			return appendNumeric(dst, val.Uint()), nil
+		}
+		if super.IsSigned(id) && val.Int() >= 0 {
+			return appendNumeric(dst, uint64(val.Int())), nil
+		}
+	case oidBytea:
+		if id == super.IDBytes {
+			return append(dst, val.Bytes()...), nil
+		}
+	case oidTimestamptz:
+		if id == super.IDTime {
+			t := nano.Ts(val.Int()).Time()
+			return binary.BigEndian.AppendUint64(dst, uint64(t.Sub(pgEpoch).Microseconds())), nil
+		}
+	case oidInterval:
+		if id == super.IDDuration {
+			dst = binary.BigEndian.AppendUint64(dst, uint64(val.Int()/1000))
+			// Days and months are zero.
+			return append(dst, 0, 0, 0, 0, 0, 0, 0, 0), nil
+		}
+	case oidInet, oidCIDR:
+		switch id {
+		case super.IDIP:
+			addr := super.DecodeIP(val.Bytes())
+			return appendInet(dst, netip.PrefixFrom(addr, addr.BitLen()), false), nil
+		case super.IDNet:
+			return appendInet(dst, super.DecodeNet(val.Bytes()), oid == oidCIDR), nil
+		}
+	}
+	return nil, fmt.Errorf("cannot encode value of type %s in binary format of %s", sup.FormatType(val.Type()), typeName(oid))
+}
+
+func asInt(val super.Value) (int64, bool) {
+	id := val.Type().ID()
+	switch {
+	case super.IsSigned(id) && id != super.IDTime && id != super.IDDuration:
+		return val.Int(), true
+	case super.IsUnsigned(id) && val.Uint() <= math.MaxInt64:
+		return int64(val.Uint()), true
+	}
+	return 0, false
+}
+
+// appendNumeric appends the binary numeric form of n, which is a sequence
+// of base 10000 digits.
+func appendNumeric(dst []byte, n uint64) []byte {
+	var digits []uint16
+	for ; n > 0; n /= 10000 {
+		digits = append([]uint16{uint16(n % 10000)}, digits...)
+	}
+	dst = binary.BigEndian.AppendUint16(dst, uint16(len(digits)))
+	// The weight is the exponent of the first digit.
+	weight := len(digits) - 1
+	if weight < 0 {
+		weight = 0
+	}
+	dst = binary.BigEndian.AppendUint16(dst, uint16(weight))
+	// The sign is positive and the display scale is zero.
+	dst = append(dst, 0, 0, 0, 0)
+	for _, d := range digits {
+		dst = binary.BigEndian.AppendUint16(dst, d)
+	}
+	return dst
+}
+
+func appendInet(dst []byte, prefix netip.Prefix, isCIDR bool) []byte {
+	family := byte(2)
+	if prefix.Addr().Is6() {
+		family = 3
+	}
+	addr := prefix.Addr().AsSlice()
+	var cidr byte
+	if isCIDR {
+		cidr = 1
+	}
+	dst = append(dst, family, byte(prefix.Bits()), cidr, byte(len(addr)))
+	return append(dst, addr...)
+}
+
+// paramText returns the SUP form of the value of a parameter given in format
+// for a parameter of the type with OID oid.  Text values of parameters of
+// unspecified type are numbers when they look like numbers and otherwise
+// strings.
+func paramText(oid uint32, format int, b []byte) (string, error) {
+	if b == nil {
+		return "null", nil
+	}
+	if format == formatBinary {
+		return binaryParamText(oid, b)
+	}
+	s := string(b)
+	switch oid {
+	case 0, oidUnknown:
+		if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return s, nil
+		}
+		if _, err := strconv.ParseFloat(s, 64); err == nil && !strings.ContainsAny(s, "nN") {
+			return s, nil
+		}
+	case oidBool:
+		switch strings.ToLower(s) {
+		case "t", "true", "y", "yes", "on", "1":
+			return "true", nil
+		case "f", "false", "n", "no", "off", "0":
+			return "false", nil
+		}
+		return "", fmt.Errorf("invalid boolean %q", s)
+	case oidInt2, oidInt4, oidInt8:
+		if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+			return "", fmt.Errorf("invalid integer %q", s)
+		}
+		return s, nil
+	case oidFloat4, oidFloat8, oidNumeric:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid number %q", s)
+		}
+		if oid == oidNumeric && f == math.Trunc(f) && !strings.ContainsAny(s, ".eE") {
+			return s, nil
+		}
+		return sup.FormatValue(super.NewFloat64(f)), nil
+	case oidBytea:
+		if h, ok := strings.CutPrefix(s, `\x`); ok {
+			if _, err := hex.DecodeString(h); err != nil {
+				return "", fmt.Errorf("invalid bytea %q", s)
+			}
+			return "0x" + h, nil
+		}
+		return "0x" + hex.EncodeToString(b), nil
+	case oidTimestamp, oidTimestamptz:
+		t, err := parseTimestamp(s)
+		if err != nil {
+			return "", err
+		}
+		return sup.FormatValue(super.NewTime(nano.TimeToTs(t))), nil
+	}
+	return sup.QuotedString(s), nil
+}
+
+func binaryParamText(oid uint32, b []byte) (string, error) {
+	switch oid {
+	case oidBool:
+		if len(b) == 1 {
+			return strconv.FormatBool(b[0] != 0), nil
+		}
+	case oidInt2:
+		if len(b) == 2 {
+			return strconv.Itoa(int(int16(binary.BigEndian.Uint16(b)))), nil
+		}
+	case oidInt4:
+		if len(b) == 4 {
+			return strconv.Itoa(int(int32(binary.BigEndian.Uint32(b)))), nil
+		}
+	case oidInt8:
+		if len(b) == 8 {
+			return strconv.FormatInt(int64(binary.BigEndian.Uint64(b)), 10), nil
+		}
+	case oidFloat4:
+		if len(b) == 4 {
+			f := math.Float32frombits(binary.BigEndian.Uint32(b))
+			return sup.FormatValue(super.NewFloat64(float64(f))), nil
+		}
+	case oidFloat8:
+		if len(b) == 8 {
+			f := math.Float64frombits(binary.BigEndian.Uint64(b))
+			return sup.FormatValue(super.NewFloat64(f)), nil
+		}
+	case oidBytea:
+		return "0x" + hex.EncodeToString(b), nil
+	case oidTimestamp, oidTimestamptz:
+		if len(b) == 8 {
+			us := int64(binary.BigEndian.Uint64(b))
+			t := pgEpoch.Add(time.Duration(us) * time.Microsecond)
+			return sup.FormatValue(super.NewTime(nano.TimeToTs(t))), nil
+		}
+	case 0, oidUnknown, oidText, oidVarchar, oidJSON:
+		return sup.QuotedString(string(b)), nil
+	default:
+		return "", fmt.Errorf("binary format not supported for parameters of type %d", oid)
+	}
+	return "", fmt.Errorf("invalid binary %s parameter", typeName(oid))
+}
+
+func parseTimestamp(s string) (time.Time, error) {
+	for _, layout := range []string{
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999Z07",
+		"2006-01-02 15:04:05.999999999",
+		time.RFC3339Nano,
+		"2006-01-02",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", s)
+}
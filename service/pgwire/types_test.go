@@ -0,0 +1,152 @@
+package pgwire
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeOID(t *testing.T) {
+	cases := []struct {
+		typ string
+		oid uint32
+	}{
+		{"bool", oidBool},
+		{"int8", oidInt2},
+		{"uint16", oidInt4},
+		{"int64", oidInt8},
+		{"uint64", oidNumeric},
+		{"float32", oidFloat4},
+		{"float64", oidFloat8},
+		{"string", oidText},
+		{"bytes", oidBytea},
+		{"time", oidTimestamptz},
+		{"duration", oidInterval},
+		{"ip", oidInet},
+		{"net", oidCIDR},
+		{"{a:int64}", oidJSON},
+		{"[string]", oidJSON},
+		{"type", oidText},
+	}
+	for _, c := range cases {
+		typ, err := sup.ParseType(super.NewContext(), c.typ)
+		require.NoError(t, err)
+		assert.Equal(t, c.oid, typeOID(typ), c.typ)
+	}
+}
+
+func TestEncodeText(t *testing.T) {
+	cases := []struct {
+		value string
+		text  string
+	}{
+		{"true", "t"},
+		{"-1(int8)", "-1"},
+		{"1.5", "1.5"},
+		{"+Inf", "Infinity"},
+		{`"hello"`, "hello"},
+		{"0x0aff", `\x0aff`},
+		{"2024-01-02T03:04:05.5Z", "2024-01-02 03:04:05.5+00"},
+		{"-27h46m40.5s", "-27:46:40.5"},
+		{"10.0.0.1", "10.0.0.1"},
+		{"10.0.0.0/8", "10.0.0.0/8"},
+		{`{a:1,b:[1,2]}`, `{"a":1,"b":[1,2]}`},
+		{`"a"(=named)`, "a"},
+	}
+	e := newEncoder()
+	for _, c := range cases {
+		val := sup.MustParseValue(super.NewContext(), c.value)
+		b, err := e.appendValue(nil, typeOID(val.Type()), formatText, val)
+		require.NoError(t, err)
+		assert.Equal(t, c.text, string(b), c.value)
+	}
+}
+
+func TestEncodeBinary(t *testing.T) {
+	cases := []struct {
+		value string
+		oid   uint32
+		hex   string
+	}{
+		{"true", oidBool, "01"},
+		{"1(int16)", oidInt2, "0001"},
+		{"-2(int32)", oidInt4, "fffffffe"},
+		{"1", oidInt8, "0000000000000001"},
+		{"1.", oidFloat8, "3ff0000000000000"},
+		{"12345678(uint64)", oidNumeric, "000200010000000004d2162e"},
+		{"0x0aff", oidBytea, "0aff"},
+		{"2000-01-01T00:00:01Z", oidTimestamptz, "00000000000f4240"},
+		{"1s", oidInterval, "00000000000f42400000000000000000"},
+		{"10.0.0.1", oidInet, "022000040a000001"},
+		{"10.0.0.0/8", oidCIDR, "020801040a000000"},
+		// Text columns are always sent as text.
+		{`"a"`, oidText, "61"},
+	}
+	e := newEncoder()
+	for _, c := range cases {
+		val := sup.MustParseValue(super.NewContext(), c.value)
+		b, err := e.appendValue(nil, c.oid, formatBinary, val)
+		require.NoError(t, err)
+		assert.Equal(t, c.hex, hex.EncodeToString(b), c.value)
+	}
+	_, err := e.appendValue(nil, oidInt2, formatBinary, super.NewInt64(1<<20))
+	assert.ErrorContains(t, err, "cannot encode value of type int64 in binary format of smallint")
+}
+
+func TestParamText(t *testing.T) {
+	cases := []struct {
+		oid    uint32
+		format int
+		param  []byte
+		text   string
+	}{
+		{0, formatText, nil, "null"},
+		{0, formatText, []byte("12"), "12"},
+		{0, formatText, []byte("1.5"), "1.5"},
+		{0, formatText, []byte("NaN"), `"NaN"`},
+		{0, formatText, []byte(`it's "x"`), `"it's \"x\""`},
+		{oidText, formatText, []byte("12"), `"12"`},
+		{oidBool, formatText, []byte("yes"), "true"},
+		{oidInt4, formatText, []byte("-3"), "-3"},
+		{oidFloat8, formatText, []byte("2"), "2."},
+		{oidNumeric, formatText, []byte("20"), "20"},
+		{oidBytea, formatText, []byte(`\x0aff`), "0x0aff"},
+		{oidTimestamptz, formatText, []byte("2024-01-02 03:04:05+00"), "2024-01-02T03:04:05Z"},
+		{oidInt4, formatBinary, []byte{0xff, 0xff, 0xff, 0xfe}, "-2"},
+		{oidBool, formatBinary, []byte{1}, "true"},
+		{oidText, formatBinary, []byte("x"), `"x"`},
+		{oidTimestamptz, formatBinary, []byte{0, 0, 0, 0, 0, 0xf, 0x42, 0x40}, "2000-01-01T00:00:01Z"},
+	}
+	for _, c := range cases {
+		text, err := paramText(c.oid, c.format, c.param)
+		require.NoError(t, err)
+		assert.Equal(t, c.text, text, string(c.param))
+	}
+	_, err := paramText(oidInt8, formatText, []byte("x"))
+	assert.ErrorContains(t, err, `invalid integer "x"`)
+	_, err = paramText(oidInt8, formatBinary, []byte{1})
+	assert.ErrorContains(t, err, "invalid binary bigint parameter")
+}
+
+func TestParseSet(t *testing.T) {
+	cases := []struct {
+		text, name, value string
+	}{
+		{"SET extra_float_digits = 3", "extra_float_digits", "3"},
+		{"set application_name to 'psql'", "application_name", "psql"},
+		{"SET SESSION TIME ZONE 'UTC'", "timezone", "UTC"},
+		{"SET LOCAL search_path=main", "search_path", "main"},
+	}
+	for _, c := range cases {
+		name, value, err := parseSet(c.text)
+		require.NoError(t, err)
+		assert.Equal(t, c.name, name, c.text)
+		assert.Equal(t, c.value, value, c.text)
+	}
+	_, _, err := parseSet("SET x")
+	assert.Error(t, err)
+}
@@ -0,0 +1,301 @@
+package service_test
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/brimdata/super/api"
+	"github.com/brimdata/super/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresSimpleQuery(t *testing.T) {
+	core, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader(`{a:1,s:"x"} {a:2,s:"y"}`))
+	pg := newPGClient(t, core, "", "")
+	res := pg.query("select a, s from test order by a")
+	require.Empty(t, res.err)
+	assert.Equal(t, []string{"a:20", "s:25"}, res.columns)
+	assert.Equal(t, [][]string{{"1", "x"}, {"2", "y"}}, res.rows)
+	assert.Equal(t, "SELECT 2", res.tag)
+	res = pg.query("from test | where a == 3 | values a")
+	require.Empty(t, res.err)
+	assert.Empty(t, res.rows)
+	assert.Equal(t, "SELECT 0", res.tag)
+	res = pg.query("from nosuchpool")
+	assert.Equal(t, "42P01", res.err)
+	res = pg.query("select from")
+	assert.Equal(t, "42601", res.err)
+	// The session continues after an error.
+	res = pg.query("select current_user")
+	require.Empty(t, res.err)
+	assert.Equal(t, [][]string{{"test"}}, res.rows)
+	res = pg.query("SET application_name = 'test'")
+	require.Empty(t, res.err)
+	assert.Equal(t, "SET", res.tag)
+	res = pg.query("show application_name")
+	assert.Equal(t, [][]string{{"test"}}, res.rows)
+}
+
+func TestPostgresExtendedQuery(t *testing.T) {
+	core, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader(`{a:1,s:"x"} {a:2,s:"y"} {a:3,s:"y"}`))
+	pg := newPGClient(t, core, "", "")
+	res := pg.extendedQuery("select a from test where s = $1 and a > $2 order by a", []string{"y", "1"}, 0)
+	require.Empty(t, res.err)
+	assert.Equal(t, []string{"a:20"}, res.columns)
+	assert.Equal(t, [][]string{{"2"}, {"3"}}, res.rows)
+	// Binary results
+	res = pg.extendedQuery("select a from test where a = $1", []string{"3"}, 1)
+	require.Empty(t, res.err)
+	assert.Equal(t, [][]string{{"\x00\x00\x00\x00\x00\x00\x00\x03"}}, res.rows)
+	// Parameter values are not query text.
+	res = pg.extendedQuery("select a from test where s = $1", []string{`y" or true or "`}, 0)
+	require.Empty(t, res.err)
+	assert.Empty(t, res.rows)
+	res = pg.extendedQuery("select a from test where s = $1", nil, 0)
+	assert.Equal(t, "08P01", res.err)
+}
+
+func TestPostgresCatalog(t *testing.T) {
+	core, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	commit := conn.TestLoad(poolID, "main", strings.NewReader(`{a:1,s:"x"}`))
+	conn.TestBranchPost(poolID, api.BranchPostRequest{Name: "dev", Commit: commit.String()})
+	conn.TestLoad(poolID, "dev", strings.NewReader(`{a:2,s:"y"}`))
+	pg := newPGClient(t, core, "", "")
+	res := pg.query("select table_schema, table_name from information_schema.tables order by table_schema")
+	require.Empty(t, res.err)
+	assert.Equal(t, [][]string{{"dev", "test"}, {"main", "test"}}, res.rows)
+	res = pg.query("select column_name, data_type from information_schema.columns where table_schema = 'main'")
+	require.Empty(t, res.err)
+	assert.Equal(t, [][]string{{"a", "bigint"}, {"s", "text"}}, res.rows)
+	res = pg.query("select a from dev.test order by a")
+	require.Empty(t, res.err)
+	assert.Equal(t, [][]string{{"1"}, {"2"}}, res.rows)
+	// The columns are sampled again once the branch moves.
+	conn.TestLoad(poolID, "main", strings.NewReader(`{a:3,s:"z",b:true}`))
+	res = pg.query("select column_name, data_type from information_schema.columns where table_schema = 'main'")
+	require.Empty(t, res.err)
+	assert.Equal(t, [][]string{{"a", "bigint"}, {"s", "text"}, {"b", "boolean"}}, res.rows)
+}
+
+func TestPostgresAuth(t *testing.T) {
+	core, conn := newCoreWithConfig(t, service.Config{Auth: testAuthConfig()})
+	conn.SetAuthToken(genToken(t, "tenant", "user"))
+	conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	_, err := dialPG(t, core, "user", "badtoken")
+	assert.ErrorContains(t, err, "28P01")
+	pg := newPGClient(t, core, "user", genToken(t, "tenant", "user"))
+	res := pg.query("from :pools | values name")
+	require.Empty(t, res.err)
+	assert.Equal(t, [][]string{{"test"}}, res.rows)
+}
+
+func TestPostgresDriver(t *testing.T) {
+	core, conn := newCore(t)
+	poolID := conn.TestPoolPost(api.PoolPostRequest{Name: "test"})
+	conn.TestLoad(poolID, "main", strings.NewReader(`{a:1,s:"x"} {a:2,s:"y"} {a:3,s:"y"}`))
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go core.ServePostgres(t.Context(), l)
+	ctx := t.Context()
+	db, err := pgx.Connect(ctx, fmt.Sprintf("postgres://test@%s/test?sslmode=disable", l.Addr()))
+	require.NoError(t, err)
+	defer db.Close(ctx)
+	type row struct {
+		A int64
+		S string
+	}
+	// Simple query protocol
+	rows, err := db.Query(ctx, "select a, s from test order by a", pgx.QueryExecModeSimpleProtocol)
+	require.NoError(t, err)
+	got, err := pgx.CollectRows(rows, pgx.RowToStructByName[row])
+	require.NoError(t, err)
+	assert.Equal(t, []row{{1, "x"}, {2, "y"}, {3, "y"}}, got)
+	// Extended query protocol with a prepared statement and parameters
+	rows, err = db.Query(ctx, "select a, s from test where s = $1 and a > $2 order by a", "y", 1)
+	require.NoError(t, err)
+	got, err = pgx.CollectRows(rows, pgx.RowToStructByName[row])
+	require.NoError(t, err)
+	assert.Equal(t, []row{{2, "y"}, {3, "y"}}, got)
+	var n int64
+	require.NoError(t, db.QueryRow(ctx, "select count(*) from test where s = $1", "y").Scan(&n))
+	assert.Equal(t, int64(2), n)
+	// Errors carry their SQLSTATE and the session continues after them.
+	for _, mode := range []pgx.QueryExecMode{pgx.QueryExecModeSimpleProtocol, pgx.QueryExecModeCacheStatement} {
+		_, err = db.Exec(ctx, "from nosuchpool", mode)
+		assert.Equal(t, "42P01", pgErrorCode(err), mode)
+		_, err = db.Exec(ctx, "select from", mode)
+		assert.Equal(t, "42601", pgErrorCode(err), mode)
+	}
+	var user string
+	require.NoError(t, db.QueryRow(ctx, "select current_user").Scan(&user))
+	assert.Equal(t, "test", user)
+}
+
+func pgErrorCode(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+}
+
+// pgClient is a minimal client for the PostgreSQL wire protocol.
+type pgClient struct {
+	t *testing.T
+	c net.Conn
+	r *bufio.Reader
+}
+
+type pgResult struct {
+	columns []string
+	rows    [][]string
+	tag     string
+	err     string
+}
+
+func newPGClient(t *testing.T, core *service.Core, user, password string) *pgClient {
+	if user == "" {
+		user = "test"
+	}
+	pg, err := dialPG(t, core, user, password)
+	require.NoError(t, err)
+	return pg
+}
+
+func dialPG(t *testing.T, core *service.Core, user, password string) (*pgClient, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go core.ServePostgres(t.Context(), l)
+	c, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	pg := &pgClient{t: t, c: c, r: bufio.NewReader(c)}
+	var body []byte
+	body = binary.BigEndian.AppendUint32(body, 196608)
+	body = append(body, "user\x00"+user+"\x00database\x00test\x00\x00"...)
+	pg.write(0, body)
+	for {
+		typ, body := pg.read()
+		switch typ {
+		case 'R':
+			if binary.BigEndian.Uint32(body) == 3 {
+				pg.write('p', append([]byte(password), 0))
+			}
+		case 'E':
+			return nil, fmt.Errorf("%s", errorCode(body))
+		case 'Z':
+			return pg, nil
+		}
+	}
+}
+
+// query runs text with the simple query protocol.
+func (p *pgClient) query(text string) pgResult {
+	p.write('Q', append([]byte(text), 0))
+	return p.result()
+}
+
+// extendedQuery runs text with the extended query protocol given text
+// parameters and requests results in format.
+func (p *pgClient) extendedQuery(text string, params []string, format int) pgResult {
+	p.write('P', append(append([]byte{0}, text...), 0, 0, 0))
+	bind := []byte{0, 0, 0, 0}
+	bind = binary.BigEndian.AppendUint16(bind, uint16(len(params)))
+	for _, param := range params {
+		bind = binary.BigEndian.AppendUint32(bind, uint32(len(param)))
+		bind = append(bind, param...)
+	}
+	bind = binary.BigEndian.AppendUint16(bind, 1)
+	bind = binary.BigEndian.AppendUint16(bind, uint16(format))
+	p.write('B', bind)
+	p.write('D', []byte{'P', 0})
+	p.write('E', []byte{0, 0, 0, 0, 0})
+	p.write('S', nil)
+	return p.result()
+}
+
+// result reads messages until ReadyForQuery and returns the results.
+func (p *pgClient) result() pgResult {
+	var res pgResult
+	for {
+		typ, body := p.read()
+		switch typ {
+		case 'T':
+			n := int(binary.BigEndian.Uint16(body))
+			body = body[2:]
+			res.columns = []string{}
+			for range n {
+				name, rest, _ := strings.Cut(string(body), "\x00")
+				body = []byte(rest)
+				oid := binary.BigEndian.Uint32(body[6:])
+				res.columns = append(res.columns, fmt.Sprintf("%s:%d", name, oid))
+				body = body[18:]
+			}
+		case 'D':
+			n := int(binary.BigEndian.Uint16(body))
+			body = body[2:]
+			var row []string
+			for range n {
+				size := int(int32(binary.BigEndian.Uint32(body)))
+				body = body[4:]
+				if size < 0 {
+					row = append(row, "NULL")
+					continue
+				}
+				row = append(row, string(body[:size]))
+				body = body[size:]
+			}
+			res.rows = append(res.rows, row)
+		case 'C':
+			res.tag = strings.TrimSuffix(string(body), "\x00")
+		case 'E':
+			res.err = errorCode(body)
+		case 'Z':
+			return res
+		}
+	}
+}
+
+func (p *pgClient) write(typ byte, body []byte) {
+	var msg []byte
+	if typ != 0 {
+		msg = append(msg, typ)
+	}
+	msg = binary.BigEndian.AppendUint32(msg, uint32(len(body)+4))
+	_, err := p.c.Write(append(msg, body...))
+	require.NoError(p.t, err)
+}
+
+func (p *pgClient) read() (byte, []byte) {
+	var hdr [5]byte
+	_, err := io.ReadFull(p.r, hdr[:])
+	require.NoError(p.t, err)
+	body := make([]byte, binary.BigEndian.Uint32(hdr[1:])-4)
+	_, err = io.ReadFull(p.r, body)
+	require.NoError(p.t, err)
+	return hdr[0], body
+}
+
+// errorCode returns the SQLSTATE code of an ErrorResponse message.
+func errorCode(body []byte) string {
+	for _, f := range strings.Split(string(body), "\x00") {
+		if strings.HasPrefix(f, "C") {
+			return f[1:]
+		}
+	}
+	return ""
+}
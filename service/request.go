@@ -17,8 +17,11 @@ import (
 	"github.com/brimdata/super/db"
 	"github.com/brimdata/super/db/branches"
 	"github.com/brimdata/super/db/commits"
+	"github.com/brimdata/super/db/grants"
 	"github.com/brimdata/super/db/journal"
 	"github.com/brimdata/super/db/pools"
+	"github.com/brimdata/super/db/tags"
+	"github.com/brimdata/super/db/views"
 	"github.com/brimdata/super/dbid"
 	"github.com/brimdata/super/service/srverr"
 	"github.com/brimdata/super/sio"
@@ -61,8 +64,8 @@ func newRequest(w http.ResponseWriter, r *http.Request, c *Core) (*ResponseWrite
 	return nil, nil, false
 }
 
-func (r *Request) openPool(w *ResponseWriter, root *db.Root) (*db.Pool, bool) {
-	id, ok := r.PoolID(w, root)
+func (r *Request) openPool(w *ResponseWriter, root *db.Root, perm grants.Permission) (*db.Pool, bool) {
+	id, ok := r.PoolID(w, root, perm)
 	if !ok {
 		return nil, false
 	}
@@ -78,11 +81,64 @@ func (r *Request) ID() string {
 	return api.RequestIDFromContext(r.Context())
 }
 
-func (r *Request) PoolID(w *ResponseWriter, root *db.Root) (ksuid.KSUID, bool) {
+// PoolID returns the ID of the pool named by the "pool" path param if perm
+// on the pool is permitted.
+func (r *Request) PoolID(w *ResponseWriter, root *db.Root, perm grants.Permission) (ksuid.KSUID, bool) {
 	s, ok := r.StringFromPath(w, "pool")
 	if !ok {
 		return ksuid.Nil, false
 	}
+	id, ok := r.lookupPool(w, root, s)
+	if !ok {
+		return ksuid.Nil, false
+	}
+	if err := db.Authorize(r.Context(), id, perm); err != nil {
+		w.Error(err)
+		return ksuid.Nil, false
+	}
+	return id, true
+}
+
+// view returns the view named by the "view" path param if perm on the
+// view's target pool is permitted.
+func (r *Request) view(w *ResponseWriter, root *db.Root, perm grants.Permission) (*views.Config, bool) {
+	name, ok := r.StringFromPath(w, "view")
+	if !ok {
+		return nil, false
+	}
+	view, err := root.LookupView(r.Context(), name)
+	if err != nil {
+		w.Error(err)
+		return nil, false
+	}
+	if err := db.Authorize(r.Context(), view.Target, perm); err != nil {
+		w.Error(err)
+		return nil, false
+	}
+	return view, true
+}
+
+// grantPoolID is like PoolID with permission grants.Admin but also accepts
+// "*", which denotes all pools and is returned as ksuid.Nil.
+func (r *Request) grantPoolID(w *ResponseWriter, root *db.Root) (ksuid.KSUID, bool) {
+	s, ok := r.StringFromPath(w, "pool")
+	if !ok {
+		return ksuid.Nil, false
+	}
+	id := ksuid.Nil
+	if s != "*" {
+		if id, ok = r.lookupPool(w, root, s); !ok {
+			return ksuid.Nil, false
+		}
+	}
+	if err := db.Authorize(r.Context(), id, grants.Admin); err != nil {
+		w.Error(err)
+		return ksuid.Nil, false
+	}
+	return id, true
+}
+
+func (r *Request) lookupPool(w *ResponseWriter, root *db.Root, s string) (ksuid.KSUID, bool) {
 	if id, err := dbid.ParseID(s); err == nil {
 		if _, err = root.OpenPool(r.Context(), id); err == nil {
 			return id, true
@@ -123,7 +179,7 @@ func (r *Request) StringFromPath(w *ResponseWriter, arg string) (string, bool) {
 		w.Error(srverr.ErrInvalid("no arg %q in path", arg))
 		return "", false
 	}
-	decoded, err := url.QueryUnescape(s)
+	decoded, err := url.PathUnescape(s)
 	return decoded, err == nil
 }
 
@@ -312,11 +368,16 @@ func errorResponse(e error) (status int, ae *api.Error) {
 	}
 
 	switch {
-	case errors.Is(e, branches.ErrExists) || errors.Is(e, pools.ErrExists):
+	case errors.Is(e, branches.ErrExists) || errors.Is(e, pools.ErrExists) ||
+		errors.Is(e, tags.ErrExists) || errors.Is(e, views.ErrExists):
 		ze.Kind = srverr.Conflict
 	case errors.Is(e, branches.ErrNotFound) || errors.Is(e, commits.ErrNotFound) ||
-		errors.Is(e, pools.ErrNotFound) || errors.Is(e, fs.ErrNotExist):
+		errors.Is(e, grants.ErrNotFound) || errors.Is(e, pools.ErrNotFound) ||
+		errors.Is(e, tags.ErrNotFound) || errors.Is(e, views.ErrNotFound) ||
+		errors.Is(e, fs.ErrNotExist):
 		ze.Kind = srverr.NotFound
+	case errors.Is(e, grants.ErrDenied):
+		ze.Kind = srverr.Forbidden
 	}
 
 	switch ze.Kind {
@@ -0,0 +1,43 @@
+package anyio
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// compressWriter returns a writer that compresses its input with compression
+// and writes it to w.
+func compressWriter(w io.WriteCloser, compression string) (io.WriteCloser, error) {
+	switch compression {
+	case "", "none":
+		return w, nil
+	case "gzip":
+		return &compressor{gzip.NewWriter(w), w}, nil
+	case "zstd":
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		return &compressor{zw, w}, nil
+	default:
+		return nil, fmt.Errorf("unknown compression: %s", compression)
+	}
+}
+
+// compressor closes both its compressing writer, which flushes it, and the
+// underlying writer.
+type compressor struct {
+	io.WriteCloser
+	w io.WriteCloser
+}
+
+func (c *compressor) Close() error {
+	err := c.WriteCloser.Close()
+	if closeErr := c.w.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,139 @@
+package anyio
+
+import (
+	"bufio"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/ulikunitz/xz"
+)
+
+// decompressors are the compression formats recognized by DecompressReader.
+var decompressors = []struct {
+	// magic is the sequence of bytes that begins a compressed stream.
+	magic string
+	// newReader returns a reader of the decompressed contents of r.  It
+	// returns an error if it reads an invalid header from r.
+	newReader func(r io.Reader) (io.Reader, error)
+}{
+	// RFC 1952, Section 2.3.1
+	{"\x1f\x8b", newGzipReader},
+	// RFC 8878, Section 3.1.1
+	{"\x28\xb5\x2f\xfd", newZstdReader},
+	{"BZh", newBzip2Reader},
+	{"\xfd7zXZ\x00", newXZReader},
+	// LZ4 frame format, not the legacy format
+	{"\x04\x22\x4d\x18", newLZ4Reader},
+}
+
+// DecompressReader returns a reader of the decompressed contents of r if r
+// holds a gzip, zstd, bzip2, xz, or LZ4 stream.  Otherwise, it returns a
+// reader of the contents of r.
+func DecompressReader(r io.Reader) (io.Reader, error) {
+	track := NewTrack(r)
+	// A decompressor might block until it reads its entire header, so
+	// readMagic reads only as many bytes as are needed to rule out every
+	// magic sequence.
+	i := readMagic(track)
+	track.Reset()
+	if i < 0 {
+		return track.Reader(), nil
+	}
+	if _, err := decompressors[i].newReader(track); err != nil {
+		return track.Reader(), nil
+	}
+	return decompressors[i].newReader(track.Reader())
+}
+
+// readMagic reads r one byte at a time until the bytes read match the magic
+// sequence of an element of decompressors, whose index it returns, or match
+// none of them, in which case it returns -1.
+func readMagic(r io.Reader) int {
+	var buf []byte
+	var b [1]byte
+	for {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return -1
+		}
+		buf = append(buf, b[0])
+		var prefix bool
+		for i, d := range decompressors {
+			if len(d.magic) < len(buf) || d.magic[:len(buf)] != string(buf) {
+				continue
+			}
+			if len(d.magic) == len(buf) {
+				return i
+			}
+			prefix = true
+		}
+		if !prefix {
+			return -1
+		}
+	}
+}
+
+func newGzipReader(r io.Reader) (io.Reader, error) {
+	return gzip.NewReader(r)
+}
+
+func newZstdReader(r io.Reader) (io.Reader, error) {
+	// With a concurrency of one, the decoder runs synchronously and
+	// doesn't need to be closed.
+	return zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+}
+
+// A bzip2 stream header is the magic, a block size digit, and then either
+// the magic of the first block or that of the end of an empty stream.
+const (
+	bzip2BlockMagic = "\x31\x41\x59\x26\x53\x59"
+	bzip2EOSMagic   = "\x17\x72\x45\x38\x50\x90"
+)
+
+var errBzip2Header = errors.New("bzip2: invalid header")
+
+func newBzip2Reader(r io.Reader) (io.Reader, error) {
+	// The bzip2 magic is short and printable so check the rest of the
+	// header before accepting it.
+	br := bufio.NewReader(r)
+	hdr, err := br.Peek(10)
+	if err != nil {
+		return nil, errBzip2Header
+	}
+	if hdr[3] < '1' || hdr[3] > '9' {
+		return nil, errBzip2Header
+	}
+	if m := string(hdr[4:]); m != bzip2BlockMagic && m != bzip2EOSMagic {
+		return nil, errBzip2Header
+	}
+	return bzip2.NewReader(br), nil
+}
+
+func newXZReader(r io.Reader) (io.Reader, error) {
+	return xz.NewReader(r)
+}
+
+func newLZ4Reader(r io.Reader) (io.Reader, error) {
+	return &lz4Reader{Reader: lz4.NewReader(r)}, nil
+}
+
+// lz4Reader wraps lz4.Reader, which returns an error other than io.EOF if
+// Read is called again after it returns io.EOF.
+type lz4Reader struct {
+	*lz4.Reader
+	eof bool
+}
+
+func (l *lz4Reader) Read(b []byte) (int, error) {
+	if l.eof {
+		return 0, io.EOF
+	}
+	n, err := l.Reader.Read(b)
+	if err == io.EOF {
+		l.eof = true
+	}
+	return n, err
+}
@@ -0,0 +1,88 @@
+package anyio
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/ulikunitz/xz"
+)
+
+// TestDecompressReaderOnlyReadsTwoBytesIfNoMagic tests that DecompressReader
+// doesn't try to read more than two bytes from a non-io.ReadSeeker reader if
+// those bytes don't begin any magic sequence.
+func TestDecompressReaderOnlyReadsTwoBytesIfNoMagic(t *testing.T) {
+	pr, pw := io.Pipe()
+	ch := make(chan struct{})
+	var writeErr error
+	go func() {
+		// DecompressReader should return upon seeing this two-byte
+		// input.  It will block (and this test will time out) if it
+		// tries to read more than two bytes.
+		_, writeErr = pw.Write([]byte("1\n"))
+		close(ch)
+	}()
+	r, err := DecompressReader(pr)
+	require.NoError(t, err)
+	require.NotNil(t, r)
+	<-ch
+	require.NoError(t, writeErr)
+}
+
+func TestDecompressReader(t *testing.T) {
+	const data = "hello, world\n"
+	compress := func(newWriter func(io.Writer) (io.WriteCloser, error)) []byte {
+		var buf bytes.Buffer
+		w, err := newWriter(&buf)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+	// Generated with `printf 'hello, world\n' | bzip2 | base64`.
+	bzip2, err := base64.StdEncoding.DecodeString("QlpoOTFBWSZTWVSkl4QAAALRgAAQQAQGRJCAIAAxADAgaGIASdSyHz8XckU4UJBUpJeE")
+	require.NoError(t, err)
+	cases := map[string][]byte{
+		"none": []byte(data),
+		"gzip": compress(func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(w), nil
+		}),
+		"zstd": compress(func(w io.Writer) (io.WriteCloser, error) {
+			return zstd.NewWriter(w)
+		}),
+		"bzip2": bzip2,
+		"xz": compress(func(w io.Writer) (io.WriteCloser, error) {
+			return xz.NewWriter(w)
+		}),
+		"lz4": compress(func(w io.Writer) (io.WriteCloser, error) {
+			return lz4.NewWriter(w), nil
+		}),
+		// Input beginning with a partial magic sequence is not
+		// compressed.
+		"bzip2 magic only": []byte("BZh9 is not bzip2"),
+	}
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			expected := data
+			if name == "bzip2 magic only" {
+				expected = string(input)
+			}
+			for _, r := range []io.Reader{bytes.NewReader(input), io.MultiReader(bytes.NewReader(input))} {
+				r, err := DecompressReader(r)
+				require.NoError(t, err)
+				b, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.Equal(t, expected, string(b))
+				// Reading after the end is reached returns io.EOF again.
+				_, err = r.Read(make([]byte, 1))
+				require.ErrorIs(t, err, io.EOF)
+			}
+		})
+	}
+}
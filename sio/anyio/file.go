@@ -44,7 +44,7 @@ func Open(ctx context.Context, sctx *super.Context, engine storage.Engine, path
 }
 
 func NewFile(sctx *super.Context, rc io.ReadCloser, path string, opts ReaderOpts) (*sbuf.File, error) {
-	r, err := GzipReader(rc)
+	r, err := DecompressReader(rc)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +75,14 @@ func FileType(ctx context.Context, sctx *super.Context, engine storage.Engine, p
 		// r can't seek so it's a fifo or pipe.
 		return nil, nil
 	}
+	if rs, ok := r.(io.Seeker); ok {
+		// r may share its offset with the reader of the file that follows,
+		// e.g., when standard input is redirected from a file, so restore
+		// the offset once the values have been sampled.
+		if off, err := rs.Seek(0, io.SeekCurrent); err == nil {
+			defer rs.Seek(off, io.SeekStart)
+		}
+	}
 	f, err := NewFile(sctx, r, path, opts)
 	if err != nil {
 		return nil, err
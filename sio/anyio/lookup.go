@@ -7,12 +7,14 @@ import (
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/arrowio"
+	"github.com/brimdata/super/sio/avroio"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sio/csupio"
 	"github.com/brimdata/super/sio/csvio"
 	"github.com/brimdata/super/sio/jsonio"
 	"github.com/brimdata/super/sio/jsupio"
 	"github.com/brimdata/super/sio/lineio"
+	"github.com/brimdata/super/sio/orcio"
 	"github.com/brimdata/super/sio/parquetio"
 	"github.com/brimdata/super/sio/supio"
 	"github.com/brimdata/super/sio/zeekio"
@@ -22,6 +24,8 @@ func lookupReader(sctx *super.Context, r io.Reader, opts ReaderOpts) (sio.ReadCl
 	switch opts.Format {
 	case "arrows":
 		return arrowio.NewReader(sctx, r)
+	case "avro":
+		return avroio.NewReader(sctx, r)
 	case "bsup":
 		return bsupio.NewReaderWithOpts(sctx, r, opts.BSUP), nil
 	case "csup":
@@ -36,6 +40,8 @@ func lookupReader(sctx *super.Context, r io.Reader, opts ReaderOpts) (sio.ReadCl
 		return sio.NopReadCloser(lineio.NewReader(r)), nil
 	case "json":
 		return sio.NopReadCloser(jsonio.NewReader(sctx, r)), nil
+	case "orc":
+		return orcio.NewReader(sctx, r, opts.Fields)
 	case "parquet":
 		return parquetio.NewReader(sctx, r, opts.Fields)
 	case "sup":
@@ -12,11 +12,13 @@ import (
 	"github.com/brimdata/super/pkg/field"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/arrowio"
+	"github.com/brimdata/super/sio/avroio"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sio/csupio"
 	"github.com/brimdata/super/sio/csvio"
 	"github.com/brimdata/super/sio/jsonio"
 	"github.com/brimdata/super/sio/jsupio"
+	"github.com/brimdata/super/sio/orcio"
 	"github.com/brimdata/super/sio/parquetio"
 	"github.com/brimdata/super/sio/supio"
 	"github.com/brimdata/super/sio/zeekio"
@@ -38,7 +40,7 @@ func NewReaderWithOpts(sctx *super.Context, r io.Reader, opts ReaderOpts) (sio.R
 		return lookupReader(sctx, r, opts)
 	}
 
-	var parquetErr, csupErr error
+	var parquetErr, csupErr, orcErr error
 	if rs, ok := r.(io.ReadSeeker); ok {
 		if n, err := rs.Seek(0, io.SeekCurrent); err == nil {
 			var rc sio.ReadCloser
@@ -57,19 +59,36 @@ func NewReaderWithOpts(sctx *super.Context, r io.Reader, opts ReaderOpts) (sio.R
 			if _, err := rs.Seek(n, io.SeekStart); err != nil {
 				return nil, err
 			}
+			orcErr = isORC(rs)
+			if _, err := rs.Seek(n, io.SeekStart); err != nil {
+				return nil, err
+			}
+			if orcErr == nil {
+				return orcio.NewReader(sctx, rs, opts.Fields)
+			}
 		} else {
 			parquetErr = err
 			csupErr = err
+			orcErr = err
 		}
 		parquetErr = fmt.Errorf("parquet: %w", parquetErr)
 		csupErr = fmt.Errorf("csup: %w", csupErr)
+		orcErr = fmt.Errorf("orc: %w", orcErr)
 	} else {
 		parquetErr = errors.New("parquet: auto-detection requires seekable input")
 		csupErr = errors.New("csup: auto-detection requires seekable input")
+		orcErr = errors.New("orc: auto-detection requires seekable input")
 	}
 
 	track := NewTrack(r)
 
+	avroErr := isAvro(track)
+	if avroErr == nil {
+		return avroio.NewReader(sctx, track.Reader())
+	}
+	avroErr = fmt.Errorf("avro: %w", avroErr)
+	track.Reset()
+
 	arrowsErr := isArrowStream(track)
 	if arrowsErr == nil {
 		return arrowio.NewReader(sctx, track.Reader())
@@ -134,11 +153,13 @@ func NewReaderWithOpts(sctx *super.Context, r io.Reader, opts ReaderOpts) (sio.R
 	lineErr := errors.New("line: auto-detection not supported")
 	return nil, joinErrs([]error{
 		arrowsErr,
+		avroErr,
 		bsupErr,
 		csupErr,
 		csvErr,
 		jsonErr,
 		lineErr,
+		orcErr,
 		parquetErr,
 		supErr,
 		tsvErr,
@@ -177,6 +198,29 @@ func isArrowStream(track *Track) error {
 	return err
 }
 
+func isAvro(track *Track) error {
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(track, buf); err != nil {
+		return err
+	}
+	if !avroio.IsAvro(buf) {
+		return errors.New("magic bytes not found")
+	}
+	track.Reset()
+	return nil
+}
+
+func isORC(r io.Reader) error {
+	buf := make([]byte, 3)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	if !orcio.IsORC(buf) {
+		return errors.New("magic bytes not found")
+	}
+	return nil
+}
+
 func isCSVStream(track *Track, delim rune, name string) error {
 	if line, err := bufio.NewReader(track).ReadSlice('\n'); err != nil {
 		return fmt.Errorf("%s: line 1: %w", name, err)
@@ -5,8 +5,10 @@ import (
 	"io"
 
 	"github.com/brimdata/super"
+	"github.com/brimdata/super/csup"
 	"github.com/brimdata/super/sio"
 	"github.com/brimdata/super/sio/arrowio"
+	"github.com/brimdata/super/sio/avroio"
 	"github.com/brimdata/super/sio/bsupio"
 	"github.com/brimdata/super/sio/csupio"
 	"github.com/brimdata/super/sio/csvio"
@@ -23,23 +25,34 @@ import (
 type WriterOpts struct {
 	Format string
 	BSUP   *bsupio.WriterOpts // Nil means use defaults via bsupio.NewWriter.
+	CSUP   csup.WriterOpts
 	CSV    csvio.WriterOpts
 	DB     dbio.WriterOpts
 	JSON   jsonio.WriterOpts
 	SUP    supio.WriterOpts
+
+	// Compression is "gzip" or "zstd" to compress the output or "none"
+	// or empty for no compression.
+	Compression string
 }
 
 func NewWriter(w io.WriteCloser, opts WriterOpts) (sio.WriteCloser, error) {
+	w, err := compressWriter(w, opts.Compression)
+	if err != nil {
+		return nil, err
+	}
 	switch opts.Format {
 	case "arrows":
 		return arrowio.NewWriter(w), nil
+	case "avro":
+		return avroio.NewWriter(w), nil
 	case "bsup":
 		if opts.BSUP == nil {
 			return bsupio.NewWriter(w), nil
 		}
 		return bsupio.NewWriterWithOpts(w, *opts.BSUP), nil
 	case "csup":
-		return csupio.NewWriter(w), nil
+		return csupio.NewWriterWithOpts(w, opts.CSUP), nil
 	case "csv":
 		return csvio.NewWriter(w, opts.CSV), nil
 	case "db":
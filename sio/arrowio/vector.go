@@ -0,0 +1,136 @@
+package arrowio
+
+import (
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/float16"
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/vector"
+)
+
+// WriteVector writes the values of vec, which must be records of a single
+// type.  Fields of primitive type whose vectors are flat are copied to
+// Arrow arrays directly without materializing their values.
+func (w *Writer) WriteVector(vec vector.Any) error {
+	rec, ok := vector.Under(vec).(*vector.Record)
+	if !ok || rec.Typ.Opts != 0 {
+		return w.writeVectorValues(vec)
+	}
+	if err := w.setType(rec.Typ); err != nil {
+		return err
+	}
+	var b scode.Builder
+	for i, builder := range w.builder.Fields() {
+		typ := rec.Typ.Fields[i].Type
+		fieldVec := rec.Field(i).Val
+		if !appendVector(builder, typ, fieldVec) {
+			for slot := range fieldVec.Len() {
+				b.Truncate()
+				fieldVec.Serialize(&b, slot)
+				w.buildArrowValue(builder, typ, b.Bytes().Body(), false)
+			}
+		}
+	}
+	return w.flush(recordBatchSize)
+}
+
+func (w *Writer) writeVectorValues(vec vector.Any) error {
+	var b scode.Builder
+	d, _ := vec.(*vector.Dynamic)
+	typ := vec.Type()
+	for slot := range vec.Len() {
+		b.Truncate()
+		vec.Serialize(&b, slot)
+		if d != nil {
+			typ = d.TypeOf(slot)
+		}
+		if err := w.Write(super.NewValue(typ, b.Bytes().Body())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendVector appends the values of vec, which have type typ, to b and
+// returns true or returns false if vec cannot be appended directly.
+func appendVector(b array.Builder, typ super.Type, vec vector.Any) bool {
+	if _, ok := typ.(*super.TypeNamed); ok {
+		// Named types may select Arrow types with other units.
+		return false
+	}
+	switch vec := vec.(type) {
+	case *vector.Bool:
+		if b, ok := b.(*array.BooleanBuilder); ok {
+			for slot := range vec.Len() {
+				b.Append(vec.IsSet(slot))
+			}
+			return true
+		}
+	case *vector.Int:
+		switch b := b.(type) {
+		case *array.Int8Builder:
+			appendConverted(b.Append, vec.Values, func(v int64) int8 { return int8(v) })
+		case *array.Int16Builder:
+			appendConverted(b.Append, vec.Values, func(v int64) int16 { return int16(v) })
+		case *array.Int32Builder:
+			appendConverted(b.Append, vec.Values, func(v int64) int32 { return int32(v) })
+		case *array.Int64Builder:
+			b.AppendValues(vec.Values, nil)
+		case *array.TimestampBuilder:
+			appendConverted(b.Append, vec.Values, func(v int64) arrow.Timestamp { return arrow.Timestamp(v) })
+		case *array.DurationBuilder:
+			appendConverted(b.Append, vec.Values, func(v int64) arrow.Duration { return arrow.Duration(v) })
+		default:
+			return false
+		}
+		return true
+	case *vector.Uint:
+		switch b := b.(type) {
+		case *array.Uint8Builder:
+			appendConverted(b.Append, vec.Values, func(v uint64) uint8 { return uint8(v) })
+		case *array.Uint16Builder:
+			appendConverted(b.Append, vec.Values, func(v uint64) uint16 { return uint16(v) })
+		case *array.Uint32Builder:
+			appendConverted(b.Append, vec.Values, func(v uint64) uint32 { return uint32(v) })
+		case *array.Uint64Builder:
+			b.AppendValues(vec.Values, nil)
+		default:
+			return false
+		}
+		return true
+	case *vector.Float:
+		switch b := b.(type) {
+		case *array.Float16Builder:
+			appendConverted(b.Append, vec.Values, func(v float64) float16.Num { return float16.New(float32(v)) })
+		case *array.Float32Builder:
+			appendConverted(b.Append, vec.Values, func(v float64) float32 { return float32(v) })
+		case *array.Float64Builder:
+			b.AppendValues(vec.Values, nil)
+		default:
+			return false
+		}
+		return true
+	case *vector.String:
+		if b, ok := b.(*array.StringBuilder); ok {
+			for slot := range vec.Len() {
+				b.Append(vec.Value(slot))
+			}
+			return true
+		}
+	case *vector.Bytes:
+		if b, ok := b.(*array.BinaryBuilder); ok {
+			for slot := range vec.Len() {
+				b.Append(vec.Value(slot))
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func appendConverted[T, U any](appendFunc func(U), values []T, convert func(T) U) {
+	for _, v := range values {
+		appendFunc(convert(v))
+	}
+}
@@ -81,6 +81,18 @@ func (w *Writer) Write(val super.Value) error {
 	if !ok {
 		return fmt.Errorf("%w: %s", ErrNotRecord, sup.FormatValue(val))
 	}
+	if err := w.setType(recType); err != nil {
+		return err
+	}
+	it := scode.NewRecordIter(val.Bytes(), recType.Opts)
+	for i, builder := range w.builder.Fields() {
+		b, none := it.Next(recType.Fields[i].Opt)
+		w.buildArrowValue(builder, recType.Fields[i].Type, b, none)
+	}
+	return w.flush(recordBatchSize)
+}
+
+func (w *Writer) setType(recType *super.TypeRecord) error {
 	if w.typ == nil {
 		w.typ = recType
 		dt, err := w.newArrowDataType(recType)
@@ -97,12 +109,7 @@ func (w *Writer) Write(val super.Value) error {
 	} else if w.typ != recType {
 		return fmt.Errorf("%w: %s and %s", ErrMultipleTypes, sup.FormatType(w.typ), sup.FormatType(recType))
 	}
-	it := scode.NewRecordIter(val.Bytes(), recType.Opts)
-	for i, builder := range w.builder.Fields() {
-		b, none := it.Next(recType.Fields[i].Opt)
-		w.buildArrowValue(builder, recType.Fields[i].Type, b, none)
-	}
-	return w.flush(recordBatchSize)
+	return nil
 }
 
 func (w *Writer) flush(min int) error {
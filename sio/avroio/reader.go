@@ -0,0 +1,466 @@
+// Package avroio implements readers and writers for the Avro Object
+// Container File format.
+package avroio
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/scode"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+const magic = "Obj\x01"
+
+const syncLen = 16
+
+// maxBlockLen limits the size of a block read into memory.
+const maxBlockLen = 1 << 30
+
+var ErrBadMagic = errors.New("avroio: not an Avro object container file")
+
+// Reader is a sio.Reader for the Avro Object Container File format.  The
+// type of the values it reads is derived from the schema in the file
+// header.
+type Reader struct {
+	sctx   *super.Context
+	r      *bufio.Reader
+	schema *schema
+	codec  string
+	sync   [syncLen]byte
+	zstd   *zstd.Decoder
+
+	block   decoder
+	count   int64
+	buf     []byte
+	builder scode.Builder
+	val     super.Value
+}
+
+// IsAvro reports whether b begins with the magic bytes of an Avro Object
+// Container File.
+func IsAvro(b []byte) bool {
+	return bytes.HasPrefix(b, []byte(magic))
+}
+
+func NewReader(sctx *super.Context, r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	b := make([]byte, len(magic))
+	if _, err := io.ReadFull(br, b); err != nil || !IsAvro(b) {
+		return nil, ErrBadMagic
+	}
+	meta, err := readMetadata(br)
+	if err != nil {
+		return nil, err
+	}
+	s, err := parseSchema(meta["avro.schema"])
+	if err != nil {
+		return nil, err
+	}
+	if err := bindTypes(sctx, s); err != nil {
+		return nil, err
+	}
+	codec := string(meta["avro.codec"])
+	switch codec {
+	case "":
+		codec = "null"
+	case "null", "deflate", "snappy", "zstandard", "bzip2":
+	default:
+		return nil, fmt.Errorf("avroio: unsupported codec %q", codec)
+	}
+	rd := &Reader{
+		sctx:   sctx,
+		r:      br,
+		schema: s,
+		codec:  codec,
+	}
+	if _, err := io.ReadFull(br, rd.sync[:]); err != nil {
+		return nil, fmt.Errorf("avroio: reading header: %w", noEOF(err))
+	}
+	if codec == "zstandard" {
+		if rd.zstd, err = zstd.NewReader(nil); err != nil {
+			return nil, err
+		}
+	}
+	return rd, nil
+}
+
+func (r *Reader) Close() error {
+	if r.zstd != nil {
+		r.zstd.Close()
+		r.zstd = nil
+	}
+	return nil
+}
+
+// readMetadata reads the metadata map of the file header.
+func readMetadata(r *bufio.Reader) (map[string][]byte, error) {
+	meta := map[string][]byte{}
+	for {
+		n, err := binary.ReadVarint(r)
+		if err != nil {
+			return nil, fmt.Errorf("avroio: reading header: %w", noEOF(err))
+		}
+		if n == 0 {
+			return meta, nil
+		}
+		if n < 0 {
+			n = -n
+			if _, err := binary.ReadVarint(r); err != nil {
+				return nil, fmt.Errorf("avroio: reading header: %w", noEOF(err))
+			}
+		}
+		for range n {
+			key, err := readBytes(r)
+			if err != nil {
+				return nil, err
+			}
+			val, err := readBytes(r)
+			if err != nil {
+				return nil, err
+			}
+			meta[string(key)] = val
+		}
+	}
+}
+
+func readBytes(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadVarint(r)
+	if err != nil {
+		return nil, fmt.Errorf("avroio: reading header: %w", noEOF(err))
+	}
+	if n < 0 || n > maxBlockLen {
+		return nil, errors.New("avroio: reading header: bad length")
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, fmt.Errorf("avroio: reading header: %w", noEOF(err))
+	}
+	return b, nil
+}
+
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (r *Reader) Read() (*super.Value, error) {
+	for r.count == 0 {
+		if err := r.nextBlock(); err != nil {
+			if err == io.EOF {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+	r.count--
+	r.builder.Truncate()
+	s := r.schema
+	if s.kind == "record" {
+		// The body of a record value comprises its fields.
+		for _, f := range s.fields {
+			if err := r.decode(f.typ); err != nil {
+				return nil, err
+			}
+		}
+		r.val = super.NewValue(s.typ, r.builder.Bytes())
+	} else {
+		if err := r.decode(s); err != nil {
+			return nil, err
+		}
+		r.val = super.NewValue(s.typ, r.builder.Bytes().Body())
+	}
+	return &r.val, nil
+}
+
+// nextBlock reads the next data block and returns io.EOF at the end of
+// the file.
+func (r *Reader) nextBlock() error {
+	if len(r.block.buf) > 0 {
+		return errors.New("avroio: block has data beyond its last value")
+	}
+	count, err := binary.ReadVarint(r.r)
+	if err != nil {
+		return err
+	}
+	size, err := binary.ReadVarint(r.r)
+	if err != nil {
+		return fmt.Errorf("avroio: reading block: %w", noEOF(err))
+	}
+	if count < 0 || size < 0 || size > maxBlockLen {
+		return errors.New("avroio: reading block: bad header")
+	}
+	if cap(r.buf) < int(size) {
+		r.buf = make([]byte, size)
+	}
+	r.buf = r.buf[:size]
+	if _, err := io.ReadFull(r.r, r.buf); err != nil {
+		return fmt.Errorf("avroio: reading block: %w", noEOF(err))
+	}
+	var sync [syncLen]byte
+	if _, err := io.ReadFull(r.r, sync[:]); err != nil {
+		return fmt.Errorf("avroio: reading block: %w", noEOF(err))
+	}
+	if sync != r.sync {
+		return errors.New("avroio: reading block: sync marker mismatch")
+	}
+	b, err := r.decompress(r.buf)
+	if err != nil {
+		return fmt.Errorf("avroio: %s codec: %w", r.codec, err)
+	}
+	r.block.buf = b
+	r.count = count
+	return nil
+}
+
+func (r *Reader) decompress(b []byte) ([]byte, error) {
+	switch r.codec {
+	case "deflate":
+		return io.ReadAll(flate.NewReader(bytes.NewReader(b)))
+	case "snappy":
+		// The compressed data is followed by the big-endian CRC-32
+		// checksum of the uncompressed data.
+		if len(b) < 4 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		out, err := snappy.Decode(nil, b[:len(b)-4])
+		if err != nil {
+			return nil, err
+		}
+		if crc32.ChecksumIEEE(out) != binary.BigEndian.Uint32(b[len(b)-4:]) {
+			return nil, errors.New("checksum mismatch")
+		}
+		return out, nil
+	case "zstandard":
+		return r.zstd.DecodeAll(b, nil)
+	case "bzip2":
+		return io.ReadAll(bzip2.NewReader(bytes.NewReader(b)))
+	}
+	return b, nil
+}
+
+// decode decodes the next value of s from the current block and appends
+// it to the builder.
+func (r *Reader) decode(s *schema) error {
+	b := &r.builder
+	d := &r.block
+	switch s.kind {
+	case "null":
+		b.Append(nil)
+	case "boolean":
+		v, err := d.byte()
+		if err != nil {
+			return err
+		}
+		b.Append(super.EncodeBool(v != 0))
+	case "int", "long":
+		v, err := d.long()
+		if err != nil {
+			return err
+		}
+		switch s.logical {
+		case "date":
+			b.Append(super.EncodeTime(nano.Ts(v * 86400 * 1_000_000_000)))
+		case "time-millis":
+			b.Append(super.EncodeDuration(nano.Duration(v) * nano.Millisecond))
+		case "time-micros":
+			b.Append(super.EncodeDuration(nano.Duration(v) * nano.Microsecond))
+		case "timestamp-millis", "local-timestamp-millis":
+			b.Append(super.EncodeTime(nano.Ts(v * 1_000_000)))
+		case "timestamp-micros", "local-timestamp-micros":
+			b.Append(super.EncodeTime(nano.Ts(v * 1_000)))
+		case "timestamp-nanos", "local-timestamp-nanos":
+			b.Append(super.EncodeTime(nano.Ts(v)))
+		default:
+			b.Append(super.EncodeInt(v))
+		}
+	case "float":
+		v, err := d.next(4)
+		if err != nil {
+			return err
+		}
+		b.Append(super.EncodeFloat32(math.Float32frombits(binary.LittleEndian.Uint32(v))))
+	case "double":
+		v, err := d.next(8)
+		if err != nil {
+			return err
+		}
+		b.Append(super.EncodeFloat64(math.Float64frombits(binary.LittleEndian.Uint64(v))))
+	case "bytes", "string":
+		v, err := d.bytes()
+		if err != nil {
+			return err
+		}
+		if s.logical == "decimal" {
+			b.Append(super.EncodeString(decodeDecimal(v, s.scale)))
+		} else {
+			b.Append(v)
+		}
+	case "fixed":
+		v, err := d.next(s.size)
+		if err != nil {
+			return err
+		}
+		switch s.logical {
+		case "decimal":
+			b.Append(super.EncodeString(decodeDecimal(v, s.scale)))
+		case "duration":
+			// An Avro duration is the little-endian months, days,
+			// and milliseconds.  A month is taken as 30 days.
+			months := int64(binary.LittleEndian.Uint32(v))
+			days := int64(binary.LittleEndian.Uint32(v[4:]))
+			ms := int64(binary.LittleEndian.Uint32(v[8:]))
+			dur := nano.Duration((months*30+days)*24)*nano.Hour + nano.Duration(ms)*nano.Millisecond
+			b.Append(super.EncodeDuration(dur))
+		default:
+			b.Append(v)
+		}
+	case "record":
+		b.BeginContainer()
+		for _, f := range s.fields {
+			if err := r.decode(f.typ); err != nil {
+				return err
+			}
+		}
+		b.EndContainer()
+	case "enum":
+		v, err := d.long()
+		if err != nil {
+			return err
+		}
+		if v < 0 || int(v) >= len(s.symbols) {
+			return fmt.Errorf("avroio: enum index %d out of range", v)
+		}
+		b.Append(super.EncodeUint(uint64(v)))
+	case "array", "map":
+		b.BeginContainer()
+		for {
+			n, err := d.long()
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				break
+			}
+			if n < 0 {
+				// A negative count is followed by the block size.
+				n = -n
+				if _, err := d.long(); err != nil {
+					return err
+				}
+			}
+			for range n {
+				if s.kind == "map" {
+					key, err := d.bytes()
+					if err != nil {
+						return err
+					}
+					b.Append(key)
+				}
+				if err := r.decode(s.items); err != nil {
+					return err
+				}
+			}
+		}
+		if s.kind == "map" {
+			b.TransformContainer(super.NormalizeMap)
+		}
+		b.EndContainer()
+	case "union":
+		v, err := d.long()
+		if err != nil {
+			return err
+		}
+		if v < 0 || int(v) >= len(s.branches) {
+			return fmt.Errorf("avroio: union index %d out of range", v)
+		}
+		if s.tags == nil {
+			// The branches have the same super type.
+			return r.decode(s.branches[v])
+		}
+		super.BeginUnion(b, s.tags[v])
+		if err := r.decode(s.branches[v]); err != nil {
+			return err
+		}
+		b.EndContainer()
+	default:
+		panic(s.kind)
+	}
+	return nil
+}
+
+// decodeDecimal returns the decimal text of the big-endian two's complement
+// unscaled value in b with scale digits after the decimal point.
+func decodeDecimal(b []byte, scale int) string {
+	v := new(big.Int).SetBytes(b)
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(b))*8))
+	}
+	digits := new(big.Int).Abs(v).String()
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if v.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// decoder decodes the Avro binary encoding of a data block.
+type decoder struct {
+	buf []byte
+}
+
+var errTruncated = errors.New("avroio: truncated block")
+
+func (d *decoder) next(n int) ([]byte, error) {
+	if n < 0 || n > len(d.buf) {
+		return nil, errTruncated
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b, nil
+}
+
+func (d *decoder) byte() (byte, error) {
+	b, err := d.next(1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+func (d *decoder) long() (int64, error) {
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		return 0, errTruncated
+	}
+	d.buf = d.buf[n:]
+	return v, nil
+}
+
+func (d *decoder) bytes() ([]byte, error) {
+	n, err := d.long()
+	if err != nil {
+		return nil, err
+	}
+	return d.next(int(n))
+}
@@ -0,0 +1,162 @@
+package avroio
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"hash/crc32"
+	"math/big"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/sup"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+  "type": "record",
+  "name": "Event",
+  "namespace": "com.example",
+  "fields": [
+    {"name": "id", "type": "long"},
+    {"name": "host", "type": ["null", "string"]},
+    {"name": "ts", "type": {"type": "long", "logicalType": "timestamp-micros"}},
+    {"name": "tags", "type": {"type": "array", "items": "string"}},
+    {"name": "src", "type": {"type": "record", "name": "Addr", "fields": [{"name": "ip", "type": "string"}]}},
+    {"name": "dst", "type": "com.example.Addr"},
+    {"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["A", "B"]}},
+    {"name": "price", "type": {"type": "bytes", "logicalType": "decimal", "precision": 5, "scale": 2}}
+  ]
+}`
+
+func TestReaderCodecs(t *testing.T) {
+	// The tags array is encoded as a block with a negative count and a
+	// byte size as some writers do.
+	var rec []byte
+	rec = binary.AppendVarint(rec, 7)
+	rec = binary.AppendVarint(rec, 1)
+	rec = appendBytes(rec, []byte("h1"))
+	rec = binary.AppendVarint(rec, 1_700_000_000_123_456)
+	rec = binary.AppendVarint(rec, -2)
+	rec = binary.AppendVarint(rec, 4)
+	rec = appendBytes(rec, []byte("a"))
+	rec = appendBytes(rec, []byte("b"))
+	rec = binary.AppendVarint(rec, 0)
+	rec = appendBytes(rec, []byte("1.2.3.4"))
+	rec = appendBytes(rec, []byte("5.6.7.8"))
+	rec = binary.AppendVarint(rec, 1)
+	rec = appendBytes(rec, []byte{0xfe, 0x0c}) // -500
+	const expected = `{id:7,host:"h1"::(string|null),ts:2023-11-14T22:13:20.123456Z::=avro_timestamp_micros,tags:["a","b"],src:{ip:"1.2.3.4"},dst:{ip:"5.6.7.8"},kind:"B"::enum(A,B),price:"-5.00"::=avro_decimal_5_2}`
+	for _, codec := range []string{"", "null", "deflate", "snappy", "zstandard"} {
+		t.Run(codec, func(t *testing.T) {
+			block := bytes.Repeat(rec, 2)
+			switch codec {
+			case "deflate":
+				var buf bytes.Buffer
+				w, err := flate.NewWriter(&buf, flate.BestSpeed)
+				require.NoError(t, err)
+				_, err = w.Write(block)
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+				block = buf.Bytes()
+			case "snappy":
+				crc := crc32.ChecksumIEEE(block)
+				block = binary.BigEndian.AppendUint32(snappy.Encode(nil, block), crc)
+			case "zstandard":
+				w, err := zstd.NewWriter(nil)
+				require.NoError(t, err)
+				block = w.EncodeAll(block, nil)
+				require.NoError(t, w.Close())
+			}
+			r, err := NewReader(super.NewContext(), bytes.NewReader(testFile(codec, 2, block)))
+			require.NoError(t, err)
+			defer r.Close()
+			for range 2 {
+				val, err := r.Read()
+				require.NoError(t, err)
+				require.NotNil(t, val)
+				require.Equal(t, expected, sup.FormatValue(*val))
+			}
+			val, err := r.Read()
+			require.NoError(t, err)
+			require.Nil(t, val)
+		})
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	_, err := NewReader(super.NewContext(), bytes.NewReader([]byte("Obj\x02")))
+	require.ErrorIs(t, err, ErrBadMagic)
+	const recursive = `{"type":"record","name":"List","fields":[{"name":"next","type":["null","List"]}]}`
+	_, err = NewReader(super.NewContext(), bytes.NewReader(testFileWithSchema(recursive, "null", 0, nil)))
+	require.EqualError(t, err, `avroio: recursive type "List" is not supported`)
+	r, err := NewReader(super.NewContext(), bytes.NewReader(testFile("null", 1, []byte{0x02})))
+	require.NoError(t, err)
+	_, err = r.Read()
+	require.ErrorIs(t, err, errTruncated)
+	for _, c := range []struct {
+		typ string
+		err string
+	}{
+		{`{"type":"bytes","logicalType":"decimal","scale":2}`, "avroio: invalid schema: decimal has invalid precision <nil>"},
+		{`{"type":"bytes","logicalType":"decimal","precision":0}`, "avroio: invalid schema: decimal has invalid precision 0"},
+		{`{"type":"bytes","logicalType":"decimal","precision":2,"scale":3}`, "avroio: invalid schema: decimal with precision 2 has invalid scale 3"},
+		{`{"type":"bytes","logicalType":"decimal","precision":5,"scale":-1}`, "avroio: invalid schema: decimal with precision 5 has invalid scale -1"},
+		{`{"type":"fixed","name":"F","size":2,"logicalType":"decimal","precision":5}`, `avroio: invalid schema: decimal precision 5 exceeds 4 digits of fixed "F"`},
+	} {
+		schema := `{"type":"record","name":"R","fields":[{"name":"d","type":` + c.typ + `}]}`
+		_, err := NewReader(super.NewContext(), bytes.NewReader(testFileWithSchema(schema, "", 0, nil)))
+		require.EqualError(t, err, c.err, c.typ)
+	}
+}
+
+func TestReaderLogicalTypes(t *testing.T) {
+	const schema = `{"type":"record","name":"R","fields":[
+	  {"name":"dec","type":{"type":"fixed","name":"D","size":16,"logicalType":"decimal","precision":30,"scale":4}},
+	  {"name":"small","type":{"type":"bytes","logicalType":"decimal","precision":3,"scale":3}},
+	  {"name":"millis","type":{"type":"int","logicalType":"time-millis"}},
+	  {"name":"micros","type":{"type":"long","logicalType":"time-micros"}}
+	]}`
+	// The fixed decimal exceeds the precision of a float64.
+	unscaled, _ := new(big.Int).SetString("-123456789012345678901234567", 10)
+	unscaled.Add(unscaled, new(big.Int).Lsh(big.NewInt(1), 128))
+	var rec []byte
+	rec = unscaled.FillBytes(make([]byte, 16))
+	rec = appendBytes(rec, []byte{0xfb})
+	rec = binary.AppendVarint(rec, 3_723_004)
+	rec = binary.AppendVarint(rec, 86_399_999_999)
+	r, err := NewReader(super.NewContext(), bytes.NewReader(testFileWithSchema(schema, "", 1, rec)))
+	require.NoError(t, err)
+	val, err := r.Read()
+	require.NoError(t, err)
+	const expected = `{dec:"-12345678901234567890123.4567"::=avro_decimal_30_4,small:"-0.005"::=avro_decimal_3_3,millis:1h2m3.004s::=avro_time_millis,micros:23h59m59.999999s::=avro_time_micros}`
+	require.Equal(t, expected, sup.String(*val))
+}
+
+func testFile(codec string, count int, block []byte) []byte {
+	return testFileWithSchema(testSchema, codec, count, block)
+}
+
+func testFileWithSchema(schema, codec string, count int, block []byte) []byte {
+	sync := bytes.Repeat([]byte{0xab}, syncLen)
+	b := []byte(magic)
+	if codec == "" {
+		b = binary.AppendVarint(b, 1)
+	} else {
+		b = binary.AppendVarint(b, 2)
+		b = appendBytes(b, []byte("avro.codec"))
+		b = appendBytes(b, []byte(codec))
+	}
+	b = appendBytes(b, []byte("avro.schema"))
+	b = appendBytes(b, []byte(schema))
+	b = binary.AppendVarint(b, 0)
+	b = append(b, sync...)
+	if count > 0 {
+		b = binary.AppendVarint(b, int64(count))
+		b = appendBytes(b, block)
+		b = append(b, sync...)
+	}
+	return b
+}
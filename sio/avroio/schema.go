@@ -0,0 +1,362 @@
+package avroio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/super"
+)
+
+// schema is a node of an Avro schema.  Each node is bound to the super
+// type of its values, and a union node read from a file holds the super
+// union tag of each of its branches.
+type schema struct {
+	kind      string // primitive type name, "record", "enum", "array", "map", "fixed", or "union"
+	logical   string
+	name      string
+	fields    []schemaField
+	symbols   []string
+	items     *schema // array items or map values
+	size      int
+	branches  []*schema
+	precision int
+	scale     int
+
+	typ  super.Type
+	tags []int
+	// offset is the index of the branch of a union for the first type
+	// of the super union when writing.
+	offset int
+}
+
+type schemaField struct {
+	name string
+	typ  *schema
+}
+
+// parseSchema parses the JSON Avro schema in b.
+func parseSchema(b []byte) (*schema, error) {
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("avroio: invalid schema: %w", err)
+	}
+	p := &schemaParser{names: map[string]*schema{}}
+	return p.parse(v, "")
+}
+
+type schemaParser struct {
+	names map[string]*schema
+}
+
+func (p *schemaParser) parse(v any, namespace string) (*schema, error) {
+	switch v := v.(type) {
+	case string:
+		if isPrimitive(v) {
+			return &schema{kind: v}, nil
+		}
+		return p.lookup(v, namespace)
+	case []any:
+		s := &schema{kind: "union"}
+		for _, elem := range v {
+			branch, err := p.parse(elem, namespace)
+			if err != nil {
+				return nil, err
+			}
+			if branch.kind == "union" {
+				return nil, errors.New("avroio: invalid schema: union contains a union")
+			}
+			s.branches = append(s.branches, branch)
+		}
+		if len(s.branches) == 0 {
+			return nil, errors.New("avroio: invalid schema: empty union")
+		}
+		return s, nil
+	case map[string]any:
+		return p.parseObject(v, namespace)
+	}
+	return nil, fmt.Errorf("avroio: invalid schema: unexpected JSON value %v", v)
+}
+
+func (p *schemaParser) parseObject(v map[string]any, namespace string) (*schema, error) {
+	kind, ok := v["type"].(string)
+	if !ok {
+		if t, ok := v["type"]; ok {
+			// The type attribute may itself be a schema.
+			return p.parse(t, namespace)
+		}
+		return nil, errors.New("avroio: invalid schema: object has no type")
+	}
+	s := &schema{kind: kind}
+	s.logical, _ = v["logicalType"].(string)
+	switch kind {
+	case "record", "error", "enum", "fixed":
+		if kind == "error" {
+			s.kind = "record"
+		}
+		fullname, err := p.define(s, v, namespace)
+		if err != nil {
+			return nil, err
+		}
+		namespace = fullname[:max(strings.LastIndexByte(fullname, '.'), 0)]
+	}
+	switch s.kind {
+	case "record":
+		fields, ok := v["fields"].([]any)
+		if !ok {
+			return nil, fmt.Errorf("avroio: invalid schema: record %q has no fields", s.name)
+		}
+		for _, f := range fields {
+			f, ok := f.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("avroio: invalid schema: bad field in record %q", s.name)
+			}
+			name, ok := f["name"].(string)
+			if !ok {
+				return nil, fmt.Errorf("avroio: invalid schema: field with no name in record %q", s.name)
+			}
+			typ, err := p.parse(f["type"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			s.fields = append(s.fields, schemaField{name, typ})
+		}
+		// The record is complete so it may now be referenced.
+		p.names[s.name] = s
+	case "enum":
+		symbols, ok := v["symbols"].([]any)
+		if !ok {
+			return nil, fmt.Errorf("avroio: invalid schema: enum %q has no symbols", s.name)
+		}
+		for _, sym := range symbols {
+			sym, ok := sym.(string)
+			if !ok {
+				return nil, fmt.Errorf("avroio: invalid schema: bad symbol in enum %q", s.name)
+			}
+			s.symbols = append(s.symbols, sym)
+		}
+	case "array", "map":
+		key := "items"
+		if kind == "map" {
+			key = "values"
+		}
+		items, err := p.parse(v[key], namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.items = items
+	case "fixed":
+		size, ok := v["size"].(float64)
+		if !ok || size < 0 {
+			return nil, fmt.Errorf("avroio: invalid schema: fixed %q has no size", s.name)
+		}
+		s.size = int(size)
+	default:
+		if !isPrimitive(kind) {
+			return p.lookup(kind, namespace)
+		}
+	}
+	if s.logical == "decimal" && (s.kind == "bytes" || s.kind == "fixed") {
+		if err := s.parseDecimal(v); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// parseDecimal parses the precision and scale of a decimal.  A decimal with
+// an invalid precision or scale is an error rather than its underlying
+// type since its values would otherwise be read as their unscaled bytes.
+func (s *schema) parseDecimal(v map[string]any) error {
+	precision, ok := v["precision"].(float64)
+	if !ok || precision < 1 || precision != math.Trunc(precision) {
+		return fmt.Errorf("avroio: invalid schema: decimal has invalid precision %v", v["precision"])
+	}
+	var scale float64
+	if x, ok := v["scale"]; ok {
+		scale, ok = x.(float64)
+		if !ok || scale < 0 || scale > precision || scale != math.Trunc(scale) {
+			return fmt.Errorf("avroio: invalid schema: decimal with precision %v has invalid scale %v", precision, x)
+		}
+	}
+	if s.kind == "fixed" {
+		// The largest precision of a two's complement value of size
+		// bytes.
+		if digits := math.Floor(float64(8*s.size-1) * math.Log10(2)); precision > digits {
+			return fmt.Errorf("avroio: invalid schema: decimal precision %v exceeds %v digits of fixed %q", precision, digits, s.name)
+		}
+	}
+	s.precision, s.scale = int(precision), int(scale)
+	return nil
+}
+
+// define enters the named schema s into the name table and returns its
+// full name.  Records are entered only once their fields are parsed so a
+// reference to a record from within itself is reported as recursive.
+func (p *schemaParser) define(s *schema, v map[string]any, namespace string) (string, error) {
+	name, ok := v["name"].(string)
+	if !ok {
+		return "", fmt.Errorf("avroio: invalid schema: %s has no name", s.kind)
+	}
+	if ns, ok := v["namespace"].(string); ok && !strings.Contains(name, ".") {
+		namespace = ns
+	}
+	if !strings.Contains(name, ".") && namespace != "" {
+		name = namespace + "." + name
+	}
+	if _, ok := p.names[name]; ok {
+		return "", fmt.Errorf("avroio: invalid schema: %q is defined more than once", name)
+	}
+	s.name = name
+	if s.kind == "record" {
+		p.names[name] = nil
+	} else {
+		p.names[name] = s
+	}
+	return name, nil
+}
+
+func (p *schemaParser) lookup(name, namespace string) (*schema, error) {
+	if !strings.Contains(name, ".") && namespace != "" {
+		if s, ok := p.names[namespace+"."+name]; ok {
+			name = namespace + "." + name
+			if s != nil {
+				return s, nil
+			}
+		}
+	}
+	s, ok := p.names[name]
+	if !ok {
+		return nil, fmt.Errorf("avroio: invalid schema: unknown type %q", name)
+	}
+	if s == nil {
+		return nil, fmt.Errorf("avroio: recursive type %q is not supported", name)
+	}
+	return s, nil
+}
+
+func isPrimitive(name string) bool {
+	switch name {
+	case "null", "boolean", "int", "long", "float", "double", "bytes", "string":
+		return true
+	}
+	return false
+}
+
+// bindTypes sets the super type of each node of s.  Logical types map to
+// named types that preserve the Avro encoding when written back out
+// except where the super type is an exact match.
+func bindTypes(sctx *super.Context, s *schema) error {
+	var err error
+	s.typ, err = newType(sctx, s)
+	return err
+}
+
+func newType(sctx *super.Context, s *schema) (super.Type, error) {
+	switch s.logical {
+	case "date":
+		if s.kind == "int" {
+			return sctx.LookupTypeNamed("avro_date", super.TypeTime)
+		}
+	case "time-millis":
+		// A time of day is the duration since midnight.
+		if s.kind == "int" {
+			return sctx.LookupTypeNamed("avro_time_millis", super.TypeDuration)
+		}
+	case "time-micros":
+		if s.kind == "long" {
+			return sctx.LookupTypeNamed("avro_time_micros", super.TypeDuration)
+		}
+	case "timestamp-millis", "timestamp-micros", "local-timestamp-millis", "local-timestamp-micros", "local-timestamp-nanos":
+		if s.kind == "long" {
+			return sctx.LookupTypeNamed("avro_"+strings.ReplaceAll(s.logical, "-", "_"), super.TypeTime)
+		}
+	case "timestamp-nanos":
+		if s.kind == "long" {
+			return super.TypeTime, nil
+		}
+	case "duration":
+		if s.kind == "fixed" && s.size == 12 {
+			return super.TypeDuration, nil
+		}
+	case "decimal":
+		// A decimal is the exact text of its value since a float64
+		// cannot represent every decimal.
+		if s.kind == "bytes" || s.kind == "fixed" {
+			name := fmt.Sprintf("avro_decimal_%d_%d", s.precision, s.scale)
+			return sctx.LookupTypeNamed(name, super.TypeString)
+		}
+	}
+	// Unknown logical types and those that do not match their underlying
+	// type are ignored as required by the Avro specification.
+	s.logical = ""
+	switch s.kind {
+	case "null":
+		return super.TypeNull, nil
+	case "boolean":
+		return super.TypeBool, nil
+	case "int":
+		return super.TypeInt32, nil
+	case "long":
+		return super.TypeInt64, nil
+	case "float":
+		return super.TypeFloat32, nil
+	case "double":
+		return super.TypeFloat64, nil
+	case "bytes":
+		return super.TypeBytes, nil
+	case "string":
+		return super.TypeString, nil
+	case "record":
+		if s.typ != nil {
+			// A named record may be referenced more than once.
+			return s.typ, nil
+		}
+		var fields []super.Field
+		for _, f := range s.fields {
+			if err := bindTypes(sctx, f.typ); err != nil {
+				return nil, err
+			}
+			fields = append(fields, super.NewField(f.name, f.typ.typ))
+		}
+		return sctx.LookupTypeRecord(fields)
+	case "enum":
+		return sctx.LookupTypeEnum(s.symbols), nil
+	case "array":
+		if err := bindTypes(sctx, s.items); err != nil {
+			return nil, err
+		}
+		return sctx.LookupTypeArray(s.items.typ), nil
+	case "map":
+		if err := bindTypes(sctx, s.items); err != nil {
+			return nil, err
+		}
+		return sctx.LookupTypeMap(super.TypeString, s.items.typ), nil
+	case "fixed":
+		return sctx.LookupTypeNamed("avro_fixed_"+strconv.Itoa(s.size), super.TypeBytes)
+	case "union":
+		var types []super.Type
+		for _, b := range s.branches {
+			if err := bindTypes(sctx, b); err != nil {
+				return nil, err
+			}
+			types = append(types, b.typ)
+		}
+		unique := super.UniqueTypes(types)
+		if len(unique) == 1 {
+			// A union with a single branch or with branches
+			// of the same super type is not a super union.
+			return unique[0], nil
+		}
+		u := sctx.LookupTypeUnion(unique)
+		s.tags = nil
+		for _, b := range s.branches {
+			s.tags = append(s.tags, u.TagOf(b.typ))
+		}
+		return u, nil
+	}
+	return nil, fmt.Errorf("avroio: unknown type %q", s.kind)
+}
@@ -0,0 +1,572 @@
+package avroio
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/scode"
+	"github.com/brimdata/super/sup"
+)
+
+var (
+	ErrMultipleTypes   = errors.New("avroio: encountered multiple types (consider 'fuse')")
+	ErrNotRecord       = errors.New("avroio: not a record")
+	ErrUnsupportedType = errors.New("avroio: unsupported type")
+)
+
+// blockLen is the size of the encoded values of a block above which the
+// block is written.
+const blockLen = 64 * 1024
+
+// Writer is a sio.Writer for the Avro Object Container File format.  The
+// Avro schema is derived from the type of the first value, which must be a
+// record, and all values must have that type.  Data blocks are compressed
+// with the deflate codec.  Named types created by Reader for Avro logical
+// types and fixed types are written as those types.
+type Writer struct {
+	w      io.WriteCloser
+	typ    *super.TypeRecord
+	schema *schema
+	names  map[string]int
+	sync   [syncLen]byte
+
+	block  []byte
+	count  int
+	out    bytes.Buffer
+	flater *flate.Writer
+}
+
+func NewWriter(w io.WriteCloser) *Writer {
+	return &Writer{
+		w:     w,
+		names: map[string]int{},
+	}
+}
+
+func (w *Writer) Close() error {
+	err := w.flush()
+	if err2 := w.w.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+func (w *Writer) Write(val super.Value) error {
+	recType, ok := super.TypeUnder(val.Type()).(*super.TypeRecord)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotRecord, sup.FormatValue(val))
+	}
+	if w.typ == nil {
+		s, err := w.newSchema(val.Type())
+		if err != nil {
+			return err
+		}
+		w.typ = recType
+		w.schema = s
+		if err := w.writeHeader(); err != nil {
+			return err
+		}
+	} else if w.typ != recType {
+		return fmt.Errorf("%w: %s and %s", ErrMultipleTypes, sup.FormatType(w.typ), sup.FormatType(recType))
+	}
+	if val.IsNull() {
+		return fmt.Errorf("avroio: cannot write null value of type %s", sup.FormatType(val.Type()))
+	}
+	var err error
+	if w.block, err = w.encode(w.block, w.schema, val.Bytes()); err != nil {
+		return err
+	}
+	w.count++
+	if len(w.block) >= blockLen {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *Writer) writeHeader() error {
+	schema, err := json.Marshal(w.schema.json())
+	if err != nil {
+		return err
+	}
+	if _, err := rand.Read(w.sync[:]); err != nil {
+		return err
+	}
+	b := []byte(magic)
+	b = binary.AppendVarint(b, 2)
+	b = appendBytes(b, []byte("avro.schema"))
+	b = appendBytes(b, schema)
+	b = appendBytes(b, []byte("avro.codec"))
+	b = appendBytes(b, []byte("deflate"))
+	b = binary.AppendVarint(b, 0)
+	b = append(b, w.sync[:]...)
+	_, err = w.w.Write(b)
+	return err
+}
+
+func (w *Writer) flush() error {
+	if w.count == 0 {
+		return nil
+	}
+	w.out.Reset()
+	if w.flater == nil {
+		var err error
+		if w.flater, err = flate.NewWriter(&w.out, flate.DefaultCompression); err != nil {
+			return err
+		}
+	} else {
+		w.flater.Reset(&w.out)
+	}
+	if _, err := w.flater.Write(w.block); err != nil {
+		return err
+	}
+	if err := w.flater.Close(); err != nil {
+		return err
+	}
+	b := binary.AppendVarint(w.block[:0], int64(w.count))
+	b = binary.AppendVarint(b, int64(w.out.Len()))
+	if _, err := w.w.Write(b); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(w.out.Bytes()); err != nil {
+		return err
+	}
+	if _, err := w.w.Write(w.sync[:]); err != nil {
+		return err
+	}
+	w.block = w.block[:0]
+	w.count = 0
+	return nil
+}
+
+func appendBytes(b, v []byte) []byte {
+	b = binary.AppendVarint(b, int64(len(v)))
+	return append(b, v...)
+}
+
+// newSchema returns the Avro schema for values of type typ.
+func (w *Writer) newSchema(typ super.Type) (*schema, error) {
+	var name string
+	if n, ok := typ.(*super.TypeNamed); ok {
+		name = n.Name
+	}
+	typ = super.TypeUnder(typ)
+	s := &schema{typ: typ}
+	switch typ := typ.(type) {
+	case *super.TypeOfNull:
+		s.kind = "null"
+	case *super.TypeOfBool:
+		s.kind = "boolean"
+	case *super.TypeOfUint8, *super.TypeOfUint16, *super.TypeOfInt8, *super.TypeOfInt16, *super.TypeOfInt32:
+		s.kind = "int"
+	case *super.TypeOfUint32, *super.TypeOfUint64, *super.TypeOfInt64:
+		s.kind = "long"
+	case *super.TypeOfDuration:
+		switch name {
+		case "avro_time_millis":
+			s.kind, s.logical = "int", "time-millis"
+		case "avro_time_micros":
+			s.kind, s.logical = "long", "time-micros"
+		default:
+			s.kind, s.logical, s.size = "fixed", "duration", 12
+			s.name = w.newName("duration")
+		}
+	case *super.TypeOfTime:
+		if name == "avro_date" {
+			s.kind = "int"
+		} else {
+			s.kind = "long"
+		}
+		switch name {
+		case "avro_date", "avro_timestamp_millis", "avro_timestamp_micros",
+			"avro_local_timestamp_millis", "avro_local_timestamp_micros", "avro_local_timestamp_nanos":
+			s.logical = strings.ReplaceAll(strings.TrimPrefix(name, "avro_"), "_", "-")
+		default:
+			s.logical = "timestamp-nanos"
+		}
+	case *super.TypeOfFloat16, *super.TypeOfFloat32:
+		s.kind = "float"
+	case *super.TypeOfFloat64:
+		s.kind = "double"
+	case *super.TypeOfBytes:
+		s.kind = "bytes"
+		if size, ok := strings.CutPrefix(name, "avro_fixed_"); ok {
+			if n, err := strconv.Atoi(size); err == nil && n >= 0 {
+				s.kind, s.size = "fixed", n
+				s.name = w.newName("fixed")
+			}
+		}
+	case *super.TypeOfString, *super.TypeOfIP, *super.TypeOfNet, *super.TypeOfType, *super.TypeError:
+		s.kind = "string"
+		var precision, scale int
+		if _, err := fmt.Sscanf(name, "avro_decimal_%d_%d", &precision, &scale); err == nil && precision > 0 && scale >= 0 && scale <= precision {
+			s.kind, s.logical = "bytes", "decimal"
+			s.precision, s.scale = precision, scale
+		}
+	case *super.TypeRecord:
+		s.kind = "record"
+		s.name = w.newName("record")
+		for _, f := range typ.Fields {
+			if !isValidName(f.Name) {
+				return nil, fmt.Errorf("%w: field name %q is not a valid Avro name", ErrUnsupportedType, f.Name)
+			}
+			fs, err := w.newSchema(f.Type)
+			if err != nil {
+				return nil, err
+			}
+			if f.Opt {
+				fs = optional(fs)
+			}
+			s.fields = append(s.fields, schemaField{f.Name, fs})
+		}
+	case *super.TypeEnum:
+		s.kind = "enum"
+		s.name = w.newName("enum")
+		for _, sym := range typ.Symbols {
+			if !isValidName(sym) {
+				return nil, fmt.Errorf("%w: enum symbol %q is not a valid Avro name", ErrUnsupportedType, sym)
+			}
+		}
+		s.symbols = typ.Symbols
+	case *super.TypeArray, *super.TypeSet:
+		items, err := w.newSchema(super.InnerType(typ))
+		if err != nil {
+			return nil, err
+		}
+		s.kind, s.items = "array", items
+	case *super.TypeMap:
+		if super.TypeUnder(typ.KeyType) != super.TypeString {
+			return nil, fmt.Errorf("%w: map with non-string keys: %s", ErrUnsupportedType, sup.FormatType(typ))
+		}
+		values, err := w.newSchema(typ.ValType)
+		if err != nil {
+			return nil, err
+		}
+		s.kind, s.items = "map", values
+	case *super.TypeUnion:
+		s.kind = "union"
+		seen := map[string]bool{}
+		for _, t := range typ.Types {
+			b, err := w.newSchema(t)
+			if err != nil {
+				return nil, err
+			}
+			if b.kind == "union" {
+				return nil, fmt.Errorf("%w: union contains a union: %s", ErrUnsupportedType, sup.FormatType(typ))
+			}
+			// Avro unions may not have more than one branch of the
+			// same unnamed type.
+			if b.name == "" {
+				if seen[b.kind] {
+					return nil, fmt.Errorf("%w: union has more than one Avro %s: %s", ErrUnsupportedType, b.kind, sup.FormatType(typ))
+				}
+				seen[b.kind] = true
+			}
+			s.branches = append(s.branches, b)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, sup.FormatType(typ))
+	}
+	return s, nil
+}
+
+// optional returns the schema of an optional field of schema s, which is a
+// union with a null branch to encode a missing value.
+func optional(s *schema) *schema {
+	if s.kind != "union" {
+		return &schema{kind: "union", typ: s.typ, branches: []*schema{{kind: "null"}, s}}
+	}
+	for _, b := range s.branches {
+		if b.kind == "null" {
+			return s
+		}
+	}
+	out := *s
+	out.branches = append([]*schema{{kind: "null"}}, s.branches...)
+	out.offset = 1
+	return &out
+}
+
+func (w *Writer) newName(kind string) string {
+	n := w.names[kind]
+	w.names[kind]++
+	return kind + strconv.Itoa(n)
+}
+
+var nameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func isValidName(s string) bool {
+	return nameRegexp.MatchString(s)
+}
+
+// json returns the JSON representation of s.
+func (s *schema) json() any {
+	var out map[string]any
+	switch s.kind {
+	case "record":
+		var fields []any
+		for _, f := range s.fields {
+			fields = append(fields, map[string]any{"name": f.name, "type": f.typ.json()})
+		}
+		out = map[string]any{"type": "record", "name": s.name, "fields": fields}
+	case "enum":
+		out = map[string]any{"type": "enum", "name": s.name, "symbols": s.symbols}
+	case "array":
+		out = map[string]any{"type": "array", "items": s.items.json()}
+	case "map":
+		out = map[string]any{"type": "map", "values": s.items.json()}
+	case "fixed":
+		out = map[string]any{"type": "fixed", "name": s.name, "size": s.size}
+	case "union":
+		var branches []any
+		for _, b := range s.branches {
+			branches = append(branches, b.json())
+		}
+		return branches
+	default:
+		if s.logical == "" {
+			return s.kind
+		}
+		out = map[string]any{"type": s.kind}
+	}
+	if s.logical != "" {
+		out["logicalType"] = s.logical
+		if s.logical == "decimal" {
+			out["precision"] = s.precision
+			out["scale"] = s.scale
+		}
+	}
+	return out
+}
+
+// encode appends the Avro binary encoding of bytes, whose type is s.typ,
+// to b.
+func (w *Writer) encode(b []byte, s *schema, bytes scode.Bytes) ([]byte, error) {
+	if bytes == nil && s.kind != "null" {
+		if s.kind == "union" {
+			// Encode a null of any type in a union with a null branch.
+			for k, branch := range s.branches {
+				if branch.kind == "null" {
+					return binary.AppendVarint(b, int64(k)), nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("avroio: cannot write null value of type %s", sup.FormatType(s.typ))
+	}
+	switch s.kind {
+	case "null":
+	case "boolean":
+		if super.DecodeBool(bytes) {
+			b = append(b, 1)
+		} else {
+			b = append(b, 0)
+		}
+	case "int", "long":
+		var v int64
+		switch s.typ.(type) {
+		case *super.TypeOfUint8, *super.TypeOfUint16, *super.TypeOfUint32, *super.TypeOfUint64:
+			u := super.DecodeUint(bytes)
+			if u > math.MaxInt64 {
+				return nil, fmt.Errorf("avroio: uint64 value %d overflows Avro long", u)
+			}
+			v = int64(u)
+		case *super.TypeOfDuration:
+			d := super.DecodeDuration(bytes)
+			if d < 0 || d >= 24*nano.Hour {
+				return nil, fmt.Errorf("avroio: duration %s out of range for Avro %s", d, s.logical)
+			}
+			if s.logical == "time-millis" {
+				v = int64(d / nano.Millisecond)
+			} else {
+				v = int64(d / nano.Microsecond)
+			}
+		case *super.TypeOfTime:
+			ts := int64(super.DecodeTime(bytes))
+			switch s.logical {
+			case "date":
+				v = floorDiv(ts, 86400*1_000_000_000)
+			case "timestamp-millis", "local-timestamp-millis":
+				v = floorDiv(ts, 1_000_000)
+			case "timestamp-micros", "local-timestamp-micros":
+				v = floorDiv(ts, 1_000)
+			default:
+				v = ts
+			}
+		default:
+			v = super.DecodeInt(bytes)
+		}
+		b = binary.AppendVarint(b, v)
+	case "float":
+		b = binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(super.DecodeFloat(bytes))))
+	case "double":
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(super.DecodeFloat64(bytes)))
+	case "bytes":
+		if s.logical == "decimal" {
+			dec, err := encodeDecimal(super.DecodeString(bytes), s.precision, s.scale)
+			if err != nil {
+				return nil, err
+			}
+			return appendBytes(b, dec), nil
+		}
+		b = appendBytes(b, bytes)
+	case "string":
+		switch typ := s.typ.(type) {
+		case *super.TypeOfString:
+			b = appendBytes(b, bytes)
+		case *super.TypeOfIP:
+			b = appendBytes(b, []byte(super.DecodeIP(bytes).String()))
+		case *super.TypeOfNet:
+			b = appendBytes(b, []byte(super.DecodeNet(bytes).String()))
+		case *super.TypeOfType:
+			b = appendBytes(b, []byte(sup.FormatTypeValue(bytes)))
+		case *super.TypeError:
+			b = appendBytes(b, []byte(sup.FormatValue(super.NewValue(typ, bytes))))
+		default:
+			panic(sup.FormatType(typ))
+		}
+	case "fixed":
+		if s.logical == "duration" {
+			d := super.DecodeDuration(bytes)
+			days := d / (24 * nano.Hour)
+			if d < 0 || days > math.MaxUint32 {
+				return nil, fmt.Errorf("avroio: duration %s out of range for Avro duration", d)
+			}
+			b = binary.LittleEndian.AppendUint32(b, 0)
+			b = binary.LittleEndian.AppendUint32(b, uint32(days))
+			return binary.LittleEndian.AppendUint32(b, uint32((d%(24*nano.Hour))/nano.Millisecond)), nil
+		}
+		if len(bytes) != s.size {
+			return nil, fmt.Errorf("avroio: %d-byte value for Avro fixed of size %d", len(bytes), s.size)
+		}
+		b = append(b, bytes...)
+	case "record":
+		recType := s.typ.(*super.TypeRecord)
+		it := scode.NewRecordIter(bytes, recType.Opts)
+		for k, f := range s.fields {
+			val, none := it.Next(recType.Fields[k].Opt)
+			var err error
+			if none {
+				b, err = w.encode(b, f.typ, nil)
+			} else {
+				b, err = w.encode(b, f.typ, val)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	case "enum":
+		b = binary.AppendVarint(b, int64(super.DecodeUint(bytes)))
+	case "array", "map":
+		var n int64
+		for it := bytes.Iter(); !it.Done(); it.Next() {
+			n++
+		}
+		if s.kind == "map" {
+			n /= 2
+		}
+		if n > 0 {
+			b = binary.AppendVarint(b, n)
+			var err error
+			for it := bytes.Iter(); !it.Done(); {
+				if s.kind == "map" {
+					b = appendBytes(b, it.Next())
+				}
+				if b, err = w.encode(b, s.items, it.Next()); err != nil {
+					return nil, err
+				}
+			}
+		}
+		b = binary.AppendVarint(b, 0)
+	case "union":
+		u, ok := s.typ.(*super.TypeUnion)
+		if !ok {
+			// s is the optional form of a non-union type.
+			b = binary.AppendVarint(b, 1)
+			return w.encode(b, s.branches[1], bytes)
+		}
+		it := bytes.Iter()
+		tag := int(super.DecodeUint(it.Next()))
+		if tag < 0 || tag >= len(u.Types) {
+			return nil, fmt.Errorf("avroio: bad union tag %d", tag)
+		}
+		k := tag + s.offset
+		b = binary.AppendVarint(b, int64(k))
+		return w.encode(b, s.branches[k], it.Next())
+	default:
+		panic(s.kind)
+	}
+	return b, nil
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+// encodeDecimal returns the big-endian two's complement encoding of the
+// unscaled value of the decimal text s, which must have no more than
+// precision digits, scale of them after the decimal point.
+func encodeDecimal(s string, precision, scale int) ([]byte, error) {
+	text := strings.TrimPrefix(s, "+")
+	text, neg := strings.CutPrefix(text, "-")
+	whole, frac, _ := strings.Cut(text, ".")
+	if whole == "" && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return nil, fmt.Errorf("avroio: invalid decimal %q", s)
+	}
+	if len(frac) > scale {
+		if strings.Trim(frac[scale:], "0") != "" {
+			return nil, fmt.Errorf("avroio: decimal %q has more than %d digits after the decimal point", s, scale)
+		}
+		frac = frac[:scale]
+	}
+	digits := strings.TrimLeft(whole+frac+strings.Repeat("0", scale-len(frac)), "0")
+	if len(digits) > precision {
+		return nil, fmt.Errorf("avroio: decimal %q has more than %d digits", s, precision)
+	}
+	i := new(big.Int)
+	if digits != "" {
+		i.SetString(digits, 10)
+	}
+	if neg {
+		i.Neg(i)
+	}
+	if i.Sign() >= 0 {
+		b := i.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		return b, nil
+	}
+	// Form the two's complement of the smallest width that holds i.
+	n := (i.BitLen() + 8) / 8
+	i.Add(i, new(big.Int).Lsh(big.NewInt(1), uint(n)*8))
+	b := i.Bytes()
+	for len(b) < n {
+		b = append([]byte{0xff}, b...)
+	}
+	return b, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -7,6 +7,7 @@ import (
 	"math"
 	"sync/atomic"
 
+	"github.com/axiomhq/hyperloglog"
 	"github.com/brimdata/super"
 	"github.com/brimdata/super/csup"
 	"github.com/brimdata/super/pkg/field"
@@ -141,3 +142,40 @@ func (v *VectorReader) close() error {
 	}
 	return nil
 }
+
+// Stats returns the number of values in the CSUP objects in r and, for each
+// path in paths, the merged distinct-value sketch of the values at the path
+// or nil if any object lacks one.
+func Stats(r io.ReaderAt, paths []field.Path) (uint64, []*hyperloglog.Sketch, error) {
+	var count uint64
+	sketches := make([]*hyperloglog.Sketch, len(paths))
+	for k := range sketches {
+		sketches[k] = hyperloglog.New()
+	}
+	var off int64
+	for {
+		section := io.NewSectionReader(r, off, math.MaxInt64)
+		hdr, err := csup.ReadHeader(section)
+		if err == io.EOF {
+			return count, sketches, nil
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		o, err := csup.NewObjectFromHeader(section, hdr)
+		if err != nil {
+			return 0, nil, err
+		}
+		count += uint64(o.Len())
+		for k, path := range paths {
+			if sketches[k] == nil {
+				continue
+			}
+			s, ok := o.Sketch(path)
+			if !ok || sketches[k].Merge(s) != nil {
+				sketches[k] = nil
+			}
+		}
+		off += int64(hdr.ObjectSize())
+	}
+}
@@ -10,3 +10,8 @@ import (
 func NewWriter(w io.WriteCloser) *csup.Writer {
 	return csup.NewWriter(w)
 }
+
+// NewWriterWithOpts returns a writer to w with opts.
+func NewWriterWithOpts(w io.WriteCloser, opts csup.WriterOpts) *csup.Writer {
+	return csup.NewWriterWithOpts(w, opts)
+}
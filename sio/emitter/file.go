@@ -43,6 +43,9 @@ func NewFileFromURI(ctx context.Context, engine storage.Engine, path *storage.UR
 		// Don't buffer terminal output.
 		wc = bufwriter.New(wc)
 	}
+	if opts.Compression == "" {
+		opts.Compression = sio.CompressionFromPath(path.Path)
+	}
 	// On close, sio.WriteCloser.Close will close and flush the
 	// downstream writer, which will flush the bufwriter here and,
 	// in turn, close its underlying writer.
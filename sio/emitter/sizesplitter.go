@@ -30,9 +30,10 @@ type sizeSplitter struct {
 
 // NewSizeSplitter returns a sio.WriteCloser that writes to sequentially
 // numbered files created by engine in dir with optional prefix and with opts,
-// creating a new file after the current one reaches size bytes.  Files may
+// creating a new file after the current one reaches size bytes.  If
+// opts.Compression is set, size applies to the compressed bytes.  Files may
 // exceed size substantially due to buffering in the underlying writer as
-// determined by opts.Format.
+// determined by opts.Format and opts.Compression.
 func NewSizeSplitter(ctx context.Context, engine storage.Engine, dir *storage.URI, prefix string, unbuffered bool,
 	opts anyio.WriterOpts, size int64) (sio.WriteCloser, error) {
 	ext := sio.Extension(opts.Format)
@@ -50,7 +51,7 @@ func NewSizeSplitter(ctx context.Context, engine storage.Engine, dir *storage.UR
 		unbuffered: unbuffered,
 		opts:       opts,
 		size:       size,
-		ext:        ext,
+		ext:        ext + sio.CompressionExtension(opts.Compression),
 	}, nil
 }
 
@@ -0,0 +1,60 @@
+package emitter
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/storage"
+	"github.com/brimdata/super/sio/anyio"
+	"github.com/brimdata/super/sio/supio"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSizeSplitterCompressed(t *testing.T) {
+	const n, size = 50000, 4096
+	dir := t.TempDir()
+	uri, err := storage.ParseURI(dir)
+	require.NoError(t, err)
+	opts := anyio.WriterOpts{Format: "sup", Compression: "gzip"}
+	w, err := NewSizeSplitter(t.Context(), storage.NewLocalEngine(), uri, "", false, opts, size)
+	require.NoError(t, err)
+	for i := range n {
+		require.NoError(t, w.Write(super.NewInt64(int64(i))))
+	}
+	require.NoError(t, w.Close())
+	paths, err := filepath.Glob(filepath.Join(dir, "*.sup.gz"))
+	require.NoError(t, err)
+	// Compression shrinks the output, so there are fewer files than the
+	// uncompressed size would produce.
+	require.Greater(t, len(paths), 1)
+	require.Less(t, len(paths), n*5/size)
+	var i int
+	for k := range paths {
+		path := filepath.Join(dir, strconv.Itoa(k)+".sup.gz")
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		if k < len(paths)-1 {
+			info, err := f.Stat()
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, info.Size(), int64(size))
+		}
+		gr, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		r := supio.NewReader(super.NewContext(), gr)
+		for {
+			val, err := r.Read()
+			require.NoError(t, err)
+			if val == nil {
+				break
+			}
+			require.Equal(t, int64(i), val.AsInt())
+			i++
+		}
+		require.NoError(t, f.Close())
+	}
+	require.Equal(t, n, i)
+}
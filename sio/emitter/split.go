@@ -38,7 +38,7 @@ func NewSplit(ctx context.Context, engine storage.Engine, dir *storage.URI, pref
 		dir:        dir,
 		prefix:     prefix,
 		unbuffered: unbuffered,
-		ext:        e,
+		ext:        e + sio.CompressionExtension(opts.Compression),
 		opts:       opts,
 		writers:    make(map[super.Type]sio.WriteCloser),
 		seen:       make(map[string]struct{}),
@@ -0,0 +1,704 @@
+package orcio
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/pkg/nano"
+	"github.com/brimdata/super/scode"
+)
+
+// node is a column of the type tree pruned to the projected fields.
+type node struct {
+	id       int
+	kind     uint64
+	typ      super.Type
+	children []*node
+	// If union is true, each value is a member of a union whose tag is
+	// valTag for a value and nullTag for a null.  Since ORC does not
+	// record which columns are nullable, a column is a union with null
+	// unless its statistics indicate it has no nulls.  The children of
+	// an ORC union are members of its union.
+	union   bool
+	valTag  int
+	nullTag int
+}
+
+type typeTree struct {
+	sctx    *super.Context
+	types   []orcType
+	hasNull []bool
+}
+
+// newNode returns the node for the column id.  If paths is nil, all the
+// fields of a struct column are included.  Otherwise, only the fields
+// named by paths are included unless none are present, in which case the
+// paths are ignored as parquetio does.
+func (t *typeTree) newNode(id int, paths []field.Path) (*node, error) {
+	n, err := t.newBareNode(id, paths)
+	if err != nil {
+		return nil, err
+	}
+	if t.nullable(id) {
+		u := t.sctx.LookupTypeUnion([]super.Type{n.typ, super.TypeNull})
+		n.union, n.valTag, n.nullTag = true, u.TagOf(n.typ), u.TagOf(super.TypeNull)
+		n.typ = u
+	}
+	return n, nil
+}
+
+// nullable reports whether the column id may hold a null.  A union holds
+// its nulls in its own union.
+func (t *typeTree) nullable(id int) bool {
+	return t.types[id].kind != kindUnion && t.hasNulls(id)
+}
+
+func (t *typeTree) hasNulls(id int) bool {
+	return id >= len(t.hasNull) || t.hasNull[id]
+}
+
+// newBareNode returns the node for the column id with the type of its
+// values that are not null.
+func (t *typeTree) newBareNode(id int, paths []field.Path) (*node, error) {
+	typ := t.types[id]
+	n := &node{id: id, kind: typ.kind}
+	var err error
+	switch typ.kind {
+	case kindBoolean:
+		n.typ = super.TypeBool
+	case kindByte:
+		n.typ = super.TypeInt8
+	case kindShort:
+		n.typ = super.TypeInt16
+	case kindInt:
+		n.typ = super.TypeInt32
+	case kindLong:
+		n.typ = super.TypeInt64
+	case kindFloat:
+		n.typ = super.TypeFloat32
+	case kindDouble, kindDecimal:
+		n.typ = super.TypeFloat64
+	case kindString, kindVarchar, kindChar:
+		n.typ = super.TypeString
+	case kindBinary:
+		n.typ = super.TypeBytes
+	case kindTimestamp, kindTimestampInstant, kindDate:
+		n.typ = super.TypeTime
+	case kindList:
+		if err := t.addChildren(n, typ.subtypes); err != nil {
+			return nil, err
+		}
+		n.typ = t.sctx.LookupTypeArray(n.children[0].typ)
+	case kindMap:
+		if err := t.addChildren(n, typ.subtypes); err != nil {
+			return nil, err
+		}
+		n.typ = t.sctx.LookupTypeMap(n.children[0].typ, n.children[1].typ)
+	case kindStruct:
+		var fields []super.Field
+		for k, sub := range typ.subtypes {
+			name := typ.fieldNames[k]
+			subpaths, ok := selectField(paths, name)
+			if !ok {
+				continue
+			}
+			child, err := t.newNode(int(sub), subpaths)
+			if err != nil {
+				return nil, err
+			}
+			n.children = append(n.children, child)
+			fields = append(fields, super.NewField(name, child.typ))
+		}
+		if len(typ.subtypes) > 0 && len(fields) == 0 {
+			return t.newBareNode(id, nil)
+		}
+		n.typ, err = t.sctx.LookupTypeRecord(fields)
+	case kindUnion:
+		err = t.newUnion(n, typ.subtypes)
+	default:
+		return nil, fmt.Errorf("orcio: unknown type kind %d", typ.kind)
+	}
+	return n, err
+}
+
+func (t *typeTree) addChildren(n *node, subtypes []uint64) error {
+	for _, sub := range subtypes {
+		child, err := t.newNode(int(sub), nil)
+		if err != nil {
+			return err
+		}
+		n.children = append(n.children, child)
+	}
+	return nil
+}
+
+// newUnion sets the type of the union n to the union of the types of its
+// children and, if the union or a child may hold a null, null.
+func (t *typeTree) newUnion(n *node, subtypes []uint64) error {
+	nullable := t.hasNulls(n.id)
+	var types []super.Type
+	for _, sub := range subtypes {
+		child, err := t.newBareNode(int(sub), nil)
+		if err != nil {
+			return err
+		}
+		if t.nullable(int(sub)) {
+			nullable = true
+		}
+		n.children = append(n.children, child)
+		types = append(types, child.typ)
+	}
+	if nullable {
+		types = append(types, super.TypeNull)
+	}
+	unique := super.UniqueTypes(slices.Clone(types))
+	if len(unique) == 1 {
+		n.typ = unique[0]
+		return nil
+	}
+	u := t.sctx.LookupTypeUnion(unique)
+	for _, c := range n.children {
+		c.union, c.valTag, c.nullTag = true, u.TagOf(c.typ), u.TagOf(super.TypeNull)
+	}
+	n.union, n.nullTag = true, u.TagOf(super.TypeNull)
+	n.typ = u
+	return nil
+}
+
+// selectField reports whether paths selects the field name and returns
+// the paths within the field or nil if the entire field is selected.
+func selectField(paths []field.Path, name string) ([]field.Path, bool) {
+	if paths == nil {
+		return nil, true
+	}
+	var subpaths []field.Path
+	var ok bool
+	for _, p := range paths {
+		if len(p) == 0 || p[0] != name {
+			continue
+		}
+		if len(p) == 1 {
+			return nil, true
+		}
+		subpaths = append(subpaths, p[1:])
+		ok = true
+	}
+	return subpaths, ok
+}
+
+// ids appends the ids of the columns in the tree rooted at n.
+func (n *node) ids(ids []int) []int {
+	ids = append(ids, n.id)
+	for _, c := range n.children {
+		ids = c.ids(ids)
+	}
+	return ids
+}
+
+// column decodes the values of a column in a stripe.
+type column interface {
+	// build appends the next value of the column to b.
+	build(b *scode.Builder) error
+}
+
+type streamKey struct {
+	column int
+	kind   uint64
+}
+
+type stripeStreams struct {
+	streams   map[streamKey]*stream
+	encodings []columnEncoding
+	// loc is the time zone of the writer of the stripe.
+	loc *time.Location
+}
+
+func (s *stripeStreams) get(n *node, kind uint64) *stream {
+	if st, ok := s.streams[streamKey{n.id, kind}]; ok {
+		return st
+	}
+	// An absent stream is empty, which is an error only if a value is
+	// read from it.
+	return &stream{}
+}
+
+func (s *stripeStreams) encoding(n *node) columnEncoding {
+	if n.id < len(s.encodings) {
+		return s.encodings[n.id]
+	}
+	return columnEncoding{kind: encodingDirect}
+}
+
+func newColumn(n *node, s *stripeStreams) (column, error) {
+	nulls := nulls{node: n}
+	if st, ok := s.streams[streamKey{n.id, streamPresent}]; ok {
+		nulls.present = newBoolRLE(st)
+	}
+	encoding := s.encoding(n)
+	enc := encoding.kind
+	data := s.get(n, streamData)
+	switch n.kind {
+	case kindBoolean:
+		return &boolColumn{nulls, newBoolRLE(data)}, nil
+	case kindByte:
+		return &byteColumn{nulls, byteRLE{s: data}}, nil
+	case kindShort, kindInt, kindLong:
+		return &intColumn{nulls, newIntReader(data, enc, true)}, nil
+	case kindDate:
+		return &dateColumn{nulls, newIntReader(data, enc, true)}, nil
+	case kindFloat:
+		return &floatColumn{nulls, data, 4}, nil
+	case kindDouble:
+		return &floatColumn{nulls, data, 8}, nil
+	case kindString, kindVarchar, kindChar, kindBinary:
+		lengths := newIntReader(s.get(n, streamLength), enc, false)
+		if enc == encodingDictionary || enc == encodingDictionaryV2 {
+			dict, err := readDictionary(s.get(n, streamDictionaryData), lengths, encoding.dictionarySize)
+			if err != nil {
+				return nil, err
+			}
+			return &dictColumn{nulls, dict, newIntReader(data, enc, false)}, nil
+		}
+		return &bytesColumn{nulls, data, lengths}, nil
+	case kindTimestamp, kindTimestampInstant:
+		loc := time.UTC
+		if n.kind == kindTimestamp {
+			loc = s.loc
+		}
+		return newTimestampColumn(nulls, newIntReader(data, enc, true), newIntReader(s.get(n, streamSecondary), enc, false), loc), nil
+	case kindDecimal:
+		return &decimalColumn{nulls, data, newIntReader(s.get(n, streamSecondary), enc, true)}, nil
+	case kindList, kindMap:
+		children, err := newColumns(n.children, s)
+		if err != nil {
+			return nil, err
+		}
+		lengths := newIntReader(s.get(n, streamLength), enc, false)
+		return &listColumn{nulls, lengths, children, n.kind == kindMap}, nil
+	case kindStruct:
+		children, err := newColumns(n.children, s)
+		if err != nil {
+			return nil, err
+		}
+		return &structColumn{nulls, children}, nil
+	case kindUnion:
+		children, err := newColumns(n.children, s)
+		if err != nil {
+			return nil, err
+		}
+		return &unionColumn{nulls, byteRLE{s: data}, children}, nil
+	}
+	panic(n.kind)
+}
+
+func newColumns(nodes []*node, s *stripeStreams) ([]column, error) {
+	var cols []column
+	for _, n := range nodes {
+		c, err := newColumn(n, s)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, c)
+	}
+	return cols, nil
+}
+
+func readDictionary(data *stream, lengths intReader, size uint64) ([][]byte, error) {
+	var dict [][]byte
+	for range size {
+		n, err := lengths.next()
+		if err != nil {
+			return nil, err
+		}
+		b, err := data.next(int(n))
+		if err != nil {
+			return nil, err
+		}
+		dict = append(dict, b)
+	}
+	return dict, nil
+}
+
+// nulls decodes the present stream of a column.  A column without a
+// present stream has no nulls.  The children of a null value have no
+// entry for it in any of their streams.
+type nulls struct {
+	*node
+	present *boolRLE
+}
+
+// next reports whether the next value is present.
+func (n nulls) next() (bool, error) {
+	if n.present == nil {
+		return true, nil
+	}
+	ok, err := n.present.next()
+	if err == nil && !ok && (!n.union || n.nullTag < 0) {
+		return false, fmt.Errorf("orcio: column %d has a null but its statistics indicate no nulls", n.id)
+	}
+	return ok, err
+}
+
+// begin appends a null to b if the next value is null and otherwise
+// begins the union of the value if needed.  It returns true if a value
+// that is not null follows.
+func (n nulls) begin(b *scode.Builder) (bool, error) {
+	ok, err := n.next()
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		super.BuildUnion(b, n.nullTag, nil)
+		return false, nil
+	}
+	if n.union {
+		super.BeginUnion(b, n.valTag)
+	}
+	return true, nil
+}
+
+// end ends the union begun by begin.
+func (n nulls) end(b *scode.Builder) {
+	if n.union {
+		b.EndContainer()
+	}
+}
+
+type boolColumn struct {
+	nulls
+	data *boolRLE
+}
+
+func (c *boolColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	v, err := c.data.next()
+	if err != nil {
+		return err
+	}
+	b.Append(super.EncodeBool(v))
+	c.end(b)
+	return nil
+}
+
+type byteColumn struct {
+	nulls
+	data byteRLE
+}
+
+func (c *byteColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	v, err := c.data.next()
+	if err != nil {
+		return err
+	}
+	b.Append(super.EncodeInt(int64(int8(v))))
+	c.end(b)
+	return nil
+}
+
+type intColumn struct {
+	nulls
+	data intReader
+}
+
+func (c *intColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	v, err := c.data.next()
+	if err != nil {
+		return err
+	}
+	b.Append(super.EncodeInt(v))
+	c.end(b)
+	return nil
+}
+
+// dateColumn decodes days since the Unix epoch.
+type dateColumn struct {
+	nulls
+	data intReader
+}
+
+func (c *dateColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	v, err := c.data.next()
+	if err != nil {
+		return err
+	}
+	b.Append(super.EncodeTime(nano.Ts(v * 24 * int64(time.Hour))))
+	c.end(b)
+	return nil
+}
+
+type floatColumn struct {
+	nulls
+	data  *stream
+	width int
+}
+
+func (c *floatColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	v, err := c.data.next(c.width)
+	if err != nil {
+		return err
+	}
+	if c.width == 4 {
+		b.Append(super.EncodeFloat32(math.Float32frombits(binary.LittleEndian.Uint32(v))))
+	} else {
+		b.Append(super.EncodeFloat64(math.Float64frombits(binary.LittleEndian.Uint64(v))))
+	}
+	c.end(b)
+	return nil
+}
+
+type bytesColumn struct {
+	nulls
+	data    *stream
+	lengths intReader
+}
+
+func (c *bytesColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	n, err := c.lengths.next()
+	if err != nil {
+		return err
+	}
+	v, err := c.data.next(int(n))
+	if err != nil {
+		return err
+	}
+	b.Append(v)
+	c.end(b)
+	return nil
+}
+
+type dictColumn struct {
+	nulls
+	dict    [][]byte
+	indexes intReader
+}
+
+func (c *dictColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	k, err := c.indexes.next()
+	if err != nil {
+		return err
+	}
+	if k < 0 || k >= int64(len(c.dict)) {
+		return fmt.Errorf("orcio: dictionary index %d out of range", k)
+	}
+	b.Append(c.dict[k])
+	c.end(b)
+	return nil
+}
+
+// timestampColumn decodes the seconds since 2015-01-01T00:00:00 and the
+// nanoseconds of each timestamp.  The seconds of a timestamp without a time
+// zone are relative to that time in the writer's time zone and the value is
+// the wall-clock time there, which is read as UTC.
+type timestampColumn struct {
+	nulls
+	secs  intReader
+	nanos intReader
+	loc   *time.Location
+	epoch int64
+}
+
+// orcEpoch is the base of timestamp seconds, 2015-01-01T00:00:00Z.
+const orcEpoch = 1420070400
+
+func newTimestampColumn(nulls nulls, secs, nanos intReader, loc *time.Location) *timestampColumn {
+	epoch := int64(orcEpoch)
+	if loc != time.UTC {
+		epoch = time.Date(2015, 1, 1, 0, 0, 0, 0, loc).Unix()
+	}
+	return &timestampColumn{nulls, secs, nanos, loc, epoch}
+}
+
+func (c *timestampColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	secs, err := c.secs.next()
+	if err != nil {
+		return err
+	}
+	v, err := c.nanos.next()
+	if err != nil {
+		return err
+	}
+	// The low three bits of the nanoseconds hold the number of trailing
+	// decimal zeros removed less one.
+	nanos := v >> 3
+	if z := v & 7; z != 0 {
+		for range z + 1 {
+			nanos *= 10
+		}
+	}
+	secs += c.epoch
+	if secs < 0 && nanos > 999999 {
+		// Writers truncate negative seconds toward zero.
+		secs--
+	}
+	if c.loc != time.UTC {
+		t := time.Unix(secs, 0).In(c.loc)
+		secs = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC).Unix()
+	}
+	b.Append(super.EncodeTime(nano.Ts(secs*1_000_000_000 + nanos)))
+	c.end(b)
+	return nil
+}
+
+// decimalColumn decodes the unbounded varint of each decimal in the data
+// stream and its scale in the secondary stream.
+type decimalColumn struct {
+	nulls
+	data   *stream
+	scales intReader
+}
+
+func (c *decimalColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	v, err := c.data.bigVarint()
+	if err != nil {
+		return err
+	}
+	scale, err := c.scales.next()
+	if err != nil {
+		return err
+	}
+	if scale < -1000 || scale > 1000 {
+		return fmt.Errorf("orcio: decimal scale %d out of range", scale)
+	}
+	f := new(big.Float).SetInt(v)
+	d := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(abs(scale)), nil))
+	if scale < 0 {
+		f.Mul(f, d)
+	} else {
+		f.Quo(f, d)
+	}
+	out, _ := f.Float64()
+	b.Append(super.EncodeFloat64(out))
+	c.end(b)
+	return nil
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// listColumn decodes a list or, if isMap is true, a map whose children
+// are the key and value columns.
+type listColumn struct {
+	nulls
+	lengths  intReader
+	children []column
+	isMap    bool
+}
+
+func (c *listColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	n, err := c.lengths.next()
+	if err != nil {
+		return err
+	}
+	if n < 0 {
+		return errors.New("orcio: negative list length")
+	}
+	b.BeginContainer()
+	for range n {
+		for _, child := range c.children {
+			if err := child.build(b); err != nil {
+				return err
+			}
+		}
+	}
+	if c.isMap {
+		b.TransformContainer(super.NormalizeMap)
+	}
+	b.EndContainer()
+	c.end(b)
+	return nil
+}
+
+type structColumn struct {
+	nulls
+	fields []column
+}
+
+func (c *structColumn) build(b *scode.Builder) error {
+	if ok, err := c.begin(b); !ok || err != nil {
+		return err
+	}
+	b.BeginContainer()
+	if err := c.buildFields(b); err != nil {
+		return err
+	}
+	b.EndContainer()
+	c.end(b)
+	return nil
+}
+
+func (c *structColumn) buildFields(b *scode.Builder) error {
+	for _, f := range c.fields {
+		if err := f.build(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unionColumn decodes the tag of each value from the data stream.  Each
+// child holds only the values of the union with its tag and builds its
+// value as a member of the union.
+type unionColumn struct {
+	nulls
+	tags     byteRLE
+	children []column
+}
+
+func (c *unionColumn) build(b *scode.Builder) error {
+	ok, err := c.next()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		super.BuildUnion(b, c.nullTag, nil)
+		return nil
+	}
+	tag, err := c.tags.next()
+	if err != nil {
+		return err
+	}
+	if int(tag) >= len(c.children) {
+		return fmt.Errorf("orcio: union tag %d out of range", tag)
+	}
+	return c.children[tag].build(b)
+}
@@ -0,0 +1,136 @@
+package orcio
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// decompressor decodes the compressed chunks of an ORC stream.
+type decompressor struct {
+	kind      uint64
+	blockSize int
+	zstd      *zstd.Decoder
+	flate     io.ReadCloser
+}
+
+func newDecompressor(kind, blockSize uint64) (*decompressor, error) {
+	switch kind {
+	case compressionNone, compressionZlib, compressionSnappy, compressionLZ4, compressionZstd:
+	case compressionLZO:
+		return nil, fmt.Errorf("orcio: LZO compression is not supported")
+	default:
+		return nil, fmt.Errorf("orcio: unknown compression kind %d", kind)
+	}
+	if blockSize == 0 {
+		blockSize = 256 * 1024
+	}
+	if blockSize > maxBlockSize {
+		return nil, fmt.Errorf("orcio: compression block size %d too large", blockSize)
+	}
+	return &decompressor{kind: kind, blockSize: int(blockSize)}, nil
+}
+
+const maxBlockSize = 1 << 26
+
+func (d *decompressor) close() {
+	if d.zstd != nil {
+		d.zstd.Close()
+		d.zstd = nil
+	}
+}
+
+// decompress returns the uncompressed contents of b.  Each chunk of a
+// compressed stream begins with a three-byte little-endian header holding
+// the chunk length and a flag indicating the chunk was stored uncompressed.
+func (d *decompressor) decompress(b []byte) ([]byte, error) {
+	if d.kind == compressionNone {
+		return b, nil
+	}
+	var out []byte
+	for len(b) > 0 {
+		if len(b) < 3 {
+			return nil, errTruncated
+		}
+		h := int(b[0]) | int(b[1])<<8 | int(b[2])<<16
+		n := h >> 1
+		if n > len(b)-3 {
+			return nil, errTruncated
+		}
+		chunk := b[3 : 3+n]
+		b = b[3+n:]
+		if h&1 == 1 {
+			out = append(out, chunk...)
+			continue
+		}
+		var err error
+		if out, err = d.decompressChunk(out, chunk); err != nil {
+			return nil, fmt.Errorf("orcio: decompressing stream: %w", err)
+		}
+	}
+	return out, nil
+}
+
+func (d *decompressor) decompressChunk(out, chunk []byte) ([]byte, error) {
+	switch d.kind {
+	case compressionZlib:
+		// ORC uses raw deflate without a zlib header.
+		if d.flate == nil {
+			d.flate = flate.NewReader(bytes.NewReader(chunk))
+		} else if err := d.flate.(flate.Resetter).Reset(bytes.NewReader(chunk), nil); err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		buf.Grow(d.blockSize)
+		if _, err := io.Copy(&buf, io.LimitReader(d.flate, int64(d.blockSize)+1)); err != nil {
+			return nil, err
+		}
+		if buf.Len() > d.blockSize {
+			return nil, fmt.Errorf("chunk exceeds block size %d", d.blockSize)
+		}
+		return append(out, buf.Bytes()...), nil
+	case compressionSnappy:
+		n, err := snappy.DecodedLen(chunk)
+		if err != nil {
+			return nil, err
+		}
+		if n > d.blockSize {
+			return nil, fmt.Errorf("chunk exceeds block size %d", d.blockSize)
+		}
+		b, err := snappy.Decode(nil, chunk)
+		if err != nil {
+			return nil, err
+		}
+		return append(out, b...), nil
+	case compressionLZ4:
+		buf := make([]byte, d.blockSize)
+		n, err := lz4.UncompressBlock(chunk, buf)
+		if err != nil {
+			return nil, err
+		}
+		return append(out, buf[:n]...), nil
+	case compressionZstd:
+		if d.zstd == nil {
+			var err error
+			d.zstd, err = zstd.NewReader(nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+		n := len(out)
+		out, err := d.zstd.DecodeAll(chunk, out)
+		if err != nil {
+			return nil, err
+		}
+		if len(out)-n > d.blockSize {
+			return nil, fmt.Errorf("chunk exceeds block size %d", d.blockSize)
+		}
+		return out, nil
+	}
+	panic(d.kind)
+}
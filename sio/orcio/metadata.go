@@ -0,0 +1,334 @@
+package orcio
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The ORC metadata is a set of protocol buffers messages, of which the
+// reader needs only a few fields, so they are decoded here directly from
+// the wire format rather than by generated code.
+
+// Compression kinds
+const (
+	compressionNone   = 0
+	compressionZlib   = 1
+	compressionSnappy = 2
+	compressionLZO    = 3
+	compressionLZ4    = 4
+	compressionZstd   = 5
+)
+
+// Type kinds
+const (
+	kindBoolean          = 0
+	kindByte             = 1
+	kindShort            = 2
+	kindInt              = 3
+	kindLong             = 4
+	kindFloat            = 5
+	kindDouble           = 6
+	kindString           = 7
+	kindBinary           = 8
+	kindTimestamp        = 9
+	kindList             = 10
+	kindMap              = 11
+	kindStruct           = 12
+	kindUnion            = 13
+	kindDecimal          = 14
+	kindDate             = 15
+	kindVarchar          = 16
+	kindChar             = 17
+	kindTimestampInstant = 18
+)
+
+// Stream kinds
+const (
+	streamPresent        = 0
+	streamData           = 1
+	streamLength         = 2
+	streamDictionaryData = 3
+	streamSecondary      = 5
+)
+
+// Column encoding kinds
+const (
+	encodingDirect       = 0
+	encodingDictionary   = 1
+	encodingDirectV2     = 2
+	encodingDictionaryV2 = 3
+)
+
+type postscript struct {
+	footerLength         uint64
+	compression          uint64
+	compressionBlockSize uint64
+	metadataLength       uint64
+	magic                string
+}
+
+type footer struct {
+	stripes      []stripeInfo
+	types        []orcType
+	numberOfRows uint64
+	// hasNull holds the hasNull statistic of each column, which is true
+	// if a writer did not record it.
+	hasNull []bool
+}
+
+type stripeInfo struct {
+	offset       uint64
+	indexLength  uint64
+	dataLength   uint64
+	footerLength uint64
+	numberOfRows uint64
+}
+
+type orcType struct {
+	kind       uint64
+	subtypes   []uint64
+	fieldNames []string
+}
+
+type stripeFooter struct {
+	streams        []streamInfo
+	encodings      []columnEncoding
+	writerTimezone string
+}
+
+type columnEncoding struct {
+	kind           uint64
+	dictionarySize uint64
+}
+
+type streamInfo struct {
+	kind   uint64
+	column uint64
+	length uint64
+}
+
+var errBadMetadata = errors.New("orcio: malformed metadata")
+
+// parseMessage calls fn for each field of the protocol buffers message in
+// b.  For a varint field, v holds its value and for a length-delimited
+// field, b holds its contents.
+func parseMessage(b []byte, fn func(num protowire.Number, v uint64, b []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return errBadMetadata
+		}
+		b = b[n:]
+		var v uint64
+		var val []byte
+		switch typ {
+		case protowire.VarintType:
+			v, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			val, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return errBadMetadata
+		}
+		b = b[n:]
+		if err := fn(num, v, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendUints appends the values of a repeated integer field, which may
+// be packed into a single length-delimited field.
+func appendUints(vals []uint64, v uint64, b []byte) ([]uint64, error) {
+	if b == nil {
+		return append(vals, v), nil
+	}
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return nil, errBadMetadata
+		}
+		vals = append(vals, v)
+		b = b[n:]
+	}
+	return vals, nil
+}
+
+func parsePostscript(b []byte) (*postscript, error) {
+	var ps postscript
+	err := parseMessage(b, func(num protowire.Number, v uint64, b []byte) error {
+		switch num {
+		case 1:
+			ps.footerLength = v
+		case 2:
+			ps.compression = v
+		case 3:
+			ps.compressionBlockSize = v
+		case 5:
+			ps.metadataLength = v
+		case 8000:
+			ps.magic = string(b)
+		}
+		return nil
+	})
+	return &ps, err
+}
+
+func parseFooter(b []byte) (*footer, error) {
+	var f footer
+	err := parseMessage(b, func(num protowire.Number, v uint64, b []byte) error {
+		switch num {
+		case 3:
+			s, err := parseStripeInfo(b)
+			if err != nil {
+				return err
+			}
+			f.stripes = append(f.stripes, s)
+		case 4:
+			t, err := parseType(b)
+			if err != nil {
+				return err
+			}
+			f.types = append(f.types, t)
+		case 6:
+			f.numberOfRows = v
+		case 7:
+			hasNull := true
+			err := parseMessage(b, func(num protowire.Number, v uint64, _ []byte) error {
+				if num == 10 {
+					hasNull = v != 0
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			f.hasNull = append(f.hasNull, hasNull)
+		}
+		return nil
+	})
+	return &f, err
+}
+
+func parseStripeInfo(b []byte) (stripeInfo, error) {
+	var s stripeInfo
+	err := parseMessage(b, func(num protowire.Number, v uint64, b []byte) error {
+		switch num {
+		case 1:
+			s.offset = v
+		case 2:
+			s.indexLength = v
+		case 3:
+			s.dataLength = v
+		case 4:
+			s.footerLength = v
+		case 5:
+			s.numberOfRows = v
+		}
+		return nil
+	})
+	return s, err
+}
+
+func parseType(b []byte) (orcType, error) {
+	var t orcType
+	err := parseMessage(b, func(num protowire.Number, v uint64, b []byte) error {
+		var err error
+		switch num {
+		case 1:
+			t.kind = v
+		case 2:
+			t.subtypes, err = appendUints(t.subtypes, v, b)
+		case 3:
+			t.fieldNames = append(t.fieldNames, string(b))
+		}
+		return err
+	})
+	return t, err
+}
+
+func parseStripeFooter(b []byte) (*stripeFooter, error) {
+	var f stripeFooter
+	err := parseMessage(b, func(num protowire.Number, v uint64, b []byte) error {
+		switch num {
+		case 1:
+			var s streamInfo
+			err := parseMessage(b, func(num protowire.Number, v uint64, _ []byte) error {
+				switch num {
+				case 1:
+					s.kind = v
+				case 2:
+					s.column = v
+				case 3:
+					s.length = v
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			f.streams = append(f.streams, s)
+		case 2:
+			var e columnEncoding
+			err := parseMessage(b, func(num protowire.Number, v uint64, _ []byte) error {
+				switch num {
+				case 1:
+					e.kind = v
+				case 2:
+					e.dictionarySize = v
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			f.encodings = append(f.encodings, e)
+		case 3:
+			f.writerTimezone = string(b)
+		}
+		return nil
+	})
+	return &f, err
+}
+
+// validateTypes checks that the subtypes of each type refer to later types
+// as required by the preorder layout of the type tree so that the tree
+// cannot contain a cycle.
+func validateTypes(types []orcType) error {
+	if len(types) == 0 {
+		return errors.New("orcio: file has no types")
+	}
+	for id, t := range types {
+		for _, sub := range t.subtypes {
+			if sub <= uint64(id) || sub >= uint64(len(types)) {
+				return fmt.Errorf("orcio: type %d has invalid subtype %d", id, sub)
+			}
+		}
+		var want int
+		switch t.kind {
+		case kindList:
+			want = 1
+		case kindMap:
+			want = 2
+		case kindStruct:
+			if len(t.fieldNames) != len(t.subtypes) {
+				return fmt.Errorf("orcio: struct type %d has %d field names and %d subtypes", id, len(t.fieldNames), len(t.subtypes))
+			}
+			continue
+		case kindUnion:
+			if len(t.subtypes) == 0 || len(t.subtypes) > 256 {
+				return fmt.Errorf("orcio: union type %d has %d subtypes", id, len(t.subtypes))
+			}
+			continue
+		}
+		if len(t.subtypes) != want {
+			return fmt.Errorf("orcio: type %d of kind %d has %d subtypes", id, t.kind, len(t.subtypes))
+		}
+	}
+	return nil
+}
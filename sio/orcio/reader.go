@@ -0,0 +1,279 @@
+// Package orcio implements a reader for the Apache ORC file format.
+package orcio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/scode"
+)
+
+const magic = "ORC"
+
+// maxTailLen limits the size of the file tail read into memory.
+const maxTailLen = 1 << 28
+
+//lint:ignore ST1005 ORC should be capitalized
+var errNotSeekable = errors.New("ORC format requires seekable input")
+
+var ErrBadMagic = errors.New("orcio: not an ORC file")
+
+type readerAtSeeker interface {
+	io.ReaderAt
+	io.Seeker
+}
+
+// Reader is a sio.Reader for the ORC format.  Each row of the file is
+// read as a record of the columns selected by the fields passed to
+// NewReader.
+type Reader struct {
+	r      readerAtSeeker
+	size   int64
+	decomp *decompressor
+
+	stripes []stripeInfo
+	root    *node
+	ids     map[int]bool
+
+	stripe  int
+	loc     *time.Location
+	rows    uint64
+	col     column
+	builder scode.Builder
+	val     super.Value
+}
+
+// IsORC reports whether b begins with the magic bytes of an ORC file.
+func IsORC(b []byte) bool {
+	return len(b) >= len(magic) && string(b[:len(magic)]) == magic
+}
+
+// NewReader returns a Reader for r, which must implement io.ReaderAt and
+// io.Seeker.  The ORC file begins at the current offset of r.  If fields
+// is not empty, only the columns it selects are read.
+func NewReader(sctx *super.Context, r io.Reader, fields []field.Path) (*Reader, error) {
+	ras, ok := r.(readerAtSeeker)
+	if !ok {
+		return nil, errNotSeekable
+	}
+	// A pipe, such as standard input, may implement io.Seeker even
+	// though it cannot seek.
+	start, err := ras.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, errNotSeekable
+	}
+	end, err := ras.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, errNotSeekable
+	}
+	size := end - start
+	// Offsets within the file are relative to its start.
+	section := io.NewSectionReader(ras, start, size)
+	head := make([]byte, len(magic))
+	if _, err := section.ReadAt(head, 0); err != nil || !IsORC(head) {
+		return nil, ErrBadMagic
+	}
+	rd := &Reader{r: section, size: size}
+	ps, psOff, err := rd.readPostscript()
+	if err != nil {
+		return nil, err
+	}
+	if rd.decomp, err = newDecompressor(ps.compression, ps.compressionBlockSize); err != nil {
+		return nil, err
+	}
+	f, err := rd.readFooter(ps, psOff)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTypes(f.types); err != nil {
+		return nil, err
+	}
+	if f.types[0].kind != kindStruct {
+		return nil, errors.New("orcio: root type is not a struct")
+	}
+	tree := &typeTree{sctx: sctx, types: f.types, hasNull: f.hasNull}
+	if len(f.hasNull) != len(f.types) {
+		// Without statistics for each column, any column may be null.
+		tree.hasNull = nil
+	}
+	if rd.root, err = tree.newBareNode(0, fields); err != nil {
+		return nil, err
+	}
+	rd.stripes = f.stripes
+	rd.ids = map[int]bool{}
+	for _, id := range rd.root.ids(nil) {
+		rd.ids[id] = true
+	}
+	return rd, nil
+}
+
+func (r *Reader) Close() error {
+	r.decomp.close()
+	return nil
+}
+
+// readPostscript reads the uncompressed postscript at the end of the file,
+// whose length is given by the last byte, and returns it along with its
+// offset.
+func (r *Reader) readPostscript() (*postscript, int64, error) {
+	if r.size < int64(len(magic))+1 {
+		return nil, 0, errTruncated
+	}
+	n, err := r.readAt(r.size-1, 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	off := r.size - 1 - int64(n[0])
+	b, err := r.readAt(off, uint64(n[0]))
+	if err != nil {
+		return nil, 0, err
+	}
+	ps, err := parsePostscript(b)
+	if err != nil {
+		return nil, 0, err
+	}
+	if ps.magic != magic {
+		return nil, 0, ErrBadMagic
+	}
+	return ps, off, nil
+}
+
+// readFooter reads the footer, which precedes the postscript at offset
+// psOff.
+func (r *Reader) readFooter(ps *postscript, psOff int64) (*footer, error) {
+	if ps.footerLength > maxTailLen {
+		return nil, fmt.Errorf("orcio: footer length %d too large", ps.footerLength)
+	}
+	b, err := r.readAt(psOff-int64(ps.footerLength), ps.footerLength)
+	if err != nil {
+		return nil, err
+	}
+	if b, err = r.decomp.decompress(b); err != nil {
+		return nil, err
+	}
+	return parseFooter(b)
+}
+
+// readAt reads n bytes at offset off after checking that they lie within
+// the file.
+func (r *Reader) readAt(off int64, n uint64) ([]byte, error) {
+	if off < 0 || n > uint64(r.size-off) {
+		return nil, errTruncated
+	}
+	b := make([]byte, n)
+	if n, err := r.r.ReadAt(b, off); n < len(b) {
+		if err == nil || err == io.EOF {
+			err = errTruncated
+		}
+		return nil, err
+	}
+	return b, nil
+}
+
+func (r *Reader) Read() (*super.Value, error) {
+	for r.rows == 0 {
+		if r.stripe == len(r.stripes) {
+			return nil, nil
+		}
+		if err := r.nextStripe(); err != nil {
+			return nil, err
+		}
+	}
+	r.rows--
+	r.builder.Truncate()
+	// The root column is not a union with null so next returns an error
+	// for a null row.
+	root := r.col.(*structColumn)
+	if _, err := root.next(); err != nil {
+		return nil, err
+	}
+	// The body of a record value comprises its fields.
+	if err := root.buildFields(&r.builder); err != nil {
+		return nil, err
+	}
+	r.val = super.NewValue(r.root.typ, r.builder.Bytes())
+	return &r.val, nil
+}
+
+// nextStripe reads the streams of the selected columns of the next stripe.
+// The streams are stored in the order they are listed in the stripe
+// footer beginning at the start of the stripe.
+func (r *Reader) nextStripe() error {
+	s := r.stripes[r.stripe]
+	r.stripe++
+	if s.offset > uint64(r.size) {
+		return errTruncated
+	}
+	footerOff := s.offset + s.indexLength + s.dataLength
+	if footerOff < s.offset {
+		return errTruncated
+	}
+	b, err := r.readAt(int64(min(footerOff, uint64(r.size))), s.footerLength)
+	if err != nil {
+		return err
+	}
+	if b, err = r.decomp.decompress(b); err != nil {
+		return err
+	}
+	sf, err := parseStripeFooter(b)
+	if err != nil {
+		return err
+	}
+	loc, err := r.location(sf.writerTimezone)
+	if err != nil {
+		return err
+	}
+	streams := &stripeStreams{
+		streams:   map[streamKey]*stream{},
+		encodings: sf.encodings,
+		loc:       loc,
+	}
+	off := s.offset
+	for _, info := range sf.streams {
+		start := off
+		off += info.length
+		if off < start || off > uint64(r.size) {
+			return errTruncated
+		}
+		if !r.ids[int(info.column)] {
+			continue
+		}
+		switch info.kind {
+		case streamPresent, streamData, streamLength, streamDictionaryData, streamSecondary:
+		default:
+			continue
+		}
+		b, err := r.readAt(int64(start), info.length)
+		if err != nil {
+			return err
+		}
+		if b, err = r.decomp.decompress(b); err != nil {
+			return err
+		}
+		streams.streams[streamKey{int(info.column), info.kind}] = &stream{b}
+	}
+	if r.col, err = newColumn(r.root, streams); err != nil {
+		return err
+	}
+	r.rows = s.numberOfRows
+	return nil
+}
+
+// location returns the time zone named name, which is UTC if name is empty.
+func (r *Reader) location(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if r.loc == nil || r.loc.String() != name {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("orcio: writer time zone: %w", err)
+		}
+		r.loc = loc
+	}
+	return r.loc, nil
+}
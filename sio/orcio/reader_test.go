@@ -0,0 +1,401 @@
+package orcio
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/brimdata/super"
+	"github.com/brimdata/super/pkg/field"
+	"github.com/brimdata/super/sup"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type testType struct {
+	kind     uint64
+	subtypes []uint64
+	names    []string
+}
+
+type testStream struct {
+	column uint64
+	kind   uint64
+	data   []byte
+}
+
+// The columns of the test file in preorder are
+//
+//	0 struct
+//	1 id long (DIRECT_V2)
+//	2 name string (DICTIONARY_V2)
+//	3 tags list
+//	4 tags item string
+//	5 attrs map
+//	6 attrs key string
+//	7 attrs value int
+//	8 u union
+//	9 u int
+//	10 u string
+//	11 ts timestamp
+//	12 d decimal
+//	13 day date
+//	14 f float
+//	15 dbl double
+//	16 b boolean
+//	17 bin binary
+//	18 tiny byte
+//	19 s struct
+//	20 s.x int
+var testTypes = []testType{
+	{kind: kindStruct, subtypes: []uint64{1, 2, 3, 5, 8, 11, 12, 13, 14, 15, 16, 17, 18, 19}, names: []string{"id", "name", "tags", "attrs", "u", "ts", "d", "day", "f", "dbl", "b", "bin", "tiny", "s"}},
+	{kind: kindLong},
+	{kind: kindString},
+	{kind: kindList, subtypes: []uint64{4}},
+	{kind: kindString},
+	{kind: kindMap, subtypes: []uint64{6, 7}},
+	{kind: kindString},
+	{kind: kindInt},
+	{kind: kindUnion, subtypes: []uint64{9, 10}},
+	{kind: kindInt},
+	{kind: kindString},
+	{kind: kindTimestamp},
+	{kind: kindDecimal},
+	{kind: kindDate},
+	{kind: kindFloat},
+	{kind: kindDouble},
+	{kind: kindBoolean},
+	{kind: kindBinary},
+	{kind: kindByte},
+	{kind: kindStruct, subtypes: []uint64{20}, names: []string{"x"}},
+	{kind: kindInt},
+}
+
+// The last row is null in every column but id and s.  A column has nulls
+// if it has a present stream.
+var testStreams = []testStream{
+	// id: a fixed delta run of 1, 2, 3.
+	{1, streamData, []byte{0xc0, 0x02, 0x02, 0x02}},
+	{2, streamPresent, bools(true, true, false)},
+	{2, streamData, direct(1, 0)},
+	{2, streamDictionaryData, []byte("ab")},
+	{2, streamLength, direct(1, 1)},
+	{3, streamPresent, bools(true, true, false)},
+	{3, streamLength, uints(2, 0)},
+	{4, streamData, []byte("xy")},
+	{4, streamLength, uints(1, 1)},
+	{5, streamPresent, bools(true, true, false)},
+	{5, streamLength, uints(2, 0)},
+	{6, streamData, []byte("kj")},
+	{6, streamLength, uints(1, 1)},
+	{7, streamData, ints(1, 2)},
+	{8, streamPresent, bools(true, true, false)},
+	{8, streamData, literalBytes(0, 1)},
+	{9, streamData, ints(7)},
+	{10, streamData, []byte("s")},
+	{10, streamLength, uints(1)},
+	{11, streamPresent, bools(true, true, false)},
+	// 2015-01-01T00:00:01.5Z and 1969-12-31T23:59:58.5Z, whose
+	// seconds are truncated toward zero.
+	{11, streamData, ints(1, -1-orcEpoch)},
+	{11, streamSecondary, uints(5<<3|7, 5<<3|7)},
+	{12, streamPresent, bools(true, true, false)},
+	{12, streamData, varints(125, -350)},
+	{12, streamSecondary, ints(2, 1)},
+	{13, streamPresent, bools(true, true, false)},
+	{13, streamData, ints(19724, -1)},
+	{14, streamPresent, bools(true, true, false)},
+	{14, streamData, floats32(1.5, -0.5)},
+	{15, streamPresent, bools(true, true, false)},
+	{15, streamData, floats64(2.25, math.Inf(1))},
+	{16, streamPresent, bools(true, true, false)},
+	{16, streamData, bools(true, false)},
+	{17, streamPresent, bools(true, true, false)},
+	{17, streamData, []byte{0x01}},
+	{17, streamLength, uints(1, 0)},
+	{18, streamPresent, bools(true, true, false)},
+	{18, streamData, literalBytes(0xff, 0x7f)},
+	{19, streamPresent, bools(true, false, true)},
+	{20, streamPresent, bools(true, false)},
+	{20, streamData, ints(5)},
+}
+
+var testEncodings = map[int]columnEncoding{
+	1: {kind: encodingDirectV2},
+	2: {kind: encodingDictionaryV2, dictionarySize: 2},
+}
+
+var testRows = []string{
+	`{id:1,name:"b"::(string|null),tags:["x","y"]::(null|[string]),attrs:|{"j":2::int32,"k":1::int32}|::(null||{string:int32}|),u:7::int32::(int32|string|null),ts:2015-01-01T00:00:01.5Z::(time|null),d:1.25::(float64|null),day:2024-01-02T00:00:00Z::(time|null),f:1.5::float32::(float32|null),dbl:2.25::(float64|null),b:true::(bool|null),bin:0x01::(bytes|null),tiny:-1::int8::(int8|null),s:{x:5::int32::(int32|null)}::(null|{x:int32|null})}`,
+	`{id:2,name:"a"::(string|null),tags:[]::[string]::(null|[string]),attrs:|{}|::|{string:int32}|::(null||{string:int32}|),u:"s"::(int32|string|null),ts:1969-12-31T23:59:58.5Z::(time|null),d:-35.::(float64|null),day:1969-12-31T00:00:00Z::(time|null),f:-0.5::float32::(float32|null),dbl:+Inf::(float64|null),b:false::(bool|null),bin:0x::(bytes|null),tiny:127::int8::(int8|null),s:null::(null|{x:int32|null})}`,
+	`{id:3,name:null::(string|null),tags:null::(null|[string]),attrs:null::(null||{string:int32}|),u:null::(int32|string|null),ts:null::(time|null),d:null::(float64|null),day:null::(time|null),f:null::(float32|null),dbl:null::(float64|null),b:null::(bool|null),bin:null::(bytes|null),tiny:null::(int8|null),s:{x:null::(int32|null)}::(null|{x:int32|null})}`,
+}
+
+func TestReader(t *testing.T) {
+	for _, compression := range []uint64{compressionNone, compressionZlib, compressionSnappy, compressionZstd} {
+		r, err := NewReader(super.NewContext(), bytes.NewReader(testFile(t, compression, testStreams)), nil)
+		require.NoError(t, err)
+		for _, expected := range testRows {
+			val, err := r.Read()
+			require.NoError(t, err)
+			require.NotNil(t, val)
+			require.Equal(t, expected, sup.FormatValue(*val))
+		}
+		val, err := r.Read()
+		require.NoError(t, err)
+		require.Nil(t, val)
+		require.NoError(t, r.Close())
+	}
+}
+
+func TestReaderOffset(t *testing.T) {
+	prefix := []byte("not ORC data")
+	b := append(prefix, testFile(t, compressionNone, testStreams)...)
+	seeked := bytes.NewReader(b)
+	_, err := seeked.Seek(int64(len(prefix)), io.SeekStart)
+	require.NoError(t, err)
+	section := io.NewSectionReader(bytes.NewReader(b), int64(len(prefix)), int64(len(b)-len(prefix)))
+	for _, in := range []io.Reader{seeked, section} {
+		r, err := NewReader(super.NewContext(), in, nil)
+		require.NoError(t, err)
+		var out []string
+		for {
+			val, err := r.Read()
+			require.NoError(t, err)
+			if val == nil {
+				break
+			}
+			out = append(out, sup.FormatValue(*val))
+		}
+		require.Equal(t, testRows, out)
+	}
+}
+
+func TestReaderProjection(t *testing.T) {
+	// Corrupt the streams of the unselected columns to check that they
+	// are not read.
+	var streams []testStream
+	for _, s := range testStreams {
+		if s.column != 1 && s.column < 19 {
+			s.data = []byte{0xff}
+		}
+		streams = append(streams, s)
+	}
+	fields := []field.Path{{"s", "x"}, {"id"}, {"missing"}}
+	r, err := NewReader(super.NewContext(), bytes.NewReader(testFile(t, compressionNone, streams)), fields)
+	require.NoError(t, err)
+	var out []string
+	for {
+		val, err := r.Read()
+		require.NoError(t, err)
+		if val == nil {
+			break
+		}
+		out = append(out, sup.FormatValue(*val))
+	}
+	require.Equal(t, []string{
+		`{id:1,s:{x:5::int32::(int32|null)}::(null|{x:int32|null})}`,
+		`{id:2,s:null::(null|{x:int32|null})}`,
+		`{id:3,s:{x:null::(int32|null)}::(null|{x:int32|null})}`,
+	}, out)
+}
+
+func TestReaderErrors(t *testing.T) {
+	_, err := NewReader(super.NewContext(), bytes.NewReader([]byte("PAR1")), nil)
+	require.ErrorIs(t, err, ErrBadMagic)
+	_, err = NewReader(super.NewContext(), bytes.NewBufferString("ORC"), nil)
+	require.ErrorIs(t, err, errNotSeekable)
+	b := testFile(t, compressionNone, testStreams)
+	_, err = NewReader(super.NewContext(), bytes.NewReader(b[:len(b)-10]), nil)
+	require.Error(t, err)
+	var streams []testStream
+	for _, s := range testStreams {
+		if s.column == 1 {
+			s.data = s.data[:2]
+		}
+		streams = append(streams, s)
+	}
+	r, err := NewReader(super.NewContext(), bytes.NewReader(testFile(t, compressionNone, streams)), nil)
+	require.NoError(t, err)
+	_, err = r.Read()
+	require.ErrorIs(t, err, errTruncated)
+}
+
+// testFile returns an ORC file with a single stripe of the test rows.
+func testFile(t *testing.T, compression uint64, streams []testStream) []byte {
+	const blockSize = 1024
+	out := []byte(magic)
+	var stripeFooter []byte
+	for _, s := range streams {
+		data := compress(t, compression, s.data)
+		out = append(out, data...)
+		var msg []byte
+		msg = appendVarintField(msg, 1, s.kind)
+		msg = appendVarintField(msg, 2, s.column)
+		msg = appendVarintField(msg, 3, uint64(len(data)))
+		stripeFooter = appendBytesField(stripeFooter, 1, msg)
+	}
+	for id := range testTypes {
+		enc := testEncodings[id]
+		msg := appendVarintField(nil, 1, enc.kind)
+		msg = appendVarintField(msg, 2, enc.dictionarySize)
+		stripeFooter = appendBytesField(stripeFooter, 2, msg)
+	}
+	stripeFooter = compress(t, compression, stripeFooter)
+	dataLen := len(out) - len(magic)
+	out = append(out, stripeFooter...)
+	var stripe []byte
+	stripe = appendVarintField(stripe, 1, uint64(len(magic)))
+	stripe = appendVarintField(stripe, 3, uint64(dataLen))
+	stripe = appendVarintField(stripe, 4, uint64(len(stripeFooter)))
+	stripe = appendVarintField(stripe, 5, uint64(len(testRows)))
+	var footer []byte
+	footer = appendBytesField(footer, 3, stripe)
+	for _, typ := range testTypes {
+		var msg []byte
+		msg = appendVarintField(msg, 1, typ.kind)
+		if len(typ.subtypes) > 0 {
+			var packed []byte
+			for _, sub := range typ.subtypes {
+				packed = protowire.AppendVarint(packed, sub)
+			}
+			msg = appendBytesField(msg, 2, packed)
+		}
+		for _, name := range typ.names {
+			msg = appendBytesField(msg, 3, []byte(name))
+		}
+		footer = appendBytesField(footer, 4, msg)
+	}
+	footer = appendVarintField(footer, 6, uint64(len(testRows)))
+	// The statistics record which columns have a present stream.
+	for id := range testTypes {
+		var hasNull uint64
+		for _, s := range streams {
+			if s.column == uint64(id) && s.kind == streamPresent {
+				hasNull = 1
+			}
+		}
+		footer = appendBytesField(footer, 7, appendVarintField(nil, 10, hasNull))
+	}
+	footer = compress(t, compression, footer)
+	out = append(out, footer...)
+	var ps []byte
+	ps = appendVarintField(ps, 1, uint64(len(footer)))
+	ps = appendVarintField(ps, 2, compression)
+	ps = appendVarintField(ps, 3, blockSize)
+	ps = appendBytesField(ps, 8000, []byte(magic))
+	out = append(out, ps...)
+	return append(out, byte(len(ps)))
+}
+
+// compress compresses b as a single chunk or, if compression does not
+// reduce its size, stores it uncompressed.
+func compress(t *testing.T, compression uint64, b []byte) []byte {
+	var c []byte
+	switch compression {
+	case compressionNone:
+		return b
+	case compressionZlib:
+		var buf bytes.Buffer
+		w, err := flate.NewWriter(&buf, flate.BestCompression)
+		require.NoError(t, err)
+		_, err = w.Write(b)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		c = buf.Bytes()
+	case compressionSnappy:
+		c = snappy.Encode(nil, b)
+	case compressionZstd:
+		w, err := zstd.NewWriter(nil)
+		require.NoError(t, err)
+		c = w.EncodeAll(b, nil)
+		require.NoError(t, w.Close())
+	}
+	h := len(c) << 1
+	if len(c) >= len(b) {
+		c, h = b, len(b)<<1|1
+	}
+	return append([]byte{byte(h), byte(h >> 8), byte(h >> 16)}, c...)
+}
+
+func appendVarintField(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+// uints returns the version 1 integer run length encoding of vals as a
+// single literal run.
+func uints(vals ...uint64) []byte {
+	b := []byte{byte(0x100 - len(vals))}
+	for _, v := range vals {
+		b = binary.AppendUvarint(b, v)
+	}
+	return b
+}
+
+// direct returns the version 2 integer run length encoding of vals as a
+// single direct run of bytes.
+func direct(vals ...byte) []byte {
+	return append([]byte{0x4e, byte(len(vals) - 1)}, vals...)
+}
+
+func ints(vals ...int64) []byte {
+	b := []byte{byte(0x100 - len(vals))}
+	for _, v := range vals {
+		b = binary.AppendVarint(b, v)
+	}
+	return b
+}
+
+// varints returns vals as a sequence of signed varints without a run
+// length encoding.
+func varints(vals ...int64) []byte {
+	var b []byte
+	for _, v := range vals {
+		b = binary.AppendVarint(b, v)
+	}
+	return b
+}
+
+func literalBytes(vals ...byte) []byte {
+	return append([]byte{byte(0x100 - len(vals))}, vals...)
+}
+
+func bools(vals ...bool) []byte {
+	var b []byte
+	for k, v := range vals {
+		if k%8 == 0 {
+			b = append(b, 0)
+		}
+		if v {
+			b[len(b)-1] |= 0x80 >> (k % 8)
+		}
+	}
+	return literalBytes(b...)
+}
+
+func floats32(vals ...float32) []byte {
+	var b []byte
+	for _, v := range vals {
+		b = binary.LittleEndian.AppendUint32(b, math.Float32bits(v))
+	}
+	return b
+}
+
+func floats64(vals ...float64) []byte {
+	var b []byte
+	for _, v := range vals {
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(v))
+	}
+	return b
+}
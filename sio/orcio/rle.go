@@ -0,0 +1,460 @@
+package orcio
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errTruncated = errors.New("orcio: truncated stream")
+
+// stream is the decompressed contents of an ORC stream.
+type stream struct {
+	buf []byte
+}
+
+func (s *stream) readByte() (byte, error) {
+	if len(s.buf) == 0 {
+		return 0, errTruncated
+	}
+	b := s.buf[0]
+	s.buf = s.buf[1:]
+	return b, nil
+}
+
+func (s *stream) next(n int) ([]byte, error) {
+	if n < 0 || n > len(s.buf) {
+		return nil, errTruncated
+	}
+	b := s.buf[:n]
+	s.buf = s.buf[n:]
+	return b, nil
+}
+
+func (s *stream) uvarint() (uint64, error) {
+	var v uint64
+	for shift := 0; ; shift += 7 {
+		b, err := s.readByte()
+		if err != nil {
+			return 0, err
+		}
+		if shift >= 64 {
+			return 0, errors.New("orcio: varint overflows 64 bits")
+		}
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v, nil
+		}
+	}
+}
+
+func (s *stream) varint() (int64, error) {
+	v, err := s.uvarint()
+	return unzigzag(v), err
+}
+
+// bigVarint reads a signed varint of unbounded length as used by the
+// decimal encoding.
+func (s *stream) bigVarint() (*big.Int, error) {
+	v := new(big.Int)
+	var digit big.Int
+	for shift := uint(0); ; shift += 7 {
+		b, err := s.readByte()
+		if err != nil {
+			return nil, err
+		}
+		if shift > 1024 {
+			return nil, errors.New("orcio: decimal varint too long")
+		}
+		v.Or(v, digit.Lsh(digit.SetUint64(uint64(b&0x7f)), shift))
+		if b < 0x80 {
+			break
+		}
+	}
+	neg := v.Bit(0) == 1
+	v.Rsh(v, 1)
+	if neg {
+		v.Neg(v.Add(v, big.NewInt(1)))
+	}
+	return v, nil
+}
+
+func unzigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// byteRLE decodes the byte run length encoding.
+type byteRLE struct {
+	s       *stream
+	literal []byte
+	repeat  int
+	val     byte
+}
+
+func (r *byteRLE) next() (byte, error) {
+	if r.repeat == 0 && len(r.literal) == 0 {
+		h, err := r.s.readByte()
+		if err != nil {
+			return 0, err
+		}
+		if h < 0x80 {
+			r.repeat = int(h) + 3
+			if r.val, err = r.s.readByte(); err != nil {
+				return 0, err
+			}
+		} else if r.literal, err = r.s.next(0x100 - int(h)); err != nil {
+			return 0, err
+		}
+	}
+	if r.repeat > 0 {
+		r.repeat--
+		return r.val, nil
+	}
+	b := r.literal[0]
+	r.literal = r.literal[1:]
+	return b, nil
+}
+
+// boolRLE decodes the boolean run length encoding, which is the byte run
+// length encoding of the bits from most to least significant.
+type boolRLE struct {
+	bytes byteRLE
+	bits  byte
+	n     int
+}
+
+func newBoolRLE(s *stream) *boolRLE {
+	return &boolRLE{bytes: byteRLE{s: s}}
+}
+
+func (r *boolRLE) next() (bool, error) {
+	if r.n == 0 {
+		b, err := r.bytes.next()
+		if err != nil {
+			return false, err
+		}
+		r.bits, r.n = b, 8
+	}
+	r.n--
+	return r.bits&(1<<r.n) != 0, nil
+}
+
+// intReader decodes a run length encoded integer stream.  The values of
+// an unsigned stream are returned as the bits of an int64.
+type intReader interface {
+	next() (int64, error)
+}
+
+func newIntReader(s *stream, encoding uint64, signed bool) intReader {
+	switch encoding {
+	case encodingDirectV2, encodingDictionaryV2:
+		return &intRLEv2{s: s, signed: signed}
+	}
+	return &intRLEv1{s: s, signed: signed}
+}
+
+// intRLEv1 decodes version 1 of the integer run length encoding.
+type intRLEv1 struct {
+	s       *stream
+	signed  bool
+	literal int
+	repeat  int
+	val     int64
+	delta   int64
+}
+
+func (r *intRLEv1) next() (int64, error) {
+	if r.repeat == 0 && r.literal == 0 {
+		h, err := r.s.readByte()
+		if err != nil {
+			return 0, err
+		}
+		if h >= 0x80 {
+			r.literal = 0x100 - int(h)
+		} else {
+			d, err := r.s.readByte()
+			if err != nil {
+				return 0, err
+			}
+			if r.val, err = r.read(); err != nil {
+				return 0, err
+			}
+			r.repeat, r.delta = int(h)+3, int64(int8(d))
+			r.repeat--
+			return r.val, nil
+		}
+	}
+	if r.repeat > 0 {
+		r.repeat--
+		r.val += r.delta
+		return r.val, nil
+	}
+	r.literal--
+	return r.read()
+}
+
+func (r *intRLEv1) read() (int64, error) {
+	if r.signed {
+		return r.s.varint()
+	}
+	v, err := r.s.uvarint()
+	return int64(v), err
+}
+
+// intRLEv2 decodes version 2 of the integer run length encoding.
+type intRLEv2 struct {
+	s      *stream
+	signed bool
+	vals   []int64
+	buf    []uint64
+}
+
+func (r *intRLEv2) next() (int64, error) {
+	if len(r.vals) == 0 {
+		if err := r.decodeRun(); err != nil {
+			return 0, err
+		}
+	}
+	v := r.vals[0]
+	r.vals = r.vals[1:]
+	return v, nil
+}
+
+func (r *intRLEv2) decodeRun() error {
+	h, err := r.s.readByte()
+	if err != nil {
+		return err
+	}
+	switch h >> 6 {
+	case 0:
+		return r.shortRepeat(h)
+	case 1:
+		return r.direct(h)
+	case 2:
+		return r.patchedBase(h)
+	default:
+		return r.delta(h)
+	}
+}
+
+func (r *intRLEv2) shortRepeat(h byte) error {
+	b, err := r.s.next(int(h>>3&7) + 1)
+	if err != nil {
+		return err
+	}
+	var u uint64
+	for _, c := range b {
+		u = u<<8 | uint64(c)
+	}
+	v := r.fromUnsigned(u)
+	r.vals = r.vals[:0]
+	for range int(h&7) + 3 {
+		r.vals = append(r.vals, v)
+	}
+	return nil
+}
+
+func (r *intRLEv2) direct(h byte) error {
+	width, n, err := r.widthAndLength(h)
+	if err != nil {
+		return err
+	}
+	data, err := r.unpack(n, width)
+	if err != nil {
+		return err
+	}
+	r.vals = r.vals[:0]
+	for _, u := range data {
+		r.vals = append(r.vals, r.fromUnsigned(u))
+	}
+	return nil
+}
+
+func (r *intRLEv2) patchedBase(h byte) error {
+	width, n, err := r.widthAndLength(h)
+	if err != nil {
+		return err
+	}
+	b, err := r.s.next(2)
+	if err != nil {
+		return err
+	}
+	baseWidth := int(b[0]>>5) + 1
+	patchWidth := decodeWidth(b[0] & 0x1f)
+	gapWidth := int(b[1]>>5) + 1
+	npatches := int(b[1] & 0x1f)
+	if patchWidth+gapWidth > 64 {
+		return errors.New("orcio: invalid patched base run")
+	}
+	bb, err := r.s.next(baseWidth)
+	if err != nil {
+		return err
+	}
+	var base uint64
+	for _, c := range bb {
+		base = base<<8 | uint64(c)
+	}
+	// The base is in sign-magnitude form.
+	signBit := uint64(1) << (baseWidth*8 - 1)
+	baseVal := int64(base &^ signBit)
+	if base&signBit != 0 {
+		baseVal = -baseVal
+	}
+	data, err := r.unpack(n, width)
+	if err != nil {
+		return err
+	}
+	// unpack reuses its buffer, so copy the data before unpacking the
+	// patch list.
+	data = append([]uint64(nil), data...)
+	patches, err := r.unpack(npatches, closestFixedBits(patchWidth+gapWidth))
+	if err != nil {
+		return err
+	}
+	var pos int
+	for _, p := range patches {
+		gap := int(p >> patchWidth)
+		patch := p & (1<<patchWidth - 1)
+		pos += gap
+		if gap == 255 && patch == 0 {
+			// A gap longer than 255 is split across entries.
+			continue
+		}
+		if pos >= len(data) {
+			return errors.New("orcio: invalid patched base run")
+		}
+		data[pos] |= patch << width
+	}
+	r.vals = r.vals[:0]
+	for _, u := range data {
+		r.vals = append(r.vals, baseVal+int64(u))
+	}
+	return nil
+}
+
+func (r *intRLEv2) delta(h byte) error {
+	width, n, err := r.widthAndLength(h)
+	if err != nil {
+		return err
+	}
+	if h>>1&0x1f == 0 {
+		// A width of zero indicates a fixed delta.
+		width = 0
+	}
+	var base int64
+	if r.signed {
+		base, err = r.s.varint()
+	} else {
+		var u uint64
+		u, err = r.s.uvarint()
+		base = int64(u)
+	}
+	if err != nil {
+		return err
+	}
+	deltaBase, err := r.s.varint()
+	if err != nil {
+		return err
+	}
+	r.vals = append(r.vals[:0], base)
+	if n == 1 {
+		return nil
+	}
+	v := base + deltaBase
+	r.vals = append(r.vals, v)
+	if width == 0 {
+		for range n - 2 {
+			v += deltaBase
+			r.vals = append(r.vals, v)
+		}
+		return nil
+	}
+	deltas, err := r.unpack(n-2, width)
+	if err != nil {
+		return err
+	}
+	// The deltas are magnitudes with the sign of the delta base.
+	for _, d := range deltas {
+		if deltaBase < 0 {
+			v -= int64(d)
+		} else {
+			v += int64(d)
+		}
+		r.vals = append(r.vals, v)
+	}
+	return nil
+}
+
+// widthAndLength decodes the bit width and run length common to the
+// headers of the direct, patched base, and delta sub-encodings.
+func (r *intRLEv2) widthAndLength(h byte) (int, int, error) {
+	b, err := r.s.readByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	return decodeWidth(h >> 1 & 0x1f), (int(h&1)<<8 | int(b)) + 1, nil
+}
+
+// unpack reads n big-endian bit-packed values of the given width.
+func (r *intRLEv2) unpack(n, width int) ([]uint64, error) {
+	r.buf = r.buf[:0]
+	var cur uint64
+	var bits int
+	for range n {
+		var v uint64
+		for need := width; need > 0; {
+			if bits == 0 {
+				b, err := r.s.readByte()
+				if err != nil {
+					return nil, err
+				}
+				cur, bits = uint64(b), 8
+			}
+			take := min(need, bits)
+			v = v<<take | cur>>(bits-take)&(1<<take-1)
+			bits -= take
+			need -= take
+		}
+		r.buf = append(r.buf, v)
+	}
+	return r.buf, nil
+}
+
+func (r *intRLEv2) fromUnsigned(u uint64) int64 {
+	if r.signed {
+		return unzigzag(u)
+	}
+	return int64(u)
+}
+
+// decodeWidth maps the five-bit encoded width of a run to its bit width.
+func decodeWidth(n byte) int {
+	switch {
+	case n <= 23:
+		return int(n) + 1
+	case n == 24:
+		return 26
+	case n == 25:
+		return 28
+	case n == 26:
+		return 30
+	case n == 27:
+		return 32
+	default:
+		return 40 + 8*int(n-28)
+	}
+}
+
+// closestFixedBits rounds a bit width up to one that can be encoded in a
+// run header.
+func closestFixedBits(n int) int {
+	switch {
+	case n <= 1:
+		return 1
+	case n <= 24:
+		return n
+	case n <= 32:
+		return n + n%2
+	default:
+		return (n + 7) / 8 * 8
+	}
+}
@@ -0,0 +1,106 @@
+package orcio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The examples are from the ORC specification.
+
+func TestIntRLEv2(t *testing.T) {
+	cases := []struct {
+		name     string
+		signed   bool
+		in       []byte
+		expected []int64
+	}{
+		{
+			name:     "short repeat",
+			in:       []byte{0x0a, 0x27, 0x10},
+			expected: []int64{10000, 10000, 10000, 10000, 10000},
+		},
+		{
+			name:     "direct",
+			in:       []byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef},
+			expected: []int64{23713, 43806, 57005, 48879},
+		},
+		{
+			name: "patched base",
+			in: []byte{
+				0x8e, 0x13, 0x2b, 0x21, 0x07, 0xd0, 0x1e, 0x00, 0x14, 0x70, 0x28,
+				0x32, 0x3c, 0x46, 0x50, 0x5a, 0x64, 0x6e, 0x78, 0x82, 0x8c, 0x96,
+				0xa0, 0xaa, 0xb4, 0xbe, 0xfc, 0xe8,
+			},
+			expected: []int64{
+				2030, 2000, 2020, 1000000, 2040, 2050, 2060, 2070, 2080, 2090,
+				2100, 2110, 2120, 2130, 2140, 2150, 2160, 2170, 2180, 2190,
+			},
+		},
+		{
+			name:     "delta",
+			in:       []byte{0xc6, 0x09, 0x02, 0x02, 0x22, 0x42, 0x42, 0x46},
+			expected: []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29},
+		},
+		{
+			name:     "fixed delta",
+			signed:   true,
+			in:       []byte{0xc0, 0x04, 0x13, 0x03},
+			expected: []int64{-10, -12, -14, -16, -18},
+		},
+		{
+			name:     "signed direct",
+			signed:   true,
+			in:       []byte{0x42, 0x02, 0x6c},
+			expected: []int64{-1, 1, -2},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newIntReader(&stream{c.in}, encodingDirectV2, c.signed)
+			var out []int64
+			for range c.expected {
+				v, err := r.next()
+				require.NoError(t, err)
+				out = append(out, v)
+			}
+			require.Equal(t, c.expected, out)
+			_, err := r.next()
+			require.ErrorIs(t, err, errTruncated)
+		})
+	}
+}
+
+func TestIntRLEv1(t *testing.T) {
+	r := newIntReader(&stream{[]byte{0x61, 0x00, 0x07, 0xfb, 0x02, 0x03, 0x06, 0x07, 0x0b, 0x00, 0xff, 0x14}}, encodingDirect, false)
+	var out []int64
+	for range 108 {
+		v, err := r.next()
+		require.NoError(t, err)
+		out = append(out, v)
+	}
+	for _, v := range out[:100] {
+		require.Equal(t, int64(7), v)
+	}
+	require.Equal(t, []int64{2, 3, 6, 7, 11, 20, 19, 18}, out[100:])
+}
+
+func TestByteAndBoolRLE(t *testing.T) {
+	r := &byteRLE{s: &stream{[]byte{0x61, 0x00, 0xfe, 0x44, 0x45}}}
+	for range 100 {
+		b, err := r.next()
+		require.NoError(t, err)
+		require.Equal(t, byte(0), b)
+	}
+	for _, expected := range []byte{0x44, 0x45} {
+		b, err := r.next()
+		require.NoError(t, err)
+		require.Equal(t, expected, b)
+	}
+	br := newBoolRLE(&stream{[]byte{0xff, 0x80}})
+	for k := range 8 {
+		v, err := br.next()
+		require.NoError(t, err)
+		require.Equal(t, k == 0, v)
+	}
+}
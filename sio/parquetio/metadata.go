@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
 	"github.com/apache/arrow-go/v18/parquet/metadata"
 	"github.com/apache/arrow-go/v18/parquet/pqarrow"
 	"github.com/brimdata/super"
@@ -105,3 +107,38 @@ func multiplier(dt arrow.DataType) int64 {
 	}
 	return 1
 }
+
+// Stats returns the number of rows in the Parquet file r and, for each path
+// in paths, the number of distinct values at the path or zero if it is
+// unknown.  Parquet records distinct counts per row group, so the sum over
+// row groups is an upper bound.
+func Stats(r parquet.ReaderAtSeeker, paths []field.Path) (int64, []int64, error) {
+	pr, err := file.NewParquetReader(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	prmd := pr.MetaData()
+	rows := prmd.NumRows
+	ndvs := make([]int64, len(paths))
+	for k, path := range paths {
+		col := prmd.Schema.ColumnIndexByName(path.String())
+		if col < 0 {
+			continue
+		}
+		for i := range pr.NumRowGroups() {
+			ccmd, err := prmd.RowGroup(i).ColumnChunk(col)
+			if err != nil {
+				ndvs[k] = 0
+				break
+			}
+			stats, err := ccmd.Statistics()
+			if stats == nil || err != nil || !stats.HasDistinctCount() {
+				ndvs[k] = 0
+				break
+			}
+			ndvs[k] += stats.DistinctCount()
+		}
+		ndvs[k] = min(ndvs[k], rows)
+	}
+	return rows, ndvs, nil
+}
@@ -11,6 +11,8 @@ import (
 
 func Extension(format string) string {
 	switch format {
+	case "avro":
+		return ".avro"
 	case "bsup":
 		return ".bsup"
 	case "csup":
@@ -36,6 +38,8 @@ func Extension(format string) string {
 
 func FormatFromPath(path string) string {
 	switch filepath.Ext(path) {
+	case ".avro":
+		return "avro"
 	case ".bsup":
 		return "bsup"
 	case ".csup":
@@ -46,6 +50,8 @@ func FormatFromPath(path string) string {
 		return "json"
 	case ".jsup":
 		return "jsup"
+	case ".orc":
+		return "orc"
 	case ".parquet":
 		return "parquet"
 	case ".sup":
@@ -57,6 +63,32 @@ func FormatFromPath(path string) string {
 	}
 }
 
+// CompressionExtension returns the file extension for output compressed
+// with compression.
+func CompressionExtension(compression string) string {
+	switch compression {
+	case "gzip":
+		return ".gz"
+	case "zstd":
+		return ".zst"
+	default:
+		return ""
+	}
+}
+
+// CompressionFromPath returns the compression implied by the extension of
+// path or "none" if there is none.
+func CompressionFromPath(path string) string {
+	switch filepath.Ext(path) {
+	case ".gz":
+		return "gzip"
+	case ".zst":
+		return "zstd"
+	default:
+		return "none"
+	}
+}
+
 type nopCloser struct {
 	io.Writer
 }
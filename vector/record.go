@@ -64,12 +64,32 @@ func (r *Record) ChangeType(typ *super.TypeRecord) *Record {
 	fields := slices.Clone(r.fields)
 	for i, f := range typ.Fields {
 		if rtyp, ok := f.Type.(*super.TypeRecord); ok {
-			fields[i].Val = r.fields[i].Val.(*Record).ChangeType(rtyp)
+			fields[i] = &Field{
+				Val:  ChangeRecordType(r.fields[i].Val, rtyp),
+				Len:  r.fields[i].Len,
+				Runs: r.fields[i].Runs,
+			}
 		}
 	}
 	return &Record{typ, fields, r.len}
 }
 
+// ChangeRecordType returns a copy of vec, whose values are records with the
+// same layout as typ, with its type changed to typ.
+func ChangeRecordType(vec Any, typ *super.TypeRecord) Any {
+	switch vec := vec.(type) {
+	case *Record:
+		return vec.ChangeType(typ)
+	case *View:
+		return NewView(ChangeRecordType(vec.Any, typ), vec.Index)
+	case *Dict:
+		return NewDict(ChangeRecordType(vec.Any, typ), vec.Index, vec.Counts)
+	case *Named:
+		return ChangeRecordType(vec.Any, typ)
+	}
+	panic(vec)
+}
+
 func (r *Record) Serialize(b *scode.Builder, slot uint32) {
 	b.BeginContainer()
 	if r.Typ.Opts != 0 {
@@ -536,7 +536,7 @@ func newInputReader(sctx *super.Context, input string, flags []string) (sio.Read
 	if err := fs.Parse(flags); err != nil {
 		return nil, err
 	}
-	r, err := anyio.GzipReader(strings.NewReader(input))
+	r, err := anyio.DecompressReader(strings.NewReader(input))
 	if err != nil {
 		return nil, err
 	}